@@ -0,0 +1,222 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	rcav1 "github.com/miradorstack/mirador-rca/internal/grpc/generated"
+)
+
+// pastIncident is one entry of a backfill file.
+type pastIncident struct {
+	IncidentID string            `json:"incident_id"`
+	Services   []string          `json:"services"`
+	Start      time.Time         `json:"start"`
+	End        time.Time         `json:"end"`
+	Title      string            `json:"title"`
+	Symptoms   []string          `json:"symptoms"`
+	Labels     map[string]string `json:"labels"`
+}
+
+func backfillCommand(flags *flag.FlagSet) func(context.Context, *cli, []string) error {
+	interval := flags.Duration("interval", 10*time.Second, "Minimum time between the start of two investigations")
+	skip := flags.Int("skip", 0, "Skip this many incidents, to resume an interrupted backfill")
+	dryRun := flags.Bool("dry-run", false, "Parse and list the incidents without investigating them")
+	labels := labelFlag{}
+	flags.Var(labels, "label", "key=value label added to every investigation; repeatable")
+
+	return func(ctx context.Context, c *cli, args []string) error {
+		if len(args) != 1 || *skip < 0 {
+			return errUsage
+		}
+		if err := c.requireTenant(); err != nil {
+			return err
+		}
+		incidents, err := readPastIncidents(args[0])
+		if err != nil {
+			return err
+		}
+		if *skip > len(incidents) {
+			return fmt.Errorf("-skip %d: the file has only %d incidents", *skip, len(incidents))
+		}
+
+		var failed int
+		var next time.Time
+		for i := *skip; i < len(incidents); i++ {
+			incident := incidents[i]
+			for key, value := range labels {
+				if _, ok := incident.Labels[key]; !ok {
+					incident.Labels[key] = value
+				}
+			}
+			prefix := fmt.Sprintf("[%d/%d] %s", i+1, len(incidents), incident.IncidentID)
+			if *dryRun {
+				fmt.Fprintf(c.stdout, "%s: %s, %s to %s\n", prefix, strings.Join(incident.Services, ","),
+					incident.Start.Format(time.RFC3339), incident.End.Format(time.RFC3339))
+				continue
+			}
+
+			if wait := time.Until(next); wait > 0 {
+				select {
+				case <-ctx.Done():
+					return fmt.Errorf("interrupted; resume with -skip %d", i)
+				case <-time.After(wait):
+				}
+			}
+			next = time.Now().Add(*interval)
+
+			result, err := c.investigatePast(ctx, incident)
+			if ctx.Err() != nil {
+				return fmt.Errorf("interrupted; resume with -skip %d", i)
+			}
+			if err != nil {
+				failed++
+				fmt.Fprintf(c.stderr, "%s: %s\n", prefix, describeError(err))
+				continue
+			}
+			if c.output == "json" {
+				if err := c.print(result, nil); err != nil {
+					return err
+				}
+				continue
+			}
+			fmt.Fprintf(c.stdout, "%s: correlation %s (%s, %.2f) %s\n", prefix, result.GetCorrelationId(),
+				enumName(result.GetCategory().String(), "ROOT_CAUSE_CATEGORY_"), result.GetConfidence(),
+				truncate(result.GetRootCause(), rootCauseWidth))
+		}
+		if failed > 0 {
+			return fmt.Errorf("%d of %d investigations failed", failed, len(incidents)-*skip)
+		}
+		return nil
+	}
+}
+
+// investigatePast runs one backfill investigation under its own -timeout deadline.
+func (c *cli) investigatePast(ctx context.Context, incident pastIncident) (*rcav1.CorrelationResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	req := &rcav1.RCAInvestigationRequest{
+		IncidentId:       incident.IncidentID,
+		TenantId:         c.tenant,
+		AffectedServices: incident.Services,
+		Symptoms:         incident.Symptoms,
+		TimeRange:        &rcav1.TimeRange{Start: timestamppb.New(incident.Start), End: timestamppb.New(incident.End)},
+		Labels:           incident.Labels,
+	}
+	if incident.Title != "" {
+		req.Incident = &rcav1.IncidentMetadata{Title: incident.Title}
+	}
+	return c.client.InvestigateIncident(ctx, req)
+}
+
+// readPastIncidents loads a backfill file: a JSON array when the name ends in .json, CSV otherwise. Incidents
+// without an ID get one derived from their first service and start time, so re-running a file reuses IDs.
+func readPastIncidents(path string) ([]pastIncident, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var incidents []pastIncident
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		if err := json.NewDecoder(f).Decode(&incidents); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	} else if incidents, err = readPastIncidentsCSV(f); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if len(incidents) == 0 {
+		return nil, fmt.Errorf("%s: no incidents", path)
+	}
+
+	for i := range incidents {
+		incident := &incidents[i]
+		if len(incident.Services) == 0 {
+			return nil, fmt.Errorf("%s: incident %d: services is required", path, i+1)
+		}
+		if incident.Start.IsZero() || !incident.Start.Before(incident.End) {
+			return nil, fmt.Errorf("%s: incident %d: start must be set and before end", path, i+1)
+		}
+		if incident.IncidentID == "" {
+			incident.IncidentID = fmt.Sprintf("backfill-%s-%s", incident.Services[0], incident.Start.UTC().Format("20060102T150405Z"))
+		}
+		if incident.Labels == nil {
+			incident.Labels = map[string]string{}
+		}
+	}
+	return incidents, nil
+}
+
+// readPastIncidentsCSV reads CSV with a header row naming the columns incident_id, services, start, end,
+// title, and symptoms; services and start/end are required. services and symptoms hold comma-separated
+// lists, quoted as CSV requires, and times are RFC 3339.
+func readPastIncidentsCSV(r io.Reader) ([]pastIncident, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		switch name {
+		case "incident_id", "services", "start", "end", "title", "symptoms":
+			columns[name] = i
+		default:
+			return nil, fmt.Errorf("unknown column %q", name)
+		}
+	}
+	for _, required := range []string{"services", "start", "end"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("missing column %q", required)
+		}
+	}
+
+	var incidents []pastIncident
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return incidents, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		field := func(name string) string {
+			if i, ok := columns[name]; ok {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		line, _ := reader.FieldPos(0)
+		var services, symptoms listFlag
+		services.Set(field("services"))
+		symptoms.Set(field("symptoms"))
+		incident := pastIncident{
+			IncidentID: field("incident_id"),
+			Services:   services,
+			Title:      field("title"),
+			Symptoms:   symptoms,
+		}
+		if incident.Start, err = time.Parse(time.RFC3339, field("start")); err != nil {
+			return nil, fmt.Errorf("line %d: start: %w", line, err)
+		}
+		if incident.End, err = time.Parse(time.RFC3339, field("end")); err != nil {
+			return nil, fmt.Errorf("line %d: end: %w", line, err)
+		}
+		incidents = append(incidents, incident)
+	}
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	rcav1 "github.com/miradorstack/mirador-rca/internal/grpc/generated"
+)
+
+const backfillCSV = `incident_id,services,start,end,title,symptoms
+inc-1,checkout,2024-05-01T10:00:00Z,2024-05-01T11:00:00Z,Checkout errors,"errors,latency"
+# resumed runs skip the rows above
+,"payments, cart",2024-05-02T10:00:00Z,2024-05-02T11:00:00Z,,
+inc-3,search,2024-05-03T10:00:00Z,2024-05-03T11:00:00Z,,
+`
+
+func writeBackfillFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write %s: %v", name, err)
+	}
+	return path
+}
+
+func TestReadPastIncidents(t *testing.T) {
+	incidents, err := readPastIncidents(writeBackfillFile(t, "incidents.csv", backfillCSV))
+	if err != nil {
+		t.Fatalf("readPastIncidents returned error: %v", err)
+	}
+	if len(incidents) != 3 {
+		t.Fatalf("expected 3 incidents, got %d", len(incidents))
+	}
+	first := incidents[0]
+	if first.IncidentID != "inc-1" || first.Title != "Checkout errors" || strings.Join(first.Symptoms, ",") != "errors,latency" {
+		t.Fatalf("unexpected first incident %+v", first)
+	}
+	if !first.Start.Equal(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected start %s", first.Start)
+	}
+	second := incidents[1]
+	if strings.Join(second.Services, ",") != "payments,cart" {
+		t.Fatalf("expected services payments,cart, got %v", second.Services)
+	}
+	if second.IncidentID != "backfill-payments-20240502T100000Z" {
+		t.Fatalf("expected an ID derived from the first service and start, got %s", second.IncidentID)
+	}
+	if second.Labels == nil {
+		t.Fatalf("expected labels to be initialised")
+	}
+
+	incidents, err = readPastIncidents(writeBackfillFile(t, "incidents.JSON", `[
+  {"services": ["checkout"], "start": "2024-05-01T10:00:00Z", "end": "2024-05-01T11:00:00Z", "labels": {"source": "pagerduty"}}
+]`))
+	if err != nil {
+		t.Fatalf("readPastIncidents returned error for JSON: %v", err)
+	}
+	if len(incidents) != 1 || incidents[0].Labels["source"] != "pagerduty" || incidents[0].IncidentID != "backfill-checkout-20240501T100000Z" {
+		t.Fatalf("unexpected JSON incidents %+v", incidents)
+	}
+}
+
+func TestReadPastIncidentsRejectsMalformedFiles(t *testing.T) {
+	cases := []struct {
+		name    string
+		file    string
+		content string
+		wantErr string
+	}{
+		{name: "unknown column", file: "a.csv", content: "services,start,end,owner\n", wantErr: `unknown column "owner"`},
+		{name: "missing column", file: "a.csv", content: "services,start\n", wantErr: `missing column "end"`},
+		{name: "empty", file: "a.csv", content: "services,start,end\n", wantErr: "no incidents"},
+		{
+			name:    "bad start",
+			file:    "a.csv",
+			content: "services,start,end\ncheckout,2024-05-01T10:00:00Z,2024-05-01T11:00:00Z\ncheckout,yesterday,2024-05-01T11:00:00Z\n",
+			wantErr: "line 3: start:",
+		},
+		{
+			name:    "bad end",
+			file:    "a.csv",
+			content: "services,start,end\ncheckout,2024-05-01T10:00:00Z,\n",
+			wantErr: "line 2: end:",
+		},
+		{
+			name:    "wrong field count",
+			file:    "a.csv",
+			content: "services,start,end\ncheckout,2024-05-01T10:00:00Z\n",
+			wantErr: "wrong number of fields",
+		},
+		{
+			name:    "no services",
+			file:    "a.csv",
+			content: "services,start,end\n,2024-05-01T10:00:00Z,2024-05-01T11:00:00Z\n",
+			wantErr: "incident 1: services is required",
+		},
+		{
+			name:    "inverted window",
+			file:    "a.json",
+			content: `[{"services": ["checkout"], "start": "2024-05-01T11:00:00Z", "end": "2024-05-01T10:00:00Z"}]`,
+			wantErr: "incident 1: start must be set and before end",
+		},
+		{name: "malformed json", file: "a.json", content: `{"services": "checkout"}`, wantErr: "cannot unmarshal"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := readPastIncidents(writeBackfillFile(t, tc.file, tc.content))
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+// runBackfill runs the backfill command directly so the test controls its context.
+func runBackfill(ctx context.Context, t *testing.T, client *stubClient, args ...string) (string, string, error) {
+	t.Helper()
+	flags := flag.NewFlagSet("rca-cli backfill", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	exec := backfillCommand(flags)
+	if err := flags.Parse(args); err != nil {
+		t.Fatalf("parse flags: %v", err)
+	}
+	var stdout, stderr bytes.Buffer
+	c := &cli{tenant: "acme", output: "table", timeout: time.Second, client: client, stdout: &stdout, stderr: &stderr}
+	err := exec(ctx, c, flags.Args())
+	return stdout.String(), stderr.String(), err
+}
+
+func TestBackfillResumesFromSkip(t *testing.T) {
+	path := writeBackfillFile(t, "incidents.csv", backfillCSV)
+	client := &stubClient{correlation: &rcav1.CorrelationResult{CorrelationId: "c-1"}}
+	stdout, _, err := runBackfill(context.Background(), t, client, "-interval", "0", "-skip", "1", "-label", "source=backfill", path)
+	if err != nil {
+		t.Fatalf("backfill returned error: %v", err)
+	}
+	if len(client.requests) != 2 {
+		t.Fatalf("expected 2 investigations after skipping 1, got %d", len(client.requests))
+	}
+	first := client.requests[0].(*rcav1.RCAInvestigationRequest)
+	if first.GetIncidentId() != "backfill-payments-20240502T100000Z" || first.GetLabels()["source"] != "backfill" {
+		t.Fatalf("unexpected first request %v", first)
+	}
+	if !strings.HasPrefix(stdout, "[2/3] backfill-payments-20240502T100000Z: correlation c-1") {
+		t.Fatalf("expected progress to count from the resume offset, got %q", stdout)
+	}
+
+	if _, _, err := runBackfill(context.Background(), t, client, "-skip", "4", path); err == nil || !strings.Contains(err.Error(), "the file has only 3 incidents") {
+		t.Fatalf("expected an out-of-range -skip error, got %v", err)
+	}
+	if _, _, err := runBackfill(context.Background(), t, client, "-skip", "-1", path); !errors.Is(err, errUsage) {
+		t.Fatalf("expected a usage error for a negative -skip, got %v", err)
+	}
+}
+
+func TestBackfillPacesInvestigations(t *testing.T) {
+	path := writeBackfillFile(t, "incidents.csv", backfillCSV)
+	client := &stubClient{correlation: &rcav1.CorrelationResult{}}
+	interval := 50 * time.Millisecond
+	if _, _, err := runBackfill(context.Background(), t, client, "-interval", interval.String(), path); err != nil {
+		t.Fatalf("backfill returned error: %v", err)
+	}
+	if len(client.investigated) != 3 {
+		t.Fatalf("expected 3 investigations, got %d", len(client.investigated))
+	}
+	for i := 1; i < len(client.investigated); i++ {
+		if gap := client.investigated[i].Sub(client.investigated[i-1]); gap < interval {
+			t.Fatalf("investigation %d started %s after the previous one, want at least %s", i+1, gap, interval)
+		}
+	}
+}
+
+func TestBackfillReportsResumeOffsetWhenInterrupted(t *testing.T) {
+	path := writeBackfillFile(t, "incidents.csv", backfillCSV)
+	client := &stubClient{correlation: &rcav1.CorrelationResult{}}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, _, err := runBackfill(ctx, t, client, "-interval", "1h", path)
+	if err == nil || err.Error() != "interrupted; resume with -skip 1" {
+		t.Fatalf("expected to be told to resume with -skip 1, got %v", err)
+	}
+	if len(client.requests) != 1 {
+		t.Fatalf("expected 1 investigation before the interruption, got %d", len(client.requests))
+	}
+}
+
+func TestBackfillCountsFailures(t *testing.T) {
+	path := writeBackfillFile(t, "incidents.csv", backfillCSV)
+	client := &stubClient{err: errors.New("engine unavailable")}
+	_, stderr, err := runBackfill(context.Background(), t, client, "-interval", "0", path)
+	if err == nil || err.Error() != "3 of 3 investigations failed" {
+		t.Fatalf("expected every investigation to fail, got %v", err)
+	}
+	if !strings.Contains(stderr, "[1/3] inc-1: engine unavailable") {
+		t.Fatalf("expected per-incident failures on stderr, got %q", stderr)
+	}
+}
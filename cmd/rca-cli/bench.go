@@ -0,0 +1,191 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	rcav1 "github.com/miradorstack/mirador-rca/internal/grpc/generated"
+)
+
+// benchReport summarises a bench run; latencies cover every call, failed ones included.
+type benchReport struct {
+	Requests    int            `json:"requests"`
+	Errors      map[string]int `json:"errors,omitempty"`
+	Concurrency int            `json:"concurrency"`
+	ElapsedSec  float64        `json:"elapsed_seconds"`
+	Throughput  float64        `json:"throughput_per_second"`
+	P50Ms       float64        `json:"p50_ms"`
+	P90Ms       float64        `json:"p90_ms"`
+	P95Ms       float64        `json:"p95_ms"`
+	P99Ms       float64        `json:"p99_ms"`
+	MaxMs       float64        `json:"max_ms"`
+	TargetP95Ms float64        `json:"target_p95_ms,omitempty"`
+}
+
+func benchCommand(flags *flag.FlagSet) func(context.Context, *cli, []string) error {
+	var services listFlag
+	flags.Var(&services, "service", "Service to investigate; repeat or separate with commas to rotate through several (required)")
+	concurrency := flags.Int("concurrency", 8, "Investigations in flight at once")
+	requests := flags.Int("requests", 100, "Total investigations to run; ignored when -duration is set")
+	duration := flags.Duration("duration", 0, "Keep investigating for this long instead of a fixed count")
+	window := flags.Duration("window", 15*time.Minute, "Investigation window ending now")
+	target := flags.Duration("target", 4*time.Second, "Fail when p95 latency exceeds this; 0 only reports")
+
+	return func(ctx context.Context, c *cli, _ []string) error {
+		if len(services) == 0 || *concurrency <= 0 || (*duration <= 0 && *requests <= 0) {
+			return errUsage
+		}
+		if err := c.requireTenant(); err != nil {
+			return err
+		}
+
+		runCtx := ctx
+		if *duration > 0 {
+			var cancel context.CancelFunc
+			runCtx, cancel = context.WithTimeout(ctx, *duration)
+			defer cancel()
+		}
+		run := time.Now().UTC().Format("20060102T150405Z")
+
+		// next hands out request numbers until the count or the duration runs out.
+		var mu sync.Mutex
+		issued := 0
+		next := func() (int, bool) {
+			mu.Lock()
+			defer mu.Unlock()
+			if runCtx.Err() != nil || (*duration <= 0 && issued >= *requests) {
+				return 0, false
+			}
+			issued++
+			return issued, true
+		}
+
+		var latencies []time.Duration
+		failures := map[string]int{}
+		var wg sync.WaitGroup
+		start := time.Now()
+		for range *concurrency {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for {
+					n, ok := next()
+					if !ok {
+						return
+					}
+					elapsed, err := c.benchInvestigate(ctx, run, n, services[(n-1)%len(services)], *window)
+					if ctx.Err() != nil {
+						return
+					}
+					mu.Lock()
+					latencies = append(latencies, elapsed)
+					if err != nil {
+						failures[status.Code(err).String()]++
+					}
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+		if ctx.Err() != nil {
+			return fmt.Errorf("interrupted after %d investigations", len(latencies))
+		}
+
+		report := summariseBench(latencies, failures, *concurrency, time.Since(start), *target)
+		if c.output == "json" {
+			data, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(c.stdout, string(data))
+		} else {
+			printBenchReport(c, report)
+		}
+		if *target > 0 && report.P95Ms > report.TargetP95Ms {
+			return fmt.Errorf("p95 latency %.0fms exceeds the %s target", report.P95Ms, *target)
+		}
+		return nil
+	}
+}
+
+// benchInvestigate runs one labelled investigation under its own -timeout deadline and returns how long it
+// took. The incident ID is unique per request and part of the engine's memoization key, so no bench request
+// is answered from an earlier one's memoized result.
+func (c *cli) benchInvestigate(ctx context.Context, run string, n int, service string, window time.Duration) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	end := time.Now()
+	req := &rcav1.RCAInvestigationRequest{
+		IncidentId:       fmt.Sprintf("bench-%s-%d", run, n),
+		TenantId:         c.tenant,
+		AffectedServices: []string{service},
+		TimeRange:        &rcav1.TimeRange{Start: timestamppb.New(end.Add(-window)), End: timestamppb.New(end)},
+		Labels:           map[string]string{"source": "bench", "bench_run": run},
+	}
+	start := time.Now()
+	_, err := c.client.InvestigateIncident(ctx, req)
+	return time.Since(start), err
+}
+
+func summariseBench(latencies []time.Duration, failures map[string]int, concurrency int, elapsed, target time.Duration) benchReport {
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	report := benchReport{
+		Requests:    len(latencies),
+		Concurrency: concurrency,
+		ElapsedSec:  elapsed.Seconds(),
+		P50Ms:       percentileMs(latencies, 50),
+		P90Ms:       percentileMs(latencies, 90),
+		P95Ms:       percentileMs(latencies, 95),
+		P99Ms:       percentileMs(latencies, 99),
+		MaxMs:       percentileMs(latencies, 100),
+		TargetP95Ms: milliseconds(target),
+	}
+	if len(failures) > 0 {
+		report.Errors = failures
+	}
+	if elapsed > 0 {
+		report.Throughput = float64(len(latencies)) / elapsed.Seconds()
+	}
+	return report
+}
+
+// percentileMs returns the nearest-rank percentile p of sorted, in milliseconds.
+func percentileMs(sorted []time.Duration, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return milliseconds(sorted[max(rank, 1)-1])
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+func printBenchReport(c *cli, report benchReport) {
+	failed := 0
+	for _, count := range report.Errors {
+		failed += count
+	}
+	fmt.Fprintf(c.stdout, "investigations: %d (%d failed) with %d concurrent in %.1fs, %.2f/s\n",
+		report.Requests, failed, report.Concurrency, report.ElapsedSec, report.Throughput)
+	fmt.Fprintf(c.stdout, "latency: p50 %.0fms  p90 %.0fms  p95 %.0fms  p99 %.0fms  max %.0fms\n",
+		report.P50Ms, report.P90Ms, report.P95Ms, report.P99Ms, report.MaxMs)
+	codes := make([]string, 0, len(report.Errors))
+	for code := range report.Errors {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		fmt.Fprintf(c.stdout, "errors: %s %d\n", code, report.Errors[code])
+	}
+}
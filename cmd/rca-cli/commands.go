@@ -0,0 +1,253 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	rcav1 "github.com/miradorstack/mirador-rca/internal/grpc/generated"
+)
+
+// timeRange registers -since, -start, and -end; the returned function resolves them into a window ending now
+// unless -start or -end pin it.
+func timeRange(flags *flag.FlagSet, defaultSince time.Duration) func() (*rcav1.TimeRange, error) {
+	since := flags.Duration("since", defaultSince, "Look back this far from -end (or now)")
+	start := flags.String("start", "", "Window start, RFC 3339; overrides -since")
+	end := flags.String("end", "", "Window end, RFC 3339; defaults to now")
+	return func() (*rcav1.TimeRange, error) {
+		to := time.Now().UTC()
+		if *end != "" {
+			parsed, err := time.Parse(time.RFC3339, *end)
+			if err != nil {
+				return nil, fmt.Errorf("-end: %w", err)
+			}
+			to = parsed
+		}
+		from := to.Add(-*since)
+		if *start != "" {
+			parsed, err := time.Parse(time.RFC3339, *start)
+			if err != nil {
+				return nil, fmt.Errorf("-start: %w", err)
+			}
+			from = parsed
+		}
+		if !from.Before(to) {
+			return nil, fmt.Errorf("the window start must be before its end")
+		}
+		return &rcav1.TimeRange{Start: timestamppb.New(from), End: timestamppb.New(to)}, nil
+	}
+}
+
+func investigateCommand(flags *flag.FlagSet) func(context.Context, *cli, []string) error {
+	var services, symptoms listFlag
+	labels := labelFlag{}
+	flags.Var(&services, "service", "Affected service; repeat or separate with commas (required)")
+	flags.Var(&symptoms, "symptom", "Observed symptom, such as \"high latency\"; repeatable")
+	flags.Var(labels, "label", "key=value label stored on the correlation; repeatable")
+	incident := flags.String("incident", "", "Incident ID; defaults to cli-<timestamp>")
+	title := flags.String("title", "", "Incident title")
+	threshold := flags.Float64("threshold", 0, "Anomaly threshold; 0 uses the engine default")
+	preset := flags.String("preset", "", "Investigation preset: fast, deep, logs-heavy, or one from the engine config")
+	environment := flags.String("environment", "", "Deployment environment, such as prod or staging, to scope signals to")
+	window := timeRange(flags, time.Hour)
+
+	return func(ctx context.Context, c *cli, _ []string) error {
+		if len(services) == 0 {
+			return errUsage
+		}
+		if err := c.requireTenant(); err != nil {
+			return err
+		}
+		tr, err := window()
+		if err != nil {
+			return err
+		}
+		if *incident == "" {
+			*incident = "cli-" + time.Now().UTC().Format("20060102T150405Z")
+		}
+		req := &rcav1.RCAInvestigationRequest{
+			IncidentId:       *incident,
+			TenantId:         c.tenant,
+			AffectedServices: services,
+			Symptoms:         symptoms,
+			TimeRange:        tr,
+			AnomalyThreshold: *threshold,
+			Labels:           labels,
+			Preset:           *preset,
+			Environment:      *environment,
+		}
+		if *title != "" {
+			req.Incident = &rcav1.IncidentMetadata{Title: *title}
+		}
+		result, err := c.client.InvestigateIncident(ctx, req)
+		if err != nil {
+			return err
+		}
+		return c.print(result, func() { printCorrelation(c.stdout, result) })
+	}
+}
+
+func listCommand(flags *flag.FlagSet) func(context.Context, *cli, []string) error {
+	labels := labelFlag{}
+	flags.Var(labels, "label", "Only correlations carrying this key=value label; repeatable")
+	service := flags.String("service", "", "Only correlations affecting this service")
+	category := flags.String("category", "", "Only this root-cause category: deployment, capacity, dependency_failure, config, or network")
+	limit := flags.Int("limit", 20, "Page size")
+	pageToken := flags.String("page-token", "", "Continue from a previous page")
+	window := timeRange(flags, 7*24*time.Hour)
+
+	return func(ctx context.Context, c *cli, _ []string) error {
+		if err := c.requireTenant(); err != nil {
+			return err
+		}
+		tr, err := window()
+		if err != nil {
+			return err
+		}
+		req := &rcav1.ListCorrelationsRequest{
+			TenantId:  c.tenant,
+			Service:   *service,
+			StartTime: tr.Start,
+			EndTime:   tr.End,
+			PageSize:  int32(*limit),
+			PageToken: *pageToken,
+			Labels:    labels,
+		}
+		if *category != "" {
+			value, ok := rcav1.RootCauseCategory_value["ROOT_CAUSE_CATEGORY_"+strings.ToUpper(*category)]
+			if !ok {
+				return fmt.Errorf("-category: unknown category %q", *category)
+			}
+			req.Category = rcav1.RootCauseCategory(value)
+		}
+		resp, err := c.client.ListCorrelations(ctx, req)
+		if err != nil {
+			return err
+		}
+		return c.print(resp, func() { printCorrelationList(c.stdout, resp) })
+	}
+}
+
+func getCommand(flags *flag.FlagSet) func(context.Context, *cli, []string) error {
+	return func(ctx context.Context, c *cli, args []string) error {
+		if len(args) != 1 {
+			return errUsage
+		}
+		if err := c.requireTenant(); err != nil {
+			return err
+		}
+		result, err := c.client.GetCorrelation(ctx, &rcav1.GetCorrelationRequest{TenantId: c.tenant, CorrelationId: args[0]})
+		if err != nil {
+			return err
+		}
+		return c.print(result, func() { printCorrelation(c.stdout, result) })
+	}
+}
+
+func explainCommand(flags *flag.FlagSet) func(context.Context, *cli, []string) error {
+	return func(ctx context.Context, c *cli, args []string) error {
+		if len(args) != 1 {
+			return errUsage
+		}
+		if err := c.requireTenant(); err != nil {
+			return err
+		}
+		explanation, err := c.client.ExplainCorrelation(ctx, &rcav1.ExplainCorrelationRequest{TenantId: c.tenant, CorrelationId: args[0]})
+		if err != nil {
+			return err
+		}
+		return c.print(explanation, func() { printExplanation(c.stdout, explanation) })
+	}
+}
+
+func feedbackCommand(flags *flag.FlagSet) func(context.Context, *cli, []string) error {
+	correct := flags.Bool("correct", false, "The correlation identified the root cause")
+	incorrect := flags.Bool("incorrect", false, "The correlation was wrong")
+	notes := flags.String("notes", "", "Free-text notes for the feedback")
+
+	return func(ctx context.Context, c *cli, args []string) error {
+		if len(args) != 1 || *correct == *incorrect {
+			return errUsage
+		}
+		if err := c.requireTenant(); err != nil {
+			return err
+		}
+		ack, err := c.client.SubmitFeedback(ctx, &rcav1.FeedbackRequest{
+			TenantId:      c.tenant,
+			CorrelationId: args[0],
+			Correct:       *correct,
+			Notes:         *notes,
+		})
+		if err != nil {
+			return err
+		}
+		return c.print(ack, func() {
+			verdict := "not accepted"
+			if ack.GetAccepted() {
+				verdict = "accepted"
+			}
+			fmt.Fprintf(c.stdout, "feedback for %s %s\n", ack.GetCorrelationId(), verdict)
+		})
+	}
+}
+
+func patternsCommand(flags *flag.FlagSet) func(context.Context, *cli, []string) error {
+	service := flags.String("service", "", "Only patterns involving this service")
+
+	return func(ctx context.Context, c *cli, _ []string) error {
+		if err := c.requireTenant(); err != nil {
+			return err
+		}
+		resp, err := c.client.GetPatterns(ctx, &rcav1.GetPatternsRequest{TenantId: c.tenant, Service: *service})
+		if err != nil {
+			return err
+		}
+		return c.print(resp, func() { printPatterns(c.stdout, resp) })
+	}
+}
+
+func thresholdsCommand(flags *flag.FlagSet) func(context.Context, *cli, []string) error {
+	refresh := flags.Bool("refresh", false, "Retune from the stored history instead of showing the last run")
+
+	return func(ctx context.Context, c *cli, _ []string) error {
+		if err := c.requireTenant(); err != nil {
+			return err
+		}
+		resp, err := c.client.GetThresholdRecommendations(ctx, &rcav1.GetThresholdRecommendationsRequest{TenantId: c.tenant, Refresh: *refresh})
+		if err != nil {
+			return err
+		}
+		return c.print(resp, func() { printThresholds(c.stdout, resp) })
+	}
+}
+
+func graphCommand(flags *flag.FlagSet) func(context.Context, *cli, []string) error {
+	environment := flags.String("environment", "", "Deployment environment, such as prod or staging, to fetch the graph from")
+	since := flags.Duration("since", 0, "Look back this far from now; defaults to the correlation's window")
+
+	return func(ctx context.Context, c *cli, args []string) error {
+		if len(args) > 1 {
+			return errUsage
+		}
+		if err := c.requireTenant(); err != nil {
+			return err
+		}
+		req := &rcav1.GetServiceGraphRequest{TenantId: c.tenant, Environment: *environment}
+		if len(args) == 1 {
+			req.CorrelationId = args[0]
+		}
+		if *since > 0 {
+			now := time.Now().UTC()
+			req.TimeRange = &rcav1.TimeRange{Start: timestamppb.New(now.Add(-*since)), End: timestamppb.New(now)}
+		}
+		resp, err := c.client.GetServiceGraph(ctx, req)
+		if err != nil {
+			return err
+		}
+		return c.print(resp, func() { printServiceGraph(c.stdout, resp) })
+	}
+}
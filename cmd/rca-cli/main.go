@@ -0,0 +1,228 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"os/user"
+	"strings"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/miradorstack/mirador-rca/internal/api"
+	rcav1 "github.com/miradorstack/mirador-rca/internal/grpc/generated"
+)
+
+const usage = `rca-cli talks to the mirador-rca gRPC API.
+
+Usage:
+  rca-cli <command> [flags] [args]
+
+Commands:
+  investigate   run an investigation for one or more services
+  list          list past correlations
+  get           show one correlation: rca-cli get <correlation-id>
+  explain       show how a correlation was reached: rca-cli explain <correlation-id>
+  feedback      mark a correlation correct or incorrect: rca-cli feedback -correct <correlation-id>
+  patterns      show mined failure patterns
+  thresholds    show the anomaly thresholds tuned for each service
+  graph         show the service graph with a correlation's anomalies: rca-cli graph [<correlation-id>]
+  backfill      investigate past incidents listed in a CSV or JSON file: rca-cli backfill <file>
+  bench         load-test InvestigateIncident and report throughput and latency percentiles
+
+Every command accepts -addr, -tenant, -output (table or json), -timeout, and -actor; -addr and -tenant
+default to MIRADOR_RCA_ADDR and MIRADOR_RCA_TENANT. Run "rca-cli <command> -h" for its flags.
+`
+
+// command is one rca-cli subcommand. setup registers its flags and returns the function that runs it once
+// they are parsed. Batch commands issue many requests and apply -timeout to each one themselves instead of
+// to the whole command.
+type command struct {
+	name  string
+	setup func(*flag.FlagSet) func(ctx context.Context, c *cli, args []string) error
+	batch bool
+}
+
+var commands = []command{
+	{name: "investigate", setup: investigateCommand},
+	{name: "list", setup: listCommand},
+	{name: "get", setup: getCommand},
+	{name: "explain", setup: explainCommand},
+	{name: "feedback", setup: feedbackCommand},
+	{name: "patterns", setup: patternsCommand},
+	{name: "thresholds", setup: thresholdsCommand},
+	{name: "graph", setup: graphCommand},
+	{name: "backfill", setup: backfillCommand, batch: true},
+	{name: "bench", setup: benchCommand, batch: true},
+}
+
+// errUsage marks an invalid invocation; run prints the command's usage.
+var errUsage = errors.New("usage")
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "--help" || args[0] == "help" {
+		fmt.Fprint(stderr, usage)
+		if len(args) == 0 {
+			return 2
+		}
+		return 0
+	}
+
+	var cmd *command
+	for i := range commands {
+		if commands[i].name == args[0] {
+			cmd = &commands[i]
+		}
+	}
+	if cmd == nil {
+		fmt.Fprintf(stderr, "unknown command %q\n\n%s", args[0], usage)
+		return 2
+	}
+
+	flags := flag.NewFlagSet("rca-cli "+cmd.name, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	c := &cli{stdout: stdout, stderr: stderr}
+	flags.StringVar(&c.addr, "addr", envOr("MIRADOR_RCA_ADDR", "localhost:50051"), "mirador-rca gRPC address")
+	flags.StringVar(&c.tenant, "tenant", os.Getenv("MIRADOR_RCA_TENANT"), "Tenant ID")
+	flags.StringVar(&c.output, "output", "table", "Output format: table or json")
+	flags.DurationVar(&c.timeout, "timeout", 2*time.Minute, "Deadline for the request, or for each request of a batch command")
+	flags.StringVar(&c.actor, "actor", defaultActor(), "Caller recorded in the engine's audit log")
+	exec := cmd.setup(flags)
+	if err := flags.Parse(args[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if c.output != "table" && c.output != "json" {
+		fmt.Fprintf(stderr, "-output: want table or json, got %q\n", c.output)
+		return 2
+	}
+
+	client, conn, err := dial(c.addr)
+	if err != nil {
+		fmt.Fprintf(stderr, "connect to %s: %v\n", c.addr, err)
+		return 1
+	}
+	defer conn.Close()
+	c.client = client
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if !cmd.batch {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+	if c.actor != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, api.ActorMetadataKey, c.actor)
+	}
+
+	if err := exec(ctx, c, flags.Args()); err != nil {
+		if errors.Is(err, errUsage) {
+			flags.Usage()
+			return 2
+		}
+		fmt.Fprintln(stderr, describeError(err))
+		return 1
+	}
+	return 0
+}
+
+// dial connects to the engine at addr; tests replace it with a stub client.
+var dial = func(addr string) (rcav1.RCAEngineClient, io.Closer, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, err
+	}
+	return rcav1.NewRCAEngineClient(conn), conn, nil
+}
+
+// cli carries the connection and the flags every command shares.
+type cli struct {
+	addr    string
+	tenant  string
+	output  string
+	timeout time.Duration
+	actor   string
+	client  rcav1.RCAEngineClient
+	stdout  io.Writer
+	stderr  io.Writer
+}
+
+func (c *cli) requireTenant() error {
+	if c.tenant == "" {
+		return fmt.Errorf("-tenant (or MIRADOR_RCA_TENANT) is required")
+	}
+	return nil
+}
+
+// describeError renders gRPC errors as "Code: message" and anything else as is.
+func describeError(err error) string {
+	if st, ok := status.FromError(err); ok {
+		return fmt.Sprintf("%s: %s", st.Code(), st.Message())
+	}
+	return err.Error()
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+// defaultActor names the local user so audit records show who ran the command.
+func defaultActor() string {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return "cli:" + u.Username
+	}
+	return "cli"
+}
+
+// listFlag collects a repeatable flag; each value may hold several comma-separated items.
+type listFlag []string
+
+func (l *listFlag) String() string { return strings.Join(*l, ",") }
+
+func (l *listFlag) Set(value string) error {
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			*l = append(*l, item)
+		}
+	}
+	return nil
+}
+
+// labelFlag collects repeatable key=value labels.
+type labelFlag map[string]string
+
+func (l labelFlag) String() string {
+	pairs := make([]string, 0, len(l))
+	for key, value := range l {
+		pairs = append(pairs, key+"="+value)
+	}
+	return strings.Join(pairs, ",")
+}
+
+func (l labelFlag) Set(value string) error {
+	key, val, ok := strings.Cut(value, "=")
+	if !ok || strings.TrimSpace(key) == "" {
+		return fmt.Errorf("want key=value, got %q", value)
+	}
+	l[strings.TrimSpace(key)] = strings.TrimSpace(val)
+	return nil
+}
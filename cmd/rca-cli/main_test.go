@@ -0,0 +1,317 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	rcav1 "github.com/miradorstack/mirador-rca/internal/grpc/generated"
+)
+
+// stubClient answers the RPCs the commands issue with canned responses and records each request.
+type stubClient struct {
+	rcav1.RCAEngineClient
+	err          error
+	requests     []proto.Message
+	investigated []time.Time
+	correlation  *rcav1.CorrelationResult
+	list         *rcav1.ListCorrelationsResponse
+	thresholds   *rcav1.GetThresholdRecommendationsResponse
+	graph        *rcav1.GetServiceGraphResponse
+}
+
+func (s *stubClient) InvestigateIncident(_ context.Context, req *rcav1.RCAInvestigationRequest, _ ...grpc.CallOption) (*rcav1.CorrelationResult, error) {
+	s.requests = append(s.requests, req)
+	s.investigated = append(s.investigated, time.Now())
+	return s.correlation, s.err
+}
+
+func (s *stubClient) GetCorrelation(_ context.Context, req *rcav1.GetCorrelationRequest, _ ...grpc.CallOption) (*rcav1.CorrelationResult, error) {
+	s.requests = append(s.requests, req)
+	return s.correlation, s.err
+}
+
+func (s *stubClient) ListCorrelations(_ context.Context, req *rcav1.ListCorrelationsRequest, _ ...grpc.CallOption) (*rcav1.ListCorrelationsResponse, error) {
+	s.requests = append(s.requests, req)
+	return s.list, s.err
+}
+
+func (s *stubClient) SubmitFeedback(_ context.Context, req *rcav1.FeedbackRequest, _ ...grpc.CallOption) (*rcav1.FeedbackAck, error) {
+	s.requests = append(s.requests, req)
+	return &rcav1.FeedbackAck{CorrelationId: req.GetCorrelationId(), Accepted: true}, s.err
+}
+
+func (s *stubClient) GetThresholdRecommendations(_ context.Context, req *rcav1.GetThresholdRecommendationsRequest, _ ...grpc.CallOption) (*rcav1.GetThresholdRecommendationsResponse, error) {
+	s.requests = append(s.requests, req)
+	return s.thresholds, s.err
+}
+
+func (s *stubClient) GetServiceGraph(_ context.Context, req *rcav1.GetServiceGraphRequest, _ ...grpc.CallOption) (*rcav1.GetServiceGraphResponse, error) {
+	s.requests = append(s.requests, req)
+	return s.graph, s.err
+}
+
+type nopCloser struct{}
+
+func (nopCloser) Close() error { return nil }
+
+// runStub runs the CLI against client and returns its exit code and output.
+func runStub(t *testing.T, client *stubClient, args ...string) (int, string, string) {
+	t.Helper()
+	t.Setenv("MIRADOR_RCA_TENANT", "")
+	original := dial
+	dial = func(string) (rcav1.RCAEngineClient, io.Closer, error) { return client, nopCloser{}, nil }
+	t.Cleanup(func() { dial = original })
+
+	var stdout, stderr bytes.Buffer
+	code := run(args, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestRunValidatesArguments(t *testing.T) {
+	cases := []struct {
+		name       string
+		args       []string
+		err        error
+		wantCode   int
+		wantStderr string
+		wantCalls  int
+	}{
+		{name: "no command", args: nil, wantCode: 2, wantStderr: "Usage:"},
+		{name: "help", args: []string{"help"}, wantCode: 0, wantStderr: "Usage:"},
+		{name: "unknown command", args: []string{"bogus"}, wantCode: 2, wantStderr: `unknown command "bogus"`},
+		{name: "unknown flag", args: []string{"list", "-bogus"}, wantCode: 2, wantStderr: "flag provided but not defined: -bogus"},
+		{name: "bad output", args: []string{"list", "-tenant", "acme", "-output", "yaml"}, wantCode: 2, wantStderr: `-output: want table or json, got "yaml"`},
+		{name: "missing tenant", args: []string{"get", "c-1"}, wantCode: 1, wantStderr: "-tenant (or MIRADOR_RCA_TENANT) is required"},
+		{name: "get without id", args: []string{"get", "-tenant", "acme"}, wantCode: 2, wantStderr: "Usage of rca-cli get"},
+		{name: "explain with two ids", args: []string{"explain", "-tenant", "acme", "c-1", "c-2"}, wantCode: 2, wantStderr: "Usage of rca-cli explain"},
+		{name: "investigate without service", args: []string{"investigate", "-tenant", "acme"}, wantCode: 2, wantStderr: "Usage of rca-cli investigate"},
+		{name: "feedback without verdict", args: []string{"feedback", "-tenant", "acme", "c-1"}, wantCode: 2, wantStderr: "Usage of rca-cli feedback"},
+		{name: "feedback with both verdicts", args: []string{"feedback", "-tenant", "acme", "-correct", "-incorrect", "c-1"}, wantCode: 2, wantStderr: "Usage of rca-cli feedback"},
+		{name: "graph with two ids", args: []string{"graph", "-tenant", "acme", "c-1", "c-2"}, wantCode: 2, wantStderr: "Usage of rca-cli graph"},
+		{name: "unknown category", args: []string{"list", "-tenant", "acme", "-category", "bogus"}, wantCode: 1, wantStderr: `-category: unknown category "bogus"`},
+		{name: "malformed start", args: []string{"list", "-tenant", "acme", "-start", "yesterday"}, wantCode: 1, wantStderr: "-start: parsing time"},
+		{
+			name:       "inverted window",
+			args:       []string{"investigate", "-tenant", "acme", "-service", "checkout", "-start", "2024-05-01T12:00:00Z", "-end", "2024-05-01T11:00:00Z"},
+			wantCode:   1,
+			wantStderr: "the window start must be before its end",
+		},
+		{
+			name:       "rpc error",
+			args:       []string{"get", "-tenant", "acme", "c-1"},
+			err:        status.Error(codes.NotFound, "correlation not found"),
+			wantCode:   1,
+			wantStderr: "NotFound: correlation not found",
+			wantCalls:  1,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &stubClient{err: tc.err}
+			code, _, stderr := runStub(t, client, tc.args...)
+			if code != tc.wantCode {
+				t.Fatalf("expected exit code %d, got %d (stderr %q)", tc.wantCode, code, stderr)
+			}
+			if !strings.Contains(stderr, tc.wantStderr) {
+				t.Fatalf("expected stderr to contain %q, got %q", tc.wantStderr, stderr)
+			}
+			if len(client.requests) != tc.wantCalls {
+				t.Fatalf("expected %d requests, got %d", tc.wantCalls, len(client.requests))
+			}
+		})
+	}
+}
+
+func TestRunBuildsRequests(t *testing.T) {
+	client := &stubClient{list: &rcav1.ListCorrelationsResponse{}}
+	code, _, stderr := runStub(t, client, "list", "-tenant", "acme", "-category", "dependency_failure", "-label", "team=payments", "-limit", "5")
+	if code != 0 {
+		t.Fatalf("expected exit code 0, got %d (stderr %q)", code, stderr)
+	}
+	req := client.requests[0].(*rcav1.ListCorrelationsRequest)
+	if req.GetTenantId() != "acme" || req.GetPageSize() != 5 {
+		t.Fatalf("unexpected request %v", req)
+	}
+	if req.GetCategory() != rcav1.RootCauseCategory_ROOT_CAUSE_CATEGORY_DEPENDENCY_FAILURE {
+		t.Fatalf("expected dependency_failure category, got %s", req.GetCategory())
+	}
+	if req.GetLabels()["team"] != "payments" {
+		t.Fatalf("expected team label, got %v", req.GetLabels())
+	}
+
+	client = &stubClient{correlation: &rcav1.CorrelationResult{}}
+	code, _, stderr = runStub(t, client, "investigate", "-tenant", "acme", "-service", "checkout,payments", "-service", "cart", "-title", "Checkout errors")
+	if code != 0 {
+		t.Fatalf("expected exit code 0, got %d (stderr %q)", code, stderr)
+	}
+	investigation := client.requests[0].(*rcav1.RCAInvestigationRequest)
+	if got := strings.Join(investigation.GetAffectedServices(), ","); got != "checkout,payments,cart" {
+		t.Fatalf("expected services checkout,payments,cart, got %s", got)
+	}
+	if investigation.GetIncident().GetTitle() != "Checkout errors" || !strings.HasPrefix(investigation.GetIncidentId(), "cli-") {
+		t.Fatalf("unexpected incident %q %v", investigation.GetIncidentId(), investigation.GetIncident())
+	}
+}
+
+func TestRunFormatsOutput(t *testing.T) {
+	exemplar := &rcav1.TraceExemplar{TraceId: "trace-1", SpanId: "span-1", Operation: "POST /pay", DurationMs: 1500}
+	correlation := &rcav1.CorrelationResult{
+		CorrelationId:    "c-1",
+		IncidentId:       "inc-1",
+		RootCause:        "payments database connection pool exhausted",
+		Confidence:       0.82,
+		Category:         rcav1.RootCauseCategory_ROOT_CAUSE_CATEGORY_CAPACITY,
+		AffectedServices: []string{"checkout", "payments"},
+		RedAnchors: []*rcav1.RedAnchor{
+			{Service: "payments", DataType: rcav1.DataType_DATA_TYPE_METRICS, AnomalyScore: 0.9, Selector: "error_rate", Evidence: &rcav1.Evidence{Exemplars: []*rcav1.TraceExemplar{exemplar}}},
+			{Service: "payments", DataType: rcav1.DataType_DATA_TYPE_METRICS, AnomalyScore: 0.7, Selector: "latency_p95", Evidence: &rcav1.Evidence{Exemplars: []*rcav1.TraceExemplar{exemplar}}},
+		},
+		Recommendations: []string{"Raise the pool size"},
+	}
+	client := &stubClient{
+		correlation: correlation,
+		list: &rcav1.ListCorrelationsResponse{
+			Correlations:  []*rcav1.CorrelationResult{{CorrelationId: "c-1", Confidence: 0.82, RootCause: strings.Repeat("pool exhausted ", 10)}},
+			NextPageToken: "next",
+		},
+		thresholds: &rcav1.GetThresholdRecommendationsResponse{
+			Recommendations: []*rcav1.ThresholdRecommendation{{Service: "checkout", Current: 0.6, Threshold: 0.7, Basis: "feedback"}},
+		},
+		graph: &rcav1.GetServiceGraphResponse{
+			CorrelationId: "c-1",
+			Nodes:         []*rcav1.ServiceGraphNode{{Service: "payments", AnchorCount: 2, RootCause: true, Affected: true}},
+		},
+	}
+
+	cases := []struct {
+		name    string
+		args    []string
+		want    []string
+		notWant []string
+	}{
+		{
+			name: "get table",
+			args: []string{"get", "-tenant", "acme", "c-1"},
+			want: []string{
+				"Correlation:  c-1",
+				"Category:     capacity",
+				"Confidence:   0.82",
+				"Services:     checkout, payments",
+				"Root cause:   payments database connection pool exhausted",
+				"SERVICE   SIGNAL   SCORE",
+				"payments  metrics  0.90",
+				"trace-1  POST /pay  1.5s",
+				"  - Raise the pool size",
+			},
+			notWant: []string{"Impact:", "Timeline:"},
+		},
+		{
+			name: "list table",
+			args: []string{"list", "-tenant", "acme"},
+			want: []string{"ID   CREATED  CATEGORY  CONFIDENCE  STATUS  ROOT CAUSE", "c-1  -        -         0.82", strings.Repeat("pool exhausted ", 3) + "pool exhausted…", "more results: -page-token next"},
+		},
+		{
+			name: "thresholds table",
+			args: []string{"thresholds", "-tenant", "acme"},
+			want: []string{"checkout  0.60     0.70         feedback", "Not applied; enable the threshold_tuning feature flag"},
+		},
+		{
+			name: "feedback",
+			args: []string{"feedback", "-tenant", "acme", "-incorrect", "c-1"},
+			want: []string{"feedback for c-1 accepted"},
+		},
+		{
+			name: "graph table",
+			args: []string{"graph", "-tenant", "acme", "c-1"},
+			want: []string{"Correlation:  c-1", "payments  2        0.00       -       root cause, affected", "CALLER  CALLEE"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			code, stdout, stderr := runStub(t, client, tc.args...)
+			if code != 0 {
+				t.Fatalf("expected exit code 0, got %d (stderr %q)", code, stderr)
+			}
+			for _, want := range tc.want {
+				if !strings.Contains(stdout, want) {
+					t.Fatalf("expected output to contain %q, got\n%s", want, stdout)
+				}
+			}
+			for _, notWant := range tc.notWant {
+				if strings.Contains(stdout, notWant) {
+					t.Fatalf("expected output not to contain %q, got\n%s", notWant, stdout)
+				}
+			}
+		})
+	}
+}
+
+func TestRunPrintsJSON(t *testing.T) {
+	correlation := &rcav1.CorrelationResult{
+		CorrelationId: "c-1",
+		RootCause:     "payments database connection pool exhausted",
+		Category:      rcav1.RootCauseCategory_ROOT_CAUSE_CATEGORY_CAPACITY,
+	}
+	code, stdout, stderr := runStub(t, &stubClient{correlation: correlation}, "get", "-tenant", "acme", "-output", "json", "c-1")
+	if code != 0 {
+		t.Fatalf("expected exit code 0, got %d (stderr %q)", code, stderr)
+	}
+	var decoded rcav1.CorrelationResult
+	if err := protojson.Unmarshal([]byte(stdout), &decoded); err != nil {
+		t.Fatalf("expected JSON output, got %v:\n%s", err, stdout)
+	}
+	if !proto.Equal(&decoded, correlation) {
+		t.Fatalf("expected %v, got %v", correlation, &decoded)
+	}
+}
+
+func TestPrintCorrelationListsSharedExemplarsOnce(t *testing.T) {
+	exemplar := &rcav1.TraceExemplar{TraceId: "trace-1", SpanId: "span-1"}
+	other := &rcav1.TraceExemplar{TraceId: "trace-1", SpanId: "span-2"}
+	var out bytes.Buffer
+	printCorrelation(&out, &rcav1.CorrelationResult{RedAnchors: []*rcav1.RedAnchor{
+		{Service: "payments", Evidence: &rcav1.Evidence{Exemplars: []*rcav1.TraceExemplar{exemplar}}},
+		{Service: "payments", Evidence: &rcav1.Evidence{Exemplars: []*rcav1.TraceExemplar{exemplar, other}}},
+	}})
+	_, exemplars, _ := strings.Cut(out.String(), "Exemplar traces:")
+	if got := strings.Count(exemplars, "trace-1"); got != 2 {
+		t.Fatalf("expected two distinct exemplar spans, got %d in\n%s", got, exemplars)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	cases := []struct {
+		in    string
+		width int
+		want  string
+	}{
+		{in: "short", width: 10, want: "short"},
+		{in: "spread\n  over   lines", width: 30, want: "spread over lines"},
+		{in: "abcdefghij", width: 5, want: "abcd…"},
+		{in: "ünïcödé text", width: 4, want: "ünï…"},
+	}
+	for _, tc := range cases {
+		if got := truncate(tc.in, tc.width); got != tc.want {
+			t.Fatalf("truncate(%q, %d) = %q, want %q", tc.in, tc.width, got, tc.want)
+		}
+	}
+}
+
+func TestEnumName(t *testing.T) {
+	if got := enumName("ROOT_CAUSE_CATEGORY_DEPENDENCY_FAILURE", "ROOT_CAUSE_CATEGORY_"); got != "dependency_failure" {
+		t.Fatalf("expected dependency_failure, got %s", got)
+	}
+	if got := enumName("ROOT_CAUSE_CATEGORY_UNSPECIFIED", "ROOT_CAUSE_CATEGORY_"); got != "-" {
+		t.Fatalf("expected - for unspecified, got %s", got)
+	}
+}
@@ -0,0 +1,395 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	rcav1 "github.com/miradorstack/mirador-rca/internal/grpc/generated"
+)
+
+// rootCauseWidth caps the root cause column of correlation tables; get shows it in full.
+const rootCauseWidth = 60
+
+// print writes msg as JSON when -output json is set and otherwise calls table.
+func (c *cli) print(msg proto.Message, table func()) error {
+	if c.output != "json" {
+		table()
+		return nil
+	}
+	data, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(c.stdout, string(data))
+	return err
+}
+
+func printCorrelationList(out io.Writer, resp *rcav1.ListCorrelationsResponse) {
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tCREATED\tCATEGORY\tCONFIDENCE\tSTATUS\tROOT CAUSE")
+	for _, corr := range resp.GetCorrelations() {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%.2f\t%s\t%s\n",
+			corr.GetCorrelationId(),
+			formatTime(corr.GetCreatedAt()),
+			enumName(corr.GetCategory().String(), "ROOT_CAUSE_CATEGORY_"),
+			corr.GetConfidence(),
+			enumName(corr.GetStatus().String(), "CORRELATION_STATUS_"),
+			truncate(corr.GetRootCause(), rootCauseWidth),
+		)
+	}
+	tw.Flush()
+	if token := resp.GetNextPageToken(); token != "" {
+		fmt.Fprintf(out, "\nmore results: -page-token %s\n", token)
+	}
+}
+
+func printCorrelation(out io.Writer, corr *rcav1.CorrelationResult) {
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	field := func(name, value string) {
+		if value != "" {
+			fmt.Fprintf(tw, "%s:\t%s\n", name, value)
+		}
+	}
+	field("Correlation", corr.GetCorrelationId())
+	field("Incident", corr.GetIncidentId())
+	field("Title", corr.GetIncident().GetTitle())
+	field("Created", formatTime(corr.GetCreatedAt()))
+	field("Category", enumName(corr.GetCategory().String(), "ROOT_CAUSE_CATEGORY_"))
+	field("Status", enumName(corr.GetStatus().String(), "CORRELATION_STATUS_"))
+	field("Confidence", fmt.Sprintf("%.2f", corr.GetConfidence()))
+	if len(corr.GetSloImpacts()) > 0 {
+		field("Impact", fmt.Sprintf("%.2f", corr.GetImpactScore()))
+	}
+	field("Services", strings.Join(corr.GetAffectedServices(), ", "))
+	field("Environment", corr.GetEnvironment())
+	field("Root cause", corr.GetRootCause())
+	field("Duplicate of", corr.GetDuplicateOf())
+	if expansion := corr.GetWindowExpansion(); expansion != nil {
+		field("Window", fmt.Sprintf("%s to %s (widened; %d of %d samples)",
+			formatTime(expansion.GetAnalysed().GetStart()), formatTime(expansion.GetAnalysed().GetEnd()),
+			expansion.GetSamples(), expansion.GetMinSamples()))
+	}
+	tw.Flush()
+
+	if summary := corr.GetSummary(); summary != "" {
+		fmt.Fprintf(out, "\n%s\n", summary)
+	}
+
+	if anchors := corr.GetRedAnchors(); len(anchors) > 0 {
+		fmt.Fprintln(out, "\nAnchors:")
+		tw = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "  SERVICE\tSIGNAL\tSCORE\tTIME\tSELECTOR")
+		for _, anchor := range anchors {
+			fmt.Fprintf(tw, "  %s\t%s\t%.2f\t%s\t%s\n",
+				anchor.GetService(),
+				enumName(anchor.GetDataType().String(), "DATA_TYPE_"),
+				anchor.GetAnomalyScore(),
+				formatTime(anchor.GetTimestamp()),
+				anchor.GetSelector(),
+			)
+		}
+		tw.Flush()
+	}
+
+	// Anchors of one signal often share exemplars; each trace span is listed once.
+	var exemplars []*rcav1.TraceExemplar
+	listed := map[string]bool{}
+	for _, anchor := range corr.GetRedAnchors() {
+		for _, exemplar := range anchor.GetEvidence().GetExemplars() {
+			key := exemplar.GetTraceId() + "/" + exemplar.GetSpanId()
+			if !listed[key] {
+				listed[key] = true
+				exemplars = append(exemplars, exemplar)
+			}
+		}
+	}
+	if len(exemplars) > 0 {
+		fmt.Fprintln(out, "\nExemplar traces:")
+		tw = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		for _, exemplar := range exemplars {
+			fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\t%s\n",
+				exemplar.GetTraceId(),
+				exemplar.GetOperation(),
+				time.Duration(exemplar.GetDurationMs()*float64(time.Millisecond)).Round(time.Millisecond),
+				exemplar.GetStatus(),
+				exemplar.GetLink(),
+			)
+		}
+		tw.Flush()
+	}
+
+	if capacity := corr.GetCapacity(); len(capacity.GetResources()) > 0 {
+		verdict := "not saturation-driven"
+		if capacity.GetSaturationDriven() {
+			verdict = "saturation-driven"
+		}
+		fmt.Fprintf(out, "\nCapacity of %s (%s):\n", capacity.GetService(), verdict)
+		tw = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "  RESOURCE\tPEAK\tLATEST\tHEADROOM\tSATURATED\tEXHAUSTED IN")
+		for _, usage := range capacity.GetResources() {
+			exhaustion := "-"
+			if seconds := usage.GetTimeToExhaustionSeconds(); seconds > 0 {
+				exhaustion = time.Duration(seconds * float64(time.Second)).Round(time.Second).String()
+			}
+			fmt.Fprintf(tw, "  %s\t%.0f%%\t%.0f%%\t%.0f%%\t%s\t%s\n",
+				usage.GetResource(),
+				usage.GetPeak()*100,
+				usage.GetLatest()*100,
+				usage.GetHeadroom()*100,
+				formatTime(usage.GetSaturatedAt()),
+				exhaustion,
+			)
+		}
+		tw.Flush()
+	}
+
+	if impacts := corr.GetSloImpacts(); len(impacts) > 0 {
+		fmt.Fprintln(out, "\nSLO impact:")
+		tw = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "  SERVICE\tSLO\tOBJECTIVE\tBAD\tBURN RATE\tBUDGET SPENT")
+		for _, impact := range impacts {
+			fmt.Fprintf(tw, "  %s\t%s\t%.2f%%\t%.2f%%\t%.1fx\t%.2f%%\n",
+				impact.GetService(),
+				impact.GetSlo(),
+				impact.GetObjective()*100,
+				impact.GetBadFraction()*100,
+				impact.GetBurnRate(),
+				impact.GetBudgetSpent()*100,
+			)
+		}
+		tw.Flush()
+	}
+
+	if recs := corr.GetRecommendations(); len(recs) > 0 {
+		fmt.Fprintln(out, "\nRecommendations:")
+		generated := map[string]bool{}
+		for _, detail := range corr.GetRecommendationDetails() {
+			generated[detail.GetText()] = detail.GetGenerated()
+		}
+		for _, rec := range recs {
+			if generated[rec] {
+				rec += " (generated)"
+			}
+			fmt.Fprintf(out, "  - %s\n", rec)
+		}
+	}
+
+	if timeline := corr.GetTimeline(); len(timeline) > 0 {
+		fmt.Fprintln(out, "\nTimeline:")
+		tw = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		for _, event := range timeline {
+			fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\n",
+				formatTime(event.GetTime()),
+				enumName(event.GetSeverity().String(), "SEVERITY_"),
+				event.GetService(),
+				event.GetEvent()+eventSpan(event),
+			)
+		}
+		tw.Flush()
+	}
+}
+
+func printExplanation(out io.Writer, explanation *rcav1.CorrelationExplanation) {
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	field := func(name, value string) {
+		if value != "" {
+			fmt.Fprintf(tw, "%s:\t%s\n", name, value)
+		}
+	}
+	field("Correlation", explanation.GetCorrelationId())
+	field("Category", enumName(explanation.GetCategory().String(), "ROOT_CAUSE_CATEGORY_"))
+	field("Confidence", fmt.Sprintf("%.2f", explanation.GetConfidence()))
+	field("Root cause", explanation.GetRootCause())
+	if !explanation.GetRecorded() {
+		tw.Flush()
+		fmt.Fprintln(out, "\nNo explanation was recorded for this correlation; showing its anchors and rules only.")
+	} else {
+		field("Service", explanation.GetService())
+		threshold := "detector defaults"
+		if explanation.GetThreshold() > 0 {
+			threshold = fmt.Sprintf("%.2f", explanation.GetThreshold())
+		}
+		field("Threshold", threshold)
+		field("Preset", explanation.GetPreset())
+		field("Detection confidence", fmt.Sprintf("%.2f", explanation.GetDetectionConfidence()))
+		field("Causality score", fmt.Sprintf("%.2f", explanation.GetCausalityScore()))
+		field("Suggested service", explanation.GetSuggestedService())
+		if missing := explanation.GetMissingSources(); missing > 0 {
+			field("Missing sources", fmt.Sprint(missing))
+		}
+		if covered := explanation.GetMaintenanceAnomalies(); covered > 0 {
+			field("In maintenance", fmt.Sprintf("%d anomalies", covered))
+		}
+		field("Recommendations", explanation.GetRecommendationSource())
+		field("Similar", strings.Join(explanation.GetSimilarCorrelations(), ", "))
+		tw.Flush()
+	}
+
+	if detectors := explanation.GetDetectors(); len(detectors) > 0 {
+		fmt.Fprintln(out, "\nDetectors:")
+		tw = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "  NAME\tANOMALIES\tTHRESHOLD\tTOP SCORE")
+		for _, run := range detectors {
+			fmt.Fprintf(tw, "  %s\t%d\t%.2f\t%.2f\n", run.GetName(), run.GetAnomalies(), run.GetThreshold(), run.GetTopScore())
+		}
+		tw.Flush()
+	}
+
+	if notes := explanation.GetCausalityNotes(); len(notes) > 0 {
+		fmt.Fprintln(out, "\nCausality:")
+		for _, note := range notes {
+			fmt.Fprintf(out, "  - %s\n", note)
+		}
+	}
+
+	if rules := explanation.GetMatchedRules(); len(rules) > 0 {
+		fmt.Fprintln(out, "\nMatched rules:")
+		for _, rule := range rules {
+			fmt.Fprintf(out, "  %s\n", rule.GetRuleId())
+			for _, reason := range rule.GetReasons() {
+				fmt.Fprintf(out, "    - %s\n", reason)
+			}
+		}
+	}
+
+	if anchors := explanation.GetAnchors(); len(anchors) > 0 {
+		fmt.Fprintln(out, "\nAnchors:")
+		tw = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "  SERVICE\tSIGNAL\tSCORE\tTHRESHOLD\tSELECTOR")
+		for _, anchor := range anchors {
+			fmt.Fprintf(tw, "  %s\t%s\t%.2f\t%.2f\t%s\n",
+				anchor.GetService(),
+				enumName(anchor.GetDataType().String(), "DATA_TYPE_"),
+				anchor.GetAnomalyScore(),
+				anchor.GetThreshold(),
+				anchor.GetSelector(),
+			)
+		}
+		tw.Flush()
+	}
+}
+
+func printPatterns(out io.Writer, resp *rcav1.GetPatternsResponse) {
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tNAME\tSERVICES\tPREVALENCE\tPRECISION\tLAST SEEN")
+	for _, pattern := range resp.GetPatterns() {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%.2f\t%.2f\t%s\n",
+			pattern.GetId(),
+			pattern.GetName(),
+			strings.Join(pattern.GetServices(), ","),
+			pattern.GetPrevalence(),
+			pattern.GetQuality().GetPrecision(),
+			formatTime(pattern.GetLastSeen()),
+		)
+	}
+	tw.Flush()
+}
+
+func printThresholds(out io.Writer, resp *rcav1.GetThresholdRecommendationsResponse) {
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "SERVICE\tCURRENT\tRECOMMENDED\tBASIS\tCORRELATIONS\tFEEDBACK\tANCHORS")
+	for _, rec := range resp.GetRecommendations() {
+		fmt.Fprintf(tw, "%s\t%.2f\t%.2f\t%s\t%d\t%d\t%.2f -> %.2f\n",
+			rec.GetService(),
+			rec.GetCurrent(),
+			rec.GetThreshold(),
+			rec.GetBasis(),
+			rec.GetCorrelations(),
+			rec.GetFeedback(),
+			rec.GetAnchorsPerCorrelation(),
+			rec.GetExpectedAnchors(),
+		)
+	}
+	tw.Flush()
+	if resp.GetApplied() {
+		fmt.Fprintln(out, "\nApplied to investigations that set no anomaly threshold.")
+	} else {
+		fmt.Fprintln(out, "\nNot applied; enable the threshold_tuning feature flag to use them.")
+	}
+}
+
+func printServiceGraph(out io.Writer, resp *rcav1.GetServiceGraphResponse) {
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Window:\t%s to %s\n", formatTime(resp.GetTimeRange().GetStart()), formatTime(resp.GetTimeRange().GetEnd()))
+	if resp.GetCorrelationId() != "" {
+		fmt.Fprintf(tw, "Correlation:\t%s\n", resp.GetCorrelationId())
+	}
+	tw.Flush()
+
+	fmt.Fprintln(out)
+	tw = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "SERVICE\tANCHORS\tTOP SCORE\tIMPACT\tROLE")
+	for _, node := range resp.GetNodes() {
+		var roles []string
+		if node.GetRootCause() {
+			roles = append(roles, "root cause")
+		}
+		if node.GetAffected() {
+			roles = append(roles, "affected")
+		}
+		if len(roles) == 0 {
+			roles = append(roles, "-")
+		}
+		impact := "-"
+		if node.GetImpactScore() > 0 {
+			impact = fmt.Sprintf("%.2f (depth %d)", node.GetImpactScore(), node.GetImpactDepth())
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%.2f\t%s\t%s\n", node.GetService(), node.GetAnchorCount(), node.GetAnomalyScore(), impact, strings.Join(roles, ", "))
+	}
+	tw.Flush()
+
+	fmt.Fprintln(out)
+	tw = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CALLER\tCALLEE\tCALLS/S\tERRORS\tANOMALOUS")
+	for _, edge := range resp.GetEdges() {
+		anomalous := "-"
+		if edge.GetAnomalous() {
+			anomalous = "yes"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%.2f\t%.2f%%\t%s\n", edge.GetSource(), edge.GetTarget(), edge.GetCallRate(), edge.GetErrorRate(), anomalous)
+	}
+	tw.Flush()
+}
+
+// eventSpan describes how long a sustained timeline event lasted, such as " (for 12m0s, ongoing)".
+func eventSpan(event *rcav1.TimelineEvent) string {
+	if event.GetStart() == nil || event.GetEnd() == nil {
+		return ""
+	}
+	span := "for " + event.GetEnd().AsTime().Sub(event.GetStart().AsTime()).String()
+	if event.GetOngoing() {
+		span += ", ongoing"
+	}
+	return " (" + span + ")"
+}
+
+func formatTime(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return "-"
+	}
+	return ts.AsTime().UTC().Format(time.RFC3339)
+}
+
+// enumName turns ROOT_CAUSE_CATEGORY_DEPLOYMENT into deployment, the spelling -category accepts.
+func enumName(name, prefix string) string {
+	name = strings.ToLower(strings.TrimPrefix(name, prefix))
+	if name == "unspecified" {
+		return "-"
+	}
+	return name
+}
+
+func truncate(s string, width int) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if runes := []rune(s); len(runes) > width {
+		return string(runes[:width-1]) + "…"
+	}
+	return s
+}
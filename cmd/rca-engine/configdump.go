@@ -0,0 +1,65 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/miradorstack/mirador-rca/internal/config"
+)
+
+// configHandler serves the configuration the reloader last applied, with secrets redacted, as YAML. A
+// comment header names the files it was merged from, the environment overrides that were set, and the
+// sections whose changes wait for a restart.
+func configHandler(r *reloader) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet && req.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		current, pending := r.Snapshot()
+		body, err := yaml.Marshal(config.Redacted(current))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("encode config: %v", err), http.StatusInternalServerError)
+			return
+		}
+		files, _ := config.Files(r.paths...)
+
+		var buf bytes.Buffer
+		fmt.Fprintf(&buf, "# Effective configuration; secrets are shown as %s.\n", config.RedactedValue)
+		fmt.Fprintf(&buf, "# files: %s\n", listOrNone(files))
+		fmt.Fprintf(&buf, "# environment overrides: %s\n", listOrNone(envOverrides()))
+		fmt.Fprintf(&buf, "# pending restart: %s\n", listOrNone(pending))
+		buf.Write(body)
+
+		w.Header().Set("Content-Type", "application/yaml")
+		w.Header().Set("Cache-Control", "no-store")
+		_, _ = w.Write(buf.Bytes())
+	})
+}
+
+// envOverrides lists the names, never the values, of the MIRADOR_ environment variables that are set.
+func envOverrides() []string {
+	var names []string
+	for _, entry := range os.Environ() {
+		name, value, _ := strings.Cut(entry, "=")
+		if strings.HasPrefix(name, "MIRADOR_") && value != "" {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+func listOrNone(items []string) string {
+	if len(items) == 0 {
+		return "none"
+	}
+	return strings.Join(items, ", ")
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"encoding/json"
+	"expvar"
+	"net/http"
+	"net/http/pprof"
+	runtimepprof "runtime/pprof"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/api"
+	"github.com/miradorstack/mirador-rca/internal/utils"
+)
+
+// debugWriteTimeout replaces the metrics server's write timeout when debug endpoints are mounted, since
+// /debug/pprof/profile and /debug/pprof/trace stream for their whole sampling period (30s by default).
+const debugWriteTimeout = 2 * time.Minute
+
+// mountDebugHandlers adds the runtime profiling endpoints to mux: the net/http/pprof handlers under
+// /debug/pprof/, expvar counters at /debug/vars, and a full goroutine stack dump at /debug/goroutines.
+func mountDebugHandlers(mux *http.ServeMux) {
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+	mux.HandleFunc("/debug/goroutines", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Header().Set("Cache-Control", "no-store")
+		_ = runtimepprof.Lookup("goroutine").WriteTo(w, 2)
+	})
+}
+
+// latencyHandler reports p50, p95, and p99 latency per gRPC method over the server's sliding window. It is
+// cheap and reveals nothing sensitive, so it is mounted whether or not debug endpoints are enabled.
+func latencyHandler(server *api.Server) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		_ = json.NewEncoder(w).Encode(struct {
+			Window  string                          `json:"window"`
+			Methods map[string]utils.LatencySummary `json:"methods"`
+		}{Window: utils.DefaultLatencyWindow.String(), Methods: server.Latencies()})
+	})
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+
+	"github.com/miradorstack/mirador-rca/internal/config"
+	"github.com/miradorstack/mirador-rca/internal/mockcore"
+)
+
+// startMockCore serves the mock mirador-core API on a free loopback port for --dev and returns its base URL.
+func startMockCore() (string, func(), error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", nil, fmt.Errorf("listen for mock core: %w", err)
+	}
+	server := &http.Server{Handler: mockcore.Handler()}
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("mock core stopped", slog.Any("error", err))
+		}
+	}()
+	return "http://" + listener.Addr().String(), func() { _ = server.Close() }, nil
+}
+
+// applyDevMode points the configuration at the embedded mock core and replaces every external dependency
+// with its in-process equivalent: an in-memory history store and cache, and no Kafka, remote config, or
+// archive. Settings that need nothing external, such as rules, notifications, and the listen addresses, keep
+// their configured values.
+func applyDevMode(cfg *config.Config, coreURL string) {
+	cfg.Clients.Core.BaseURL = coreURL
+	cfg.Clients.Core.MetricsPath = mockcore.MetricsPath
+	cfg.Clients.Core.LogsPath = mockcore.LogsPath
+	cfg.Clients.Core.TracesPath = mockcore.TracesPath
+	cfg.Clients.Core.ServiceGraphPath = mockcore.ServiceGraphPath
+	cfg.Clients.Core.HealthPath = mockcore.HealthPath
+	cfg.Clients.Core.SLOPath = mockcore.SLOPath
+	cfg.Clients.MetricsSource = "core"
+	cfg.Clients.LogsSource = "core"
+	cfg.Clients.Traces.Backend = "core"
+	cfg.Clients.ServiceGraphSource = "core"
+
+	cfg.History.Backend = "memory"
+	cfg.History.Memory.Path = ""
+	cfg.Weaviate.Endpoint = ""
+	cfg.Cache.Addr = ""
+
+	cfg.Kafka.Enabled = false
+	cfg.Remote.Enabled = false
+	cfg.Archive.Enabled = false
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/miradorstack/mirador-rca/internal/utils"
+)
+
+// logLevelHandler reports and changes log levels at runtime. GET returns the global level and per-module
+// overrides; PUT or POST with ?level=debug changes the global level, and with &module=kafka overrides one
+// module instead. level=default with a module drops its override. The change lasts until the process
+// restarts or a reload changes logging.level. Unless writable is set, changes are refused with 403 and the
+// levels can only be read.
+func logLevelHandler(logger *slog.Logger, levels *utils.Levels, writable bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet, http.MethodHead:
+		case http.MethodPut, http.MethodPost:
+			if !writable {
+				http.Error(w, "log level changes require server.debugEndpoints", http.StatusForbidden)
+				return
+			}
+			query := req.URL.Query()
+			module := strings.TrimSpace(query.Get("module"))
+			value := strings.TrimSpace(query.Get("level"))
+			if value == "" {
+				http.Error(w, "level is required", http.StatusBadRequest)
+				return
+			}
+			if module != "" && strings.EqualFold(value, "default") {
+				levels.ClearModule(module)
+				logger.Info("module log level override cleared", slog.String("module", module))
+				break
+			}
+			level, err := utils.ParseLevel(value)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if module != "" {
+				levels.SetModule(module, level)
+				logger.Info("module log level changed", slog.String("module", module), slog.String("level", level.String()))
+			} else {
+				levels.Set(level)
+				logger.Info("log level changed", slog.String("level", level.String()))
+			}
+		default:
+			w.Header().Set("Allow", "GET, HEAD, PUT, POST")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		state := struct {
+			Level   string            `json:"level"`
+			Modules map[string]string `json:"modules"`
+		}{Level: levelName(levels.Level()), Modules: map[string]string{}}
+		for module, level := range levels.Modules() {
+			state.Modules[module] = levelName(level)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		_ = json.NewEncoder(w).Encode(state)
+	})
+}
+
+// levelName renders level the way logging.level spells it.
+func levelName(level slog.Level) string {
+	return strings.ToLower(level.String())
+}
@@ -4,69 +4,241 @@ import (
 	"context"
 	"errors"
 	"flag"
+	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
+	// Rule schedules name IANA timezones; embed the database for images without one.
+	_ "time/tzdata"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"github.com/miradorstack/mirador-rca/internal/api"
+	"github.com/miradorstack/mirador-rca/internal/archive"
+	"github.com/miradorstack/mirador-rca/internal/audit"
 	"github.com/miradorstack/mirador-rca/internal/cache"
 	"github.com/miradorstack/mirador-rca/internal/config"
 	"github.com/miradorstack/mirador-rca/internal/engine"
 	"github.com/miradorstack/mirador-rca/internal/extractors"
+	"github.com/miradorstack/mirador-rca/internal/features"
+	"github.com/miradorstack/mirador-rca/internal/health"
+	"github.com/miradorstack/mirador-rca/internal/integrations"
+	"github.com/miradorstack/mirador-rca/internal/kafka"
+	"github.com/miradorstack/mirador-rca/internal/llm"
 	"github.com/miradorstack/mirador-rca/internal/metrics"
+	"github.com/miradorstack/mirador-rca/internal/models"
+	"github.com/miradorstack/mirador-rca/internal/notify"
+	"github.com/miradorstack/mirador-rca/internal/otlp"
+	"github.com/miradorstack/mirador-rca/internal/patterns"
+	"github.com/miradorstack/mirador-rca/internal/remoteconfig"
 	"github.com/miradorstack/mirador-rca/internal/repo"
+	"github.com/miradorstack/mirador-rca/internal/retention"
 	"github.com/miradorstack/mirador-rca/internal/services"
+	"github.com/miradorstack/mirador-rca/internal/slo"
+	"github.com/miradorstack/mirador-rca/internal/ticketing"
+	"github.com/miradorstack/mirador-rca/internal/topology"
+	"github.com/miradorstack/mirador-rca/internal/tracing"
+	"github.com/miradorstack/mirador-rca/internal/tuning"
 	"github.com/miradorstack/mirador-rca/internal/utils"
+	"github.com/miradorstack/mirador-rca/internal/version"
+	"github.com/miradorstack/mirador-rca/internal/watch"
 )
 
 func main() {
-	var configPath string
-	flag.StringVar(&configPath, "config", "", "Path to configuration file")
+	if len(os.Args) > 1 && os.Args[1] == "validate" {
+		os.Exit(validateConfig(os.Args[2:]))
+	}
+
+	var configPaths pathList
+	var strict, dev bool
+	flag.Var(&configPaths, "config", "Path to a configuration file; repeat or separate with commas to merge overlays in order")
+	flag.BoolVar(&strict, "strict", false, "Reject unknown config keys and invalid values at startup and on reload")
+	flag.BoolVar(&dev, "dev", false, "Run self-contained against an embedded mock mirador-core with in-memory history and cache")
 	flag.Parse()
 
-	cfg, err := config.Load(configPath)
+	var devCoreURL string
+	if dev {
+		url, stop, err := startMockCore()
+		if err != nil {
+			slog.Error("failed to start the mock core", slog.Any("error", err))
+			os.Exit(1)
+		}
+		defer stop()
+		devCoreURL = url
+	}
+
+	// remote overlays the settings kept in etcd or Consul once it is configured below.
+	var remote *remoteconfig.Watcher
+	loadConfig := func(paths ...string) (*config.Config, error) {
+		var overlays []config.Overlay
+		if remote != nil {
+			if data := remote.Overlay(); data != nil {
+				overlays = append(overlays, config.Overlay{Name: "remote config", Data: data})
+			}
+		}
+		cfg, err := config.LoadOverlaid(strict, overlays, paths...)
+		if err == nil && dev {
+			applyDevMode(cfg, devCoreURL)
+		}
+		return cfg, err
+	}
+	cfg, err := loadConfig(configPaths...)
 	if err != nil {
-		slog.Error("failed to load config", slog.String("path", configPath), slog.Any("error", err))
+		slog.Error("failed to load config", slog.String("path", configPaths.String()), slog.Any("error", err))
 		os.Exit(1)
 	}
 
-	logger := utils.NewLogger(cfg.Logging.Level, cfg.Logging.JSON)
-	logger.Info("starting mirador-rca", slog.String("address", cfg.Server.Address))
+	logger, logLevel := utils.NewLeveledLogger(cfg.Logging.Level, cfg.Logging.JSON)
+	// moduleLogger tags a component's logger so /debug/loglevel can change its verbosity on its own.
+	moduleLogger := func(module string) *slog.Logger {
+		return logger.With(slog.String(utils.ModuleKey, module))
+	}
+	build := version.Get()
+	logger.Info("starting mirador-rca", slog.String("address", cfg.Server.Address), slog.String("version", build.Version), slog.String("commit", build.Commit))
+	if dev {
+		logger.Warn("dev mode: serving mock mirador-core data with in-memory history and cache; do not use in production", slog.String("mock_core", devCoreURL))
+	}
 
 	if err := metrics.Register(prometheus.DefaultRegisterer); err != nil {
 		logger.Error("failed to register metrics", slog.Any("error", err))
 		os.Exit(1)
 	}
+	metrics.SetTenantLabels(cfg.Metrics.Tenants, cfg.Metrics.MaxTenants)
+	metrics.SetBuildInfo(build.Version, build.Commit, build.BuildDate, build.GoVersion)
+
+	remote, err = buildRemoteWatcher(moduleLogger("remoteconfig"), cfg.Remote)
+	if err != nil {
+		logger.Error("invalid remote config configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+	if remote != nil {
+		timeout := cfg.Remote.Timeout
+		if timeout <= 0 {
+			timeout = 5 * time.Second
+		}
+		syncCtx, cancel := context.WithTimeout(context.Background(), timeout)
+		err := remote.Sync(syncCtx)
+		cancel()
+		if err != nil {
+			logger.Warn("remote config unavailable at startup; using local settings until it responds", slog.Any("error", err))
+		}
+		if cfg, err = loadConfig(configPaths...); err != nil {
+			logger.Error("failed to apply remote config", slog.Any("error", err))
+			os.Exit(1)
+		}
+		if level, err := utils.ParseLevel(cfg.Logging.Level); err == nil {
+			logLevel.Set(level)
+		}
+	}
+
+	shutdownTracing := func(context.Context) error { return nil }
+	if cfg.Tracing.Enabled {
+		shutdownTracing, err = tracing.Setup(context.Background(), moduleLogger("tracing"), tracing.Config{
+			Endpoint:       cfg.Tracing.Endpoint,
+			Headers:        cfg.Tracing.Headers,
+			SampleRatio:    cfg.Tracing.SampleRatio,
+			ServiceName:    cfg.Tracing.ServiceName,
+			ServiceVersion: version.Version,
+			Timeout:        cfg.Tracing.Timeout,
+		})
+		if err != nil {
+			logger.Error("failed to set up tracing", slog.Any("error", err))
+			os.Exit(1)
+		}
+		logger.Info("exporting traces over OTLP", slog.String("endpoint", cfg.Tracing.Endpoint), slog.Float64("sample_ratio", cfg.Tracing.SampleRatio))
+	}
+
+	secretResolver, err := buildSecretResolver(*cfg)
+	if err != nil {
+		logger.Error("invalid secrets configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+	// rawCfg keeps the secret references for reloads and refreshes; everything below uses resolved values.
+	rawCfg := *cfg
+	resolvedCfg, err := resolveSecrets(context.Background(), secretResolver, rawCfg)
+	if err != nil {
+		logger.Error("failed to resolve secrets", slog.Any("error", err))
+		os.Exit(1)
+	}
+	cfg = &resolvedCfg
 
 	var cacheProvider cache.Provider = cache.NoopProvider{}
-	var valkeyCloser cache.Provider
+	if dev {
+		cacheProvider = cache.NewInstrumentedProvider(cache.NewMemoryProvider(), nil)
+	}
+	var valkeyProvider *cache.ValkeyProvider
 	if cfg.Cache.Enabled && cfg.Cache.Addr != "" {
 		provider, err := cache.NewValkeyProvider(cache.ValkeyConfig{
-			Addr:         cfg.Cache.Addr,
-			Username:     cfg.Cache.Username,
-			Password:     cfg.Cache.Password,
-			DB:           cfg.Cache.DB,
-			DialTimeout:  cfg.Cache.DialTimeout,
-			ReadTimeout:  cfg.Cache.ReadTimeout,
-			WriteTimeout: cfg.Cache.WriteTimeout,
-			MaxRetries:   cfg.Cache.MaxRetries,
-			TLS:          cfg.Cache.TLS,
+			Addr:               cfg.Cache.Addr,
+			Username:           cfg.Cache.Username,
+			Password:           cfg.Cache.Password,
+			DB:                 cfg.Cache.DB,
+			DialTimeout:        cfg.Cache.DialTimeout,
+			ReadTimeout:        cfg.Cache.ReadTimeout,
+			WriteTimeout:       cfg.Cache.WriteTimeout,
+			MaxRetries:         cfg.Cache.MaxRetries,
+			TLS:                cfg.Cache.TLS,
+			CAFile:             cfg.Cache.TLSCAFile,
+			CertFile:           cfg.Cache.TLSCertFile,
+			KeyFile:            cfg.Cache.TLSKeyFile,
+			InsecureSkipVerify: cfg.Cache.TLSInsecureSkipVerify,
+			Network:            cfg.Cache.Network,
 		})
 		if err != nil {
 			logger.Warn("valkey cache unavailable", slog.Any("error", err))
 		} else {
-			cacheProvider = provider
-			valkeyCloser = provider
+			compressed, err := cache.NewCompressingProvider(provider, cfg.Cache.Compression, cfg.Cache.CompressionThreshold)
+			if err != nil {
+				logger.Error("invalid cache compression", slog.Any("error", err))
+				os.Exit(1)
+			}
+			cacheProvider = cache.NewInstrumentedProvider(compressed, nil)
+			valkeyProvider = provider
 		}
 	}
-	if valkeyCloser != nil {
-		defer valkeyCloser.Close()
+	if valkeyProvider != nil {
+		defer valkeyProvider.Close()
+	}
+
+	var otlpBuffer *otlp.Buffer
+	if cfg.OTLP.Enabled {
+		otlpBuffer = otlp.NewBuffer(cfg.OTLP.Retention, cfg.OTLP.MaxItems, cfg.OTLP.MaxTenants)
+	}
+
+	metricSource, err := buildMetricSource(cfg.Clients, otlpBuffer)
+	if err != nil {
+		logger.Error("invalid metrics source configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	logSource, err := buildLogSource(cfg.Clients, otlpBuffer)
+	if err != nil {
+		logger.Error("invalid logs source configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	traceSource, err := buildTraceSource(cfg.Clients.Traces, otlpBuffer)
+	if err != nil {
+		logger.Error("invalid traces backend configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	graphSource, err := buildServiceGraphSource(cfg.Clients, otlpBuffer)
+	if err != nil {
+		logger.Error("invalid service graph source configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	resources, err := resourceOption(cfg, otlpBuffer)
+	if err != nil {
+		logger.Error("invalid capacity configuration", slog.Any("error", err))
+		os.Exit(1)
 	}
 
 	coreClient := repo.NewMiradorCoreClient(
@@ -78,36 +250,202 @@ func main() {
 		cfg.Clients.Core.Timeout,
 		cacheProvider,
 		cfg.Cache.ServiceGraphTTL,
+		repo.WithMetricNames(cfg.Clients.Core.Metrics...),
+		repo.WithBaselineOffset(time.Duration(cfg.Clients.Core.BaselineDays)*24*time.Hour),
+		repo.WithAuth(repo.CoreAuth{
+			BearerToken:  cfg.Clients.Core.Auth.BearerToken,
+			APIKey:       cfg.Clients.Core.Auth.APIKey,
+			APIKeyHeader: cfg.Clients.Core.Auth.APIKeyHeader,
+			Headers:      cfg.Clients.Core.Auth.Headers,
+			TenantTokens: cfg.Clients.Core.Auth.TenantTokens,
+		}),
+		repo.WithMetricSource(metricSource),
+		resources,
+		repo.WithLogSource(logSource),
+		repo.WithTraceSource(traceSource),
+		repo.WithServiceGraphSource(graphSource),
+		repo.WithSignalCache(cfg.Cache.MetricsTTL, cfg.Cache.LogsTTL, cfg.Cache.TracesTTL),
+		repo.WithPagination(cfg.Clients.Core.PageSize, cfg.Clients.Core.MaxItems),
+		repo.WithHealthPath(cfg.Clients.Core.HealthPath),
+		repo.WithSLOPath(cfg.Clients.Core.SLOPath),
+		repo.WithCircuitBreaker(repo.NewCircuitBreaker("mirador-core", cfg.Clients.Core.CircuitBreaker.FailureThreshold, cfg.Clients.Core.CircuitBreaker.Cooldown)),
 	)
 
-	weaviateRepo := repo.NewWeaviateRepo(
-		cfg.Weaviate.Endpoint,
-		cfg.Weaviate.APIKey,
-		cfg.Weaviate.Timeout,
-		cacheProvider,
-		cfg.Cache.SimilarIncidentsTTL,
-		cfg.Cache.PatternsTTL,
-	)
+	history, err := buildHistoryStore(cfg, cacheProvider)
+	if err != nil {
+		logger.Error("failed to initialise history store", slog.Any("error", err))
+		os.Exit(1)
+	}
+	if _, ok := history.(*repo.MemoryRepo); ok {
+		logger.Warn("using embedded history store; correlations are not shared across replicas", slog.String("path", cfg.History.Memory.Path))
+	}
+	if closer, ok := history.(io.Closer); ok {
+		defer closer.Close()
+	}
 
-	ruleEngine, err := engine.NewRuleEngine(cfg.Rules.Path, logger)
+	engineLogger := moduleLogger("engine")
+	ruleEngine, err := engine.NewRuleEngine(cfg.Rules.Path, engineLogger)
 	if err != nil {
 		logger.Error("failed to load rule pack", slog.Any("error", err))
 		os.Exit(1)
 	}
-	causalityEngine := engine.NewCausalityEngine(logger)
+	causalityEngine := engine.NewCausalityEngine(engineLogger)
+
+	registry, err := buildExtractorRegistry(cfg.Extractors, moduleLogger("extractors"))
+	if err != nil {
+		logger.Error("invalid extractor configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	presets, err := buildPresets(cfg.Presets, registry)
+	if err != nil {
+		logger.Error("invalid preset configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	serviceNames, err := buildServiceNames(cfg.ServiceNames)
+	if err != nil {
+		logger.Error("invalid service name configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	var shadow *engine.ShadowDetectors
+	if len(cfg.Extractors.Shadow.Enabled) > 0 {
+		shadowLogger := moduleLogger("shadow")
+		shadowRegistry, err := buildExtractorRegistry(config.ExtractorsConfig{Enabled: cfg.Extractors.Shadow.Enabled, External: cfg.Extractors.External}, shadowLogger)
+		if err != nil {
+			logger.Error("invalid shadow extractor configuration", slog.Any("error", err))
+			os.Exit(1)
+		}
+		shadow = engine.NewShadowDetectors(shadowRegistry, cfg.Extractors.Shadow.Threshold, cfg.Extractors.Shadow.SampleRatio, cfg.Extractors.Shadow.MaxConcurrent, shadowLogger)
+		logger.Info("shadow detectors enabled", slog.Any("extractors", cfg.Extractors.Shadow.Enabled), slog.Float64("sample_ratio", cfg.Extractors.Shadow.SampleRatio))
+	}
+
+	maintenance, err := buildMaintenanceCalendar(cfg.Maintenance, history)
+	if err != nil {
+		logger.Error("invalid maintenance window configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	router, err := buildNotifier(cfg.Notifications)
+	if err != nil {
+		logger.Error("invalid notification configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+	notifications := notify.NewReloadable(router)
+
+	tickets, err := buildTicketCreator(cfg.Ticketing)
+	if err != nil {
+		logger.Error("invalid ticketing configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	auditor, err := buildAuditor(moduleLogger("audit"), cfg.Audit)
+	if err != nil {
+		logger.Error("invalid audit configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	flags, err := features.NewSet(featureRules(cfg.Features))
+	if err != nil {
+		logger.Error("invalid feature flag configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	summarizer, err := buildSummarizer(cfg.Summary)
+	if err != nil {
+		logger.Error("invalid summary configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	narrator, err := buildNarrator(cfg.LLM)
+	if err != nil {
+		logger.Error("invalid language model configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	// The tuner is only handed over when enabled, so the pipeline and service see a nil interface otherwise.
+	var thresholds engine.ThresholdSource
+	var thresholdTuner services.ThresholdTuner
+	tuner := buildThresholdTuner(cfg.Tuning, history, moduleLogger("tuning"))
+	if tuner != nil {
+		thresholds, thresholdTuner = tuner, tuner
+	}
+
+	// Likewise the snapshotter, which the pipeline reads snapshots back through.
+	var topologyHistory engine.TopologyHistory
+	snapshotter := buildSnapshotter(cfg.Topology, history, cacheProvider, moduleLogger("topology"))
+	if snapshotter != nil {
+		topologyHistory = snapshotter
+	}
+
+	var claims *engine.IncidentClaims
+	if cfg.Investigation.Claims.Enabled {
+		claims = engine.NewIncidentClaims(cacheProvider, cfg.Investigation.Budget, cfg.Investigation.Claims.Hold, engineLogger)
+	}
 
 	pipeline := engine.NewPipeline(
-		logger,
+		engineLogger,
 		coreClient,
-		weaviateRepo,
+		history,
 		ruleEngine,
 		causalityEngine,
-		extractors.NewMetricExtractor(),
-		extractors.NewLogsExtractor(),
-		extractors.NewTracesExtractor(),
+		registry,
+		engine.WithLinkBuilder(engine.NewLinkBuilder(cfg.Links.AnchorTemplate, cfg.Links.TimelineTemplate, cfg.Links.TraceTemplate, cfg.Links.Padding)),
+		engine.WithMaintenanceCalendar(maintenance),
+		engine.WithClusterer(buildClusterer(cfg.Clustering, history)),
+		engine.WithNotifier(notifications),
+		engine.WithNotifier(tickets),
+		engine.WithFeatures(flags),
+		engine.WithAuditor(auditor),
+		engine.WithShadowDetectors(shadow),
+		engine.WithIncidentClaims(claims),
+		engine.WithSummarizer(summarizer),
+		engine.WithNarrator(narrator),
+		engine.WithThresholds(thresholds),
+		engine.WithPresets(presets),
+		engine.WithServiceNames(serviceNames),
+		engine.WithWindowExpansion(expansionLimits(cfg.Investigation.Expansion)),
+		engine.WithCapacityAnalyzer(capacityAnalyzer(cfg.Capacity)),
+		engine.WithImpactScorer(impactScorer(cfg.Impact)),
+		engine.WithTopologyDrift(topologyHistory, cfg.Topology.Lookback),
+		engine.WithTimeouts(engine.Timeouts{
+			Metrics:       cfg.Clients.Core.Timeouts.Metrics,
+			Logs:          cfg.Clients.Core.Timeouts.Logs,
+			Traces:        cfg.Clients.Core.Timeouts.Traces,
+			ServiceGraph:  cfg.Clients.Core.Timeouts.ServiceGraph,
+			Baseline:      cfg.Clients.Core.Timeouts.Baseline,
+			Investigation: cfg.Investigation.Budget,
+		}),
 	)
 
-	rcaService := services.NewRCAService(logger, coreClient, pipeline, weaviateRepo)
+	patternsLogger := moduleLogger("patterns")
+	miningScheduler, err := patterns.NewScheduler(patternsLogger, patterns.NewMiner(patternsLogger, history, patterns.WithMinCoOccurrence(cfg.Patterns.MinCoOccurrence)), history, cacheProvider, cfg.Patterns.Schedule, cfg.Patterns.Tenants, cfg.Patterns.Lookback, cfg.Patterns.MaxCorrelations)
+	if err != nil {
+		logger.Error("invalid pattern mining configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	var latencySLO *slo.LatencyTracker
+	if cfg.SLO.Enabled {
+		latencySLO = slo.NewLatencyTracker(slo.InvestigationLatency, cfg.SLO.LatencyThreshold, cfg.SLO.Objective, cfg.SLO.Windows)
+	}
+
+	rcaService := services.NewRCAService(moduleLogger("api"), coreClient, pipeline, history,
+		services.WithMaintenanceCalendar(maintenance),
+		services.WithDataPurger(history),
+		services.WithPatternMiner(miningScheduler),
+		services.WithThresholdTuner(thresholdTuner),
+		services.WithRuleEngine(ruleEngine),
+		services.WithAuditor(auditor),
+		services.WithSLO(latencySLO),
+		services.WithExecutor(services.NewExecutor(services.ExecutorConfig{
+			MaxConcurrent:    cfg.Investigation.Executor.MaxConcurrent,
+			QueueDepth:       cfg.Investigation.Executor.QueueDepth,
+			TenantQueueDepth: cfg.Investigation.Executor.TenantQueueDepth,
+		})),
+		services.WithResultCache(cacheProvider, cfg.Cache.InvestigationTTL, cfg.Investigation.Budget),
+	)
 
 	server, err := api.NewServer(cfg.Server, rcaService)
 	if err != nil {
@@ -118,16 +456,174 @@ func main() {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
+	if ruleEngine != nil {
+		go ruleEngine.Watch(ctx, cfg.Rules.ReloadInterval)
+	}
+
+	if cfg.Retention.Enabled {
+		job := retention.NewJob(moduleLogger("retention"), history, cfg.Retention.Interval, cfg.Retention.DefaultAge, cfg.Retention.Tenants, cfg.Retention.DryRun)
+		go job.Run(ctx)
+	}
+
+	if cfg.Patterns.Enabled {
+		if !cfg.Cache.Enabled {
+			logger.Warn("pattern mining without a shared cache; every replica will mine")
+		}
+		go miningScheduler.Run(ctx)
+	}
+
+	go tuner.Run(ctx)
+	go snapshotter.Run(ctx, pipeline)
+
+	if cfg.Archive.Enabled {
+		store, err := buildArchiveStore(cfg.Archive)
+		if err != nil {
+			logger.Error("invalid archive configuration", slog.Any("error", err))
+			os.Exit(1)
+		}
+		exporter := archive.NewExporter(moduleLogger("archive"), history, store, cfg.Archive.Prefix, cfg.Archive.Interval, cfg.Archive.Tenants)
+		go exporter.Run(ctx)
+	}
+
+	var watcher *watch.Watcher
+	if cfg.Watch.Enabled {
+		watcher, err = buildWatcher(cfg.Watch, pipeline, moduleLogger("watch"))
+		if err != nil {
+			logger.Error("invalid watch configuration", slog.Any("error", err))
+			os.Exit(1)
+		}
+		go watcher.Run(ctx)
+	}
+
+	reloads := &reloader{
+		paths:         configPaths,
+		load:          loadConfig,
+		logger:        moduleLogger("config"),
+		level:         logLevel,
+		rules:         ruleEngine,
+		core:          coreClient,
+		notifications: notifications,
+		watcher:       watcher,
+		features:      flags,
+		secrets:       secretResolver,
+		cache:         valkeyProvider,
+		service:       rcaService,
+		current:       rawCfg,
+		started:       rawCfg,
+	}
+	reloads.weaviate, _ = history.(*repo.WeaviateRepo)
+	reloads.modTimes = reloads.configModTimes()
+	go reloads.Run(ctx, cfg.Reload.Interval, cfg.Secrets.RefreshInterval)
+	if remote != nil {
+		go remote.Run(ctx, func() { _ = reloads.Reload(ctx) })
+	}
+
+	checker := health.NewChecker(cfg.Health.Interval, cfg.Health.Timeout)
+	if cfg.Clients.Core.BaseURL != "" {
+		checker.Register("mirador_core", coreClient.Ping)
+	}
+	if reloads.weaviate != nil {
+		checker.RegisterOptional("weaviate", reloads.weaviate.Ready)
+	}
+	if valkeyProvider != nil {
+		checker.RegisterOptional("valkey", valkeyProvider.Ping)
+	}
+	checker.OnUpdate(server.ReportHealth)
+	go checker.Run(ctx)
+
+	if latencySLO != nil {
+		go latencySLO.Run(ctx, moduleLogger("slo"), cfg.SLO.ReportInterval)
+	}
+
+	var kafkaDone chan struct{}
+	if cfg.Kafka.Enabled {
+		proxy, err := kafka.NewRESTProxy(kafka.RESTProxyConfig{
+			URL:         cfg.Kafka.RESTProxyURL,
+			Group:       cfg.Kafka.Group,
+			Topic:       cfg.Kafka.Topic,
+			Username:    cfg.Kafka.Username,
+			Password:    cfg.Kafka.Password,
+			PollTimeout: cfg.Kafka.PollTimeout,
+		})
+		if err != nil {
+			logger.Error("invalid kafka configuration", slog.Any("error", err))
+			os.Exit(1)
+		}
+		consumer := kafka.NewConsumer(moduleLogger("kafka"), proxy, pipeline, kafka.ConsumerConfig{
+			DeadLetterTopic: cfg.Kafka.DeadLetterTopic,
+			MaxConcurrent:   cfg.Kafka.MaxConcurrent,
+			MaxAttempts:     cfg.Kafka.MaxAttempts,
+			RetryBackoff:    cfg.Kafka.RetryBackoff,
+			Lookback:        cfg.Kafka.Lookback,
+			Timeout:         cfg.Kafka.Timeout,
+		})
+		kafkaDone = make(chan struct{})
+		go func() {
+			defer close(kafkaDone)
+			logger.Info("consuming incident events", slog.String("topic", cfg.Kafka.Topic))
+			consumer.Run(ctx)
+		}()
+	}
+
+	var webhookServer *http.Server
+	var webhooks *integrations.Handler
+	sources, err := buildIncidentSources(cfg.Integrations)
+	if err != nil {
+		logger.Error("invalid integrations configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+	if len(sources) > 0 {
+		webhooks = integrations.NewHandler(moduleLogger("integrations"), pipeline, cfg.Integrations.Lookback, cfg.Integrations.Timeout, cfg.Integrations.MaxConcurrent, sources...)
+		mux := http.NewServeMux()
+		mux.Handle("/webhooks/", webhooks)
+		webhookServer = &http.Server{
+			Addr:         cfg.Integrations.Address,
+			Handler:      mux,
+			ReadTimeout:  5 * time.Second,
+			WriteTimeout: 15 * time.Second,
+		}
+		go func() {
+			logger.Info("webhook server listening", slog.String("address", cfg.Integrations.Address))
+			if err := webhookServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logger.Error("webhook server exited", slog.Any("error", err))
+				stop()
+			}
+		}()
+	}
+
+	var stopOTLP func(context.Context)
+	if otlpBuffer != nil {
+		stopOTLP, err = startOTLP(cfg.OTLP, otlpBuffer, moduleLogger("otlp"), stop)
+		if err != nil {
+			logger.Error("failed to start OTLP receiver", slog.Any("error", err))
+			os.Exit(1)
+		}
+	}
+
 	var metricsServer *http.Server
 	if cfg.Server.MetricsAddress != "" {
 		mux := http.NewServeMux()
-		mux.Handle("/metrics", promhttp.Handler())
+		// OpenMetrics is negotiated so scrapers that ask for it receive the trace_id exemplars on investigation_seconds.
+		mux.Handle("/metrics", promhttp.InstrumentMetricHandler(prometheus.DefaultRegisterer,
+			promhttp.HandlerFor(prometheus.DefaultGatherer, promhttp.HandlerOpts{EnableOpenMetrics: true})))
+		mux.Handle("/debug/config", configHandler(reloads))
+		// Changing levels is only allowed with the debug endpoints, since the metrics port is usually reachable by
+		// every scraper.
+		mux.Handle("/debug/loglevel", logLevelHandler(logger, logLevel, cfg.Server.DebugEndpoints))
+		mux.Handle("/debug/latency", latencyHandler(server))
+		mux.Handle("/healthz", checker.LivenessHandler())
+		mux.Handle("/readyz", checker.ReadinessHandler())
 		metricsServer = &http.Server{
 			Addr:         cfg.Server.MetricsAddress,
 			Handler:      mux,
 			ReadTimeout:  5 * time.Second,
 			WriteTimeout: 15 * time.Second,
 		}
+		if cfg.Server.DebugEndpoints {
+			mountDebugHandlers(mux)
+			metricsServer.WriteTimeout = debugWriteTimeout
+			logger.Warn("debug endpoints enabled on the metrics listener", slog.String("address", cfg.Server.MetricsAddress))
+		}
 		go func() {
 			logger.Info("metrics server listening", slog.String("address", cfg.Server.MetricsAddress))
 			if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
@@ -146,11 +642,41 @@ func main() {
 
 	<-ctx.Done()
 	logger.Info("shutdown signal received")
+	checker.Drain()
+	if cfg.Server.DrainDelay > 0 {
+		logger.Info("draining before shutdown", slog.Duration("delay", cfg.Server.DrainDelay))
+		time.Sleep(cfg.Server.DrainDelay)
+	}
 
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.GracefulTimeout)
 	defer cancel()
 	server.Shutdown(shutdownCtx)
 
+	if webhookServer != nil {
+		if err := webhookServer.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Warn("webhook server shutdown", slog.Any("error", err))
+		}
+		webhooks.Wait()
+	}
+
+	if stopOTLP != nil {
+		stopOTLP(shutdownCtx)
+	}
+
+	if kafkaDone != nil {
+		select {
+		case <-kafkaDone:
+		case <-shutdownCtx.Done():
+			logger.Warn("kafka consumer did not stop before the graceful timeout")
+		}
+	}
+
+	auditCtx, cancelAudit := context.WithTimeout(context.Background(), 5*time.Second)
+	if err := auditor.Close(auditCtx); err != nil {
+		logger.Warn("audit log shutdown", slog.Any("error", err))
+	}
+	cancelAudit()
+
 	if metricsServer != nil {
 		metricsCtx, cancelMetrics := context.WithTimeout(context.Background(), 5*time.Second)
 		if err := metricsServer.Shutdown(metricsCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
@@ -159,7 +685,563 @@ func main() {
 		cancelMetrics()
 	}
 
+	tracingCtx, cancelTracing := context.WithTimeout(context.Background(), 5*time.Second)
+	if err := shutdownTracing(tracingCtx); err != nil {
+		logger.Warn("trace exporter shutdown", slog.Any("error", err))
+	}
+	cancelTracing()
+
 	// Give remaining goroutines time to finish logging
 	time.Sleep(100 * time.Millisecond)
 	logger.Info("mirador-rca stopped")
 }
+
+// expansionLimits returns the window expansion settings for the pipeline; a disabled expansion has no maximum.
+func expansionLimits(cfg config.ExpansionConfig) (int, time.Duration) {
+	if !cfg.Enabled {
+		return 0, 0
+	}
+	return cfg.MinSamples, cfg.MaxExpansion
+}
+
+// buildServiceNames returns the service name normaliser, or nil when no aliases or rules are configured.
+func buildServiceNames(cfg config.ServiceNamesConfig) (*engine.ServiceNames, error) {
+	if len(cfg.Aliases) == 0 && len(cfg.Rules) == 0 {
+		return nil, nil
+	}
+	rules := make([]engine.ServiceNameRule, 0, len(cfg.Rules))
+	for _, rule := range cfg.Rules {
+		rules = append(rules, engine.ServiceNameRule{Pattern: rule.Pattern, Replace: rule.Replace})
+	}
+	return engine.NewServiceNames(cfg.Aliases, rules)
+}
+
+// buildPresets merges the configured presets into the built-in ones, checking their extractors against registry.
+func buildPresets(cfg map[string]config.PresetConfig, registry *extractors.Registry) (map[string]engine.Preset, error) {
+	overrides := make(map[string]engine.Preset, len(cfg))
+	for name, preset := range cfg {
+		overrides[name] = engine.Preset{
+			Padding:        preset.Padding,
+			Extractors:     preset.Extractors,
+			MaxAnchors:     preset.MaxAnchors,
+			MaxTimeline:    preset.MaxTimeline,
+			CausalityDepth: preset.CausalityDepth,
+		}
+	}
+	return engine.NewPresets(registry, overrides)
+}
+
+func buildExtractorRegistry(cfg config.ExtractorsConfig, logger *slog.Logger) (*extractors.Registry, error) {
+	registry := extractors.NewDefaultRegistry()
+	if cfg.External.Endpoint != "" {
+		external := extractors.NewExternalExtractor(
+			cfg.External.Endpoint,
+			cfg.External.Timeout,
+			logger,
+			extractors.NewMetricExtractor(),
+			extractors.NewLogsExtractor(),
+			extractors.NewTracesExtractor(),
+		)
+		if err := registry.Register(external); err != nil {
+			return nil, err
+		}
+	}
+	if len(cfg.Enabled) > 0 {
+		if err := registry.SetDefault(cfg.Enabled); err != nil {
+			return nil, err
+		}
+	}
+	for tenantID, names := range cfg.Tenants {
+		if err := registry.SetTenant(tenantID, names); err != nil {
+			return nil, fmt.Errorf("tenant %s: %w", tenantID, err)
+		}
+	}
+	return registry, nil
+}
+
+// resourceOption sends the resource utilisation fetches of capacity analysis to the configured metrics backend.
+func resourceOption(cfg *config.Config, buffer *otlp.Buffer) (repo.CoreClientOption, error) {
+	if !cfg.Capacity.Enabled {
+		return repo.WithResourceMetrics(nil), nil
+	}
+	switch strings.ToLower(cfg.Clients.MetricsSource) {
+	case "victoriametrics":
+		vm := cfg.Clients.VictoriaMetrics
+		return repo.WithResourceSource(repo.NewVictoriaMetricsClient(vm.BaseURL, cfg.Capacity.Resources, vm.Step, vm.Timeout)), nil
+	case "prometheus":
+		prom := cfg.Clients.Prometheus
+		client, err := repo.NewPrometheusClient(prom.URL, cfg.Capacity.Resources, prom.Headers, prom.Step, prom.Timeout)
+		if err != nil {
+			return nil, err
+		}
+		return repo.WithResourceSource(client), nil
+	case "otlp":
+		if buffer != nil {
+			return repo.WithResourceSource(buffer.Resources(cfg.Capacity.Resources)), nil
+		}
+	}
+	return repo.WithResourceMetrics(cfg.Capacity.Resources), nil
+}
+
+// capacityAnalyzer returns the pipeline's capacity analyser, or nil when capacity analysis is off.
+func capacityAnalyzer(cfg config.CapacityConfig) *engine.CapacityAnalyzer {
+	if !cfg.Enabled {
+		return nil
+	}
+	return engine.NewCapacityAnalyzer(cfg.Saturation)
+}
+
+// impactScorer returns the pipeline's SLO impact scorer, or nil when impact scoring is off.
+func impactScorer(cfg config.ImpactConfig) *engine.ImpactScorer {
+	if !cfg.Enabled {
+		return nil
+	}
+	var slos []repo.SLO
+	for service, definitions := range cfg.SLOs {
+		for _, slo := range definitions {
+			slos = append(slos, repo.SLO{
+				Name:      slo.Name,
+				Service:   service,
+				Indicator: slo.Indicator,
+				Objective: slo.Objective,
+				Threshold: slo.Threshold,
+				Period:    slo.Period,
+			})
+		}
+	}
+	return engine.NewImpactScorer(slos, cfg.CriticalBurnRate)
+}
+
+func buildMetricSource(cfg config.ClientsConfig, buffer *otlp.Buffer) (repo.MetricSource, error) {
+	switch strings.ToLower(cfg.MetricsSource) {
+	case "", "core":
+		return nil, nil
+	case "victoriametrics":
+		vm := cfg.VictoriaMetrics
+		if vm.BaseURL == "" || len(vm.Queries) == 0 {
+			return nil, fmt.Errorf("victoriametrics source requires baseURL and queries")
+		}
+		return repo.NewVictoriaMetricsClient(vm.BaseURL, vm.Queries, vm.Step, vm.Timeout), nil
+	case "prometheus":
+		prom := cfg.Prometheus
+		if prom.URL == "" || len(prom.Selectors) == 0 {
+			return nil, fmt.Errorf("prometheus source requires url and selectors")
+		}
+		return repo.NewPrometheusClient(prom.URL, prom.Selectors, prom.Headers, prom.Step, prom.Timeout)
+	case "otlp":
+		if buffer == nil {
+			return nil, fmt.Errorf("otlp metrics source requires otlp.enabled")
+		}
+		return buffer, nil
+	default:
+		return nil, fmt.Errorf("unknown metrics source %q", cfg.MetricsSource)
+	}
+}
+
+func buildLogSource(cfg config.ClientsConfig, buffer *otlp.Buffer) (repo.LogSource, error) {
+	switch strings.ToLower(cfg.LogsSource) {
+	case "", "core":
+		return nil, nil
+	case "victorialogs":
+		vl := cfg.VictoriaLogs
+		if vl.BaseURL == "" || vl.Query == "" {
+			return nil, fmt.Errorf("victorialogs source requires baseURL and query")
+		}
+		return repo.NewVictoriaLogsClient(vl.BaseURL, vl.Query, vl.SeverityField, vl.Limit, vl.Timeout), nil
+	case "loki":
+		loki := cfg.Loki
+		if loki.BaseURL == "" || loki.Query == "" {
+			return nil, fmt.Errorf("loki source requires baseURL and query")
+		}
+		return repo.NewLokiClient(loki.BaseURL, loki.Query, loki.SeverityLabel, loki.Limit, loki.Headers, loki.Timeout), nil
+	case "otlp":
+		if buffer == nil {
+			return nil, fmt.Errorf("otlp logs source requires otlp.enabled")
+		}
+		return buffer, nil
+	default:
+		return nil, fmt.Errorf("unknown logs source %q", cfg.LogsSource)
+	}
+}
+
+func buildTraceSource(cfg config.TracesSourceConfig, buffer *otlp.Buffer) (repo.TraceSource, error) {
+	backend := strings.ToLower(cfg.Backend)
+	if backend == "" || backend == "core" {
+		return nil, nil
+	}
+	if backend == "otlp" {
+		if buffer == nil {
+			return nil, fmt.Errorf("otlp traces backend requires otlp.enabled")
+		}
+		return buffer, nil
+	}
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("%s traces backend requires url", backend)
+	}
+	switch backend {
+	case "jaeger":
+		return repo.NewJaegerClient(cfg.URL, cfg.Limit, cfg.Timeout), nil
+	case "tempo":
+		return repo.NewTempoClient(cfg.URL, cfg.Limit, cfg.Timeout), nil
+	default:
+		return nil, fmt.Errorf("unknown traces backend %q", cfg.Backend)
+	}
+}
+
+func buildServiceGraphSource(cfg config.ClientsConfig, buffer *otlp.Buffer) (repo.ServiceGraphSource, error) {
+	switch strings.ToLower(cfg.ServiceGraphSource) {
+	case "", "core":
+		return nil, nil
+	case "otlp":
+		if buffer == nil {
+			return nil, fmt.Errorf("otlp service graph source requires otlp.enabled")
+		}
+		return buffer, nil
+	default:
+		return nil, fmt.Errorf("unknown service graph source %q", cfg.ServiceGraphSource)
+	}
+}
+
+func buildHistoryStore(cfg *config.Config, cacheProvider cache.Provider) (repo.HistoryStore, error) {
+	backend := strings.ToLower(cfg.History.Backend)
+	if (backend == "" || backend == "weaviate") && cfg.Weaviate.Endpoint == "" {
+		backend = "memory"
+	}
+	switch backend {
+	case "memory":
+		return repo.NewMemoryRepo(cfg.History.Memory.Path)
+	case "", "weaviate":
+		return repo.NewWeaviateRepo(
+			cfg.Weaviate.Endpoint,
+			cfg.Weaviate.APIKey,
+			cfg.Weaviate.Timeout,
+			cacheProvider,
+			cfg.Cache.SimilarIncidentsTTL,
+			cfg.Cache.PatternsTTL,
+			repo.WithNegativeCacheTTL(cfg.Cache.NegativeTTL),
+			repo.WithWeaviateCircuitBreaker(repo.NewCircuitBreaker("weaviate", cfg.Weaviate.CircuitBreaker.FailureThreshold, cfg.Weaviate.CircuitBreaker.Cooldown)),
+		), nil
+	case "postgres":
+		if cfg.History.Postgres.DSN == "" {
+			return nil, fmt.Errorf("history.postgres.dsn is required for the postgres backend")
+		}
+		store, err := repo.NewPostgresRepo(cfg.History.Postgres.DSN, cfg.History.Postgres.MaxOpenConns)
+		if err != nil {
+			return nil, err
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := store.Migrate(ctx); err != nil {
+			_ = store.Close()
+			return nil, fmt.Errorf("migrate postgres: %w", err)
+		}
+		return store, nil
+	default:
+		return nil, fmt.Errorf("unknown history backend %q", cfg.History.Backend)
+	}
+}
+
+func buildArchiveStore(cfg config.ArchiveConfig) (archive.ObjectStore, error) {
+	switch strings.ToLower(cfg.Provider) {
+	case "", "s3":
+		return archive.NewS3Store(archive.S3Config{
+			Endpoint:        cfg.Endpoint,
+			Region:          cfg.Region,
+			Bucket:          cfg.Bucket,
+			AccessKeyID:     cfg.AccessKeyID,
+			SecretAccessKey: cfg.SecretAccessKey,
+			SessionToken:    cfg.SessionToken,
+			PathStyle:       cfg.PathStyle,
+		}, cfg.Timeout)
+	case "gcs":
+		return archive.NewGCSStore(cfg.Bucket, cfg.AccessKeyID, cfg.SecretAccessKey, cfg.Timeout)
+	default:
+		return nil, fmt.Errorf("unknown archive provider %q", cfg.Provider)
+	}
+}
+
+func buildIncidentSources(cfg config.IntegrationsConfig) ([]integrations.Source, error) {
+	var sources []integrations.Source
+	if cfg.PagerDuty.Enabled {
+		source, err := integrations.NewPagerDuty(integrations.PagerDutyConfig{
+			TenantID:      cfg.PagerDuty.TenantID,
+			Environment:   cfg.PagerDuty.Environment,
+			WebhookSecret: cfg.PagerDuty.WebhookSecret,
+			APIToken:      cfg.PagerDuty.APIToken,
+			FromEmail:     cfg.PagerDuty.FromEmail,
+			APIURL:        cfg.PagerDuty.APIURL,
+		})
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, source)
+	}
+	if cfg.Opsgenie.Enabled {
+		source, err := integrations.NewOpsgenie(integrations.OpsgenieConfig{
+			TenantID:     cfg.Opsgenie.TenantID,
+			Environment:  cfg.Opsgenie.Environment,
+			WebhookToken: cfg.Opsgenie.WebhookToken,
+			APIKey:       cfg.Opsgenie.APIKey,
+			APIURL:       cfg.Opsgenie.APIURL,
+		})
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, source)
+	}
+	return sources, nil
+}
+
+func buildRemoteWatcher(logger *slog.Logger, cfg config.RemoteConfig) (*remoteconfig.Watcher, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	var source remoteconfig.Source
+	switch cfg.Backend {
+	case "etcd":
+		etcd, err := remoteconfig.NewEtcd(remoteconfig.EtcdConfig{
+			Endpoint:     cfg.Endpoint,
+			Prefix:       cfg.Prefix,
+			Username:     cfg.Username,
+			Password:     cfg.Password,
+			PollInterval: cfg.PollInterval,
+			Timeout:      cfg.Timeout,
+		})
+		if err != nil {
+			return nil, err
+		}
+		source = etcd
+	case "consul":
+		consul, err := remoteconfig.NewConsul(remoteconfig.ConsulConfig{
+			Address: cfg.Endpoint,
+			Prefix:  cfg.Prefix,
+			Token:   cfg.Token,
+			Wait:    cfg.PollInterval,
+		})
+		if err != nil {
+			return nil, err
+		}
+		source = consul
+	default:
+		return nil, fmt.Errorf("unknown remote config backend %q", cfg.Backend)
+	}
+	return remoteconfig.NewWatcher(logger, source, config.RemoteKeys), nil
+}
+
+func buildClusterer(cfg config.ClusteringConfig, history repo.HistoryStore) *engine.Clusterer {
+	if !cfg.Enabled {
+		return nil
+	}
+	return engine.NewClusterer(history, cfg.Window)
+}
+
+func buildAuditor(logger *slog.Logger, cfg config.AuditConfig) (*audit.Logger, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	var sink audit.Sink
+	switch cfg.Sink {
+	case "", "file":
+		fileSink, err := audit.NewFileSink(cfg.Path)
+		if err != nil {
+			return nil, err
+		}
+		sink = fileSink
+	case "http":
+		sink = audit.NewHTTPSink(cfg.URL, cfg.Headers, cfg.Timeout)
+	default:
+		return nil, fmt.Errorf("unknown audit sink %q", cfg.Sink)
+	}
+	return audit.NewLogger(logger, sink, cfg.BufferSize), nil
+}
+
+func buildNotifier(cfg config.NotificationsConfig) (*notify.Router, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	channels := make([]notify.Channel, 0, len(cfg.Channels))
+	for _, c := range cfg.Channels {
+		if c.URL == "" {
+			return nil, fmt.Errorf("channel %q has no url", c.Name)
+		}
+		var sender notify.Sender
+		switch strings.ToLower(c.Type) {
+		case "slack":
+			sender = notify.NewSlackSender(c.URL, cfg.Timeout)
+		case "teams":
+			sender = notify.NewTeamsSender(c.URL, cfg.Timeout)
+		case "webhook", "":
+			sender = notify.NewWebhookSender(c.URL, c.Headers, cfg.Timeout)
+		default:
+			return nil, fmt.Errorf("channel %q has unsupported type %q", c.Name, c.Type)
+		}
+		channel, err := notify.NewChannel(c.Name, sender, c.Template)
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, channel)
+	}
+	routes := make([]notify.Route, 0, len(cfg.Routes))
+	for _, r := range cfg.Routes {
+		route := notify.Route{
+			Tenants:           r.Tenants,
+			MinConfidence:     r.MinConfidence,
+			IncludeDuplicates: r.IncludeDuplicates,
+			Channels:          r.Channels,
+		}
+		for _, category := range r.Categories {
+			route.Categories = append(route.Categories, models.RootCauseCategory(category))
+		}
+		routes = append(routes, route)
+	}
+	router, err := notify.NewRouter(channels, routes)
+	if err != nil {
+		return nil, err
+	}
+	return router, nil
+}
+
+func buildSummarizer(cfg config.SummaryConfig) (*engine.Summarizer, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	tenants := make(map[string]engine.SummaryTenant, len(cfg.Tenants))
+	for id, tenant := range cfg.Tenants {
+		tenants[id] = engine.SummaryTenant{Locale: tenant.Locale, Template: tenant.Template}
+	}
+	return engine.NewSummarizer(cfg.Locale, cfg.Templates, tenants)
+}
+
+func buildThresholdTuner(cfg config.TuningConfig, history repo.HistoryStore, logger *slog.Logger) *tuning.Tuner {
+	if !cfg.Enabled {
+		return nil
+	}
+	return tuning.NewTuner(logger, history, tuning.Config{
+		Interval:        cfg.Interval,
+		Lookback:        cfg.Lookback,
+		MaxCorrelations: cfg.MaxCorrelations,
+		MinCorrelations: cfg.MinCorrelations,
+		MinFeedback:     cfg.MinFeedback,
+		TargetAnchors:   cfg.TargetAnchors,
+		MinThreshold:    cfg.MinThreshold,
+		MaxThreshold:    cfg.MaxThreshold,
+		Tenants:         cfg.Tenants,
+	})
+}
+
+func buildSnapshotter(cfg config.TopologyConfig, history repo.HistoryStore, locker topology.Locker, logger *slog.Logger) *topology.Snapshotter {
+	if !cfg.Enabled {
+		return nil
+	}
+	return topology.NewSnapshotter(logger, history, locker, topology.Config{
+		Interval:     cfg.Interval,
+		Tenants:      cfg.Tenants,
+		Environments: cfg.Environments,
+		ScopeTTL:     cfg.ScopeTTL,
+		Retention:    cfg.Retention,
+	})
+}
+
+func buildNarrator(cfg config.LLMConfig) (engine.Narrator, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	return llm.NewClient(llm.Config{
+		Endpoint:           cfg.Endpoint,
+		APIKey:             cfg.APIKey,
+		Headers:            cfg.Headers,
+		Model:              cfg.Model,
+		Timeout:            cfg.Timeout,
+		MaxTokens:          cfg.MaxTokens,
+		Temperature:        cfg.Temperature,
+		MaxRecommendations: cfg.MaxRecommendations,
+		Redaction:          llm.RedactionConfig{Labels: cfg.Redact.Labels, Patterns: cfg.Redact.Patterns},
+	})
+}
+
+func buildTicketCreator(cfg config.TicketingConfig) (engine.Notifier, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	var tracker ticketing.Tracker
+	var err error
+	switch strings.ToLower(cfg.Provider) {
+	case "jira":
+		tracker, err = ticketing.NewJira(ticketing.JiraConfig{
+			BaseURL:   cfg.Jira.BaseURL,
+			Email:     cfg.Jira.Email,
+			APIToken:  cfg.Jira.APIToken,
+			Project:   cfg.Jira.Project,
+			IssueType: cfg.Jira.IssueType,
+			Labels:    cfg.Jira.Labels,
+			Timeout:   cfg.Timeout,
+		})
+	case "servicenow":
+		tracker, err = ticketing.NewServiceNow(ticketing.ServiceNowConfig{
+			InstanceURL:     cfg.ServiceNow.InstanceURL,
+			Username:        cfg.ServiceNow.Username,
+			Password:        cfg.ServiceNow.Password,
+			Table:           cfg.ServiceNow.Table,
+			AssignmentGroup: cfg.ServiceNow.AssignmentGroup,
+			Timeout:         cfg.Timeout,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported ticketing provider %q", cfg.Provider)
+	}
+	if err != nil {
+		return nil, err
+	}
+	creator, err := ticketing.NewCreator(tracker, ticketing.Config{
+		MinConfidence:   cfg.MinConfidence,
+		Tenants:         cfg.Tenants,
+		SummaryTemplate: cfg.SummaryTemplate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return creator, nil
+}
+
+func buildWatcher(cfg config.WatchConfig, pipeline *engine.Pipeline, logger *slog.Logger) (*watch.Watcher, error) {
+	return watch.NewWatcher(logger, pipeline, pipeline, watchTargets(cfg), cfg.Timeout)
+}
+
+func featureRules(cfg map[string]config.FeatureConfig) map[string]features.Rule {
+	rules := make(map[string]features.Rule, len(cfg))
+	for name, feature := range cfg {
+		rules[name] = features.Rule{Enabled: feature.Enabled, Tenants: feature.Tenants, Percentage: feature.Percentage}
+	}
+	return rules
+}
+
+func watchTargets(cfg config.WatchConfig) []watch.Target {
+	targets := make([]watch.Target, 0, len(cfg.Targets))
+	for _, t := range cfg.Targets {
+		targets = append(targets, watch.Target{
+			TenantID:    t.TenantID,
+			Service:     t.Service,
+			Environment: t.Environment,
+			Interval:    t.Interval,
+			Window:      t.Window,
+			MinDensity:  t.MinDensity,
+			Threshold:   t.Threshold,
+			Cooldown:    t.Cooldown,
+		})
+	}
+	return targets
+}
+
+func buildMaintenanceCalendar(windows []config.MaintenanceWindowConfig, store engine.MaintenanceStore) (*engine.MaintenanceCalendar, error) {
+	seed := make([]models.MaintenanceWindow, 0, len(windows))
+	for _, w := range windows {
+		seed = append(seed, models.MaintenanceWindow{
+			ID:       w.ID,
+			TenantID: w.TenantID,
+			Services: w.Services,
+			Start:    w.Start,
+			End:      w.End,
+			Reason:   w.Reason,
+		})
+	}
+	return engine.NewMaintenanceCalendar(store, seed...)
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/miradorstack/mirador-rca/internal/config"
+	"github.com/miradorstack/mirador-rca/internal/otlp"
+)
+
+// startOTLP serves the OTLP receiver on the configured gRPC and HTTP addresses, filling buffer, and returns a
+// function that stops both. A listener that fails after startup calls stop.
+func startOTLP(cfg config.OTLPConfig, buffer *otlp.Buffer, logger *slog.Logger, stop func()) (func(context.Context), error) {
+	receiver := otlp.NewReceiver(buffer, cfg.TenantHeader, cfg.DefaultTenant, cfg.Tenants)
+
+	var grpcServer *grpc.Server
+	if cfg.GRPCAddress != "" {
+		listener, err := net.Listen("tcp", cfg.GRPCAddress)
+		if err != nil {
+			return nil, fmt.Errorf("listen for OTLP gRPC: %w", err)
+		}
+		grpcServer = grpc.NewServer(grpc.MaxRecvMsgSize(otlp.MaxExportBytes))
+		receiver.RegisterGRPC(grpcServer)
+		go func() {
+			logger.Info("OTLP gRPC receiver listening", slog.String("address", cfg.GRPCAddress))
+			if err := grpcServer.Serve(listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+				logger.Error("OTLP gRPC receiver exited", slog.Any("error", err))
+				stop()
+			}
+		}()
+	}
+
+	var httpServer *http.Server
+	if cfg.HTTPAddress != "" {
+		httpServer = &http.Server{
+			Addr:         cfg.HTTPAddress,
+			Handler:      receiver.Handler(),
+			ReadTimeout:  30 * time.Second,
+			WriteTimeout: 30 * time.Second,
+		}
+		go func() {
+			logger.Info("OTLP HTTP receiver listening", slog.String("address", cfg.HTTPAddress))
+			if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logger.Error("OTLP HTTP receiver exited", slog.Any("error", err))
+				stop()
+			}
+		}()
+	}
+
+	return func(ctx context.Context) {
+		if httpServer != nil {
+			if err := httpServer.Shutdown(ctx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logger.Warn("OTLP HTTP receiver shutdown", slog.Any("error", err))
+			}
+		}
+		if grpcServer != nil {
+			stopped := make(chan struct{})
+			go func() {
+				grpcServer.GracefulStop()
+				close(stopped)
+			}()
+			select {
+			case <-stopped:
+			case <-ctx.Done():
+				grpcServer.Stop()
+			}
+		}
+	}, nil
+}
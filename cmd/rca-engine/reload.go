@@ -0,0 +1,243 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/cache"
+	"github.com/miradorstack/mirador-rca/internal/config"
+	"github.com/miradorstack/mirador-rca/internal/engine"
+	"github.com/miradorstack/mirador-rca/internal/features"
+	"github.com/miradorstack/mirador-rca/internal/metrics"
+	"github.com/miradorstack/mirador-rca/internal/notify"
+	"github.com/miradorstack/mirador-rca/internal/repo"
+	"github.com/miradorstack/mirador-rca/internal/secrets"
+	"github.com/miradorstack/mirador-rca/internal/services"
+	"github.com/miradorstack/mirador-rca/internal/utils"
+	"github.com/miradorstack/mirador-rca/internal/watch"
+)
+
+// reloader re-reads the configuration and applies the sections that are safe to change at runtime: the rule
+// file path, cache TTLs, the log level, notification channels and routes, feature flags, watch thresholds,
+// and the Weaviate API key and cache password. Every section is validated before anything is swapped, so a rejected reload
+// leaves the running configuration untouched. Components that are disabled at startup (for example a missing
+// rule file) cannot be enabled by a reload. Between reloads, refreshSecrets re-resolves the secret references
+// of the current configuration so rotated credentials apply without a restart.
+type reloader struct {
+	paths         []string
+	load          func(paths ...string) (*config.Config, error)
+	logger        *slog.Logger
+	level         *utils.Levels
+	rules         *engine.RuleEngine
+	core          *repo.MiradorCoreClient
+	weaviate      *repo.WeaviateRepo
+	notifications *notify.Reloadable
+	watcher       *watch.Watcher
+	features      *features.Set
+	secrets       *secrets.Resolver
+	cache         *cache.ValkeyProvider
+	service       *services.RCAService
+
+	mu sync.Mutex
+	// current holds the configuration as loaded, with secret references unresolved; started is the one the
+	// process started with.
+	current  config.Config
+	started  config.Config
+	modTimes map[string]time.Time
+}
+
+// Reload applies the configuration file, recording the outcome in the config reload metrics.
+func (r *reloader) Reload(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	err := r.reloadLocked(ctx)
+	metrics.ObserveConfigReload(time.Now(), err)
+	if err != nil {
+		r.logger.Warn("configuration reload rejected; keeping the running configuration", slog.Any("paths", r.paths), slog.Any("error", err))
+	}
+	return err
+}
+
+func (r *reloader) reloadLocked(ctx context.Context) error {
+	r.modTimes = r.configModTimes()
+	next, err := r.load(r.paths...)
+	if err != nil {
+		return err
+	}
+	if err := validateCacheTTLs(next.Cache); err != nil {
+		return err
+	}
+	level, err := utils.ParseLevel(next.Logging.Level)
+	if err != nil {
+		return fmt.Errorf("logging: %w", err)
+	}
+	resolved, err := resolveSecrets(ctx, r.secrets, *next)
+	if err != nil {
+		return err
+	}
+	router, err := buildNotifier(resolved.Notifications)
+	if err != nil {
+		return fmt.Errorf("notifications: %w", err)
+	}
+	flags := featureRules(next.Features)
+	if _, err := features.NewSet(flags); err != nil {
+		return fmt.Errorf("features: %w", err)
+	}
+	targets := watchTargets(next.Watch)
+	if r.watcher != nil {
+		if _, err := watch.NormalizeTargets(targets); err != nil {
+			return fmt.Errorf("watch: %w", err)
+		}
+	}
+	// The rule engine validates and swaps in one step, so it goes last among the steps that can fail.
+	if r.rules != nil {
+		if err := r.rules.SetPath(next.Rules.Path); err != nil {
+			return fmt.Errorf("rules: %w", err)
+		}
+	}
+
+	// Only a changed logging.level replaces the global level, so an unrelated reload keeps a level set
+	// through /debug/loglevel.
+	if next.Logging.Level != r.current.Logging.Level {
+		r.level.Set(level)
+	}
+	r.applySecrets(resolved, router)
+	r.core.SetCacheTTLs(next.Cache.ServiceGraphTTL, next.Cache.MetricsTTL, next.Cache.LogsTTL, next.Cache.TracesTTL)
+	if r.service != nil {
+		r.service.SetResultTTL(next.Cache.InvestigationTTL)
+	}
+	if r.weaviate != nil {
+		r.weaviate.SetCacheTTLs(next.Cache.SimilarIncidentsTTL, next.Cache.PatternsTTL, next.Cache.NegativeTTL)
+	}
+	_ = r.features.Update(flags)
+	if r.watcher != nil {
+		_ = r.watcher.SetThresholds(targets)
+	}
+	if r.rules == nil && next.Rules.Path != r.current.Rules.Path {
+		r.logger.Warn("no rule file was loaded at startup; the new rules path needs a restart", slog.String("rules_path", next.Rules.Path))
+	}
+	if sections := config.RestartRequired(r.current, *next); len(sections) > 0 {
+		r.logger.Warn("configuration changes outside the reloadable settings need a restart", slog.Any("sections", sections))
+	}
+	r.current = *next
+	r.logger.Info("configuration reloaded", slog.Any("paths", r.paths), slog.String("log_level", r.level.Level().String()))
+	return nil
+}
+
+// Snapshot returns the current configuration, with secret references unresolved, and the sections whose
+// changes since startup need a restart.
+func (r *reloader) Snapshot() (config.Config, []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.current, config.RestartRequired(r.started, r.current)
+}
+
+// refreshSecrets re-resolves the secret references of the current configuration and applies the results.
+// A failed lookup keeps the credentials in use.
+func (r *reloader) refreshSecrets(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	resolved, err := resolveSecrets(ctx, r.secrets, r.current)
+	if err == nil {
+		var router *notify.Router
+		if router, err = buildNotifier(resolved.Notifications); err == nil {
+			r.applySecrets(resolved, router)
+		}
+	}
+	metrics.ObserveSecretRefresh(err)
+	if err != nil {
+		r.logger.Warn("secret refresh failed; keeping the current credentials", slog.Any("error", err))
+	}
+	return err
+}
+
+// applySecrets swaps in the settings that carry resolved secrets.
+func (r *reloader) applySecrets(resolved config.Config, router *notify.Router) {
+	r.notifications.Swap(router)
+	if r.weaviate != nil {
+		r.weaviate.SetAPIKey(resolved.Weaviate.APIKey)
+	}
+	if r.cache != nil {
+		r.cache.SetPassword(resolved.Cache.Password)
+	}
+}
+
+// Run reloads on SIGHUP and, with a positive interval, whenever a config file's modification time changes,
+// and refreshes secrets every refreshInterval when it is positive, until ctx is cancelled.
+func (r *reloader) Run(ctx context.Context, interval, refreshInterval time.Duration) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	var tick, refresh <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	if refreshInterval > 0 {
+		ticker := time.NewTicker(refreshInterval)
+		defer ticker.Stop()
+		refresh = ticker.C
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			_ = r.Reload(ctx)
+		case <-tick:
+			if r.changed() {
+				_ = r.Reload(ctx)
+			}
+		case <-refresh:
+			_ = r.refreshSecrets(ctx)
+		}
+	}
+}
+
+func (r *reloader) changed() bool {
+	modTimes := r.configModTimes()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return !maps.EqualFunc(modTimes, r.modTimes, time.Time.Equal)
+}
+
+// configModTimes returns the modification time of every configuration file, including overlays and
+// included files, so editing any of them triggers a reload.
+func (r *reloader) configModTimes() map[string]time.Time {
+	files, _ := config.Files(r.paths...)
+	modTimes := make(map[string]time.Time, len(files))
+	for _, file := range files {
+		if info, err := os.Stat(file); err == nil {
+			modTimes[file] = info.ModTime()
+		}
+	}
+	return modTimes
+}
+
+func validateCacheTTLs(cfg config.CacheConfig) error {
+	ttls := map[string]time.Duration{
+		"similarIncidentsTTL": cfg.SimilarIncidentsTTL,
+		"serviceGraphTTL":     cfg.ServiceGraphTTL,
+		"patternsTTL":         cfg.PatternsTTL,
+		"negativeTTL":         cfg.NegativeTTL,
+		"metricsTTL":          cfg.MetricsTTL,
+		"logsTTL":             cfg.LogsTTL,
+		"tracesTTL":           cfg.TracesTTL,
+		"investigationTTL":    cfg.InvestigationTTL,
+	}
+	for name, ttl := range ttls {
+		if ttl < 0 {
+			return fmt.Errorf("cache.%s must not be negative", name)
+		}
+	}
+	return nil
+}
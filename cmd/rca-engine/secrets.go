@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/miradorstack/mirador-rca/internal/config"
+	"github.com/miradorstack/mirador-rca/internal/secrets"
+)
+
+// buildSecretResolver registers the Vault and AWS Secrets Manager backends when they are configured or
+// referenced by cfg, so a reference to an unconfigured backend fails at startup with the backend's error.
+func buildSecretResolver(cfg config.Config) (*secrets.Resolver, error) {
+	used := map[string]bool{}
+	for _, value := range secretValues(cfg) {
+		used[secrets.Scheme(value)] = true
+	}
+	var opts []secrets.Option
+	if vaultCfg := cfg.Secrets.Vault; used["vault"] || vaultCfg.Address != "" {
+		vault, err := secrets.NewVault(secrets.VaultConfig{
+			Address:   vaultCfg.Address,
+			Token:     vaultCfg.Token,
+			TokenFile: vaultCfg.TokenFile,
+			Namespace: vaultCfg.Namespace,
+			Timeout:   vaultCfg.Timeout,
+		})
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, secrets.WithBackend("vault", vault))
+	}
+	if awsCfg := cfg.Secrets.AWS; used["awssm"] || awsCfg.Region != "" {
+		manager, err := secrets.NewSecretsManager(secrets.AWSConfig{
+			Region:          awsCfg.Region,
+			Endpoint:        awsCfg.Endpoint,
+			AccessKeyID:     awsCfg.AccessKeyID,
+			SecretAccessKey: awsCfg.SecretAccessKey,
+			SessionToken:    awsCfg.SessionToken,
+			Timeout:         awsCfg.Timeout,
+		})
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, secrets.WithBackend("awssm", manager))
+	}
+	return secrets.NewResolver(opts...), nil
+}
+
+// secretValues lists the settings that may hold secret references.
+func secretValues(cfg config.Config) []string {
+	values := []string{cfg.Weaviate.APIKey, cfg.Cache.Password, cfg.LLM.APIKey}
+	for _, channel := range cfg.Notifications.Channels {
+		values = append(values, channel.URL)
+		for _, header := range channel.Headers {
+			values = append(values, header)
+		}
+	}
+	return values
+}
+
+// resolveSecrets returns a copy of cfg with the secret references of secretValues replaced by their values;
+// cfg itself keeps the references so later refreshes can resolve them again.
+func resolveSecrets(ctx context.Context, resolver *secrets.Resolver, cfg config.Config) (config.Config, error) {
+	var err error
+	if cfg.Weaviate.APIKey, err = resolver.Resolve(ctx, cfg.Weaviate.APIKey); err != nil {
+		return cfg, fmt.Errorf("weaviate.apiKey: %w", err)
+	}
+	if cfg.Cache.Password, err = resolver.Resolve(ctx, cfg.Cache.Password); err != nil {
+		return cfg, fmt.Errorf("cache.password: %w", err)
+	}
+	if cfg.LLM.APIKey, err = resolver.Resolve(ctx, cfg.LLM.APIKey); err != nil {
+		return cfg, fmt.Errorf("llm.apiKey: %w", err)
+	}
+	channels := make([]config.NotificationChannelConfig, len(cfg.Notifications.Channels))
+	for i, channel := range cfg.Notifications.Channels {
+		if channel.URL, err = resolver.Resolve(ctx, channel.URL); err != nil {
+			return cfg, fmt.Errorf("notifications channel %q url: %w", channel.Name, err)
+		}
+		if channel.Headers, err = resolver.ResolveAll(ctx, channel.Headers); err != nil {
+			return cfg, fmt.Errorf("notifications channel %q headers: %w", channel.Name, err)
+		}
+		channels[i] = channel
+	}
+	cfg.Notifications.Channels = channels
+	return cfg, nil
+}
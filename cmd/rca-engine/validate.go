@@ -0,0 +1,57 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/miradorstack/mirador-rca/internal/config"
+)
+
+// validateConfig implements `rca-engine validate`: it loads the configuration strictly, prints every problem
+// to stderr, and returns the process exit code.
+func validateConfig(args []string) int {
+	return runValidate(args, os.Stdout, os.Stderr)
+}
+
+func runValidate(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("validate", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	var configPaths pathList
+	flags.Var(&configPaths, "config", "Path to a configuration file; repeat or separate with commas to merge overlays in order")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if _, err := config.LoadStrict(configPaths...); err != nil {
+		var invalid *config.ValidationError
+		if !errors.As(err, &invalid) {
+			fmt.Fprintf(stderr, "%v\n", err)
+			return 1
+		}
+		for _, problem := range invalid.Problems {
+			fmt.Fprintln(stderr, problem)
+		}
+		fmt.Fprintf(stderr, "%d problem(s) found\n", len(invalid.Problems))
+		return 1
+	}
+	fmt.Fprintln(stdout, "config OK")
+	return 0
+}
+
+// pathList collects -config values; each may hold several comma-separated paths.
+type pathList []string
+
+func (p *pathList) String() string { return strings.Join(*p, ",") }
+
+func (p *pathList) Set(value string) error {
+	for _, path := range strings.Split(value, ",") {
+		if path = strings.TrimSpace(path); path != "" {
+			*p = append(*p, path)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package api
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/miradorstack/mirador-rca/internal/audit"
+)
+
+// ActorMetadataKey is the gRPC metadata key callers set to identify the person or system behind a request
+// in the audit log.
+const ActorMetadataKey = "x-mirador-actor"
+
+// actorInterceptor tags each RPC's context with its caller for the audit log: the ActorMetadataKey value
+// when set, otherwise the peer address.
+func actorInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return handler(audit.WithActor(ctx, callerActor(ctx)), req)
+}
+
+func callerActor(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(ActorMetadataKey); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return "peer:" + p.Addr.String()
+	}
+	return ""
+}
@@ -2,10 +2,14 @@ package api
 
 import (
 	"fmt"
+	"net/url"
+	"strings"
 	"time"
 
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/miradorstack/mirador-rca/internal/engine"
 	rcav1 "github.com/miradorstack/mirador-rca/internal/grpc/generated"
 	"github.com/miradorstack/mirador-rca/internal/models"
 )
@@ -24,6 +28,14 @@ func FromProtoInvestigationRequest(req *rcav1.RCAInvestigationRequest) (models.I
 	if start.IsZero() || end.IsZero() {
 		return models.InvestigationRequest{}, fmt.Errorf("time_range values must be set")
 	}
+	if err := validateLabels(req.GetLabels()); err != nil {
+		return models.InvestigationRequest{}, err
+	}
+	if ticket := req.GetIncident().GetTicketUrl(); ticket != "" {
+		if u, err := url.Parse(ticket); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return models.InvestigationRequest{}, fmt.Errorf("incident.ticket_url must be an absolute http(s) URL")
+		}
+	}
 
 	return models.InvestigationRequest{
 		IncidentID:       req.IncidentId,
@@ -32,43 +44,174 @@ func FromProtoInvestigationRequest(req *rcav1.RCAInvestigationRequest) (models.I
 		AffectedServices: append([]string(nil), req.AffectedServices...),
 		AnomalyThreshold: req.AnomalyThreshold,
 		TenantID:         req.TenantId,
+		Labels:           models.CopyLabels(req.GetLabels()),
+		Preset:           strings.TrimSpace(req.GetPreset()),
+		Environment:      strings.TrimSpace(req.GetEnvironment()),
+		Incident: models.IncidentMetadata{
+			Title:             req.GetIncident().GetTitle(),
+			Description:       req.GetIncident().GetDescription(),
+			AlertFingerprints: append([]string(nil), req.GetIncident().GetAlertFingerprints()...),
+			TicketURL:         req.GetIncident().GetTicketUrl(),
+		},
 	}, nil
 }
 
 // ToProtoCorrelationResult converts a domain result into the gRPC representation.
 func ToProtoCorrelationResult(res models.CorrelationResult) *rcav1.CorrelationResult {
 	proto := &rcav1.CorrelationResult{
-		CorrelationId:    res.CorrelationID,
-		IncidentId:       res.IncidentID,
-		RootCause:        res.RootCause,
-		Confidence:       res.Confidence,
-		AffectedServices: append([]string(nil), res.AffectedServices...),
-		Recommendations:  append([]string(nil), res.Recommendations...),
-		CreatedAt:        timestamppb.New(res.CreatedAt),
+		CorrelationId:       res.CorrelationID,
+		IncidentId:          res.IncidentID,
+		RootCause:           res.RootCause,
+		Confidence:          res.Confidence,
+		AffectedServices:    append([]string(nil), res.AffectedServices...),
+		Recommendations:     models.RecommendationTexts(res.Recommendations),
+		CreatedAt:           timestamppb.New(res.CreatedAt),
+		Category:            toProtoCategory(res.Category),
+		DuplicateOf:         res.DuplicateOf,
+		RelatedCorrelations: append([]string(nil), res.RelatedCorrelations...),
+		Status:              toProtoStatus(res.Status),
+		Labels:              models.CopyLabels(res.Labels),
+		Summary:             res.Summary,
+		Environment:         res.Environment,
 	}
-	for _, anchor := range res.RedAnchors {
-		proto.RedAnchors = append(proto.RedAnchors, &rcav1.RedAnchor{
-			Service:      anchor.Service,
-			Selector:     anchor.Selector,
-			DataType:     toProtoDataType(anchor.DataType),
-			Timestamp:    timestamppb.New(anchor.Timestamp),
-			AnomalyScore: anchor.AnomalyScore,
-			Threshold:    anchor.Threshold,
+	if res.Incident.Title != "" || res.Incident.Description != "" || len(res.Incident.AlertFingerprints) > 0 || res.Incident.TicketURL != "" {
+		proto.Incident = &rcav1.IncidentMetadata{
+			Title:             res.Incident.Title,
+			Description:       res.Incident.Description,
+			AlertFingerprints: append([]string(nil), res.Incident.AlertFingerprints...),
+			TicketUrl:         res.Incident.TicketURL,
+		}
+	}
+	proto.RecommendationDetails = toProtoRecommendations(res.Recommendations)
+	for _, annotation := range res.Annotations {
+		proto.Annotations = append(proto.Annotations, &rcav1.Annotation{
+			Author:    annotation.Author,
+			Text:      annotation.Text,
+			CreatedAt: timestamppb.New(annotation.CreatedAt),
 		})
 	}
+	for _, source := range res.UnavailableSources {
+		proto.UnavailableSources = append(proto.UnavailableSources, toProtoDataType(source))
+	}
+	for _, anchor := range res.RedAnchors {
+		proto.RedAnchors = append(proto.RedAnchors, toProtoRedAnchor(anchor))
+	}
 	for _, event := range res.Timeline {
-		proto.Timeline = append(proto.Timeline, &rcav1.TimelineEvent{
+		protoEvent := &rcav1.TimelineEvent{
 			Time:         timestamppb.New(event.Time),
 			Event:        event.Event,
 			Service:      event.Service,
 			Severity:     toProtoSeverity(event.Severity),
 			AnomalyScore: event.AnomalyScore,
 			DataSource:   toProtoDataType(event.DataSource),
+			Link:         event.Link,
+			Ongoing:      event.Ongoing,
+			Direction:    string(event.Direction),
+		}
+		if event.Sustained() {
+			protoEvent.Start = timestamppb.New(event.Start)
+			protoEvent.End = timestamppb.New(event.End)
+		}
+		proto.Timeline = append(proto.Timeline, protoEvent)
+	}
+	for _, impact := range res.BlastRadius {
+		proto.BlastRadius = append(proto.BlastRadius, &rcav1.ServiceImpact{
+			Service: impact.Service,
+			Score:   impact.Score,
+			Depth:   int32(impact.Depth),
+		})
+	}
+	if expansion := res.WindowExpansion; expansion != nil {
+		proto.WindowExpansion = &rcav1.WindowExpansion{
+			Requested:  toProtoTimeRange(expansion.Requested),
+			Analysed:   toProtoTimeRange(expansion.Analysed),
+			Samples:    int32(expansion.Samples),
+			MinSamples: int32(expansion.MinSamples),
+		}
+		for _, source := range expansion.SparseSources {
+			proto.WindowExpansion.SparseSources = append(proto.WindowExpansion.SparseSources, toProtoDataType(source))
+		}
+	}
+	proto.Capacity = toProtoCapacity(res.Capacity)
+	proto.ImpactScore = res.ImpactScore
+	for _, impact := range res.SLOImpacts {
+		proto.SloImpacts = append(proto.SloImpacts, &rcav1.SLOImpact{
+			Service:     impact.Service,
+			Slo:         impact.SLO,
+			Objective:   impact.Objective,
+			BadFraction: impact.BadFraction,
+			BurnRate:    impact.BurnRate,
+			BudgetSpent: impact.BudgetSpent,
 		})
 	}
 	return proto
 }
 
+// ToProtoCorrelationExplanation describes how res was reached. Correlations stored before explanations were
+// recorded only carry their anchors and the rule IDs of their recommendations.
+func ToProtoCorrelationExplanation(res models.CorrelationResult) *rcav1.CorrelationExplanation {
+	proto := &rcav1.CorrelationExplanation{
+		CorrelationId: res.CorrelationID,
+		RootCause:     res.RootCause,
+		Confidence:    res.Confidence,
+		Category:      toProtoCategory(res.Category),
+	}
+	for _, anchor := range res.RedAnchors {
+		proto.Anchors = append(proto.Anchors, toProtoRedAnchor(anchor))
+	}
+
+	explanation := res.Explanation
+	if explanation == nil {
+		for _, rec := range res.Recommendations {
+			if rec.RuleID != "" {
+				proto.MatchedRules = append(proto.MatchedRules, &rcav1.MatchedRule{RuleId: rec.RuleID})
+			}
+		}
+		return proto
+	}
+	proto.Recorded = true
+	proto.Service = explanation.Service
+	proto.Threshold = explanation.Threshold
+	proto.Preset = explanation.Preset
+	for _, run := range explanation.Detectors {
+		proto.Detectors = append(proto.Detectors, &rcav1.DetectorRun{
+			Name:      run.Name,
+			Anomalies: int32(run.Anomalies),
+			Threshold: run.Threshold,
+			TopScore:  run.TopScore,
+		})
+	}
+	proto.CausalityScore = explanation.Causality.Score
+	proto.SuggestedService = explanation.Causality.SuggestedService
+	proto.CausalityNotes = append([]string(nil), explanation.Causality.Notes...)
+	proto.DetectionConfidence = explanation.DetectionConfidence
+	proto.MissingSources = int32(explanation.MissingSources)
+	proto.MaintenanceAnomalies = int32(explanation.MaintenanceAnomalies)
+	proto.RecommendationSource = string(explanation.RecommendationSource)
+	for _, rule := range explanation.MatchedRules {
+		proto.MatchedRules = append(proto.MatchedRules, &rcav1.MatchedRule{
+			RuleId:  rule.RuleID,
+			Reasons: append([]string(nil), rule.Reasons...),
+		})
+	}
+	proto.SimilarCorrelations = append([]string(nil), explanation.SimilarCorrelations...)
+	return proto
+}
+
+// validateLabels rejects keys that cannot round-trip through the "key=value" form used by Weaviate.
+func validateLabels(labels map[string]string) error {
+	for key := range labels {
+		if strings.TrimSpace(key) == "" || strings.Contains(key, "=") {
+			return fmt.Errorf("invalid label key %q", key)
+		}
+	}
+	return nil
+}
+
+func toProtoTimeRange(window models.TimeRange) *rcav1.TimeRange {
+	return &rcav1.TimeRange{Start: timestamppb.New(window.Start), End: timestamppb.New(window.End)}
+}
+
 func toProtoDataType(dataType models.DataType) rcav1.DataType {
 	switch dataType {
 	case models.DataTypeMetrics:
@@ -82,6 +225,99 @@ func toProtoDataType(dataType models.DataType) rcav1.DataType {
 	}
 }
 
+func toProtoCategory(category models.RootCauseCategory) rcav1.RootCauseCategory {
+	switch category {
+	case models.CategoryDeployment:
+		return rcav1.RootCauseCategory_ROOT_CAUSE_CATEGORY_DEPLOYMENT
+	case models.CategoryCapacity:
+		return rcav1.RootCauseCategory_ROOT_CAUSE_CATEGORY_CAPACITY
+	case models.CategoryDependency:
+		return rcav1.RootCauseCategory_ROOT_CAUSE_CATEGORY_DEPENDENCY_FAILURE
+	case models.CategoryConfig:
+		return rcav1.RootCauseCategory_ROOT_CAUSE_CATEGORY_CONFIG
+	case models.CategoryNetwork:
+		return rcav1.RootCauseCategory_ROOT_CAUSE_CATEGORY_NETWORK
+	default:
+		return rcav1.RootCauseCategory_ROOT_CAUSE_CATEGORY_UNSPECIFIED
+	}
+}
+
+func fromProtoCategory(category rcav1.RootCauseCategory) models.RootCauseCategory {
+	switch category {
+	case rcav1.RootCauseCategory_ROOT_CAUSE_CATEGORY_DEPLOYMENT:
+		return models.CategoryDeployment
+	case rcav1.RootCauseCategory_ROOT_CAUSE_CATEGORY_CAPACITY:
+		return models.CategoryCapacity
+	case rcav1.RootCauseCategory_ROOT_CAUSE_CATEGORY_DEPENDENCY_FAILURE:
+		return models.CategoryDependency
+	case rcav1.RootCauseCategory_ROOT_CAUSE_CATEGORY_CONFIG:
+		return models.CategoryConfig
+	case rcav1.RootCauseCategory_ROOT_CAUSE_CATEGORY_NETWORK:
+		return models.CategoryNetwork
+	default:
+		return models.CategoryUnknown
+	}
+}
+
+func toProtoRecommendations(recs []models.Recommendation) []*rcav1.Recommendation {
+	var out []*rcav1.Recommendation
+	for _, rec := range recs {
+		detail := &rcav1.Recommendation{Text: rec.Text, RuleId: rec.RuleID, Generated: rec.Generated}
+		for _, action := range rec.Actions {
+			detail.Actions = append(detail.Actions, &rcav1.RecommendationAction{
+				Type:  toProtoActionType(action.Type),
+				Label: action.Label,
+				Url:   action.URL,
+			})
+		}
+		out = append(out, detail)
+	}
+	return out
+}
+
+func toProtoActionType(actionType models.ActionType) rcav1.RecommendationActionType {
+	switch actionType {
+	case models.ActionRunbook:
+		return rcav1.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_RUNBOOK
+	case models.ActionDashboard:
+		return rcav1.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_DASHBOARD
+	case models.ActionWebhook:
+		return rcav1.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_WEBHOOK
+	default:
+		return rcav1.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_UNSPECIFIED
+	}
+}
+
+func toProtoStatus(status models.CorrelationStatus) rcav1.CorrelationStatus {
+	switch status {
+	case models.CorrelationOpen, "":
+		return rcav1.CorrelationStatus_CORRELATION_STATUS_OPEN
+	case models.CorrelationConfirmed:
+		return rcav1.CorrelationStatus_CORRELATION_STATUS_CONFIRMED
+	case models.CorrelationRejected:
+		return rcav1.CorrelationStatus_CORRELATION_STATUS_REJECTED
+	case models.CorrelationResolved:
+		return rcav1.CorrelationStatus_CORRELATION_STATUS_RESOLVED
+	default:
+		return rcav1.CorrelationStatus_CORRELATION_STATUS_UNSPECIFIED
+	}
+}
+
+func fromProtoStatus(status rcav1.CorrelationStatus) models.CorrelationStatus {
+	switch status {
+	case rcav1.CorrelationStatus_CORRELATION_STATUS_OPEN:
+		return models.CorrelationOpen
+	case rcav1.CorrelationStatus_CORRELATION_STATUS_CONFIRMED:
+		return models.CorrelationConfirmed
+	case rcav1.CorrelationStatus_CORRELATION_STATUS_REJECTED:
+		return models.CorrelationRejected
+	case rcav1.CorrelationStatus_CORRELATION_STATUS_RESOLVED:
+		return models.CorrelationResolved
+	default:
+		return ""
+	}
+}
+
 func toProtoSeverity(sev models.Severity) rcav1.Severity {
 	switch sev {
 	case models.SeverityLow:
@@ -97,6 +333,107 @@ func toProtoSeverity(sev models.Severity) rcav1.Severity {
 	}
 }
 
+func fromProtoDataType(dataType rcav1.DataType) models.DataType {
+	switch dataType {
+	case rcav1.DataType_DATA_TYPE_METRICS:
+		return models.DataTypeMetrics
+	case rcav1.DataType_DATA_TYPE_LOGS:
+		return models.DataTypeLogs
+	case rcav1.DataType_DATA_TYPE_TRACES:
+		return models.DataTypeTraces
+	default:
+		return ""
+	}
+}
+
+func fromProtoSeverity(sev rcav1.Severity) models.Severity {
+	switch sev {
+	case rcav1.Severity_SEVERITY_LOW:
+		return models.SeverityLow
+	case rcav1.Severity_SEVERITY_MEDIUM:
+		return models.SeverityMedium
+	case rcav1.Severity_SEVERITY_HIGH:
+		return models.SeverityHigh
+	case rcav1.Severity_SEVERITY_CRITICAL:
+		return models.SeverityCritical
+	default:
+		return ""
+	}
+}
+
+// FromProtoTestRulesRequest maps a TestRules request into the sample investigation, anchors, and timeline the
+// rules are evaluated against. Without a time range the sample covers the 30 minutes before now.
+func FromProtoTestRulesRequest(req *rcav1.TestRulesRequest, now time.Time) (models.InvestigationRequest, []models.RedAnchor, []models.TimelineEvent, error) {
+	if req == nil {
+		return models.InvestigationRequest{}, nil, nil, fmt.Errorf("request is nil")
+	}
+	sample := req.GetRequest()
+	if sample == nil {
+		sample = &rcav1.RCAInvestigationRequest{}
+	}
+	if sample.TimeRange == nil {
+		sample = proto.Clone(sample).(*rcav1.RCAInvestigationRequest)
+		sample.TimeRange = &rcav1.TimeRange{Start: timestamppb.New(now.Add(-30 * time.Minute)), End: timestamppb.New(now)}
+	}
+	domainReq, err := FromProtoInvestigationRequest(sample)
+	if err != nil {
+		return models.InvestigationRequest{}, nil, nil, fmt.Errorf("request: %w", err)
+	}
+
+	anchors := make([]models.RedAnchor, 0, len(req.GetAnchors()))
+	for _, anchor := range req.GetAnchors() {
+		anchors = append(anchors, models.RedAnchor{
+			Service:      anchor.GetService(),
+			Selector:     anchor.GetSelector(),
+			DataType:     fromProtoDataType(anchor.GetDataType()),
+			Timestamp:    anchor.GetTimestamp().AsTime(),
+			AnomalyScore: anchor.GetAnomalyScore(),
+			Threshold:    anchor.GetThreshold(),
+			Link:         anchor.GetLink(),
+			Environment:  anchor.GetEnvironment(),
+			Direction:    models.Direction(anchor.GetDirection()),
+		})
+	}
+	timeline := make([]models.TimelineEvent, 0, len(req.GetTimeline()))
+	for _, event := range req.GetTimeline() {
+		domainEvent := models.TimelineEvent{
+			Time:         event.GetTime().AsTime(),
+			Event:        event.GetEvent(),
+			Service:      event.GetService(),
+			Severity:     fromProtoSeverity(event.GetSeverity()),
+			AnomalyScore: event.GetAnomalyScore(),
+			DataSource:   fromProtoDataType(event.GetDataSource()),
+			Link:         event.GetLink(),
+			Ongoing:      event.GetOngoing(),
+			Direction:    models.Direction(event.GetDirection()),
+		}
+		if event.GetStart() != nil && event.GetEnd() != nil {
+			domainEvent.Start = event.GetStart().AsTime()
+			domainEvent.End = event.GetEnd().AsTime()
+		}
+		timeline = append(timeline, domainEvent)
+	}
+	return domainReq, anchors, timeline, nil
+}
+
+// ToProtoTestRulesResponse converts rule evaluations into the TestRules response.
+func ToProtoTestRulesResponse(evaluations []engine.RuleEvaluation) *rcav1.TestRulesResponse {
+	resp := &rcav1.TestRulesResponse{}
+	for _, evaluation := range evaluations {
+		recs := toProtoRecommendations(evaluation.Recommendations)
+		resp.Evaluations = append(resp.Evaluations, &rcav1.RuleEvaluation{
+			RuleId:          evaluation.RuleID,
+			Priority:        int32(evaluation.Priority),
+			Matched:         evaluation.Matched,
+			Reasons:         append([]string(nil), evaluation.Reasons...),
+			Recommendations: recs,
+			Skipped:         evaluation.Skipped,
+		})
+		resp.Recommendations = append(resp.Recommendations, recs...)
+	}
+	return resp
+}
+
 // FromProtoFeedbackRequest converts the proto feedback into a domain struct.
 func FromProtoFeedbackRequest(req *rcav1.FeedbackRequest) (models.Feedback, error) {
 	if req == nil {
@@ -114,6 +451,48 @@ func FromProtoFeedbackRequest(req *rcav1.FeedbackRequest) (models.Feedback, erro
 	}, nil
 }
 
+// FromProtoUpdateCorrelationRequest validates a status/annotation update. Annotations without a timestamp
+// are stamped with the current time.
+func FromProtoUpdateCorrelationRequest(req *rcav1.UpdateCorrelationRequest) (models.UpdateCorrelationRequest, error) {
+	if req == nil {
+		return models.UpdateCorrelationRequest{}, fmt.Errorf("request is nil")
+	}
+	if req.GetTenantId() == "" {
+		return models.UpdateCorrelationRequest{}, fmt.Errorf("tenant_id is required")
+	}
+	if req.GetCorrelationId() == "" {
+		return models.UpdateCorrelationRequest{}, fmt.Errorf("correlation_id is required")
+	}
+	out := models.UpdateCorrelationRequest{
+		TenantID:      req.GetTenantId(),
+		CorrelationID: req.GetCorrelationId(),
+		Status:        fromProtoStatus(req.GetStatus()),
+		Labels:        models.CopyLabels(req.GetLabels()),
+	}
+	now := time.Now().UTC()
+	for _, annotation := range req.GetAnnotations() {
+		if strings.TrimSpace(annotation.GetText()) == "" {
+			return models.UpdateCorrelationRequest{}, fmt.Errorf("annotation text is required")
+		}
+		createdAt := now
+		if annotation.GetCreatedAt() != nil {
+			createdAt = annotation.GetCreatedAt().AsTime()
+		}
+		out.Annotations = append(out.Annotations, models.Annotation{
+			Author:    annotation.GetAuthor(),
+			Text:      annotation.GetText(),
+			CreatedAt: createdAt,
+		})
+	}
+	if err := validateLabels(out.Labels); err != nil {
+		return models.UpdateCorrelationRequest{}, err
+	}
+	if out.Status == "" && len(out.Annotations) == 0 && len(out.Labels) == 0 {
+		return models.UpdateCorrelationRequest{}, fmt.Errorf("status, annotations, or labels are required")
+	}
+	return out, nil
+}
+
 // FromProtoListCorrelationsRequest maps the proto request into a domain request.
 func FromProtoListCorrelationsRequest(req *rcav1.ListCorrelationsRequest) (models.ListCorrelationsRequest, error) {
 	if req == nil {
@@ -135,6 +514,8 @@ func FromProtoListCorrelationsRequest(req *rcav1.ListCorrelationsRequest) (model
 		End:       end,
 		PageSize:  int(req.GetPageSize()),
 		PageToken: req.GetPageToken(),
+		Category:  fromProtoCategory(req.GetCategory()),
+		Labels:    models.CopyLabels(req.GetLabels()),
 	}, nil
 }
 
@@ -147,6 +528,145 @@ func ToProtoListCorrelationsResponse(resp models.ListCorrelationsResponse) *rcav
 	return proto
 }
 
+// FromProtoSearchCorrelationsRequest maps the proto search request into a domain request.
+func FromProtoSearchCorrelationsRequest(req *rcav1.SearchCorrelationsRequest) (models.SearchCorrelationsRequest, error) {
+	if req == nil {
+		return models.SearchCorrelationsRequest{}, fmt.Errorf("request is nil")
+	}
+	if strings.TrimSpace(req.GetQuery()) == "" {
+		return models.SearchCorrelationsRequest{}, fmt.Errorf("query is required")
+	}
+	if alpha := req.GetAlpha(); alpha < 0 || alpha > 1 {
+		return models.SearchCorrelationsRequest{}, fmt.Errorf("alpha must be between 0 and 1")
+	}
+	return models.SearchCorrelationsRequest{
+		TenantID: req.GetTenantId(),
+		Query:    req.GetQuery(),
+		Alpha:    req.GetAlpha(),
+		Limit:    int(req.GetLimit()),
+	}, nil
+}
+
+// ToProtoSearchCorrelationsResponse converts scored search hits into the proto response.
+func ToProtoSearchCorrelationsResponse(resp models.SearchCorrelationsResponse) *rcav1.SearchCorrelationsResponse {
+	proto := &rcav1.SearchCorrelationsResponse{}
+	for _, hit := range resp.Results {
+		proto.Results = append(proto.Results, &rcav1.ScoredCorrelation{
+			Correlation: ToProtoCorrelationResult(hit.Correlation),
+			Score:       hit.Score,
+		})
+	}
+	return proto
+}
+
+// FromProtoFeedbackStatsRequest maps the proto stats request into a domain request.
+func FromProtoFeedbackStatsRequest(req *rcav1.GetFeedbackStatsRequest) (models.FeedbackStatsRequest, error) {
+	if req == nil {
+		return models.FeedbackStatsRequest{}, fmt.Errorf("request is nil")
+	}
+	if req.GetBucketSeconds() < 0 {
+		return models.FeedbackStatsRequest{}, fmt.Errorf("bucket_seconds must not be negative")
+	}
+	domainReq := models.FeedbackStatsRequest{
+		TenantID: req.GetTenantId(),
+		Bucket:   time.Duration(req.GetBucketSeconds()) * time.Second,
+	}
+	if tr := req.GetTimeRange(); tr != nil {
+		if tr.Start != nil {
+			domainReq.Start = tr.Start.AsTime()
+		}
+		if tr.End != nil {
+			domainReq.End = tr.End.AsTime()
+		}
+	}
+	if !domainReq.Start.IsZero() && !domainReq.End.IsZero() && domainReq.End.Before(domainReq.Start) {
+		return models.FeedbackStatsRequest{}, fmt.Errorf("time_range end must not precede start")
+	}
+	return domainReq, nil
+}
+
+// ToProtoFeedbackStatsResponse converts aggregated feedback accuracy into the proto response.
+func ToProtoFeedbackStatsResponse(stats models.FeedbackStats) *rcav1.GetFeedbackStatsResponse {
+	proto := &rcav1.GetFeedbackStatsResponse{
+		Total:    int32(stats.Total),
+		Correct:  int32(stats.Correct),
+		Accuracy: stats.Accuracy,
+	}
+	for _, s := range stats.ByService {
+		proto.ByService = append(proto.ByService, toProtoAccuracyStat(s))
+	}
+	for _, s := range stats.ByCategory {
+		proto.ByCategory = append(proto.ByCategory, toProtoAccuracyStat(s))
+	}
+	for _, s := range stats.ByPattern {
+		proto.ByPattern = append(proto.ByPattern, toProtoAccuracyStat(s))
+	}
+	for _, b := range stats.ByBucket {
+		proto.ByBucket = append(proto.ByBucket, &rcav1.AccuracyBucket{
+			Start:    timestamppb.New(b.Start),
+			Total:    int32(b.Total),
+			Correct:  int32(b.Correct),
+			Accuracy: b.Accuracy,
+		})
+	}
+	return proto
+}
+
+// ToProtoThresholdRecommendations converts tuned thresholds; applied reports whether investigations use them.
+func ToProtoThresholdRecommendations(recs []models.ThresholdRecommendation, applied bool) *rcav1.GetThresholdRecommendationsResponse {
+	resp := &rcav1.GetThresholdRecommendationsResponse{Applied: applied}
+	for _, rec := range recs {
+		protoRec := &rcav1.ThresholdRecommendation{
+			Service:               rec.Service,
+			Threshold:             rec.Threshold,
+			Current:               rec.Current,
+			Basis:                 string(rec.Basis),
+			Correlations:          int32(rec.Correlations),
+			Feedback:              int32(rec.Feedback),
+			AnchorsPerCorrelation: rec.AnchorsPerCorrelation,
+			ExpectedAnchors:       rec.ExpectedAnchors,
+		}
+		if !rec.UpdatedAt.IsZero() {
+			protoRec.UpdatedAt = timestamppb.New(rec.UpdatedAt)
+		}
+		resp.Recommendations = append(resp.Recommendations, protoRec)
+	}
+	return resp
+}
+
+// ToProtoServiceGraph converts a service graph and its correlation overlay.
+func ToProtoServiceGraph(graph models.ServiceGraph) *rcav1.GetServiceGraphResponse {
+	resp := &rcav1.GetServiceGraphResponse{
+		TimeRange:     toProtoTimeRange(graph.TimeRange),
+		CorrelationId: graph.CorrelationID,
+	}
+	for _, node := range graph.Nodes {
+		resp.Nodes = append(resp.Nodes, &rcav1.ServiceGraphNode{
+			Service:      node.Service,
+			AnomalyScore: node.AnomalyScore,
+			AnchorCount:  int32(node.AnchorCount),
+			ImpactScore:  node.ImpactScore,
+			ImpactDepth:  int32(node.ImpactDepth),
+			RootCause:    node.RootCause,
+			Affected:     node.Affected,
+		})
+	}
+	for _, edge := range graph.Edges {
+		resp.Edges = append(resp.Edges, &rcav1.ServiceGraphEdge{
+			Source:    edge.Source,
+			Target:    edge.Target,
+			CallRate:  edge.CallRate,
+			ErrorRate: edge.ErrorRate,
+			Anomalous: edge.Anomalous,
+		})
+	}
+	return resp
+}
+
+func toProtoAccuracyStat(s models.AccuracyStat) *rcav1.AccuracyStat {
+	return &rcav1.AccuracyStat{Key: s.Key, Total: int32(s.Total), Correct: int32(s.Correct), Accuracy: s.Accuracy}
+}
+
 // ToProtoPatternsResponse maps failure patterns into the proto response.
 func ToProtoPatternsResponse(patterns []models.FailurePattern) *rcav1.GetPatternsResponse {
 	resp := &rcav1.GetPatternsResponse{}
@@ -178,3 +698,102 @@ func ToProtoPatternsResponse(patterns []models.FailurePattern) *rcav1.GetPattern
 	}
 	return resp
 }
+
+func toProtoRedAnchor(anchor models.RedAnchor) *rcav1.RedAnchor {
+	return &rcav1.RedAnchor{
+		Service:      anchor.Service,
+		Selector:     anchor.Selector,
+		DataType:     toProtoDataType(anchor.DataType),
+		Timestamp:    timestamppb.New(anchor.Timestamp),
+		AnomalyScore: anchor.AnomalyScore,
+		Threshold:    anchor.Threshold,
+		Evidence:     toProtoEvidence(anchor.Evidence),
+		Link:         anchor.Link,
+		Environment:  anchor.Environment,
+		Direction:    string(anchor.Direction),
+	}
+}
+
+func toProtoEvidence(ev models.Evidence) *rcav1.Evidence {
+	if len(ev.LogLines) == 0 && len(ev.MetricValues) == 0 && len(ev.TraceIDs) == 0 && len(ev.Exemplars) == 0 {
+		return nil
+	}
+	proto := &rcav1.Evidence{
+		LogLines: append([]string(nil), ev.LogLines...),
+		TraceIds: append([]string(nil), ev.TraceIDs...),
+	}
+	for _, sample := range ev.MetricValues {
+		proto.MetricValues = append(proto.MetricValues, &rcav1.MetricSample{
+			Timestamp: timestamppb.New(sample.Timestamp),
+			Value:     sample.Value,
+		})
+	}
+	for _, exemplar := range ev.Exemplars {
+		proto.Exemplars = append(proto.Exemplars, &rcav1.TraceExemplar{
+			TraceId:    exemplar.TraceID,
+			SpanId:     exemplar.SpanID,
+			Operation:  exemplar.Operation,
+			Status:     exemplar.Status,
+			DurationMs: float64(exemplar.Duration) / float64(time.Millisecond),
+			Timestamp:  timestamppb.New(exemplar.Timestamp),
+			Link:       exemplar.Link,
+		})
+	}
+	return proto
+}
+
+func toProtoCapacity(analysis *models.CapacityAnalysis) *rcav1.CapacityAnalysis {
+	if analysis == nil {
+		return nil
+	}
+	proto := &rcav1.CapacityAnalysis{
+		Service:          analysis.Service,
+		SaturationDriven: analysis.SaturationDriven,
+		Saturation:       analysis.Saturation,
+	}
+	for _, usage := range analysis.Resources {
+		resource := &rcav1.ResourceUsage{
+			Resource:                usage.Resource,
+			Peak:                    usage.Peak,
+			Latest:                  usage.Latest,
+			Headroom:                usage.Headroom,
+			ExtraCapacity:           usage.ExtraCapacity,
+			TimeToExhaustionSeconds: usage.TimeToExhaustion.Seconds(),
+		}
+		if usage.Saturated() {
+			resource.SaturatedAt = timestamppb.New(usage.SaturatedAt)
+		}
+		proto.Resources = append(proto.Resources, resource)
+	}
+	return proto
+}
+
+// FromProtoMaintenanceWindow converts a proto maintenance window into the domain type.
+func FromProtoMaintenanceWindow(w *rcav1.MaintenanceWindow) (models.MaintenanceWindow, error) {
+	if w == nil {
+		return models.MaintenanceWindow{}, fmt.Errorf("window is nil")
+	}
+	if w.GetStart() == nil || w.GetEnd() == nil {
+		return models.MaintenanceWindow{}, fmt.Errorf("start and end are required")
+	}
+	return models.MaintenanceWindow{
+		ID:       w.GetId(),
+		TenantID: w.GetTenantId(),
+		Services: append([]string(nil), w.GetServices()...),
+		Start:    w.GetStart().AsTime(),
+		End:      w.GetEnd().AsTime(),
+		Reason:   w.GetReason(),
+	}, nil
+}
+
+// ToProtoMaintenanceWindow converts a domain maintenance window into the proto shape.
+func ToProtoMaintenanceWindow(w models.MaintenanceWindow) *rcav1.MaintenanceWindow {
+	return &rcav1.MaintenanceWindow{
+		Id:       w.ID,
+		TenantId: w.TenantID,
+		Services: append([]string(nil), w.Services...),
+		Start:    timestamppb.New(w.Start),
+		End:      timestamppb.New(w.End),
+		Reason:   w.Reason,
+	}
+}
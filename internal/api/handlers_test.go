@@ -21,6 +21,11 @@ func TestFromProtoInvestigationRequest(t *testing.T) {
 		},
 		AffectedServices: []string{"checkout"},
 		TenantId:         "tenant",
+		Incident: &rcav1.IncidentMetadata{
+			Title:             "Checkout latency",
+			AlertFingerprints: []string{"a1b2", "c3d4"},
+			TicketUrl:         "https://tickets.example.com/INC-42",
+		},
 	}
 
 	domainReq, err := FromProtoInvestigationRequest(req)
@@ -30,6 +35,14 @@ func TestFromProtoInvestigationRequest(t *testing.T) {
 	if domainReq.IncidentID != "incident-1" {
 		t.Fatalf("unexpected incident id: %s", domainReq.IncidentID)
 	}
+	if domainReq.Incident.Title != "Checkout latency" || len(domainReq.Incident.AlertFingerprints) != 2 || domainReq.Incident.TicketURL != "https://tickets.example.com/INC-42" {
+		t.Fatalf("unexpected incident metadata: %+v", domainReq.Incident)
+	}
+
+	req.Incident.TicketUrl = "INC-42"
+	if _, err := FromProtoInvestigationRequest(req); err == nil {
+		t.Fatalf("expected relative ticket url to be rejected")
+	}
 }
 
 func TestToProtoCorrelationResult(t *testing.T) {
@@ -40,7 +53,7 @@ func TestToProtoCorrelationResult(t *testing.T) {
 		RootCause:        "checkout",
 		Confidence:       0.7,
 		AffectedServices: []string{"checkout"},
-		Recommendations:  []string{"Do thing"},
+		Recommendations:  []models.Recommendation{{Text: "Do thing", RuleID: "rule-1", Actions: []models.RecommendationAction{{Type: models.ActionRunbook, Label: "Runbook", URL: "https://runbooks.example.com/checkout"}}}},
 		RedAnchors: []models.RedAnchor{
 			{
 				Service:      "checkout",
@@ -49,6 +62,15 @@ func TestToProtoCorrelationResult(t *testing.T) {
 				Timestamp:    now,
 				AnomalyScore: 3,
 				Threshold:    2,
+				Direction:    models.DirectionUp,
+			},
+			{
+				Service:  "checkout",
+				Selector: "trace:HTTP POST",
+				DataType: models.DataTypeTraces,
+				Evidence: models.Evidence{Exemplars: []models.TraceExemplar{
+					{TraceID: "abc123", SpanID: "def456", Duration: 1500 * time.Millisecond, Link: "https://jaeger/trace/abc123"},
+				}},
 			},
 		},
 		Timeline: []models.TimelineEvent{
@@ -59,17 +81,108 @@ func TestToProtoCorrelationResult(t *testing.T) {
 				Severity:     models.SeverityHigh,
 				AnomalyScore: 3,
 				DataSource:   models.DataTypeMetrics,
+				Direction:    models.DirectionUp,
+			},
+			{
+				Time:       now,
+				Event:      "Log spike (error)",
+				DataSource: models.DataTypeLogs,
+				Start:      now,
+				End:        now.Add(12 * time.Minute),
+				Ongoing:    true,
 			},
 		},
 		CreatedAt: now,
+		Capacity: &models.CapacityAnalysis{
+			Service:          "checkout",
+			SaturationDriven: true,
+			Saturation:       0.9,
+			Resources: []models.ResourceUsage{
+				{Resource: "cpu", Peak: 0.97, SaturatedAt: now},
+				{Resource: "memory", Peak: 0.7, TimeToExhaustion: 40 * time.Minute},
+			},
+		},
+		ImpactScore: 0.8,
+		SLOImpacts:  []models.SLOImpact{{Service: "checkout", SLO: "availability", Objective: 0.999, BadFraction: 0.0115, BurnRate: 11.5, BudgetSpent: 0.004}},
 	}
 
 	proto := ToProtoCorrelationResult(res)
 	if proto.GetCorrelationId() != "corr-1" {
 		t.Fatalf("unexpected correlation id: %s", proto.GetCorrelationId())
 	}
-	if len(proto.GetRedAnchors()) != 1 {
-		t.Fatalf("expected 1 red anchor, got %d", len(proto.GetRedAnchors()))
+	if len(proto.GetRedAnchors()) != 2 {
+		t.Fatalf("expected 2 red anchors, got %d", len(proto.GetRedAnchors()))
+	}
+	if exemplars := proto.GetRedAnchors()[1].GetEvidence().GetExemplars(); len(exemplars) != 1 || exemplars[0].GetSpanId() != "def456" || exemplars[0].GetDurationMs() != 1500 || exemplars[0].GetLink() == "" {
+		t.Fatalf("unexpected exemplars: %+v", exemplars)
+	}
+	if proto.GetRedAnchors()[0].GetDirection() != "up" || proto.GetRedAnchors()[1].GetDirection() != "" {
+		t.Fatalf("expected only the metric anchor to carry a direction, got %+v", proto.GetRedAnchors())
+	}
+	if timeline := proto.GetTimeline(); len(timeline) == 0 || timeline[0].GetDirection() != "up" {
+		t.Fatalf("expected the metric event to carry its direction, got %+v", timeline[0])
+	}
+	if timeline := proto.GetTimeline(); len(timeline) != 2 || timeline[0].GetStart() != nil || timeline[1].GetEnd().AsTime().Sub(timeline[1].GetStart().AsTime()) != 12*time.Minute || !timeline[1].GetOngoing() {
+		t.Fatalf("expected an instant and an ongoing twelve-minute event, got %+v", timeline)
+	}
+	capacity := proto.GetCapacity()
+	if !capacity.GetSaturationDriven() || len(capacity.GetResources()) != 2 {
+		t.Fatalf("unexpected capacity: %+v", capacity)
+	}
+	if cpu, memory := capacity.GetResources()[0], capacity.GetResources()[1]; cpu.GetSaturatedAt() == nil || memory.GetSaturatedAt() != nil || memory.GetTimeToExhaustionSeconds() != 2400 {
+		t.Fatalf("unexpected resource usage: %+v %+v", cpu, memory)
+	}
+	if impacts := proto.GetSloImpacts(); proto.GetImpactScore() != 0.8 || len(impacts) != 1 || impacts[0].GetSlo() != "availability" || impacts[0].GetBurnRate() != 11.5 {
+		t.Fatalf("unexpected SLO impact: %f %+v", proto.GetImpactScore(), impacts)
+	}
+	if recs := proto.GetRecommendations(); len(recs) != 1 || recs[0] != "Do thing" {
+		t.Fatalf("unexpected recommendation texts: %v", recs)
+	}
+	details := proto.GetRecommendationDetails()
+	if len(details) != 1 || details[0].GetRuleId() != "rule-1" || len(details[0].GetActions()) != 1 {
+		t.Fatalf("unexpected recommendation details: %+v", details)
+	}
+	if action := details[0].GetActions()[0]; action.GetType() != rcav1.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_RUNBOOK || action.GetUrl() != "https://runbooks.example.com/checkout" {
+		t.Fatalf("unexpected action: %+v", action)
+	}
+}
+
+func TestToProtoCorrelationExplanation(t *testing.T) {
+	res := models.CorrelationResult{
+		CorrelationID:   "corr-1",
+		RootCause:       "db failover",
+		Recommendations: []models.Recommendation{{Text: "Fail back", RuleID: "rule-1"}, {Text: "Check replicas"}},
+		RedAnchors:      []models.RedAnchor{{Service: "db", DataType: models.DataTypeMetrics, AnomalyScore: 4, Threshold: 2}},
+	}
+
+	legacy := ToProtoCorrelationExplanation(res)
+	if legacy.GetRecorded() || len(legacy.GetAnchors()) != 1 {
+		t.Fatalf("expected an unrecorded explanation with anchors, got %+v", legacy)
+	}
+	if rules := legacy.GetMatchedRules(); len(rules) != 1 || rules[0].GetRuleId() != "rule-1" {
+		t.Fatalf("expected rules derived from recommendations, got %+v", rules)
+	}
+
+	res.Explanation = &models.Explanation{
+		Service:              "db",
+		Detectors:            []models.DetectorRun{{Name: "metrics_zscore", Anomalies: 2, Threshold: 2, TopScore: 4}},
+		Causality:            models.CausalityExplanation{Score: 0.8, SuggestedService: "db", Notes: []string{"db degraded first"}},
+		MaintenanceAnomalies: 1,
+		RecommendationSource: models.RecommendationsFromRules,
+		MatchedRules:         []models.MatchedRule{{RuleID: "rule-1", Reasons: []string{"service matched"}}},
+	}
+	recorded := ToProtoCorrelationExplanation(res)
+	if !recorded.GetRecorded() || recorded.GetRecommendationSource() != "rules" || recorded.GetMaintenanceAnomalies() != 1 {
+		t.Fatalf("unexpected explanation: %+v", recorded)
+	}
+	if detectors := recorded.GetDetectors(); len(detectors) != 1 || detectors[0].GetAnomalies() != 2 || detectors[0].GetTopScore() != 4 {
+		t.Fatalf("unexpected detectors: %+v", detectors)
+	}
+	if rules := recorded.GetMatchedRules(); len(rules) != 1 || len(rules[0].GetReasons()) != 1 {
+		t.Fatalf("expected recorded rule reasons, got %+v", rules)
+	}
+	if recorded.GetSuggestedService() != "db" || len(recorded.GetCausalityNotes()) != 1 {
+		t.Fatalf("unexpected causality: %+v", recorded)
 	}
 }
 
@@ -82,13 +195,14 @@ func TestFromProtoListCorrelationsRequest(t *testing.T) {
 		EndTime:   timestamppb.New(now.Add(time.Hour)),
 		PageSize:  25,
 		PageToken: "50",
+		Category:  rcav1.RootCauseCategory_ROOT_CAUSE_CATEGORY_NETWORK,
 	}
 
 	domainReq, err := FromProtoListCorrelationsRequest(req)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if domainReq.Service != "checkout" || domainReq.PageSize != 25 || domainReq.PageToken != "50" {
+	if domainReq.Service != "checkout" || domainReq.PageSize != 25 || domainReq.PageToken != "50" || domainReq.Category != models.CategoryNetwork {
 		t.Fatalf("unexpected domain request: %+v", domainReq)
 	}
 }
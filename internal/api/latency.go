@@ -0,0 +1,20 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/miradorstack/mirador-rca/internal/utils"
+)
+
+// latencyInterceptor times every unary RPC, failed ones included, into latencies keyed by full method name.
+func latencyInterceptor(latencies *utils.MethodLatencies) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		latencies.Observe(info.FullMethod, time.Since(start))
+		return resp, err
+	}
+}
@@ -7,13 +7,16 @@ import (
 	"time"
 
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/health"
+	grpchealth "google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/miradorstack/mirador-rca/internal/config"
 	rcav1 "github.com/miradorstack/mirador-rca/internal/grpc/generated"
+	"github.com/miradorstack/mirador-rca/internal/health"
+	"github.com/miradorstack/mirador-rca/internal/utils"
 )
 
 // Server wraps the gRPC server implementation and lifecycle helpers.
@@ -21,9 +24,12 @@ type Server struct {
 	cfg        config.ServerConfig
 	grpcServer *grpc.Server
 	listener   net.Listener
+	healthSrv  *grpchealth.Server
+	latencies  *utils.MethodLatencies
 }
 
-// NewServer constructs a gRPC server bound to the configured address.
+// NewServer constructs a gRPC server bound to the configured address. Every RPC opens a server span that
+// continues the caller's trace.
 func NewServer(cfg config.ServerConfig, service rcav1.RCAEngineServer, opts ...grpc.ServerOption) (*Server, error) {
 	lis, err := net.Listen("tcp", cfg.Address)
 	if err != nil {
@@ -31,8 +37,10 @@ func NewServer(cfg config.ServerConfig, service rcav1.RCAEngineServer, opts ...g
 	}
 
 	grpc_prometheus.EnableHandlingTimeHistogram()
+	latencies := utils.NewMethodLatencies(utils.DefaultLatencyWindow)
 	serverOpts := []grpc.ServerOption{
-		grpc.ChainUnaryInterceptor(grpc_prometheus.UnaryServerInterceptor),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(grpc_prometheus.UnaryServerInterceptor, latencyInterceptor(latencies), actorInterceptor),
 		grpc.ChainStreamInterceptor(grpc_prometheus.StreamServerInterceptor),
 	}
 	serverOpts = append(serverOpts, opts...)
@@ -41,9 +49,11 @@ func NewServer(cfg config.ServerConfig, service rcav1.RCAEngineServer, opts ...g
 	rcav1.RegisterRCAEngineServer(grpcServer, service)
 	grpc_prometheus.Register(grpcServer)
 
-	// Register health service so probes can hit /health via gRPC reflection tools.
-	healthSrv := health.NewServer()
-	healthSrv.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	// Register the standard health service; it reports NOT_SERVING until ReportHealth publishes the first
+	// round of dependency checks.
+	healthSrv := grpchealth.NewServer()
+	healthSrv.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	healthSrv.SetServingStatus(rcav1.RCAEngine_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
 	healthpb.RegisterHealthServer(grpcServer, healthSrv)
 
 	// Enable server reflection in development environments.
@@ -53,9 +63,37 @@ func NewServer(cfg config.ServerConfig, service rcav1.RCAEngineServer, opts ...g
 		cfg:        cfg,
 		grpcServer: grpcServer,
 		listener:   lis,
+		healthSrv:  healthSrv,
+		latencies:  latencies,
 	}, nil
 }
 
+// Latencies returns p50, p95, and p99 latency per RPC method over the last utils.DefaultLatencyWindow.
+func (s *Server) Latencies() map[string]utils.LatencySummary {
+	return s.latencies.Summary()
+}
+
+// ReportHealth publishes a readiness verdict through the gRPC health service. The overall status ("") and
+// the RCAEngine service follow ready; each dependency is also exposed under its check name (mirador_core,
+// weaviate, valkey) so probes can target one. Register it with health.Checker.OnUpdate.
+func (s *Server) ReportHealth(ready bool, results []health.Result) {
+	if s.healthSrv == nil {
+		return
+	}
+	s.healthSrv.SetServingStatus("", servingStatus(ready))
+	s.healthSrv.SetServingStatus(rcav1.RCAEngine_ServiceDesc.ServiceName, servingStatus(ready))
+	for _, result := range results {
+		s.healthSrv.SetServingStatus(result.Name, servingStatus(result.Healthy))
+	}
+}
+
+func servingStatus(serving bool) healthpb.HealthCheckResponse_ServingStatus {
+	if serving {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
+
 // Start serves incoming gRPC requests until Stop/Shutdown is invoked.
 func (s *Server) Start() error {
 	if s.grpcServer == nil || s.listener == nil {
@@ -69,6 +107,8 @@ func (s *Server) Shutdown(ctx context.Context) {
 	if s.grpcServer == nil {
 		return
 	}
+	// Watchers see NOT_SERVING before the listener closes; later ReportHealth calls are ignored.
+	s.healthSrv.Shutdown()
 
 	stopped := make(chan struct{})
 	go func() {
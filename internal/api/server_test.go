@@ -0,0 +1,75 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/miradorstack/mirador-rca/internal/config"
+	rcav1 "github.com/miradorstack/mirador-rca/internal/grpc/generated"
+	"github.com/miradorstack/mirador-rca/internal/health"
+)
+
+func TestServerReportHealth(t *testing.T) {
+	server, err := NewServer(config.ServerConfig{Address: "127.0.0.1:0"}, rcav1.UnimplementedRCAEngineServer{})
+	if err != nil {
+		t.Fatalf("new server: %v", err)
+	}
+	defer server.Shutdown(context.Background())
+
+	status := func(service string) healthpb.HealthCheckResponse_ServingStatus {
+		t.Helper()
+		resp, err := server.healthSrv.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		if err != nil {
+			t.Fatalf("check %q: %v", service, err)
+		}
+		return resp.Status
+	}
+
+	if status("") != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("expected NOT_SERVING before the first report")
+	}
+
+	server.ReportHealth(true, []health.Result{
+		{Name: "mirador_core", Required: true, Healthy: true},
+		{Name: "valkey", Healthy: false},
+	})
+	if status("") != healthpb.HealthCheckResponse_SERVING || status(rcav1.RCAEngine_ServiceDesc.ServiceName) != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("expected the engine to serve while its required dependencies are healthy")
+	}
+	if status("mirador_core") != healthpb.HealthCheckResponse_SERVING || status("valkey") != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("expected per-dependency statuses to follow their checks")
+	}
+
+	server.ReportHealth(false, []health.Result{{Name: "mirador_core", Required: true}})
+	if status("") != healthpb.HealthCheckResponse_NOT_SERVING || status("mirador_core") != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("expected a failing required dependency to flip the engine to NOT_SERVING")
+	}
+}
+
+func TestServerTracksMethodLatencies(t *testing.T) {
+	server, err := NewServer(config.ServerConfig{Address: "127.0.0.1:0"}, rcav1.UnimplementedRCAEngineServer{})
+	if err != nil {
+		t.Fatalf("new server: %v", err)
+	}
+	go server.Start()
+	defer server.Shutdown(context.Background())
+
+	conn, err := grpc.NewClient(server.Address(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close()
+	client := rcav1.NewRCAEngineClient(conn)
+	for i := 0; i < 3; i++ {
+		_, _ = client.GetVersion(context.Background(), &rcav1.GetVersionRequest{})
+	}
+
+	latencies := server.Latencies()
+	if got := latencies[rcav1.RCAEngine_GetVersion_FullMethodName]; got.Count != 3 || got.MaxMs <= 0 {
+		t.Fatalf("expected three timed GetVersion calls, got %+v", latencies)
+	}
+}
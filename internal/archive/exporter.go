@@ -0,0 +1,192 @@
+package archive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// CorrelationLister pages through stored correlations.
+type CorrelationLister interface {
+	ListCorrelations(ctx context.Context, req models.ListCorrelationsRequest) (models.ListCorrelationsResponse, error)
+}
+
+// Exporter periodically copies newly stored correlations to object storage as gzip-compressed NDJSON, one
+// object per tenant and run, for audit retention beyond the history store.
+type Exporter struct {
+	logger   *slog.Logger
+	lister   CorrelationLister
+	store    ObjectStore
+	prefix   string
+	interval time.Duration
+	tenants  []string
+	// watermarks caches the exclusive end of the last exported window per tenant. Each is also written to a
+	// marker object next to the tenant's archives, so a restarted exporter resumes where it stopped.
+	watermarks map[string]time.Time
+	now        func() time.Time
+}
+
+// NewExporter constructs an exporter for the listed tenants. A non-positive interval defaults to one hour.
+func NewExporter(logger *slog.Logger, lister CorrelationLister, store ObjectStore, prefix string, interval time.Duration, tenants []string) *Exporter {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	return &Exporter{
+		logger:     logger,
+		lister:     lister,
+		store:      store,
+		prefix:     prefix,
+		interval:   interval,
+		tenants:    tenants,
+		watermarks: make(map[string]time.Time, len(tenants)),
+		now:        time.Now,
+	}
+}
+
+// Run exports every interval until ctx is cancelled.
+func (e *Exporter) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := e.RunOnce(ctx); err != nil {
+			e.logger.Warn("correlation export failed", slog.Any("error", err))
+		}
+	}
+}
+
+// RunOnce exports each tenant's correlations created since its watermark. A tenant's watermark only advances
+// after a successful upload, so failed windows are retried on the next run. A tenant without a stored
+// watermark starts one interval back.
+func (e *Exporter) RunOnce(ctx context.Context) error {
+	if e == nil || e.lister == nil || e.store == nil {
+		return nil
+	}
+	end := e.now().UTC().Truncate(time.Second)
+
+	var errs []error
+	for _, tenantID := range e.tenants {
+		start, ok, err := e.watermark(ctx, tenantID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("tenant %s: %w", tenantID, err))
+			continue
+		}
+		if !ok {
+			start = end.Add(-e.interval)
+		}
+		if !end.After(start) {
+			continue
+		}
+		count, err := e.exportTenant(ctx, tenantID, start, end)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("tenant %s: %w", tenantID, err))
+			continue
+		}
+		e.watermarks[tenantID] = end
+		if err := e.store.PutObject(ctx, watermarkKey(e.prefix, tenantID), []byte(end.Format(time.RFC3339)), "text/plain", ""); err != nil {
+			errs = append(errs, fmt.Errorf("tenant %s: store watermark: %w", tenantID, err))
+		}
+		if count > 0 {
+			e.logger.Info("correlations exported",
+				slog.String("tenant_id", tenantID),
+				slog.Time("start", start),
+				slog.Time("end", end),
+				slog.Int("correlations", count),
+			)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// watermark returns the tenant's cached watermark, loading it from its marker object on first use. ok is
+// false when the tenant has never been exported.
+func (e *Exporter) watermark(ctx context.Context, tenantID string) (time.Time, bool, error) {
+	if mark, ok := e.watermarks[tenantID]; ok {
+		return mark, true, nil
+	}
+	data, err := e.store.GetObject(ctx, watermarkKey(e.prefix, tenantID))
+	if errors.Is(err, ErrObjectNotFound) {
+		return time.Time{}, false, nil
+	}
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("load watermark: %w", err)
+	}
+	mark, err := time.Parse(time.RFC3339, strings.TrimSpace(string(data)))
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("parse watermark: %w", err)
+	}
+	e.watermarks[tenantID] = mark
+	return mark, true, nil
+}
+
+func (e *Exporter) exportTenant(ctx context.Context, tenantID string, start, end time.Time) (int, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	encoder := json.NewEncoder(gz)
+
+	count := 0
+	pageToken := ""
+	for {
+		resp, err := e.lister.ListCorrelations(ctx, models.ListCorrelationsRequest{
+			TenantID:  tenantID,
+			Start:     start,
+			End:       end.Add(-time.Second),
+			PageSize:  100,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return 0, err
+		}
+		for _, correlation := range resp.Correlations {
+			if correlation.CreatedAt.Before(start) || !correlation.CreatedAt.Before(end) {
+				continue
+			}
+			if err := encoder.Encode(correlation); err != nil {
+				return 0, fmt.Errorf("encode correlation: %w", err)
+			}
+			count++
+		}
+		if resp.NextPageToken == "" || resp.NextPageToken == pageToken {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+	if err := gz.Close(); err != nil {
+		return 0, err
+	}
+	if count == 0 {
+		return 0, nil
+	}
+	if err := e.store.PutObject(ctx, objectKey(e.prefix, tenantID, start, end), buf.Bytes(), "application/x-ndjson", "gzip"); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// objectKey lays archives out as <prefix>/<tenant>/YYYY/MM/DD/correlations-<start>-<end>.ndjson.gz so buckets
+// can be lifecycle-managed and queried by date.
+func objectKey(prefix, tenantID string, start, end time.Time) string {
+	name := fmt.Sprintf("correlations-%s-%s.ndjson.gz", start.Format("20060102T150405Z"), end.Format("20060102T150405Z"))
+	return path.Join(prefix, tenantID, start.Format("2006/01/02"), name)
+}
+
+// watermarkKey is the marker object holding the end of a tenant's last exported window.
+func watermarkKey(prefix, tenantID string) string {
+	return path.Join(prefix, tenantID, "watermark")
+}
@@ -0,0 +1,178 @@
+package archive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+type stubLister struct {
+	correlations []models.CorrelationResult
+	requests     []models.ListCorrelationsRequest
+}
+
+func (s *stubLister) ListCorrelations(ctx context.Context, req models.ListCorrelationsRequest) (models.ListCorrelationsResponse, error) {
+	s.requests = append(s.requests, req)
+	if req.PageToken == "" {
+		return models.ListCorrelationsResponse{Correlations: s.correlations[:1], NextPageToken: "1"}, nil
+	}
+	return models.ListCorrelationsResponse{Correlations: s.correlations[1:]}, nil
+}
+
+type memoryObjectStore struct {
+	objects map[string][]byte
+	err     error
+}
+
+func (m *memoryObjectStore) PutObject(ctx context.Context, key string, body []byte, contentType, contentEncoding string) error {
+	if m.err != nil {
+		return m.err
+	}
+	if contentType == "application/x-ndjson" && contentEncoding != "gzip" {
+		return errors.New("expected gzip encoding")
+	}
+	m.objects[key] = body
+	return nil
+}
+
+func (m *memoryObjectStore) GetObject(ctx context.Context, key string) ([]byte, error) {
+	body, ok := m.objects[key]
+	if !ok {
+		return nil, ErrObjectNotFound
+	}
+	return body, nil
+}
+
+func TestExporterRunOnceWritesNDJSON(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	lister := &stubLister{correlations: []models.CorrelationResult{
+		{CorrelationID: "c-1", CreatedAt: now.Add(-10 * time.Minute)},
+		{CorrelationID: "c-2", CreatedAt: now.Add(-5 * time.Minute)},
+	}}
+	store := &memoryObjectStore{objects: map[string][]byte{}, err: errors.New("bucket unavailable")}
+	exporter := NewExporter(nil, lister, store, "archive", time.Hour, []string{"acme"})
+	exporter.now = func() time.Time { return now }
+
+	if err := exporter.RunOnce(context.Background()); err == nil {
+		t.Fatalf("expected upload failure to surface")
+	}
+	if _, ok := exporter.watermarks["acme"]; ok {
+		t.Fatalf("watermark must not advance after a failed upload")
+	}
+
+	store.err = nil
+	if err := exporter.RunOnce(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	key := "archive/acme/2024/06/01/correlations-20240601T110000Z-20240601T120000Z.ndjson.gz"
+	body, ok := store.objects[key]
+	if !ok {
+		t.Fatalf("expected object %s, got %v", key, store.objects)
+	}
+	gz, err := gzip.NewReader(bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("gzip: %v", err)
+	}
+	raw, _ := io.ReadAll(gz)
+	lines := strings.Split(strings.TrimSpace(string(raw)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected two NDJSON lines, got %q", raw)
+	}
+	var first models.CorrelationResult
+	if err := json.Unmarshal([]byte(lines[0]), &first); err != nil || first.CorrelationID != "c-1" {
+		t.Fatalf("unexpected first line %q (%v)", lines[0], err)
+	}
+	if !exporter.watermarks["acme"].Equal(now) {
+		t.Fatalf("expected watermark to advance to %s, got %s", now, exporter.watermarks["acme"])
+	}
+}
+
+func TestExporterResumesFromStoredWatermark(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	store := &memoryObjectStore{objects: map[string][]byte{}}
+	lister := &stubLister{correlations: []models.CorrelationResult{
+		{CorrelationID: "c-1", CreatedAt: now.Add(-10 * time.Minute)},
+		{CorrelationID: "c-2", CreatedAt: now.Add(-5 * time.Minute)},
+	}}
+	exporter := NewExporter(nil, lister, store, "archive", time.Hour, []string{"acme"})
+	exporter.now = func() time.Time { return now }
+	if err := exporter.RunOnce(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := string(store.objects["archive/acme/watermark"]); got != "2024-06-01T12:00:00Z" {
+		t.Fatalf("expected the watermark marker to be stored, got %q", got)
+	}
+
+	// A restarted exporter that was down for three intervals picks up at the stored watermark rather than
+	// one interval back.
+	later := now.Add(3 * time.Hour)
+	restarted := NewExporter(nil, &stubLister{correlations: lister.correlations}, store, "archive", time.Hour, []string{"acme"})
+	restarted.now = func() time.Time { return later }
+	if err := restarted.RunOnce(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	req := restarted.lister.(*stubLister).requests[0]
+	if !req.Start.Equal(now) {
+		t.Fatalf("expected the export to resume at %s, got %s", now, req.Start)
+	}
+	if got := string(store.objects["archive/acme/watermark"]); got != "2024-06-01T15:00:00Z" {
+		t.Fatalf("expected the marker to advance, got %q", got)
+	}
+}
+
+func TestS3StoreGetObjectNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/audit/rca/acme/watermark" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	store, err := NewS3Store(S3Config{Endpoint: server.URL, Bucket: "audit", PathStyle: true}, time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := store.GetObject(context.Background(), "rca/acme/watermark"); !errors.Is(err, ErrObjectNotFound) {
+		t.Fatalf("expected ErrObjectNotFound, got %v", err)
+	}
+}
+
+func TestS3StorePutObjectSigned(t *testing.T) {
+	var got *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	store, err := NewS3Store(S3Config{Endpoint: server.URL, Region: "eu-west-1", Bucket: "audit", AccessKeyID: "AKID", SecretAccessKey: "secret", PathStyle: true}, time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	store.now = func() time.Time { return time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC) }
+
+	if err := store.PutObject(context.Background(), "rca/tenant=acme/a.ndjson.gz", []byte("{}"), "application/x-ndjson", "gzip"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Method != http.MethodPut || got.URL.EscapedPath() != "/audit/rca/tenant%3Dacme/a.ndjson.gz" {
+		t.Fatalf("unexpected request: %s %s", got.Method, got.URL.EscapedPath())
+	}
+	auth := got.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKID/20240601/eu-west-1/s3/aws4_request, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=") {
+		t.Fatalf("unexpected authorization header: %s", auth)
+	}
+	if got.Header.Get("X-Amz-Content-Sha256") != sha256Hex([]byte("{}")) || got.Header.Get("Content-Encoding") != "gzip" {
+		t.Fatalf("unexpected headers: %v", got.Header)
+	}
+}
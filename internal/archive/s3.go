@@ -0,0 +1,227 @@
+package archive
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ErrObjectNotFound is returned by GetObject when the key does not exist.
+var ErrObjectNotFound = errors.New("object not found")
+
+// ObjectStore reads and writes archive objects in a bucket.
+type ObjectStore interface {
+	PutObject(ctx context.Context, key string, body []byte, contentType, contentEncoding string) error
+	GetObject(ctx context.Context, key string) ([]byte, error)
+}
+
+// S3Config identifies an S3-compatible bucket. Endpoint defaults to AWS for Region; PathStyle addresses the
+// bucket in the URL path, which MinIO and most self-hosted gateways require.
+type S3Config struct {
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	PathStyle       bool
+}
+
+// S3Store uploads objects with SigV4-signed PUT requests.
+type S3Store struct {
+	cfg        S3Config
+	endpoint   *url.URL
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+// NewS3Store builds an S3 object store.
+func NewS3Store(cfg S3Config, timeout time.Duration) (*S3Store, error) {
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("s3 bucket is required")
+	}
+	if cfg.Region == "" {
+		cfg.Region = "us-east-1"
+	}
+	if cfg.Endpoint == "" {
+		cfg.Endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", cfg.Region)
+	}
+	endpoint, err := url.Parse(strings.TrimRight(cfg.Endpoint, "/"))
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint %q", cfg.Endpoint)
+	}
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+	return &S3Store{
+		cfg:        cfg,
+		endpoint:   endpoint,
+		httpClient: &http.Client{Timeout: timeout},
+		now:        time.Now,
+	}, nil
+}
+
+// NewGCSStore builds a store for Google Cloud Storage through its S3-compatible XML API, authenticated with
+// an HMAC key pair.
+func NewGCSStore(bucket, accessKeyID, secretAccessKey string, timeout time.Duration) (*S3Store, error) {
+	return NewS3Store(S3Config{
+		Endpoint:        "https://storage.googleapis.com",
+		Region:          "auto",
+		Bucket:          bucket,
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: secretAccessKey,
+		PathStyle:       true,
+	}, timeout)
+}
+
+// PutObject uploads body under key.
+func (s *S3Store) PutObject(ctx context.Context, key string, body []byte, contentType, contentEncoding string) error {
+	key = strings.TrimLeft(key, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if contentEncoding != "" {
+		req.Header.Set("Content-Encoding", contentEncoding)
+	}
+	s.sign(req, body)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("put object %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("put object %s: %s: %s", key, resp.Status, strings.TrimSpace(string(data)))
+	}
+	return nil
+}
+
+// GetObject downloads the object under key, returning ErrObjectNotFound when it does not exist.
+func (s *S3Store) GetObject(ctx context.Context, key string) ([]byte, error) {
+	key = strings.TrimLeft(key, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(key), nil)
+	if err != nil {
+		return nil, err
+	}
+	s.sign(req, nil)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("get object %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrObjectNotFound
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return nil, fmt.Errorf("get object %s: %s: %s", key, resp.Status, strings.TrimSpace(string(data)))
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// objectURL addresses key in the bucket, path- or virtual-host-style as configured.
+func (s *S3Store) objectURL(key string) string {
+	target := *s.endpoint
+	prefix := "/"
+	if s.cfg.PathStyle {
+		prefix = "/" + s.cfg.Bucket + "/"
+	} else {
+		target.Host = s.cfg.Bucket + "." + target.Host
+	}
+	target.Path = prefix + key
+	target.RawPath = prefix + escapeObjectKey(key)
+	return target.String()
+}
+
+// sign applies AWS Signature Version 4 headers. Anonymous stores (no access key) are left unsigned.
+func (s *S3Store) sign(req *http.Request, body []byte) {
+	if s.cfg.AccessKeyID == "" {
+		return
+	}
+	now := s.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	day := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if s.cfg.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.cfg.SessionToken)
+	}
+
+	signed := []string{"host", "x-amz-content-sha256", "x-amz-date"}
+	if s.cfg.SessionToken != "" {
+		signed = append(signed, "x-amz-security-token")
+	}
+	var canonicalHeaders strings.Builder
+	for _, name := range signed {
+		value := req.Header.Get(name)
+		if name == "host" {
+			value = req.URL.Host
+		}
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(value) + "\n")
+	}
+	signedHeaders := strings.Join(signed, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := day + "/" + s.cfg.Region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.cfg.SecretAccessKey), day)
+	key = hmacSHA256(key, s.cfg.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.cfg.AccessKeyID, scope, signedHeaders, signature))
+}
+
+// escapeObjectKey percent-encodes everything but unreserved characters and "/" as SigV4 canonical URIs
+// require.
+func escapeObjectKey(key string) string {
+	var b strings.Builder
+	for _, c := range []byte(key) {
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '.', c == '_', c == '~', c == '/':
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,193 @@
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/metrics"
+)
+
+// Audited actions.
+const (
+	ActionInvestigate             = "investigate"
+	ActionSubmitFeedback          = "submit_feedback"
+	ActionUpdateCorrelation       = "update_correlation"
+	ActionPurgeTenantData         = "purge_tenant_data"
+	ActionMinePatterns            = "mine_patterns"
+	ActionCreateMaintenanceWindow = "create_maintenance_window"
+	ActionDeleteMaintenanceWindow = "delete_maintenance_window"
+)
+
+const (
+	// OutcomeSuccess and OutcomeError are the values of Record.Outcome.
+	OutcomeSuccess = "success"
+	OutcomeError   = "error"
+
+	// DefaultBufferSize is the number of records a Logger queues when no size is configured.
+	DefaultBufferSize = 1024
+	// maxBatch caps the records handed to a sink in one write.
+	maxBatch = 100
+)
+
+// Record is one audit entry. Fields that do not apply to an action are left empty.
+type Record struct {
+	Time   time.Time `json:"time"`
+	Action string    `json:"action"`
+	// Actor identifies who triggered the action: the gRPC caller, or the webhook source, Kafka topic, or
+	// watch mode for investigations started by the engine itself.
+	Actor    string `json:"actor,omitempty"`
+	TenantID string `json:"tenant_id,omitempty"`
+	// RequestHash is the SHA-256 of the request, so repeated submissions can be matched without storing them.
+	RequestHash string  `json:"request_hash,omitempty"`
+	ResultID    string  `json:"result_id,omitempty"`
+	Confidence  float64 `json:"confidence,omitempty"`
+	DurationMs  float64 `json:"duration_ms"`
+	// Sources lists the signal sources an investigation was able to use.
+	Sources []string          `json:"sources,omitempty"`
+	Outcome string            `json:"outcome"`
+	Error   string            `json:"error,omitempty"`
+	Details map[string]string `json:"details,omitempty"`
+}
+
+// Finish stamps the record with the time elapsed since start and the outcome of err.
+func (r *Record) Finish(start time.Time, err error) {
+	r.DurationMs = float64(time.Since(start).Microseconds()) / 1000
+	r.Outcome = OutcomeSuccess
+	if err != nil {
+		r.Outcome = OutcomeError
+		r.Error = err.Error()
+	}
+}
+
+// Hash returns the hex SHA-256 of v's JSON encoding, or "" when v cannot be encoded.
+func Hash(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+type actorKey struct{}
+
+// WithActor tags ctx with the actor recorded for audited actions made under it.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// Actor returns the actor set by WithActor, or "".
+func Actor(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
+
+// Sink persists batches of audit records.
+type Sink interface {
+	Write(ctx context.Context, records []Record) error
+	Close() error
+}
+
+// Logger queues audit records and writes them to a sink in the background so audited calls never wait on
+// it. When the queue is full, records are dropped and counted rather than blocking the caller. A nil Logger
+// discards records.
+type Logger struct {
+	logger *slog.Logger
+	sink   Sink
+	queue  chan Record
+	done   chan struct{}
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+// NewLogger starts a logger writing to sink with room for bufferSize queued records; zero uses
+// DefaultBufferSize.
+func NewLogger(logger *slog.Logger, sink Sink, bufferSize int) *Logger {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+	l := &Logger{logger: logger, sink: sink, queue: make(chan Record, bufferSize), done: make(chan struct{})}
+	go l.run()
+	return l
+}
+
+// Record queues rec, stamping its time and, when unset, the actor from ctx.
+func (l *Logger) Record(ctx context.Context, rec Record) {
+	if l == nil {
+		return
+	}
+	if rec.Time.IsZero() {
+		rec.Time = time.Now().UTC()
+	}
+	if rec.Actor == "" {
+		rec.Actor = Actor(ctx)
+	}
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if l.closed {
+		metrics.ObserveAuditRecords(metrics.AuditDropped, 1)
+		return
+	}
+	select {
+	case l.queue <- rec:
+	default:
+		metrics.ObserveAuditRecords(metrics.AuditDropped, 1)
+		l.logger.Warn("audit queue full; record dropped", slog.String("action", rec.Action), slog.String("tenant_id", rec.TenantID))
+	}
+}
+
+// Close stops accepting records, writes those still queued, and closes the sink. It gives up waiting when
+// ctx is done.
+func (l *Logger) Close(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	if !l.closed {
+		l.closed = true
+		close(l.queue)
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-l.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return l.sink.Close()
+}
+
+func (l *Logger) run() {
+	defer close(l.done)
+	for rec := range l.queue {
+		batch := []Record{rec}
+	fill:
+		for len(batch) < maxBatch {
+			select {
+			case next, ok := <-l.queue:
+				if !ok {
+					break fill
+				}
+				batch = append(batch, next)
+			default:
+				break fill
+			}
+		}
+		if err := l.sink.Write(context.Background(), batch); err != nil {
+			metrics.ObserveAuditRecords(metrics.OutcomeError, len(batch))
+			l.logger.Error("failed to write audit records", slog.Int("records", len(batch)), slog.Any("error", err))
+			continue
+		}
+		metrics.ObserveAuditRecords(metrics.AuditWritten, len(batch))
+	}
+}
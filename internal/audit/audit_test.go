@@ -0,0 +1,91 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoggerWritesToFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := NewFileSink(path)
+	if err != nil {
+		t.Fatalf("new file sink: %v", err)
+	}
+	logger := NewLogger(nil, sink, 0)
+
+	ctx := WithActor(context.Background(), "grpc:oncall@example.com")
+	start := time.Now()
+	rec := Record{Action: ActionInvestigate, TenantID: "acme", RequestHash: Hash(map[string]string{"incident": "INC-1"}), ResultID: "corr-1", Confidence: 0.8, Sources: []string{"metrics", "logs"}}
+	rec.Finish(start, nil)
+	logger.Record(ctx, rec)
+	failed := Record{Action: ActionSubmitFeedback, TenantID: "acme"}
+	failed.Finish(start, errors.New("store unavailable"))
+	logger.Record(context.Background(), failed)
+
+	if err := logger.Close(context.Background()); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	logger.Record(ctx, rec) // after Close: dropped, must not panic
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open audit log: %v", err)
+	}
+	defer file.Close()
+	var records []Record
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var got Record
+		if err := json.Unmarshal(scanner.Bytes(), &got); err != nil {
+			t.Fatalf("decode line %q: %v", scanner.Text(), err)
+		}
+		records = append(records, got)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected two records, got %d", len(records))
+	}
+	if got := records[0]; got.Actor != "grpc:oncall@example.com" || got.Outcome != OutcomeSuccess || got.ResultID != "corr-1" || len(got.RequestHash) != 64 || got.Time.IsZero() {
+		t.Fatalf("unexpected investigation record: %+v", got)
+	}
+	if got := records[1]; got.Outcome != OutcomeError || got.Error != "store unavailable" || got.Actor != "" {
+		t.Fatalf("unexpected feedback record: %+v", got)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0o600 {
+		t.Fatalf("expected an owner-only audit log, got %v (%v)", info.Mode(), err)
+	}
+}
+
+func TestHTTPSink(t *testing.T) {
+	var lines []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer audit" || r.Header.Get("Content-Type") != "application/x-ndjson" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		lines = strings.Split(strings.TrimSpace(string(body)), "\n")
+	}))
+	defer server.Close()
+
+	sink := NewHTTPSink(server.URL, map[string]string{"Authorization": "Bearer audit"}, time.Second)
+	if err := sink.Write(context.Background(), []Record{{Action: ActionPurgeTenantData}, {Action: ActionMinePatterns}}); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if len(lines) != 2 || !strings.Contains(lines[0], `"action":"purge_tenant_data"`) {
+		t.Fatalf("unexpected body: %q", lines)
+	}
+
+	if err := NewHTTPSink(server.URL, nil, time.Second).Write(context.Background(), []Record{{Action: ActionMinePatterns}}); err == nil {
+		t.Fatalf("expected a rejected batch to return an error")
+	}
+}
@@ -0,0 +1,106 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// encode renders records as newline-delimited JSON.
+func encode(records []Record) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, rec := range records {
+		if err := enc.Encode(rec); err != nil {
+			return nil, fmt.Errorf("encode audit record: %w", err)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// FileSink appends records as JSON lines to a local file, which is created with owner-only permissions if
+// missing. Rotation is left to the platform (logrotate with copytruncate, or a sidecar shipper).
+type FileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFileSink opens path for appending.
+func NewFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("open audit log: %w", err)
+	}
+	return &FileSink{file: file}, nil
+}
+
+// Write implements Sink.
+func (s *FileSink) Write(_ context.Context, records []Record) error {
+	data, err := encode(records)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.file.Write(data)
+	return err
+}
+
+// Close implements Sink.
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
+
+// HTTPSink posts each batch of records as newline-delimited JSON to an HTTP endpoint, for log collectors
+// such as Vector or Fluent Bit.
+type HTTPSink struct {
+	url        string
+	headers    map[string]string
+	httpClient *http.Client
+}
+
+// NewHTTPSink builds a sink posting to url with the given extra headers, e.g. for authentication.
+func NewHTTPSink(url string, headers map[string]string, timeout time.Duration) *HTTPSink {
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	return &HTTPSink{url: url, headers: headers, httpClient: &http.Client{Timeout: timeout}}
+}
+
+// Write implements Sink.
+func (s *HTTPSink) Write(ctx context.Context, records []Record) error {
+	body, err := encode(records)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	for key, value := range s.headers {
+		req.Header.Set(key, value)
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("audit sink: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("audit sink returned %s: %s", resp.Status, strings.TrimSpace(string(data)))
+	}
+	return nil
+}
+
+// Close implements Sink.
+func (s *HTTPSink) Close() error { return nil }
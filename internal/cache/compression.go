@@ -0,0 +1,178 @@
+package cache
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/klauspost/compress/s2"
+)
+
+// Compression codecs accepted by NewCompressingProvider.
+const (
+	CompressionNone   = "none"
+	CompressionSnappy = "snappy"
+	CompressionGzip   = "gzip"
+)
+
+// compressedMagic prefixes compressed values and is followed by one codec byte. Plain JSON and the short
+// lock tokens stored elsewhere never start with a NUL byte, so uncompressed values pass through unchanged.
+var compressedMagic = []byte("\x00mrc")
+
+const (
+	codecSnappy byte = 's'
+	codecGzip   byte = 'g'
+)
+
+// DefaultCompressionThreshold is the payload size from which values are compressed.
+const DefaultCompressionThreshold = 16 * 1024
+
+// CompressingProvider compresses values of at least threshold bytes before they reach next and flags them,
+// so reads decompress flagged values and return everything else as stored. Reads understand both codecs
+// whatever the write codec is, which keeps mixed-version replicas and codec changes safe.
+type CompressingProvider struct {
+	next      Provider
+	codec     byte
+	threshold int
+}
+
+// NewCompressingProvider wraps next. codec is "snappy", "gzip", or "none"/"" to only decode flagged values;
+// a non-positive threshold uses DefaultCompressionThreshold.
+func NewCompressingProvider(next Provider, codec string, threshold int) (*CompressingProvider, error) {
+	p := &CompressingProvider{next: next, threshold: threshold}
+	switch codec {
+	case "", CompressionNone:
+	case CompressionSnappy:
+		p.codec = codecSnappy
+	case CompressionGzip:
+		p.codec = codecGzip
+	default:
+		return nil, fmt.Errorf("unsupported cache compression %q (want none, snappy, or gzip)", codec)
+	}
+	if p.threshold <= 0 {
+		p.threshold = DefaultCompressionThreshold
+	}
+	return p, nil
+}
+
+// Get fetches a key and decompresses it when flagged.
+func (p *CompressingProvider) Get(ctx context.Context, key string) ([]byte, error) {
+	value, err := p.next.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return decompress(value)
+}
+
+// Set compresses large values before storing them.
+func (p *CompressingProvider) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	encoded, err := p.compress(value)
+	if err != nil {
+		return err
+	}
+	return p.next.Set(ctx, key, encoded, ttl)
+}
+
+// SetNX compresses large values before storing them if the key is absent.
+func (p *CompressingProvider) SetNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	encoded, err := p.compress(value)
+	if err != nil {
+		return false, err
+	}
+	return p.next.SetNX(ctx, key, encoded, ttl)
+}
+
+// MGet fetches several keys; a value that fails to decompress is reported as missing.
+func (p *CompressingProvider) MGet(ctx context.Context, keys ...string) (map[string][]byte, error) {
+	values, err := p.next.MGet(ctx, keys...)
+	for key, value := range values {
+		decoded, decodeErr := decompress(value)
+		if decodeErr != nil {
+			delete(values, key)
+			continue
+		}
+		values[key] = decoded
+	}
+	return values, err
+}
+
+// MSet compresses large values before storing them.
+func (p *CompressingProvider) MSet(ctx context.Context, values map[string][]byte, ttl time.Duration) error {
+	encoded := make(map[string][]byte, len(values))
+	for key, value := range values {
+		compressed, err := p.compress(value)
+		if err != nil {
+			return err
+		}
+		encoded[key] = compressed
+	}
+	return p.next.MSet(ctx, encoded, ttl)
+}
+
+// Del removes a key.
+func (p *CompressingProvider) Del(ctx context.Context, key string) error { return p.next.Del(ctx, key) }
+
+// DelPrefix removes every key under prefix.
+func (p *CompressingProvider) DelPrefix(ctx context.Context, prefix string) (int, error) {
+	return p.next.DelPrefix(ctx, prefix)
+}
+
+// Close closes the wrapped provider.
+func (p *CompressingProvider) Close() error { return p.next.Close() }
+
+// compress flags and compresses value when it reaches the threshold and compression actually saves space.
+func (p *CompressingProvider) compress(value []byte) ([]byte, error) {
+	if p.codec == 0 || len(value) < p.threshold {
+		return value, nil
+	}
+	out := append(append(make([]byte, 0, len(compressedMagic)+1+len(value)/2), compressedMagic...), p.codec)
+	switch p.codec {
+	case codecSnappy:
+		out = append(out, s2.EncodeSnappy(nil, value)...)
+	case codecGzip:
+		buf := bytes.NewBuffer(out)
+		writer := gzip.NewWriter(buf)
+		if _, err := writer.Write(value); err != nil {
+			return nil, err
+		}
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+		out = buf.Bytes()
+	}
+	if len(out) >= len(value) {
+		return value, nil
+	}
+	return out, nil
+}
+
+func decompress(value []byte) ([]byte, error) {
+	if len(value) <= len(compressedMagic) || !bytes.HasPrefix(value, compressedMagic) {
+		return value, nil
+	}
+	body := value[len(compressedMagic)+1:]
+	switch codec := value[len(compressedMagic)]; codec {
+	case codecSnappy:
+		decoded, err := s2.Decode(nil, body)
+		if err != nil {
+			return nil, fmt.Errorf("decompress snappy cache value: %w", err)
+		}
+		return decoded, nil
+	case codecGzip:
+		reader, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("decompress gzip cache value: %w", err)
+		}
+		defer reader.Close()
+		decoded, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, fmt.Errorf("decompress gzip cache value: %w", err)
+		}
+		return decoded, nil
+	default:
+		return nil, fmt.Errorf("unknown cache compression codec %q", codec)
+	}
+}
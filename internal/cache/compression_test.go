@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCompressingProviderRoundTrips(t *testing.T) {
+	ctx := context.Background()
+	large := []byte(`[` + strings.Repeat(`{"correlationId":"c-1","rootCause":"checkout db pool exhausted"},`, 500) + `{}]`)
+	small := []byte(`[{"correlationId":"c-2"}]`)
+
+	for _, codec := range []string{CompressionSnappy, CompressionGzip} {
+		store := NewMemoryProvider()
+		provider, err := NewCompressingProvider(store, codec, 1024)
+		if err != nil {
+			t.Fatalf("%s: new provider: %v", codec, err)
+		}
+		if err := provider.Set(ctx, "weaviate:similar:large", large, time.Minute); err != nil {
+			t.Fatalf("%s: set: %v", codec, err)
+		}
+		if err := provider.Set(ctx, "weaviate:similar:small", small, time.Minute); err != nil {
+			t.Fatalf("%s: set small: %v", codec, err)
+		}
+
+		raw, _ := store.Get(ctx, "weaviate:similar:large")
+		if !bytes.HasPrefix(raw, compressedMagic) || len(raw) >= len(large)/4 {
+			t.Fatalf("%s: expected the large value to be stored compressed, got %d bytes", codec, len(raw))
+		}
+		if raw, _ := store.Get(ctx, "weaviate:similar:small"); !bytes.Equal(raw, small) {
+			t.Fatalf("%s: expected the small value to be stored as is, got %q", codec, raw)
+		}
+
+		value, err := provider.Get(ctx, "weaviate:similar:large")
+		if err != nil || !bytes.Equal(value, large) {
+			t.Fatalf("%s: get returned %d bytes (%v)", codec, len(value), err)
+		}
+		values, err := provider.MGet(ctx, "weaviate:similar:large", "weaviate:similar:small")
+		if err != nil || !bytes.Equal(values["weaviate:similar:large"], large) || !bytes.Equal(values["weaviate:similar:small"], small) {
+			t.Fatalf("%s: unexpected mget result (%v)", codec, err)
+		}
+
+		// A replica with compression disabled still reads flagged values.
+		reader, _ := NewCompressingProvider(store, CompressionNone, 0)
+		if value, err := reader.Get(ctx, "weaviate:similar:large"); err != nil || !bytes.Equal(value, large) {
+			t.Fatalf("%s: uncompressed reader returned %d bytes (%v)", codec, len(value), err)
+		}
+	}
+
+	if _, err := NewCompressingProvider(NewMemoryProvider(), "lz4", 0); err == nil {
+		t.Fatalf("expected an unknown codec to be rejected")
+	}
+}
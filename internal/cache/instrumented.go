@@ -0,0 +1,235 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/miradorstack/mirador-rca/internal/metrics"
+	"github.com/miradorstack/mirador-rca/internal/tracing"
+)
+
+// KeyFamily maps keys starting with Prefix to a logical family label.
+type KeyFamily struct {
+	Prefix string
+	Family string
+}
+
+// DefaultKeyFamilies covers the keys written by the mirador-core client, the Weaviate repository, Loader
+// locks, and the pattern mining scheduler. Keys matching none of them are labelled "other".
+var DefaultKeyFamilies = []KeyFamily{
+	{Prefix: "lock:", Family: "locks"},
+	{Prefix: "servicegraph:", Family: "service-graph"},
+	{Prefix: "weaviate:similar:", Family: "similar-incidents"},
+	{Prefix: "weaviate:patterns:", Family: "patterns"},
+	{Prefix: "metrics:", Family: "metrics"},
+	{Prefix: "logs:", Family: "logs"},
+	{Prefix: "traces:", Family: "traces"},
+	{Prefix: "rca:patterns:mine:", Family: "mining-locks"},
+	{Prefix: "investigation:", Family: "investigations"},
+	{Prefix: "incident:", Family: "incidents"},
+}
+
+const (
+	otherFamily = "other"
+	mixedFamily = "mixed"
+)
+
+// InstrumentedProvider decorates a Provider with Prometheus hit, miss, error, and latency metrics labelled by
+// key family, so the effectiveness of each cached lookup can be measured separately, and with a span per
+// round trip.
+type InstrumentedProvider struct {
+	next     Provider
+	families []KeyFamily
+}
+
+// NewInstrumentedProvider wraps next; nil families uses DefaultKeyFamilies. The first matching prefix wins.
+func NewInstrumentedProvider(next Provider, families []KeyFamily) *InstrumentedProvider {
+	if families == nil {
+		families = DefaultKeyFamilies
+	}
+	return &InstrumentedProvider{next: next, families: families}
+}
+
+// Get fetches a key, counting a hit, a miss, or an error.
+func (p *InstrumentedProvider) Get(ctx context.Context, key string) ([]byte, error) {
+	family := p.family(key)
+	ctx, span := startSpan(ctx, "get", family)
+	start := time.Now()
+	value, err := p.next.Get(ctx, key)
+	metrics.ObserveCacheLatency(family, "get", time.Since(start))
+	metrics.ObserveCacheRequest(family, "get", readOutcome(err), 1)
+	endSpan(span, readOutcome(err), err)
+	return value, err
+}
+
+// Set stores a key.
+func (p *InstrumentedProvider) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	family := p.family(key)
+	ctx, span := startSpan(ctx, "set", family)
+	start := time.Now()
+	err := p.next.Set(ctx, key, value, ttl)
+	metrics.ObserveCacheLatency(family, "set", time.Since(start))
+	metrics.ObserveCacheRequest(family, "set", writeOutcome(err), 1)
+	endSpan(span, writeOutcome(err), err)
+	return err
+}
+
+// SetNX stores a key only if it is absent; a lost race counts as a miss.
+func (p *InstrumentedProvider) SetNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	family := p.family(key)
+	ctx, span := startSpan(ctx, "setnx", family)
+	start := time.Now()
+	ok, err := p.next.SetNX(ctx, key, value, ttl)
+	metrics.ObserveCacheLatency(family, "setnx", time.Since(start))
+	outcome := writeOutcome(err)
+	if err == nil && !ok {
+		outcome = metrics.CacheMiss
+	}
+	metrics.ObserveCacheRequest(family, "setnx", outcome, 1)
+	endSpan(span, outcome, err)
+	return ok, err
+}
+
+// MGet fetches several keys, counting hits and misses per family.
+func (p *InstrumentedProvider) MGet(ctx context.Context, keys ...string) (map[string][]byte, error) {
+	ctx, span := startSpan(ctx, "mget", p.batchFamily(keys))
+	span.SetAttributes(attribute.Int("cache.keys", len(keys)))
+	start := time.Now()
+	values, err := p.next.MGet(ctx, keys...)
+	metrics.ObserveCacheLatency(p.batchFamily(keys), "mget", time.Since(start))
+	hits, misses, failed := map[string]int{}, map[string]int{}, map[string]int{}
+	for _, key := range keys {
+		family := p.family(key)
+		switch _, ok := values[key]; {
+		case err != nil:
+			failed[family]++
+		case ok:
+			hits[family]++
+		default:
+			misses[family]++
+		}
+	}
+	observeCounts("mget", metrics.CacheHit, hits)
+	observeCounts("mget", metrics.CacheMiss, misses)
+	observeCounts("mget", metrics.OutcomeError, failed)
+	span.SetAttributes(attribute.Int("cache.hits", len(values)))
+	tracing.End(span, err)
+	return values, err
+}
+
+// MSet stores several keys.
+func (p *InstrumentedProvider) MSet(ctx context.Context, values map[string][]byte, ttl time.Duration) error {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	ctx, span := startSpan(ctx, "mset", p.batchFamily(keys))
+	span.SetAttributes(attribute.Int("cache.keys", len(keys)))
+	start := time.Now()
+	err := p.next.MSet(ctx, values, ttl)
+	metrics.ObserveCacheLatency(p.batchFamily(keys), "mset", time.Since(start))
+	counts := map[string]int{}
+	for _, key := range keys {
+		counts[p.family(key)]++
+	}
+	observeCounts("mset", writeOutcome(err), counts)
+	tracing.End(span, err)
+	return err
+}
+
+// Del removes a key.
+func (p *InstrumentedProvider) Del(ctx context.Context, key string) error {
+	family := p.family(key)
+	ctx, span := startSpan(ctx, "del", family)
+	start := time.Now()
+	err := p.next.Del(ctx, key)
+	metrics.ObserveCacheLatency(family, "del", time.Since(start))
+	metrics.ObserveCacheRequest(family, "del", writeOutcome(err), 1)
+	tracing.End(span, err)
+	return err
+}
+
+// DelPrefix removes every key under prefix, labelled with the prefix's family.
+func (p *InstrumentedProvider) DelPrefix(ctx context.Context, prefix string) (int, error) {
+	family := p.family(prefix)
+	ctx, span := startSpan(ctx, "del_prefix", family)
+	start := time.Now()
+	removed, err := p.next.DelPrefix(ctx, prefix)
+	metrics.ObserveCacheLatency(family, "del_prefix", time.Since(start))
+	metrics.ObserveCacheRequest(family, "del_prefix", writeOutcome(err), 1)
+	span.SetAttributes(attribute.Int("cache.removed", removed))
+	tracing.End(span, err)
+	return removed, err
+}
+
+// Close closes the wrapped provider.
+func (p *InstrumentedProvider) Close() error { return p.next.Close() }
+
+func (p *InstrumentedProvider) family(key string) string {
+	for _, family := range p.families {
+		if strings.HasPrefix(key, family.Prefix) {
+			return family.Family
+		}
+	}
+	return otherFamily
+}
+
+// batchFamily labels a multi-key round trip with the keys' common family, or "mixed".
+func (p *InstrumentedProvider) batchFamily(keys []string) string {
+	family := ""
+	for _, key := range keys {
+		switch current := p.family(key); {
+		case family == "":
+			family = current
+		case family != current:
+			return mixedFamily
+		}
+	}
+	if family == "" {
+		return otherFamily
+	}
+	return family
+}
+
+// startSpan opens a client span for one cache round trip; keys are left out since they carry tenant data.
+func startSpan(ctx context.Context, operation, family string) (context.Context, trace.Span) {
+	return tracing.StartClient(ctx, "cache "+operation, attribute.String("cache.family", family))
+}
+
+// endSpan ends a single-key span with its outcome; a miss is not an error.
+func endSpan(span trace.Span, outcome string, err error) {
+	span.SetAttributes(attribute.String("cache.outcome", outcome))
+	if errors.Is(err, ErrCacheMiss) {
+		err = nil
+	}
+	tracing.End(span, err)
+}
+
+func observeCounts(operation, outcome string, counts map[string]int) {
+	for family, count := range counts {
+		metrics.ObserveCacheRequest(family, operation, outcome, count)
+	}
+}
+
+func readOutcome(err error) string {
+	switch {
+	case err == nil:
+		return metrics.CacheHit
+	case errors.Is(err, ErrCacheMiss):
+		return metrics.CacheMiss
+	default:
+		return metrics.OutcomeError
+	}
+}
+
+func writeOutcome(err error) string {
+	if err != nil {
+		return metrics.OutcomeError
+	}
+	return metrics.CacheStored
+}
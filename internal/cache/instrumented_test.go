@@ -0,0 +1,94 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/miradorstack/mirador-rca/internal/metrics"
+)
+
+type mapProvider struct {
+	NoopProvider
+	store map[string][]byte
+}
+
+func (m *mapProvider) Get(_ context.Context, key string) ([]byte, error) {
+	if value, ok := m.store[key]; ok {
+		return value, nil
+	}
+	return nil, ErrCacheMiss
+}
+
+func (m *mapProvider) MGet(_ context.Context, keys ...string) (map[string][]byte, error) {
+	values := map[string][]byte{}
+	for _, key := range keys {
+		if value, ok := m.store[key]; ok {
+			values[key] = value
+		}
+	}
+	return values, nil
+}
+
+func TestInstrumentedProviderLabelsByFamily(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	if err := metrics.Register(registry); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	graphHits := counterValue(t, registry, "service-graph", "get", metrics.CacheHit)
+	similarMisses := counterValue(t, registry, "similar-incidents", "get", metrics.CacheMiss)
+	patternMisses := counterValue(t, registry, "patterns", "mget", metrics.CacheMiss)
+
+	provider := NewInstrumentedProvider(&mapProvider{store: map[string][]byte{"servicegraph:acme:1:2": []byte("[]")}}, nil)
+	ctx := context.Background()
+	if _, err := provider.Get(ctx, "servicegraph:acme:1:2"); err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if _, err := provider.Get(ctx, "weaviate:similar:acme:5:cpu"); err != ErrCacheMiss {
+		t.Fatalf("expected a miss, got %v", err)
+	}
+	if _, err := provider.MGet(ctx, "servicegraph:acme:1:2", "weaviate:patterns:acme:checkout"); err != nil {
+		t.Fatalf("mget: %v", err)
+	}
+	if err := provider.Set(ctx, "custom", []byte("x"), time.Minute); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+
+	if got := counterValue(t, registry, "service-graph", "get", metrics.CacheHit) - graphHits; got != 1 {
+		t.Fatalf("expected one service-graph hit, got %v", got)
+	}
+	if got := counterValue(t, registry, "similar-incidents", "get", metrics.CacheMiss) - similarMisses; got != 1 {
+		t.Fatalf("expected one similar-incidents miss, got %v", got)
+	}
+	if got := counterValue(t, registry, "patterns", "mget", metrics.CacheMiss) - patternMisses; got != 1 {
+		t.Fatalf("expected one patterns miss from mget, got %v", got)
+	}
+	if got := counterValue(t, registry, "other", "set", metrics.CacheStored); got < 1 {
+		t.Fatalf("expected unknown keys to be labelled other, got %v", got)
+	}
+}
+
+func counterValue(t *testing.T, registry *prometheus.Registry, family, operation, outcome string) float64 {
+	t.Helper()
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("gather: %v", err)
+	}
+	for _, mf := range families {
+		if mf.GetName() != "mirador_rca_cache_requests_total" {
+			continue
+		}
+		for _, metric := range mf.GetMetric() {
+			labels := map[string]string{}
+			for _, pair := range metric.GetLabel() {
+				labels[pair.GetName()] = pair.GetValue()
+			}
+			if labels["family"] == family && labels["operation"] == operation && labels["outcome"] == outcome {
+				return metric.GetCounter().GetValue()
+			}
+		}
+	}
+	return 0
+}
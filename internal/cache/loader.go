@@ -0,0 +1,174 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultLockTTL = 5 * time.Second
+	lockPollEvery  = 100 * time.Millisecond
+)
+
+// Loader protects expensive fetches behind cache keys from stampedes. Concurrent misses for a key in one
+// process share a single fetch, and across replicas a short SetNX lock elects one fetcher while the others
+// poll the cache for its result.
+type Loader struct {
+	provider Provider
+	lockTTL  time.Duration
+
+	mu    sync.Mutex
+	calls map[string]*loadCall
+}
+
+type loadCall struct {
+	done  chan struct{}
+	value []byte
+	err   error
+	// abandoned is set when the fetch failed because its caller's context ended; waiters then retry.
+	abandoned bool
+	// stale is set when the key is invalidated mid-fetch; the fetched value is then returned but not cached.
+	stale bool
+}
+
+// NewLoader builds a loader over provider. lockTTL bounds how long other replicas wait for the lock holder
+// and defaults to five seconds; it should exceed a typical fetch.
+func NewLoader(provider Provider, lockTTL time.Duration) *Loader {
+	if provider == nil {
+		provider = NoopProvider{}
+	}
+	if lockTTL <= 0 {
+		lockTTL = defaultLockTTL
+	}
+	return &Loader{provider: provider, lockTTL: lockTTL, calls: map[string]*loadCall{}}
+}
+
+// Fetch produces a fresh value for a key and how long to cache it; a non-positive ttl leaves it uncached.
+type Fetch func(ctx context.Context) (value []byte, ttl time.Duration, err error)
+
+// Load returns the cached value of key, or runs fetch and caches its result. Callers that joined another
+// caller's fetch share its value and error. The fetch runs in the first caller's goroutine under its context,
+// so it is bounded by that caller's deadline and holds whatever the caller holds; when it fails because that
+// caller gave up, the callers still waiting retry instead of inheriting the cancellation.
+func (l *Loader) Load(ctx context.Context, key string, fetch Fetch) ([]byte, error) {
+	for {
+		if value, err := l.provider.Get(ctx, key); err == nil {
+			return value, nil
+		}
+
+		l.mu.Lock()
+		if call, ok := l.calls[key]; ok {
+			l.mu.Unlock()
+			select {
+			case <-call.done:
+				if call.abandoned && ctx.Err() == nil {
+					continue
+				}
+				return call.value, call.err
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		call := &loadCall{done: make(chan struct{})}
+		l.calls[key] = call
+		l.mu.Unlock()
+
+		call.value, call.err = l.refresh(ctx, key, call, fetch)
+		call.abandoned = call.err != nil && ctx.Err() != nil
+		l.mu.Lock()
+		delete(l.calls, key)
+		l.mu.Unlock()
+		close(call.done)
+		return call.value, call.err
+	}
+}
+
+// refresh claims the replica-wide lock for key. Losers wait for the winner's value and fetch themselves when
+// the lock is released or expires without one; if the cache is unreachable everyone fetches.
+func (l *Loader) refresh(ctx context.Context, key string, call *loadCall, fetch Fetch) ([]byte, error) {
+	lockKey := "lock:" + key
+	claimed, err := l.provider.SetNX(ctx, lockKey, []byte("1"), l.lockTTL)
+	if err == nil && !claimed {
+		if value, ok := l.await(ctx, key, lockKey); ok {
+			return value, nil
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+	}
+	if claimed {
+		defer func() { _ = l.provider.Del(context.WithoutCancel(ctx), lockKey) }()
+	}
+
+	value, ttl, err := fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	l.mu.Lock()
+	stale := call.stale
+	l.mu.Unlock()
+	if ttl > 0 && !stale {
+		_ = l.provider.Set(ctx, key, value, ttl)
+	}
+	return value, nil
+}
+
+// Invalidate removes keys after a write made them stale. Fetches of those keys already in flight in this
+// process still return their result but do not cache it.
+func (l *Loader) Invalidate(ctx context.Context, keys ...string) error {
+	l.mu.Lock()
+	for _, key := range keys {
+		if call, ok := l.calls[key]; ok {
+			call.stale = true
+		}
+	}
+	l.mu.Unlock()
+	var errs []error
+	for _, key := range keys {
+		if err := l.provider.Del(ctx, key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// InvalidatePrefix removes every key starting with prefix, for writes that affect a whole family of keys
+// such as all cached lookups of one tenant.
+func (l *Loader) InvalidatePrefix(ctx context.Context, prefix string) error {
+	l.mu.Lock()
+	for key, call := range l.calls {
+		if strings.HasPrefix(key, prefix) {
+			call.stale = true
+		}
+	}
+	l.mu.Unlock()
+	_, err := l.provider.DelPrefix(ctx, prefix)
+	return err
+}
+
+// await polls for the value of key while lockKey is held. A holder that failed, or stored nothing, releases
+// the lock, so waiters stop early instead of sitting out the lock TTL.
+func (l *Loader) await(ctx context.Context, key, lockKey string) ([]byte, bool) {
+	deadline := time.Now().Add(l.lockTTL)
+	ticker := time.NewTicker(lockPollEvery)
+	defer ticker.Stop()
+	for time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			return nil, false
+		case <-ticker.C:
+		}
+		if value, err := l.provider.Get(ctx, key); err == nil {
+			return value, true
+		}
+		if _, err := l.provider.Get(ctx, lockKey); errors.Is(err, ErrCacheMiss) {
+			// The holder may have stored its value between the two reads.
+			value, err := l.provider.Get(ctx, key)
+			return value, err == nil
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,145 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLoaderSharesFetchesWithinAndAcrossReplicas(t *testing.T) {
+	provider := NewMemoryProvider()
+	var fetches atomic.Int32
+	release := make(chan struct{})
+	fetch := func(context.Context) ([]byte, time.Duration, error) {
+		fetches.Add(1)
+		<-release
+		return []byte("graph"), time.Minute, nil
+	}
+
+	replicaA, replicaB := NewLoader(provider, time.Second), NewLoader(provider, time.Second)
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	results := make([][]byte, 6)
+	for i := range results {
+		loader := replicaA
+		if i%2 == 1 {
+			loader = replicaB
+		}
+		wg.Add(1)
+		go func(i int, loader *Loader) {
+			defer wg.Done()
+			value, err := loader.Load(ctx, "servicegraph:acme", fetch)
+			if err != nil {
+				t.Errorf("load %d: %v", i, err)
+			}
+			results[i] = value
+		}(i, loader)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if got := fetches.Load(); got != 1 {
+		t.Fatalf("expected one upstream fetch across both replicas, got %d", got)
+	}
+	for i, value := range results {
+		if string(value) != "graph" {
+			t.Fatalf("caller %d got %q", i, value)
+		}
+	}
+	if _, err := provider.Get(ctx, "lock:servicegraph:acme"); err != ErrCacheMiss {
+		t.Fatalf("expected the lock to be released, got %v", err)
+	}
+}
+
+func TestLoaderFetchesWhenLockHolderNeverFills(t *testing.T) {
+	provider := NewMemoryProvider()
+	ctx := context.Background()
+	if ok, _ := provider.SetNX(ctx, "lock:patterns", []byte("1"), time.Minute); !ok {
+		t.Fatalf("seed lock")
+	}
+	loader := NewLoader(provider, 150*time.Millisecond)
+	value, err := loader.Load(ctx, "patterns", func(context.Context) ([]byte, time.Duration, error) { return []byte("p"), time.Minute, nil })
+	if err != nil || string(value) != "p" {
+		t.Fatalf("expected a fallback fetch after the lock wait, got %q %v", value, err)
+	}
+}
+
+func TestLoaderStopsWaitingWhenLockIsReleasedEmpty(t *testing.T) {
+	provider := NewMemoryProvider()
+	ctx := context.Background()
+	if ok, _ := provider.SetNX(ctx, "lock:incident", []byte("1"), time.Minute); !ok {
+		t.Fatalf("seed lock")
+	}
+	go func() {
+		time.Sleep(150 * time.Millisecond)
+		_ = provider.Del(ctx, "lock:incident")
+	}()
+	loader := NewLoader(provider, time.Minute)
+	start := time.Now()
+	value, err := loader.Load(ctx, "incident", func(context.Context) ([]byte, time.Duration, error) { return []byte("r"), time.Minute, nil })
+	if err != nil || string(value) != "r" {
+		t.Fatalf("expected a fetch once the lock was released, got %q %v", value, err)
+	}
+	if waited := time.Since(start); waited > 5*time.Second {
+		t.Fatalf("expected the waiter to stop when the lock was released, waited %s", waited)
+	}
+}
+
+func TestLoaderJoinersOutliveCanceledLeader(t *testing.T) {
+	loader := NewLoader(NewMemoryProvider(), 0)
+	started := make(chan struct{})
+	leaderStopped := make(chan struct{})
+	leaderFetch := func(ctx context.Context) ([]byte, time.Duration, error) {
+		close(started)
+		<-ctx.Done()
+		close(leaderStopped)
+		return nil, 0, ctx.Err()
+	}
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := loader.Load(leaderCtx, "servicegraph:acme", leaderFetch)
+		leaderErr <- err
+	}()
+	<-started
+
+	var joinerFetches atomic.Int32
+	joined := make(chan struct{})
+	var value []byte
+	var joinErr error
+	go func() {
+		defer close(joined)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		value, joinErr = loader.Load(ctx, "servicegraph:acme", func(context.Context) ([]byte, time.Duration, error) {
+			joinerFetches.Add(1)
+			return []byte("graph"), time.Minute, nil
+		})
+	}()
+	// Let the joiner attach to the in-flight fetch before the leader gives up.
+	time.Sleep(50 * time.Millisecond)
+	if joinerFetches.Load() != 0 {
+		t.Fatalf("expected the joiner to wait for the leader's fetch")
+	}
+
+	cancelLeader()
+	if err := <-leaderErr; err != context.Canceled {
+		t.Fatalf("expected the leader to see its own cancellation, got %v", err)
+	}
+	select {
+	case <-leaderStopped:
+	default:
+		t.Fatalf("expected the leader's fetch to have stopped before its Load returned")
+	}
+	<-joined
+	if joinErr != nil || string(value) != "graph" {
+		t.Fatalf("expected the joiner to fetch the value itself, got %q %v", value, joinErr)
+	}
+	if joinerFetches.Load() != 1 {
+		t.Fatalf("expected the joiner to retry once, got %d fetches", joinerFetches.Load())
+	}
+}
@@ -0,0 +1,127 @@
+package cache
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MemoryProvider is a process-local Provider with the same TTL semantics as Valkey. It suits development and
+// single-replica setups; entries are not shared between processes and expired ones are dropped lazily.
+type MemoryProvider struct {
+	mu      sync.Mutex
+	entries map[string]memoryEntry
+	now     func() time.Time
+}
+
+type memoryEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryProvider returns an empty in-memory cache.
+func NewMemoryProvider() *MemoryProvider {
+	return &MemoryProvider{entries: make(map[string]memoryEntry), now: time.Now}
+}
+
+// Get returns the value for key, or ErrCacheMiss if it is absent or expired.
+func (p *MemoryProvider) Get(_ context.Context, key string) ([]byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	value, ok := p.lookupLocked(key)
+	if !ok {
+		return nil, ErrCacheMiss
+	}
+	return value, nil
+}
+
+// Set stores value under key; a non-positive ttl keeps it until it is deleted.
+func (p *MemoryProvider) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.storeLocked(key, value, ttl)
+	return nil
+}
+
+// SetNX stores value only if key is absent or expired.
+func (p *MemoryProvider) SetNX(_ context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.lookupLocked(key); ok {
+		return false, nil
+	}
+	p.storeLocked(key, value, ttl)
+	return true, nil
+}
+
+// MGet returns the live values among keys.
+func (p *MemoryProvider) MGet(_ context.Context, keys ...string) (map[string][]byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	values := make(map[string][]byte, len(keys))
+	for _, key := range keys {
+		if value, ok := p.lookupLocked(key); ok {
+			values[key] = value
+		}
+	}
+	return values, nil
+}
+
+// MSet stores every value with the shared ttl.
+func (p *MemoryProvider) MSet(_ context.Context, values map[string][]byte, ttl time.Duration) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for key, value := range values {
+		p.storeLocked(key, value, ttl)
+	}
+	return nil
+}
+
+// Del removes key.
+func (p *MemoryProvider) Del(_ context.Context, key string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.entries, key)
+	return nil
+}
+
+// DelPrefix removes every live key starting with prefix.
+func (p *MemoryProvider) DelPrefix(_ context.Context, prefix string) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	removed := 0
+	for key := range p.entries {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		if _, ok := p.lookupLocked(key); ok {
+			removed++
+		}
+		delete(p.entries, key)
+	}
+	return removed, nil
+}
+
+// Close is a no-op.
+func (p *MemoryProvider) Close() error { return nil }
+
+func (p *MemoryProvider) lookupLocked(key string) ([]byte, bool) {
+	entry, ok := p.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !entry.expires.IsZero() && !p.now().Before(entry.expires) {
+		delete(p.entries, key)
+		return nil, false
+	}
+	return append([]byte(nil), entry.value...), true
+}
+
+func (p *MemoryProvider) storeLocked(key string, value []byte, ttl time.Duration) {
+	entry := memoryEntry{value: append([]byte(nil), value...)}
+	if ttl > 0 {
+		entry.expires = p.now().Add(ttl)
+	}
+	p.entries[key] = entry
+}
@@ -0,0 +1,44 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMemoryProviderExpiresEntries(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	provider := NewMemoryProvider()
+	provider.now = func() time.Time { return now }
+
+	if err := provider.Set(ctx, "graph:a", []byte("1"), time.Minute); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+	if err := provider.MSet(ctx, map[string][]byte{"graph:b": []byte("2"), "patterns:a": []byte("3")}, 0); err != nil {
+		t.Fatalf("mset: %v", err)
+	}
+	if ok, _ := provider.SetNX(ctx, "graph:a", []byte("x"), time.Minute); ok {
+		t.Fatalf("expected SetNX to keep the live entry")
+	}
+
+	now = now.Add(time.Minute)
+	if _, err := provider.Get(ctx, "graph:a"); !errors.Is(err, ErrCacheMiss) {
+		t.Fatalf("expected the entry to expire, got %v", err)
+	}
+	if ok, _ := provider.SetNX(ctx, "graph:a", []byte("4"), 0); !ok {
+		t.Fatalf("expected SetNX to replace the expired entry")
+	}
+	values, _ := provider.MGet(ctx, "graph:a", "graph:b", "graph:c")
+	if len(values) != 2 || string(values["graph:a"]) != "4" || string(values["graph:b"]) != "2" {
+		t.Fatalf("unexpected values: %v", values)
+	}
+
+	if removed, _ := provider.DelPrefix(ctx, "graph:"); removed != 2 {
+		t.Fatalf("expected two graph keys removed, got %d", removed)
+	}
+	if value, err := provider.Get(ctx, "patterns:a"); err != nil || string(value) != "3" {
+		t.Fatalf("expected other prefixes untouched, got %q, %v", value, err)
+	}
+}
@@ -11,7 +11,13 @@ type Provider interface {
 	Get(ctx context.Context, key string) ([]byte, error)
 	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
 	SetNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error)
+	// MGet fetches several keys in one round trip; missing keys are absent from the result.
+	MGet(ctx context.Context, keys ...string) (map[string][]byte, error)
+	// MSet stores several values with a shared TTL in one round trip.
+	MSet(ctx context.Context, values map[string][]byte, ttl time.Duration) error
 	Del(ctx context.Context, key string) error
+	// DelPrefix removes every key starting with prefix and reports how many were removed.
+	DelPrefix(ctx context.Context, prefix string) (int, error)
 	Close() error
 }
 
@@ -36,8 +42,21 @@ func (NoopProvider) SetNX(context.Context, string, []byte, time.Duration) (bool,
 	return true, nil
 }
 
+// MGet returns an empty result.
+func (NoopProvider) MGet(context.Context, ...string) (map[string][]byte, error) {
+	return map[string][]byte{}, nil
+}
+
+// MSet discards the values and returns nil.
+func (NoopProvider) MSet(context.Context, map[string][]byte, time.Duration) error {
+	return nil
+}
+
 // Del is a no-op for the noop cache.
 func (NoopProvider) Del(context.Context, string) error { return nil }
 
+// DelPrefix removes nothing.
+func (NoopProvider) DelPrefix(context.Context, string) (int, error) { return 0, nil }
+
 // Close is a no-op.
 func (NoopProvider) Close() error { return nil }
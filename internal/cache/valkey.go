@@ -4,17 +4,23 @@ import (
 	"bufio"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"net"
+	"os"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 )
 
 // ValkeyProvider implements Provider backed by a Valkey/Redis-compatible server.
 type ValkeyProvider struct {
-	cfg ValkeyConfig
+	cfg       ValkeyConfig
+	tlsConfig *tls.Config
+	// password starts as cfg.Password and is replaced by SetPassword when credentials rotate.
+	password atomic.Pointer[string]
 }
 
 // ValkeyConfig holds connection parameters for the Valkey cluster.
@@ -28,6 +34,15 @@ type ValkeyConfig struct {
 	WriteTimeout time.Duration
 	MaxRetries   int
 	TLS          bool
+	// CAFile is a PEM bundle trusted instead of the system roots.
+	CAFile string
+	// CertFile and KeyFile present a client certificate for mutual TLS; both or neither must be set.
+	CertFile string
+	KeyFile  string
+	// InsecureSkipVerify disables server certificate verification; only for lab setups.
+	InsecureSkipVerify bool
+	// Network is "tcp" (the default) or "unix", in which case Addr is the socket path of a sidecar instance.
+	Network string
 }
 
 // NewValkeyProvider creates a Provider using the supplied configuration. It performs a ping
@@ -36,13 +51,32 @@ func NewValkeyProvider(cfg ValkeyConfig) (*ValkeyProvider, error) {
 	if cfg.Addr == "" {
 		return nil, errors.New("valkey addr is required")
 	}
+	switch cfg.Network {
+	case "":
+		cfg.Network = "tcp"
+	case "tcp":
+	case "unix":
+		if cfg.TLS {
+			return nil, errors.New("valkey tls is not supported over unix sockets")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported valkey network %q (want tcp or unix)", cfg.Network)
+	}
 
 	normaliseDurations(&cfg)
 	provider := &ValkeyProvider{cfg: cfg}
+	provider.password.Store(&cfg.Password)
+	if cfg.TLS {
+		tlsCfg, err := buildTLSConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		provider.tlsConfig = tlsCfg
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), cfg.DialTimeout)
 	defer cancel()
-	if err := provider.ping(ctx); err != nil {
+	if err := provider.Ping(ctx); err != nil {
 		return nil, err
 	}
 
@@ -131,6 +165,80 @@ func (p *ValkeyProvider) SetNX(ctx context.Context, key string, value []byte, tt
 	return ok, err
 }
 
+// MGet fetches several keys with a single MGET.
+func (p *ValkeyProvider) MGet(ctx context.Context, keys ...string) (map[string][]byte, error) {
+	values := make(map[string][]byte, len(keys))
+	if len(keys) == 0 {
+		return values, nil
+	}
+	err := p.withConn(ctx, func(vc *valkeyConn) error {
+		args := make([][]byte, 0, len(keys))
+		for _, key := range keys {
+			args = append(args, []byte(key))
+		}
+		if err := vc.writeCommand("MGET", args...); err != nil {
+			return err
+		}
+		reply, err := vc.readReply()
+		if err != nil {
+			return err
+		}
+		if reply.typ != replyArray || len(reply.items) != len(keys) {
+			return fmt.Errorf("unexpected MGET response type %q with %d items", reply.typ, len(reply.items))
+		}
+		for i, item := range reply.items {
+			if item.typ == replyBulkString {
+				values[keys[i]] = item.data
+			}
+		}
+		return nil
+	})
+	return values, err
+}
+
+// MSet stores several values in one round trip. Without a TTL it issues a single MSET; with one it pipelines
+// a SET PX per key, since MSET cannot expire keys.
+func (p *ValkeyProvider) MSet(ctx context.Context, values map[string][]byte, ttl time.Duration) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return p.withConn(ctx, func(vc *valkeyConn) error {
+		if ttl <= 0 {
+			args := make([][]byte, 0, 2*len(values))
+			for key, value := range values {
+				args = append(args, []byte(key), value)
+			}
+			if err := vc.writeCommand("MSET", args...); err != nil {
+				return err
+			}
+			reply, err := vc.readReply()
+			if err != nil {
+				return err
+			}
+			if reply.typ != replySimpleString || string(reply.data) != "OK" {
+				return fmt.Errorf("unexpected MSET response: %s", reply.data)
+			}
+			return nil
+		}
+
+		ms := []byte(strconv.FormatInt(ttl.Milliseconds(), 10))
+		commands := make([][][]byte, 0, len(values))
+		for key, value := range values {
+			commands = append(commands, [][]byte{[]byte("SET"), []byte(key), value, []byte("PX"), ms})
+		}
+		replies, err := vc.pipeline(commands)
+		if err != nil {
+			return err
+		}
+		for _, reply := range replies {
+			if reply.typ != replySimpleString || string(reply.data) != "OK" {
+				return fmt.Errorf("unexpected SET response: %s", reply.data)
+			}
+		}
+		return nil
+	})
+}
+
 // Del removes a key from the cache.
 func (p *ValkeyProvider) Del(ctx context.Context, key string) error {
 	return p.withConn(ctx, func(vc *valkeyConn) error {
@@ -142,10 +250,65 @@ func (p *ValkeyProvider) Del(ctx context.Context, key string) error {
 	})
 }
 
+// DelPrefix walks the keyspace with SCAN MATCH and unlinks each page of matches, so large keyspaces are never
+// blocked by a single KEYS call. Keys written concurrently may survive.
+func (p *ValkeyProvider) DelPrefix(ctx context.Context, prefix string) (int, error) {
+	pattern := []byte(escapeGlob(prefix) + "*")
+	removed := 0
+	err := p.withConn(ctx, func(vc *valkeyConn) error {
+		cursor := []byte("0")
+		for {
+			if err := vc.writeCommand("SCAN", cursor, []byte("MATCH"), pattern, []byte("COUNT"), []byte(scanCount)); err != nil {
+				return err
+			}
+			reply, err := vc.readReply()
+			if err != nil {
+				return err
+			}
+			if reply.typ != replyArray || len(reply.items) != 2 || reply.items[1].typ != replyArray {
+				return fmt.Errorf("unexpected SCAN response type %q", reply.typ)
+			}
+			cursor = reply.items[0].data
+			if keys := reply.items[1].items; len(keys) > 0 {
+				args := make([][]byte, 0, len(keys))
+				for _, key := range keys {
+					args = append(args, key.data)
+				}
+				if err := vc.writeCommand("UNLINK", args...); err != nil {
+					return err
+				}
+				reply, err := vc.readReply()
+				if err != nil {
+					return err
+				}
+				if reply.typ != replyInteger {
+					return fmt.Errorf("unexpected UNLINK response type %q", reply.typ)
+				}
+				count, _ := strconv.Atoi(string(reply.data))
+				removed += count
+			}
+			if string(cursor) == "0" {
+				return nil
+			}
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+	})
+	return removed, err
+}
+
+// SetPassword changes the password used to authenticate; connections are opened per operation, so it
+// applies from the next call.
+func (p *ValkeyProvider) SetPassword(password string) {
+	p.password.Store(&password)
+}
+
 // Close closes the underlying client (no-op for stateless provider).
 func (p *ValkeyProvider) Close() error { return nil }
 
-func (p *ValkeyProvider) ping(ctx context.Context) error {
+// Ping sends PING on a fresh connection, checking reachability and credentials.
+func (p *ValkeyProvider) Ping(ctx context.Context) error {
 	return p.withConn(ctx, func(vc *valkeyConn) error {
 		if err := vc.writeCommand("PING"); err != nil {
 			return err
@@ -213,12 +376,10 @@ func (p *ValkeyProvider) dial(ctx context.Context) (*valkeyConn, error) {
 		conn net.Conn
 		err  error
 	)
-	if p.cfg.TLS {
-		host := hostForTLS(p.cfg.Addr)
-		tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12, ServerName: host}
-		conn, err = tls.DialWithDialer(&dialer, "tcp", p.cfg.Addr, tlsCfg)
+	if p.tlsConfig != nil {
+		conn, err = tls.DialWithDialer(&dialer, p.cfg.Network, p.cfg.Addr, p.tlsConfig)
 	} else {
-		conn, err = dialer.DialContext(ctx, "tcp", p.cfg.Addr)
+		conn, err = dialer.DialContext(ctx, p.cfg.Network, p.cfg.Addr)
 	}
 	if err != nil {
 		return nil, err
@@ -233,12 +394,12 @@ func (p *ValkeyProvider) dial(ctx context.Context) (*valkeyConn, error) {
 }
 
 func (p *ValkeyProvider) bootstrap(vc *valkeyConn) error {
-	if p.cfg.Password != "" {
+	if password := *p.password.Load(); password != "" {
 		cmd := []string{"AUTH"}
 		if p.cfg.Username != "" {
-			cmd = append(cmd, p.cfg.Username, p.cfg.Password)
+			cmd = append(cmd, p.cfg.Username, password)
 		} else {
-			cmd = append(cmd, p.cfg.Password)
+			cmd = append(cmd, password)
 		}
 		if err := vc.writeStrings(cmd...); err != nil {
 			return err
@@ -275,11 +436,13 @@ const (
 	replyError        replyType = "-"
 	replyInteger      replyType = ":"
 	replyNil          replyType = "_"
+	replyArray        replyType = "*"
 )
 
 type respReply struct {
-	typ  replyType
-	data []byte
+	typ   replyType
+	data  []byte
+	items []respReply
 }
 
 // valkeyConn wraps a network connection with RESP helpers.
@@ -313,6 +476,14 @@ func (vc *valkeyConn) write(parts ...[]byte) error {
 	if err := vc.conn.SetWriteDeadline(time.Now().Add(writeTimeout(vc.cfg))); err != nil {
 		return err
 	}
+	if err := vc.buffer(parts); err != nil {
+		return err
+	}
+	return vc.writer.Flush()
+}
+
+// buffer encodes one command into the write buffer without flushing it.
+func (vc *valkeyConn) buffer(parts [][]byte) error {
 	if _, err := vc.writer.WriteString(fmt.Sprintf("*%d\r\n", len(parts))); err != nil {
 		return err
 	}
@@ -327,9 +498,47 @@ func (vc *valkeyConn) write(parts ...[]byte) error {
 			return err
 		}
 	}
-	return vc.writer.Flush()
+	return nil
 }
 
+// pipeline sends every command in one write and then reads one reply per command. All replies are drained
+// so the connection stays in sync; the first error reply is returned after the rest were read.
+func (vc *valkeyConn) pipeline(commands [][][]byte) ([]respReply, error) {
+	if err := vc.conn.SetWriteDeadline(time.Now().Add(writeTimeout(vc.cfg))); err != nil {
+		return nil, err
+	}
+	for _, command := range commands {
+		if err := vc.buffer(command); err != nil {
+			return nil, err
+		}
+	}
+	if err := vc.writer.Flush(); err != nil {
+		return nil, err
+	}
+
+	replies := make([]respReply, 0, len(commands))
+	var firstErr error
+	for range commands {
+		reply, err := vc.readReply()
+		if err != nil {
+			var serverErr respError
+			if !errors.As(err, &serverErr) {
+				return nil, err
+			}
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+		replies = append(replies, reply)
+	}
+	return replies, firstErr
+}
+
+// respError is an error reply sent by the server, as opposed to a transport failure.
+type respError string
+
+func (e respError) Error() string { return string(e) }
+
 func (vc *valkeyConn) readReply() (respReply, error) {
 	if err := vc.conn.SetReadDeadline(time.Now().Add(readTimeout(vc.cfg))); err != nil {
 		return respReply{}, err
@@ -347,7 +556,7 @@ func (vc *valkeyConn) readReply() (respReply, error) {
 		if err != nil {
 			return respReply{}, err
 		}
-		return respReply{}, errors.New(string(line))
+		return respReply{}, respError(line)
 	case ':':
 		line, err := vc.readLine()
 		return respReply{typ: replyInteger, data: line}, err
@@ -371,6 +580,27 @@ func (vc *valkeyConn) readReply() (respReply, error) {
 			return respReply{}, err
 		}
 		return respReply{typ: replyBulkString, data: buf}, nil
+	case '*':
+		line, err := vc.readLine()
+		if err != nil {
+			return respReply{}, err
+		}
+		count, err := strconv.Atoi(string(line))
+		if err != nil {
+			return respReply{}, err
+		}
+		if count == -1 {
+			return respReply{typ: replyNil}, nil
+		}
+		items := make([]respReply, 0, count)
+		for i := 0; i < count; i++ {
+			item, err := vc.readReply()
+			if err != nil {
+				return respReply{}, err
+			}
+			items = append(items, item)
+		}
+		return respReply{typ: replyArray, items: items}, nil
 	default:
 		return respReply{}, fmt.Errorf("unexpected RESP prefix %q", prefix)
 	}
@@ -401,6 +631,22 @@ func (vc *valkeyConn) expectCRLF() error {
 	return nil
 }
 
+// scanCount hints how many keys each SCAN step inspects.
+const scanCount = "500"
+
+// escapeGlob quotes the characters SCAN MATCH treats as wildcards, so a prefix matches literally.
+func escapeGlob(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch r {
+		case '*', '?', '[', ']', '\\':
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
 func normaliseDurations(cfg *ValkeyConfig) {
 	if cfg.DialTimeout <= 0 {
 		cfg.DialTimeout = 2 * time.Second
@@ -450,6 +696,38 @@ func shouldRetry(err error) bool {
 	return ok && (netErr.Timeout() || netErr.Temporary())
 }
 
+// buildTLSConfig loads the CA bundle and client key pair once, so bad paths fail at startup rather than on
+// every dial.
+func buildTLSConfig(cfg ValkeyConfig) (*tls.Config, error) {
+	tlsCfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         hostForTLS(cfg.Addr),
+		InsecureSkipVerify: cfg.InsecureSkipVerify, //nolint:gosec // opt-in for lab setups
+	}
+	if cfg.CAFile != "" {
+		pem, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read valkey CA bundle: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("valkey CA bundle %s contains no certificates", cfg.CAFile)
+		}
+		tlsCfg.RootCAs = pool
+	}
+	if (cfg.CertFile == "") != (cfg.KeyFile == "") {
+		return nil, errors.New("valkey client certificate and key must be set together")
+	}
+	if cfg.CertFile != "" {
+		pair, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load valkey client certificate: %w", err)
+		}
+		tlsCfg.Certificates = []tls.Certificate{pair}
+	}
+	return tlsCfg, nil
+}
+
 func hostForTLS(addr string) string {
 	host, _, err := net.SplitHostPort(addr)
 	if err != nil {
@@ -0,0 +1,228 @@
+package cache
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeValkey speaks enough RESP to serve PING, GET, SET, MSET, MGET, SCAN, and UNLINK from memory.
+type fakeValkey struct {
+	mu       sync.Mutex
+	store    map[string]string
+	ttls     map[string]string
+	commands []string
+}
+
+func (f *fakeValkey) serve(ln net.Listener) {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		go f.handle(conn)
+	}
+}
+
+func (f *fakeValkey) handle(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	for {
+		args, err := readCommand(reader)
+		if err != nil {
+			return
+		}
+		f.mu.Lock()
+		f.commands = append(f.commands, strings.ToUpper(args[0]))
+		var reply string
+		switch strings.ToUpper(args[0]) {
+		case "PING":
+			reply = "+PONG\r\n"
+		case "GET":
+			if value, ok := f.store[args[1]]; ok {
+				reply = fmt.Sprintf("$%d\r\n%s\r\n", len(value), value)
+			} else {
+				reply = "$-1\r\n"
+			}
+		case "SET":
+			f.store[args[1]] = args[2]
+			if len(args) == 5 && strings.EqualFold(args[3], "PX") {
+				f.ttls[args[1]] = args[4]
+			}
+			reply = "+OK\r\n"
+		case "MSET":
+			for i := 1; i+1 < len(args); i += 2 {
+				f.store[args[i]] = args[i+1]
+			}
+			reply = "+OK\r\n"
+		case "MGET":
+			reply = fmt.Sprintf("*%d\r\n", len(args)-1)
+			for _, key := range args[1:] {
+				if value, ok := f.store[key]; ok {
+					reply += fmt.Sprintf("$%d\r\n%s\r\n", len(value), value)
+				} else {
+					reply += "$-1\r\n"
+				}
+			}
+		case "SCAN":
+			prefix := strings.TrimSuffix(strings.ReplaceAll(args[3], "\\", ""), "*")
+			var keys []string
+			for key := range f.store {
+				if strings.HasPrefix(key, prefix) {
+					keys = append(keys, key)
+				}
+			}
+			reply = fmt.Sprintf("*2\r\n$1\r\n0\r\n*%d\r\n", len(keys))
+			for _, key := range keys {
+				reply += fmt.Sprintf("$%d\r\n%s\r\n", len(key), key)
+			}
+		case "UNLINK":
+			removed := 0
+			for _, key := range args[1:] {
+				if _, ok := f.store[key]; ok {
+					delete(f.store, key)
+					removed++
+				}
+			}
+			reply = fmt.Sprintf(":%d\r\n", removed)
+		default:
+			reply = "-ERR unknown command\r\n"
+		}
+		f.mu.Unlock()
+		if _, err := conn.Write([]byte(reply)); err != nil {
+			return
+		}
+	}
+}
+
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	count, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "*")))
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		header, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(header, "$")))
+		if err != nil {
+			return nil, err
+		}
+		buf := make([]byte, size+2)
+		if _, err := ioReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		args = append(args, string(buf[:size]))
+	}
+	return args, nil
+}
+
+func TestValkeyProviderMultiKeyOperations(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer ln.Close()
+	server := &fakeValkey{store: map[string]string{}, ttls: map[string]string{}}
+	go server.serve(ln)
+
+	provider, err := NewValkeyProvider(ValkeyConfig{Addr: ln.Addr().String()})
+	if err != nil {
+		t.Fatalf("new provider: %v", err)
+	}
+	ctx := context.Background()
+
+	if err := provider.MSet(ctx, map[string][]byte{"graph": []byte("g"), "patterns": []byte("p")}, time.Minute); err != nil {
+		t.Fatalf("mset with ttl: %v", err)
+	}
+	if err := provider.MSet(ctx, map[string][]byte{"similar": []byte("s")}, 0); err != nil {
+		t.Fatalf("mset: %v", err)
+	}
+	values, err := provider.MGet(ctx, "graph", "missing", "patterns", "similar")
+	if err != nil {
+		t.Fatalf("mget: %v", err)
+	}
+	if len(values) != 3 || string(values["graph"]) != "g" || string(values["patterns"]) != "p" || string(values["similar"]) != "s" {
+		t.Fatalf("unexpected values: %q", values)
+	}
+	if removed, err := provider.DelPrefix(ctx, "pat"); err != nil || removed != 1 {
+		t.Fatalf("del prefix: removed %d (%v)", removed, err)
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	if _, ok := server.store["patterns"]; ok || server.store["graph"] != "g" {
+		t.Fatalf("expected only the prefixed key to be removed, got %v", server.store)
+	}
+	if server.ttls["graph"] != "60000" || server.ttls["patterns"] != "60000" {
+		t.Fatalf("expected pipelined SET PX for each key, got %v", server.ttls)
+	}
+	if got := strings.Join(server.commands, " "); got != "PING SET SET MSET MGET SCAN UNLINK" {
+		t.Fatalf("unexpected command sequence: %s", got)
+	}
+}
+
+func TestBuildTLSConfig(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := buildTLSConfig(ValkeyConfig{Addr: "valkey:6379", CertFile: filepath.Join(dir, "client.pem")}); err == nil {
+		t.Fatalf("expected a certificate without a key to be rejected")
+	}
+	empty := filepath.Join(dir, "ca.pem")
+	if err := os.WriteFile(empty, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatalf("write ca: %v", err)
+	}
+	if _, err := buildTLSConfig(ValkeyConfig{Addr: "valkey:6379", CAFile: empty}); err == nil {
+		t.Fatalf("expected a CA bundle without certificates to be rejected")
+	}
+
+	cfg, err := buildTLSConfig(ValkeyConfig{Addr: "valkey:6379", InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatalf("build tls config: %v", err)
+	}
+	if cfg.ServerName != "valkey" || !cfg.InsecureSkipVerify || cfg.RootCAs != nil {
+		t.Fatalf("unexpected tls config: %+v", cfg)
+	}
+}
+
+func TestValkeyProviderUnixSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "valkey.sock")
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer ln.Close()
+	go (&fakeValkey{store: map[string]string{}, ttls: map[string]string{}}).serve(ln)
+
+	provider, err := NewValkeyProvider(ValkeyConfig{Addr: path, Network: "unix"})
+	if err != nil {
+		t.Fatalf("new provider: %v", err)
+	}
+	ctx := context.Background()
+	if err := provider.Set(ctx, "graph", []byte("g"), time.Minute); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+	if value, err := provider.Get(ctx, "graph"); err != nil || string(value) != "g" {
+		t.Fatalf("get: %q (%v)", value, err)
+	}
+
+	if _, err := NewValkeyProvider(ValkeyConfig{Addr: path, Network: "unix", TLS: true}); err == nil {
+		t.Fatalf("expected tls over a unix socket to be rejected")
+	}
+	if _, err := NewValkeyProvider(ValkeyConfig{Addr: path, Network: "udp"}); err == nil {
+		t.Fatalf("expected an unsupported network to be rejected")
+	}
+}
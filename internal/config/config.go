@@ -3,7 +3,6 @@ package config
 import (
 	"errors"
 	"fmt"
-	"io/fs"
 	"os"
 	"strconv"
 	"strings"
@@ -14,12 +13,115 @@ import (
 
 // Config captures the minimal settings required to boot the RCA service.
 type Config struct {
-	Server   ServerConfig   `yaml:"server"`
-	Clients  ClientsConfig  `yaml:"clients"`
-	Weaviate WeaviateConfig `yaml:"weaviate"`
-	Logging  LoggingConfig  `yaml:"logging"`
-	Rules    RulesConfig    `yaml:"rules"`
-	Cache    CacheConfig    `yaml:"cache"`
+	Server     ServerConfig     `yaml:"server"`
+	Clients    ClientsConfig    `yaml:"clients"`
+	Weaviate   WeaviateConfig   `yaml:"weaviate"`
+	History    HistoryConfig    `yaml:"history"`
+	Logging    LoggingConfig    `yaml:"logging"`
+	Rules      RulesConfig      `yaml:"rules"`
+	Cache      CacheConfig      `yaml:"cache"`
+	Extractors ExtractorsConfig `yaml:"extractors"`
+	Links      LinksConfig      `yaml:"links"`
+	Summary    SummaryConfig    `yaml:"summary"`
+	LLM        LLMConfig        `yaml:"llm"`
+	// Presets adds investigation presets or adjusts the built-in fast, deep, and logs-heavy ones by name.
+	Presets map[string]PresetConfig `yaml:"presets"`
+	// ServiceNames folds the spellings of a service in requests, traces, and the service graph into one name.
+	ServiceNames ServiceNamesConfig `yaml:"serviceNames"`
+	// Capacity judges from resource utilisation whether an incident was driven by saturation.
+	Capacity CapacityConfig `yaml:"capacity"`
+	// OTLP receives signals pushed by OpenTelemetry collectors and SDKs for the otlp signal sources.
+	OTLP OTLPConfig `yaml:"otlp"`
+	// Impact scores each correlation by the error budget burn of the investigated service's SLOs.
+	Impact ImpactConfig `yaml:"impact"`
+	// Investigation bounds the total latency budget of a single investigation.
+	Investigation InvestigationConfig `yaml:"investigation"`
+	Retention     RetentionConfig     `yaml:"retention"`
+	Archive       ArchiveConfig       `yaml:"archive"`
+	Patterns      PatternsConfig      `yaml:"patterns"`
+	Tuning        TuningConfig        `yaml:"tuning"`
+	Topology      TopologyConfig      `yaml:"topology"`
+	Clustering    ClusteringConfig    `yaml:"clustering"`
+	Integrations  IntegrationsConfig  `yaml:"integrations"`
+	Notifications NotificationsConfig `yaml:"notifications"`
+	Ticketing     TicketingConfig     `yaml:"ticketing"`
+	Kafka         KafkaConfig         `yaml:"kafka"`
+	Watch         WatchConfig         `yaml:"watch"`
+	// Maintenance seeds planned maintenance windows; more can be managed at runtime over gRPC and are kept in
+	// the history store.
+	Maintenance []MaintenanceWindowConfig `yaml:"maintenance"`
+	Reload      ReloadConfig              `yaml:"reload"`
+	Secrets     SecretsConfig             `yaml:"secrets"`
+	Remote      RemoteConfig              `yaml:"remote"`
+	// Features rolls engine behaviour out per tenant, keyed by flag name.
+	Features map[string]FeatureConfig `yaml:"features"`
+	Tracing  TracingConfig            `yaml:"tracing"`
+	Metrics  MetricsConfig            `yaml:"metrics"`
+	Health   HealthConfig             `yaml:"health"`
+	Audit    AuditConfig              `yaml:"audit"`
+	SLO      SLOConfig                `yaml:"slo"`
+	// Include lists files merged before the one that names them, relative to it; Load clears it.
+	Include []string `yaml:"include"`
+}
+
+// FeatureConfig rolls out one feature flag. Enabled false turns the flag off everywhere; when enabled, the
+// flag is on for Tenants and for Percentage percent of the other tenants, or for everyone if neither is set.
+// An explicit percentage of 0 enables only the listed tenants. Flags without an entry keep their built-in
+// default.
+type FeatureConfig struct {
+	Enabled    bool     `yaml:"enabled"`
+	Tenants    []string `yaml:"tenants"`
+	Percentage *float64 `yaml:"percentage"`
+}
+
+// TracingConfig exports OpenTelemetry spans of RPCs, pipeline stages, upstream calls, and cache operations over
+// OTLP/HTTP. Endpoint is the collector URL, such as http://otel-collector:4318; empty falls back to
+// OTEL_EXPORTER_OTLP_ENDPOINT. SampleRatio applies to traces started here; propagated traces keep the
+// caller's sampling decision.
+type TracingConfig struct {
+	Enabled     bool              `yaml:"enabled"`
+	Endpoint    string            `yaml:"endpoint"`
+	Headers     map[string]string `yaml:"headers" secret:"true"`
+	SampleRatio float64           `yaml:"sampleRatio"`
+	ServiceName string            `yaml:"serviceName"`
+	Timeout     time.Duration     `yaml:"timeout"`
+}
+
+// MetricsConfig bounds the tenant label of the investigation metrics. Listed Tenants keep their own label
+// value; without a list the first MaxTenants tenants seen do. Every other tenant is labelled "other".
+type MetricsConfig struct {
+	Tenants    []string `yaml:"tenants"`
+	MaxTenants int      `yaml:"maxTenants"`
+}
+
+// HealthConfig paces the dependency probes behind /readyz and the gRPC health service.
+type HealthConfig struct {
+	Interval time.Duration `yaml:"interval"`
+	Timeout  time.Duration `yaml:"timeout"`
+}
+
+// AuditConfig writes an append-only record of every investigation, feedback submission, and admin RPC. Sink
+// "file" (the default) appends JSON lines to Path; "http" posts them to URL with Headers. BufferSize bounds
+// the records queued for the sink; records beyond it are dropped and counted.
+type AuditConfig struct {
+	Enabled    bool              `yaml:"enabled"`
+	Sink       string            `yaml:"sink"`
+	Path       string            `yaml:"path"`
+	URL        string            `yaml:"url"`
+	Headers    map[string]string `yaml:"headers" secret:"true"`
+	Timeout    time.Duration     `yaml:"timeout"`
+	BufferSize int               `yaml:"bufferSize"`
+}
+
+// SLOConfig tracks the engine's own investigation latency objective: Objective of investigations finish
+// within LatencyThreshold, so the defaults encode p95 < 4s. The burn rate over each of Windows is exported
+// and logged every ReportInterval.
+type SLOConfig struct {
+	Enabled          bool            `yaml:"enabled"`
+	LatencyThreshold time.Duration   `yaml:"latencyThreshold"`
+	Objective        float64         `yaml:"objective"`
+	Windows          []time.Duration `yaml:"windows"`
+	ReportInterval   time.Duration   `yaml:"reportInterval"`
 }
 
 // ServerConfig controls gRPC listener behaviour.
@@ -27,11 +129,81 @@ type ServerConfig struct {
 	Address         string        `yaml:"address"`
 	MetricsAddress  string        `yaml:"metricsAddress"`
 	GracefulTimeout time.Duration `yaml:"gracefulTimeout"`
+	// DrainDelay keeps serving after readiness starts failing on shutdown, so load balancers and endpoint
+	// controllers stop routing new work before the listeners close.
+	DrainDelay time.Duration `yaml:"drainDelay"`
+	// DebugEndpoints mounts /debug/pprof, /debug/vars, and /debug/goroutines on the metrics listener and allows
+	// changing log levels through /debug/loglevel.
+	DebugEndpoints bool `yaml:"debugEndpoints"`
 }
 
 // ClientsConfig groups integrations with Victoria* backends.
 type ClientsConfig struct {
 	Core CoreClientConfig `yaml:"core"`
+	// MetricsSource selects the metrics backend: "core" (default), "victoriametrics", "prometheus", or "otlp".
+	MetricsSource   string                `yaml:"metricsSource"`
+	VictoriaMetrics VictoriaMetricsConfig `yaml:"victoriaMetrics"`
+	Prometheus      PrometheusConfig      `yaml:"prometheus"`
+	// LogsSource selects the logs backend: "core" (default), "victorialogs", "loki", or "otlp".
+	LogsSource   string             `yaml:"logsSource"`
+	VictoriaLogs VictoriaLogsConfig `yaml:"victoriaLogs"`
+	Loki         LokiConfig         `yaml:"loki"`
+	Traces       TracesSourceConfig `yaml:"traces"`
+	// ServiceGraphSource selects the service graph backend: "core" (default), or "otlp" to derive it from the
+	// buffered spans.
+	ServiceGraphSource string `yaml:"serviceGraphSource"`
+}
+
+// TracesSourceConfig selects the trace backend: "core" (default), "jaeger", "tempo", or "otlp".
+type TracesSourceConfig struct {
+	Backend string        `yaml:"backend"`
+	URL     string        `yaml:"url"`
+	Limit   int           `yaml:"limit"`
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// VictoriaLogsConfig configures direct LogsQL queries against VictoriaLogs. Query is a LogsQL template with
+// {service} and {tenant} placeholders.
+type VictoriaLogsConfig struct {
+	BaseURL       string        `yaml:"baseURL"`
+	Query         string        `yaml:"query"`
+	SeverityField string        `yaml:"severityField"`
+	Limit         int           `yaml:"limit"`
+	Timeout       time.Duration `yaml:"timeout"`
+}
+
+// LokiConfig configures direct LogQL queries against Loki. Query is a LogQL log query with {service}, {tenant},
+// and {environment} placeholders; SeverityLabel names the stream label holding the level. Headers are sent with
+// every query with the same placeholders substituted, for example X-Scope-OrgID for a multi-tenant Loki.
+type LokiConfig struct {
+	BaseURL       string            `yaml:"baseURL"`
+	Query         string            `yaml:"query"`
+	SeverityLabel string            `yaml:"severityLabel"`
+	Limit         int               `yaml:"limit"`
+	Headers       map[string]string `yaml:"headers" secret:"true"`
+	Timeout       time.Duration     `yaml:"timeout"`
+}
+
+// VictoriaMetricsConfig configures direct PromQL queries against VictoriaMetrics. Queries maps series names
+// to PromQL templates with {service} and {tenant} placeholders.
+type VictoriaMetricsConfig struct {
+	BaseURL string            `yaml:"baseURL"`
+	Queries map[string]string `yaml:"queries"`
+	Step    time.Duration     `yaml:"step"`
+	Timeout time.Duration     `yaml:"timeout"`
+}
+
+// PrometheusConfig configures Prometheus remote-read against URL, the read endpoint of any compatible TSDB.
+// Selectors maps series names to PromQL series selectors with {service}, {tenant}, and {environment}
+// placeholders; remote read returns raw samples, so selectors should name recording rules or other series that
+// already hold rates and ratios. Headers are sent with every read, with {tenant} substituted, for example
+// X-Scope-OrgID for Mimir or Cortex.
+type PrometheusConfig struct {
+	URL       string            `yaml:"url"`
+	Selectors map[string]string `yaml:"selectors"`
+	Headers   map[string]string `yaml:"headers" secret:"true"`
+	Step      time.Duration     `yaml:"step"`
+	Timeout   time.Duration     `yaml:"timeout"`
 }
 
 // CoreClientConfig configures access to mirador-core data aggregation APIs.
@@ -42,13 +214,104 @@ type CoreClientConfig struct {
 	TracesPath       string        `yaml:"tracesPath"`
 	ServiceGraphPath string        `yaml:"serviceGraphPath"`
 	Timeout          time.Duration `yaml:"timeout"`
+	// Timeouts caps each endpoint independently; zero values fall back to Timeout.
+	Timeouts SignalTimeoutsConfig `yaml:"timeouts"`
+	// Metrics lists named series (latency, error rate, saturation, ...) fetched per service; empty keeps the
+	// legacy single-series request.
+	Metrics        []string             `yaml:"metrics"`
+	CircuitBreaker CircuitBreakerConfig `yaml:"circuitBreaker"`
+	Auth           CoreAuthConfig       `yaml:"auth"`
+	// PageSize and MaxItems bound log/trace fetches: results are requested page by page and accumulation
+	// stops once MaxItems entries are held. Zero leaves the page size to the server and disables the cap.
+	PageSize int `yaml:"pageSize"`
+	MaxItems int `yaml:"maxItems"`
+	// BaselineDays enables historical-baseline scoring against the same window N days earlier; 0 disables it.
+	BaselineDays int `yaml:"baselineDays"`
+	// HealthPath is requested by the readiness probe to check that mirador-core is reachable.
+	HealthPath string `yaml:"healthPath"`
+	// SLOPath, when set, is requested for the SLO definitions of the investigated service.
+	SLOPath string `yaml:"sloPath"`
+}
+
+// SignalTimeoutsConfig holds per-endpoint deadlines for mirador-core calls.
+type SignalTimeoutsConfig struct {
+	Metrics      time.Duration `yaml:"metrics"`
+	Logs         time.Duration `yaml:"logs"`
+	Traces       time.Duration `yaml:"traces"`
+	ServiceGraph time.Duration `yaml:"serviceGraph"`
+	Baseline     time.Duration `yaml:"baseline"`
+}
+
+// InvestigationConfig controls investigation-wide limits.
+type InvestigationConfig struct {
+	Budget   time.Duration  `yaml:"budget"`
+	Executor ExecutorConfig `yaml:"executor"`
+	Claims   ClaimsConfig   `yaml:"claims"`
+	// Expansion widens signal windows too sparse to analyse.
+	Expansion ExpansionConfig `yaml:"expansion"`
+}
+
+// ExecutorConfig bounds concurrent InvestigateIncident calls. MaxConcurrent pipelines run at once; up to
+// QueueDepth more wait for a slot, at most TenantQueueDepth of them per tenant (0 means QueueDepth). Calls
+// beyond the queue fail with ResourceExhausted.
+type ExecutorConfig struct {
+	MaxConcurrent    int `yaml:"maxConcurrent"`
+	QueueDepth       int `yaml:"queueDepth"`
+	TenantQueueDepth int `yaml:"tenantQueueDepth"`
+}
+
+// ClaimsConfig deduplicates investigations of one incident that arrive together, on any replica sharing the
+// cache: the first caller claims the incident and the others receive its result, as do callers within Hold
+// after it finishes. Incidents are matched by alert fingerprints, or by incident ID without them.
+type ClaimsConfig struct {
+	Enabled bool          `yaml:"enabled"`
+	Hold    time.Duration `yaml:"hold"`
+}
+
+// ExpansionConfig re-fetches an investigation's signals over a wider window while the metrics or logs hold
+// fewer than MinSamples samples, doubling the window each time until it has grown by MaxExpansion in total.
+// The window's end never moves past the current time.
+type ExpansionConfig struct {
+	Enabled      bool          `yaml:"enabled"`
+	MinSamples   int           `yaml:"minSamples"`
+	MaxExpansion time.Duration `yaml:"maxExpansion"`
+}
+
+// CoreAuthConfig configures credentials for secured mirador-core deployments. TenantTokens maps tenant IDs to
+// bearer tokens that override BearerToken for that tenant.
+type CoreAuthConfig struct {
+	BearerToken  string            `yaml:"bearerToken" secret:"true"`
+	APIKey       string            `yaml:"apiKey" secret:"true"`
+	APIKeyHeader string            `yaml:"apiKeyHeader"`
+	Headers      map[string]string `yaml:"headers" secret:"true"`
+	TenantTokens map[string]string `yaml:"tenantTokens" secret:"true"`
 }
 
 // WeaviateConfig configures the similarity search cluster.
 type WeaviateConfig struct {
-	Endpoint string        `yaml:"endpoint"`
-	APIKey   string        `yaml:"apiKey"`
-	Timeout  time.Duration `yaml:"timeout"`
+	Endpoint       string               `yaml:"endpoint"`
+	APIKey         string               `yaml:"apiKey" secret:"true"`
+	Timeout        time.Duration        `yaml:"timeout"`
+	CircuitBreaker CircuitBreakerConfig `yaml:"circuitBreaker"`
+}
+
+// HistoryConfig selects where correlations, patterns, and feedback are stored: "weaviate" (default),
+// "postgres", or "memory". The weaviate backend without an endpoint falls back to memory.
+type HistoryConfig struct {
+	Backend  string            `yaml:"backend"`
+	Postgres PostgresConfig    `yaml:"postgres"`
+	Memory   MemoryStoreConfig `yaml:"memory"`
+}
+
+// MemoryStoreConfig configures the embedded development store; an empty Path keeps history in memory only.
+type MemoryStoreConfig struct {
+	Path string `yaml:"path"`
+}
+
+// PostgresConfig configures the PostgreSQL history backend. Migrations run at startup.
+type PostgresConfig struct {
+	DSN          string `yaml:"dsn" secret:"true"`
+	MaxOpenConns int    `yaml:"maxOpenConns"`
 }
 
 // LoggingConfig controls structured logging.
@@ -57,9 +320,442 @@ type LoggingConfig struct {
 	JSON  bool   `yaml:"json"`
 }
 
+// ReloadConfig controls configuration hot reload. SIGHUP always triggers a reload.
+type ReloadConfig struct {
+	// Interval is how often the config file's modification time is checked; zero disables polling.
+	Interval time.Duration `yaml:"interval"`
+}
+
+// SecretsConfig resolves secret references (file://, vault://, and awssm://) in weaviate.apiKey,
+// cache.password, and notification channel URLs and headers. A backend is only needed when a reference uses
+// it; empty Vault and AWS settings fall back to the standard VAULT_* and AWS_* environment variables.
+type SecretsConfig struct {
+	// RefreshInterval re-resolves the references so rotated secrets apply without a restart; zero resolves
+	// them only at startup and on configuration reloads.
+	RefreshInterval time.Duration      `yaml:"refreshInterval"`
+	Vault           VaultSecretsConfig `yaml:"vault"`
+	AWS             AWSSecretsConfig   `yaml:"aws"`
+}
+
+// VaultSecretsConfig points vault:// references at a Vault server. TokenFile is re-read on every lookup.
+type VaultSecretsConfig struct {
+	Address   string        `yaml:"address"`
+	Token     string        `yaml:"token" secret:"true"`
+	TokenFile string        `yaml:"tokenFile"`
+	Namespace string        `yaml:"namespace"`
+	Timeout   time.Duration `yaml:"timeout"`
+}
+
+// AWSSecretsConfig points awssm:// references at AWS Secrets Manager.
+type AWSSecretsConfig struct {
+	Region          string        `yaml:"region"`
+	Endpoint        string        `yaml:"endpoint"`
+	AccessKeyID     string        `yaml:"accessKeyId"`
+	SecretAccessKey string        `yaml:"secretAccessKey" secret:"true"`
+	SessionToken    string        `yaml:"sessionToken" secret:"true"`
+	Timeout         time.Duration `yaml:"timeout"`
+}
+
+// RemoteConfig overlays the settings listed in RemoteKeys with keys stored below Prefix in etcd or Consul,
+// applying changes without a restart. A key such as <prefix>/cache/metricsTTL sets cache.metricsTTL; values
+// are YAML. A list value, such as <prefix>/watch/targets, replaces the whole list from the files.
+type RemoteConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Backend is "etcd" (v3 JSON gateway) or "consul".
+	Backend  string `yaml:"backend"`
+	Endpoint string `yaml:"endpoint"`
+	Prefix   string `yaml:"prefix"`
+	// Token is a Consul ACL token; Username and Password authenticate against etcd.
+	Token    string `yaml:"token" secret:"true"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password" secret:"true"`
+	// PollInterval is the etcd poll interval and the Consul blocking-query wait.
+	PollInterval time.Duration `yaml:"pollInterval"`
+	Timeout      time.Duration `yaml:"timeout"`
+}
+
 // RulesConfig controls rule-pack loading for the recommender.
 type RulesConfig struct {
 	Path string `yaml:"path"`
+	// ReloadInterval is how often the rule file is checked for changes; zero disables polling. SIGHUP always
+	// triggers a reload.
+	ReloadInterval time.Duration `yaml:"reloadInterval"`
+}
+
+// ExtractorsConfig selects which registered anomaly detectors run, globally and per tenant.
+type ExtractorsConfig struct {
+	Enabled  []string              `yaml:"enabled"`
+	Tenants  map[string][]string   `yaml:"tenants"`
+	External ExternalScoringConfig `yaml:"external"`
+	Shadow   ShadowConfig          `yaml:"shadow"`
+}
+
+// PresetConfig bundles investigation settings callers select by preset name. Unset fields keep the values of
+// the built-in preset with the same name, or the engine defaults.
+type PresetConfig struct {
+	// Padding widens the request window on both sides; the end never moves past the current time.
+	Padding time.Duration `yaml:"padding"`
+	// Extractors replaces the tenant's enabled detectors.
+	Extractors  []string `yaml:"extractors"`
+	MaxAnchors  int      `yaml:"maxAnchors"`
+	MaxTimeline int      `yaml:"maxTimeline"`
+	// CausalityDepth is how many service-graph hops upstream causality follows.
+	CausalityDepth int `yaml:"causalityDepth"`
+}
+
+// ServiceNamesConfig normalises service names before analysis. Rules rewrite names in order, then Aliases,
+// keyed by canonical name, map the remaining spellings case-insensitively.
+type ServiceNamesConfig struct {
+	Aliases map[string][]string     `yaml:"aliases"`
+	Rules   []ServiceNameRuleConfig `yaml:"rules"`
+}
+
+// CapacityConfig fetches the resource utilisation of each investigation's suspected root service. Resources
+// maps resource names (cpu, memory, connection_pool, queue_depth, ...) to series reporting utilisation as a
+// fraction of the resource's limit: mirador-core metric names, PromQL templates when clients.metricsSource
+// is victoriametrics, or series selectors when it is prometheus. A resource counts as saturated once it reaches Saturation.
+type CapacityConfig struct {
+	Enabled    bool              `yaml:"enabled"`
+	Resources  map[string]string `yaml:"resources"`
+	Saturation float64           `yaml:"saturation"`
+}
+
+// OTLPConfig serves an OTLP receiver over gRPC on GRPCAddress and over HTTP on HTTPAddress; an empty address
+// disables that transport. Received signals are buffered for Retention, keeping at most MaxItems records of each
+// signal per tenant and at most MaxTenants tenants. Exports are attributed to the tenant named by the
+// TenantHeader header or metadata, or to DefaultTenant without it; a non-empty Tenants rejects any other tenant.
+type OTLPConfig struct {
+	Enabled       bool          `yaml:"enabled"`
+	GRPCAddress   string        `yaml:"grpcAddress"`
+	HTTPAddress   string        `yaml:"httpAddress"`
+	Retention     time.Duration `yaml:"retention"`
+	MaxItems      int           `yaml:"maxItems"`
+	MaxTenants    int           `yaml:"maxTenants"`
+	TenantHeader  string        `yaml:"tenantHeader"`
+	DefaultTenant string        `yaml:"defaultTenant"`
+	Tenants       []string      `yaml:"tenants"`
+}
+
+// ImpactConfig measures the error budget burn of the investigated service's SLOs during the incident window.
+// SLOs maps services to their SLO definitions and replaces same-named ones fetched from
+// clients.core.sloPath. A window burning the budget at CriticalBurnRate or faster scores an impact of 1.
+type ImpactConfig struct {
+	Enabled          bool                          `yaml:"enabled"`
+	CriticalBurnRate float64                       `yaml:"criticalBurnRate"`
+	SLOs             map[string][]ServiceSLOConfig `yaml:"slos"`
+}
+
+// ServiceSLOConfig defines one SLO. Indicator names the metric series measuring it: the fraction of bad
+// events, or with a Threshold, a value that is bad above it. Period defaults to 30 days.
+type ServiceSLOConfig struct {
+	Name      string        `yaml:"name"`
+	Indicator string        `yaml:"indicator"`
+	Objective float64       `yaml:"objective"`
+	Threshold float64       `yaml:"threshold"`
+	Period    time.Duration `yaml:"period"`
+}
+
+// ServiceNameRuleConfig rewrites names matching the Pattern regular expression to Replace, which may use $1.
+type ServiceNameRuleConfig struct {
+	Pattern string `yaml:"pattern"`
+	Replace string `yaml:"replace"`
+}
+
+// ShadowConfig runs a candidate detector set alongside the enabled one during live investigations. Its
+// results are logged and compared in metrics but never returned; an empty Enabled list turns it off.
+type ShadowConfig struct {
+	Enabled []string `yaml:"enabled"`
+	// Threshold replaces the request's anomaly threshold for the shadow detectors when positive.
+	Threshold   float64 `yaml:"threshold"`
+	SampleRatio float64 `yaml:"sampleRatio"`
+	// MaxConcurrent bounds the shadow comparisons running at once; sampled investigations beyond it are
+	// dropped.
+	MaxConcurrent int `yaml:"maxConcurrent"`
+}
+
+// ExternalScoringConfig points the "external" extractor at a model server (e.g. mirador-predict).
+type ExternalScoringConfig struct {
+	Endpoint string        `yaml:"endpoint"`
+	Timeout  time.Duration `yaml:"timeout"`
+}
+
+// LinksConfig holds dashboard URL templates used to deep-link anchors and timeline events.
+type LinksConfig struct {
+	AnchorTemplate   string        `yaml:"anchorTemplate"`
+	TimelineTemplate string        `yaml:"timelineTemplate"`
+	Padding          time.Duration `yaml:"padding"`
+	// TraceTemplate opens a trace exemplar in the tracing UI; it may also use {trace_id} and {span_id}.
+	TraceTemplate string `yaml:"traceTemplate"`
+}
+
+// SummaryConfig renders a narrative paragraph for every correlation from Go text/templates. Templates maps
+// locales to templates and falls back to the built-in English narrative; Tenants overrides the locale or the
+// template of individual tenants.
+type SummaryConfig struct {
+	Enabled   bool                           `yaml:"enabled"`
+	Locale    string                         `yaml:"locale"`
+	Templates map[string]string              `yaml:"templates"`
+	Tenants   map[string]SummaryTenantConfig `yaml:"tenants"`
+}
+
+// SummaryTenantConfig picks one tenant's summary locale or, with Template, replaces its template outright.
+type SummaryTenantConfig struct {
+	Locale   string `yaml:"locale"`
+	Template string `yaml:"template"`
+}
+
+// LLMConfig lets a language model behind an OpenAI-compatible chat completions API write correlation
+// summaries and suggest extra recommendations. Endpoint is the API base URL. Each call is bounded by Timeout;
+// when it fails, the template summary is kept. The llm_narration feature flag limits it to some tenants.
+type LLMConfig struct {
+	Enabled            bool               `yaml:"enabled"`
+	Endpoint           string             `yaml:"endpoint"`
+	APIKey             string             `yaml:"apiKey" secret:"true"`
+	Headers            map[string]string  `yaml:"headers" secret:"true"`
+	Model              string             `yaml:"model"`
+	Timeout            time.Duration      `yaml:"timeout"`
+	MaxTokens          int                `yaml:"maxTokens"`
+	Temperature        float64            `yaml:"temperature"`
+	MaxRecommendations int                `yaml:"maxRecommendations"`
+	Redact             LLMRedactionConfig `yaml:"redact"`
+}
+
+// LLMRedactionConfig limits what is sent to the model: only the correlation labels listed in Labels are
+// included, and matches of Patterns, regular expressions, are replaced along with the built-in ones.
+type LLMRedactionConfig struct {
+	Labels   []string `yaml:"labels"`
+	Patterns []string `yaml:"patterns"`
+}
+
+// RetentionConfig schedules deletion of correlation and feedback history. Tenants maps tenant IDs to their
+// retention age; a zero age, or a stored tenant that is not listed, uses DefaultAge.
+type RetentionConfig struct {
+	Enabled    bool                     `yaml:"enabled"`
+	Interval   time.Duration            `yaml:"interval"`
+	DefaultAge time.Duration            `yaml:"defaultAge"`
+	DryRun     bool                     `yaml:"dryRun"`
+	Tenants    map[string]time.Duration `yaml:"tenants"`
+}
+
+// ArchiveConfig exports correlations to object storage as gzip NDJSON every Interval. Provider is "s3"
+// (including S3-compatible stores via Endpoint) or "gcs" (HMAC keys against the XML API).
+type ArchiveConfig struct {
+	Enabled         bool          `yaml:"enabled"`
+	Provider        string        `yaml:"provider"`
+	Bucket          string        `yaml:"bucket"`
+	Prefix          string        `yaml:"prefix"`
+	Interval        time.Duration `yaml:"interval"`
+	Endpoint        string        `yaml:"endpoint"`
+	Region          string        `yaml:"region"`
+	PathStyle       bool          `yaml:"pathStyle"`
+	AccessKeyID     string        `yaml:"accessKeyId"`
+	SecretAccessKey string        `yaml:"secretAccessKey" secret:"true"`
+	SessionToken    string        `yaml:"sessionToken" secret:"true"`
+	Timeout         time.Duration `yaml:"timeout"`
+	Tenants         []string      `yaml:"tenants"`
+}
+
+// PatternsConfig schedules failure-pattern mining. Tenants maps tenant IDs to cron expressions; an empty
+// expression uses Schedule. Replicas coordinate through the Valkey cache when it is enabled.
+type PatternsConfig struct {
+	Enabled         bool          `yaml:"enabled"`
+	Schedule        string        `yaml:"schedule"`
+	Lookback        time.Duration `yaml:"lookback"`
+	MaxCorrelations int           `yaml:"maxCorrelations"`
+	// MinCoOccurrence is how many correlations must share anomalous services before a cross-service pattern
+	// is emitted.
+	MinCoOccurrence int               `yaml:"minCoOccurrence"`
+	Tenants         map[string]string `yaml:"tenants"`
+}
+
+// TuningConfig recommends a per-service anomaly threshold from each tenant's correlation history every
+// Interval. Services need MinCorrelations correlations with metric anchors; with MinFeedback verdicts, at least
+// one of them wrong, feedback decides the threshold, and otherwise it keeps TargetAnchors metric anchors per
+// correlation. The threshold_tuning feature flag applies the recommendations to investigations.
+type TuningConfig struct {
+	Enabled         bool          `yaml:"enabled"`
+	Interval        time.Duration `yaml:"interval"`
+	Lookback        time.Duration `yaml:"lookback"`
+	MaxCorrelations int           `yaml:"maxCorrelations"`
+	MinCorrelations int           `yaml:"minCorrelations"`
+	MinFeedback     int           `yaml:"minFeedback"`
+	TargetAnchors   float64       `yaml:"targetAnchors"`
+	MinThreshold    float64       `yaml:"minThreshold"`
+	MaxThreshold    float64       `yaml:"maxThreshold"`
+	// Tenants are tuned from startup; others once they have been investigated.
+	Tenants []string `yaml:"tenants"`
+}
+
+// TopologyConfig snapshots each tenant's service graph into the history store every Interval, once per
+// environment in Environments, and flags dependencies that appeared or disappeared in the Lookback before an
+// incident's first anomaly as timeline events.
+type TopologyConfig struct {
+	Enabled  bool          `yaml:"enabled"`
+	Interval time.Duration `yaml:"interval"`
+	Lookback time.Duration `yaml:"lookback"`
+	// Environments of Tenants to snapshot; empty snapshots their unscoped graph.
+	Environments []string `yaml:"environments"`
+	// Tenants are snapshotted from startup; others in the environments they have been investigated in, until
+	// those go uninvestigated for ScopeTTL.
+	Tenants  []string      `yaml:"tenants"`
+	ScopeTTL time.Duration `yaml:"scopeTTL"`
+	// Retention deletes snapshots older than this; drift only reads the Lookback before an incident.
+	Retention time.Duration `yaml:"retention"`
+}
+
+// ClusteringConfig links each new correlation to correlations stored within Window that share its services
+// and dominant anchors, marking it as a duplicate or related result.
+type ClusteringConfig struct {
+	Enabled bool          `yaml:"enabled"`
+	Window  time.Duration `yaml:"window"`
+}
+
+// IntegrationsConfig serves incident-management webhooks on Address. Each accepted incident is investigated
+// over the Lookback window before it fired, and the root cause is posted back as a note.
+type IntegrationsConfig struct {
+	Address       string                     `yaml:"address"`
+	Lookback      time.Duration              `yaml:"lookback"`
+	Timeout       time.Duration              `yaml:"timeout"`
+	MaxConcurrent int                        `yaml:"maxConcurrent"`
+	PagerDuty     PagerDutyIntegrationConfig `yaml:"pagerduty"`
+	Opsgenie      OpsgenieIntegrationConfig  `yaml:"opsgenie"`
+}
+
+// PagerDutyIntegrationConfig enables PagerDuty v3 webhooks at /webhooks/pagerduty. PagerDuty incidents name
+// no environment, so each is investigated in Environment; empty leaves it unscoped.
+type PagerDutyIntegrationConfig struct {
+	Enabled       bool   `yaml:"enabled"`
+	TenantID      string `yaml:"tenantId"`
+	Environment   string `yaml:"environment"`
+	WebhookSecret string `yaml:"webhookSecret" secret:"true"`
+	APIToken      string `yaml:"apiToken" secret:"true"`
+	FromEmail     string `yaml:"fromEmail"`
+	APIURL        string `yaml:"apiURL"`
+}
+
+// OpsgenieIntegrationConfig enables Opsgenie outgoing webhooks at /webhooks/opsgenie. Alerts are investigated
+// in the environment of their "environment" detail or env: tag, or else in Environment.
+type OpsgenieIntegrationConfig struct {
+	Enabled      bool   `yaml:"enabled"`
+	TenantID     string `yaml:"tenantId"`
+	Environment  string `yaml:"environment"`
+	WebhookToken string `yaml:"webhookToken" secret:"true"`
+	APIKey       string `yaml:"apiKey" secret:"true"`
+	APIURL       string `yaml:"apiURL"`
+}
+
+// NotificationsConfig pushes completed correlations to chat and webhook channels selected by routes.
+type NotificationsConfig struct {
+	Enabled  bool                        `yaml:"enabled"`
+	Timeout  time.Duration               `yaml:"timeout"`
+	Channels []NotificationChannelConfig `yaml:"channels"`
+	Routes   []NotificationRouteConfig   `yaml:"routes"`
+}
+
+// NotificationChannelConfig is a named destination. Type is slack, teams, or webhook; Template is a Go
+// text/template and falls back to the built-in summary when empty. Headers apply to webhook channels only.
+type NotificationChannelConfig struct {
+	Name     string            `yaml:"name"`
+	Type     string            `yaml:"type"`
+	URL      string            `yaml:"url" secret:"true"`
+	Template string            `yaml:"template"`
+	Headers  map[string]string `yaml:"headers" secret:"true"`
+}
+
+// NotificationRouteConfig sends matching correlations to Channels. Empty Tenants and Categories match all.
+type NotificationRouteConfig struct {
+	Tenants           []string `yaml:"tenants"`
+	Categories        []string `yaml:"categories"`
+	MinConfidence     float64  `yaml:"minConfidence"`
+	IncludeDuplicates bool     `yaml:"includeDuplicates"`
+	Channels          []string `yaml:"channels"`
+}
+
+// TicketingConfig opens a Jira issue or ServiceNow record for each correlation of the listed tenants (all
+// when empty) whose confidence reaches MinConfidence. SummaryTemplate is a Go text/template.
+type TicketingConfig struct {
+	Enabled         bool                      `yaml:"enabled"`
+	Provider        string                    `yaml:"provider"`
+	MinConfidence   float64                   `yaml:"minConfidence"`
+	Tenants         []string                  `yaml:"tenants"`
+	SummaryTemplate string                    `yaml:"summaryTemplate"`
+	Timeout         time.Duration             `yaml:"timeout"`
+	Jira            JiraTicketingConfig       `yaml:"jira"`
+	ServiceNow      ServiceNowTicketingConfig `yaml:"servicenow"`
+}
+
+// JiraTicketingConfig selects the Jira project and issue type for new issues.
+type JiraTicketingConfig struct {
+	BaseURL   string   `yaml:"baseURL"`
+	Email     string   `yaml:"email"`
+	APIToken  string   `yaml:"apiToken" secret:"true"`
+	Project   string   `yaml:"project"`
+	IssueType string   `yaml:"issueType"`
+	Labels    []string `yaml:"labels"`
+}
+
+// ServiceNowTicketingConfig selects the ServiceNow table and assignment group (queue) for new records.
+type ServiceNowTicketingConfig struct {
+	InstanceURL     string `yaml:"instanceURL"`
+	Username        string `yaml:"username"`
+	Password        string `yaml:"password" secret:"true"`
+	Table           string `yaml:"table"`
+	AssignmentGroup string `yaml:"assignmentGroup"`
+}
+
+// KafkaConfig consumes incident events from Topic through a Kafka REST Proxy (v2) at RESTProxyURL and
+// investigates each one; malformed or repeatedly failing events go to DeadLetterTopic.
+type KafkaConfig struct {
+	Enabled         bool          `yaml:"enabled"`
+	RESTProxyURL    string        `yaml:"restProxyURL"`
+	Username        string        `yaml:"username"`
+	Password        string        `yaml:"password" secret:"true"`
+	Group           string        `yaml:"group"`
+	Topic           string        `yaml:"topic"`
+	DeadLetterTopic string        `yaml:"deadLetterTopic"`
+	PollTimeout     time.Duration `yaml:"pollTimeout"`
+	MaxConcurrent   int           `yaml:"maxConcurrent"`
+	MaxAttempts     int           `yaml:"maxAttempts"`
+	RetryBackoff    time.Duration `yaml:"retryBackoff"`
+	Lookback        time.Duration `yaml:"lookback"`
+	Timeout         time.Duration `yaml:"timeout"`
+}
+
+// WatchConfig periodically scans the listed services and investigates them before an incident is filed.
+type WatchConfig struct {
+	Enabled bool                `yaml:"enabled"`
+	Timeout time.Duration       `yaml:"timeout"`
+	Targets []WatchTargetConfig `yaml:"targets"`
+}
+
+// WatchTargetConfig scans Service every Interval over the trailing Window; an investigation starts once the
+// scan finds MinDensity anomalies per minute, and the service is then left alone for Cooldown.
+type WatchTargetConfig struct {
+	TenantID   string        `yaml:"tenantId"`
+	Service    string        `yaml:"service"`
+	Interval   time.Duration `yaml:"interval"`
+	Window     time.Duration `yaml:"window"`
+	MinDensity float64       `yaml:"minDensity"`
+	Threshold  float64       `yaml:"threshold"`
+	Cooldown   time.Duration `yaml:"cooldown"`
+	// Environment scopes the scans and investigations; empty leaves them unscoped.
+	Environment string `yaml:"environment"`
+}
+
+// MaintenanceWindowConfig describes a planned maintenance window; empty services covers the whole tenant.
+type MaintenanceWindowConfig struct {
+	ID       string    `yaml:"id"`
+	TenantID string    `yaml:"tenantId"`
+	Services []string  `yaml:"services"`
+	Start    time.Time `yaml:"start"`
+	End      time.Time `yaml:"end"`
+	Reason   string    `yaml:"reason"`
+}
+
+// CircuitBreakerConfig trips a client after consecutive upstream failures; a zero threshold disables it.
+type CircuitBreakerConfig struct {
+	FailureThreshold int           `yaml:"failureThreshold"`
+	Cooldown         time.Duration `yaml:"cooldown"`
 }
 
 // CacheConfig controls Valkey-backed caching of expensive lookups.
@@ -67,7 +763,7 @@ type CacheConfig struct {
 	Enabled             bool          `yaml:"enabled"`
 	Addr                string        `yaml:"addr"`
 	Username            string        `yaml:"username"`
-	Password            string        `yaml:"password"`
+	Password            string        `yaml:"password" secret:"true"`
 	DB                  int           `yaml:"db"`
 	DialTimeout         time.Duration `yaml:"dialTimeout"`
 	ReadTimeout         time.Duration `yaml:"readTimeout"`
@@ -77,30 +773,84 @@ type CacheConfig struct {
 	SimilarIncidentsTTL time.Duration `yaml:"similarIncidentsTTL"`
 	ServiceGraphTTL     time.Duration `yaml:"serviceGraphTTL"`
 	PatternsTTL         time.Duration `yaml:"patternsTTL"`
+	// NegativeTTL caches empty similar-incident and pattern results from Weaviate; zero disables.
+	NegativeTTL time.Duration `yaml:"negativeTTL"`
+	// MetricsTTL, LogsTTL, and TracesTTL cache signal fetches per tenant/service/window; zero disables.
+	MetricsTTL time.Duration `yaml:"metricsTTL"`
+	LogsTTL    time.Duration `yaml:"logsTTL"`
+	TracesTTL  time.Duration `yaml:"tracesTTL"`
+	// InvestigationTTL serves a repeated InvestigateIncident request (same incident, tenant, services, window to the
+	// minute, and threshold) the result of the first one for this long; zero disables.
+	InvestigationTTL time.Duration `yaml:"investigationTTL"`
+	// TLSCAFile, TLSCertFile, and TLSKeyFile configure a custom CA bundle and a client certificate when TLS
+	// is enabled; TLSInsecureSkipVerify disables server verification for lab setups.
+	TLSCAFile             string `yaml:"tlsCAFile"`
+	TLSCertFile           string `yaml:"tlsCertFile"`
+	TLSKeyFile            string `yaml:"tlsKeyFile"`
+	TLSInsecureSkipVerify bool   `yaml:"tlsInsecureSkipVerify"`
+	// Network is "tcp" or "unix"; with "unix", Addr is the path of a sidecar's socket.
+	Network string `yaml:"network"`
+	// Compression is "none", "snappy", or "gzip"; values of at least CompressionThreshold bytes (zero means
+	// 16KiB) are compressed before they are written.
+	Compression          string `yaml:"compression"`
+	CompressionThreshold int    `yaml:"compressionThreshold"`
+}
+
+// Load initialises Config from YAML files and optional environment overrides. Files are merged in order, so
+// an environment overlay only needs the keys it changes; each file's include list is merged before the file
+// itself. ${VAR} and ${VAR:-default} references are expanded first. Without paths, the comma-separated
+// MIRADOR_RCA_CONFIG is used.
+func Load(paths ...string) (*Config, error) {
+	return load(paths, nil, false)
 }
 
-// Load initialises Config from a YAML file and optional environment overrides.
-func Load(path string) (*Config, error) {
-	if path == "" {
-		path = os.Getenv("MIRADOR_RCA_CONFIG")
+// Overlay is an in-memory layer, such as the keys of a remote store, merged after the configuration files.
+type Overlay struct {
+	Name string
+	Data []byte
+}
+
+// LoadOverlaid is Load, or LoadStrict when strict is set, with overlays merged in order after the files and
+// before environment overrides.
+func LoadOverlaid(strict bool, overlays []Overlay, paths ...string) (*Config, error) {
+	return load(paths, overlays, strict)
+}
+
+func load(paths []string, overlays []Overlay, strict bool) (*Config, error) {
+	layers, err := readLayers(configPaths(paths))
+	if err != nil {
+		return nil, err
+	}
+	for _, overlay := range overlays {
+		layers = append(layers, layer{path: overlay.Name, data: overlay.Data})
 	}
 
 	cfg := defaultConfig()
-
-	if path != "" {
-		data, err := os.ReadFile(path)
-		if err != nil {
-			if errors.Is(err, fs.ErrNotExist) {
-				return nil, fmt.Errorf("config file %s not found: %w", path, err)
+	var problems []string
+	for _, layer := range layers {
+		if err := layer.decode(&cfg, strict); err != nil {
+			var typeErr *yaml.TypeError
+			if !strict || !errors.As(err, &typeErr) {
+				return nil, fmt.Errorf("parse config %s: %w", layer.path, err)
+			}
+			for _, problem := range typeErr.Errors {
+				problems = append(problems, layer.path+": "+problem)
 			}
-			return nil, fmt.Errorf("read config: %w", err)
-		}
-		if err := yaml.Unmarshal(data, &cfg); err != nil {
-			return nil, fmt.Errorf("parse config: %w", err)
 		}
 	}
-
+	cfg.Include = nil
 	applyEnvOverrides(&cfg)
+	if !strict {
+		return &cfg, nil
+	}
+
+	var invalid *ValidationError
+	if err := cfg.Validate(); errors.As(err, &invalid) {
+		problems = append(problems, invalid.Problems...)
+	}
+	if len(problems) > 0 {
+		return nil, &ValidationError{Problems: problems}
+	}
 	return &cfg, nil
 }
 
@@ -110,6 +860,7 @@ func defaultConfig() Config {
 			Address:         ":50051",
 			MetricsAddress:  ":2112",
 			GracefulTimeout: 10 * time.Second,
+			DrainDelay:      5 * time.Second,
 		},
 		Clients: ClientsConfig{
 			Core: CoreClientConfig{
@@ -117,22 +868,113 @@ func defaultConfig() Config {
 				LogsPath:         "/api/v1/rca/logs",
 				TracesPath:       "/api/v1/rca/traces",
 				ServiceGraphPath: "/api/v1/rca/service-graph",
+				HealthPath:       "/healthz",
 				Timeout:          5 * time.Second,
+				CircuitBreaker:   CircuitBreakerConfig{FailureThreshold: 5, Cooldown: 30 * time.Second},
+				PageSize:         1000,
+				MaxItems:         10000,
+			},
+			MetricsSource:   "core",
+			VictoriaMetrics: VictoriaMetricsConfig{Step: 30 * time.Second, Timeout: 5 * time.Second},
+			Prometheus:      PrometheusConfig{Step: 30 * time.Second, Timeout: 5 * time.Second},
+			LogsSource:      "core",
+			Traces:          TracesSourceConfig{Backend: "core", Limit: 100, Timeout: 5 * time.Second},
+			VictoriaLogs: VictoriaLogsConfig{
+				Query:         `service:"{service}"`,
+				SeverityField: "level",
+				Limit:         5000,
+				Timeout:       5 * time.Second,
 			},
+			Loki: LokiConfig{
+				Query:         `{service_name="{service}"}`,
+				SeverityLabel: "level",
+				Limit:         5000,
+				Timeout:       5 * time.Second,
+			},
+		},
+		Weaviate: WeaviateConfig{
+			Timeout:        5 * time.Second,
+			CircuitBreaker: CircuitBreakerConfig{FailureThreshold: 5, Cooldown: 30 * time.Second},
 		},
-		Weaviate: WeaviateConfig{Timeout: 5 * time.Second},
-		Logging:  LoggingConfig{Level: "info", JSON: false},
-		Rules:    RulesConfig{Path: "configs/rules/default.yaml"},
+		History: HistoryConfig{Backend: "weaviate", Postgres: PostgresConfig{MaxOpenConns: 10}},
+		Logging: LoggingConfig{Level: "info", JSON: false},
+		Rules:   RulesConfig{Path: "configs/rules/default.yaml", ReloadInterval: 30 * time.Second},
 		Cache: CacheConfig{
 			Enabled:             false,
 			SimilarIncidentsTTL: 2 * time.Minute,
 			ServiceGraphTTL:     5 * time.Minute,
 			PatternsTTL:         10 * time.Minute,
+			NegativeTTL:         30 * time.Second,
+			Network:             "tcp",
+			Compression:         "none",
 			DialTimeout:         2 * time.Second,
 			ReadTimeout:         500 * time.Millisecond,
 			WriteTimeout:        500 * time.Millisecond,
 			MaxRetries:          2,
 		},
+		Extractors: ExtractorsConfig{
+			Enabled:  []string{"metrics", "logs", "traces"},
+			External: ExternalScoringConfig{Timeout: 2 * time.Second},
+			Shadow:   ShadowConfig{SampleRatio: 1, MaxConcurrent: 4},
+		},
+		Tuning: TuningConfig{
+			Interval: 6 * time.Hour, Lookback: 14 * 24 * time.Hour, MaxCorrelations: 5000, MinCorrelations: 10,
+			MinFeedback: 5, TargetAnchors: 3, MinThreshold: 1.5, MaxThreshold: 10,
+		},
+		Topology:      TopologyConfig{Interval: 5 * time.Minute, Lookback: 30 * time.Minute, ScopeTTL: 24 * time.Hour, Retention: 7 * 24 * time.Hour},
+		Links:         LinksConfig{Padding: 15 * time.Minute},
+		Summary:       SummaryConfig{Enabled: true, Locale: "en"},
+		LLM:           LLMConfig{Timeout: 10 * time.Second, MaxTokens: 400, Temperature: 0.2, MaxRecommendations: 3},
+		Retention:     RetentionConfig{Interval: time.Hour, DefaultAge: 90 * 24 * time.Hour},
+		Patterns:      PatternsConfig{Schedule: "0 */6 * * *", Lookback: 7 * 24 * time.Hour, MaxCorrelations: 5000, MinCoOccurrence: 2},
+		Clustering:    ClusteringConfig{Enabled: true, Window: time.Hour},
+		Integrations:  IntegrationsConfig{Address: ":8090", Lookback: 30 * time.Minute, Timeout: 2 * time.Minute, MaxConcurrent: 4},
+		Notifications: NotificationsConfig{Timeout: 5 * time.Second},
+		Ticketing:     TicketingConfig{Provider: "jira", MinConfidence: 0.8, Timeout: 10 * time.Second},
+		Watch:         WatchConfig{Timeout: 2 * time.Minute},
+		Remote:        RemoteConfig{Prefix: "mirador-rca", PollInterval: 15 * time.Second, Timeout: 5 * time.Second},
+		Tracing:       TracingConfig{SampleRatio: 1, ServiceName: "mirador-rca", Timeout: 10 * time.Second},
+		Metrics:       MetricsConfig{MaxTenants: 20},
+		Health:        HealthConfig{Interval: 15 * time.Second, Timeout: 5 * time.Second},
+		Audit:         AuditConfig{Sink: "file", Timeout: 5 * time.Second, BufferSize: 1024},
+		Archive:       ArchiveConfig{Provider: "s3", Prefix: "mirador-rca/correlations", Interval: time.Hour, Timeout: 30 * time.Second},
+		SLO: SLOConfig{
+			Enabled:          true,
+			LatencyThreshold: 4 * time.Second,
+			Objective:        0.95,
+			Windows:          []time.Duration{15 * time.Minute, time.Hour, 6 * time.Hour},
+			ReportInterval:   5 * time.Minute,
+		},
+		Kafka: KafkaConfig{
+			Group:           "mirador-rca",
+			Topic:           "incidents",
+			DeadLetterTopic: "incidents.dlq",
+			PollTimeout:     5 * time.Second,
+			MaxConcurrent:   4,
+			MaxAttempts:     3,
+			RetryBackoff:    5 * time.Second,
+			Lookback:        30 * time.Minute,
+			Timeout:         2 * time.Minute,
+		},
+		Capacity: CapacityConfig{Saturation: 0.9},
+		Impact:   ImpactConfig{CriticalBurnRate: 14.4},
+		OTLP: OTLPConfig{
+			GRPCAddress:  ":4317",
+			HTTPAddress:  ":4318",
+			Retention:    2 * time.Hour,
+			MaxItems:     100000,
+			TenantHeader: "X-Scope-OrgID",
+		},
+		Investigation: InvestigationConfig{
+			Budget:   20 * time.Second,
+			Executor: ExecutorConfig{MaxConcurrent: 16, QueueDepth: 64, TenantQueueDepth: 16},
+			Claims:   ClaimsConfig{Enabled: true, Hold: time.Minute},
+			Expansion: ExpansionConfig{
+				Enabled:      true,
+				MinSamples:   10,
+				MaxExpansion: time.Hour,
+			},
+		},
 	}
 }
 
@@ -143,6 +985,9 @@ func applyEnvOverrides(cfg *Config) {
 	if v := os.Getenv("MIRADOR_RCA_METRICS_ADDRESS"); v != "" {
 		cfg.Server.MetricsAddress = v
 	}
+	if v := os.Getenv("MIRADOR_RCA_DEBUG_ENDPOINTS"); v != "" {
+		cfg.Server.DebugEndpoints = strings.EqualFold(v, "true") || strings.EqualFold(v, "1")
+	}
 	if v := os.Getenv("MIRADOR_CORE_BASE_URL"); v != "" {
 		cfg.Clients.Core.BaseURL = v
 	}
@@ -158,12 +1003,109 @@ func applyEnvOverrides(cfg *Config) {
 	if v := os.Getenv("MIRADOR_CORE_SERVICE_GRAPH_PATH"); v != "" {
 		cfg.Clients.Core.ServiceGraphPath = v
 	}
+	if v := os.Getenv("MIRADOR_RCA_METRICS_SOURCE"); v != "" {
+		cfg.Clients.MetricsSource = v
+	}
+	if v := os.Getenv("MIRADOR_VICTORIAMETRICS_URL"); v != "" {
+		cfg.Clients.VictoriaMetrics.BaseURL = v
+	}
+	if v := os.Getenv("MIRADOR_PROMETHEUS_URL"); v != "" {
+		cfg.Clients.Prometheus.URL = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_LOGS_SOURCE"); v != "" {
+		cfg.Clients.LogsSource = v
+	}
+	if v := os.Getenv("MIRADOR_VICTORIALOGS_URL"); v != "" {
+		cfg.Clients.VictoriaLogs.BaseURL = v
+	}
+	if v := os.Getenv("MIRADOR_LOKI_URL"); v != "" {
+		cfg.Clients.Loki.BaseURL = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_TRACES_BACKEND"); v != "" {
+		cfg.Clients.Traces.Backend = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_TRACES_URL"); v != "" {
+		cfg.Clients.Traces.URL = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_INVESTIGATION_BUDGET"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.Investigation.Budget = d
+		}
+	}
+	if v := os.Getenv("MIRADOR_CORE_BEARER_TOKEN"); v != "" {
+		cfg.Clients.Core.Auth.BearerToken = v
+	}
+	if v := os.Getenv("MIRADOR_CORE_API_KEY"); v != "" {
+		cfg.Clients.Core.Auth.APIKey = v
+	}
+	if v := os.Getenv("MIRADOR_CORE_METRICS"); v != "" {
+		cfg.Clients.Core.Metrics = splitList(v)
+	}
+	if v := os.Getenv("MIRADOR_CORE_BASELINE_DAYS"); v != "" {
+		if days, err := strconv.Atoi(v); err == nil {
+			cfg.Clients.Core.BaselineDays = days
+		}
+	}
+	if v := os.Getenv("MIRADOR_RCA_EXTERNAL_SCORING_URL"); v != "" {
+		cfg.Extractors.External.Endpoint = v
+	}
 	if v := os.Getenv("MIRADOR_RCA_WEAVIATE_URL"); v != "" {
 		cfg.Weaviate.Endpoint = v
 	}
 	if v := os.Getenv("MIRADOR_RCA_WEAVIATE_API_KEY"); v != "" {
 		cfg.Weaviate.APIKey = v
 	}
+	if v := os.Getenv("MIRADOR_RCA_LLM_API_KEY"); v != "" {
+		cfg.LLM.APIKey = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_HISTORY_BACKEND"); v != "" {
+		cfg.History.Backend = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_POSTGRES_DSN"); v != "" {
+		cfg.History.Postgres.DSN = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_HISTORY_PATH"); v != "" {
+		cfg.History.Memory.Path = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_CLUSTERING_ENABLED"); v != "" {
+		cfg.Clustering.Enabled = strings.EqualFold(v, "true") || strings.EqualFold(v, "1")
+	}
+	if v := os.Getenv("MIRADOR_RCA_PAGERDUTY_WEBHOOK_SECRET"); v != "" {
+		cfg.Integrations.PagerDuty.WebhookSecret = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_PAGERDUTY_API_TOKEN"); v != "" {
+		cfg.Integrations.PagerDuty.APIToken = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_OPSGENIE_WEBHOOK_TOKEN"); v != "" {
+		cfg.Integrations.Opsgenie.WebhookToken = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_OPSGENIE_API_KEY"); v != "" {
+		cfg.Integrations.Opsgenie.APIKey = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_NOTIFICATIONS_ENABLED"); v != "" {
+		cfg.Notifications.Enabled = strings.EqualFold(v, "true") || strings.EqualFold(v, "1")
+	}
+	if v := os.Getenv("MIRADOR_RCA_JIRA_API_TOKEN"); v != "" {
+		cfg.Ticketing.Jira.APIToken = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_SERVICENOW_PASSWORD"); v != "" {
+		cfg.Ticketing.ServiceNow.Password = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_KAFKA_REST_PROXY_URL"); v != "" {
+		cfg.Kafka.RESTProxyURL = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_KAFKA_PASSWORD"); v != "" {
+		cfg.Kafka.Password = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_ARCHIVE_BUCKET"); v != "" {
+		cfg.Archive.Bucket = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_ARCHIVE_ACCESS_KEY_ID"); v != "" {
+		cfg.Archive.AccessKeyID = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_ARCHIVE_SECRET_ACCESS_KEY"); v != "" {
+		cfg.Archive.SecretAccessKey = v
+	}
 	if v := os.Getenv("MIRADOR_RCA_LOG_LEVEL"); v != "" {
 		cfg.Logging.Level = v
 	}
@@ -176,6 +1118,47 @@ func applyEnvOverrides(cfg *Config) {
 	if v := os.Getenv("MIRADOR_RCA_CACHE_ADDR"); v != "" {
 		cfg.Cache.Addr = v
 	}
+	if v := os.Getenv("MIRADOR_RCA_RELOAD_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.Reload.Interval = d
+		}
+	}
+	if v := os.Getenv("MIRADOR_RCA_SECRETS_REFRESH_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.Secrets.RefreshInterval = d
+		}
+	}
+	if v := os.Getenv("MIRADOR_RCA_REMOTE_ENDPOINT"); v != "" {
+		cfg.Remote.Endpoint = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_REMOTE_TOKEN"); v != "" {
+		cfg.Remote.Token = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_REMOTE_PASSWORD"); v != "" {
+		cfg.Remote.Password = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_TRACING_ENABLED"); v != "" {
+		cfg.Tracing.Enabled = strings.EqualFold(v, "true") || strings.EqualFold(v, "1")
+	}
+	if v := os.Getenv("MIRADOR_RCA_TRACING_ENDPOINT"); v != "" {
+		cfg.Tracing.Endpoint = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_TRACING_SAMPLE_RATIO"); v != "" {
+		if ratio, err := strconv.ParseFloat(v, 64); err == nil {
+			cfg.Tracing.SampleRatio = ratio
+		}
+	}
+	if v := os.Getenv("MIRADOR_RCA_CACHE_NETWORK"); v != "" {
+		cfg.Cache.Network = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_CACHE_COMPRESSION"); v != "" {
+		cfg.Cache.Compression = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_CACHE_COMPRESSION_THRESHOLD"); v != "" {
+		if threshold, err := strconv.Atoi(v); err == nil {
+			cfg.Cache.CompressionThreshold = threshold
+		}
+	}
 	if v := os.Getenv("MIRADOR_RCA_CACHE_ENABLED"); v != "" {
 		cfg.Cache.Enabled = strings.EqualFold(v, "true") || strings.EqualFold(v, "1")
 	}
@@ -193,6 +1176,18 @@ func applyEnvOverrides(cfg *Config) {
 	if v := os.Getenv("MIRADOR_RCA_CACHE_TLS"); strings.EqualFold(v, "true") || strings.EqualFold(v, "1") {
 		cfg.Cache.TLS = true
 	}
+	if v := os.Getenv("MIRADOR_RCA_CACHE_TLS_CA_FILE"); v != "" {
+		cfg.Cache.TLSCAFile = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_CACHE_TLS_CERT_FILE"); v != "" {
+		cfg.Cache.TLSCertFile = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_CACHE_TLS_KEY_FILE"); v != "" {
+		cfg.Cache.TLSKeyFile = v
+	}
+	if v := os.Getenv("MIRADOR_RCA_CACHE_TLS_INSECURE_SKIP_VERIFY"); strings.EqualFold(v, "true") || strings.EqualFold(v, "1") {
+		cfg.Cache.TLSInsecureSkipVerify = true
+	}
 	if v := os.Getenv("MIRADOR_RCA_CACHE_DIAL_TIMEOUT"); v != "" {
 		if d, err := time.ParseDuration(v); err == nil {
 			cfg.Cache.DialTimeout = d
@@ -228,4 +1223,40 @@ func applyEnvOverrides(cfg *Config) {
 			cfg.Cache.PatternsTTL = d
 		}
 	}
+	if v := os.Getenv("MIRADOR_RCA_CACHE_NEGATIVE_TTL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.Cache.NegativeTTL = d
+		}
+	}
+	if v := os.Getenv("MIRADOR_RCA_CACHE_METRICS_TTL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.Cache.MetricsTTL = d
+		}
+	}
+	if v := os.Getenv("MIRADOR_RCA_CACHE_LOGS_TTL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.Cache.LogsTTL = d
+		}
+	}
+	if v := os.Getenv("MIRADOR_RCA_CACHE_TRACES_TTL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.Cache.TracesTTL = d
+		}
+	}
+	if v := os.Getenv("MIRADOR_RCA_CACHE_INVESTIGATION_TTL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.Cache.InvestigationTTL = d
+		}
+	}
+}
+
+func splitList(value string) []string {
+	parts := strings.Split(value, ",")
+	result := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if trimmed := strings.TrimSpace(part); trimmed != "" {
+			result = append(result, trimmed)
+		}
+	}
+	return result
 }
@@ -0,0 +1,90 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+var envName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// expandEnv substitutes ${VAR} with the variable's value (empty when unset) and ${VAR:-default} with default
+// when VAR is unset or empty, before the YAML is parsed. Defaults may themselves contain references, as in
+// ${A:-${B:-x}}. $${ escapes a literal ${. Bare $VAR is left alone so templates and queries containing $ keep
+// working. Substituted values are not quoted, so references to values that may contain YAML syntax (such as
+// ": " or " #") belong inside a quoted string.
+func expandEnv(data []byte) ([]byte, error) {
+	var errs []string
+	expanded := expandReferences(string(data), &errs)
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("expand environment: %s", strings.Join(errs, "; "))
+	}
+	return []byte(expanded), nil
+}
+
+// expandReferences expands every reference in s, recording malformed ones in errs and leaving them as written.
+func expandReferences(s string, errs *[]string) string {
+	var out strings.Builder
+	for i := 0; i < len(s); {
+		switch {
+		case strings.HasPrefix(s[i:], "$${"):
+			out.WriteString("${")
+			i += 3
+		case strings.HasPrefix(s[i:], "${"):
+			end := closingBrace(s, i+2)
+			if end < 0 {
+				*errs = append(*errs, fmt.Sprintf("unterminated variable reference %q", firstLine(s[i:])))
+				out.WriteString(s[i:])
+				return out.String()
+			}
+			out.WriteString(resolveReference(s[i+2:end], s[i:end+1], errs))
+			i = end + 1
+		default:
+			out.WriteByte(s[i])
+			i++
+		}
+	}
+	return out.String()
+}
+
+// resolveReference returns the value of the reference whose body (between the braces) is body.
+func resolveReference(body, match string, errs *[]string) string {
+	name, fallback, hasDefault := strings.Cut(body, ":-")
+	if !envName.MatchString(name) {
+		*errs = append(*errs, fmt.Sprintf("invalid variable reference %q", match))
+		return match
+	}
+	if value := os.Getenv(name); value != "" || !hasDefault {
+		return value
+	}
+	return expandReferences(fallback, errs)
+}
+
+// closingBrace returns the index of the brace closing a reference whose body starts at from, skipping nested
+// references, or -1 when the line ends first.
+func closingBrace(s string, from int) int {
+	depth := 0
+	for j := from; j < len(s); j++ {
+		switch {
+		case s[j] == '\n':
+			return -1
+		case strings.HasPrefix(s[j:], "$${"):
+			j += 2
+		case strings.HasPrefix(s[j:], "${"):
+			depth++
+			j++
+		case s[j] == '}':
+			if depth == 0 {
+				return j
+			}
+			depth--
+		}
+	}
+	return -1
+}
+
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(s, "\n")
+	return line
+}
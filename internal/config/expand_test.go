@@ -0,0 +1,47 @@
+package config
+
+import "testing"
+
+func TestExpandEnv(t *testing.T) {
+	t.Setenv("RCA_TEST_HOST", "core.internal")
+	t.Setenv("RCA_TEST_EMPTY", "")
+
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "set variable", input: "url: http://${RCA_TEST_HOST}:8080", want: "url: http://core.internal:8080"},
+		{name: "unset variable", input: "key: '${RCA_TEST_UNSET}'", want: "key: ''"},
+		{name: "default for unset", input: "addr: ${RCA_TEST_UNSET:-localhost}", want: "addr: localhost"},
+		{name: "default for empty", input: "addr: ${RCA_TEST_EMPTY:-localhost}", want: "addr: localhost"},
+		{name: "empty default", input: "addr: '${RCA_TEST_UNSET:-}'", want: "addr: ''"},
+		{name: "set variable ignores default", input: "addr: ${RCA_TEST_HOST:-localhost}", want: "addr: core.internal"},
+		{name: "escape", input: "query: '$${service}'", want: "query: '${service}'"},
+		{name: "escape next to reference", input: "x: $${A}${RCA_TEST_HOST}", want: "x: ${A}core.internal"},
+		{name: "bare dollar", input: "query: rate($metric[5m])", want: "query: rate($metric[5m])"},
+		{name: "nested default", input: "addr: ${RCA_TEST_UNSET:-${RCA_TEST_HOST}}", want: "addr: core.internal"},
+		{name: "nested default fallback", input: "addr: ${RCA_TEST_UNSET:-${RCA_TEST_EMPTY:-db}:5432}", want: "addr: db:5432"},
+		{name: "escape inside default", input: "q: '${RCA_TEST_UNSET:-$${x}}'", want: "q: '${x}'"},
+		{name: "invalid name", input: "key: ${1BAD}", wantErr: true},
+		{name: "unterminated", input: "key: ${RCA_TEST_HOST\nother: }", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := expandEnv([]byte(tt.input))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Fatalf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
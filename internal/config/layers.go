@@ -0,0 +1,106 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// layer is one configuration file after environment expansion.
+type layer struct {
+	path string
+	data []byte
+}
+
+// decode merges the layer into cfg: mappings and nested sections merge key by key, while scalars and lists
+// replace what earlier layers set.
+func (l layer) decode(cfg *Config, strict bool) error {
+	decoder := yaml.NewDecoder(bytes.NewReader(l.data))
+	decoder.KnownFields(strict)
+	if err := decoder.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	return nil
+}
+
+// configPaths drops empty paths and falls back to the comma-separated MIRADOR_RCA_CONFIG.
+func configPaths(paths []string) []string {
+	var result []string
+	for _, path := range paths {
+		if path != "" {
+			result = append(result, path)
+		}
+	}
+	if len(result) == 0 {
+		result = splitList(os.Getenv("MIRADOR_RCA_CONFIG"))
+	}
+	return result
+}
+
+// Files lists the files Load reads for paths in merge order, including files pulled in by include
+// directives.
+func Files(paths ...string) ([]string, error) {
+	layers, err := readLayers(configPaths(paths))
+	if err != nil {
+		return nil, err
+	}
+	files := make([]string, 0, len(layers))
+	for _, layer := range layers {
+		files = append(files, layer.path)
+	}
+	return files, nil
+}
+
+// readLayers reads paths in order, placing the files named by each file's include list (resolved relative
+// to that file) before the file itself.
+func readLayers(paths []string) ([]layer, error) {
+	var layers []layer
+	for _, path := range paths {
+		var err error
+		if layers, err = appendLayers(layers, path, nil); err != nil {
+			return nil, err
+		}
+	}
+	return layers, nil
+}
+
+func appendLayers(layers []layer, path string, including []string) ([]layer, error) {
+	path = filepath.Clean(path)
+	for _, parent := range including {
+		if parent == path {
+			return nil, fmt.Errorf("config include cycle: %s -> %s", strings.Join(including, " -> "), path)
+		}
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("config file %s not found: %w", path, err)
+		}
+		return nil, fmt.Errorf("read config: %w", err)
+	}
+	if data, err = expandEnv(data); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	var directives struct {
+		Include []string `yaml:"include"`
+	}
+	if err := yaml.Unmarshal(data, &directives); err != nil {
+		return nil, fmt.Errorf("parse config %s: %w", path, err)
+	}
+	for _, include := range directives.Include {
+		if !filepath.IsAbs(include) {
+			include = filepath.Join(filepath.Dir(path), include)
+		}
+		if layers, err = appendLayers(layers, include, append(including, path)); err != nil {
+			return nil, err
+		}
+	}
+	return append(layers, layer{path: path, data: data}), nil
+}
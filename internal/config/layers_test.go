@@ -0,0 +1,93 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeConfigFile(t *testing.T, path, content string) string {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write %s: %v", path, err)
+	}
+	return path
+}
+
+func TestLoadRejectsIncludeCycles(t *testing.T) {
+	dir := t.TempDir()
+	a := writeConfigFile(t, filepath.Join(dir, "a.yaml"), "include: [b.yaml]\n")
+	writeConfigFile(t, filepath.Join(dir, "b.yaml"), "include: [a.yaml]\n")
+
+	_, err := Load(a)
+	if err == nil || !strings.Contains(err.Error(), "config include cycle") {
+		t.Fatalf("expected an include cycle error, got %v", err)
+	}
+}
+
+func TestLoadMergesLayersInOrder(t *testing.T) {
+	dir := t.TempDir()
+	writeConfigFile(t, filepath.Join(dir, "shared", "core.yaml"), `
+clients:
+  core:
+    baseURL: http://core-shared:8080
+    metrics: [latency, errors]
+    auth:
+      headers:
+        X-Team: rca
+        X-Env: shared
+`)
+	base := writeConfigFile(t, filepath.Join(dir, "base.yaml"), `
+include: [shared/core.yaml]
+clients:
+  core:
+    timeout: 3s
+    metricsPath: /base/metrics
+`)
+	prod := writeConfigFile(t, filepath.Join(dir, "overlays", "prod.yaml"), `
+clients:
+  core:
+    baseURL: http://core-prod:8080
+    metrics: [saturation]
+    auth:
+      headers:
+        X-Env: prod
+`)
+
+	files, err := Files(base, prod)
+	if err != nil {
+		t.Fatalf("files: %v", err)
+	}
+	want := []string{filepath.Join(dir, "shared", "core.yaml"), base, prod}
+	if !slices.Equal(files, want) {
+		t.Fatalf("expected includes resolved relative to their file and merged first, got %v", files)
+	}
+
+	cfg, err := Load(base, prod)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	core := cfg.Clients.Core
+	if core.BaseURL != "http://core-prod:8080" {
+		t.Fatalf("expected the later layer to override the base URL, got %q", core.BaseURL)
+	}
+	if core.Timeout != 3*time.Second || core.MetricsPath != "/base/metrics" || core.LogsPath != "/api/v1/rca/logs" {
+		t.Fatalf("expected keys the later layer omits to keep earlier values and defaults, got %+v", core)
+	}
+	if !slices.Equal(core.Metrics, []string{"saturation"}) {
+		t.Fatalf("expected the later list to replace the earlier one, got %v", core.Metrics)
+	}
+	headers := core.Auth.Headers
+	if len(headers) != 2 || headers["X-Team"] != "rca" || headers["X-Env"] != "prod" {
+		t.Fatalf("expected maps to merge key by key with later values winning, got %v", headers)
+	}
+	if cfg.Include != nil {
+		t.Fatalf("expected include directives to be cleared, got %v", cfg.Include)
+	}
+}
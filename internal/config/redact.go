@@ -0,0 +1,70 @@
+package config
+
+import (
+	"reflect"
+
+	"github.com/miradorstack/mirador-rca/internal/secrets"
+)
+
+// RedactedValue replaces secret settings in the output of Redacted.
+const RedactedValue = "<redacted>"
+
+// Redacted returns a deep copy of cfg with every setting tagged secret:"true" replaced by RedactedValue. In
+// tagged maps, such as header sets, the values are replaced and the keys kept. Empty values and secret
+// references (vault://, awssm://, file://) are kept, since they name a secret rather than contain it.
+func Redacted(cfg Config) Config {
+	return redact(reflect.ValueOf(cfg), false).Interface().(Config)
+}
+
+func redact(value reflect.Value, secret bool) reflect.Value {
+	switch value.Kind() {
+	case reflect.String:
+		if s := value.String(); secret && s != "" && !secrets.IsReference(s) {
+			return reflect.ValueOf(RedactedValue).Convert(value.Type())
+		}
+	case reflect.Struct:
+		out := reflect.New(value.Type()).Elem()
+		out.Set(value)
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if field.IsExported() {
+				out.Field(i).Set(redact(value.Field(i), secret || field.Tag.Get("secret") == "true"))
+			}
+		}
+		return out
+	case reflect.Pointer:
+		if value.IsNil() {
+			return value
+		}
+		out := reflect.New(value.Type().Elem())
+		out.Elem().Set(redact(value.Elem(), secret))
+		return out
+	case reflect.Interface:
+		if value.IsNil() {
+			return value
+		}
+		out := reflect.New(value.Type()).Elem()
+		out.Set(redact(value.Elem(), secret))
+		return out
+	case reflect.Slice:
+		if value.IsNil() {
+			return value
+		}
+		out := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		for i := 0; i < value.Len(); i++ {
+			out.Index(i).Set(redact(value.Index(i), secret))
+		}
+		return out
+	case reflect.Map:
+		if value.IsNil() {
+			return value
+		}
+		out := reflect.MakeMapWithSize(value.Type(), value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			out.SetMapIndex(iter.Key(), redact(iter.Value(), secret))
+		}
+		return out
+	}
+	return value
+}
@@ -0,0 +1,138 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestRedactedHidesSecrets(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.Clients.Core.Auth = CoreAuthConfig{
+		BearerToken:  "leak-bearer",
+		APIKeyHeader: "X-API-Key",
+		Headers:      map[string]string{"X-Core-Token": "leak-header"},
+		TenantTokens: map[string]string{"acme": "leak-tenant"},
+	}
+	cfg.Weaviate.APIKey = "vault://secret/data/weaviate#apiKey"
+	cfg.History.Postgres.DSN = "postgres://rca:leak-dsn@db/rca"
+	cfg.Cache.Password = "leak-cache"
+	cfg.Notifications.Channels = []NotificationChannelConfig{
+		{Name: "oncall", Type: "slack", URL: "https://hooks.slack.com/leak-url"},
+		{Name: "hook", Type: "webhook", Headers: map[string]string{"Authorization": "Bearer leak-channel"}},
+	}
+
+	redacted := Redacted(cfg)
+	body, err := yaml.Marshal(redacted)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if strings.Contains(string(body), "leak-") {
+		t.Fatalf("expected no secret in the dump, got:\n%s", body)
+	}
+
+	auth := redacted.Clients.Core.Auth
+	if auth.BearerToken != RedactedValue || auth.TenantTokens["acme"] != RedactedValue || auth.Headers["X-Core-Token"] != RedactedValue {
+		t.Fatalf("expected core credentials to be redacted with their keys kept, got %+v", auth)
+	}
+	if auth.APIKeyHeader != "X-API-Key" || auth.APIKey != "" {
+		t.Fatalf("expected untagged and empty settings to be kept, got %+v", auth)
+	}
+	if redacted.Weaviate.APIKey != "vault://secret/data/weaviate#apiKey" {
+		t.Fatalf("expected secret references to be kept, got %q", redacted.Weaviate.APIKey)
+	}
+	if channel := redacted.Notifications.Channels[0]; channel.URL != RedactedValue || channel.Name != "oncall" {
+		t.Fatalf("expected the channel URL redacted and its name kept, got %+v", channel)
+	}
+	if cfg.Cache.Password != "leak-cache" || cfg.Notifications.Channels[1].Headers["Authorization"] != "Bearer leak-channel" {
+		t.Fatalf("expected the original configuration to be left untouched")
+	}
+}
+
+func TestRedactFollowsPointersAndInterfaces(t *testing.T) {
+	type credentials struct {
+		User     string
+		Password string `secret:"true"`
+	}
+	type settings struct {
+		Primary  *credentials
+		Replicas []*credentials
+		ByRegion map[string]credentials
+		Extra    any `secret:"true"`
+		Missing  *credentials
+	}
+	original := settings{
+		Primary:  &credentials{User: "rca", Password: "leak-primary"},
+		Replicas: []*credentials{{User: "ro", Password: "leak-replica"}},
+		ByRegion: map[string]credentials{"eu": {User: "eu", Password: "leak-region"}},
+		Extra:    "leak-extra",
+	}
+
+	redacted := redact(reflect.ValueOf(original), false).Interface().(settings)
+	if redacted.Primary.Password != RedactedValue || redacted.Primary.User != "rca" {
+		t.Fatalf("expected the pointed-to password redacted, got %+v", redacted.Primary)
+	}
+	if redacted.Replicas[0].Password != RedactedValue || redacted.ByRegion["eu"].Password != RedactedValue {
+		t.Fatalf("expected passwords in slices and maps redacted, got %+v %+v", redacted.Replicas[0], redacted.ByRegion)
+	}
+	if redacted.Extra != RedactedValue || redacted.Missing != nil {
+		t.Fatalf("expected the interface value redacted and nil pointers kept, got %v %v", redacted.Extra, redacted.Missing)
+	}
+	if original.Primary.Password != "leak-primary" || original.Replicas[0].Password != "leak-replica" {
+		t.Fatalf("expected redaction to copy pointed-to values instead of changing them")
+	}
+}
+
+func TestRemoteKeysExcludeSecrets(t *testing.T) {
+	for _, key := range RemoteKeys {
+		typ := reflect.TypeOf(Config{})
+		for _, name := range strings.Split(key, "/") {
+			field, ok := fieldByYAMLName(typ, name)
+			if !ok {
+				t.Fatalf("remote key %q: no setting %q", key, name)
+			}
+			if field.Tag.Get("secret") == "true" {
+				t.Fatalf("remote key %q is secret", key)
+			}
+			typ = field.Type
+		}
+		if path := secretPath(typ); path != "" {
+			t.Errorf("remote key %q contains secret setting %s", key, path)
+		}
+	}
+}
+
+func fieldByYAMLName(typ reflect.Type, name string) (reflect.StructField, bool) {
+	if typ.Kind() != reflect.Struct {
+		return reflect.StructField{}, false
+	}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if tag, _, _ := strings.Cut(field.Tag.Get("yaml"), ","); tag == name {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// secretPath returns the YAML name of the first secret setting within typ, or "" when it has none.
+func secretPath(typ reflect.Type) string {
+	switch typ.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map:
+		return secretPath(typ.Elem())
+	case reflect.Struct:
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+			if field.Tag.Get("secret") == "true" {
+				return name
+			}
+			if inner := secretPath(field.Type); inner != "" {
+				return name + "." + inner
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,60 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+)
+
+// RestartRequired lists the top-level sections that differ between current and next once the settings a
+// reload applies are ignored: the rule file path, cache TTLs, the log level, notifications, feature flags,
+// the window, thresholds, and cooldown of watch targets, and the secret-bearing Weaviate API key and cache
+// password. Changes in the listed sections only take effect after a restart.
+func RestartRequired(current, next Config) []string {
+	a, b := reflect.ValueOf(withoutReloadable(current)), reflect.ValueOf(withoutReloadable(next))
+	var sections []string
+	for i := 0; i < a.NumField(); i++ {
+		if !reflect.DeepEqual(a.Field(i).Interface(), b.Field(i).Interface()) {
+			name, _, _ := strings.Cut(a.Type().Field(i).Tag.Get("yaml"), ",")
+			sections = append(sections, name)
+		}
+	}
+	return sections
+}
+
+// RemoteKeys lists, as slash-separated YAML paths, the settings a remote overlay may set: the reloadable
+// settings above except secrets. Notification channels carry secret URLs and headers, so only the routes and
+// the enabled and timeout settings can be overlaid. A list such as watch/targets is replaced as a whole.
+var RemoteKeys = []string{
+	"rules/path",
+	"logging/level",
+	"notifications/enabled",
+	"notifications/timeout",
+	"notifications/routes",
+	"watch/targets",
+	"features",
+	"cache/similarIncidentsTTL",
+	"cache/serviceGraphTTL",
+	"cache/patternsTTL",
+	"cache/negativeTTL",
+	"cache/metricsTTL",
+	"cache/logsTTL",
+	"cache/tracesTTL",
+	"cache/investigationTTL",
+}
+
+func withoutReloadable(cfg Config) Config {
+	cfg.Rules.Path = ""
+	cfg.Logging.Level = ""
+	cfg.Weaviate.APIKey, cfg.Cache.Password = "", ""
+	cfg.Notifications = NotificationsConfig{}
+	cfg.Features = nil
+	cfg.Cache.SimilarIncidentsTTL, cfg.Cache.ServiceGraphTTL, cfg.Cache.PatternsTTL, cfg.Cache.NegativeTTL = 0, 0, 0, 0
+	cfg.Cache.MetricsTTL, cfg.Cache.LogsTTL, cfg.Cache.TracesTTL, cfg.Cache.InvestigationTTL = 0, 0, 0, 0
+	targets := make([]WatchTargetConfig, 0, len(cfg.Watch.Targets))
+	for _, target := range cfg.Watch.Targets {
+		target.Window, target.MinDensity, target.Threshold, target.Cooldown = 0, 0, 0, 0
+		targets = append(targets, target)
+	}
+	cfg.Watch.Targets = targets
+	return cfg
+}
@@ -0,0 +1,512 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/secrets"
+)
+
+// ValidationError lists every problem found in a configuration: parse errors in file order, followed by
+// semantic checks.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid config: %s", strings.Join(e.Problems, "; "))
+}
+
+// LoadStrict is Load with unknown keys rejected. Parse problems (unknown keys, malformed durations, wrong
+// types) in every file and the checks of Validate are all collected and returned together as a
+// *ValidationError, so a typo is reported instead of silently falling back to a default.
+func LoadStrict(paths ...string) (*Config, error) {
+	return load(paths, nil, true)
+}
+
+// Validate checks the settings Load accepts without complaint but the service would reject or misuse at
+// runtime: malformed listen addresses and URLs, backends without the URL they need, unknown enum values, and
+// negative durations. It returns a *ValidationError listing every problem, or nil.
+func (c *Config) Validate() error {
+	var v validator
+
+	v.address("server.address", c.Server.Address, true)
+	v.address("server.metricsAddress", c.Server.MetricsAddress, false)
+	if c.Server.DrainDelay < 0 {
+		v.addf("server.drainDelay: must not be negative")
+	}
+	v.durations("", reflect.ValueOf(*c))
+
+	v.url("clients.core.baseURL", c.Clients.Core.BaseURL, false)
+	switch c.Clients.MetricsSource {
+	case "", "core":
+	case "victoriametrics":
+		v.url("clients.victoriaMetrics.baseURL", c.Clients.VictoriaMetrics.BaseURL, true)
+	case "prometheus":
+		v.url("clients.prometheus.url", c.Clients.Prometheus.URL, true)
+		if len(c.Clients.Prometheus.Selectors) == 0 {
+			v.addf("clients.prometheus.selectors: required by the prometheus source")
+		}
+	case "otlp":
+		v.otlpSource("clients.metricsSource", c.OTLP)
+	default:
+		v.addf("clients.metricsSource: unknown source %q (want core, victoriametrics, prometheus, or otlp)", c.Clients.MetricsSource)
+	}
+	switch c.Clients.LogsSource {
+	case "", "core":
+	case "victorialogs":
+		v.url("clients.victoriaLogs.baseURL", c.Clients.VictoriaLogs.BaseURL, true)
+	case "loki":
+		v.url("clients.loki.baseURL", c.Clients.Loki.BaseURL, true)
+	case "otlp":
+		v.otlpSource("clients.logsSource", c.OTLP)
+	default:
+		v.addf("clients.logsSource: unknown source %q (want core, victorialogs, loki, or otlp)", c.Clients.LogsSource)
+	}
+	switch c.Clients.Traces.Backend {
+	case "", "core":
+	case "jaeger", "tempo":
+		v.url("clients.traces.url", c.Clients.Traces.URL, true)
+	case "otlp":
+		v.otlpSource("clients.traces.backend", c.OTLP)
+	default:
+		v.addf("clients.traces.backend: unknown backend %q (want core, jaeger, tempo, or otlp)", c.Clients.Traces.Backend)
+	}
+	switch c.Clients.ServiceGraphSource {
+	case "", "core":
+	case "otlp":
+		v.otlpSource("clients.serviceGraphSource", c.OTLP)
+	default:
+		v.addf("clients.serviceGraphSource: unknown source %q (want core or otlp)", c.Clients.ServiceGraphSource)
+	}
+	if c.OTLP.Enabled {
+		v.address("otlp.grpcAddress", c.OTLP.GRPCAddress, false)
+		v.address("otlp.httpAddress", c.OTLP.HTTPAddress, false)
+		if c.OTLP.GRPCAddress == "" && c.OTLP.HTTPAddress == "" {
+			v.addf("otlp: grpcAddress or httpAddress is required when enabled")
+		}
+		if c.OTLP.MaxItems < 0 {
+			v.addf("otlp.maxItems: must not be negative")
+		}
+		if c.OTLP.MaxTenants < 0 {
+			v.addf("otlp.maxTenants: must not be negative")
+		}
+	}
+
+	v.url("weaviate.endpoint", c.Weaviate.Endpoint, false)
+	switch c.History.Backend {
+	case "", "weaviate", "memory":
+	case "postgres":
+		if c.History.Postgres.DSN == "" {
+			v.addf("history.postgres.dsn: required by the postgres backend")
+		}
+	default:
+		v.addf("history.backend: unknown backend %q (want weaviate, postgres, or memory)", c.History.Backend)
+	}
+
+	switch strings.ToLower(c.Logging.Level) {
+	case "", "debug", "info", "warn", "warning", "error":
+	default:
+		v.addf("logging.level: unknown level %q (want debug, info, warn, or error)", c.Logging.Level)
+	}
+
+	if c.Cache.Enabled && c.Cache.Addr == "" {
+		v.addf("cache.addr: required when the cache is enabled")
+	}
+	switch c.Cache.Network {
+	case "", "tcp":
+		if c.Cache.Enabled {
+			v.address("cache.addr", c.Cache.Addr, false)
+		}
+	case "unix":
+		if c.Cache.TLS {
+			v.addf("cache.tls: not supported over a unix socket")
+		}
+	default:
+		v.addf("cache.network: unknown network %q (want tcp or unix)", c.Cache.Network)
+	}
+	switch c.Cache.Compression {
+	case "", "none", "snappy", "gzip":
+	default:
+		v.addf("cache.compression: unknown codec %q (want none, snappy, or gzip)", c.Cache.Compression)
+	}
+	if c.Cache.CompressionThreshold < 0 {
+		v.addf("cache.compressionThreshold: must not be negative")
+	}
+
+	v.url("extractors.external.endpoint", c.Extractors.External.Endpoint, false)
+	if c.Extractors.Shadow.Threshold < 0 {
+		v.addf("extractors.shadow.threshold: must not be negative")
+	}
+	if r := c.Extractors.Shadow.SampleRatio; len(c.Extractors.Shadow.Enabled) > 0 && (r <= 0 || r > 1) {
+		v.addf("extractors.shadow.sampleRatio: must be greater than 0 and at most 1")
+	}
+	if c.Extractors.Shadow.MaxConcurrent < 0 {
+		v.addf("extractors.shadow.maxConcurrent: must not be negative")
+	}
+
+	if c.Investigation.Executor.MaxConcurrent <= 0 {
+		v.addf("investigation.executor.maxConcurrent: must be positive")
+	}
+	if c.Investigation.Executor.QueueDepth < 0 {
+		v.addf("investigation.executor.queueDepth: must not be negative")
+	}
+	if c.Investigation.Executor.TenantQueueDepth < 0 {
+		v.addf("investigation.executor.tenantQueueDepth: must not be negative")
+	}
+	if c.Investigation.Expansion.Enabled && (c.Investigation.Expansion.MinSamples <= 0 || c.Investigation.Expansion.MaxExpansion <= 0) {
+		v.addf("investigation.expansion: minSamples and maxExpansion must be positive when enabled")
+	}
+
+	if c.Summary.Enabled {
+		for locale, text := range c.Summary.Templates {
+			if locale == "" {
+				v.addf("summary.templates: locale names must not be empty")
+			} else if strings.TrimSpace(text) == "" {
+				v.addf("summary.templates.%s: must not be empty", locale)
+			}
+		}
+	}
+
+	if c.LLM.Enabled {
+		v.url("llm.endpoint", c.LLM.Endpoint, true)
+		if c.LLM.Model == "" {
+			v.addf("llm.model: required when the language model is enabled")
+		}
+		if c.LLM.Timeout <= 0 {
+			v.addf("llm.timeout: must be positive")
+		}
+		if c.LLM.MaxRecommendations < 0 {
+			v.addf("llm.maxRecommendations: must not be negative")
+		}
+		for i, pattern := range c.LLM.Redact.Patterns {
+			if _, err := regexp.Compile(pattern); err != nil {
+				v.addf("llm.redact.patterns[%d]: %v", i, err)
+			}
+		}
+	}
+
+	for i, rule := range c.ServiceNames.Rules {
+		if _, err := regexp.Compile(rule.Pattern); err != nil {
+			v.addf("serviceNames.rules[%d].pattern: %v", i, err)
+		}
+	}
+	aliases := make(map[string]string)
+	for canonical, spellings := range c.ServiceNames.Aliases {
+		if strings.TrimSpace(canonical) == "" {
+			v.addf("serviceNames.aliases: canonical names must not be empty")
+			continue
+		}
+		for _, alias := range append([]string{canonical}, spellings...) {
+			key := strings.ToLower(strings.TrimSpace(alias))
+			if other, ok := aliases[key]; ok && other != canonical {
+				v.addf("serviceNames.aliases: %q is an alias of both %s and %s", alias, other, canonical)
+			}
+			aliases[key] = canonical
+		}
+	}
+
+	if c.Capacity.Enabled {
+		if len(c.Capacity.Resources) == 0 {
+			v.addf("capacity.resources: at least one resource is required when enabled")
+		}
+		for resource, query := range c.Capacity.Resources {
+			if strings.TrimSpace(query) == "" {
+				v.addf("capacity.resources.%s: must not be empty", resource)
+			}
+		}
+		if c.Capacity.Saturation <= 0 || c.Capacity.Saturation > 1 {
+			v.addf("capacity.saturation: must be in (0, 1]")
+		}
+	}
+
+	if c.Impact.Enabled && c.Impact.CriticalBurnRate < 1 {
+		v.addf("impact.criticalBurnRate: must be at least 1")
+	}
+	for service, slos := range c.Impact.SLOs {
+		for i, slo := range slos {
+			if strings.TrimSpace(slo.Name) == "" || strings.TrimSpace(slo.Indicator) == "" {
+				v.addf("impact.slos.%s[%d]: name and indicator are required", service, i)
+			}
+			if slo.Objective <= 0 || slo.Objective >= 1 {
+				v.addf("impact.slos.%s[%d]: objective must be in (0, 1)", service, i)
+			}
+			if slo.Threshold < 0 || slo.Period < 0 {
+				v.addf("impact.slos.%s[%d]: threshold and period must not be negative", service, i)
+			}
+		}
+	}
+
+	for name, preset := range c.Presets {
+		if strings.TrimSpace(name) == "" {
+			v.addf("presets: names must not be empty")
+			continue
+		}
+		if preset.MaxAnchors < 0 || preset.MaxTimeline < 0 || preset.CausalityDepth < 0 {
+			v.addf("presets.%s: maxAnchors, maxTimeline, and causalityDepth must not be negative", name)
+		}
+	}
+
+	if c.Tuning.Enabled {
+		if c.Tuning.Interval <= 0 {
+			v.addf("tuning.interval: must be positive")
+		}
+		if c.Tuning.Lookback <= 0 {
+			v.addf("tuning.lookback: must be positive")
+		}
+		if c.Tuning.MinCorrelations <= 0 || c.Tuning.MinFeedback <= 0 || c.Tuning.MaxCorrelations <= 0 {
+			v.addf("tuning: maxCorrelations, minCorrelations, and minFeedback must be positive")
+		}
+		if c.Tuning.TargetAnchors <= 0 {
+			v.addf("tuning.targetAnchors: must be positive")
+		}
+		if c.Tuning.MinThreshold <= 0 || c.Tuning.MaxThreshold < c.Tuning.MinThreshold {
+			v.addf("tuning.minThreshold: must be positive and at most tuning.maxThreshold")
+		}
+	}
+
+	if c.Topology.Enabled {
+		if c.Topology.Interval <= 0 {
+			v.addf("topology.interval: must be positive")
+		}
+		if c.Topology.Lookback < c.Topology.Interval {
+			v.addf("topology.lookback: must be at least topology.interval")
+		}
+		if c.Topology.ScopeTTL < 0 {
+			v.addf("topology.scopeTTL: must not be negative")
+		}
+		if c.Topology.Retention != 0 && c.Topology.Retention < c.Topology.Lookback {
+			v.addf("topology.retention: must be at least topology.lookback")
+		}
+	}
+
+	if c.Archive.Enabled {
+		switch c.Archive.Provider {
+		case "", "s3", "gcs":
+		default:
+			v.addf("archive.provider: unknown provider %q (want s3 or gcs)", c.Archive.Provider)
+		}
+		if c.Archive.Bucket == "" {
+			v.addf("archive.bucket: required when archival is enabled")
+		}
+		v.url("archive.endpoint", c.Archive.Endpoint, false)
+	}
+
+	if c.Integrations.PagerDuty.Enabled || c.Integrations.Opsgenie.Enabled {
+		v.address("integrations.address", c.Integrations.Address, true)
+	}
+	if c.Integrations.PagerDuty.Enabled {
+		v.url("integrations.pagerduty.apiURL", c.Integrations.PagerDuty.APIURL, false)
+	}
+	if c.Integrations.Opsgenie.Enabled {
+		v.url("integrations.opsgenie.apiURL", c.Integrations.Opsgenie.APIURL, false)
+	}
+
+	if c.Notifications.Enabled {
+		names := make(map[string]bool, len(c.Notifications.Channels))
+		for i, channel := range c.Notifications.Channels {
+			field := fmt.Sprintf("notifications.channels[%d]", i)
+			if channel.Name == "" {
+				v.addf("%s.name: required", field)
+			} else if names[channel.Name] {
+				v.addf("%s.name: duplicate channel %q", field, channel.Name)
+			}
+			names[channel.Name] = true
+			switch strings.ToLower(channel.Type) {
+			case "", "slack", "teams", "webhook":
+			default:
+				v.addf("%s.type: unknown type %q (want slack, teams, or webhook)", field, channel.Type)
+			}
+			v.url(field+".url", channel.URL, true)
+		}
+		for i, route := range c.Notifications.Routes {
+			for _, name := range route.Channels {
+				if !names[name] {
+					v.addf("notifications.routes[%d].channels: unknown channel %q", i, name)
+				}
+			}
+		}
+	}
+
+	if c.Ticketing.Enabled {
+		switch c.Ticketing.Provider {
+		case "jira":
+			v.url("ticketing.jira.baseURL", c.Ticketing.Jira.BaseURL, true)
+		case "servicenow":
+			v.url("ticketing.servicenow.instanceURL", c.Ticketing.ServiceNow.InstanceURL, true)
+		default:
+			v.addf("ticketing.provider: unknown provider %q (want jira or servicenow)", c.Ticketing.Provider)
+		}
+	}
+
+	if c.Kafka.Enabled {
+		v.url("kafka.restProxyURL", c.Kafka.RESTProxyURL, true)
+		if c.Kafka.Topic == "" {
+			v.addf("kafka.topic: required when the consumer is enabled")
+		}
+	}
+
+	if c.Watch.Enabled {
+		for i, target := range c.Watch.Targets {
+			field := fmt.Sprintf("watch.targets[%d]", i)
+			if target.TenantID == "" || target.Service == "" {
+				v.addf("%s: tenantId and service are required", field)
+			}
+			if target.MinDensity <= 0 {
+				v.addf("%s.minDensity: must be positive", field)
+			}
+		}
+	}
+
+	if c.Remote.Enabled {
+		switch c.Remote.Backend {
+		case "etcd", "consul":
+		default:
+			v.addf("remote.backend: unknown backend %q (want etcd or consul)", c.Remote.Backend)
+		}
+		v.url("remote.endpoint", c.Remote.Endpoint, true)
+	}
+
+	if c.Tracing.Enabled {
+		v.url("tracing.endpoint", c.Tracing.Endpoint, false)
+		if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+			v.addf("tracing.sampleRatio: must be between 0 and 1")
+		}
+	}
+
+	if c.Metrics.MaxTenants < 0 {
+		v.addf("metrics.maxTenants: must not be negative")
+	}
+
+	if c.Audit.Enabled {
+		switch c.Audit.Sink {
+		case "", "file":
+			if c.Audit.Path == "" {
+				v.addf("audit.path: required for the file sink")
+			}
+		case "http":
+			v.url("audit.url", c.Audit.URL, true)
+		default:
+			v.addf("audit.sink: unknown sink %q (want file or http)", c.Audit.Sink)
+		}
+	}
+	if c.Audit.BufferSize < 0 {
+		v.addf("audit.bufferSize: must not be negative")
+	}
+
+	if c.SLO.Enabled {
+		if c.SLO.LatencyThreshold <= 0 {
+			v.addf("slo.latencyThreshold: must be positive")
+		}
+		if c.SLO.Objective <= 0 || c.SLO.Objective >= 1 {
+			v.addf("slo.objective: must be between 0 and 1, exclusive")
+		}
+		if len(c.SLO.Windows) == 0 {
+			v.addf("slo.windows: at least one window is required")
+		}
+		for i, window := range c.SLO.Windows {
+			if window < time.Minute || window > 24*time.Hour {
+				v.addf("slo.windows[%d]: must be between 1m and 24h", i)
+			}
+		}
+	}
+
+	for name, feature := range c.Features {
+		if p := feature.Percentage; p != nil && (*p < 0 || *p > 100) {
+			v.addf("features.%s.percentage: must be between 0 and 100", name)
+		}
+	}
+
+	for i, window := range c.Maintenance {
+		if !window.Start.IsZero() && !window.End.IsZero() && !window.Start.Before(window.End) {
+			v.addf("maintenance[%d]: start must be before end", i)
+		}
+	}
+
+	if len(v.problems) == 0 {
+		return nil
+	}
+	return &ValidationError{Problems: v.problems}
+}
+
+type validator struct {
+	problems []string
+}
+
+func (v *validator) addf(format string, args ...any) {
+	v.problems = append(v.problems, fmt.Sprintf(format, args...))
+}
+
+// address checks a host:port listen or dial address; the host may be empty.
+func (v *validator) address(field, value string, required bool) {
+	if value == "" {
+		if required {
+			v.addf("%s: required", field)
+		}
+		return
+	}
+	if _, _, err := net.SplitHostPort(value); err != nil {
+		v.addf("%s: invalid address %q: want host:port", field, value)
+	}
+}
+
+// url checks for an absolute http(s) URL; secret references are resolved later and skipped.
+func (v *validator) url(field, value string, required bool) {
+	if value == "" {
+		if required {
+			v.addf("%s: required", field)
+		}
+		return
+	}
+	if secrets.IsReference(value) {
+		return
+	}
+	parsed, err := url.Parse(value)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		v.addf("%s: invalid URL %q: want an absolute http(s) URL", field, value)
+	}
+}
+
+// otlpSource checks that a signal source reading the OTLP buffer has the receiver filling it.
+func (v *validator) otlpSource(field string, otlp OTLPConfig) {
+	if !otlp.Enabled {
+		v.addf("%s: otlp requires otlp.enabled", field)
+	}
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// durations reports every negative time.Duration under value, naming fields by their YAML path.
+func (v *validator) durations(path string, value reflect.Value) {
+	switch value.Kind() {
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			name, _, _ := strings.Cut(value.Type().Field(i).Tag.Get("yaml"), ",")
+			if name == "" || name == "-" {
+				continue
+			}
+			if path != "" {
+				name = path + "." + name
+			}
+			v.durations(name, value.Field(i))
+		}
+	case reflect.Slice:
+		for i := 0; i < value.Len(); i++ {
+			v.durations(fmt.Sprintf("%s[%d]", path, i), value.Index(i))
+		}
+	case reflect.Map:
+		iter := value.MapRange()
+		for iter.Next() {
+			v.durations(fmt.Sprintf("%s.%v", path, iter.Key()), iter.Value())
+		}
+	case reflect.Int64:
+		if value.Type() == durationType && value.Int() < 0 {
+			v.addf("%s: duration must not be negative", path)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadStrictReportsEveryProblem(t *testing.T) {
+	path := writeConfigFile(t, filepath.Join(t.TempDir(), "config.yaml"), `
+server:
+  adress: ":50051"
+clients:
+  metricsSource: graphite
+  core:
+    timeout: 5 seconds
+`)
+
+	_, err := LoadStrict(path)
+	var invalid *ValidationError
+	if !errors.As(err, &invalid) {
+		t.Fatalf("expected a *ValidationError, got %v", err)
+	}
+	if len(invalid.Problems) != 3 {
+		t.Fatalf("expected three problems, got %d: %q", len(invalid.Problems), invalid.Problems)
+	}
+	for i, want := range []string{"field adress not found", "5 seconds", `clients.metricsSource: unknown source "graphite"`} {
+		if !strings.Contains(invalid.Problems[i], want) {
+			t.Fatalf("expected problem %d to mention %q, got %q", i, want, invalid.Problems[i])
+		}
+	}
+	if !strings.HasPrefix(invalid.Problems[0], path+": ") {
+		t.Fatalf("expected parse problems to name their file, got %q", invalid.Problems[0])
+	}
+}
+
+func TestLoadIgnoresUnknownKeysUnlessStrict(t *testing.T) {
+	path := writeConfigFile(t, filepath.Join(t.TempDir(), "config.yaml"), "server:\n  adress: \":50051\"\n")
+	if _, err := Load(path); err != nil {
+		t.Fatalf("expected lenient loading to ignore unknown keys, got %v", err)
+	}
+	if _, err := LoadStrict(path); err == nil {
+		t.Fatalf("expected strict loading to reject unknown keys")
+	}
+}
+
+func TestValidateAcceptsDefaults(t *testing.T) {
+	cfg := defaultConfig()
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("expected the defaults to be valid, got %v", err)
+	}
+}
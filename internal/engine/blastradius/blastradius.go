@@ -0,0 +1,100 @@
+package blastradius
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+	"github.com/miradorstack/mirador-rca/internal/repo"
+)
+
+// Estimator walks the service graph downstream from a suspected root and scores the impact on each reachable service.
+type Estimator struct {
+	maxDepth int
+	decay    float64
+	minScore float64
+}
+
+// NewEstimator constructs an Estimator with default depth (4), per-hop decay (0.8) and minimum reportable score (0.05).
+func NewEstimator() *Estimator {
+	return &Estimator{maxDepth: 4, decay: 0.8, minScore: 0.05}
+}
+
+// Estimate returns the impact set for root, including the root itself with score 1, sorted by descending score.
+// Edge weights combine the share of the caller's outbound traffic carried by the edge with the edge error rate.
+func (e *Estimator) Estimate(root string, edges []repo.ServiceGraphEdge) []models.ServiceImpact {
+	if e == nil || root == "" {
+		return nil
+	}
+
+	outbound := make(map[string][]repo.ServiceGraphEdge)
+	totalCalls := make(map[string]float64)
+	for _, edge := range edges {
+		if edge.Source == "" || edge.Target == "" {
+			continue
+		}
+		key := strings.ToLower(edge.Source)
+		outbound[key] = append(outbound[key], edge)
+		totalCalls[key] += edge.CallRate
+	}
+
+	rootKey := strings.ToLower(root)
+	impacts := map[string]*models.ServiceImpact{
+		rootKey: {Service: root, Score: 1, Depth: 0},
+	}
+
+	frontier := []string{rootKey}
+	for depth := 1; depth <= e.maxDepth && len(frontier) > 0; depth++ {
+		next := make([]string, 0)
+		for _, current := range frontier {
+			parentScore := impacts[current].Score
+			for _, edge := range outbound[current] {
+				score := parentScore * e.decay * edgeWeight(edge, totalCalls[current])
+				if score < e.minScore {
+					continue
+				}
+				targetKey := strings.ToLower(edge.Target)
+				existing, ok := impacts[targetKey]
+				if ok && existing.Score >= score {
+					continue
+				}
+				if !ok {
+					existing = &models.ServiceImpact{Service: edge.Target, Depth: depth}
+					impacts[targetKey] = existing
+				}
+				existing.Score = score
+				next = append(next, targetKey)
+			}
+		}
+		frontier = next
+	}
+
+	result := make([]models.ServiceImpact, 0, len(impacts))
+	for _, impact := range impacts {
+		result = append(result, *impact)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Score == result[j].Score {
+			return result[i].Service < result[j].Service
+		}
+		return result[i].Score > result[j].Score
+	})
+	return result
+}
+
+// edgeWeight blends traffic share (60%) and error rate (40%) into a [0,1] propagation factor.
+// ErrorRate is expressed as a percentage, matching the service graph payload from mirador-core.
+func edgeWeight(edge repo.ServiceGraphEdge, totalCalls float64) float64 {
+	share := 1.0
+	if totalCalls > 0 {
+		share = edge.CallRate / totalCalls
+	}
+	errorRatio := edge.ErrorRate / 100
+	if errorRatio > 1 {
+		errorRatio = 1
+	}
+	if errorRatio < 0 {
+		errorRatio = 0
+	}
+	return 0.6*share + 0.4*errorRatio
+}
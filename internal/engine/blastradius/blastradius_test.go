@@ -0,0 +1,41 @@
+package blastradius
+
+import (
+	"testing"
+
+	"github.com/miradorstack/mirador-rca/internal/repo"
+)
+
+func TestEstimateWalksDownstream(t *testing.T) {
+	edges := []repo.ServiceGraphEdge{
+		{Source: "payments", Target: "ledger", CallRate: 90, ErrorRate: 10},
+		{Source: "payments", Target: "fraud", CallRate: 10},
+		{Source: "ledger", Target: "postgres", CallRate: 50},
+		{Source: "checkout", Target: "payments", CallRate: 120},
+	}
+
+	impacts := NewEstimator().Estimate("payments", edges)
+	if len(impacts) == 0 || impacts[0].Service != "payments" || impacts[0].Score != 1 {
+		t.Fatalf("expected root first with score 1, got %+v", impacts)
+	}
+
+	scores := make(map[string]float64)
+	for _, impact := range impacts {
+		scores[impact.Service] = impact.Score
+	}
+	if _, ok := scores["checkout"]; ok {
+		t.Fatalf("upstream caller should not be in blast radius: %+v", impacts)
+	}
+	if scores["ledger"] <= scores["fraud"] {
+		t.Fatalf("expected heavier edge to carry more impact: %+v", impacts)
+	}
+	if scores["postgres"] == 0 || scores["postgres"] >= scores["ledger"] {
+		t.Fatalf("expected decayed second-hop impact: %+v", impacts)
+	}
+}
+
+func TestEstimateNoRoot(t *testing.T) {
+	if impacts := NewEstimator().Estimate("", nil); impacts != nil {
+		t.Fatalf("expected nil impacts, got %+v", impacts)
+	}
+}
@@ -0,0 +1,225 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/miradorstack/mirador-rca/internal/metrics"
+	"github.com/miradorstack/mirador-rca/internal/models"
+	"github.com/miradorstack/mirador-rca/internal/repo"
+)
+
+// capacityHorizon is how soon a rising resource must be projected to reach its limit to earn a recommendation.
+const capacityHorizon = 6 * time.Hour
+
+// CapacityClient is implemented by core clients that can fetch resource utilisation series, each sample named
+// after its resource and valued as a fraction of the resource's limit.
+type CapacityClient interface {
+	FetchResourceUsage(ctx context.Context, tenantID, service string, start, end time.Time) ([]repo.MetricPoint, error)
+}
+
+// CapacityAnalyzer judges from resource utilisation whether an incident was driven by saturation.
+type CapacityAnalyzer struct {
+	saturation float64
+}
+
+// NewCapacityAnalyzer constructs an analyser counting a resource as saturated once its utilisation reaches
+// saturation, a fraction of its limit. Values outside (0, 1] default to 0.9.
+func NewCapacityAnalyzer(saturation float64) *CapacityAnalyzer {
+	if saturation <= 0 || saturation > 1 {
+		saturation = 0.9
+	}
+	return &CapacityAnalyzer{saturation: saturation}
+}
+
+// WithCapacityAnalyzer fetches the resource utilisation of the suspected root service when the core client is
+// a CapacityClient. A resource that saturated no later than the service's first anomaly marks the incident as
+// saturation-driven; the result then carries a saturation timeline event, and recommendations lead with the
+// headroom each saturated or soon-exhausted resource needs.
+func WithCapacityAnalyzer(analyzer *CapacityAnalyzer) PipelineOption {
+	return func(p *Pipeline) {
+		p.capacity = analyzer
+	}
+}
+
+// Analyze summarises each resource in samples. onset is the service's first anomaly; a zero onset lets any
+// saturation in the window count as the driver. It returns nil without samples.
+func (a *CapacityAnalyzer) Analyze(service string, samples []repo.MetricPoint, onset time.Time) *models.CapacityAnalysis {
+	if a == nil || len(samples) == 0 {
+		return nil
+	}
+	grouped := make(map[string][]repo.MetricPoint)
+	for _, sample := range samples {
+		if sample.Name != "" {
+			grouped[sample.Name] = append(grouped[sample.Name], sample)
+		}
+	}
+	if len(grouped) == 0 {
+		return nil
+	}
+
+	analysis := &models.CapacityAnalysis{Service: service, Saturation: a.saturation}
+	for resource, series := range grouped {
+		sort.SliceStable(series, func(i, j int) bool { return series[i].Timestamp.Before(series[j].Timestamp) })
+		usage := a.usage(resource, series)
+		analysis.Resources = append(analysis.Resources, usage)
+
+		// Samples are taken a step apart, so saturation may show up to one step after the anomaly it drove.
+		timestamps := make([]time.Time, len(series))
+		for i, sample := range series {
+			timestamps[i] = sample.Timestamp
+		}
+		if usage.Saturated() && (onset.IsZero() || !usage.SaturatedAt.After(onset.Add(samplingStep(timestamps)))) {
+			analysis.SaturationDriven = true
+		}
+	}
+	sort.Slice(analysis.Resources, func(i, j int) bool {
+		if analysis.Resources[i].Peak != analysis.Resources[j].Peak {
+			return analysis.Resources[i].Peak > analysis.Resources[j].Peak
+		}
+		return analysis.Resources[i].Resource < analysis.Resources[j].Resource
+	})
+	return analysis
+}
+
+// usage summarises the time-ordered utilisation series of one resource.
+func (a *CapacityAnalyzer) usage(resource string, series []repo.MetricPoint) models.ResourceUsage {
+	usage := models.ResourceUsage{Resource: resource, Latest: series[len(series)-1].Value}
+	for _, sample := range series {
+		usage.Peak = max(usage.Peak, sample.Value)
+		if usage.SaturatedAt.IsZero() && sample.Value >= a.saturation {
+			usage.SaturatedAt = sample.Timestamp
+		}
+	}
+	usage.Headroom = max(1-usage.Peak, 0)
+	if usage.Saturated() {
+		usage.ExtraCapacity = usage.Peak/a.saturation - 1
+	}
+	if slope := utilisationTrend(series); slope > 0 && usage.Latest < 1 {
+		usage.TimeToExhaustion = time.Duration((1 - usage.Latest) / slope * float64(time.Second)).Round(time.Second)
+	}
+	return usage
+}
+
+// utilisationTrend is the least-squares slope of series, in utilisation per second.
+func utilisationTrend(series []repo.MetricPoint) float64 {
+	if len(series) < 2 {
+		return 0
+	}
+	origin := series[0].Timestamp
+	var sumX, sumY, sumXY, sumXX float64
+	for _, sample := range series {
+		x := sample.Timestamp.Sub(origin).Seconds()
+		sumX += x
+		sumY += sample.Value
+		sumXY += x * sample.Value
+		sumXX += x * x
+	}
+	n := float64(len(series))
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return 0
+	}
+	return (n*sumXY - sumX*sumY) / denominator
+}
+
+// analyzeCapacity fetches and analyses the resource utilisation of service over the request window; it
+// returns nil when capacity analysis is off or the utilisation could not be fetched.
+func (p *Pipeline) analyzeCapacity(ctx context.Context, req models.InvestigationRequest, service string, timeline []models.TimelineEvent) *models.CapacityAnalysis {
+	client, ok := p.coreClient.(CapacityClient)
+	if p.capacity == nil || !ok {
+		return nil
+	}
+	ctx = repo.WithEnvironment(ctx, req.Environment)
+	ctx, capacity := startStage(ctx, "rca.capacity", metrics.StageCapacity, attribute.String("rca.service", service))
+	fetchCtx, cancel := withTimeout(ctx, p.timeouts.Metrics)
+	samples, err := client.FetchResourceUsage(fetchCtx, req.TenantID, service, req.TimeRange.Start, req.TimeRange.End)
+	cancel()
+	if err != nil {
+		capacity.End(err)
+		p.logger.Warn("resource usage fetch failed; skipping capacity analysis", slog.String("service", service), slog.Any("error", err))
+		return nil
+	}
+	analysis := p.capacity.Analyze(service, samples, serviceOnset(service, timeline))
+	if analysis != nil {
+		capacity.span.SetAttributes(attribute.Bool("rca.saturation_driven", analysis.SaturationDriven))
+	}
+	capacity.End(nil)
+	return analysis
+}
+
+// serviceOnset returns the time of service's earliest timeline event, or of the earliest event when the
+// service has none.
+func serviceOnset(service string, timeline []models.TimelineEvent) time.Time {
+	var onset, earliest time.Time
+	for _, event := range timeline {
+		if earliest.IsZero() || event.Time.Before(earliest) {
+			earliest = event.Time
+		}
+		if strings.EqualFold(event.Service, service) && (onset.IsZero() || event.Time.Before(onset)) {
+			onset = event.Time
+		}
+	}
+	if onset.IsZero() {
+		return earliest
+	}
+	return onset
+}
+
+// saturationEvent marks on the timeline when the first resource to saturate reached the saturation level.
+func saturationEvent(analysis *models.CapacityAnalysis) (models.TimelineEvent, bool) {
+	if analysis == nil || !analysis.SaturationDriven {
+		return models.TimelineEvent{}, false
+	}
+	var driver *models.ResourceUsage
+	for i, usage := range analysis.Resources {
+		if usage.Saturated() && (driver == nil || usage.SaturatedAt.Before(driver.SaturatedAt)) {
+			driver = &analysis.Resources[i]
+		}
+	}
+	if driver == nil {
+		return models.TimelineEvent{}, false
+	}
+	return models.TimelineEvent{
+		Time:       driver.SaturatedAt,
+		Event:      fmt.Sprintf("Saturation: %s reached %s of its limit", driver.Resource, percent(driver.Peak)),
+		Service:    analysis.Service,
+		Severity:   models.SeverityHigh,
+		DataSource: models.DataTypeMetrics,
+	}, true
+}
+
+// capacityRecommendations advises the headroom needed by each saturated resource and warns about rising ones
+// projected to reach their limit within capacityHorizon.
+func capacityRecommendations(analysis *models.CapacityAnalysis) []models.Recommendation {
+	if analysis == nil {
+		return nil
+	}
+	var recs []models.Recommendation
+	for _, usage := range analysis.Resources {
+		switch {
+		case usage.Saturated():
+			recs = append(recs, models.Recommendation{Text: fmt.Sprintf(
+				"Add %s capacity to %s: it peaked at %s of its limit (%s headroom); about %s more keeps it under %s",
+				usage.Resource, analysis.Service, percent(usage.Peak), percent(usage.Headroom),
+				percent(usage.ExtraCapacity), percent(analysis.Saturation))})
+		case usage.TimeToExhaustion > 0 && usage.TimeToExhaustion <= capacityHorizon:
+			recs = append(recs, models.Recommendation{Text: fmt.Sprintf(
+				"Watch %s %s: at %s of its limit and rising, it runs out in about %s",
+				analysis.Service, usage.Resource, percent(usage.Latest), max(usage.TimeToExhaustion.Round(time.Minute), time.Minute))})
+		}
+	}
+	return recs
+}
+
+// percent renders a fraction as a whole percentage.
+func percent(fraction float64) string {
+	return fmt.Sprintf("%d%%", int(math.Round(fraction*100)))
+}
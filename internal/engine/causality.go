@@ -31,6 +31,12 @@ func NewCausalityEngine(logger *slog.Logger) *CausalityEngine {
 
 // Evaluate inspects upstream edges and timeline ordering to derive a causality score in [0,1].
 func (e *CausalityEngine) Evaluate(rootService string, timeline []models.TimelineEvent, edges []repo.ServiceGraphEdge) CausalityResult {
+	return e.EvaluateDepth(rootService, timeline, edges, 1)
+}
+
+// EvaluateDepth is Evaluate following upstream edges up to depth hops from rootService. Notes about services
+// further than one hop away name the direct upstream they reach rootService through.
+func (e *CausalityEngine) EvaluateDepth(rootService string, timeline []models.TimelineEvent, edges []repo.ServiceGraphEdge, depth int) CausalityResult {
 	result := CausalityResult{}
 	if rootService == "" || len(edges) == 0 || len(timeline) == 0 {
 		return result
@@ -45,31 +51,51 @@ func (e *CausalityEngine) Evaluate(rootService string, timeline []models.Timelin
 	supporting := 0
 
 	var suggested repo.ServiceGraphEdge
-	for _, edge := range edges {
-		if !strings.EqualFold(edge.Target, rootService) {
-			continue
-		}
-		totalUpstream++
-		srcTime := firstEventTime(edge.Source, timeline)
-		if srcTime.IsZero() {
-			if edge.ErrorRate > 0 {
+	// frontier maps the services reached at the current hop to the direct upstream they were reached through.
+	frontier := map[string]string{strings.ToLower(rootService): ""}
+	visited := map[string]bool{strings.ToLower(rootService): true}
+	for hop := 1; hop <= max(depth, 1) && len(frontier) > 0; hop++ {
+		next := make(map[string]string)
+		for _, edge := range edges {
+			via, ok := frontier[strings.ToLower(edge.Target)]
+			if !ok || (hop > 1 && visited[strings.ToLower(edge.Source)]) {
+				continue
+			}
+			if via == "" {
+				next[strings.ToLower(edge.Source)] = edge.Source
+			} else {
+				next[strings.ToLower(edge.Source)] = via
+			}
+			suffix := ""
+			if via != "" {
+				suffix = " (via " + via + ")"
+			}
+			totalUpstream++
+			srcTime := firstEventTime(edge.Source, timeline)
+			if srcTime.IsZero() {
+				if edge.ErrorRate > 0 {
+					supporting++
+					result.Notes = append(result.Notes, edge.Source+" error rate influencing "+rootService+suffix)
+					if !suggestedEdgeSet(suggested) || edge.ErrorRate > suggested.ErrorRate {
+						suggested = edge
+					}
+				}
+				continue
+			}
+			if srcTime.Before(rootTime) {
 				supporting++
-				result.Notes = append(result.Notes, edge.Source+" error rate influencing "+rootService)
-				if !suggestedEdgeSet(suggested) || edge.ErrorRate > suggested.ErrorRate {
+				result.Notes = append(result.Notes, edge.Source+" precedes "+rootService+suffix)
+				if !suggestedEdgeSet(suggested) || edge.CallRate > suggested.CallRate {
 					suggested = edge
 				}
+			} else {
+				result.Notes = append(result.Notes, edge.Source+" occurs after root cause"+suffix)
 			}
-			continue
 		}
-		if srcTime.Before(rootTime) {
-			supporting++
-			result.Notes = append(result.Notes, edge.Source+" precedes "+rootService)
-			if !suggestedEdgeSet(suggested) || edge.CallRate > suggested.CallRate {
-				suggested = edge
-			}
-		} else {
-			result.Notes = append(result.Notes, edge.Source+" occurs after root cause")
+		for service := range next {
+			visited[service] = true
 		}
+		frontier = next
 	}
 
 	if totalUpstream == 0 {
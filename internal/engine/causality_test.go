@@ -30,3 +30,29 @@ func TestCausalityEngineNoEvidence(t *testing.T) {
 		t.Fatalf("expected zero score without data")
 	}
 }
+
+func TestCausalityEngineEvaluateDepth(t *testing.T) {
+	engine := NewCausalityEngine(nil)
+	now := time.Now()
+	timeline := []models.TimelineEvent{
+		{Service: "postgres", Time: now.Add(-2 * time.Minute)},
+		{Service: "checkout", Time: now},
+	}
+	edges := []repo.ServiceGraphEdge{
+		{Source: "payments", Target: "checkout", CallRate: 100},
+		{Source: "postgres", Target: "payments", CallRate: 50},
+		{Source: "checkout", Target: "payments", CallRate: 10},
+	}
+
+	direct := engine.Evaluate("checkout", timeline, edges)
+	if direct.SuggestedService != "" {
+		t.Fatalf("expected no suggestion one hop out, got %+v", direct)
+	}
+	deep := engine.EvaluateDepth("checkout", timeline, edges, 3)
+	if deep.SuggestedService != "postgres" {
+		t.Fatalf("expected postgres two hops upstream, got %+v", deep)
+	}
+	if len(deep.Notes) != 1 || deep.Notes[0] != "postgres precedes checkout (via payments)" {
+		t.Fatalf("unexpected notes %v", deep.Notes)
+	}
+}
@@ -0,0 +1,111 @@
+package engine
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/cache"
+	"github.com/miradorstack/mirador-rca/internal/metrics"
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// IncidentClaims lets replicas that receive the same incident at once share one investigation. The first
+// caller claims the incident's fingerprint with SETNX and investigates; the others, in this process or on
+// another replica sharing the cache, wait for its result, and later arrivals get that result until the hold
+// period passes. An incident is fingerprinted by its alert fingerprints, or by its ID when it has none. The
+// investigation runs in the claiming caller's goroutine under its context, so it keeps that caller's executor
+// slot until it stops.
+type IncidentClaims struct {
+	loader *cache.Loader
+	hold   time.Duration
+	logger *slog.Logger
+}
+
+// NewIncidentClaims claims incidents in provider. wait bounds how long other replicas wait for the claim
+// holder before investigating themselves and should cover the investigation budget; hold is how long the
+// result is shared after the investigation finishes.
+func NewIncidentClaims(provider cache.Provider, wait, hold time.Duration, logger *slog.Logger) *IncidentClaims {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &IncidentClaims{loader: cache.NewLoader(provider, wait), hold: hold, logger: logger}
+}
+
+// WithIncidentClaims shares the investigation of an incident between the callers that request it together.
+func WithIncidentClaims(claims *IncidentClaims) PipelineOption {
+	return func(p *Pipeline) {
+		p.claims = claims
+	}
+}
+
+// investigate runs investigate under the claim for req's incident, or directly when the request carries no
+// incident identity. Partial results are shared with the callers already waiting but not held for later ones.
+func (c *IncidentClaims) investigate(ctx context.Context, req models.InvestigationRequest, investigate func(context.Context) (models.CorrelationResult, error)) (models.CorrelationResult, error) {
+	if c == nil {
+		return investigate(ctx)
+	}
+	key := incidentClaimKey(req)
+	if key == "" {
+		return investigate(ctx)
+	}
+
+	var fresh *models.CorrelationResult
+	data, err := c.loader.Load(ctx, key, func(ctx context.Context) ([]byte, time.Duration, error) {
+		result, err := investigate(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		fresh = &result
+		payload, err := json.Marshal(result)
+		if len(result.UnavailableSources) > 0 {
+			return payload, 0, err
+		}
+		return payload, c.hold, err
+	})
+	if err != nil {
+		return models.CorrelationResult{}, err
+	}
+	if fresh != nil {
+		metrics.ObserveIncidentClaim(true)
+		return *fresh, nil
+	}
+	var shared models.CorrelationResult
+	if err := json.Unmarshal(data, &shared); err != nil {
+		return investigate(ctx)
+	}
+	metrics.ObserveIncidentClaim(false)
+	c.logger.Info("incident already investigated; sharing the result",
+		slog.String("incident_id", req.IncidentID),
+		slog.String("tenant_id", req.TenantID),
+		slog.String("correlation_id", shared.CorrelationID),
+	)
+	return shared, nil
+}
+
+// incidentClaimKey returns the claim key of the incident behind req in its environment, or "" when the request
+// names none.
+func incidentClaimKey(req models.InvestigationRequest) string {
+	var fingerprint string
+	switch {
+	case len(req.Incident.AlertFingerprints) > 0:
+		alerts := slices.Clone(req.Incident.AlertFingerprints)
+		slices.Sort(alerts)
+		fingerprint = "alerts:" + strings.Join(slices.Compact(alerts), ",")
+	case req.IncidentID != "":
+		fingerprint = "id:" + req.IncidentID
+	default:
+		return ""
+	}
+	// The same incident investigated in two environments is two runs, not one.
+	if req.Environment != "" {
+		fingerprint += "|environment:" + req.Environment
+	}
+	digest := sha256.Sum256([]byte(fingerprint))
+	return "incident:" + req.TenantID + ":" + hex.EncodeToString(digest[:16])
+}
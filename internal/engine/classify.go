@@ -0,0 +1,129 @@
+package engine
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// CategoryRule tags a correlation with Category when any keyword appears in its evidence. Weight scales each
+// match; anchor selectors and the root cause count double relative to timeline events and log lines.
+type CategoryRule struct {
+	Category models.RootCauseCategory `yaml:"category"`
+	Keywords []string                 `yaml:"keywords"`
+	Weight   float64                  `yaml:"weight"`
+}
+
+// Classifier assigns a root-cause category using keyword rules plus topology heuristics.
+type Classifier struct {
+	rules []CategoryRule
+}
+
+// NewClassifier constructs a classifier. With no rules the built-in DefaultCategoryRules are used.
+func NewClassifier(rules ...CategoryRule) *Classifier {
+	if len(rules) == 0 {
+		rules = DefaultCategoryRules()
+	}
+	return &Classifier{rules: rules}
+}
+
+// DefaultCategoryRules returns the built-in keyword rules for deployment, capacity, dependency, config,
+// and network failures.
+func DefaultCategoryRules() []CategoryRule {
+	return []CategoryRule{
+		{Category: models.CategoryDeployment, Weight: 1.2, Keywords: []string{"deploy", "rollout", "release", "canary", "new version", "image"}},
+		{Category: models.CategoryCapacity, Weight: 1, Keywords: []string{"cpu", "memory", "saturation", "oom", "throttl", "resourceexhausted", "http_429", "queue", "disk full"}},
+		{Category: models.CategoryDependency, Weight: 1, Keywords: []string{"upstream", "dependency", "http_502", "http_503", "http_504", "grpc_unavailable", "causality:"}},
+		{Category: models.CategoryConfig, Weight: 1.1, Keywords: []string{"config", "feature flag", "invalid", "missing", "http_401", "http_403", "grpc_permissiondenied", "grpc_invalidargument"}},
+		{Category: models.CategoryNetwork, Weight: 1, Keywords: []string{"timeout", "deadlineexceeded", "dns", "connection reset", "connection refused", "tls", "unreachable", "network"}},
+	}
+}
+
+// AddRule appends a rule, allowing deployments to extend the built-in taxonomy.
+func (c *Classifier) AddRule(rule CategoryRule) {
+	c.rules = append(c.rules, rule)
+}
+
+// WithOverrides returns a classifier whose rule for each overridden category uses the override's keywords,
+// and its weight when positive. Overrides for categories without a rule are added, weighted 1 unless set.
+func (c *Classifier) WithOverrides(overrides []CategoryRule) *Classifier {
+	if c == nil || len(overrides) == 0 {
+		return c
+	}
+	rules := append([]CategoryRule(nil), c.rules...)
+	for _, override := range overrides {
+		i := slices.IndexFunc(rules, func(rule CategoryRule) bool { return rule.Category == override.Category })
+		if i < 0 {
+			if override.Weight <= 0 {
+				override.Weight = 1
+			}
+			rules = append(rules, override)
+			continue
+		}
+		rules[i].Keywords = override.Keywords
+		if override.Weight > 0 {
+			rules[i].Weight = override.Weight
+		}
+	}
+	return &Classifier{rules: rules}
+}
+
+// Classify returns the highest-scoring category for the correlation, or CategoryUnknown when nothing matches.
+// An upstream service suggested by causality analysis biases towards a dependency failure.
+func (c *Classifier) Classify(result models.CorrelationResult, signals Signals, upstream bool) models.RootCauseCategory {
+	if c == nil {
+		return models.CategoryUnknown
+	}
+
+	// A signal that dropped below its baseline, such as CPU falling off with traffic, is no evidence of
+	// saturation, so it does not count towards capacity.
+	type source struct {
+		text   string
+		weight float64
+		drop   bool
+	}
+	sources := []source{{text: result.RootCause, weight: 2}}
+	for _, anchor := range result.RedAnchors {
+		sources = append(sources, source{text: anchor.Selector, weight: 2, drop: anchor.Direction == models.DirectionDown})
+		for _, line := range anchor.Evidence.LogLines {
+			sources = append(sources, source{text: line, weight: 1})
+		}
+	}
+	for _, event := range result.Timeline {
+		sources = append(sources, source{text: event.Event, weight: 1, drop: event.Direction == models.DirectionDown})
+	}
+	for _, entry := range signals.Logs {
+		if entry.Message != "" {
+			sources = append(sources, source{text: entry.Message, weight: 0.5})
+		}
+	}
+
+	scores := make(map[models.RootCauseCategory]float64)
+	for _, rule := range c.rules {
+		for _, src := range sources {
+			if src.drop && rule.Category == models.CategoryCapacity {
+				continue
+			}
+			text := strings.ToLower(src.text)
+			for _, kw := range rule.Keywords {
+				if kw != "" && strings.Contains(text, strings.ToLower(kw)) {
+					scores[rule.Category] += src.weight * rule.Weight
+					break
+				}
+			}
+		}
+	}
+	if upstream {
+		scores[models.CategoryDependency] += 2
+	}
+
+	best := models.CategoryUnknown
+	bestScore := 0.0
+	for _, rule := range c.rules {
+		if score := scores[rule.Category]; score > bestScore {
+			best, bestScore = rule.Category, score
+		}
+	}
+	return best
+}
@@ -0,0 +1,196 @@
+package engine
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+const (
+	// dominantAnchorCount is how many of the highest-scoring anchors identify an incident.
+	dominantAnchorCount = 3
+	// duplicateSimilarity and relatedSimilarity are the service/anchor overlap thresholds for linking a new
+	// correlation to a recent one.
+	duplicateSimilarity  = 0.85
+	relatedSimilarity    = 0.5
+	maxRelated           = 5
+	maxClusterCandidates = 500
+)
+
+// CorrelationLister pages through stored correlations.
+type CorrelationLister interface {
+	ListCorrelations(ctx context.Context, req models.ListCorrelationsRequest) (models.ListCorrelationsResponse, error)
+}
+
+// Clusterer links a new correlation to recent ones for the same incident, so an alert storm produces one
+// primary correlation plus duplicates instead of many independent results. Correlations match on their
+// affected services and dominant anchors.
+type Clusterer struct {
+	lister CorrelationLister
+	window time.Duration
+}
+
+// NewClusterer builds a clusterer comparing against correlations created within window. A non-positive
+// window defaults to one hour.
+func NewClusterer(lister CorrelationLister, window time.Duration) *Clusterer {
+	if window <= 0 {
+		window = time.Hour
+	}
+	return &Clusterer{lister: lister, window: window}
+}
+
+// Link sets DuplicateOf and RelatedCorrelations on result from the tenant's recent correlations in the same
+// environment. A duplicate always points at the primary correlation, never at another duplicate.
+func (c *Clusterer) Link(ctx context.Context, tenantID string, result *models.CorrelationResult) error {
+	if c == nil || c.lister == nil || result == nil {
+		return nil
+	}
+	if len(result.AffectedServices) == 0 && len(result.RedAnchors) == 0 {
+		return nil
+	}
+	candidates, err := c.recent(ctx, tenantID, result.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	fingerprint := CorrelationFingerprint(*result)
+	type match struct {
+		id    string
+		score float64
+	}
+	var related []match
+	best := match{}
+	for _, candidate := range candidates {
+		if candidate.CorrelationID == "" || candidate.CorrelationID == result.CorrelationID {
+			continue
+		}
+		if candidate.Environment != result.Environment {
+			continue
+		}
+		id := candidate.CorrelationID
+		if candidate.DuplicateOf != "" {
+			id = candidate.DuplicateOf
+		}
+		score := correlationSimilarity(*result, candidate)
+		// Without anchors a fingerprint covers only the services, which alone do not make a duplicate.
+		if len(result.RedAnchors) > 0 && CorrelationFingerprint(candidate) == fingerprint {
+			score = 1
+		}
+		if score >= duplicateSimilarity && score > best.score {
+			best = match{id: id, score: score}
+		}
+		if score >= relatedSimilarity {
+			related = append(related, match{id: id, score: score})
+		}
+	}
+
+	sort.SliceStable(related, func(i, j int) bool { return related[i].score > related[j].score })
+	result.DuplicateOf = best.id
+	result.RelatedCorrelations = nil
+	seen := map[string]bool{best.id: true}
+	for _, m := range related {
+		if seen[m.id] {
+			continue
+		}
+		seen[m.id] = true
+		result.RelatedCorrelations = append(result.RelatedCorrelations, m.id)
+		if len(result.RelatedCorrelations) == maxRelated {
+			break
+		}
+	}
+	return nil
+}
+
+func (c *Clusterer) recent(ctx context.Context, tenantID string, at time.Time) ([]models.CorrelationResult, error) {
+	if at.IsZero() {
+		at = time.Now()
+	}
+	var correlations []models.CorrelationResult
+	pageToken := ""
+	for len(correlations) < maxClusterCandidates {
+		resp, err := c.lister.ListCorrelations(ctx, models.ListCorrelationsRequest{
+			TenantID:  tenantID,
+			Start:     at.Add(-c.window),
+			End:       at,
+			PageSize:  100,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list recent correlations: %w", err)
+		}
+		correlations = append(correlations, resp.Correlations...)
+		if resp.NextPageToken == "" || resp.NextPageToken == pageToken {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+	return correlations, nil
+}
+
+// CorrelationFingerprint hashes a correlation's affected services and dominant anchors; correlations for the
+// same incident share a fingerprint regardless of ordering.
+func CorrelationFingerprint(result models.CorrelationResult) string {
+	services := normalizedSet(result.AffectedServices)
+	anchors := dominantAnchors(result.RedAnchors)
+	sum := sha256.Sum256([]byte(strings.Join(services, ",") + "|" + strings.Join(anchors, ",")))
+	return hex.EncodeToString(sum[:8])
+}
+
+func correlationSimilarity(a, b models.CorrelationResult) float64 {
+	return 0.5*jaccard(normalizedSet(a.AffectedServices), normalizedSet(b.AffectedServices)) +
+		0.5*jaccard(dominantAnchors(a.RedAnchors), dominantAnchors(b.RedAnchors))
+}
+
+// dominantAnchors returns the sorted service:selector keys of the highest-scoring anchors.
+func dominantAnchors(anchors []models.RedAnchor) []string {
+	ranked := append([]models.RedAnchor(nil), anchors...)
+	sort.SliceStable(ranked, func(i, j int) bool { return ranked[i].AnomalyScore > ranked[j].AnomalyScore })
+	keys := make([]string, 0, dominantAnchorCount)
+	for _, anchor := range ranked {
+		if len(keys) == dominantAnchorCount {
+			break
+		}
+		keys = append(keys, strings.ToLower(anchor.Service)+":"+anchor.Selector)
+	}
+	return normalizedSet(keys)
+}
+
+func normalizedSet(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		v = strings.ToLower(strings.TrimSpace(v))
+		if v == "" || seen[v] {
+			continue
+		}
+		seen[v] = true
+		out = append(out, v)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// jaccard compares two sorted sets; two empty sets share nothing, so correlations without anchors are not
+// alike on that account.
+func jaccard(a, b []string) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 0
+	}
+	inA := make(map[string]bool, len(a))
+	for _, v := range a {
+		inA[v] = true
+	}
+	shared := 0
+	for _, v := range b {
+		if inA[v] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
@@ -0,0 +1,150 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// maxDriftEvents bounds the topology drift events added to a timeline; the changes closest to the first
+// anomaly are kept.
+const maxDriftEvents = 3
+
+// TopologyHistory reads the service graph snapshots stored for a tenant environment, oldest first.
+type TopologyHistory interface {
+	TopologySnapshots(ctx context.Context, tenantID, environment string, start, end time.Time) ([]models.TopologySnapshot, error)
+}
+
+// WithTopologyDrift compares the snapshots history holds for the lookback before an investigation's first
+// anomaly and adds a timeline event for each dependency of the affected services that appeared or disappeared
+// and stayed that way. A non-positive lookback defaults to 30 minutes.
+func WithTopologyDrift(history TopologyHistory, lookback time.Duration) PipelineOption {
+	return func(p *Pipeline) {
+		if lookback <= 0 {
+			lookback = 30 * time.Minute
+		}
+		p.topology, p.driftLookback = history, lookback
+	}
+}
+
+// topologyDrift returns the drift events for services in the lookback before onset, or none without an onset
+// or when fewer than two snapshots cover the lookback.
+func (p *Pipeline) topologyDrift(ctx context.Context, req models.InvestigationRequest, services []string, onset time.Time) []models.TimelineEvent {
+	if p.topology == nil || onset.IsZero() {
+		return nil
+	}
+	ctx, cancel := withTimeout(ctx, p.timeouts.ServiceGraph)
+	defer cancel()
+	snapshots, err := p.topology.TopologySnapshots(ctx, req.TenantID, req.Environment, onset.Add(-p.driftLookback), onset)
+	if err != nil {
+		p.logger.Warn("topology snapshots unavailable; skipping drift detection", slog.Any("error", err))
+		return nil
+	}
+	return driftEvents(snapshots, services, onset)
+}
+
+// anomalyOnset is the time of the earliest event of a timeline built from anomalies alone, or zero for an
+// empty one.
+func anomalyOnset(timeline []models.TimelineEvent) time.Time {
+	var onset time.Time
+	for _, event := range timeline {
+		if onset.IsZero() || event.Time.Before(onset) {
+			onset = event.Time
+		}
+	}
+	return onset
+}
+
+type edgeKey struct {
+	source string
+	target string
+}
+
+// driftEvents compares the first and last of snapshots, which must be ordered oldest first, and reports each
+// edge touching services that one has and the other lacks. The change is dated to the snapshot from which the
+// edge stayed present, or absent, up to the last one, so edges that flap between snapshots are not reported.
+func driftEvents(snapshots []models.TopologySnapshot, services []string, onset time.Time) []models.TimelineEvent {
+	if len(snapshots) < 2 {
+		return nil
+	}
+	relevant := make(map[string]bool, len(services))
+	for _, service := range services {
+		relevant[service] = true
+	}
+	present := make([]map[edgeKey]models.TopologyEdge, len(snapshots))
+	for i, snapshot := range snapshots {
+		present[i] = make(map[edgeKey]models.TopologyEdge, len(snapshot.Edges))
+		for _, edge := range snapshot.Edges {
+			if relevant[edge.Source] || relevant[edge.Target] {
+				present[i][edgeKey{edge.Source, edge.Target}] = edge
+			}
+		}
+	}
+
+	first, last := present[0], present[len(present)-1]
+	var events []models.TimelineEvent
+	report := func(key edgeKey, edge models.TopologyEdge, added bool) {
+		since := len(snapshots) - 1
+		for since > 0 {
+			if _, ok := present[since-1][key]; ok != added {
+				break
+			}
+			since--
+		}
+		changedAt := snapshots[since].CapturedAt
+		event := models.TimelineEvent{
+			Time:       changedAt,
+			Service:    key.source,
+			Severity:   models.SeverityMedium,
+			DataSource: models.DataTypeTraces,
+		}
+		if added {
+			event.Event = fmt.Sprintf("Topology drift: %s began calling %s %s before the anomaly", key.source, key.target, leadTime(onset.Sub(changedAt)))
+			if edge.ErrorRate > 0 {
+				event.Event += fmt.Sprintf(" (error rate %.2f%%)", edge.ErrorRate)
+			}
+		} else {
+			event.Severity = models.SeverityLow
+			event.Event = fmt.Sprintf("Topology drift: %s stopped calling %s %s before the anomaly", key.source, key.target, leadTime(onset.Sub(changedAt)))
+		}
+		events = append(events, event)
+	}
+	for key, edge := range last {
+		if _, ok := first[key]; !ok {
+			report(key, edge, true)
+		}
+	}
+	for key, edge := range first {
+		if _, ok := last[key]; !ok {
+			report(key, edge, false)
+		}
+	}
+
+	// Latest changes first, so the cap keeps those closest to the anomaly.
+	sort.Slice(events, func(i, j int) bool {
+		if !events[i].Time.Equal(events[j].Time) {
+			return events[i].Time.After(events[j].Time)
+		}
+		return events[i].Event < events[j].Event
+	})
+	if len(events) > maxDriftEvents {
+		events = events[:maxDriftEvents]
+	}
+	return events
+}
+
+// leadTime renders how long before the anomaly a change was seen, in whole minutes.
+func leadTime(d time.Duration) string {
+	switch minutes := int(d.Round(time.Minute) / time.Minute); {
+	case minutes < 1:
+		return "under a minute"
+	case minutes == 1:
+		return "1 minute"
+	default:
+		return fmt.Sprintf("%d minutes", minutes)
+	}
+}
@@ -0,0 +1,164 @@
+package engine
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/extractors"
+	"github.com/miradorstack/mirador-rca/internal/models"
+	"github.com/miradorstack/mirador-rca/internal/repo"
+)
+
+const (
+	evidenceMetricWindow = 3
+	evidenceMaxItems     = 5
+	evidenceMaxExemplars = 3
+)
+
+// attachEvidence populates each anchor with the raw samples closest to its timestamp: metric values around
+// the spike, matching log lines, or exemplar traces depending on the anchor's data type.
+func attachEvidence(anchors []models.RedAnchor, signals Signals) {
+	for i := range anchors {
+		switch anchors[i].DataType {
+		case models.DataTypeMetrics:
+			anchors[i].Evidence.MetricValues = metricEvidence(anchors[i], signals.Metrics)
+		case models.DataTypeLogs:
+			anchors[i].Evidence.LogLines = logEvidence(anchors[i], signals.Logs)
+		case models.DataTypeTraces:
+			matches := traceMatches(anchors[i], signals.Traces)
+			anchors[i].Evidence.TraceIDs = traceEvidence(anchors[i], matches)
+			anchors[i].Evidence.Exemplars = traceExemplars(matches)
+		}
+	}
+}
+
+func metricEvidence(anchor models.RedAnchor, points []repo.MetricPoint) []models.MetricSample {
+	series := make([]repo.MetricPoint, 0, len(points))
+	for _, point := range points {
+		if extractors.MetricSelector(point.Name) == anchor.Selector {
+			series = append(series, point)
+		}
+	}
+	if len(series) == 0 {
+		return nil
+	}
+	sort.Slice(series, func(i, j int) bool { return series[i].Timestamp.Before(series[j].Timestamp) })
+
+	center := 0
+	for i, point := range series {
+		if absDuration(point.Timestamp.Sub(anchor.Timestamp)) < absDuration(series[center].Timestamp.Sub(anchor.Timestamp)) {
+			center = i
+		}
+	}
+	from := max(center-evidenceMetricWindow, 0)
+	to := min(center+evidenceMetricWindow+1, len(series))
+
+	samples := make([]models.MetricSample, 0, to-from)
+	for _, point := range series[from:to] {
+		samples = append(samples, models.MetricSample{Timestamp: point.Timestamp, Value: point.Value})
+	}
+	return samples
+}
+
+func logEvidence(anchor models.RedAnchor, entries []repo.LogEntry) []string {
+	severity := ""
+	if parts := strings.SplitN(strings.TrimPrefix(anchor.Selector, "logs:"), ":", 2); len(parts) > 0 {
+		severity = parts[0]
+	}
+
+	matches := make([]repo.LogEntry, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Message == "" || (severity != "" && !strings.EqualFold(entry.Severity, severity)) {
+			continue
+		}
+		matches = append(matches, entry)
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return absDuration(matches[i].Timestamp.Sub(anchor.Timestamp)) < absDuration(matches[j].Timestamp.Sub(anchor.Timestamp))
+	})
+
+	lines := make([]string, 0, evidenceMaxItems)
+	for _, entry := range matches {
+		lines = appendUnique(lines, entry.Message)
+		if len(lines) == evidenceMaxItems {
+			break
+		}
+	}
+	return lines
+}
+
+// traceMatches returns the spans of the anchor's service and operation that carry a trace ID.
+func traceMatches(anchor models.RedAnchor, spans []repo.TraceSpan) []repo.TraceSpan {
+	operation := strings.TrimPrefix(anchor.Selector, "trace:")
+
+	matches := make([]repo.TraceSpan, 0, len(spans))
+	for _, span := range spans {
+		if span.TraceID == "" || span.Operation != operation {
+			continue
+		}
+		if anchor.Service != "" && span.Service != "" && !strings.EqualFold(span.Service, anchor.Service) {
+			continue
+		}
+		matches = append(matches, span)
+	}
+	return matches
+}
+
+// traceEvidence lists the trace IDs of matches closest to the anchor in time.
+func traceEvidence(anchor models.RedAnchor, matches []repo.TraceSpan) []string {
+	matches = slices.Clone(matches)
+	sort.SliceStable(matches, func(i, j int) bool {
+		return absDuration(matches[i].Timestamp.Sub(anchor.Timestamp)) < absDuration(matches[j].Timestamp.Sub(anchor.Timestamp))
+	})
+
+	ids := make([]string, 0, evidenceMaxItems)
+	for _, span := range matches {
+		ids = appendUnique(ids, span.TraceID)
+		if len(ids) == evidenceMaxItems {
+			break
+		}
+	}
+	return ids
+}
+
+// traceExemplars picks the spans of matches most worth opening: failing spans first, then the slowest, one per
+// trace.
+func traceExemplars(matches []repo.TraceSpan) []models.TraceExemplar {
+	matches = slices.Clone(matches)
+	sort.SliceStable(matches, func(i, j int) bool {
+		if failedI, failedJ := matches[i].Status == "error", matches[j].Status == "error"; failedI != failedJ {
+			return failedI
+		}
+		return matches[i].Duration > matches[j].Duration
+	})
+
+	exemplars := make([]models.TraceExemplar, 0, evidenceMaxExemplars)
+	seen := make(map[string]bool)
+	for _, span := range matches {
+		if seen[span.TraceID] {
+			continue
+		}
+		seen[span.TraceID] = true
+		exemplars = append(exemplars, models.TraceExemplar{
+			TraceID:   span.TraceID,
+			SpanID:    span.SpanID,
+			Operation: span.Operation,
+			Status:    span.Status,
+			Duration:  span.Duration,
+			Timestamp: span.Timestamp,
+		})
+		if len(exemplars) == evidenceMaxExemplars {
+			break
+		}
+	}
+	return exemplars
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,130 @@
+package engine
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/miradorstack/mirador-rca/internal/metrics"
+	"github.com/miradorstack/mirador-rca/internal/models"
+	"github.com/miradorstack/mirador-rca/internal/tracing"
+)
+
+// windowExpansion bounds how far sparse signal windows are widened.
+type windowExpansion struct {
+	minSamples int
+	max        time.Duration
+}
+
+// WithWindowExpansion re-fetches the signals over a wider window while the metrics or logs hold fewer than
+// minSamples samples. Each round doubles the window, split across both sides, until it has grown by
+// maxExpansion in total; the end never moves past the current time. Sources that returned nothing at all
+// are not considered sparse, since a wider window rarely changes that. A zero maxExpansion disables it.
+func WithWindowExpansion(minSamples int, maxExpansion time.Duration) PipelineOption {
+	return func(p *Pipeline) {
+		p.expansion = windowExpansion{minSamples: minSamples, max: maxExpansion}
+	}
+}
+
+// fetchExpanding fetches the signals for req, widening the window while they are too sparse. The returned
+// expansion is nil when the requested window was analysed.
+func (p *Pipeline) fetchExpanding(ctx context.Context, req models.InvestigationRequest, service string) (Signals, *models.WindowExpansion, error) {
+	signals, err := p.FetchSignals(ctx, req, service)
+	if err != nil || p.expansion.max <= 0 || p.expansion.minSamples <= 0 {
+		return signals, nil, err
+	}
+	sparse := sparseSources(signals, p.expansion.minSamples)
+	if len(sparse) == 0 {
+		return signals, nil, nil
+	}
+
+	ctx, span := tracing.Start(ctx, "rca.expand_window")
+	defer span.End()
+	expansion := &models.WindowExpansion{Requested: req.TimeRange, SparseSources: sparse, MinSamples: p.expansion.minSamples}
+	now := time.Now()
+	window := req.TimeRange
+	for added := time.Duration(0); added < p.expansion.max && ctx.Err() == nil; {
+		step := min(window.End.Sub(window.Start), p.expansion.max-added)
+		if step <= 0 {
+			step = p.expansion.max - added
+		}
+		added += step
+		window = widen(window, step, now)
+		req.TimeRange = window
+		wider, err := p.FetchSignals(ctx, req, service)
+		if err != nil {
+			p.logger.Warn("failed to fetch expanded signal window", slog.Any("error", err))
+			break
+		}
+		signals = wider
+		expansion.Analysed = window
+		if len(sparseSources(signals, p.expansion.minSamples)) == 0 {
+			break
+		}
+	}
+	if expansion.Analysed.Start.IsZero() {
+		return signals, nil, nil
+	}
+	expansion.Samples = fewestSamples(signals, sparse)
+	sufficient := expansion.Samples >= expansion.MinSamples
+	metrics.ObserveWindowExpansion(sufficient)
+	span.SetAttributes(
+		attribute.String("rca.window_start", expansion.Analysed.Start.Format(time.RFC3339)),
+		attribute.Bool("rca.window_sufficient", sufficient),
+	)
+	p.logger.Debug("expanded sparse signal window",
+		slog.String("tenant_id", req.TenantID),
+		slog.Time("start", expansion.Analysed.Start),
+		slog.Time("end", expansion.Analysed.End),
+		slog.Int("samples", expansion.Samples),
+	)
+	return signals, expansion, nil
+}
+
+// widen grows window by step, half on each side; growth the current time stops at the end goes to the start.
+func widen(window models.TimeRange, step time.Duration, now time.Time) models.TimeRange {
+	end := window.End.Add(step / 2)
+	if end.After(now) {
+		end = now
+		if window.End.After(now) {
+			end = window.End
+		}
+	}
+	window.Start = window.Start.Add(-(step - end.Sub(window.End)))
+	window.End = end
+	return window
+}
+
+// sparseSources lists the statistical sources holding some, but fewer than minSamples, samples.
+func sparseSources(signals Signals, minSamples int) []models.DataType {
+	var sparse []models.DataType
+	for _, source := range []models.DataType{models.DataTypeMetrics, models.DataTypeLogs} {
+		if n := sampleCount(signals, source); n > 0 && n < minSamples {
+			sparse = append(sparse, source)
+		}
+	}
+	return sparse
+}
+
+// fewestSamples returns the smallest sample count among sources.
+func fewestSamples(signals Signals, sources []models.DataType) int {
+	fewest := -1
+	for _, source := range sources {
+		if n := sampleCount(signals, source); fewest < 0 || n < fewest {
+			fewest = n
+		}
+	}
+	return max(fewest, 0)
+}
+
+func sampleCount(signals Signals, source models.DataType) int {
+	switch source {
+	case models.DataTypeMetrics:
+		return len(signals.Metrics)
+	case models.DataTypeLogs:
+		return len(signals.Logs)
+	}
+	return 0
+}
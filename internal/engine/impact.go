@@ -0,0 +1,145 @@
+package engine
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+	"github.com/miradorstack/mirador-rca/internal/repo"
+	"github.com/miradorstack/mirador-rca/internal/tracing"
+)
+
+// defaultSLOPeriod is the error budget period of SLOs that do not set one.
+const defaultSLOPeriod = 30 * 24 * time.Hour
+
+// SLOClient is implemented by core clients that can fetch the SLO definitions of a service.
+type SLOClient interface {
+	FetchSLOs(ctx context.Context, tenantID, service string) ([]repo.SLO, error)
+}
+
+// ImpactScorer scores correlations by the error budget their window burned on the investigated service's SLOs.
+type ImpactScorer struct {
+	slos         map[string][]repo.SLO
+	criticalBurn float64
+}
+
+// NewImpactScorer constructs a scorer from configured SLOs. A window burning the budget at criticalBurn or
+// faster scores 1; values below 1 default to 14.4, the rate that spends 2% of a 30-day budget in an hour.
+func NewImpactScorer(slos []repo.SLO, criticalBurn float64) *ImpactScorer {
+	if criticalBurn < 1 {
+		criticalBurn = 14.4
+	}
+	scorer := &ImpactScorer{slos: make(map[string][]repo.SLO), criticalBurn: criticalBurn}
+	for _, slo := range slos {
+		key := strings.ToLower(slo.Service)
+		scorer.slos[key] = append(scorer.slos[key], slo)
+	}
+	return scorer
+}
+
+// WithImpactScorer reports the error budget burn of the investigated service's SLOs and an impact score on
+// each correlation. SLOs come from the scorer and, when the core client is an SLOClient, from mirador-core;
+// configured SLOs replace fetched ones of the same name.
+func WithImpactScorer(scorer *ImpactScorer) PipelineOption {
+	return func(p *Pipeline) {
+		p.impact = scorer
+	}
+}
+
+// Score measures each SLO against the indicator samples in metrics over window, and returns the impacts, most
+// burning first, with the impact score.
+func (s *ImpactScorer) Score(slos []repo.SLO, metrics []repo.MetricPoint, window models.TimeRange) ([]models.SLOImpact, float64) {
+	if s == nil {
+		return nil, 0
+	}
+	var impacts []models.SLOImpact
+	score := 0.0
+	for _, slo := range slos {
+		bad, ok := badFraction(slo, metrics)
+		if !ok || slo.Objective <= 0 || slo.Objective >= 1 {
+			continue
+		}
+		period := slo.Period
+		if period <= 0 {
+			period = defaultSLOPeriod
+		}
+		impact := models.SLOImpact{
+			Service:     slo.Service,
+			SLO:         slo.Name,
+			Objective:   slo.Objective,
+			BadFraction: bad,
+			BurnRate:    bad / (1 - slo.Objective),
+		}
+		impact.BudgetSpent = impact.BurnRate * window.End.Sub(window.Start).Seconds() / period.Seconds()
+		impacts = append(impacts, impact)
+		score = max(score, min(impact.BurnRate/s.criticalBurn, 1))
+	}
+	sort.SliceStable(impacts, func(i, j int) bool { return impacts[i].BurnRate > impacts[j].BurnRate })
+	return impacts, score
+}
+
+// badFraction is the mean bad-event fraction of the SLO's indicator samples, or for threshold SLOs the share
+// of samples above the threshold. It reports false when metrics hold no indicator samples.
+func badFraction(slo repo.SLO, metrics []repo.MetricPoint) (float64, bool) {
+	var samples, bad int
+	var sum float64
+	for _, point := range metrics {
+		if point.Name != slo.Indicator {
+			continue
+		}
+		samples++
+		if point.Value > slo.Threshold {
+			bad++
+		}
+		sum += min(max(point.Value, 0), 1)
+	}
+	if samples == 0 {
+		return 0, false
+	}
+	if slo.Threshold > 0 {
+		return float64(bad) / float64(samples), true
+	}
+	return sum / float64(samples), true
+}
+
+// serviceSLOs returns the SLOs of service: those fetched from mirador-core, replaced by configured ones of
+// the same name.
+func (p *Pipeline) serviceSLOs(ctx context.Context, req models.InvestigationRequest, service string) []repo.SLO {
+	configured := p.impact.slos[strings.ToLower(service)]
+	client, ok := p.coreClient.(SLOClient)
+	if !ok {
+		return configured
+	}
+	fetchCtx, cancel := withTimeout(repo.WithEnvironment(ctx, req.Environment), p.timeouts.Metrics)
+	defer cancel()
+	fetched, err := client.FetchSLOs(fetchCtx, req.TenantID, service)
+	if err != nil {
+		p.logger.Warn("SLO fetch failed; using configured SLOs", slog.String("service", service), slog.Any("error", err))
+		return configured
+	}
+	slos := append([]repo.SLO(nil), configured...)
+	for _, slo := range fetched {
+		if !slices.ContainsFunc(configured, func(c repo.SLO) bool { return c.Name == slo.Name }) {
+			slos = append(slos, slo)
+		}
+	}
+	return slos
+}
+
+// scoreImpact measures the error budget burn of service's SLOs during the request window.
+func (p *Pipeline) scoreImpact(ctx context.Context, req models.InvestigationRequest, service string, signals Signals) ([]models.SLOImpact, float64) {
+	if p.impact == nil {
+		return nil, 0
+	}
+	ctx, span := tracing.Start(ctx, "rca.impact")
+	defer span.End()
+	impacts, score := p.impact.Score(p.serviceSLOs(ctx, req, service), signals.Metrics, req.TimeRange)
+	span.SetAttributes(attribute.Float64("rca.impact_score", score))
+	return impacts, score
+}
@@ -0,0 +1,115 @@
+package engine
+
+import (
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/extractors"
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// timelineRun is a run of consecutive anomalous samples of one signal, carried by its onset with the peak
+// score. start and end are zero when the run is a single sample.
+type timelineRun struct {
+	extractors.Anomaly
+	start   time.Time
+	end     time.Time
+	ongoing bool
+}
+
+// sustainedRuns folds the metrics and logs anomalies of each signal into runs, so a twelve-minute error plateau
+// is one timeline event rather than twelve. Anomalies join a run while at most one and a half sampling steps
+// separate them, the step being the median spacing of the source's samples. A run that reaches the source's
+// latest sample is still ongoing. Trace anomalies, whose spans arrive at no fixed step, stay instants.
+func sustainedRuns(anomalies []extractors.Anomaly, signals Signals) []timelineRun {
+	type series struct {
+		dataType models.DataType
+		service  string
+		selector string
+		event    string
+	}
+	var order []series
+	grouped := make(map[series][]extractors.Anomaly)
+	var runs []timelineRun
+	for _, a := range anomalies {
+		if a.DataType != models.DataTypeMetrics && a.DataType != models.DataTypeLogs {
+			runs = append(runs, timelineRun{Anomaly: a})
+			continue
+		}
+		key := series{dataType: a.DataType, service: a.Service, selector: a.Selector, event: a.Event}
+		if _, ok := grouped[key]; !ok {
+			order = append(order, key)
+		}
+		grouped[key] = append(grouped[key], a)
+	}
+
+	for _, key := range order {
+		group := grouped[key]
+		sort.SliceStable(group, func(i, j int) bool { return group[i].Timestamp.Before(group[j].Timestamp) })
+		timestamps := sampleTimestamps(signals, key.dataType)
+		maxGap := samplingStep(timestamps) * 3 / 2
+		latest := time.Time{}
+		if len(timestamps) > 0 {
+			latest = timestamps[len(timestamps)-1]
+		}
+
+		first := 0
+		for i := 1; i <= len(group); i++ {
+			if i < len(group) && maxGap > 0 && group[i].Timestamp.Sub(group[i-1].Timestamp) <= maxGap {
+				continue
+			}
+			runs = append(runs, foldRun(group[first:i], latest))
+			first = i
+		}
+	}
+	return runs
+}
+
+// foldRun turns consecutive anomalies into one run, ongoing when its last sample is the source's latest.
+func foldRun(group []extractors.Anomaly, latest time.Time) timelineRun {
+	run := timelineRun{Anomaly: group[0]}
+	for _, a := range group[1:] {
+		if a.Score > run.Score {
+			run.Score, run.Value, run.Threshold = a.Score, a.Value, a.Threshold
+			if a.Severity != "" {
+				run.Severity = a.Severity
+			}
+		}
+	}
+	if last := group[len(group)-1]; last.Timestamp.After(run.Timestamp) {
+		run.start, run.end = run.Timestamp, last.Timestamp
+		run.ongoing = !latest.IsZero() && !last.Timestamp.Before(latest)
+	}
+	return run
+}
+
+// sampleTimestamps returns the distinct sample times of a source, earliest first.
+func sampleTimestamps(signals Signals, source models.DataType) []time.Time {
+	var timestamps []time.Time
+	switch source {
+	case models.DataTypeMetrics:
+		for _, point := range signals.Metrics {
+			timestamps = append(timestamps, point.Timestamp)
+		}
+	case models.DataTypeLogs:
+		for _, entry := range signals.Logs {
+			timestamps = append(timestamps, entry.Timestamp)
+		}
+	}
+	slices.SortFunc(timestamps, func(a, b time.Time) int { return a.Compare(b) })
+	return slices.CompactFunc(timestamps, time.Time.Equal)
+}
+
+// samplingStep is the median spacing of timestamps, or zero with fewer than two.
+func samplingStep(timestamps []time.Time) time.Duration {
+	if len(timestamps) < 2 {
+		return 0
+	}
+	steps := make([]time.Duration, 0, len(timestamps)-1)
+	for i := 1; i < len(timestamps); i++ {
+		steps = append(steps, timestamps[i].Sub(timestamps[i-1]))
+	}
+	slices.Sort(steps)
+	return steps[len(steps)/2]
+}
@@ -0,0 +1,98 @@
+package engine
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// LinkBuilder renders dashboard deep links (Grafana, Mirador UI) from URL templates. Templates may use the
+// placeholders {tenant}, {service}, {selector}, {from}, {to} (epoch milliseconds) and {from_rfc3339},
+// {to_rfc3339}; the time range spans the padding either side of the event, or of a sustained event's interval.
+// Trace templates may also use {trace_id} and {span_id}. Values are query-escaped.
+type LinkBuilder struct {
+	anchorTemplate string
+	eventTemplate  string
+	traceTemplate  string
+	padding        time.Duration
+}
+
+// NewLinkBuilder constructs a link builder. An empty template disables links for that kind of item.
+func NewLinkBuilder(anchorTemplate, eventTemplate, traceTemplate string, padding time.Duration) *LinkBuilder {
+	if padding <= 0 {
+		padding = 15 * time.Minute
+	}
+	return &LinkBuilder{
+		anchorTemplate: anchorTemplate,
+		eventTemplate:  eventTemplate,
+		traceTemplate:  traceTemplate,
+		padding:        padding,
+	}
+}
+
+// AnchorLink renders the deep link for a red anchor.
+func (b *LinkBuilder) AnchorLink(tenantID string, anchor models.RedAnchor) string {
+	if b == nil {
+		return ""
+	}
+	return b.render(b.anchorTemplate, tenantID, anchor.Service, anchor.Selector, anchor.Timestamp, anchor.Timestamp)
+}
+
+// EventLink renders the deep link for a timeline event; the selector placeholder carries the data source.
+func (b *LinkBuilder) EventLink(tenantID string, event models.TimelineEvent) string {
+	if b == nil {
+		return ""
+	}
+	if event.Sustained() {
+		return b.render(b.eventTemplate, tenantID, event.Service, string(event.DataSource), event.Start, event.End)
+	}
+	return b.render(b.eventTemplate, tenantID, event.Service, string(event.DataSource), event.Time, event.Time)
+}
+
+// TraceLink renders the tracing UI link for a trace exemplar; the selector placeholder carries the operation.
+func (b *LinkBuilder) TraceLink(tenantID, service string, exemplar models.TraceExemplar) string {
+	if b == nil || exemplar.TraceID == "" {
+		return ""
+	}
+	return b.render(b.traceTemplate, tenantID, service, exemplar.Operation, exemplar.Timestamp, exemplar.Timestamp,
+		"{trace_id}", url.QueryEscape(exemplar.TraceID),
+		"{span_id}", url.QueryEscape(exemplar.SpanID),
+	)
+}
+
+func (b *LinkBuilder) render(template, tenantID, service, selector string, start, end time.Time, extra ...string) string {
+	if template == "" || start.IsZero() {
+		return ""
+	}
+	from := start.Add(-b.padding).UTC()
+	to := end.Add(b.padding).UTC()
+	replacer := strings.NewReplacer(append(extra,
+		"{tenant}", url.QueryEscape(tenantID),
+		"{service}", url.QueryEscape(service),
+		"{selector}", url.QueryEscape(selector),
+		"{from}", strconv.FormatInt(from.UnixMilli(), 10),
+		"{to}", strconv.FormatInt(to.UnixMilli(), 10),
+		"{from_rfc3339}", url.QueryEscape(from.Format(time.RFC3339)),
+		"{to_rfc3339}", url.QueryEscape(to.Format(time.RFC3339)),
+	)...)
+	return replacer.Replace(template)
+}
+
+func (b *LinkBuilder) attach(tenantID string, anchors []models.RedAnchor, timeline []models.TimelineEvent) {
+	if b == nil {
+		return
+	}
+	for i := range anchors {
+		anchors[i].Link = b.AnchorLink(tenantID, anchors[i])
+		for j := range anchors[i].Evidence.Exemplars {
+			exemplar := &anchors[i].Evidence.Exemplars[j]
+			exemplar.Link = b.TraceLink(tenantID, anchors[i].Service, *exemplar)
+		}
+	}
+	for i := range timeline {
+		timeline[i].Link = b.EventLink(tenantID, timeline[i])
+	}
+}
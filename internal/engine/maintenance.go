@@ -0,0 +1,149 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/miradorstack/mirador-rca/internal/extractors"
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// maintenanceScoreFactor down-weights anomalies that fall inside a planned maintenance window.
+const maintenanceScoreFactor = 0.5
+
+// ErrInvalidMaintenanceWindow is returned by Add for a window that is malformed or whose ID is taken.
+var ErrInvalidMaintenanceWindow = errors.New("invalid maintenance window")
+
+// MaintenanceStore persists the maintenance windows created at runtime so every replica sees them and they
+// survive restarts.
+type MaintenanceStore interface {
+	StoreMaintenanceWindow(ctx context.Context, window models.MaintenanceWindow) error
+	ListMaintenanceWindows(ctx context.Context, tenantID string) ([]models.MaintenanceWindow, error)
+	DeleteMaintenanceWindow(ctx context.Context, tenantID, id string) (bool, error)
+}
+
+// MaintenanceCalendar holds tenant maintenance windows consulted during analysis. Windows seeded from
+// configuration stay in memory; windows added at runtime go to the store when one is set.
+type MaintenanceCalendar struct {
+	store   MaintenanceStore
+	mu      sync.RWMutex
+	windows map[string][]models.MaintenanceWindow
+}
+
+// NewMaintenanceCalendar constructs a calendar seeded with the supplied windows; invalid entries are rejected.
+// A nil store keeps runtime windows in memory.
+func NewMaintenanceCalendar(store MaintenanceStore, windows ...models.MaintenanceWindow) (*MaintenanceCalendar, error) {
+	c := &MaintenanceCalendar{windows: make(map[string][]models.MaintenanceWindow)}
+	for _, w := range windows {
+		if _, err := c.Add(context.Background(), w); err != nil {
+			return nil, err
+		}
+	}
+	c.store = store
+	return c, nil
+}
+
+// Add validates and stores a window, assigning a random ID when none is provided.
+func (c *MaintenanceCalendar) Add(ctx context.Context, w models.MaintenanceWindow) (models.MaintenanceWindow, error) {
+	if w.TenantID == "" {
+		return models.MaintenanceWindow{}, fmt.Errorf("%w: a tenant is required", ErrInvalidMaintenanceWindow)
+	}
+	if w.Start.IsZero() || !w.End.After(w.Start) {
+		return models.MaintenanceWindow{}, fmt.Errorf("%w: end must be after start", ErrInvalidMaintenanceWindow)
+	}
+	if w.ID == "" {
+		w.ID = "mw-" + uuid.NewString()
+	}
+	w.Services = append([]string(nil), w.Services...)
+
+	existing, err := c.List(ctx, w.TenantID)
+	if err != nil {
+		return models.MaintenanceWindow{}, err
+	}
+	for _, other := range existing {
+		if other.ID == w.ID {
+			return models.MaintenanceWindow{}, fmt.Errorf("%w: %q already exists", ErrInvalidMaintenanceWindow, w.ID)
+		}
+	}
+	if c.store != nil {
+		if err := c.store.StoreMaintenanceWindow(ctx, w); err != nil {
+			return models.MaintenanceWindow{}, fmt.Errorf("store maintenance window: %w", err)
+		}
+		return w, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.windows[w.TenantID] = append(c.windows[w.TenantID], w)
+	return w, nil
+}
+
+// Remove deletes a tenant window by ID and reports whether it existed. Removing a configured window only
+// lasts until the next restart.
+func (c *MaintenanceCalendar) Remove(ctx context.Context, tenantID, id string) (bool, error) {
+	c.mu.Lock()
+	windows := c.windows[tenantID]
+	for i, w := range windows {
+		if w.ID == id {
+			c.windows[tenantID] = append(windows[:i:i], windows[i+1:]...)
+			c.mu.Unlock()
+			return true, nil
+		}
+	}
+	c.mu.Unlock()
+
+	if c.store == nil {
+		return false, nil
+	}
+	deleted, err := c.store.DeleteMaintenanceWindow(ctx, tenantID, id)
+	if err != nil {
+		return false, fmt.Errorf("delete maintenance window: %w", err)
+	}
+	return deleted, nil
+}
+
+// List returns a tenant's configured and stored windows ordered by start time.
+func (c *MaintenanceCalendar) List(ctx context.Context, tenantID string) ([]models.MaintenanceWindow, error) {
+	if c == nil {
+		return nil, nil
+	}
+	c.mu.RLock()
+	windows := append([]models.MaintenanceWindow(nil), c.windows[tenantID]...)
+	c.mu.RUnlock()
+
+	if c.store != nil {
+		stored, err := c.store.ListMaintenanceWindows(ctx, tenantID)
+		if err != nil {
+			return nil, fmt.Errorf("list maintenance windows: %w", err)
+		}
+		windows = append(windows, stored...)
+	}
+	sort.SliceStable(windows, func(i, j int) bool { return windows[i].Start.Before(windows[j].Start) })
+	return windows, nil
+}
+
+// annotateMaintenance down-weights the anomalies one of windows covers and returns how many it covered.
+func annotateMaintenance(windows []models.MaintenanceWindow, service string, anomalies []extractors.Anomaly) ([]extractors.Anomaly, int) {
+	if len(windows) == 0 {
+		return anomalies, 0
+	}
+	covered := 0
+	for i := range anomalies {
+		target := firstNonEmpty(anomalies[i].Service, service)
+		for _, w := range windows {
+			if !w.Covers(target, anomalies[i].Timestamp) {
+				continue
+			}
+			anomalies[i].Score *= maintenanceScoreFactor
+			anomalies[i].Event = fmt.Sprintf("%s (during maintenance: %s)", anomalies[i].Event, firstNonEmpty(w.Reason, w.ID))
+			covered++
+			break
+		}
+	}
+	return anomalies, covered
+}
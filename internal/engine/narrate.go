@@ -0,0 +1,56 @@
+package engine
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/miradorstack/mirador-rca/internal/features"
+	"github.com/miradorstack/mirador-rca/internal/metrics"
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// Narrator writes the narrative summary of a correlation in locale and may suggest further recommendations,
+// for example with a language model.
+type Narrator interface {
+	Narrate(ctx context.Context, locale string, result models.CorrelationResult) (models.Narrative, error)
+}
+
+// WithNarrator lets narrator replace the template summary and add recommendations for the tenants the
+// llm_narration flag is on for. When it fails or runs out of time, the template summary stays and no
+// recommendations are added.
+func WithNarrator(narrator Narrator) PipelineOption {
+	return func(p *Pipeline) {
+		p.narrator = narrator
+	}
+}
+
+// narrate applies the narrator's summary and recommendations to result. Recommendations it suggests are
+// marked Generated, and those repeating an existing one are dropped.
+func (p *Pipeline) narrate(ctx context.Context, req models.InvestigationRequest, result *models.CorrelationResult) {
+	if p.narrator == nil || !p.features.Enabled(features.LLMNarration, req.TenantID) {
+		return
+	}
+	narrateCtx, narration := startStage(ctx, "rca.narrate", metrics.StageNarration)
+	narrative, err := p.narrator.Narrate(narrateCtx, p.summarizer.Locale(req), *result)
+	narration.End(err)
+	if err != nil {
+		p.logger.Warn("language model narration failed; keeping the template summary",
+			slog.String("tenant_id", req.TenantID), slog.Any("error", err))
+		return
+	}
+
+	result.Summary = narrative.Summary
+	seen := make(map[string]bool, len(result.Recommendations))
+	for _, rec := range result.Recommendations {
+		seen[strings.ToLower(strings.TrimSpace(rec.Text))] = true
+	}
+	for _, text := range narrative.Recommendations {
+		key := strings.ToLower(strings.TrimSpace(text))
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		result.Recommendations = append(result.Recommendations, models.Recommendation{Text: text, Generated: true})
+	}
+}
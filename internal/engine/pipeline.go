@@ -4,13 +4,22 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/miradorstack/mirador-rca/internal/audit"
+	"github.com/miradorstack/mirador-rca/internal/engine/blastradius"
 	"github.com/miradorstack/mirador-rca/internal/extractors"
+	"github.com/miradorstack/mirador-rca/internal/features"
+	"github.com/miradorstack/mirador-rca/internal/metrics"
 	"github.com/miradorstack/mirador-rca/internal/models"
 	"github.com/miradorstack/mirador-rca/internal/repo"
+	"github.com/miradorstack/mirador-rca/internal/tracing"
 )
 
 // CoreClient defines the mirador-core signal client behaviour used by the pipeline.
@@ -21,88 +30,233 @@ type CoreClient interface {
 	FetchServiceGraph(ctx context.Context, tenantID string, start, end time.Time) ([]repo.ServiceGraphEdge, error)
 }
 
+// BaselineClient is implemented by core clients that can fetch a historical comparison window.
+type BaselineClient interface {
+	FetchBaselineMetricSeries(ctx context.Context, tenantID, service string, start, end time.Time) ([]repo.MetricPoint, error)
+}
+
 // WeaviateClient describes the Weaviate operations required for the pipeline.
 type WeaviateClient interface {
 	SimilarIncidents(ctx context.Context, tenantID string, symptoms []string, limit int) ([]models.CorrelationResult, error)
 	StoreCorrelation(ctx context.Context, tenantID string, correlation models.CorrelationResult) error
 }
 
+// Notifier pushes completed correlations to external channels such as chat or webhooks.
+type Notifier interface {
+	Notify(ctx context.Context, tenantID string, result models.CorrelationResult) error
+}
+
 // Pipeline orchestrates the phase-1 investigation flow.
 type Pipeline struct {
-	logger           *slog.Logger
-	coreClient       CoreClient
-	metricsExtractor *extractors.MetricExtractor
-	logsExtractor    *extractors.LogsExtractor
-	tracesExtractor  *extractors.TracesExtractor
-	weaviate         WeaviateClient
-	rulesEngine      *RuleEngine
-	causalityEngine  *CausalityEngine
+	logger          *slog.Logger
+	coreClient      CoreClient
+	extractors      *extractors.Registry
+	weaviate        WeaviateClient
+	rulesEngine     *RuleEngine
+	causalityEngine *CausalityEngine
+	blastRadius     *blastradius.Estimator
+	links           *LinkBuilder
+	classifier      *Classifier
+	maintenance     *MaintenanceCalendar
+	clusterer       *Clusterer
+	notifiers       []Notifier
+	timeouts        Timeouts
+	features        *features.Set
+	auditor         *audit.Logger
+	shadow          *ShadowDetectors
+	claims          *IncidentClaims
+	summarizer      *Summarizer
+	narrator        Narrator
+	thresholds      ThresholdSource
+	presets         map[string]Preset
+	expansion       windowExpansion
+	serviceNames    *ServiceNames
+	capacity        *CapacityAnalyzer
+	impact          *ImpactScorer
+	topology        TopologyHistory
+	driftLookback   time.Duration
+}
+
+// PipelineOption customises optional Pipeline behaviour.
+type PipelineOption func(*Pipeline)
+
+// WithClassifier replaces the default root-cause category classifier.
+func WithClassifier(classifier *Classifier) PipelineOption {
+	return func(p *Pipeline) {
+		p.classifier = classifier
+	}
+}
+
+// WithMaintenanceCalendar down-weights and annotates anomalies that occur during planned maintenance.
+func WithMaintenanceCalendar(calendar *MaintenanceCalendar) PipelineOption {
+	return func(p *Pipeline) {
+		p.maintenance = calendar
+	}
+}
+
+// WithClusterer marks new correlations as duplicates of, or related to, recent ones before they are stored.
+func WithClusterer(clusterer *Clusterer) PipelineOption {
+	return func(p *Pipeline) {
+		p.clusterer = clusterer
+	}
+}
+
+// WithNotifier delivers each completed correlation to n in the background after it is stored. The option
+// may be repeated; nil notifiers are ignored.
+func WithNotifier(n Notifier) PipelineOption {
+	return func(p *Pipeline) {
+		if n != nil {
+			p.notifiers = append(p.notifiers, n)
+		}
+	}
+}
+
+// WithFeatures evaluates feature flags per tenant from set; without it every flag takes its default.
+func WithFeatures(set *features.Set) PipelineOption {
+	return func(p *Pipeline) {
+		p.features = set
+	}
+}
+
+// WithLinkBuilder attaches dashboard deep links to anchors and timeline events.
+func WithLinkBuilder(links *LinkBuilder) PipelineOption {
+	return func(p *Pipeline) {
+		p.links = links
+	}
+}
+
+// WithAuditor records every investigation, successful or not, in the audit log.
+func WithAuditor(auditor *audit.Logger) PipelineOption {
+	return func(p *Pipeline) {
+		p.auditor = auditor
+	}
 }
 
 // Signals captures the raw inputs required for analysis.
 type Signals struct {
 	ServiceGraph []repo.ServiceGraphEdge
 	Metrics      []repo.MetricPoint
+	Baseline     []repo.MetricPoint
 	Logs         []repo.LogEntry
 	Traces       []repo.TraceSpan
+	// Unavailable lists signal sources that failed to fetch; analysis continues without them.
+	Unavailable []models.DataType
 }
 
-// NewPipeline constructs a new investigation pipeline.
+// NewPipeline constructs a new investigation pipeline. A nil registry enables the built-in metrics, logs, and traces detectors.
 func NewPipeline(
 	logger *slog.Logger,
 	coreClient CoreClient,
 	weaviate WeaviateClient,
 	rulesEngine *RuleEngine,
 	causalityEngine *CausalityEngine,
-	metricsExtractor *extractors.MetricExtractor,
-	logsExtractor *extractors.LogsExtractor,
-	tracesExtractor *extractors.TracesExtractor,
+	registry *extractors.Registry,
+	opts ...PipelineOption,
 ) *Pipeline {
 	if logger == nil {
 		logger = slog.Default()
 	}
-	if metricsExtractor == nil {
-		metricsExtractor = extractors.NewMetricExtractor()
-	}
-	if logsExtractor == nil {
-		logsExtractor = extractors.NewLogsExtractor()
-	}
-	if tracesExtractor == nil {
-		tracesExtractor = extractors.NewTracesExtractor()
+	if registry == nil {
+		registry = extractors.NewDefaultRegistry()
 	}
 
-	return &Pipeline{
-		logger:           logger,
-		coreClient:       coreClient,
-		metricsExtractor: metricsExtractor,
-		logsExtractor:    logsExtractor,
-		tracesExtractor:  tracesExtractor,
-		weaviate:         weaviate,
-		rulesEngine:      rulesEngine,
-		causalityEngine:  causalityEngine,
+	pipeline := &Pipeline{
+		logger:          logger,
+		coreClient:      coreClient,
+		extractors:      registry,
+		weaviate:        weaviate,
+		rulesEngine:     rulesEngine,
+		causalityEngine: causalityEngine,
+		blastRadius:     blastradius.NewEstimator(),
+		classifier:      NewClassifier(),
+		presets:         DefaultPresets(),
 	}
+	for _, opt := range opts {
+		opt(pipeline)
+	}
+	return pipeline
 }
 
 // Investigate executes the anomaly detection + ranking flow and returns a correlation result.
-func (p *Pipeline) Investigate(ctx context.Context, req models.InvestigationRequest) (models.CorrelationResult, error) {
+func (p *Pipeline) Investigate(ctx context.Context, req models.InvestigationRequest) (result models.CorrelationResult, err error) {
+	start := time.Now()
+	defer func() { p.audit(ctx, req, result, start, err) }()
 	if p.coreClient == nil {
 		return models.CorrelationResult{}, fmt.Errorf("core client not configured")
 	}
+	return p.claims.investigate(ctx, req, func(ctx context.Context) (models.CorrelationResult, error) {
+		return p.investigate(ctx, req)
+	})
+}
+
+// investigate runs one investigation within the investigation budget.
+func (p *Pipeline) investigate(ctx context.Context, req models.InvestigationRequest) (result models.CorrelationResult, err error) {
+	ctx, cancel := withTimeout(ctx, p.timeouts.Investigation)
+	defer cancel()
+
+	preset, err := p.preset(req.Preset)
+	if err != nil {
+		return models.CorrelationResult{}, err
+	}
+	req.TimeRange = preset.pad(req.TimeRange, time.Now())
 
 	service := p.DetermineService(req)
-	signals, err := p.FetchSignals(ctx, req, service)
+	ctx, span := tracing.Start(ctx, "rca.investigate",
+		attribute.String("rca.tenant_id", req.TenantID),
+		attribute.String("rca.incident_id", req.IncidentID),
+		attribute.String("rca.service", service),
+		attribute.String("rca.preset", req.Preset),
+		attribute.String("rca.environment", req.Environment),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	signals, expansion, err := p.fetchExpanding(ctx, req, service)
 	if err != nil {
 		return models.CorrelationResult{}, err
 	}
+	if expansion != nil {
+		req.TimeRange = expansion.Analysed
+	}
+	signals = p.serviceNames.normalizeSignals(signals)
+	req.AffectedServices = p.serviceNames.normalizeAll(req.AffectedServices)
+	service = p.serviceNames.Normalize(service)
 
-	result, err := p.Analyze(ctx, req, service, signals)
+	result, err = p.Analyze(ctx, req, service, signals)
 	if err != nil {
 		return models.CorrelationResult{}, err
 	}
+	result.WindowExpansion = expansion
+	clusterCtx, clustering := startStage(ctx, "rca.cluster", metrics.StageClustering)
+	clusterErr := p.clusterer.Link(clusterCtx, req.TenantID, &result)
+	clustering.End(clusterErr)
+	if clusterErr != nil {
+		p.logger.Warn("failed to cluster correlation", slog.Any("error", clusterErr))
+	}
 	p.PersistResult(ctx, req.TenantID, result)
+	p.notify(ctx, req.TenantID, result)
+	span.SetAttributes(attribute.String("rca.correlation_id", result.CorrelationID))
 	return result, nil
 }
 
+// audit records the investigation with the signal sources it could use.
+func (p *Pipeline) audit(ctx context.Context, req models.InvestigationRequest, result models.CorrelationResult, start time.Time, err error) {
+	if p.auditor == nil {
+		return
+	}
+	rec := audit.Record{Action: audit.ActionInvestigate, TenantID: req.TenantID, RequestHash: audit.Hash(req)}
+	if err == nil {
+		rec.ResultID = result.CorrelationID
+		rec.Confidence = result.Confidence
+		for _, source := range []models.DataType{models.DataTypeMetrics, models.DataTypeLogs, models.DataTypeTraces} {
+			if !slices.Contains(result.UnavailableSources, source) {
+				rec.Sources = append(rec.Sources, string(source))
+			}
+		}
+	}
+	rec.Finish(start, err)
+	p.auditor.Record(ctx, rec)
+}
+
 // DetermineService infers the primary service under investigation.
 func (p *Pipeline) DetermineService(req models.InvestigationRequest) string {
 	service := firstNonEmpty(req.AffectedServices...)
@@ -115,34 +269,101 @@ func (p *Pipeline) DetermineService(req models.InvestigationRequest) string {
 	return service
 }
 
-// FetchSignals retrieves metrics/logs/traces and the optional service graph from mirador-core.
+// FetchSignals retrieves metrics/logs/traces and the optional service graph from mirador-core, concurrently when
+// the parallel_fetch flag is on for the tenant. Every fetch is scoped to the request's environment. A failed
+// source is recorded in Signals.Unavailable; only when every source fails is an error returned.
 func (p *Pipeline) FetchSignals(ctx context.Context, req models.InvestigationRequest, service string) (Signals, error) {
 	var sig Signals
 	if p.coreClient == nil {
 		return sig, fmt.Errorf("core client not configured")
 	}
+	ctx = repo.WithEnvironment(ctx, req.Environment)
+	ctx, span := tracing.Start(ctx, "rca.fetch_signals")
+	defer span.End()
+
+	var graph []repo.ServiceGraphEdge
+	var series []repo.MetricPoint
+	var logs []repo.LogEntry
+	var spans []repo.TraceSpan
+	var graphErr, metricsErr, logsErr, spansErr error
+	// timed runs fetch as the pipeline stage name, bounded by timeout.
+	timed := func(source, name string, timeout time.Duration, fetch func(ctx context.Context) error) func() {
+		return func() {
+			fetchCtx, fetchStage := startStage(ctx, "rca.fetch."+source, name)
+			fetchCtx, cancel := withTimeout(fetchCtx, timeout)
+			defer cancel()
+			fetchStage.End(fetch(fetchCtx))
+		}
+	}
+	fetches := []func(){
+		timed("service_graph", metrics.StageGraphFetch, p.timeouts.ServiceGraph, func(ctx context.Context) error {
+			graph, graphErr = p.coreClient.FetchServiceGraph(ctx, req.TenantID, req.TimeRange.Start, req.TimeRange.End)
+			return graphErr
+		}),
+		timed("metrics", metrics.StageMetricsFetch, p.timeouts.Metrics, func(ctx context.Context) error {
+			series, metricsErr = p.coreClient.FetchMetricSeries(ctx, req.TenantID, service, req.TimeRange.Start, req.TimeRange.End)
+			return metricsErr
+		}),
+		timed("logs", metrics.StageLogsFetch, p.timeouts.Logs, func(ctx context.Context) error {
+			logs, logsErr = p.coreClient.FetchLogEntries(ctx, req.TenantID, service, req.TimeRange.Start, req.TimeRange.End)
+			return logsErr
+		}),
+		timed("traces", metrics.StageTracesFetch, p.timeouts.Traces, func(ctx context.Context) error {
+			spans, spansErr = p.coreClient.FetchTraceSpans(ctx, req.TenantID, service, req.TimeRange.Start, req.TimeRange.End)
+			return spansErr
+		}),
+	}
+	if p.features.Enabled(features.ParallelFetch, req.TenantID) {
+		var wg sync.WaitGroup
+		for _, fetch := range fetches {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				fetch()
+			}()
+		}
+		wg.Wait()
+	} else {
+		for _, fetch := range fetches {
+			fetch()
+		}
+	}
 
-	graph, err := p.coreClient.FetchServiceGraph(ctx, req.TenantID, req.TimeRange.Start, req.TimeRange.End)
-	if err != nil {
-		p.logger.Warn("service graph fetch failed", slog.Any("error", err))
+	if graphErr != nil {
+		p.logger.Warn("service graph fetch failed", slog.Any("error", graphErr))
 	} else {
 		sig.ServiceGraph = graph
 	}
-
-	metrics, err := p.coreClient.FetchMetricSeries(ctx, req.TenantID, service, req.TimeRange.Start, req.TimeRange.End)
-	if err != nil {
-		return sig, fmt.Errorf("fetch metrics: %w", err)
+	if metricsErr != nil {
+		p.logger.Warn("metrics fetch failed; continuing without metrics", slog.Any("error", metricsErr))
+		sig.Unavailable = append(sig.Unavailable, models.DataTypeMetrics)
 	}
-	logs, err := p.coreClient.FetchLogEntries(ctx, req.TenantID, service, req.TimeRange.Start, req.TimeRange.End)
-	if err != nil {
-		return sig, fmt.Errorf("fetch logs: %w", err)
+	if logsErr != nil {
+		p.logger.Warn("logs fetch failed; continuing without logs", slog.Any("error", logsErr))
+		sig.Unavailable = append(sig.Unavailable, models.DataTypeLogs)
 	}
-	spans, err := p.coreClient.FetchTraceSpans(ctx, req.TenantID, service, req.TimeRange.Start, req.TimeRange.End)
-	if err != nil {
-		return sig, fmt.Errorf("fetch traces: %w", err)
+	if spansErr != nil {
+		p.logger.Warn("traces fetch failed; continuing without traces", slog.Any("error", spansErr))
+		sig.Unavailable = append(sig.Unavailable, models.DataTypeTraces)
+	}
+	if len(sig.Unavailable) == 3 {
+		return sig, fmt.Errorf("fetch signals: metrics, logs, and traces all unavailable: %w", spansErr)
+	}
+
+	if baseline, ok := p.coreClient.(BaselineClient); ok && series != nil {
+		baselineCtx, baselineStage := startStage(ctx, "rca.fetch.baseline", metrics.StageBaselineFetch)
+		baselineCtx, cancel := withTimeout(baselineCtx, p.timeouts.Baseline)
+		history, err := baseline.FetchBaselineMetricSeries(baselineCtx, req.TenantID, service, req.TimeRange.Start, req.TimeRange.End)
+		cancel()
+		baselineStage.End(err)
+		if err != nil {
+			p.logger.Warn("baseline metrics fetch failed", slog.Any("error", err))
+		} else {
+			sig.Baseline = history
+		}
 	}
 
-	sig.Metrics = metrics
+	sig.Metrics = series
 	sig.Logs = logs
 	sig.Traces = spans
 	return sig, nil
@@ -150,20 +371,43 @@ func (p *Pipeline) FetchSignals(ctx context.Context, req models.InvestigationReq
 
 // Analyze performs anomaly detection, causality checks, and recommendation assembly.
 func (p *Pipeline) Analyze(ctx context.Context, req models.InvestigationRequest, service string, signals Signals) (models.CorrelationResult, error) {
-	metricAnomalies := p.metricsExtractor.Detect(signals.Metrics, req.AnomalyThreshold)
-	logAnomalies := p.logsExtractor.Detect(signals.Logs)
-	traceAnomalies := p.tracesExtractor.Detect(signals.Traces)
+	ctx, span := tracing.Start(ctx, "rca.analyze")
+	defer span.End()
+
+	preset, err := p.preset(req.Preset)
+	if err != nil {
+		return models.CorrelationResult{}, err
+	}
+	req.AnomalyThreshold = p.anomalyThreshold(req, service)
+	detectCtx, detection := startStage(ctx, "rca.detect", metrics.StageDetection)
+	anomalies, detectors := p.detect(detectCtx, req, service, signals)
+	detection.span.SetAttributes(attribute.Int("rca.anomalies", len(anomalies)))
+	detection.End(nil)
+	windows, err := p.maintenance.List(ctx, req.TenantID)
+	if err != nil {
+		p.logger.Warn("maintenance windows unavailable; continuing without them", slog.Any("error", err))
+	}
+	anomalies, maintenanceAnomalies := annotateMaintenance(windows, service, anomalies)
+	p.runShadow(ctx, req, service, signals, anomalies, windows)
 
-	anchors := p.buildAnchors(service, metricAnomalies, logAnomalies, traceAnomalies)
-	timeline := p.buildTimeline(metricAnomalies, logAnomalies, traceAnomalies)
+	anchors := p.buildAnchors(service, anomalies, preset.MaxAnchors)
+	for i := range anchors {
+		anchors[i].Environment = req.Environment
+	}
+	attachEvidence(anchors, signals)
+	timeline := p.buildTimeline(sustainedRuns(anomalies, signals), preset.MaxTimeline)
+	onset := anomalyOnset(timeline)
 
-	confidence := p.computeConfidence(metricAnomalies, logAnomalies, traceAnomalies)
+	confidence := p.computeConfidence(anomalies)
 	rootCause := deriveRootCause(service, anchors)
 
 	causalityScore := 0.0
 	var causalityResult CausalityResult
 	if p.causalityEngine != nil {
-		causalityResult = p.causalityEngine.Evaluate(service, timeline, signals.ServiceGraph)
+		_, causality := startStage(ctx, "rca.causality", metrics.StageCausality)
+		causalityResult = p.causalityEngine.EvaluateDepth(service, timeline, signals.ServiceGraph, preset.CausalityDepth)
+		causality.span.SetAttributes(attribute.String("rca.suggested_service", causalityResult.SuggestedService))
+		causality.End(nil)
 		causalityScore = causalityResult.Score
 		if len(causalityResult.Notes) > 0 {
 			for _, note := range causalityResult.Notes {
@@ -172,7 +416,21 @@ func (p *Pipeline) Analyze(ctx context.Context, req models.InvestigationRequest,
 		}
 	}
 
-	recommendations := p.fetchRecommendations(ctx, req, anchors, timeline)
+	explanation := &models.Explanation{
+		Service:   service,
+		Threshold: req.AnomalyThreshold,
+		Preset:    req.Preset,
+		Detectors: detectors,
+		Causality: models.CausalityExplanation{
+			Score:            causalityResult.Score,
+			SuggestedService: causalityResult.SuggestedService,
+			Notes:            causalityResult.Notes,
+		},
+		DetectionConfidence:  confidence,
+		MissingSources:       len(signals.Unavailable),
+		MaintenanceAnomalies: maintenanceAnomalies,
+	}
+	recommendations := p.fetchRecommendations(ctx, req, anchors, timeline, explanation)
 	affected := uniqueStrings(append([]string{service}, req.AffectedServices...))
 	affected = uniqueStrings(append(affected, neighborServices(signals.ServiceGraph, service)...))
 
@@ -190,19 +448,59 @@ func (p *Pipeline) Analyze(ctx context.Context, req models.InvestigationRequest,
 		timeline = append(timeline, suggestedEvent)
 	}
 
+	if drift := p.topologyDrift(ctx, req, affected, onset); len(drift) > 0 {
+		timeline = append(timeline, drift...)
+		sort.SliceStable(timeline, func(i, j int) bool { return timeline[i].Time.Before(timeline[j].Time) })
+	}
 	timeline = p.appendTopologyEvents(timeline, service, signals.ServiceGraph)
 
+	suspectedRoot := service
+	if causalityResult.SuggestedService != "" {
+		suspectedRoot = causalityResult.SuggestedService
+	}
+	impacts := p.blastRadius.Estimate(suspectedRoot, signals.ServiceGraph)
+	capacity := p.analyzeCapacity(ctx, req, suspectedRoot, timeline)
+	if event, ok := saturationEvent(capacity); ok {
+		timeline = append(timeline, event)
+	}
+	recommendations = append(capacityRecommendations(capacity), recommendations...)
+	sloImpacts, impactScore := p.scoreImpact(ctx, req, service, signals)
+	p.links.attach(req.TenantID, anchors, timeline)
+
 	result := models.CorrelationResult{
-		CorrelationID:    fmt.Sprintf("corr-%d", time.Now().UnixNano()),
-		IncidentID:       req.IncidentID,
-		RootCause:        rootCause,
-		Confidence:       calibrateConfidence(confidence, causalityScore),
-		AffectedServices: affected,
-		Recommendations:  recommendations,
-		RedAnchors:       anchors,
-		Timeline:         timeline,
-		CreatedAt:        time.Now().UTC(),
+		CorrelationID:      fmt.Sprintf("corr-%d", time.Now().UnixNano()),
+		Status:             models.CorrelationOpen,
+		IncidentID:         req.IncidentID,
+		RootCause:          rootCause,
+		Confidence:         degradeConfidence(calibrateConfidence(confidence, causalityScore), len(signals.Unavailable)),
+		AffectedServices:   affected,
+		Recommendations:    recommendations,
+		RedAnchors:         anchors,
+		Timeline:           timeline,
+		BlastRadius:        impacts,
+		CreatedAt:          time.Now().UTC(),
+		UnavailableSources: append([]models.DataType(nil), signals.Unavailable...),
+		Labels:             models.CopyLabels(req.Labels),
+		Incident: models.IncidentMetadata{
+			Title:             req.Incident.Title,
+			Description:       req.Incident.Description,
+			AlertFingerprints: append([]string(nil), req.Incident.AlertFingerprints...),
+			TicketURL:         req.Incident.TicketURL,
+		},
+		Explanation: explanation,
+		Environment: req.Environment,
+		Capacity:    capacity,
+		ImpactScore: impactScore,
+		SLOImpacts:  sloImpacts,
+	}
+	upstream := causalityResult.SuggestedService != "" && !strings.EqualFold(causalityResult.SuggestedService, service)
+	result.Category = p.classifier.WithOverrides(p.rulesEngine.CategoryRules()).Classify(result, signals, upstream)
+	summary, err := p.summarizer.Summarize(req, result)
+	if err != nil {
+		p.logger.Warn("failed to summarise correlation", slog.String("tenant_id", req.TenantID), slog.Any("error", err))
 	}
+	result.Summary = summary
+	p.narrate(ctx, req, &result)
 
 	return result, nil
 }
@@ -212,44 +510,102 @@ func (p *Pipeline) PersistResult(ctx context.Context, tenantID string, result mo
 	if p.weaviate == nil {
 		return
 	}
-	if err := p.weaviate.StoreCorrelation(ctx, tenantID, result); err != nil {
+	ctx, persistence := startStage(ctx, "rca.persist", metrics.StagePersistence)
+	err := p.weaviate.StoreCorrelation(ctx, tenantID, result)
+	persistence.End(err)
+	if err != nil {
 		p.logger.Warn("failed to persist correlation", slog.Any("error", err))
 	}
 }
 
-func (p *Pipeline) buildAnchors(service string, metricAnoms []extractors.MetricAnomaly, logAnoms []extractors.LogAnomaly, traceAnoms []extractors.TraceAnomaly) []models.RedAnchor {
-	anchors := make([]models.RedAnchor, 0, len(metricAnoms)+len(logAnoms)+len(traceAnoms))
+// notify dispatches the result without holding up the caller; delivery outlives the request context.
+func (p *Pipeline) notify(ctx context.Context, tenantID string, result models.CorrelationResult) {
+	ctx = context.WithoutCancel(ctx)
+	for _, notifier := range p.notifiers {
+		go func(notifier Notifier) {
+			if err := notifier.Notify(ctx, tenantID, result); err != nil {
+				p.logger.Warn("failed to deliver correlation notification", slog.String("correlation_id", result.CorrelationID), slog.Any("error", err))
+			}
+		}(notifier)
+	}
+}
+
+// detect runs the detectors of the request's preset, or the tenant's, and summarises what each of them found.
+func (p *Pipeline) detect(ctx context.Context, req models.InvestigationRequest, service string, signals Signals) ([]extractors.Anomaly, []models.DetectorRun) {
+	enabled := p.detectors(req)
+	anomalies := runExtractors(ctx, enabled, detectorInput(req, service, signals))
+	return anomalies, detectorRuns(enabled, anomalies)
+}
 
-	for _, m := range metricAnoms {
-		anchors = append(anchors, models.RedAnchor{
-			Service:      service,
-			Selector:     "metrics:cpu_usage",
-			DataType:     models.DataTypeMetrics,
-			Timestamp:    m.Timestamp,
-			AnomalyScore: m.Score,
-			Threshold:    m.Threshold,
-		})
+// detectors returns the extractors the request's preset selects, falling back to the tenant's enabled ones.
+func (p *Pipeline) detectors(req models.InvestigationRequest) []extractors.Extractor {
+	preset, err := p.preset(req.Preset)
+	if err != nil || len(preset.Extractors) == 0 {
+		return p.extractors.ForTenant(req.TenantID)
 	}
+	selected, err := p.extractors.Select(preset.Extractors)
+	if err != nil {
+		p.logger.Warn("preset selects unavailable extractors; using the tenant's", slog.String("preset", req.Preset), slog.Any("error", err))
+		return p.extractors.ForTenant(req.TenantID)
+	}
+	return selected
+}
 
-	for _, l := range logAnoms {
-		anchors = append(anchors, models.RedAnchor{
-			Service:      service,
-			Selector:     fmt.Sprintf("logs:%s", l.Severity),
-			DataType:     models.DataTypeLogs,
-			Timestamp:    l.Timestamp,
-			AnomalyScore: l.Score,
-			Threshold:    3,
-		})
+// detectorRuns reports, for each enabled detector in order, how many anomalies it found, the highest
+// threshold they were held to, and the top score.
+func detectorRuns(enabled []extractors.Extractor, anomalies []extractors.Anomaly) []models.DetectorRun {
+	runs := make([]models.DetectorRun, 0, len(enabled))
+	for _, extractor := range enabled {
+		run := models.DetectorRun{Name: extractor.Name()}
+		for _, anomaly := range anomalies {
+			if anomaly.Detector != run.Name {
+				continue
+			}
+			run.Anomalies++
+			run.Threshold = max(run.Threshold, anomaly.Threshold)
+			run.TopScore = max(run.TopScore, anomaly.Score)
+		}
+		runs = append(runs, run)
+	}
+	return runs
+}
+
+func detectorInput(req models.InvestigationRequest, service string, signals Signals) extractors.Input {
+	return extractors.Input{
+		TenantID:  req.TenantID,
+		Service:   service,
+		Threshold: req.AnomalyThreshold,
+		Metrics:   signals.Metrics,
+		Baseline:  signals.Baseline,
+		Logs:      signals.Logs,
+		Traces:    signals.Traces,
+	}
+}
+
+func runExtractors(ctx context.Context, enabled []extractors.Extractor, input extractors.Input) []extractors.Anomaly {
+	var anomalies []extractors.Anomaly
+	for _, extractor := range enabled {
+		extractCtx, extractSpan := tracing.Start(ctx, "rca.extract", attribute.String("rca.extractor", extractor.Name()))
+		found := extractor.Extract(extractCtx, input)
+		extractSpan.SetAttributes(attribute.Int("rca.anomalies", len(found)))
+		extractSpan.End()
+		anomalies = append(anomalies, found...)
 	}
+	return anomalies
+}
 
-	for _, t := range traceAnoms {
+func (p *Pipeline) buildAnchors(service string, anomalies []extractors.Anomaly, limit int) []models.RedAnchor {
+	anchors := make([]models.RedAnchor, 0, len(anomalies))
+
+	for _, a := range anomalies {
 		anchors = append(anchors, models.RedAnchor{
-			Service:      t.Span.Service,
-			Selector:     fmt.Sprintf("trace:%s", t.Span.Operation),
-			DataType:     models.DataTypeTraces,
-			Timestamp:    t.Span.Timestamp,
-			AnomalyScore: t.Score,
-			Threshold:    2,
+			Service:      firstNonEmpty(a.Service, service),
+			Selector:     a.Selector,
+			DataType:     a.DataType,
+			Timestamp:    a.Timestamp,
+			AnomalyScore: a.Score,
+			Threshold:    a.Threshold,
+			Direction:    a.Direction,
 		})
 	}
 
@@ -257,50 +613,32 @@ func (p *Pipeline) buildAnchors(service string, metricAnoms []extractors.MetricA
 		return anchors[i].AnomalyScore > anchors[j].AnomalyScore
 	})
 
-	if len(anchors) > 5 {
-		anchors = anchors[:5]
+	if len(anchors) > limit {
+		anchors = anchors[:limit]
 	}
 
 	return anchors
 }
 
-func (p *Pipeline) buildTimeline(metricAnoms []extractors.MetricAnomaly, logAnoms []extractors.LogAnomaly, traceAnoms []extractors.TraceAnomaly) []models.TimelineEvent {
-	timeline := make([]models.TimelineEvent, 0, len(metricAnoms)+len(logAnoms)+len(traceAnoms))
-
-	for _, m := range metricAnoms {
-		timeline = append(timeline, models.TimelineEvent{
-			Time:         m.Timestamp,
-			Event:        "Metric anomaly detected",
-			Service:      "",
-			Severity:     severityFromScore(m.Score),
-			AnomalyScore: m.Score,
-			DataSource:   models.DataTypeMetrics,
-		})
-	}
-
-	for _, l := range logAnoms {
-		timeline = append(timeline, models.TimelineEvent{
-			Time:         l.Timestamp,
-			Event:        fmt.Sprintf("Log spike (%s)", l.Severity),
-			Service:      "",
-			Severity:     severityFromScore(l.Score),
-			AnomalyScore: l.Score,
-			DataSource:   models.DataTypeLogs,
-		})
-	}
+func (p *Pipeline) buildTimeline(runs []timelineRun, limit int) []models.TimelineEvent {
+	timeline := make([]models.TimelineEvent, 0, len(runs))
 
-	for _, t := range traceAnoms {
-		severity := severityFromScore(t.Score)
-		if t.Span.Status == "error" {
-			severity = models.SeverityHigh
+	for _, a := range runs {
+		severity := severityFromScore(a.Score)
+		if a.Severity != "" {
+			severity = a.Severity
 		}
 		timeline = append(timeline, models.TimelineEvent{
-			Time:         t.Span.Timestamp,
-			Event:        fmt.Sprintf("Slow span: %s", t.Span.Operation),
-			Service:      t.Span.Service,
+			Time:         a.Timestamp,
+			Event:        a.Event,
+			Service:      a.Service,
 			Severity:     severity,
-			AnomalyScore: t.Score,
-			DataSource:   models.DataTypeTraces,
+			AnomalyScore: a.Score,
+			DataSource:   a.DataType,
+			Start:        a.start,
+			End:          a.end,
+			Ongoing:      a.ongoing,
+			Direction:    a.Direction,
 		})
 	}
 
@@ -308,24 +646,31 @@ func (p *Pipeline) buildTimeline(metricAnoms []extractors.MetricAnomaly, logAnom
 		return timeline[i].Time.Before(timeline[j].Time)
 	})
 
-	if len(timeline) > 10 {
-		timeline = timeline[:10]
+	if len(timeline) > limit {
+		timeline = timeline[:limit]
 	}
 
 	return timeline
 }
 
-func (p *Pipeline) computeConfidence(metricAnoms []extractors.MetricAnomaly, logAnoms []extractors.LogAnomaly, traceAnoms []extractors.TraceAnomaly) float64 {
+func (p *Pipeline) computeConfidence(anomalies []extractors.Anomaly) float64 {
+	maxScores := make(map[models.DataType]float64)
+	for _, a := range anomalies {
+		if current, ok := maxScores[a.DataType]; !ok || a.Score > current {
+			maxScores[a.DataType] = a.Score
+		}
+	}
+
 	confidence := 0.0
 
-	if len(metricAnoms) > 0 {
-		confidence += 0.25 + clamp(maxMetricScore(metricAnoms)/8.0, 0, 0.25)
+	if score, ok := maxScores[models.DataTypeMetrics]; ok {
+		confidence += 0.25 + clamp(score/8.0, 0, 0.25)
 	}
-	if len(logAnoms) > 0 {
-		confidence += 0.25 + clamp(maxLogScore(logAnoms)/6.0, 0, 0.2)
+	if score, ok := maxScores[models.DataTypeLogs]; ok {
+		confidence += 0.25 + clamp(score/6.0, 0, 0.2)
 	}
-	if len(traceAnoms) > 0 {
-		confidence += 0.25 + clamp(maxTraceScore(traceAnoms)/6.0, 0, 0.2)
+	if score, ok := maxScores[models.DataTypeTraces]; ok {
+		confidence += 0.25 + clamp(score/6.0, 0, 0.2)
 	}
 
 	if confidence > 1 {
@@ -344,37 +689,54 @@ func clamp(value, min, max float64) float64 {
 	return value
 }
 
-func (p *Pipeline) fetchRecommendations(ctx context.Context, req models.InvestigationRequest, anchors []models.RedAnchor, timeline []models.TimelineEvent) []string {
-	if p.weaviate == nil {
-		return p.recommendFromRules(req, anchors, timeline)
+// fetchRecommendations reuses the advice of the most similar past incident, falling back to the rules and
+// then to generic advice, and records in explanation which source it used.
+func (p *Pipeline) fetchRecommendations(ctx context.Context, req models.InvestigationRequest, anchors []models.RedAnchor, timeline []models.TimelineEvent, explanation *models.Explanation) []models.Recommendation {
+	ctx, recommendations := startStage(ctx, "rca.recommend", metrics.StageRecommendations)
+	defer recommendations.End(nil)
+	if p.weaviate == nil || !p.features.Enabled(features.PatternMatching, req.TenantID) {
+		return p.recommendFromRules(req, anchors, timeline, explanation)
 	}
 
 	results, err := p.weaviate.SimilarIncidents(ctx, req.TenantID, req.Symptoms, 3)
 	if err != nil || len(results) == 0 {
-		return p.recommendFromRules(req, anchors, timeline)
+		return p.recommendFromRules(req, anchors, timeline, explanation)
+	}
+	for _, similar := range results {
+		explanation.SimilarCorrelations = append(explanation.SimilarCorrelations, similar.CorrelationID)
 	}
 
 	recs := results[0].Recommendations
 	if len(recs) == 0 {
-		return p.recommendFromRules(req, anchors, timeline)
+		return p.recommendFromRules(req, anchors, timeline, explanation)
 	}
 
+	explanation.RecommendationSource = models.RecommendationsFromHistory
 	return recs
 }
 
-func (p *Pipeline) recommendFromRules(req models.InvestigationRequest, anchors []models.RedAnchor, timeline []models.TimelineEvent) []string {
-	if p.rulesEngine != nil {
-		if recs := p.rulesEngine.Recommend(req, anchors, timeline); len(recs) > 0 {
-			return recs
+func (p *Pipeline) recommendFromRules(req models.InvestigationRequest, anchors []models.RedAnchor, timeline []models.TimelineEvent, explanation *models.Explanation) []models.Recommendation {
+	var recs []models.Recommendation
+	for _, evaluation := range p.rulesEngine.Evaluate(req, anchors, timeline) {
+		// A matching rule skipped for its group, the recommendation limit, or duplicates contributed nothing
+		// and is left out of the audit trail.
+		if evaluation.Matched && len(evaluation.Recommendations) > 0 {
+			explanation.MatchedRules = append(explanation.MatchedRules, models.MatchedRule{RuleID: evaluation.RuleID, Reasons: evaluation.Reasons})
 		}
+		recs = append(recs, evaluation.Recommendations...)
+	}
+	if len(recs) > 0 {
+		explanation.RecommendationSource = models.RecommendationsFromRules
+		return recs
 	}
+	explanation.RecommendationSource = models.RecommendationsDefault
 	return defaultRecommendations()
 }
 
-func defaultRecommendations() []string {
-	return []string{
-		"Review recent deployments for regressions",
-		"Check upstream dependencies for correlated errors",
+func defaultRecommendations() []models.Recommendation {
+	return []models.Recommendation{
+		{Text: "Review recent deployments for regressions"},
+		{Text: "Check upstream dependencies for correlated errors"},
 	}
 }
 
@@ -407,36 +769,6 @@ func firstNonEmpty(values ...string) string {
 	return ""
 }
 
-func maxMetricScore(anoms []extractors.MetricAnomaly) float64 {
-	max := 0.0
-	for _, a := range anoms {
-		if a.Score > max {
-			max = a.Score
-		}
-	}
-	return max
-}
-
-func maxLogScore(anoms []extractors.LogAnomaly) float64 {
-	max := 0.0
-	for _, a := range anoms {
-		if a.Score > max {
-			max = a.Score
-		}
-	}
-	return max
-}
-
-func maxTraceScore(anoms []extractors.TraceAnomaly) float64 {
-	max := 0.0
-	for _, a := range anoms {
-		if a.Score > max {
-			max = a.Score
-		}
-	}
-	return max
-}
-
 func neighborServices(edges []repo.ServiceGraphEdge, service string) []string {
 	set := make(map[string]struct{})
 	for _, edge := range edges {
@@ -522,6 +854,11 @@ func uniqueStrings(values []string) []string {
 	return result
 }
 
+// degradeConfidence discounts confidence by a quarter for each signal source missing from the analysis.
+func degradeConfidence(confidence float64, missing int) float64 {
+	return clamp(confidence*(1-0.25*float64(missing)), 0, 1)
+}
+
 func calibrateConfidence(base, causality float64) float64 {
 	base = clamp(base, 0, 1)
 	if causality <= 0 {
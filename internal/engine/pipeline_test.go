@@ -1,21 +1,37 @@
 package engine
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"os"
+	"path/filepath"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/miradorstack/mirador-rca/internal/audit"
+	"github.com/miradorstack/mirador-rca/internal/cache"
 	"github.com/miradorstack/mirador-rca/internal/extractors"
+	"github.com/miradorstack/mirador-rca/internal/features"
 	"github.com/miradorstack/mirador-rca/internal/models"
 	"github.com/miradorstack/mirador-rca/internal/repo"
 )
 
 type fakeCoreClient struct {
-	metrics []repo.MetricPoint
-	logs    []repo.LogEntry
-	traces  []repo.TraceSpan
-	graph   []repo.ServiceGraphEdge
+	metrics   []repo.MetricPoint
+	logs      []repo.LogEntry
+	traces    []repo.TraceSpan
+	graph     []repo.ServiceGraphEdge
+	logsErr   error
+	tracesErr error
+	logsDelay time.Duration
 }
 
 func (f *fakeCoreClient) FetchMetricSeries(ctx context.Context, tenantID, service string, start, end time.Time) ([]repo.MetricPoint, error) {
@@ -23,11 +39,18 @@ func (f *fakeCoreClient) FetchMetricSeries(ctx context.Context, tenantID, servic
 }
 
 func (f *fakeCoreClient) FetchLogEntries(ctx context.Context, tenantID, service string, start, end time.Time) ([]repo.LogEntry, error) {
-	return f.logs, nil
+	if f.logsDelay > 0 {
+		select {
+		case <-time.After(f.logsDelay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return f.logs, f.logsErr
 }
 
 func (f *fakeCoreClient) FetchTraceSpans(ctx context.Context, tenantID, service string, start, end time.Time) ([]repo.TraceSpan, error) {
-	return f.traces, nil
+	return f.traces, f.tracesErr
 }
 
 func (f *fakeCoreClient) FetchServiceGraph(ctx context.Context, tenantID string, start, end time.Time) ([]repo.ServiceGraphEdge, error) {
@@ -41,7 +64,7 @@ type fakeWeaviate struct {
 func (f *fakeWeaviate) SimilarIncidents(ctx context.Context, tenantID string, symptoms []string, limit int) ([]models.CorrelationResult, error) {
 	return []models.CorrelationResult{
 		{
-			Recommendations: []string{"Check caching layer", "Verify deployment"},
+			Recommendations: []models.Recommendation{{Text: "Check caching layer"}, {Text: "Verify deployment"}},
 		},
 	}, nil
 }
@@ -89,6 +112,10 @@ func TestPipelineInvestigate(t *testing.T) {
 	}
 
 	fakeWeaviateClient := &fakeWeaviate{}
+	summarizer, err := NewSummarizer("", nil, nil)
+	if err != nil {
+		t.Fatalf("new summarizer: %v", err)
+	}
 
 	pipeline := NewPipeline(
 		nil,
@@ -106,9 +133,8 @@ func TestPipelineInvestigate(t *testing.T) {
 		fakeWeaviateClient,
 		nil,
 		NewCausalityEngine(nil),
-		extractors.NewMetricExtractor(),
-		extractors.NewLogsExtractor(),
-		extractors.NewTracesExtractor(),
+		extractors.NewDefaultRegistry(),
+		WithSummarizer(summarizer),
 	)
 
 	req := models.InvestigationRequest{
@@ -120,6 +146,8 @@ func TestPipelineInvestigate(t *testing.T) {
 		},
 		AffectedServices: []string{"checkout"},
 		TenantID:         "tenant-a",
+		Labels:           map[string]string{"team": "payments"},
+		Incident:         models.IncidentMetadata{Title: "Checkout errors", TicketURL: "https://tickets.example.com/INC-1"},
 	}
 
 	result, err := pipeline.Investigate(context.Background(), req)
@@ -150,6 +178,23 @@ func TestPipelineInvestigate(t *testing.T) {
 	if !strings.Contains(result.RootCause, "payments") {
 		t.Fatalf("expected causality-adjusted root cause, got %s", result.RootCause)
 	}
+	if result.Status != models.CorrelationOpen || result.Labels["team"] != "payments" || result.Incident.Title != "Checkout errors" {
+		t.Fatalf("expected request metadata on result, got %q %v %+v", result.Status, result.Labels, result.Incident)
+	}
+	if !strings.HasPrefix(result.Summary, "Checkout errors was most likely caused by "+result.RootCause) {
+		t.Fatalf("expected a narrative summary, got %q", result.Summary)
+	}
+	explanation := result.Explanation
+	if explanation == nil || explanation.Service != "checkout" || len(explanation.Detectors) == 0 {
+		t.Fatalf("expected an explanation of the detectors, got %+v", explanation)
+	}
+	fired := 0
+	for _, run := range explanation.Detectors {
+		fired += run.Anomalies
+	}
+	if fired == 0 || explanation.Causality.SuggestedService != "payments" || explanation.RecommendationSource == "" {
+		t.Fatalf("expected detectors, causality, and recommendation source to be explained, got %+v", explanation)
+	}
 }
 
 func TestPipelineRulesFallback(t *testing.T) {
@@ -160,13 +205,18 @@ func TestPipelineRulesFallback(t *testing.T) {
 		nil,
 		&RuleEngine{rules: []Rule{{
 			ID:              "rule1",
+			Priority:        10,
+			Group:           "checkout",
 			Match:           RuleMatch{Service: "checkout"},
 			Recommendations: []string{"Rule Rec"},
+		}, {
+			ID:              "rule2",
+			Group:           "checkout",
+			Match:           RuleMatch{Service: "checkout"},
+			Recommendations: []string{"Shadowed Rec"},
 		}}},
 		NewCausalityEngine(nil),
-		extractors.NewMetricExtractor(),
-		extractors.NewLogsExtractor(),
-		extractors.NewTracesExtractor(),
+		extractors.NewDefaultRegistry(),
 	)
 
 	req := models.InvestigationRequest{
@@ -179,9 +229,100 @@ func TestPipelineRulesFallback(t *testing.T) {
 	if err != nil {
 		t.Fatalf("investigate: %v", err)
 	}
-	if len(result.Recommendations) == 0 || result.Recommendations[0] != "Rule Rec" {
+	if len(result.Recommendations) == 0 || result.Recommendations[0].Text != "Rule Rec" {
 		t.Fatalf("expected rule-based recommendation")
 	}
+	if explanation := result.Explanation; explanation.RecommendationSource != models.RecommendationsFromRules ||
+		len(explanation.MatchedRules) != 1 || explanation.MatchedRules[0].RuleID != "rule1" || len(explanation.MatchedRules[0].Reasons) == 0 {
+		t.Fatalf("expected only the contributing rule in the explanation, got %+v", explanation)
+	}
+}
+
+func TestPipelineFeatureFlags(t *testing.T) {
+	now := time.Now()
+	flags, err := features.NewSet(map[string]features.Rule{
+		features.ParallelFetch:   {Enabled: true},
+		features.PatternMatching: {Enabled: true, Tenants: []string{"acme"}},
+	})
+	if err != nil {
+		t.Fatalf("new feature set: %v", err)
+	}
+	pipeline := NewPipeline(
+		nil,
+		&fakeCoreClient{metrics: []repo.MetricPoint{{Timestamp: now, Value: 3}}, tracesErr: errors.New("traces down")},
+		&fakeWeaviate{},
+		&RuleEngine{rules: []Rule{{ID: "rule1", Match: RuleMatch{Service: "checkout"}, Recommendations: []string{"Rule Rec"}}}},
+		nil,
+		extractors.NewDefaultRegistry(),
+		WithFeatures(flags),
+	)
+
+	for tenant, want := range map[string]string{"acme": "Check caching layer", "globex": "Rule Rec"} {
+		req := models.InvestigationRequest{
+			TenantID:         tenant,
+			AffectedServices: []string{"checkout"},
+			TimeRange:        models.TimeRange{Start: now, End: now.Add(time.Minute)},
+		}
+		result, err := pipeline.Investigate(context.Background(), req)
+		if err != nil {
+			t.Fatalf("investigate %s: %v", tenant, err)
+		}
+		if len(result.Recommendations) == 0 || result.Recommendations[0].Text != want {
+			t.Fatalf("tenant %s: expected %q first, got %+v", tenant, want, result.Recommendations)
+		}
+		if len(result.UnavailableSources) != 1 || result.UnavailableSources[0] != models.DataTypeTraces {
+			t.Fatalf("tenant %s: expected traces to be reported unavailable, got %v", tenant, result.UnavailableSources)
+		}
+	}
+}
+
+type recordingSink struct {
+	records []audit.Record
+}
+
+func (r *recordingSink) Write(_ context.Context, records []audit.Record) error {
+	r.records = append(r.records, records...)
+	return nil
+}
+
+func (r *recordingSink) Close() error { return nil }
+
+func TestPipelineAuditsInvestigations(t *testing.T) {
+	now := time.Now()
+	sink := &recordingSink{}
+	auditor := audit.NewLogger(nil, sink, 0)
+	pipeline := NewPipeline(
+		nil,
+		&fakeCoreClient{metrics: []repo.MetricPoint{{Timestamp: now, Value: 3}}, tracesErr: errors.New("traces down")},
+		&fakeWeaviate{},
+		nil,
+		nil,
+		extractors.NewDefaultRegistry(),
+		WithAuditor(auditor),
+	)
+	req := models.InvestigationRequest{
+		TenantID:         "acme",
+		AffectedServices: []string{"checkout"},
+		TimeRange:        models.TimeRange{Start: now, End: now.Add(time.Minute)},
+	}
+	result, err := pipeline.Investigate(audit.WithActor(context.Background(), "watch"), req)
+	if err != nil {
+		t.Fatalf("investigate: %v", err)
+	}
+	if err := auditor.Close(context.Background()); err != nil {
+		t.Fatalf("close auditor: %v", err)
+	}
+
+	if len(sink.records) != 1 {
+		t.Fatalf("expected one audit record, got %+v", sink.records)
+	}
+	rec := sink.records[0]
+	if rec.Action != audit.ActionInvestigate || rec.Actor != "watch" || rec.TenantID != "acme" || rec.ResultID != result.CorrelationID || rec.Outcome != audit.OutcomeSuccess {
+		t.Fatalf("unexpected audit record: %+v", rec)
+	}
+	if rec.RequestHash != audit.Hash(req) || strings.Join(rec.Sources, ",") != "metrics,logs" {
+		t.Fatalf("expected the request hash and the usable sources, got %+v", rec)
+	}
 }
 
 func sortIsChronological(events []models.TimelineEvent) bool {
@@ -225,9 +366,7 @@ func TestPipelineLatencyWithinTarget(t *testing.T) {
 		&fakeWeaviate{},
 		nil,
 		NewCausalityEngine(nil),
-		extractors.NewMetricExtractor(),
-		extractors.NewLogsExtractor(),
-		extractors.NewTracesExtractor(),
+		extractors.NewDefaultRegistry(),
 	)
 
 	req := models.InvestigationRequest{
@@ -251,3 +390,1330 @@ func TestPipelineLatencyWithinTarget(t *testing.T) {
 		t.Fatalf("p95 latency exceeds target: %v", p95Estimate)
 	}
 }
+
+func TestAttachEvidence(t *testing.T) {
+	now := time.Now()
+	metrics := make([]repo.MetricPoint, 0, 10)
+	for i := 0; i < 10; i++ {
+		metrics = append(metrics, repo.MetricPoint{Name: "latency_p95", Timestamp: now.Add(time.Duration(i) * time.Minute), Value: float64(i)})
+	}
+	signals := Signals{
+		Metrics: metrics,
+		Logs: []repo.LogEntry{
+			{Timestamp: now.Add(4 * time.Minute), Severity: "error", Message: "payments call failed: status=503"},
+			{Timestamp: now.Add(4 * time.Minute), Severity: "info", Message: "request served"},
+		},
+		Traces: []repo.TraceSpan{
+			{TraceID: "trace-1", SpanID: "span-1", Service: "checkout", Operation: "HTTP POST", Duration: time.Second, Timestamp: now.Add(5 * time.Minute)},
+			{TraceID: "trace-2", Service: "checkout", Operation: "HTTP GET", Timestamp: now.Add(5 * time.Minute)},
+			{TraceID: "trace-3", SpanID: "span-3", Service: "checkout", Operation: "HTTP POST", Duration: 2 * time.Second, Timestamp: now.Add(9 * time.Minute)},
+			{TraceID: "trace-4", SpanID: "span-4", Service: "checkout", Operation: "HTTP POST", Duration: 50 * time.Millisecond, Status: "error", Timestamp: now.Add(8 * time.Minute)},
+		},
+	}
+	anchors := []models.RedAnchor{
+		{Service: "checkout", Selector: "metrics:latency_p95", DataType: models.DataTypeMetrics, Timestamp: now.Add(5 * time.Minute)},
+		{Service: "checkout", Selector: "logs:error:http_503", DataType: models.DataTypeLogs, Timestamp: now.Add(5 * time.Minute)},
+		{Service: "checkout", Selector: "trace:HTTP POST", DataType: models.DataTypeTraces, Timestamp: now.Add(5 * time.Minute)},
+	}
+
+	attachEvidence(anchors, signals)
+
+	if got := anchors[0].Evidence.MetricValues; len(got) != 7 || got[3].Value != 5 {
+		t.Fatalf("expected 7 samples centred on the spike, got %+v", got)
+	}
+	if got := anchors[1].Evidence.LogLines; len(got) != 1 || got[0] != "payments call failed: status=503" {
+		t.Fatalf("unexpected log evidence: %v", got)
+	}
+	if got := anchors[2].Evidence.TraceIDs; len(got) != 3 || got[0] != "trace-1" {
+		t.Fatalf("unexpected trace evidence: %v", got)
+	}
+	exemplars := anchors[2].Evidence.Exemplars
+	if len(exemplars) != 3 || exemplars[0].SpanID != "span-4" || exemplars[1].SpanID != "span-3" || exemplars[2].SpanID != "span-1" {
+		t.Fatalf("expected the failing span, then the slowest, got %+v", exemplars)
+	}
+}
+
+func TestLinkBuilderRendersTemplates(t *testing.T) {
+	at := time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+	links := NewLinkBuilder(
+		"https://grafana/d/x?var-service={service}&var-selector={selector}&from={from}&to={to}",
+		"https://mirador/{tenant}/{service}?from={from_rfc3339}",
+		"https://jaeger/trace/{trace_id}?uiFind={span_id}",
+		time.Minute,
+	)
+
+	anchors := []models.RedAnchor{
+		{Service: "checkout", Selector: "metrics:latency_p95", Timestamp: at},
+		{Service: "checkout", Selector: "trace:HTTP POST", Timestamp: at, Evidence: models.Evidence{
+			Exemplars: []models.TraceExemplar{{TraceID: "abc123", SpanID: "def456", Timestamp: at}},
+		}},
+	}
+	timeline := []models.TimelineEvent{{Service: "checkout", Time: at, DataSource: models.DataTypeLogs}}
+	links.attach("tenant-a", anchors, timeline)
+
+	wantAnchor := "https://grafana/d/x?var-service=checkout&var-selector=metrics%3Alatency_p95&from=1704207540000&to=1704207660000"
+	if anchors[0].Link != wantAnchor {
+		t.Fatalf("unexpected anchor link %q", anchors[0].Link)
+	}
+	if got := anchors[1].Evidence.Exemplars[0].Link; got != "https://jaeger/trace/abc123?uiFind=def456" {
+		t.Fatalf("unexpected trace link %q", got)
+	}
+	if timeline[0].Link != "https://mirador/tenant-a/checkout?from=2024-01-02T14%3A59%3A00Z" {
+		t.Fatalf("unexpected event link %q", timeline[0].Link)
+	}
+}
+
+func TestSummarizerRendersPerTenantAndLocale(t *testing.T) {
+	at := time.Date(2026, 3, 1, 9, 12, 0, 0, time.UTC)
+	result := models.CorrelationResult{
+		IncidentID:       "INC-7",
+		RootCause:        "payments: upstream influence on checkout",
+		Confidence:       0.738,
+		Category:         models.CategoryDependency,
+		AffectedServices: []string{"checkout", "payments", "inventory"},
+		Recommendations:  []models.Recommendation{{Text: "roll back payments"}},
+		Timeline: []models.TimelineEvent{
+			{Time: at.Add(time.Minute), Service: "checkout", Event: "Error burst"},
+			{Time: at, Service: "payments", Event: "Slow span: POST /charge"},
+		},
+		Incident: models.IncidentMetadata{Title: "Checkout slow"},
+	}
+
+	summarizer, err := NewSummarizer("en",
+		map[string]string{"de": `{{.Correlation.RootCause}} ({{.ConfidencePercent}}%), betroffen: {{list .Correlation.AffectedServices "und"}}`},
+		map[string]SummaryTenant{
+			"acme":   {Locale: "de_AT"},
+			"globex": {Template: "{{.TenantID}}/{{.Locale}}: {{humanize .Correlation.Category}}"},
+		})
+	if err != nil {
+		t.Fatalf("new summarizer: %v", err)
+	}
+
+	cases := []struct {
+		tenant string
+		labels map[string]string
+		want   string
+	}{
+		{
+			tenant: "initech",
+			want: "Checkout slow was most likely caused by payments: upstream influence on checkout, a dependency failure problem, with 74% confidence." +
+				" It began at 09:12 UTC, when payments reported Slow span: POST /charge. Affected services: checkout, payments, and inventory." +
+				" Suggested first step: Roll back payments.",
+		},
+		{tenant: "acme", want: "payments: upstream influence on checkout (74%), betroffen: checkout, payments, und inventory"},
+		{tenant: "initech", labels: map[string]string{"locale": "DE"}, want: "payments: upstream influence on checkout (74%), betroffen: checkout, payments, und inventory"},
+		{tenant: "acme", labels: map[string]string{"locale": "fr"}, want: "Checkout slow was most likely caused by"},
+		{tenant: "globex", labels: map[string]string{"locale": "fr"}, want: "globex/fr: dependency failure"},
+	}
+	for _, tc := range cases {
+		got, err := summarizer.Summarize(models.InvestigationRequest{TenantID: tc.tenant, Labels: tc.labels}, result)
+		if err != nil {
+			t.Fatalf("%s %v: summarize: %v", tc.tenant, tc.labels, err)
+		}
+		if !strings.HasPrefix(got, tc.want) {
+			t.Fatalf("%s %v: expected %q, got %q", tc.tenant, tc.labels, tc.want, got)
+		}
+	}
+
+	broken, err := NewSummarizer("", map[string]string{"en": "{{.Missing}}"}, nil)
+	if err != nil {
+		t.Fatalf("new summarizer: %v", err)
+	}
+	if _, err := broken.Summarize(models.InvestigationRequest{TenantID: "t1"}, result); err == nil {
+		t.Fatalf("expected a render error for an unknown field")
+	}
+	if _, err := NewSummarizer("", map[string]string{"de": "{{.Correlation"}, nil); err == nil {
+		t.Fatalf("expected a parse error")
+	}
+}
+
+type fakeNarrator struct {
+	narrative models.Narrative
+	err       error
+	locales   []string
+}
+
+func (f *fakeNarrator) Narrate(ctx context.Context, locale string, result models.CorrelationResult) (models.Narrative, error) {
+	f.locales = append(f.locales, locale)
+	return f.narrative, f.err
+}
+
+func TestPipelineNarrator(t *testing.T) {
+	now := time.Now()
+	flags, err := features.NewSet(map[string]features.Rule{
+		features.LLMNarration: {Enabled: true, Tenants: []string{"acme", "initech"}},
+	})
+	if err != nil {
+		t.Fatalf("new feature set: %v", err)
+	}
+	summarizer, err := NewSummarizer("", nil, map[string]SummaryTenant{"acme": {Locale: "de"}})
+	if err != nil {
+		t.Fatalf("new summarizer: %v", err)
+	}
+	newPipeline := func(narrator Narrator) *Pipeline {
+		return NewPipeline(
+			nil,
+			&fakeCoreClient{metrics: []repo.MetricPoint{{Timestamp: now, Value: 3}}},
+			nil,
+			&RuleEngine{rules: []Rule{{ID: "rule1", Match: RuleMatch{Service: "checkout"}, Recommendations: []string{"Rule Rec"}}}},
+			nil,
+			extractors.NewDefaultRegistry(),
+			WithFeatures(flags),
+			WithSummarizer(summarizer),
+			WithNarrator(narrator),
+		)
+	}
+	investigate := func(p *Pipeline, tenant string) models.CorrelationResult {
+		t.Helper()
+		result, err := p.Investigate(context.Background(), models.InvestigationRequest{
+			TenantID:         tenant,
+			AffectedServices: []string{"checkout"},
+			TimeRange:        models.TimeRange{Start: now, End: now.Add(time.Minute)},
+		})
+		if err != nil {
+			t.Fatalf("investigate %s: %v", tenant, err)
+		}
+		return result
+	}
+
+	narrator := &fakeNarrator{narrative: models.Narrative{
+		Summary:         "Checkout failed after a bad deploy.",
+		Recommendations: []string{"rule rec", "Roll back the checkout deploy"},
+	}}
+	pipeline := newPipeline(narrator)
+	result := investigate(pipeline, "acme")
+	if result.Summary != "Checkout failed after a bad deploy." {
+		t.Fatalf("expected the narrated summary, got %q", result.Summary)
+	}
+	if len(result.Recommendations) != 2 || result.Recommendations[0].Generated ||
+		result.Recommendations[1].Text != "Roll back the checkout deploy" || !result.Recommendations[1].Generated {
+		t.Fatalf("expected one generated recommendation after the rule one, got %+v", result.Recommendations)
+	}
+	if len(narrator.locales) != 1 || narrator.locales[0] != "de" {
+		t.Fatalf("expected the tenant locale to be passed, got %v", narrator.locales)
+	}
+
+	result = investigate(pipeline, "globex")
+	if len(narrator.locales) != 1 || strings.HasPrefix(result.Summary, "Checkout failed") {
+		t.Fatalf("expected no narration for a tenant without the flag, got %q", result.Summary)
+	}
+
+	failing := newPipeline(&fakeNarrator{err: context.DeadlineExceeded})
+	result = investigate(failing, "initech")
+	if !strings.Contains(result.Summary, "most likely caused by") || len(result.Recommendations) != 1 {
+		t.Fatalf("expected the template summary and no generated recommendations, got %q %+v", result.Summary, result.Recommendations)
+	}
+}
+
+type fakeThresholds struct {
+	asked []string
+}
+
+func (f *fakeThresholds) Threshold(tenantID, service string) (float64, bool) {
+	f.asked = append(f.asked, tenantID+"/"+service)
+	return 9, service == "checkout"
+}
+
+func TestPipelineTunedThresholds(t *testing.T) {
+	now := time.Now()
+	flags, err := features.NewSet(map[string]features.Rule{
+		features.ThresholdTuning: {Enabled: true, Tenants: []string{"acme"}},
+	})
+	if err != nil {
+		t.Fatalf("new feature set: %v", err)
+	}
+	source := &fakeThresholds{}
+	pipeline := NewPipeline(nil, &fakeCoreClient{metrics: []repo.MetricPoint{{Timestamp: now, Value: 3}}}, nil, nil, nil,
+		extractors.NewDefaultRegistry(), WithFeatures(flags), WithThresholds(source))
+
+	for _, tc := range []struct {
+		tenant    string
+		requested float64
+		want      float64
+	}{
+		{tenant: "acme", want: 9},
+		{tenant: "acme", requested: 1.5, want: 1.5},
+		{tenant: "globex", want: 0},
+	} {
+		result, err := pipeline.Investigate(context.Background(), models.InvestigationRequest{
+			TenantID:         tc.tenant,
+			AffectedServices: []string{"checkout"},
+			AnomalyThreshold: tc.requested,
+			TimeRange:        models.TimeRange{Start: now, End: now.Add(time.Minute)},
+		})
+		if err != nil {
+			t.Fatalf("investigate %s: %v", tc.tenant, err)
+		}
+		if result.Explanation.Threshold != tc.want {
+			t.Fatalf("%s with %v requested: expected threshold %v, got %v", tc.tenant, tc.requested, tc.want, result.Explanation.Threshold)
+		}
+	}
+	if len(source.asked) != 2 || source.asked[1] != "globex/checkout" {
+		t.Fatalf("expected the source to be asked whenever the request sets no threshold, got %v", source.asked)
+	}
+	if !pipeline.TunesThresholds("acme") || pipeline.TunesThresholds("globex") {
+		t.Fatalf("expected tuned thresholds to apply to acme only")
+	}
+}
+
+// burstExtractor reports one metrics anomaly per minute of the window it is given.
+type burstExtractor struct{}
+
+func (burstExtractor) Name() string { return "burst" }
+
+func (burstExtractor) Extract(ctx context.Context, in extractors.Input) []extractors.Anomaly {
+	var anomalies []extractors.Anomaly
+	for i := 0; i < 12; i++ {
+		anomalies = append(anomalies, extractors.Anomaly{
+			Detector:  "burst",
+			DataType:  models.DataTypeMetrics,
+			Service:   in.Service,
+			Selector:  fmt.Sprintf("burst_%d", i),
+			Event:     "burst",
+			Timestamp: time.Unix(int64(i)*60, 0),
+			Score:     float64(i),
+		})
+	}
+	return anomalies
+}
+
+// windowCoreClient records the window metrics were fetched for.
+type windowCoreClient struct {
+	fakeCoreClient
+	start, end time.Time
+}
+
+func (w *windowCoreClient) FetchMetricSeries(ctx context.Context, tenantID, service string, start, end time.Time) ([]repo.MetricPoint, error) {
+	w.start, w.end = start, end
+	return nil, nil
+}
+
+func TestPipelinePresets(t *testing.T) {
+	registry := extractors.NewDefaultRegistry()
+	if err := registry.Register(burstExtractor{}); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	presets, err := NewPresets(registry, map[string]Preset{
+		"burst":    {Extractors: []string{"burst"}, MaxAnchors: 2, MaxTimeline: 3},
+		PresetDeep: {Extractors: []string{"burst"}},
+	})
+	if err != nil {
+		t.Fatalf("new presets: %v", err)
+	}
+	if _, err := NewPresets(registry, map[string]Preset{"broken": {Extractors: []string{"missing"}}}); err == nil {
+		t.Fatalf("expected a preset with an unknown extractor to be rejected")
+	}
+
+	core := &windowCoreClient{}
+	pipeline := NewPipeline(nil, core, nil, nil, nil, registry, WithPresets(presets))
+	end := time.Now().Add(-time.Hour)
+	req := models.InvestigationRequest{
+		TenantID:         "acme",
+		AffectedServices: []string{"checkout"},
+		TimeRange:        models.TimeRange{Start: end.Add(-time.Hour), End: end},
+	}
+
+	for _, tc := range []struct {
+		preset            string
+		anchors, timeline int
+		padding           time.Duration
+	}{
+		{preset: "", anchors: 0, timeline: 0},
+		{preset: "burst", anchors: 2, timeline: 3},
+		{preset: PresetDeep, anchors: 10, timeline: 12, padding: 15 * time.Minute},
+	} {
+		req.Preset = tc.preset
+		result, err := pipeline.Investigate(context.Background(), req)
+		if err != nil {
+			t.Fatalf("investigate %q: %v", tc.preset, err)
+		}
+		if len(result.RedAnchors) != tc.anchors || len(result.Timeline) != tc.timeline {
+			t.Fatalf("preset %q: expected %d anchors and %d events, got %d and %d", tc.preset, tc.anchors, tc.timeline, len(result.RedAnchors), len(result.Timeline))
+		}
+		if !core.start.Equal(req.TimeRange.Start.Add(-tc.padding)) || !core.end.Equal(req.TimeRange.End.Add(tc.padding)) {
+			t.Fatalf("preset %q: unexpected window %v to %v", tc.preset, core.start, core.end)
+		}
+		if result.Explanation.Preset != tc.preset {
+			t.Fatalf("expected the explanation to record preset %q, got %q", tc.preset, result.Explanation.Preset)
+		}
+	}
+
+	req.Preset = "thorough"
+	if _, err := pipeline.Investigate(context.Background(), req); !errors.Is(err, ErrUnknownPreset) {
+		t.Fatalf("expected an unknown preset error, got %v", err)
+	}
+	if pipeline.HasPreset("thorough") || !pipeline.HasPreset("") || !pipeline.HasPreset(PresetLogsHeavy) {
+		t.Fatalf("unexpected preset lookup results")
+	}
+}
+
+// sparseCoreClient returns one metric point per ten minutes of the window it is asked for.
+type sparseCoreClient struct {
+	fakeCoreClient
+	fetches int
+}
+
+func (s *sparseCoreClient) FetchMetricSeries(ctx context.Context, tenantID, service string, start, end time.Time) ([]repo.MetricPoint, error) {
+	s.fetches++
+	var points []repo.MetricPoint
+	for ts := start; ts.Before(end); ts = ts.Add(10 * time.Minute) {
+		points = append(points, repo.MetricPoint{Timestamp: ts, Value: 1})
+	}
+	return points, nil
+}
+
+func TestPipelineExpandsSparseWindows(t *testing.T) {
+	end := time.Now().Add(-2 * time.Hour).Truncate(time.Minute)
+	req := models.InvestigationRequest{
+		TenantID:         "acme",
+		AffectedServices: []string{"checkout"},
+		TimeRange:        models.TimeRange{Start: end.Add(-20 * time.Minute), End: end},
+	}
+
+	core := &sparseCoreClient{}
+	result, err := NewPipeline(nil, core, nil, nil, nil, nil, WithWindowExpansion(5, time.Hour)).Investigate(context.Background(), req)
+	if err != nil {
+		t.Fatalf("investigate: %v", err)
+	}
+	expansion := result.WindowExpansion
+	if expansion == nil || core.fetches != 3 {
+		t.Fatalf("expected two expansions, got %+v after %d fetches", expansion, core.fetches)
+	}
+	want := models.TimeRange{Start: req.TimeRange.Start.Add(-30 * time.Minute), End: end.Add(30 * time.Minute)}
+	if expansion.Analysed != want || expansion.Requested != req.TimeRange {
+		t.Fatalf("expected %v widened to %v, got %+v", req.TimeRange, want, expansion)
+	}
+	if expansion.Samples != 8 || len(expansion.SparseSources) != 1 || expansion.SparseSources[0] != models.DataTypeMetrics {
+		t.Fatalf("unexpected expansion %+v", expansion)
+	}
+
+	core = &sparseCoreClient{}
+	result, err = NewPipeline(nil, core, nil, nil, nil, nil, WithWindowExpansion(5, 10*time.Minute)).Investigate(context.Background(), req)
+	if err != nil {
+		t.Fatalf("investigate: %v", err)
+	}
+	if expansion := result.WindowExpansion; expansion == nil || expansion.Samples != 3 || expansion.Analysed.End.Sub(expansion.Analysed.Start) != 30*time.Minute {
+		t.Fatalf("expected the expansion to stop at the maximum, got %+v", expansion)
+	}
+
+	core = &sparseCoreClient{}
+	result, err = NewPipeline(nil, core, nil, nil, nil, nil).Investigate(context.Background(), req)
+	if err != nil {
+		t.Fatalf("investigate: %v", err)
+	}
+	if result.WindowExpansion != nil || core.fetches != 1 {
+		t.Fatalf("expected no expansion by default, got %+v", result.WindowExpansion)
+	}
+}
+
+func TestPipelineServiceNames(t *testing.T) {
+	names, err := NewServiceNames(map[string][]string{"checkout": {"checkout-svc"}}, []ServiceNameRule{{Pattern: `^prod/`, Replace: ""}})
+	if err != nil {
+		t.Fatalf("new service names: %v", err)
+	}
+	for raw, want := range map[string]string{"checkout-svc": "checkout", "CHECKOUT-SVC": "checkout", "prod/checkout": "checkout", "payments": "payments"} {
+		if got := names.Normalize(raw); got != want {
+			t.Fatalf("normalize %q: expected %q, got %q", raw, want, got)
+		}
+	}
+	if _, err := NewServiceNames(map[string][]string{"a": {"x"}, "b": {"X"}}, nil); err == nil {
+		t.Fatalf("expected an alias of two services to be rejected")
+	}
+	if _, err := NewServiceNames(nil, []ServiceNameRule{{Pattern: "("}}); err == nil {
+		t.Fatalf("expected an invalid rule to be rejected")
+	}
+
+	now := time.Now()
+	core := &fakeCoreClient{graph: []repo.ServiceGraphEdge{
+		{Source: "payments", Target: "checkout-svc", CallRate: 100, ErrorRate: 1},
+		{Source: "payments", Target: "prod/checkout", CallRate: 300, ErrorRate: 3},
+		{Source: "checkout-svc", Target: "prod/checkout", CallRate: 5},
+	}}
+	pipeline := NewPipeline(nil, core, nil, nil, nil, nil, WithServiceNames(names))
+	result, err := pipeline.Investigate(context.Background(), models.InvestigationRequest{
+		TenantID:         "acme",
+		AffectedServices: []string{"checkout-svc", "prod/checkout"},
+		TimeRange:        models.TimeRange{Start: now.Add(-time.Minute), End: now},
+	})
+	if err != nil {
+		t.Fatalf("investigate: %v", err)
+	}
+	if len(result.AffectedServices) != 2 || result.AffectedServices[0] != "checkout" || result.AffectedServices[1] != "payments" {
+		t.Fatalf("expected checkout and payments, got %v", result.AffectedServices)
+	}
+	var topology []string
+	for _, event := range result.Timeline {
+		if strings.HasPrefix(event.Event, "Service graph:") {
+			topology = append(topology, event.Event)
+		}
+	}
+	if len(topology) != 1 || topology[0] != "Service graph: upstream payments -> checkout (error rate 2.50%)" {
+		t.Fatalf("expected one merged upstream edge, got %v", topology)
+	}
+}
+
+// environmentCoreClient records the environment every fetch was scoped to.
+type environmentCoreClient struct {
+	fakeCoreClient
+	mu           sync.Mutex
+	environments []string
+}
+
+func (e *environmentCoreClient) record(ctx context.Context) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.environments = append(e.environments, repo.Environment(ctx))
+}
+
+func (e *environmentCoreClient) FetchMetricSeries(ctx context.Context, tenantID, service string, start, end time.Time) ([]repo.MetricPoint, error) {
+	e.record(ctx)
+	return e.fakeCoreClient.FetchMetricSeries(ctx, tenantID, service, start, end)
+}
+
+func (e *environmentCoreClient) FetchLogEntries(ctx context.Context, tenantID, service string, start, end time.Time) ([]repo.LogEntry, error) {
+	e.record(ctx)
+	return e.fakeCoreClient.FetchLogEntries(ctx, tenantID, service, start, end)
+}
+
+func (e *environmentCoreClient) FetchTraceSpans(ctx context.Context, tenantID, service string, start, end time.Time) ([]repo.TraceSpan, error) {
+	e.record(ctx)
+	return e.fakeCoreClient.FetchTraceSpans(ctx, tenantID, service, start, end)
+}
+
+func (e *environmentCoreClient) FetchServiceGraph(ctx context.Context, tenantID string, start, end time.Time) ([]repo.ServiceGraphEdge, error) {
+	e.record(ctx)
+	return e.fakeCoreClient.FetchServiceGraph(ctx, tenantID, start, end)
+}
+
+func TestPipelineScopesEnvironment(t *testing.T) {
+	now := time.Now()
+	core := &environmentCoreClient{fakeCoreClient: fakeCoreClient{traces: []repo.TraceSpan{
+		{TraceID: "t1", Service: "checkout", Duration: 900 * time.Millisecond, Status: "error", Timestamp: now},
+	}}}
+	pipeline := NewPipeline(nil, core, nil, nil, nil, nil)
+	result, err := pipeline.Investigate(context.Background(), models.InvestigationRequest{
+		TenantID:         "acme",
+		AffectedServices: []string{"checkout"},
+		TimeRange:        models.TimeRange{Start: now.Add(-time.Minute), End: now},
+		Environment:      "staging",
+	})
+	if err != nil {
+		t.Fatalf("investigate: %v", err)
+	}
+	if len(core.environments) != 4 {
+		t.Fatalf("expected four fetches, got %v", core.environments)
+	}
+	for _, environment := range core.environments {
+		if environment != "staging" {
+			t.Fatalf("expected every fetch scoped to staging, got %v", core.environments)
+		}
+	}
+	if result.Environment != "staging" {
+		t.Fatalf("expected staging result, got %q", result.Environment)
+	}
+	for _, anchor := range result.RedAnchors {
+		if anchor.Environment != "staging" {
+			t.Fatalf("expected staging anchors, got %+v", result.RedAnchors)
+		}
+	}
+}
+
+func TestPipelineFoldsSustainedAnomalies(t *testing.T) {
+	start := time.Now().Add(-time.Hour).Truncate(time.Minute)
+	var series []repo.MetricPoint
+	for i := 0; i < 40; i++ {
+		value := 1.0
+		if (i >= 10 && i < 15) || i >= 36 {
+			value = 10
+		}
+		series = append(series, repo.MetricPoint{Name: "error_rate", Timestamp: start.Add(time.Duration(i) * time.Minute), Value: value})
+	}
+	pipeline := NewPipeline(nil, &fakeCoreClient{metrics: series}, nil, nil, nil, nil)
+	result, err := pipeline.Investigate(context.Background(), models.InvestigationRequest{
+		TenantID:         "acme",
+		AffectedServices: []string{"checkout"},
+		TimeRange:        models.TimeRange{Start: start, End: start.Add(40 * time.Minute)},
+		AnomalyThreshold: 1.5,
+	})
+	if err != nil {
+		t.Fatalf("investigate: %v", err)
+	}
+
+	var plateaus []models.TimelineEvent
+	for _, event := range result.Timeline {
+		if event.DataSource == models.DataTypeMetrics && event.Event == "Metric anomaly detected" {
+			plateaus = append(plateaus, event)
+		}
+	}
+	if len(plateaus) != 2 {
+		t.Fatalf("expected two sustained metric events, got %+v", plateaus)
+	}
+	first, last := plateaus[0], plateaus[1]
+	if !first.Time.Equal(start.Add(10*time.Minute)) || first.Duration() != 4*time.Minute || first.Ongoing {
+		t.Fatalf("expected a finished four-minute plateau from minute 10, got %+v", first)
+	}
+	if last.Duration() != 3*time.Minute || !last.Ongoing {
+		t.Fatalf("expected an ongoing three-minute plateau, got %+v", last)
+	}
+}
+
+func TestPipelineCarriesAnomalyDirection(t *testing.T) {
+	start := time.Now().Add(-time.Hour).Truncate(time.Minute)
+	var series []repo.MetricPoint
+	for i := 0; i < 30; i++ {
+		value := 100.0
+		if i >= 20 && i < 23 {
+			value = 5
+		}
+		series = append(series, repo.MetricPoint{Name: "requests_per_second", Timestamp: start.Add(time.Duration(i) * time.Minute), Value: value})
+	}
+	pipeline := NewPipeline(nil, &fakeCoreClient{metrics: series}, nil, nil, nil, nil)
+	result, err := pipeline.Investigate(context.Background(), models.InvestigationRequest{
+		TenantID:         "acme",
+		AffectedServices: []string{"checkout"},
+		TimeRange:        models.TimeRange{Start: start, End: start.Add(30 * time.Minute)},
+	})
+	if err != nil {
+		t.Fatalf("investigate: %v", err)
+	}
+
+	if len(result.RedAnchors) == 0 || result.RedAnchors[0].Selector != "metrics:requests_per_second" || result.RedAnchors[0].Direction != models.DirectionDown {
+		t.Fatalf("expected a drop anchor, got %+v", result.RedAnchors)
+	}
+	for _, event := range result.Timeline {
+		if event.DataSource == models.DataTypeMetrics && event.Direction != models.DirectionDown {
+			t.Fatalf("expected metric events to carry the drop, got %+v", event)
+		}
+	}
+}
+
+type capacityCoreClient struct {
+	fakeCoreClient
+	resources    []repo.MetricPoint
+	environments []string
+}
+
+func (c *capacityCoreClient) FetchResourceUsage(ctx context.Context, tenantID, service string, start, end time.Time) ([]repo.MetricPoint, error) {
+	c.environments = append(c.environments, repo.Environment(ctx))
+	return c.resources, nil
+}
+
+func TestPipelineAnalysesCapacity(t *testing.T) {
+	now := time.Now().Truncate(time.Minute)
+	onset := now.Add(-5 * time.Minute)
+	core := &capacityCoreClient{fakeCoreClient: fakeCoreClient{traces: []repo.TraceSpan{
+		{TraceID: "t1", Service: "checkout", Duration: 900 * time.Millisecond, Status: "error", Timestamp: onset},
+	}}}
+	cpu := []float64{0.5, 0.6, 0.7, 0.8, 0.92, 0.95, 0.95, 0.94, 0.95, 0.93}
+	for i, value := range cpu {
+		ts := now.Add(time.Duration(i-10) * time.Minute)
+		core.resources = append(core.resources,
+			repo.MetricPoint{Name: "cpu", Timestamp: ts, Value: value},
+			repo.MetricPoint{Name: "memory", Timestamp: ts, Value: 0.4 + 0.03*float64(i)},
+		)
+	}
+
+	pipeline := NewPipeline(nil, core, nil, nil, nil, nil, WithCapacityAnalyzer(NewCapacityAnalyzer(0.9)))
+	result, err := pipeline.Investigate(context.Background(), models.InvestigationRequest{
+		TenantID:         "acme",
+		AffectedServices: []string{"checkout"},
+		TimeRange:        models.TimeRange{Start: now.Add(-15 * time.Minute), End: now},
+		Environment:      "prod",
+	})
+	if err != nil {
+		t.Fatalf("investigate: %v", err)
+	}
+	if len(core.environments) != 1 || core.environments[0] != "prod" {
+		t.Fatalf("expected one resource fetch scoped to prod, got %v", core.environments)
+	}
+
+	capacity := result.Capacity
+	if capacity == nil || !capacity.SaturationDriven || capacity.Service != "checkout" || len(capacity.Resources) != 2 {
+		t.Fatalf("expected a saturation-driven analysis of checkout's two resources, got %+v", capacity)
+	}
+	cpuUsage, memory := capacity.Resources[0], capacity.Resources[1]
+	if cpuUsage.Resource != "cpu" || cpuUsage.Peak != 0.95 || !cpuUsage.SaturatedAt.Equal(now.Add(-6*time.Minute)) {
+		t.Fatalf("expected cpu saturated six minutes ago at a 95%% peak, got %+v", cpuUsage)
+	}
+	if math.Abs(cpuUsage.Headroom-0.05) > 1e-9 || math.Abs(cpuUsage.ExtraCapacity-(0.95/0.9-1)) > 1e-9 {
+		t.Fatalf("unexpected cpu headroom estimate: %+v", cpuUsage)
+	}
+	if memory.Saturated() || memory.TimeToExhaustion != 11*time.Minute {
+		t.Fatalf("expected memory to run out in eleven minutes without saturating, got %+v", memory)
+	}
+
+	if len(result.Recommendations) < 2 ||
+		!strings.HasPrefix(result.Recommendations[0].Text, "Add cpu capacity to checkout: it peaked at 95% of its limit (5% headroom); about 6% more") ||
+		!strings.HasPrefix(result.Recommendations[1].Text, "Watch checkout memory: at 67% of its limit and rising, it runs out in about 11m") {
+		t.Fatalf("expected capacity recommendations first, got %+v", result.Recommendations)
+	}
+	saturated := false
+	for _, event := range result.Timeline {
+		if event.Event == "Saturation: cpu reached 95% of its limit" && event.Time.Equal(cpuUsage.SaturatedAt) {
+			saturated = true
+		}
+	}
+	if !saturated {
+		t.Fatalf("expected a saturation timeline event, got %+v", result.Timeline)
+	}
+	if result.Category != models.CategoryCapacity {
+		t.Fatalf("expected a capacity category, got %s", result.Category)
+	}
+
+	late := NewCapacityAnalyzer(0.9).Analyze("checkout", core.resources, now.Add(-9*time.Minute))
+	if late == nil || late.SaturationDriven {
+		t.Fatalf("expected saturation after the onset not to drive the incident, got %+v", late)
+	}
+}
+
+type sloCoreClient struct {
+	fakeCoreClient
+	slos     []repo.SLO
+	services []string
+}
+
+func (c *sloCoreClient) FetchSLOs(ctx context.Context, tenantID, service string) ([]repo.SLO, error) {
+	c.services = append(c.services, service)
+	return c.slos, nil
+}
+
+func TestPipelineScoresSLOImpact(t *testing.T) {
+	now := time.Now().Truncate(time.Minute)
+	core := &sloCoreClient{slos: []repo.SLO{
+		{Name: "availability", Service: "checkout", Indicator: "error_rate", Objective: 0.99},
+		{Name: "latency", Service: "checkout", Indicator: "latency_p95", Objective: 0.9, Threshold: 0.5},
+		{Name: "throughput", Service: "checkout", Indicator: "requests_per_second", Objective: 0.99},
+	}}
+	for i, errors := range []float64{0.01, 0.02, 0.03} {
+		ts := now.Add(time.Duration(i-3) * time.Minute)
+		core.metrics = append(core.metrics,
+			repo.MetricPoint{Name: "error_rate", Timestamp: ts, Value: errors},
+			repo.MetricPoint{Name: "latency_p95", Timestamp: ts, Value: 0.2 + 0.3*float64(i)},
+		)
+	}
+	configured := []repo.SLO{{Name: "availability", Service: "Checkout", Indicator: "error_rate", Objective: 0.999}}
+
+	pipeline := NewPipeline(nil, core, nil, nil, nil, nil, WithImpactScorer(NewImpactScorer(configured, 40)))
+	result, err := pipeline.Investigate(context.Background(), models.InvestigationRequest{
+		TenantID:         "acme",
+		AffectedServices: []string{"checkout"},
+		TimeRange:        models.TimeRange{Start: now.Add(-15 * time.Minute), End: now},
+	})
+	if err != nil {
+		t.Fatalf("investigate: %v", err)
+	}
+	if len(core.services) != 1 || core.services[0] != "checkout" {
+		t.Fatalf("expected one SLO fetch for checkout, got %v", core.services)
+	}
+
+	if len(result.SLOImpacts) != 2 {
+		t.Fatalf("expected availability and latency impacts, got %+v", result.SLOImpacts)
+	}
+	availability, latency := result.SLOImpacts[0], result.SLOImpacts[1]
+	if availability.SLO != "availability" || availability.Objective != 0.999 || math.Abs(availability.BurnRate-20) > 1e-6 {
+		t.Fatalf("expected the configured availability SLO burning 20x, got %+v", availability)
+	}
+	if math.Abs(availability.BudgetSpent-20*(15*time.Minute).Seconds()/defaultSLOPeriod.Seconds()) > 1e-9 {
+		t.Fatalf("unexpected budget spent: %+v", availability)
+	}
+	if latency.SLO != "latency" || math.Abs(latency.BadFraction-1.0/3) > 1e-9 || math.Abs(latency.BurnRate-10.0/3) > 1e-6 {
+		t.Fatalf("expected one latency sample in three over the threshold, got %+v", latency)
+	}
+	if math.Abs(result.ImpactScore-0.5) > 1e-6 {
+		t.Fatalf("expected an impact score of 0.5, got %f", result.ImpactScore)
+	}
+
+	if _, score := NewImpactScorer(nil, 0).Score(configured, core.metrics, models.TimeRange{Start: now.Add(-time.Hour), End: now}); score != 1 {
+		t.Fatalf("expected a burn beyond the default critical rate to score 1, got %f", score)
+	}
+}
+
+func TestClassifierCategories(t *testing.T) {
+	classifier := NewClassifier()
+
+	capacity := models.CorrelationResult{
+		RootCause:  "checkout: metrics:cpu_usage anomaly",
+		RedAnchors: []models.RedAnchor{{Selector: "metrics:cpu_usage"}},
+	}
+	if got := classifier.Classify(capacity, Signals{}, false); got != models.CategoryCapacity {
+		t.Fatalf("expected capacity, got %q", got)
+	}
+
+	dependency := models.CorrelationResult{
+		RootCause:  "payments: upstream influence on checkout",
+		RedAnchors: []models.RedAnchor{{Selector: "logs:error:http_503"}},
+	}
+	if got := classifier.Classify(dependency, Signals{}, true); got != models.CategoryDependency {
+		t.Fatalf("expected dependency failure, got %q", got)
+	}
+
+	network := models.CorrelationResult{RootCause: "checkout: trace:HTTP POST anomaly"}
+	signals := Signals{Logs: []repo.LogEntry{{Message: "dial tcp: lookup payments: DNS timeout"}}}
+	if got := classifier.Classify(network, signals, false); got != models.CategoryNetwork {
+		t.Fatalf("expected network, got %q", got)
+	}
+
+	// CPU falling off with traffic does not point at saturation.
+	drop := models.CorrelationResult{
+		RootCause: "checkout: traffic drop",
+		RedAnchors: []models.RedAnchor{
+			{Selector: "metrics:cpu_usage", Direction: models.DirectionDown},
+			{Selector: "logs:error:http_503", Direction: models.DirectionUp},
+		},
+	}
+	if got := classifier.Classify(drop, Signals{}, false); got != models.CategoryDependency {
+		t.Fatalf("expected a drop to leave capacity out, got %q", got)
+	}
+
+	classifier.AddRule(CategoryRule{Category: "storage", Weight: 5, Keywords: []string{"disk"}})
+	if got := classifier.Classify(models.CorrelationResult{RootCause: "disk latency"}, Signals{}, false); got != "storage" {
+		t.Fatalf("expected custom category, got %q", got)
+	}
+
+	if got := classifier.Classify(models.CorrelationResult{RootCause: "checkout: no dominant anchor"}, Signals{}, false); got != models.CategoryUnknown {
+		t.Fatalf("expected unknown category, got %q", got)
+	}
+}
+
+func TestPipelineClassifiesWithRulePackOverrides(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.yaml")
+	if err := os.WriteFile(path, []byte("rules: []\ncategories:\n  - category: deployment\n    keywords: [\"metrics:\"]\n"), 0644); err != nil {
+		t.Fatalf("write rules: %v", err)
+	}
+	engine, err := NewRuleEngine(path, nil)
+	if err != nil {
+		t.Fatalf("new rule engine: %v", err)
+	}
+	start := time.Now().Add(-time.Hour).Truncate(time.Minute)
+	var series []repo.MetricPoint
+	for i := 0; i < 30; i++ {
+		value := 1.0
+		if i >= 27 {
+			value = 10
+		}
+		series = append(series, repo.MetricPoint{Name: "error_rate", Timestamp: start.Add(time.Duration(i) * time.Minute), Value: value})
+	}
+	pipeline := NewPipeline(nil, &fakeCoreClient{metrics: series}, nil, engine, nil, nil)
+	result, err := pipeline.Investigate(context.Background(), models.InvestigationRequest{
+		TenantID:         "acme",
+		AffectedServices: []string{"checkout"},
+		TimeRange:        models.TimeRange{Start: start, End: start.Add(30 * time.Minute)},
+	})
+	if err != nil {
+		t.Fatalf("investigate: %v", err)
+	}
+	if result.Category != models.CategoryDeployment {
+		t.Fatalf("expected the rule pack's category override to apply, got %q", result.Category)
+	}
+}
+
+func TestMaintenanceWindowDownweightsAnomalies(t *testing.T) {
+	now := time.Now()
+	calendar, err := NewMaintenanceCalendar(nil, models.MaintenanceWindow{
+		TenantID: "tenant-a",
+		Services: []string{"checkout"},
+		Start:    now.Add(-time.Hour),
+		End:      now.Add(time.Hour),
+		Reason:   "node pool upgrade",
+	})
+	if err != nil {
+		t.Fatalf("calendar: %v", err)
+	}
+	if _, err := calendar.Add(context.Background(), models.MaintenanceWindow{TenantID: "tenant-a", Start: now, End: now}); !errors.Is(err, ErrInvalidMaintenanceWindow) {
+		t.Fatalf("expected empty window to be rejected, got %v", err)
+	}
+
+	anomalies := []extractors.Anomaly{
+		{Service: "checkout", Event: "Metric anomaly detected", Timestamp: now, Score: 4},
+		{Service: "payments", Event: "Slow span: DB", Timestamp: now, Score: 4},
+	}
+	windows, err := calendar.List(context.Background(), "tenant-a")
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	anomalies, _ = annotateMaintenance(windows, "checkout", anomalies)
+	if anomalies[0].Score != 2 || !strings.Contains(anomalies[0].Event, "node pool upgrade") {
+		t.Fatalf("expected maintenance down-weighting, got %+v", anomalies[0])
+	}
+	if anomalies[1].Score != 4 {
+		t.Fatalf("expected other services untouched, got %+v", anomalies[1])
+	}
+
+	if removed, err := calendar.Remove(context.Background(), "tenant-a", windows[0].ID); err != nil || !removed {
+		t.Fatalf("expected window to be removable, got %v (%v)", removed, err)
+	}
+	if windows, _ := calendar.List(context.Background(), "tenant-a"); len(windows) != 0 {
+		t.Fatalf("expected no windows after removal, got %+v", windows)
+	}
+}
+
+func TestMaintenanceWindowsPersistAcrossReplicasAndRestarts(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "history.json")
+	store, err := repo.NewMemoryRepo(path)
+	if err != nil {
+		t.Fatalf("store: %v", err)
+	}
+	first, _ := NewMaintenanceCalendar(store)
+	second, _ := NewMaintenanceCalendar(store)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	a, err := first.Add(ctx, models.MaintenanceWindow{TenantID: "tenant-a", Start: now, End: now.Add(time.Hour), Reason: "db upgrade"})
+	if err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	b, err := second.Add(ctx, models.MaintenanceWindow{TenantID: "tenant-a", Start: now.Add(time.Hour), End: now.Add(2 * time.Hour)})
+	if err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	if a.ID == b.ID || !strings.HasPrefix(a.ID, "mw-") || len(a.ID) < len("mw-")+32 {
+		t.Fatalf("expected distinct random ids, got %q and %q", a.ID, b.ID)
+	}
+
+	reopened, err := repo.NewMemoryRepo(path)
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	restarted, _ := NewMaintenanceCalendar(reopened)
+	windows, err := restarted.List(ctx, "tenant-a")
+	if err != nil || len(windows) != 2 || windows[0].ID != a.ID || windows[0].Reason != "db upgrade" {
+		t.Fatalf("expected both windows after restart, got %+v (%v)", windows, err)
+	}
+	if removed, err := restarted.Remove(ctx, "tenant-a", a.ID); err != nil || !removed {
+		t.Fatalf("expected stored window removed, got %v (%v)", removed, err)
+	}
+	if windows, _ := restarted.List(ctx, "tenant-a"); len(windows) != 1 || windows[0].ID != b.ID {
+		t.Fatalf("expected only the second window, got %+v", windows)
+	}
+}
+
+func TestPipelineContinuesWithPartialSignals(t *testing.T) {
+	now := time.Now()
+	metrics := make([]repo.MetricPoint, 0, 15)
+	for i := 0; i < 15; i++ {
+		value := 0.5
+		if i > 10 {
+			value = 2.5
+		}
+		metrics = append(metrics, repo.MetricPoint{Timestamp: now.Add(time.Duration(i) * time.Minute), Value: value})
+	}
+	req := models.InvestigationRequest{
+		TenantID:         "tenant",
+		AffectedServices: []string{"checkout"},
+		AnomalyThreshold: 1.0,
+		TimeRange:        models.TimeRange{Start: now, End: now.Add(15 * time.Minute)},
+	}
+
+	full := NewPipeline(nil, &fakeCoreClient{metrics: metrics}, nil, nil, nil, extractors.NewDefaultRegistry())
+	complete, err := full.Investigate(context.Background(), req)
+	if err != nil {
+		t.Fatalf("investigate: %v", err)
+	}
+
+	degraded := NewPipeline(nil, &fakeCoreClient{metrics: metrics, logsErr: errors.New("logs down"), tracesErr: errors.New("traces down")}, nil, nil, nil, extractors.NewDefaultRegistry())
+	partial, err := degraded.Investigate(context.Background(), req)
+	if err != nil {
+		t.Fatalf("expected partial investigation, got error: %v", err)
+	}
+	if len(partial.UnavailableSources) != 2 || partial.UnavailableSources[0] != models.DataTypeLogs || partial.UnavailableSources[1] != models.DataTypeTraces {
+		t.Fatalf("unexpected unavailable sources: %v", partial.UnavailableSources)
+	}
+	if partial.Confidence >= complete.Confidence {
+		t.Fatalf("expected degraded confidence (%f) below complete (%f)", partial.Confidence, complete.Confidence)
+	}
+}
+
+func TestPipelineSignalTimeoutBoundsSlowSource(t *testing.T) {
+	now := time.Now()
+	metrics := make([]repo.MetricPoint, 0, 15)
+	for i := 0; i < 15; i++ {
+		metrics = append(metrics, repo.MetricPoint{Timestamp: now.Add(time.Duration(i) * time.Minute), Value: 0.5})
+	}
+	req := models.InvestigationRequest{
+		TenantID:         "tenant",
+		AffectedServices: []string{"checkout"},
+		TimeRange:        models.TimeRange{Start: now, End: now.Add(15 * time.Minute)},
+	}
+
+	pipeline := NewPipeline(nil, &fakeCoreClient{metrics: metrics, logsDelay: time.Minute}, nil, nil, nil, extractors.NewDefaultRegistry(),
+		WithTimeouts(Timeouts{Logs: 20 * time.Millisecond, Investigation: 5 * time.Second}))
+
+	start := time.Now()
+	result, err := pipeline.Investigate(context.Background(), req)
+	if err != nil {
+		t.Fatalf("investigate: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Fatalf("slow logs source was not bounded: %v", elapsed)
+	}
+	if len(result.UnavailableSources) != 1 || result.UnavailableSources[0] != models.DataTypeLogs {
+		t.Fatalf("expected logs to be reported unavailable, got %v", result.UnavailableSources)
+	}
+}
+
+type fakeLister struct {
+	correlations []models.CorrelationResult
+}
+
+func (f *fakeLister) ListCorrelations(ctx context.Context, req models.ListCorrelationsRequest) (models.ListCorrelationsResponse, error) {
+	return models.ListCorrelationsResponse{Correlations: f.correlations}, nil
+}
+
+func TestClustererMarksDuplicatesAndRelated(t *testing.T) {
+	now := time.Now()
+	anchors := []models.RedAnchor{
+		{Service: "checkout", Selector: "metrics:latency_p95", AnomalyScore: 0.9},
+		{Service: "payments", Selector: "logs:error:http_503", AnomalyScore: 0.8},
+	}
+	lister := &fakeLister{correlations: []models.CorrelationResult{
+		{CorrelationID: "corr-primary", AffectedServices: []string{"payments", "checkout"}, RedAnchors: anchors},
+		{CorrelationID: "corr-dup", DuplicateOf: "corr-primary", AffectedServices: []string{"checkout", "payments"}, RedAnchors: anchors},
+		{CorrelationID: "corr-related", AffectedServices: []string{"checkout", "payments"}, RedAnchors: anchors[:1]},
+		{CorrelationID: "corr-other", AffectedServices: []string{"search"}, RedAnchors: []models.RedAnchor{{Service: "search", Selector: "metrics:cpu_usage"}}},
+	}}
+	clusterer := NewClusterer(lister, time.Hour)
+
+	result := models.CorrelationResult{
+		CorrelationID:    "corr-new",
+		AffectedServices: []string{"Checkout", "payments"},
+		RedAnchors:       anchors,
+		CreatedAt:        now,
+	}
+	if err := clusterer.Link(context.Background(), "tenant-a", &result); err != nil {
+		t.Fatalf("link: %v", err)
+	}
+	if result.DuplicateOf != "corr-primary" {
+		t.Fatalf("expected duplicate of corr-primary, got %q", result.DuplicateOf)
+	}
+	if len(result.RelatedCorrelations) != 1 || result.RelatedCorrelations[0] != "corr-related" {
+		t.Fatalf("unexpected related correlations %v", result.RelatedCorrelations)
+	}
+
+	unrelated := models.CorrelationResult{CorrelationID: "corr-solo", AffectedServices: []string{"inventory"}, CreatedAt: now}
+	if err := clusterer.Link(context.Background(), "tenant-a", &unrelated); err != nil {
+		t.Fatalf("link: %v", err)
+	}
+	if unrelated.DuplicateOf != "" || len(unrelated.RelatedCorrelations) != 0 {
+		t.Fatalf("expected no links, got %q %v", unrelated.DuplicateOf, unrelated.RelatedCorrelations)
+	}
+
+	staging := models.CorrelationResult{
+		CorrelationID:    "corr-staging",
+		AffectedServices: []string{"checkout", "payments"},
+		RedAnchors:       anchors,
+		CreatedAt:        now,
+		Environment:      "staging",
+	}
+	if err := clusterer.Link(context.Background(), "tenant-a", &staging); err != nil {
+		t.Fatalf("link: %v", err)
+	}
+	if staging.DuplicateOf != "" || len(staging.RelatedCorrelations) != 0 {
+		t.Fatalf("expected no links across environments, got %q %v", staging.DuplicateOf, staging.RelatedCorrelations)
+	}
+
+	if CorrelationFingerprint(result) != CorrelationFingerprint(lister.correlations[0]) {
+		t.Fatalf("expected fingerprint to ignore ordering and case")
+	}
+}
+
+func TestClustererDoesNotMatchCorrelationsOnMissingAnchors(t *testing.T) {
+	now := time.Now()
+	lister := &fakeLister{correlations: []models.CorrelationResult{
+		{CorrelationID: "corr-quiet", AffectedServices: []string{"inventory"}},
+	}}
+	clusterer := NewClusterer(lister, time.Hour)
+
+	sameServices := models.CorrelationResult{CorrelationID: "corr-new", AffectedServices: []string{"inventory"}, CreatedAt: now}
+	if err := clusterer.Link(context.Background(), "tenant-a", &sameServices); err != nil {
+		t.Fatalf("link: %v", err)
+	}
+	if sameServices.DuplicateOf != "" {
+		t.Fatalf("expected no duplicate without anchors, got %q", sameServices.DuplicateOf)
+	}
+	if len(sameServices.RelatedCorrelations) != 1 || sameServices.RelatedCorrelations[0] != "corr-quiet" {
+		t.Fatalf("expected the shared services to relate the correlations, got %v", sameServices.RelatedCorrelations)
+	}
+
+	otherServices := models.CorrelationResult{CorrelationID: "corr-other", AffectedServices: []string{"search"}, CreatedAt: now}
+	if err := clusterer.Link(context.Background(), "tenant-a", &otherServices); err != nil {
+		t.Fatalf("link: %v", err)
+	}
+	if otherServices.DuplicateOf != "" || len(otherServices.RelatedCorrelations) != 0 {
+		t.Fatalf("expected no links, got %q %v", otherServices.DuplicateOf, otherServices.RelatedCorrelations)
+	}
+
+	if score := jaccard(nil, nil); score != 0 {
+		t.Fatalf("expected two empty sets to score 0, got %v", score)
+	}
+}
+
+func TestPipelineShadowDetectors(t *testing.T) {
+	now := time.Now()
+	metrics := make([]repo.MetricPoint, 0, 15)
+	for i := 0; i < 15; i++ {
+		value := 0.5
+		if i > 10 {
+			value = 2.5
+		}
+		metrics = append(metrics, repo.MetricPoint{Timestamp: now.Add(time.Duration(i) * time.Minute), Value: value})
+	}
+	logs := []repo.LogEntry{
+		{Timestamp: now.Add(2 * time.Minute), Severity: "info", Count: 10},
+		{Timestamp: now.Add(4 * time.Minute), Severity: "info", Count: 10},
+		{Timestamp: now.Add(6 * time.Minute), Severity: "info", Count: 10},
+		{Timestamp: now.Add(8 * time.Minute), Severity: "info", Count: 10},
+		{Timestamp: now.Add(12 * time.Minute), Severity: "error", Count: 40},
+	}
+
+	shadowRegistry := extractors.NewDefaultRegistry()
+	if err := shadowRegistry.SetDefault([]string{"logs"}); err != nil {
+		t.Fatalf("shadow registry: %v", err)
+	}
+	var buf bytes.Buffer
+	shadow := NewShadowDetectors(shadowRegistry, 0, 1, 0, slog.New(slog.NewJSONHandler(&buf, nil)))
+	pipeline := NewPipeline(
+		nil,
+		&fakeCoreClient{metrics: metrics, logs: logs},
+		&fakeWeaviate{},
+		nil,
+		nil,
+		extractors.NewDefaultRegistry(),
+		WithShadowDetectors(shadow),
+	)
+	req := models.InvestigationRequest{
+		TenantID:         "tenant-a",
+		IncidentID:       "incident-1",
+		AffectedServices: []string{"checkout"},
+		TimeRange:        models.TimeRange{Start: now, End: now.Add(15 * time.Minute)},
+		AnomalyThreshold: 1.5,
+	}
+	result, err := pipeline.Investigate(context.Background(), req)
+	if err != nil {
+		t.Fatalf("investigate: %v", err)
+	}
+	shadow.wait()
+
+	var primaryMetrics bool
+	for _, anchor := range result.RedAnchors {
+		primaryMetrics = primaryMetrics || anchor.DataType == models.DataTypeMetrics
+	}
+	if !primaryMetrics {
+		t.Fatalf("expected the returned result to keep the primary metric anchors, got %+v", result.RedAnchors)
+	}
+
+	var entry struct {
+		Msg           string  `json:"msg"`
+		IncidentID    string  `json:"incident_id"`
+		AnchorOverlap float64 `json:"anchor_overlap"`
+		Primary       struct {
+			Anchors []string `json:"anchors"`
+		} `json:"primary"`
+		Shadow struct {
+			Anchors []string `json:"anchors"`
+		} `json:"shadow"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("expected one shadow log line, got %q: %v", buf.String(), err)
+	}
+	if entry.Msg != "shadow detection compared" || entry.IncidentID != "incident-1" {
+		t.Fatalf("unexpected shadow log entry: %s", buf.String())
+	}
+	if len(entry.Shadow.Anchors) == 0 || len(entry.Primary.Anchors) <= len(entry.Shadow.Anchors) || entry.AnchorOverlap <= 0 || entry.AnchorOverlap >= 1 {
+		t.Fatalf("expected the logs-only shadow to find a subset of the primary anchors, got %s", buf.String())
+	}
+	for _, anchor := range entry.Shadow.Anchors {
+		if !strings.HasSuffix(anchor, "|logs") {
+			t.Fatalf("expected only log anchors from the shadow, got %v", entry.Shadow.Anchors)
+		}
+	}
+}
+
+func TestPipelineShadowDropsWhenSlotsAreBusy(t *testing.T) {
+	var buf bytes.Buffer
+	shadow := NewShadowDetectors(extractors.NewDefaultRegistry(), 0, 1, 1, slog.New(slog.NewJSONHandler(&buf, nil)))
+	pipeline := NewPipeline(nil, &fakeCoreClient{}, &fakeWeaviate{}, nil, nil, extractors.NewDefaultRegistry(), WithShadowDetectors(shadow))
+
+	// Occupy the only slot, as a slow comparison still running would.
+	shadow.slots <- struct{}{}
+	now := time.Now()
+	req := models.InvestigationRequest{
+		TenantID:         "tenant-a",
+		IncidentID:       "incident-1",
+		AffectedServices: []string{"checkout"},
+		TimeRange:        models.TimeRange{Start: now, End: now.Add(15 * time.Minute)},
+	}
+	if _, err := pipeline.Investigate(context.Background(), req); err != nil {
+		t.Fatalf("investigate: %v", err)
+	}
+	shadow.wait()
+	if buf.Len() != 0 {
+		t.Fatalf("expected the investigation to skip the shadow run, got %s", buf.String())
+	}
+
+	<-shadow.slots
+	if _, err := pipeline.Investigate(context.Background(), req); err != nil {
+		t.Fatalf("investigate: %v", err)
+	}
+	shadow.wait()
+	if !strings.Contains(buf.String(), "shadow detection compared") {
+		t.Fatalf("expected a comparison once a slot is free, got %q", buf.String())
+	}
+}
+
+func TestCompareOutcomes(t *testing.T) {
+	same := compareOutcomes(detectionOutcome{rootCause: "a"}, detectionOutcome{rootCause: "a"})
+	if same.AnchorOverlap != 1 || same.Diverged || same.ConfidenceDelta != 0 {
+		t.Fatalf("expected identical empty outcomes to agree, got %+v", same)
+	}
+
+	primary := detectionOutcome{anchors: []string{"x", "y", "y"}, rootCause: "x", confidence: 0.8}
+	shadow := detectionOutcome{anchors: []string{"y", "z"}, rootCause: "y", confidence: 0.5}
+	got := compareOutcomes(primary, shadow)
+	if got.AnchorOverlap != 1.0/3 || !got.Diverged || got.ConfidenceDelta > -0.29 || got.ConfidenceDelta < -0.31 {
+		t.Fatalf("unexpected comparison: %+v", got)
+	}
+}
+
+// gatedCoreClient counts service-graph fetches, one per investigation, and holds them until release closes.
+type gatedCoreClient struct {
+	fakeCoreClient
+	release chan struct{}
+	graphs  atomic.Int32
+}
+
+func (g *gatedCoreClient) FetchServiceGraph(ctx context.Context, tenantID string, start, end time.Time) ([]repo.ServiceGraphEdge, error) {
+	g.graphs.Add(1)
+	<-g.release
+	return nil, nil
+}
+
+func TestPipelineIncidentClaimsShareAcrossReplicas(t *testing.T) {
+	provider := cache.NewMemoryProvider()
+	core := &gatedCoreClient{release: make(chan struct{})}
+	replica := func() *Pipeline {
+		claims := NewIncidentClaims(provider, 5*time.Second, time.Minute, nil)
+		return NewPipeline(nil, core, nil, nil, nil, nil, WithIncidentClaims(claims))
+	}
+	first, second := replica(), replica()
+
+	now := time.Now()
+	req := models.InvestigationRequest{
+		IncidentID:       "pagerduty:P1",
+		TenantID:         "t1",
+		AffectedServices: []string{"checkout"},
+		TimeRange:        models.TimeRange{Start: now.Add(-15 * time.Minute), End: now},
+		Incident:         models.IncidentMetadata{AlertFingerprints: []string{"a1", "a2"}},
+	}
+	results := make(chan models.CorrelationResult, 2)
+	investigate := func(pipeline *Pipeline, req models.InvestigationRequest) {
+		result, err := pipeline.Investigate(context.Background(), req)
+		if err != nil {
+			t.Errorf("investigate: %v", err)
+		}
+		results <- result
+	}
+
+	go investigate(first, req)
+	for core.graphs.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	// The same alerts reach the other replica through a different integration.
+	other := req
+	other.IncidentID = "opsgenie:42"
+	other.Incident.AlertFingerprints = []string{"a2", "a1"}
+	go investigate(second, other)
+	time.Sleep(250 * time.Millisecond)
+	close(core.release)
+
+	a, b := <-results, <-results
+	if a.CorrelationID == "" || a.CorrelationID != b.CorrelationID {
+		t.Fatalf("expected both replicas to return one correlation, got %q and %q", a.CorrelationID, b.CorrelationID)
+	}
+	if runs := core.graphs.Load(); runs != 1 {
+		t.Fatalf("expected one investigation for the claimed incident, got %d", runs)
+	}
+
+	unrelated := req
+	unrelated.IncidentID = "pagerduty:P2"
+	unrelated.Incident = models.IncidentMetadata{}
+	if _, err := second.Investigate(context.Background(), unrelated); err != nil {
+		t.Fatalf("investigate: %v", err)
+	}
+	if runs := core.graphs.Load(); runs != 2 {
+		t.Fatalf("expected a different incident to be investigated, got %d runs", runs)
+	}
+}
+
+func TestPipelineTopologyDrift(t *testing.T) {
+	now := time.Now()
+	history, err := repo.NewMemoryRepo("")
+	if err != nil {
+		t.Fatalf("new memory repo: %v", err)
+	}
+	calls := func(edges ...string) []models.TopologyEdge {
+		var out []models.TopologyEdge
+		for _, edge := range edges {
+			source, target, _ := strings.Cut(edge, "->")
+			out = append(out, models.TopologyEdge{Source: source, Target: target, CallRate: 1})
+		}
+		return out
+	}
+	for _, snapshot := range []models.TopologySnapshot{
+		{CapturedAt: now.Add(-20 * time.Minute), Edges: calls("checkout->fraud-v1", "search->index")},
+		{CapturedAt: now.Add(-15 * time.Minute), Edges: calls("checkout->fraud-v1", "search->index", "search->ranker")},
+		{CapturedAt: now.Add(-10 * time.Minute), Edges: calls("checkout->fraud-v1", "checkout->fraud-v2")},
+		{CapturedAt: now.Add(-5 * time.Minute), Edges: calls("checkout->fraud-v2")},
+		{CapturedAt: now.Add(-5 * time.Minute), Environment: "staging", Edges: calls("checkout->ledger")},
+	} {
+		if err := history.StoreTopologySnapshot(context.Background(), "acme", snapshot); err != nil {
+			t.Fatalf("store snapshot: %v", err)
+		}
+	}
+
+	traces := []repo.TraceSpan{{TraceID: "trace-1", SpanID: "span-1", Service: "checkout", Duration: 900 * time.Millisecond, Status: "error", Timestamp: now.Add(11 * time.Minute)}}
+	pipeline := NewPipeline(nil, &fakeCoreClient{traces: traces}, nil, nil, nil, extractors.NewDefaultRegistry(),
+		WithTopologyDrift(history, time.Hour))
+	result, err := pipeline.Investigate(context.Background(), models.InvestigationRequest{
+		TenantID:         "acme",
+		AffectedServices: []string{"checkout"},
+		TimeRange:        models.TimeRange{Start: now, End: now.Add(15 * time.Minute)},
+	})
+	if err != nil {
+		t.Fatalf("investigate: %v", err)
+	}
+	var drift []models.TimelineEvent
+	for _, event := range result.Timeline {
+		if strings.HasPrefix(event.Event, "Topology drift:") {
+			drift = append(drift, event)
+		}
+	}
+	// search is unrelated to checkout, and staging is another environment.
+	if len(drift) != 2 || !sortIsChronological(result.Timeline) {
+		t.Fatalf("expected two chronological drift events, got %+v", result.Timeline)
+	}
+	added, removed := drift[0], drift[1]
+	// The slow span at minute 11 is the first anomaly.
+	if !added.Time.Equal(now.Add(-10*time.Minute)) || added.Service != "checkout" ||
+		added.Event != "Topology drift: checkout began calling fraud-v2 21 minutes before the anomaly" {
+		t.Fatalf("expected fraud-v2 dated to the snapshot it first appeared in, got %+v", added)
+	}
+	if !removed.Time.Equal(now.Add(-5*time.Minute)) || removed.Event != "Topology drift: checkout stopped calling fraud-v1 16 minutes before the anomaly" {
+		t.Fatalf("expected fraud-v1 to be reported dropped, got %+v", removed)
+	}
+
+	// Edges that come and go between snapshots are not drift; nor is anything without two snapshots.
+	flapping := []models.TopologySnapshot{
+		{CapturedAt: now.Add(-3 * time.Minute), Edges: calls("checkout->cache")},
+		{CapturedAt: now.Add(-2 * time.Minute), Edges: calls("checkout->cache", "checkout->ads")},
+		{CapturedAt: now.Add(-time.Minute), Edges: calls("checkout->cache")},
+	}
+	if events := driftEvents(flapping, []string{"checkout"}, now); len(events) != 0 {
+		t.Fatalf("expected no drift for a flapping edge, got %+v", events)
+	}
+	if events := driftEvents(flapping[:1], []string{"checkout"}, now); len(events) != 0 {
+		t.Fatalf("expected no drift from a single snapshot, got %+v", events)
+	}
+	if events := driftEvents(flapping[1:], []string{"checkout"}, now); len(events) != 1 || events[0].Event != "Topology drift: checkout stopped calling ads 1 minute before the anomaly" {
+		t.Fatalf("unexpected drift %+v", events)
+	}
+}
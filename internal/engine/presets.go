@@ -0,0 +1,153 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/extractors"
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// Built-in investigation presets.
+const (
+	PresetFast      = "fast"
+	PresetDeep      = "deep"
+	PresetLogsHeavy = "logs-heavy"
+)
+
+// ErrUnknownPreset is returned for investigations that name a preset the pipeline does not have.
+var ErrUnknownPreset = errors.New("unknown investigation preset")
+
+// Default limits for investigations without a preset, or presets that leave them unset.
+const (
+	defaultMaxAnchors     = 5
+	defaultMaxTimeline    = 10
+	defaultCausalityDepth = 1
+)
+
+// Preset bundles the knobs of an investigation under a name callers can select per request. Zero fields keep
+// the defaults.
+type Preset struct {
+	// Padding widens the request window on both sides; the end is never moved past the current time.
+	Padding time.Duration
+	// Extractors replaces the tenant's enabled detectors when set.
+	Extractors  []string
+	MaxAnchors  int
+	MaxTimeline int
+	// CausalityDepth is how many hops upstream of the service causality follows the service graph.
+	CausalityDepth int
+}
+
+// DefaultPresets returns the built-in presets: fast runs the metrics and traces detectors only with tight
+// limits, deep pads the window and follows causality three hops, and logs-heavy favours the logs detector.
+func DefaultPresets() map[string]Preset {
+	return map[string]Preset{
+		PresetFast: {
+			Extractors:     []string{"metrics", "traces"},
+			MaxAnchors:     3,
+			MaxTimeline:    5,
+			CausalityDepth: 1,
+		},
+		PresetDeep: {
+			Padding:        15 * time.Minute,
+			MaxAnchors:     10,
+			MaxTimeline:    25,
+			CausalityDepth: 3,
+		},
+		PresetLogsHeavy: {
+			Padding:        5 * time.Minute,
+			Extractors:     []string{"logs", "metrics"},
+			MaxAnchors:     5,
+			MaxTimeline:    20,
+			CausalityDepth: 1,
+		},
+	}
+}
+
+// NewPresets merges overrides into the built-in presets; set fields of an override replace those of the
+// built-in preset with the same name, and other names add presets. Every extractor a preset selects must be
+// registered in registry.
+func NewPresets(registry *extractors.Registry, overrides map[string]Preset) (map[string]Preset, error) {
+	presets := DefaultPresets()
+	for name, override := range overrides {
+		preset := presets[name]
+		if override.Padding > 0 {
+			preset.Padding = override.Padding
+		}
+		if len(override.Extractors) > 0 {
+			preset.Extractors = append([]string(nil), override.Extractors...)
+		}
+		if override.MaxAnchors > 0 {
+			preset.MaxAnchors = override.MaxAnchors
+		}
+		if override.MaxTimeline > 0 {
+			preset.MaxTimeline = override.MaxTimeline
+		}
+		if override.CausalityDepth > 0 {
+			preset.CausalityDepth = override.CausalityDepth
+		}
+		presets[name] = preset
+	}
+	if registry == nil {
+		registry = extractors.NewDefaultRegistry()
+	}
+	for name, preset := range presets {
+		if len(preset.Extractors) == 0 {
+			continue
+		}
+		if _, err := registry.Select(preset.Extractors); err != nil {
+			return nil, fmt.Errorf("preset %q: %w", name, err)
+		}
+	}
+	return presets, nil
+}
+
+// WithPresets replaces the built-in investigation presets.
+func WithPresets(presets map[string]Preset) PipelineOption {
+	return func(p *Pipeline) {
+		p.presets = presets
+	}
+}
+
+// HasPreset reports whether investigations may select name; the empty name selects the defaults.
+func (p *Pipeline) HasPreset(name string) bool {
+	_, err := p.preset(name)
+	return err == nil
+}
+
+// preset resolves name to a preset with every limit set.
+func (p *Pipeline) preset(name string) (Preset, error) {
+	var preset Preset
+	if name != "" {
+		found, ok := p.presets[name]
+		if !ok {
+			return Preset{}, fmt.Errorf("%w %q", ErrUnknownPreset, name)
+		}
+		preset = found
+	}
+	if preset.MaxAnchors <= 0 {
+		preset.MaxAnchors = defaultMaxAnchors
+	}
+	if preset.MaxTimeline <= 0 {
+		preset.MaxTimeline = defaultMaxTimeline
+	}
+	if preset.CausalityDepth <= 0 {
+		preset.CausalityDepth = defaultCausalityDepth
+	}
+	return preset, nil
+}
+
+// pad widens the window by the preset's padding without moving its end into the future.
+func (preset Preset) pad(window models.TimeRange, now time.Time) models.TimeRange {
+	if preset.Padding <= 0 {
+		return window
+	}
+	window.Start = window.Start.Add(-preset.Padding)
+	if end := window.End.Add(preset.Padding); end.Before(now) {
+		window.End = end
+	} else if window.End.Before(now) {
+		window.End = now
+	}
+	return window
+}
@@ -1,27 +1,62 @@
 package engine
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"net/url"
 	"os"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
+	"unicode"
 
 	"gopkg.in/yaml.v3"
 
+	"github.com/miradorstack/mirador-rca/internal/metrics"
 	"github.com/miradorstack/mirador-rca/internal/models"
 )
 
-// RuleEngine applies rule-based recommendations when similarity recall is insufficient.
+// RuleEngine applies rule-based recommendations when similarity recall is insufficient. The rule set can be
+// reloaded at runtime; a reload that fails validation keeps the previous rules.
 type RuleEngine struct {
-	rules  []Rule
+	path   string
 	logger *slog.Logger
+
+	mu    sync.RWMutex
+	rules []Rule
+	// limit caps the total number of recommendations; zero means unlimited.
+	limit int
+	// categories override the root-cause classifier's keyword rules.
+	categories []CategoryRule
+
+	// reloadMu serialises reloads so that file checks and swaps do not interleave.
+	reloadMu sync.Mutex
+	modTime  time.Time
 }
 
-// Rule represents a single recommendation rule.
+// Rule represents a single recommendation rule. Matching rules contribute in descending Priority order (file
+// order breaks ties); within a Group only the highest-priority match contributes, and MaxRecommendations caps
+// how many of the rule's recommendations are used.
 type Rule struct {
-	ID              string    `yaml:"id"`
-	Match           RuleMatch `yaml:"match"`
-	Recommendations []string  `yaml:"recommendations"`
+	ID                 string    `yaml:"id"`
+	Match              RuleMatch `yaml:"match"`
+	Recommendations    []string  `yaml:"recommendations"`
+	Priority           int       `yaml:"priority"`
+	MaxRecommendations int       `yaml:"maxRecommendations"`
+	Group              string    `yaml:"group"`
+	// Actions are attached to every recommendation the rule emits.
+	Actions []RuleAction `yaml:"actions"`
+}
+
+// RuleAction links a rule's advice to a runbook, a dashboard, or an automation webhook.
+type RuleAction struct {
+	Type  models.ActionType `yaml:"type"`
+	Label string            `yaml:"label"`
+	URL   string            `yaml:"url"`
 }
 
 // RuleMatch defines optional attributes for rule matching.
@@ -29,98 +64,593 @@ type RuleMatch struct {
 	Service          string   `yaml:"service"`
 	Severity         string   `yaml:"severity"`
 	SelectorContains []string `yaml:"selector_contains"`
+	// Environments matches the request's "environment" label against any of the listed values.
+	Environments []string `yaml:"environments"`
+	// Labels must all be present on the request with equal values (case-insensitive).
+	Labels map[string]string `yaml:"labels"`
+	// MinAnomalyScore requires at least one anchor scoring this high.
+	MinAnomalyScore float64 `yaml:"min_anomaly_score"`
+	// Schedule restricts the rule to weekdays and hours, evaluated at the end of the investigated range.
+	Schedule *RuleSchedule `yaml:"schedule"`
 }
 
-// RuleConfigFile is the YAML root structure.
+// RuleSchedule is a weekly time window. Hours is "HH:MM-HH:MM"; a window whose end precedes its start wraps
+// past midnight. Empty fields do not restrict.
+type RuleSchedule struct {
+	Weekdays []string `yaml:"weekdays"`
+	Hours    string   `yaml:"hours"`
+	Timezone string   `yaml:"timezone"`
+
+	days     map[time.Weekday]bool
+	from, to int
+	loc      *time.Location
+}
+
+// RuleConfigFile is the YAML root structure. MaxRecommendations caps the combined output of all rules.
 type RuleConfigFile struct {
-	Rules []Rule `yaml:"rules"`
+	MaxRecommendations int    `yaml:"maxRecommendations"`
+	Rules              []Rule `yaml:"rules"`
+	// Categories override the keywords and weights the classifier uses for a root-cause category.
+	Categories []CategoryRule `yaml:"categories"`
 }
 
-// NewRuleEngine loads rules from the provided path. If path is empty, returns nil engine.
+// NewRuleEngine loads rules from the provided path. If path is empty or the file does not exist, returns nil engine.
 func NewRuleEngine(path string, logger *slog.Logger) (*RuleEngine, error) {
 	if path == "" {
 		return nil, nil
 	}
-	data, err := os.ReadFile(path)
+	if logger == nil {
+		logger = slog.Default()
+	}
+	info, err := os.Stat(path)
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
 			return nil, nil
 		}
 		return nil, err
 	}
+	pack, err := loadRules(path)
+	if err != nil {
+		return nil, err
+	}
+	engine := &RuleEngine{path: path, logger: logger, rules: pack.Rules, limit: pack.MaxRecommendations, categories: pack.Categories, modTime: info.ModTime()}
+	metrics.ObserveRulesReload(len(pack.Rules), time.Now(), nil)
+	return engine, nil
+}
+
+// Rules returns the active rule set.
+func (e *RuleEngine) Rules() []Rule {
+	if e == nil {
+		return nil
+	}
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.rules
+}
+
+// CategoryRules returns the active classifier overrides.
+func (e *RuleEngine) CategoryRules() []CategoryRule {
+	if e == nil {
+		return nil
+	}
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.categories
+}
+
+// Reload re-reads and validates the rule file and swaps it in as a whole. On error the current rules stay
+// active.
+func (e *RuleEngine) Reload() error {
+	if e == nil {
+		return nil
+	}
+	e.reloadMu.Lock()
+	defer e.reloadMu.Unlock()
+	return e.reloadLocked()
+}
+
+func (e *RuleEngine) reloadLocked() error {
+	info, err := os.Stat(e.path)
+	if err == nil {
+		e.modTime = info.ModTime()
+	}
+	pack, err := loadRules(e.path)
+	if err != nil {
+		metrics.ObserveRulesReload(0, time.Now(), err)
+		e.logger.Warn("rule reload rejected; keeping previous rules", slog.String("path", e.path), slog.Any("error", err))
+		return err
+	}
+	e.mu.Lock()
+	e.rules, e.limit, e.categories = pack.Rules, pack.MaxRecommendations, pack.Categories
+	e.mu.Unlock()
+	metrics.ObserveRulesReload(len(pack.Rules), time.Now(), nil)
+	e.logger.Info("rules reloaded", slog.String("path", e.path), slog.Int("rules", len(pack.Rules)))
+	return nil
+}
+
+// SetPath switches the engine to the rule file at path. The new file is loaded and validated before the
+// swap; on error the current file and rules stay active.
+func (e *RuleEngine) SetPath(path string) error {
+	if e == nil {
+		return errors.New("rule engine not configured")
+	}
+	e.reloadMu.Lock()
+	defer e.reloadMu.Unlock()
+	if path == e.path {
+		return e.reloadLocked()
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		metrics.ObserveRulesReload(0, time.Now(), err)
+		return err
+	}
+	pack, err := loadRules(path)
+	if err != nil {
+		metrics.ObserveRulesReload(0, time.Now(), err)
+		e.logger.Warn("rule file rejected; keeping previous rules", slog.String("path", path), slog.Any("error", err))
+		return err
+	}
+	e.mu.Lock()
+	e.rules, e.limit, e.categories = pack.Rules, pack.MaxRecommendations, pack.Categories
+	e.mu.Unlock()
+	previous := e.path
+	e.path, e.modTime = path, info.ModTime()
+	metrics.ObserveRulesReload(len(pack.Rules), time.Now(), nil)
+	e.logger.Info("rule file switched", slog.String("previous", previous), slog.String("path", path), slog.Int("rules", len(pack.Rules)))
+	return nil
+}
+
+// Watch reloads the rules whenever the file's modification time changes, checking every interval until ctx
+// is cancelled.
+func (e *RuleEngine) Watch(ctx context.Context, interval time.Duration) {
+	if e == nil || interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		e.reloadMu.Lock()
+		if info, err := os.Stat(e.path); err == nil && !info.ModTime().Equal(e.modTime) {
+			_ = e.reloadLocked()
+		}
+		e.reloadMu.Unlock()
+	}
+}
+
+// ParseRules builds a static rule engine from a YAML rule pack, applying the same validation as rule files.
+// It lets a pack be evaluated before it is deployed; the result cannot be reloaded.
+func ParseRules(data []byte) (*RuleEngine, error) {
+	pack, err := parseRules(data, "rules")
+	if err != nil {
+		return nil, err
+	}
+	return &RuleEngine{logger: slog.Default(), rules: pack.Rules, limit: pack.MaxRecommendations, categories: pack.Categories}, nil
+}
+
+func loadRules(path string) (RuleConfigFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return RuleConfigFile{}, err
+	}
+	return parseRules(data, path)
+}
+
+// parseRules parses and validates a rule pack: every rule needs a unique id and at least one recommendation,
+// limits must not be negative, and actions need a known type and an absolute URL. Category overrides need a
+// built-in category, listed once, with at least one keyword.
+func parseRules(data []byte, source string) (RuleConfigFile, error) {
 	var cfg RuleConfigFile
 	if err := yaml.Unmarshal(data, &cfg); err != nil {
-		return nil, err
+		return cfg, fmt.Errorf("parse %s: %w", source, err)
 	}
-	if logger == nil {
-		logger = slog.Default()
+	if cfg.MaxRecommendations < 0 {
+		return cfg, fmt.Errorf("maxRecommendations must not be negative")
 	}
-	return &RuleEngine{rules: cfg.Rules, logger: logger}, nil
+	seen := make(map[string]struct{}, len(cfg.Rules))
+	for i, rule := range cfg.Rules {
+		if rule.ID == "" {
+			return cfg, fmt.Errorf("rule %d has no id", i)
+		}
+		if _, dup := seen[rule.ID]; dup {
+			return cfg, fmt.Errorf("duplicate rule id %q", rule.ID)
+		}
+		seen[rule.ID] = struct{}{}
+		if len(rule.Recommendations) == 0 {
+			return cfg, fmt.Errorf("rule %q has no recommendations", rule.ID)
+		}
+		if rule.MaxRecommendations < 0 {
+			return cfg, fmt.Errorf("rule %q: maxRecommendations must not be negative", rule.ID)
+		}
+		if rule.Match.MinAnomalyScore < 0 {
+			return cfg, fmt.Errorf("rule %q: min_anomaly_score must not be negative", rule.ID)
+		}
+		if rule.Match.Schedule != nil {
+			if err := rule.Match.Schedule.compile(); err != nil {
+				return cfg, fmt.Errorf("rule %q: schedule: %w", rule.ID, err)
+			}
+		}
+		for _, action := range rule.Actions {
+			if !action.Type.Valid() {
+				return cfg, fmt.Errorf("rule %q: unknown action type %q", rule.ID, action.Type)
+			}
+			if parsed, err := url.Parse(action.URL); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+				return cfg, fmt.Errorf("rule %q: action url %q must be an absolute http(s) URL", rule.ID, action.URL)
+			}
+		}
+	}
+	builtin := make(map[models.RootCauseCategory]bool)
+	for _, rule := range DefaultCategoryRules() {
+		builtin[rule.Category] = true
+	}
+	overridden := make(map[models.RootCauseCategory]bool, len(cfg.Categories))
+	for _, category := range cfg.Categories {
+		if !builtin[category.Category] {
+			return cfg, fmt.Errorf("categories: unknown category %q", category.Category)
+		}
+		if overridden[category.Category] {
+			return cfg, fmt.Errorf("categories: duplicate category %q", category.Category)
+		}
+		overridden[category.Category] = true
+		if len(category.Keywords) == 0 {
+			return cfg, fmt.Errorf("categories: %q has no keywords", category.Category)
+		}
+		if category.Weight < 0 {
+			return cfg, fmt.Errorf("categories: %q: weight must not be negative", category.Category)
+		}
+	}
+	return cfg, nil
+}
+
+// RuleEvaluation explains how one rule fared against an investigation.
+type RuleEvaluation struct {
+	RuleID   string
+	Priority int
+	Matched  bool
+	// Reasons describe the outcome of every condition the rule declares.
+	Reasons []string
+	// Recommendations are what the rule contributed after priority, group, deduplication, and limits.
+	Recommendations []models.Recommendation
+	// Skipped explains why a matching rule contributed nothing or less than it offers.
+	Skipped string
 }
 
 // Recommend produces rule-based recommendations based on anchors and timeline events.
-func (e *RuleEngine) Recommend(req models.InvestigationRequest, anchors []models.RedAnchor, timeline []models.TimelineEvent) []string {
+func (e *RuleEngine) Recommend(req models.InvestigationRequest, anchors []models.RedAnchor, timeline []models.TimelineEvent) []models.Recommendation {
+	matched := make([]models.Recommendation, 0)
+	for _, evaluation := range e.Evaluate(req, anchors, timeline) {
+		matched = append(matched, evaluation.Recommendations...)
+	}
+	return matched
+}
+
+// Evaluate runs every rule against the investigation and reports, in priority order, which rules matched,
+// why, and what each contributed. Recommend returns the concatenated contributions.
+func (e *RuleEngine) Evaluate(req models.InvestigationRequest, anchors []models.RedAnchor, timeline []models.TimelineEvent) []RuleEvaluation {
 	if e == nil {
 		return nil
 	}
 
-	matched := make([]string, 0)
-	for _, rule := range e.rules {
-		if rule.Match.Service != "" && !serviceMatches(rule.Match.Service, req, anchors) {
+	e.mu.RLock()
+	rules, limit := e.rules, e.limit
+	e.mu.RUnlock()
+
+	ordered := append([]Rule(nil), rules...)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].Priority > ordered[j].Priority })
+
+	evaluations := make([]RuleEvaluation, 0, len(ordered))
+	seen := map[string]struct{}{}
+	groups := map[string]string{}
+	total := 0
+	for _, rule := range ordered {
+		evaluation := RuleEvaluation{RuleID: rule.ID, Priority: rule.Priority}
+		evaluation.Matched, evaluation.Reasons = matchRule(rule.Match, req, anchors, timeline)
+		if !evaluation.Matched {
+			evaluations = append(evaluations, evaluation)
 			continue
 		}
-		if rule.Match.Severity != "" && !timelineHasSeverity(rule.Match.Severity, timeline) {
-			continue
+		if rule.Group != "" {
+			if owner, taken := groups[rule.Group]; taken {
+				evaluation.Skipped = fmt.Sprintf("group %q already taken by rule %q", rule.Group, owner)
+				evaluations = append(evaluations, evaluation)
+				continue
+			}
+			groups[rule.Group] = rule.ID
 		}
-		if len(rule.Match.SelectorContains) > 0 && !anchorsContain(rule.Match.SelectorContains, anchors) {
-			continue
+		recs := rule.Recommendations
+		if rule.MaxRecommendations > 0 && len(recs) > rule.MaxRecommendations {
+			recs = recs[:rule.MaxRecommendations]
 		}
-		matched = appendUnique(matched, rule.Recommendations...)
+		duplicates := 0
+		for _, rec := range recs {
+			if limit > 0 && total == limit {
+				evaluation.Skipped = fmt.Sprintf("maxRecommendations %d reached", limit)
+				break
+			}
+			key := normalizeRecommendation(rec)
+			if key == "" {
+				continue
+			}
+			if _, dup := seen[key]; dup {
+				duplicates++
+				continue
+			}
+			seen[key] = struct{}{}
+			evaluation.Recommendations = append(evaluation.Recommendations, models.Recommendation{Text: rec, RuleID: rule.ID, Actions: ruleActions(rule.Actions)})
+			total++
+		}
+		if evaluation.Skipped == "" && duplicates > 0 {
+			evaluation.Skipped = fmt.Sprintf("%d recommendation(s) duplicated higher-priority rules", duplicates)
+		}
+		evaluations = append(evaluations, evaluation)
 	}
-	return matched
+	return evaluations
+}
+
+// matchRule checks every declared condition, so authors see all failing conditions at once rather than the
+// first one.
+func matchRule(match RuleMatch, req models.InvestigationRequest, anchors []models.RedAnchor, timeline []models.TimelineEvent) (bool, []string) {
+	matched := true
+	var reasons []string
+	if match.Service != "" {
+		if found, ok := matchService(match.Service, req, anchors); ok {
+			reasons = append(reasons, fmt.Sprintf("service %q matched %s", match.Service, found))
+		} else {
+			matched = false
+			reasons = append(reasons, fmt.Sprintf("service %q is neither an affected service nor an anchor service", match.Service))
+		}
+	}
+	if match.Severity != "" {
+		if event, ok := matchSeverity(match.Severity, timeline); ok {
+			reasons = append(reasons, fmt.Sprintf("severity %q matched timeline event %q", match.Severity, event.Event))
+		} else {
+			matched = false
+			reasons = append(reasons, fmt.Sprintf("severity %q not found in the timeline", match.Severity))
+		}
+	}
+	if len(match.SelectorContains) > 0 {
+		if anchor, ok := matchSelector(match.SelectorContains, anchors); ok {
+			reasons = append(reasons, fmt.Sprintf("selector_contains matched anchor %q", anchor.Selector))
+		} else {
+			matched = false
+			reasons = append(reasons, fmt.Sprintf("no anchor selector contains any of %q", match.SelectorContains))
+		}
+	}
+	if len(match.Environments) > 0 {
+		environment := labelValue(req.Labels, "environment")
+		if containsFold(match.Environments, environment) {
+			reasons = append(reasons, fmt.Sprintf("environment %q is allowed", environment))
+		} else {
+			matched = false
+			reasons = append(reasons, fmt.Sprintf("environment %q is not one of %q", environment, match.Environments))
+		}
+	}
+	for _, key := range sortedKeys(match.Labels) {
+		want, got := match.Labels[key], labelValue(req.Labels, key)
+		if strings.EqualFold(want, got) {
+			reasons = append(reasons, fmt.Sprintf("label %s=%q matched", key, got))
+		} else {
+			matched = false
+			reasons = append(reasons, fmt.Sprintf("label %s is %q, want %q", key, got, want))
+		}
+	}
+	if match.MinAnomalyScore > 0 {
+		top := maxAnomalyScore(anchors)
+		if top >= match.MinAnomalyScore {
+			reasons = append(reasons, fmt.Sprintf("top anomaly score %.2f reaches %.2f", top, match.MinAnomalyScore))
+		} else {
+			matched = false
+			reasons = append(reasons, fmt.Sprintf("top anomaly score %.2f is below %.2f", top, match.MinAnomalyScore))
+		}
+	}
+	if sched := match.Schedule; sched != nil {
+		at := req.TimeRange.End
+		if at.IsZero() {
+			at = time.Now()
+		}
+		if ok, local, err := sched.contains(at); err != nil {
+			matched = false
+			reasons = append(reasons, fmt.Sprintf("schedule is invalid: %v", err))
+		} else if ok {
+			reasons = append(reasons, fmt.Sprintf("%s is inside the schedule", local.Format("Mon 15:04 MST")))
+		} else {
+			matched = false
+			reasons = append(reasons, fmt.Sprintf("%s is outside the schedule", local.Format("Mon 15:04 MST")))
+		}
+	}
+	if len(reasons) == 0 {
+		reasons = append(reasons, "no match conditions; the rule applies to every investigation")
+	}
+	return matched, reasons
+}
+
+func ruleActions(actions []RuleAction) []models.RecommendationAction {
+	if len(actions) == 0 {
+		return nil
+	}
+	out := make([]models.RecommendationAction, 0, len(actions))
+	for _, action := range actions {
+		out = append(out, models.RecommendationAction{Type: action.Type, Label: action.Label, URL: action.URL})
+	}
+	return out
+}
+
+// normalizeRecommendation folds case, punctuation, and spacing so near-identical advice from overlapping
+// rules is emitted once.
+func normalizeRecommendation(rec string) string {
+	fields := strings.FieldsFunc(strings.ToLower(rec), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(fields, " ")
 }
 
-func serviceMatches(service string, req models.InvestigationRequest, anchors []models.RedAnchor) bool {
+func matchService(service string, req models.InvestigationRequest, anchors []models.RedAnchor) (string, bool) {
 	for _, s := range req.AffectedServices {
 		if strings.EqualFold(service, s) {
-			return true
+			return "affected service " + strconv.Quote(s), true
 		}
 	}
 	for _, anchor := range anchors {
 		if strings.EqualFold(service, anchor.Service) {
-			return true
+			return "anchor " + strconv.Quote(anchor.Selector), true
 		}
 	}
-	return false
+	return "", false
 }
 
-func timelineHasSeverity(severity string, events []models.TimelineEvent) bool {
-	if severity == "" {
-		return true
-	}
+func matchSeverity(severity string, events []models.TimelineEvent) (models.TimelineEvent, bool) {
 	for _, ev := range events {
 		if strings.EqualFold(severity, string(ev.Severity)) {
-			return true
+			return ev, true
 		}
 	}
-	return false
+	return models.TimelineEvent{}, false
 }
 
-func anchorsContain(keywords []string, anchors []models.RedAnchor) bool {
-	if len(keywords) == 0 {
-		return true
-	}
+func matchSelector(keywords []string, anchors []models.RedAnchor) (models.RedAnchor, bool) {
 	for _, anchor := range anchors {
 		selector := strings.ToLower(anchor.Selector)
 		for _, kw := range keywords {
 			if kw != "" && strings.Contains(selector, strings.ToLower(kw)) {
-				return true
+				return anchor, true
 			}
 		}
 	}
+	return models.RedAnchor{}, false
+}
+
+// labelValue looks key up case-insensitively, so rules written for "Environment" still match.
+func labelValue(labels map[string]string, key string) string {
+	if value, ok := labels[key]; ok {
+		return value
+	}
+	for k, value := range labels {
+		if strings.EqualFold(k, key) {
+			return value
+		}
+	}
+	return ""
+}
+
+func containsFold(values []string, value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, candidate := range values {
+		if strings.EqualFold(candidate, value) {
+			return true
+		}
+	}
 	return false
 }
 
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func maxAnomalyScore(anchors []models.RedAnchor) float64 {
+	top := 0.0
+	for _, anchor := range anchors {
+		if anchor.AnomalyScore > top {
+			top = anchor.AnomalyScore
+		}
+	}
+	return top
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// compile validates the schedule and caches the parsed weekdays, minutes of day, and location.
+func (s *RuleSchedule) compile() error {
+	days := map[time.Weekday]bool{}
+	for _, name := range s.Weekdays {
+		key := strings.ToLower(strings.TrimSpace(name))
+		if len(key) > 3 {
+			key = key[:3]
+		}
+		day, ok := weekdays[key]
+		if !ok {
+			return fmt.Errorf("unknown weekday %q", name)
+		}
+		days[day] = true
+	}
+	from, to := 0, 0
+	if s.Hours != "" {
+		start, end, ok := strings.Cut(s.Hours, "-")
+		if !ok {
+			return fmt.Errorf("hours %q must look like 09:00-18:00", s.Hours)
+		}
+		var err error
+		if from, err = minuteOfDay(start); err != nil {
+			return fmt.Errorf("hours %q: %w", s.Hours, err)
+		}
+		if to, err = minuteOfDay(end); err != nil {
+			return fmt.Errorf("hours %q: %w", s.Hours, err)
+		}
+		if from == to {
+			return fmt.Errorf("hours %q is empty", s.Hours)
+		}
+	}
+	loc := time.UTC
+	if s.Timezone != "" {
+		var err error
+		if loc, err = time.LoadLocation(s.Timezone); err != nil {
+			return fmt.Errorf("timezone %q: %w", s.Timezone, err)
+		}
+	}
+	s.days, s.from, s.to, s.loc = days, from, to, loc
+	return nil
+}
+
+// contains reports whether t falls inside the schedule, along with t in the schedule's timezone.
+func (s *RuleSchedule) contains(t time.Time) (bool, time.Time, error) {
+	if s.loc == nil {
+		// Rules built in code skip parseRules; compile a copy so the shared schedule is never written.
+		compiled := *s
+		if err := compiled.compile(); err != nil {
+			return false, t, err
+		}
+		s = &compiled
+	}
+	local := t.In(s.loc)
+	minute := local.Hour()*60 + local.Minute()
+	day := local.Weekday()
+	if s.Hours != "" && s.to < s.from {
+		// Past midnight, the window belongs to the previous day's schedule.
+		if minute < s.to {
+			day = (day + 6) % 7
+		} else if minute < s.from {
+			return false, local, nil
+		}
+	} else if s.Hours != "" && (minute < s.from || minute >= s.to) {
+		return false, local, nil
+	}
+	if len(s.days) > 0 && !s.days[day] {
+		return false, local, nil
+	}
+	return true, local, nil
+}
+
+func minuteOfDay(value string) (int, error) {
+	parsed, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q", value)
+	}
+	return parsed.Hour()*60 + parsed.Minute(), nil
+}
+
 func appendUnique(existing []string, additions ...string) []string {
 	seen := make(map[string]struct{}, len(existing))
 	for _, rec := range existing {
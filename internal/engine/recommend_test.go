@@ -4,7 +4,9 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/miradorstack/mirador-rca/internal/models"
 )
@@ -18,6 +20,10 @@ func TestRuleEngineRecommend(t *testing.T) {
       service: "checkout"
       selector_contains: ["cpu"]
     recommendations: ["Scale"]
+    actions:
+      - type: runbook
+        label: CPU saturation runbook
+        url: https://runbooks.example.com/cpu
 `), 0644); err != nil {
 		t.Fatalf("write rules: %v", err)
 	}
@@ -33,6 +39,22 @@ func TestRuleEngineRecommend(t *testing.T) {
 	if len(recs) == 0 {
 		t.Fatalf("expected recommendations")
 	}
+	if recs[0].RuleID != "cpu" || len(recs[0].Actions) != 1 || recs[0].Actions[0].Type != models.ActionRunbook || recs[0].Actions[0].URL != "https://runbooks.example.com/cpu" {
+		t.Fatalf("expected the rule's runbook action, got %+v", recs[0])
+	}
+
+	if err := os.WriteFile(path, []byte(`rules:
+  - id: cpu
+    recommendations: ["Scale"]
+    actions:
+      - type: pager
+        url: https://example.com
+`), 0644); err != nil {
+		t.Fatalf("write rules: %v", err)
+	}
+	if _, err := NewRuleEngine(path, nil); err == nil {
+		t.Fatalf("expected an unknown action type to be rejected")
+	}
 }
 
 func TestRuleEngineNoFile(t *testing.T) {
@@ -44,3 +66,253 @@ func TestRuleEngineNoFile(t *testing.T) {
 		t.Fatalf("expected nil engine when file missing")
 	}
 }
+
+func TestRuleEngineReloadKeepsRulesOnInvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.yaml")
+	write := func(content string) {
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("write rules: %v", err)
+		}
+	}
+	write("rules:\n  - id: cpu\n    recommendations: [\"Scale\"]\n")
+	engine, err := NewRuleEngine(path, nil)
+	if err != nil {
+		t.Fatalf("new rule engine: %v", err)
+	}
+
+	write("rules:\n  - id: cpu\n    recommendations: [\"Scale\"]\n  - id: cpu\n    recommendations: [\"Again\"]\n")
+	if err := engine.Reload(); err == nil {
+		t.Fatalf("expected duplicate rule ids to be rejected")
+	}
+	if rules := engine.Rules(); len(rules) != 1 || rules[0].Recommendations[0] != "Scale" {
+		t.Fatalf("expected previous rules to stay active, got %+v", rules)
+	}
+
+	write("rules:\n  - id: cpu\n    recommendations: [\"Scale\"]\n  - id: mem\n    recommendations: [\"Raise memory limits\"]\n")
+	if err := engine.Reload(); err != nil {
+		t.Fatalf("reload: %v", err)
+	}
+	if rules := engine.Rules(); len(rules) != 2 {
+		t.Fatalf("expected reloaded rules, got %+v", rules)
+	}
+}
+
+func TestRuleEngineSetPath(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("write rules: %v", err)
+		}
+		return path
+	}
+	engine, err := NewRuleEngine(write("a.yaml", "rules:\n  - id: cpu\n    recommendations: [\"Scale\"]\n"), nil)
+	if err != nil {
+		t.Fatalf("new rule engine: %v", err)
+	}
+
+	if err := engine.SetPath(write("broken.yaml", "rules: [")); err == nil {
+		t.Fatalf("expected an invalid rule file to be rejected")
+	}
+	if err := engine.SetPath(filepath.Join(dir, "missing.yaml")); err == nil {
+		t.Fatalf("expected a missing rule file to be rejected")
+	}
+	if rules := engine.Rules(); len(rules) != 1 || rules[0].ID != "cpu" {
+		t.Fatalf("expected previous rules to stay active, got %+v", rules)
+	}
+
+	b := write("b.yaml", "rules:\n  - id: mem\n    recommendations: [\"Raise memory limits\"]\n")
+	if err := engine.SetPath(b); err != nil {
+		t.Fatalf("set path: %v", err)
+	}
+	if rules := engine.Rules(); len(rules) != 1 || rules[0].ID != "mem" {
+		t.Fatalf("expected rules from the new file, got %+v", rules)
+	}
+	write("b.yaml", "rules:\n  - id: mem\n    recommendations: [\"Raise memory limits\"]\n  - id: disk\n    recommendations: [\"Expand the volume\"]\n")
+	if err := engine.Reload(); err != nil || len(engine.Rules()) != 2 {
+		t.Fatalf("expected reloads to follow the new path, got %+v (%v)", engine.Rules(), err)
+	}
+}
+
+func TestRuleEnginePrioritiesGroupsAndLimits(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.yaml")
+	if err := os.WriteFile(path, []byte(`maxRecommendations: 3
+rules:
+  - id: generic_cpu
+    group: cpu
+    match:
+      selector_contains: ["cpu"]
+    recommendations: ["Check CPU limits"]
+  - id: checkout_cpu
+    group: cpu
+    priority: 10
+    maxRecommendations: 2
+    match:
+      service: checkout
+      selector_contains: ["cpu"]
+    recommendations: ["Scale checkout deployment", "Check resource limits and throttling", "Profile hot paths"]
+  - id: deploys
+    priority: 5
+    match:
+      service: checkout
+    recommendations: ["scale checkout deployment!", "Roll back the latest release", "Review the release diff"]
+`), 0644); err != nil {
+		t.Fatalf("write rules: %v", err)
+	}
+	engine, err := NewRuleEngine(path, nil)
+	if err != nil {
+		t.Fatalf("new rule engine: %v", err)
+	}
+
+	recs := engine.Recommend(models.InvestigationRequest{AffectedServices: []string{"checkout"}}, []models.RedAnchor{{Service: "checkout", Selector: "metrics:cpu_usage"}}, nil)
+	want := []string{"Scale checkout deployment", "Check resource limits and throttling", "Roll back the latest release"}
+	if strings.Join(models.RecommendationTexts(recs), "|") != strings.Join(want, "|") {
+		t.Fatalf("expected %q, got %q", want, models.RecommendationTexts(recs))
+	}
+}
+
+func TestRuleEngineEvaluateExplainsMatches(t *testing.T) {
+	engine, err := ParseRules([]byte(`rules:
+  - id: cpu
+    group: capacity
+    match:
+      service: checkout
+      selector_contains: ["cpu"]
+    recommendations: ["Scale checkout"]
+  - id: memory
+    group: capacity
+    match:
+      service: checkout
+    recommendations: ["Raise memory limits"]
+  - id: errors
+    priority: 10
+    match:
+      service: payments
+      severity: critical
+    recommendations: ["Inspect error logs"]
+`))
+	if err != nil {
+		t.Fatalf("parse rules: %v", err)
+	}
+
+	evaluations := engine.Evaluate(
+		models.InvestigationRequest{AffectedServices: []string{"checkout"}},
+		[]models.RedAnchor{{Service: "checkout", Selector: "metrics:cpu_usage"}},
+		nil,
+	)
+	if len(evaluations) != 3 || evaluations[0].RuleID != "errors" {
+		t.Fatalf("expected all rules in priority order, got %+v", evaluations)
+	}
+	if errs := evaluations[0]; errs.Matched || len(errs.Reasons) != 2 {
+		t.Fatalf("expected both failing conditions to be reported, got %+v", errs)
+	}
+	if cpu := evaluations[1]; !cpu.Matched || len(cpu.Recommendations) != 1 || !strings.Contains(cpu.Reasons[1], "metrics:cpu_usage") {
+		t.Fatalf("unexpected cpu evaluation: %+v", cpu)
+	}
+	if memory := evaluations[2]; !memory.Matched || len(memory.Recommendations) != 0 || !strings.Contains(memory.Skipped, `rule "cpu"`) {
+		t.Fatalf("expected the group to suppress the memory rule, got %+v", memory)
+	}
+}
+
+func TestRuleEngineContextConditions(t *testing.T) {
+	engine, err := ParseRules([]byte(`rules:
+  - id: prod-business-hours
+    match:
+      environments: [prod]
+      labels:
+        team: payments
+      min_anomaly_score: 3
+      schedule:
+        weekdays: [mon, tue, wed, thu, fri]
+        hours: "09:00-18:00"
+        timezone: Europe/Berlin
+    recommendations: ["Page the payments lead"]
+  - id: overnight
+    match:
+      schedule:
+        weekdays: [fri]
+        hours: "22:00-06:00"
+    recommendations: ["Defer to the morning review"]
+`))
+	if err != nil {
+		t.Fatalf("parse rules: %v", err)
+	}
+
+	// Tuesday 10:30 in Berlin.
+	req := models.InvestigationRequest{
+		Labels:    map[string]string{"Environment": "PROD", "team": "payments"},
+		TimeRange: models.TimeRange{End: time.Date(2024, 3, 5, 9, 30, 0, 0, time.UTC)},
+	}
+	anchors := []models.RedAnchor{{Service: "checkout", AnomalyScore: 4.2}}
+	if recs := engine.Recommend(req, anchors, nil); len(recs) != 1 || recs[0].RuleID != "prod-business-hours" {
+		t.Fatalf("expected the business-hours rule, got %+v", recs)
+	}
+
+	if recs := engine.Recommend(req, []models.RedAnchor{{AnomalyScore: 2}}, nil); len(recs) != 0 {
+		t.Fatalf("expected low anomaly scores to be ignored, got %+v", recs)
+	}
+
+	staging := req
+	staging.Labels = map[string]string{"environment": "staging", "team": "payments"}
+	if recs := engine.Recommend(staging, anchors, nil); len(recs) != 0 {
+		t.Fatalf("expected staging to be ignored, got %+v", recs)
+	}
+
+	// Saturday 03:00 UTC still belongs to Friday's overnight window.
+	req.TimeRange.End = time.Date(2024, 3, 9, 3, 0, 0, 0, time.UTC)
+	if recs := engine.Recommend(req, anchors, nil); len(recs) != 1 || recs[0].RuleID != "overnight" {
+		t.Fatalf("expected the overnight rule, got %+v", recs)
+	}
+	req.TimeRange.End = time.Date(2024, 3, 10, 3, 0, 0, 0, time.UTC)
+	if recs := engine.Recommend(req, anchors, nil); len(recs) != 0 {
+		t.Fatalf("expected Sunday 03:00 to be outside both rules, got %+v", recs)
+	}
+
+	if _, err := ParseRules([]byte("rules:\n  - id: bad\n    match:\n      schedule:\n        weekdays: [someday]\n    recommendations: [x]\n")); err == nil {
+		t.Fatalf("expected an unknown weekday to be rejected")
+	}
+}
+
+func TestRuleEngineCategoryOverrides(t *testing.T) {
+	engine, err := ParseRules([]byte(`rules: []
+categories:
+  - category: config
+    keywords: ["configmap", "flag flip"]
+    weight: 3
+  - category: network
+    keywords: ["mesh"]
+`))
+	if err != nil {
+		t.Fatalf("parse rules: %v", err)
+	}
+	classifier := NewClassifier().WithOverrides(engine.CategoryRules())
+
+	// The built-in capacity keyword scores 2; the overridden config keyword now outweighs it.
+	result := models.CorrelationResult{
+		RootCause:  "checkout: configmap change",
+		RedAnchors: []models.RedAnchor{{Selector: "metrics:cpu_usage"}},
+	}
+	if got := classifier.Classify(result, Signals{}, false); got != models.CategoryConfig {
+		t.Fatalf("expected the weighted config override to win, got %q", got)
+	}
+	if got := classifier.Classify(models.CorrelationResult{RootCause: "checkout: dns lookup failed"}, Signals{}, false); got != models.CategoryUnknown {
+		t.Fatalf("expected overridden network keywords to replace the built-in ones, got %q", got)
+	}
+	if got := classifier.Classify(models.CorrelationResult{RootCause: "checkout: mesh sidecar restarted"}, Signals{}, false); got != models.CategoryNetwork {
+		t.Fatalf("expected the network override to match, got %q", got)
+	}
+	if got := NewClassifier().Classify(models.CorrelationResult{RootCause: "checkout: dns lookup failed"}, Signals{}, false); got != models.CategoryNetwork {
+		t.Fatalf("expected the built-in rules to stay untouched, got %q", got)
+	}
+
+	for _, pack := range []string{
+		"categories:\n  - category: storage\n    keywords: [disk]\n",
+		"categories:\n  - category: config\n    keywords: [a]\n  - category: config\n    keywords: [b]\n",
+		"categories:\n  - category: config\n",
+		"categories:\n  - category: config\n    keywords: [a]\n    weight: -1\n",
+	} {
+		if _, err := ParseRules([]byte(pack)); err == nil {
+			t.Fatalf("expected pack to be rejected:\n%s", pack)
+		}
+	}
+}
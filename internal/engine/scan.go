@@ -0,0 +1,56 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// ScanResult summarises a lightweight anomaly scan of one service.
+type ScanResult struct {
+	Anomalies int
+	// Density is the number of anomalies per minute of the scanned window.
+	Density float64
+	// Unavailable lists signal sources that failed to fetch.
+	Unavailable []models.DataType
+}
+
+// Scan runs the anomaly detectors over a service's metrics and logs only, skipping traces, the service graph,
+// baselines, ranking, and persistence, so it is cheap enough to run on a schedule.
+func (p *Pipeline) Scan(ctx context.Context, tenantID, service string, window models.TimeRange, threshold float64) (ScanResult, error) {
+	var result ScanResult
+	if p.coreClient == nil {
+		return result, fmt.Errorf("core client not configured")
+	}
+	if !window.Start.Before(window.End) {
+		return result, fmt.Errorf("scan window start must be before end")
+	}
+
+	metricsCtx, cancel := withTimeout(ctx, p.timeouts.Metrics)
+	metrics, err := p.coreClient.FetchMetricSeries(metricsCtx, tenantID, service, window.Start, window.End)
+	cancel()
+	if err != nil {
+		p.logger.Debug("scan metrics fetch failed", slog.String("service", service), slog.Any("error", err))
+		result.Unavailable = append(result.Unavailable, models.DataTypeMetrics)
+	}
+	logsCtx, cancel := withTimeout(ctx, p.timeouts.Logs)
+	logs, logsErr := p.coreClient.FetchLogEntries(logsCtx, tenantID, service, window.Start, window.End)
+	cancel()
+	if logsErr != nil {
+		p.logger.Debug("scan logs fetch failed", slog.String("service", service), slog.Any("error", logsErr))
+		result.Unavailable = append(result.Unavailable, models.DataTypeLogs)
+	}
+	if err != nil && logsErr != nil {
+		return result, fmt.Errorf("scan %s: metrics and logs unavailable: %w", service, err)
+	}
+
+	req := models.InvestigationRequest{TenantID: tenantID, AnomalyThreshold: threshold, TimeRange: window}
+	anomalies, _ := p.detect(ctx, req, service, Signals{Metrics: metrics, Logs: logs})
+	result.Anomalies = len(anomalies)
+	if minutes := window.End.Sub(window.Start).Minutes(); minutes > 0 {
+		result.Density = float64(result.Anomalies) / minutes
+	}
+	return result, nil
+}
@@ -0,0 +1,104 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+	"github.com/miradorstack/mirador-rca/internal/repo"
+)
+
+// ServiceGraph fetches a tenant's service graph over window, scoped to environment, with service names
+// normalised as investigations see them. Fetches share the service graph cache with investigations, so a
+// window an investigation analysed is served without calling the backend again.
+func (p *Pipeline) ServiceGraph(ctx context.Context, tenantID, environment string, window models.TimeRange) ([]repo.ServiceGraphEdge, error) {
+	if p.coreClient == nil {
+		return nil, fmt.Errorf("core client not configured")
+	}
+	ctx = repo.WithEnvironment(ctx, environment)
+	edges, err := p.coreClient.FetchServiceGraph(ctx, tenantID, window.Start, window.End)
+	if err != nil {
+		return nil, err
+	}
+	return p.serviceNames.normalizeSignals(Signals{ServiceGraph: edges}).ServiceGraph, nil
+}
+
+// OverlayServiceGraph combines edges with the anchors, blast radius, and suspected root of correlation; a nil
+// correlation leaves the graph without overlay. Services the correlation names but the edges do not are added
+// as unconnected nodes.
+func OverlayServiceGraph(edges []repo.ServiceGraphEdge, correlation *models.CorrelationResult, window models.TimeRange) models.ServiceGraph {
+	graph := models.ServiceGraph{TimeRange: window}
+	nodes := make(map[string]*models.ServiceGraphNode)
+	node := func(service string) *models.ServiceGraphNode {
+		n, ok := nodes[service]
+		if !ok {
+			n = &models.ServiceGraphNode{Service: service}
+			nodes[service] = n
+		}
+		return n
+	}
+	for _, edge := range edges {
+		if edge.Source == "" || edge.Target == "" {
+			continue
+		}
+		node(edge.Source)
+		node(edge.Target)
+	}
+
+	if correlation != nil {
+		graph.CorrelationID = correlation.CorrelationID
+		for _, anchor := range correlation.RedAnchors {
+			if anchor.Service == "" {
+				continue
+			}
+			n := node(anchor.Service)
+			n.AnchorCount++
+			if anchor.AnomalyScore > n.AnomalyScore {
+				n.AnomalyScore = anchor.AnomalyScore
+			}
+		}
+		for _, impact := range correlation.BlastRadius {
+			n := node(impact.Service)
+			n.ImpactScore, n.ImpactDepth = impact.Score, impact.Depth
+		}
+		for _, service := range correlation.AffectedServices {
+			node(service).Affected = true
+		}
+		if root := suspectedRootService(*correlation); root != "" {
+			node(root).RootCause = true
+		}
+	}
+
+	for _, edge := range edges {
+		if edge.Source == "" || edge.Target == "" {
+			continue
+		}
+		graph.Edges = append(graph.Edges, models.ServiceGraphEdge{
+			Source:    edge.Source,
+			Target:    edge.Target,
+			CallRate:  edge.CallRate,
+			ErrorRate: edge.ErrorRate,
+			Anomalous: nodes[edge.Target].AnchorCount > 0,
+		})
+	}
+	for _, n := range nodes {
+		graph.Nodes = append(graph.Nodes, *n)
+	}
+	sort.Slice(graph.Nodes, func(i, j int) bool { return graph.Nodes[i].Service < graph.Nodes[j].Service })
+	return graph
+}
+
+// suspectedRootService is the service a correlation blamed: the causality engine's suggestion when it made one,
+// or else the service the detectors analysed.
+func suspectedRootService(correlation models.CorrelationResult) string {
+	if explanation := correlation.Explanation; explanation != nil {
+		if explanation.Causality.SuggestedService != "" {
+			return explanation.Causality.SuggestedService
+		}
+		if explanation.Service != "" {
+			return explanation.Service
+		}
+	}
+	return firstNonEmpty(correlation.AffectedServices...)
+}
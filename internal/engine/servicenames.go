@@ -0,0 +1,131 @@
+package engine
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/miradorstack/mirador-rca/internal/repo"
+)
+
+// ServiceNameRule rewrites service names matching Pattern to Replace, which may refer to capture groups as $1.
+type ServiceNameRule struct {
+	Pattern string
+	Replace string
+}
+
+type compiledServiceNameRule struct {
+	pattern *regexp.Regexp
+	replace string
+}
+
+// ServiceNames maps the spellings of a service seen in requests and signals, such as "checkout-svc" or
+// "prod/checkout", onto one canonical name so they are analysed as a single service.
+type ServiceNames struct {
+	rules   []compiledServiceNameRule
+	aliases map[string]string
+}
+
+// NewServiceNames builds a normaliser from aliases, keyed by canonical name, and rules applied in order
+// before the aliases are looked up. Aliases match case-insensitively; an alias may belong to one canonical
+// name only.
+func NewServiceNames(aliases map[string][]string, rules []ServiceNameRule) (*ServiceNames, error) {
+	names := &ServiceNames{aliases: make(map[string]string)}
+	for i, rule := range rules {
+		pattern, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("service name rule %d: %w", i, err)
+		}
+		names.rules = append(names.rules, compiledServiceNameRule{pattern: pattern, replace: rule.Replace})
+	}
+	for canonical, spellings := range aliases {
+		canonical = strings.TrimSpace(canonical)
+		if canonical == "" {
+			return nil, fmt.Errorf("service aliases need a canonical name")
+		}
+		for _, alias := range append([]string{canonical}, spellings...) {
+			key := strings.ToLower(strings.TrimSpace(alias))
+			if existing, ok := names.aliases[key]; ok && existing != canonical {
+				return nil, fmt.Errorf("service alias %q belongs to both %q and %q", alias, existing, canonical)
+			}
+			names.aliases[key] = canonical
+		}
+	}
+	return names, nil
+}
+
+// Normalize returns the canonical name of service, or service itself when no rule or alias applies.
+func (n *ServiceNames) Normalize(service string) string {
+	service = strings.TrimSpace(service)
+	if n == nil || service == "" {
+		return service
+	}
+	for _, rule := range n.rules {
+		service = rule.pattern.ReplaceAllString(service, rule.replace)
+	}
+	if canonical, ok := n.aliases[strings.ToLower(service)]; ok {
+		return canonical
+	}
+	return service
+}
+
+// normalizeAll normalises each service, dropping the repeats that aliasing produces.
+func (n *ServiceNames) normalizeAll(services []string) []string {
+	if n == nil {
+		return services
+	}
+	out := make([]string, 0, len(services))
+	for _, service := range services {
+		out = append(out, n.Normalize(service))
+	}
+	return uniqueStrings(out)
+}
+
+// normalizeSignals rewrites the services named by spans and service graph edges. Edges that aliasing turns
+// into a service calling itself are dropped, and edges that become duplicates are merged: their call rates
+// add up and their error rates are averaged by calls.
+func (n *ServiceNames) normalizeSignals(signals Signals) Signals {
+	if n == nil {
+		return signals
+	}
+	spans := make([]repo.TraceSpan, len(signals.Traces))
+	for i, span := range signals.Traces {
+		span.Service = n.Normalize(span.Service)
+		spans[i] = span
+	}
+	signals.Traces = spans
+
+	var edges []repo.ServiceGraphEdge
+	index := make(map[[2]string]int)
+	for _, edge := range signals.ServiceGraph {
+		source, target := n.Normalize(edge.Source), n.Normalize(edge.Target)
+		if source == target && (source != edge.Source || target != edge.Target) {
+			continue
+		}
+		edge.Source, edge.Target = source, target
+		key := [2]string{source, target}
+		i, seen := index[key]
+		if !seen {
+			index[key] = len(edges)
+			edges = append(edges, edge)
+			continue
+		}
+		merged := &edges[i]
+		if calls := merged.CallRate + edge.CallRate; calls > 0 {
+			merged.ErrorRate = (merged.ErrorRate*merged.CallRate + edge.ErrorRate*edge.CallRate) / calls
+		} else {
+			merged.ErrorRate = max(merged.ErrorRate, edge.ErrorRate)
+		}
+		merged.CallRate += edge.CallRate
+	}
+	signals.ServiceGraph = edges
+	return signals
+}
+
+// WithServiceNames analyses every spelling of a service under its canonical name. Signals are still
+// fetched under the name the request used, since that is how the backends know the service.
+func WithServiceNames(names *ServiceNames) PipelineOption {
+	return func(p *Pipeline) {
+		p.serviceNames = names
+	}
+}
@@ -0,0 +1,180 @@
+package engine
+
+import (
+	"context"
+	"log/slog"
+	"math/rand/v2"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/miradorstack/mirador-rca/internal/extractors"
+	"github.com/miradorstack/mirador-rca/internal/metrics"
+	"github.com/miradorstack/mirador-rca/internal/models"
+	"github.com/miradorstack/mirador-rca/internal/tracing"
+)
+
+// ShadowDetectors runs a second detector configuration on the signals of live investigations and compares
+// its anchors, root cause, and confidence with the primary detectors' in the background. Its output is only
+// logged and measured, never returned or stored, so a candidate configuration can be evaluated on real
+// traffic before it replaces the primary one.
+type ShadowDetectors struct {
+	registry    *extractors.Registry
+	threshold   float64
+	sampleRatio float64
+	// slots bounds the comparisons running at once; sampled investigations beyond it are dropped.
+	slots  chan struct{}
+	logger *slog.Logger
+	wg     sync.WaitGroup
+}
+
+// defaultShadowConcurrency bounds in-flight shadow comparisons when no limit is configured.
+const defaultShadowConcurrency = 4
+
+// NewShadowDetectors runs the extractors registry enables for each tenant. A positive threshold replaces the
+// request's anomaly threshold; sampleRatio is the fraction of investigations shadowed, where values outside
+// (0, 1] shadow all of them. At most maxConcurrent comparisons run at once (defaultShadowConcurrency when not
+// positive); a sampled investigation arriving while all are busy is counted as dropped instead of queued.
+func NewShadowDetectors(registry *extractors.Registry, threshold, sampleRatio float64, maxConcurrent int, logger *slog.Logger) *ShadowDetectors {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if sampleRatio <= 0 || sampleRatio > 1 {
+		sampleRatio = 1
+	}
+	if maxConcurrent <= 0 {
+		maxConcurrent = defaultShadowConcurrency
+	}
+	return &ShadowDetectors{
+		registry:    registry,
+		threshold:   threshold,
+		sampleRatio: sampleRatio,
+		slots:       make(chan struct{}, maxConcurrent),
+		logger:      logger,
+	}
+}
+
+// WithShadowDetectors compares every sampled investigation against shadow.
+func WithShadowDetectors(shadow *ShadowDetectors) PipelineOption {
+	return func(p *Pipeline) {
+		p.shadow = shadow
+	}
+}
+
+// shadowComparison summarises how a shadow run differed from the primary one.
+type shadowComparison struct {
+	// AnchorOverlap is the Jaccard similarity of the two anchor sets, keyed by service, selector, and data
+	// type; 1 when both found the same anchors, including none.
+	AnchorOverlap   float64
+	ConfidenceDelta float64
+	// Diverged reports a different root cause.
+	Diverged bool
+}
+
+// detectionOutcome is what the comparison looks at in a primary or shadow run.
+type detectionOutcome struct {
+	anchors    []string
+	rootCause  string
+	confidence float64
+}
+
+func (p *Pipeline) detectionOutcome(service string, anomalies []extractors.Anomaly) detectionOutcome {
+	anchors := p.buildAnchors(service, anomalies, defaultMaxAnchors)
+	keys := make([]string, 0, len(anchors))
+	for _, anchor := range anchors {
+		keys = append(keys, anchor.Service+"|"+anchor.Selector+"|"+string(anchor.DataType))
+	}
+	return detectionOutcome{
+		anchors:    keys,
+		rootCause:  deriveRootCause(service, anchors),
+		confidence: p.computeConfidence(anomalies),
+	}
+}
+
+// runShadow starts the shadow comparison for one investigation without waiting for it. The shadow run
+// outlives the request so a slow candidate detector never delays or cancels the response; the concurrency
+// bound keeps a slow one from piling up goroutines and retained signals under load.
+func (p *Pipeline) runShadow(ctx context.Context, req models.InvestigationRequest, service string, signals Signals, anomalies []extractors.Anomaly, windows []models.MaintenanceWindow) {
+	shadow := p.shadow
+	if shadow == nil || (shadow.sampleRatio < 1 && rand.Float64() >= shadow.sampleRatio) {
+		return
+	}
+	select {
+	case shadow.slots <- struct{}{}:
+	default:
+		metrics.IncShadowDropped()
+		return
+	}
+	primary := p.detectionOutcome(service, anomalies)
+	ctx = context.WithoutCancel(ctx)
+	shadow.wg.Add(1)
+	go func() {
+		defer shadow.wg.Done()
+		defer func() { <-shadow.slots }()
+		ctx, span := tracing.Start(ctx, "rca.shadow")
+		defer span.End()
+
+		input := detectorInput(req, service, signals)
+		if shadow.threshold > 0 {
+			input.Threshold = shadow.threshold
+		}
+		anomalies := runExtractors(ctx, shadow.registry.ForTenant(req.TenantID), input)
+		anomalies, _ = annotateMaintenance(windows, service, anomalies)
+		candidate := p.detectionOutcome(service, anomalies)
+
+		comparison := compareOutcomes(primary, candidate)
+		span.SetAttributes(attribute.Bool("rca.shadow.diverged", comparison.Diverged))
+		metrics.ObserveShadowComparison(comparison.AnchorOverlap, comparison.ConfidenceDelta, comparison.Diverged)
+		shadow.logger.Info("shadow detection compared",
+			slog.String("tenant_id", req.TenantID),
+			slog.String("incident_id", req.IncidentID),
+			slog.String("service", service),
+			slog.Bool("diverged", comparison.Diverged),
+			slog.Float64("anchor_overlap", comparison.AnchorOverlap),
+			slog.Float64("confidence_delta", comparison.ConfidenceDelta),
+			slog.Group("primary", outcomeAttrs(primary)...),
+			slog.Group("shadow", outcomeAttrs(candidate)...),
+		)
+	}()
+}
+
+// wait blocks until in-flight shadow comparisons finish.
+func (s *ShadowDetectors) wait() {
+	if s != nil {
+		s.wg.Wait()
+	}
+}
+
+func compareOutcomes(primary, shadow detectionOutcome) shadowComparison {
+	comparison := shadowComparison{
+		AnchorOverlap:   1,
+		ConfidenceDelta: shadow.confidence - primary.confidence,
+		Diverged:        primary.rootCause != shadow.rootCause,
+	}
+	// 1 marks a primary anchor, 2 a shadow anchor, and 3 one both found.
+	seen := make(map[string]int, len(primary.anchors)+len(shadow.anchors))
+	for _, key := range primary.anchors {
+		seen[key] |= 1
+	}
+	for _, key := range shadow.anchors {
+		seen[key] |= 2
+	}
+	var shared int
+	for _, found := range seen {
+		if found == 3 {
+			shared++
+		}
+	}
+	if len(seen) > 0 {
+		comparison.AnchorOverlap = float64(shared) / float64(len(seen))
+	}
+	return comparison
+}
+
+func outcomeAttrs(outcome detectionOutcome) []any {
+	return []any{
+		slog.String("root_cause", outcome.rootCause),
+		slog.Float64("confidence", outcome.confidence),
+		slog.Any("anchors", outcome.anchors),
+	}
+}
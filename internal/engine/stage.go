@@ -0,0 +1,31 @@
+package engine
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/miradorstack/mirador-rca/internal/metrics"
+	"github.com/miradorstack/mirador-rca/internal/tracing"
+)
+
+// stage times one investigation pipeline stage as both a span and a pipeline_stage_seconds observation.
+type stage struct {
+	span  trace.Span
+	name  string
+	start time.Time
+}
+
+// startStage opens the span spanName for the metrics stage name.
+func startStage(ctx context.Context, spanName, name string, attrs ...attribute.KeyValue) (context.Context, *stage) {
+	ctx, span := tracing.Start(ctx, spanName, attrs...)
+	return ctx, &stage{span: span, name: name, start: time.Now()}
+}
+
+// End records the stage duration and ends its span with err.
+func (s *stage) End(err error) {
+	metrics.ObservePipelineStage(s.name, time.Since(s.start))
+	tracing.End(s.span, err)
+}
@@ -0,0 +1,222 @@
+package engine
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// DefaultSummaryLocale is the locale of DefaultSummaryTemplate.
+const DefaultSummaryLocale = "en"
+
+// DefaultSummaryTemplate narrates the incident, root cause and confidence, the first signal, the affected
+// services, and the first recommendation in English.
+const DefaultSummaryTemplate = `{{if .Correlation.Incident.Title}}{{.Correlation.Incident.Title}}{{else if .Correlation.IncidentID}}Incident {{.Correlation.IncidentID}}{{else}}This incident{{end}}
+{{- ""}} was most likely caused by {{.Correlation.RootCause}}
+{{- with .Correlation.Category}}, a {{humanize .}} problem{{end}}
+{{- ""}}, with {{.ConfidencePercent}}% confidence.
+{{- with .FirstEvent}} It began at {{.Time.UTC.Format "15:04 UTC"}}, when {{.Service}} reported {{.Event}}.{{end}}
+{{- with .Correlation.AffectedServices}} Affected services: {{list . "and"}}.{{end}}
+{{- with .Recommendations}} Suggested first step: {{sentence (index . 0)}}{{end}}`
+
+// summaryAnchorCount is how many anchors are offered to summary templates as TopAnchors.
+const summaryAnchorCount = 3
+
+// summaryLocaleLabel is the request label that picks the summary locale for one investigation.
+const summaryLocaleLabel = "locale"
+
+// SummaryData is the value summary templates are executed against.
+type SummaryData struct {
+	TenantID          string
+	Locale            string
+	Correlation       models.CorrelationResult
+	ConfidencePercent int
+	// Start and End bound the investigated window.
+	Start time.Time
+	End   time.Time
+	// FirstEvent is the earliest timeline event, or nil when the timeline is empty.
+	FirstEvent      *models.TimelineEvent
+	TopAnchors      []models.RedAnchor
+	Recommendations []string
+}
+
+// SummaryTenant overrides the summary of one tenant: Locale replaces the default locale, and Template, when
+// set, replaces the locale templates altogether.
+type SummaryTenant struct {
+	Locale   string
+	Template string
+}
+
+// Summarizer renders each correlation into a human-readable narrative paragraph with Go text/templates chosen
+// per tenant and locale. The locale comes from the request's "locale" label, then the tenant's locale, then
+// the default one; a regional locale such as "de-AT" falls back to its language, and a locale without a
+// template falls back to the default locale and finally to DefaultSummaryTemplate.
+type Summarizer struct {
+	locale  string
+	locales map[string]*template.Template
+	tenants map[string]summaryTenant
+}
+
+type summaryTenant struct {
+	locale   string
+	template *template.Template
+}
+
+// NewSummarizer parses the templates of locales, keyed by locale, and of tenants, keyed by tenant ID. An empty
+// locale defaults to DefaultSummaryLocale.
+func NewSummarizer(locale string, locales map[string]string, tenants map[string]SummaryTenant) (*Summarizer, error) {
+	if locale == "" {
+		locale = DefaultSummaryLocale
+	}
+	s := &Summarizer{
+		locale:  normalizeLocale(locale),
+		locales: make(map[string]*template.Template, len(locales)+1),
+		tenants: make(map[string]summaryTenant, len(tenants)),
+	}
+	builtin, err := parseSummaryTemplate(DefaultSummaryLocale, DefaultSummaryTemplate)
+	if err != nil {
+		return nil, err
+	}
+	s.locales[DefaultSummaryLocale] = builtin
+	for name, text := range locales {
+		tmpl, err := parseSummaryTemplate("locale "+name, text)
+		if err != nil {
+			return nil, err
+		}
+		s.locales[normalizeLocale(name)] = tmpl
+	}
+	for id, tenant := range tenants {
+		override := summaryTenant{locale: normalizeLocale(tenant.Locale)}
+		if tenant.Template != "" {
+			if override.template, err = parseSummaryTemplate("tenant "+id, tenant.Template); err != nil {
+				return nil, err
+			}
+		}
+		s.tenants[id] = override
+	}
+	return s, nil
+}
+
+// WithSummarizer adds a narrative summary to every correlation.
+func WithSummarizer(summarizer *Summarizer) PipelineOption {
+	return func(p *Pipeline) {
+		p.summarizer = summarizer
+	}
+}
+
+// Summarize renders the summary of result, investigated for req. A nil Summarizer renders nothing.
+func (s *Summarizer) Summarize(req models.InvestigationRequest, result models.CorrelationResult) (string, error) {
+	if s == nil {
+		return "", nil
+	}
+	tenant := s.tenants[req.TenantID]
+	locale := s.Locale(req)
+	tmpl := tenant.template
+	if tmpl == nil {
+		tmpl = s.localeTemplate(locale)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, summaryData(req, locale, result)); err != nil {
+		return "", fmt.Errorf("render summary: %w", err)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// Locale returns the summary locale of req; a nil Summarizer has none.
+func (s *Summarizer) Locale(req models.InvestigationRequest) string {
+	if s == nil {
+		return ""
+	}
+	return firstNonEmpty(normalizeLocale(req.Labels[summaryLocaleLabel]), s.tenants[req.TenantID].locale, s.locale)
+}
+
+func (s *Summarizer) localeTemplate(locale string) *template.Template {
+	if tmpl, ok := s.locales[locale]; ok {
+		return tmpl
+	}
+	if language, _, found := strings.Cut(locale, "-"); found {
+		if tmpl, ok := s.locales[language]; ok {
+			return tmpl
+		}
+	}
+	if tmpl, ok := s.locales[s.locale]; ok {
+		return tmpl
+	}
+	return s.locales[DefaultSummaryLocale]
+}
+
+func summaryData(req models.InvestigationRequest, locale string, result models.CorrelationResult) SummaryData {
+	data := SummaryData{
+		TenantID:          req.TenantID,
+		Locale:            locale,
+		Correlation:       result,
+		ConfidencePercent: int(result.Confidence*100 + 0.5),
+		Start:             req.TimeRange.Start,
+		End:               req.TimeRange.End,
+		Recommendations:   models.RecommendationTexts(result.Recommendations),
+	}
+	for i := range result.Timeline {
+		if data.FirstEvent == nil || result.Timeline[i].Time.Before(data.FirstEvent.Time) {
+			data.FirstEvent = &result.Timeline[i]
+		}
+	}
+	data.TopAnchors = append([]models.RedAnchor(nil), result.RedAnchors...)
+	sort.SliceStable(data.TopAnchors, func(i, j int) bool { return data.TopAnchors[i].AnomalyScore > data.TopAnchors[j].AnomalyScore })
+	if len(data.TopAnchors) > summaryAnchorCount {
+		data.TopAnchors = data.TopAnchors[:summaryAnchorCount]
+	}
+	return data
+}
+
+// summaryFuncs are available to every summary template: list joins items into a natural-language list with
+// the given conjunction, humanize turns identifiers such as "dependency_failure" into words, and sentence
+// capitalises text and ends it with a full stop.
+var summaryFuncs = template.FuncMap{
+	"list": func(items []string, conjunction string) string {
+		switch len(items) {
+		case 0:
+			return ""
+		case 1:
+			return items[0]
+		case 2:
+			return items[0] + " " + conjunction + " " + items[1]
+		}
+		return strings.Join(items[:len(items)-1], ", ") + ", " + conjunction + " " + items[len(items)-1]
+	},
+	"humanize": func(value any) string {
+		return strings.ReplaceAll(fmt.Sprint(value), "_", " ")
+	},
+	"sentence": func(text string) string {
+		text = strings.TrimSpace(text)
+		if text == "" {
+			return ""
+		}
+		first, size := utf8.DecodeRuneInString(text)
+		text = string(unicode.ToUpper(first)) + text[size:]
+		if !strings.ContainsAny(text[len(text)-1:], ".!?") {
+			text += "."
+		}
+		return text
+	},
+}
+
+func parseSummaryTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Funcs(summaryFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("summary template %s: %w", name, err)
+	}
+	return tmpl, nil
+}
+
+// normalizeLocale lowercases locale and uses "-" as its separator, so "de_AT" and "de-at" are the same.
+func normalizeLocale(locale string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(locale)), "_", "-")
+}
@@ -0,0 +1,38 @@
+package engine
+
+import (
+	"github.com/miradorstack/mirador-rca/internal/features"
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// ThresholdSource supplies tuned per-service anomaly thresholds, reporting false for services it has none for.
+type ThresholdSource interface {
+	Threshold(tenantID, service string) (float64, bool)
+}
+
+// WithThresholds detects anomalies with the tuned threshold of the investigated service when the request sets
+// no anomaly threshold and the threshold_tuning flag is on for the tenant. Thresholds set on the request
+// always win.
+func WithThresholds(source ThresholdSource) PipelineOption {
+	return func(p *Pipeline) {
+		p.thresholds = source
+	}
+}
+
+// anomalyThreshold returns the threshold to investigate service with.
+func (p *Pipeline) anomalyThreshold(req models.InvestigationRequest, service string) float64 {
+	if req.AnomalyThreshold > 0 || p.thresholds == nil {
+		return req.AnomalyThreshold
+	}
+	// The source is asked even with the flag off so it learns the tenant and keeps recommendations current.
+	threshold, ok := p.thresholds.Threshold(req.TenantID, service)
+	if !ok || !p.features.Enabled(features.ThresholdTuning, req.TenantID) {
+		return req.AnomalyThreshold
+	}
+	return threshold
+}
+
+// TunesThresholds reports whether investigations of tenantID that set no anomaly threshold use tuned ones.
+func (p *Pipeline) TunesThresholds(tenantID string) bool {
+	return p != nil && p.thresholds != nil && p.features.Enabled(features.ThresholdTuning, tenantID)
+}
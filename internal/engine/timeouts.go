@@ -0,0 +1,32 @@
+package engine
+
+import (
+	"context"
+	"time"
+)
+
+// Timeouts bounds each mirador-core call and the investigation as a whole so one slow source cannot consume the
+// entire latency budget. Zero values leave the corresponding context unbounded (the client timeout still applies).
+type Timeouts struct {
+	Metrics      time.Duration
+	Logs         time.Duration
+	Traces       time.Duration
+	ServiceGraph time.Duration
+	Baseline     time.Duration
+	// Investigation is the overall deadline for fetching, analysis, and persistence.
+	Investigation time.Duration
+}
+
+// WithTimeouts enforces per-signal and total investigation deadlines via derived contexts.
+func WithTimeouts(timeouts Timeouts) PipelineOption {
+	return func(p *Pipeline) {
+		p.timeouts = timeouts
+	}
+}
+
+func withTimeout(ctx context.Context, d time.Duration) (context.Context, context.CancelFunc) {
+	if d <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, d)
+}
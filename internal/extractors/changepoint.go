@@ -0,0 +1,153 @@
+package extractors
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+	"github.com/miradorstack/mirador-rca/internal/repo"
+)
+
+// ChangePoint marks a sustained level shift in a metric series.
+type ChangePoint struct {
+	Metric      string
+	Timestamp   time.Time
+	BeforeMean  float64
+	AfterMean   float64
+	Score       float64
+	Threshold   float64
+	SampleIndex int
+}
+
+// ChangePointExtractor finds level shifts using CUSUM-driven binary segmentation, complementing the
+// spike-oriented z-score detector for gradual regressions.
+type ChangePointExtractor struct {
+	threshold  float64
+	minSegment int
+	maxChanges int
+}
+
+// NewChangePointExtractor constructs a detector with default threshold (2.0), minimum segment length (3) and
+// at most 3 reported change points per series.
+func NewChangePointExtractor() *ChangePointExtractor {
+	return &ChangePointExtractor{threshold: 2.0, minSegment: 3, maxChanges: 3}
+}
+
+// Name identifies the extractor in the registry.
+func (e *ChangePointExtractor) Name() string { return "changepoint" }
+
+// Extract adapts Detect to the Extractor interface.
+func (e *ChangePointExtractor) Extract(_ context.Context, in Input) []Anomaly {
+	detected := e.Detect(in.Metrics)
+	anomalies := make([]Anomaly, 0, len(detected))
+	for _, cp := range detected {
+		anomalies = append(anomalies, Anomaly{
+			Detector:  e.Name(),
+			DataType:  models.DataTypeMetrics,
+			Selector:  MetricSelector(cp.Metric),
+			Event:     fmt.Sprintf("Metric level shift (%.2f -> %.2f)", cp.BeforeMean, cp.AfterMean),
+			Timestamp: cp.Timestamp,
+			Value:     cp.AfterMean,
+			Score:     cp.Score,
+			Threshold: cp.Threshold,
+		})
+	}
+	return anomalies
+}
+
+// Detect returns change points per metric series, ordered by time within each series. The score is the absolute
+// mean difference between the segments either side of the change, normalised by their pooled standard deviation.
+func (e *ChangePointExtractor) Detect(series []repo.MetricPoint) []ChangePoint {
+	var points []ChangePoint
+	for _, group := range groupByMetric(series) {
+		points = append(points, e.detectSeries(group)...)
+	}
+	return points
+}
+
+func (e *ChangePointExtractor) detectSeries(series []repo.MetricPoint) []ChangePoint {
+	if len(series) < 2*e.minSegment {
+		return nil
+	}
+
+	sorted := append([]repo.MetricPoint(nil), series...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp.Before(sorted[j].Timestamp)
+	})
+	values := make([]float64, len(sorted))
+	for i, p := range sorted {
+		values[i] = p.Value
+	}
+
+	var points []ChangePoint
+	segments := [][2]int{{0, len(values)}}
+	for len(segments) > 0 && len(points) < e.maxChanges {
+		seg := segments[0]
+		segments = segments[1:]
+
+		idx, score := e.bestSplit(values[seg[0]:seg[1]])
+		if idx < 0 || score < e.threshold {
+			continue
+		}
+		split := seg[0] + idx
+		points = append(points, ChangePoint{
+			Metric:      sorted[split].Name,
+			Timestamp:   sorted[split].Timestamp,
+			BeforeMean:  mean(values[seg[0]:split]),
+			AfterMean:   mean(values[split:seg[1]]),
+			Score:       score,
+			Threshold:   e.threshold,
+			SampleIndex: split,
+		})
+		segments = append(segments, [2]int{seg[0], split}, [2]int{split, seg[1]})
+	}
+
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].SampleIndex < points[j].SampleIndex
+	})
+	return points
+}
+
+// bestSplit locates the CUSUM extremum of the segment and scores the resulting shift.
+func (e *ChangePointExtractor) bestSplit(values []float64) (int, float64) {
+	n := len(values)
+	if n < 2*e.minSegment {
+		return -1, 0
+	}
+	avg := mean(values)
+	cusum := 0.0
+	best, bestAbs := -1, 0.0
+	for k := 0; k < n-1; k++ {
+		cusum += values[k] - avg
+		split := k + 1
+		if split < e.minSegment || n-split < e.minSegment {
+			continue
+		}
+		if math.Abs(cusum) > bestAbs {
+			bestAbs = math.Abs(cusum)
+			best = split
+		}
+	}
+	if best < 0 {
+		return -1, 0
+	}
+
+	left, right := values[:best], values[best:]
+	leftMean, rightMean := mean(left), mean(right)
+	pooled := math.Sqrt((sumSquares(left, leftMean) + sumSquares(right, rightMean)) / float64(n))
+	if pooled == 0 {
+		pooled = 0.01
+	}
+	return best, math.Abs(rightMean-leftMean) / pooled
+}
+
+func sumSquares(values []float64, center float64) float64 {
+	sum := 0.0
+	for _, v := range values {
+		sum += (v - center) * (v - center)
+	}
+	return sum
+}
@@ -0,0 +1,207 @@
+package extractors
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/metrics"
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// ExternalExtractor delegates scoring to an external model server (e.g. mirador-predict). When the server
+// is unreachable, times out, or returns an error, the configured local detectors run instead.
+type ExternalExtractor struct {
+	endpoint   string
+	httpClient *http.Client
+	fallback   []Extractor
+	logger     *slog.Logger
+}
+
+// NewExternalExtractor constructs an extractor that POSTs signal windows to endpoint.
+func NewExternalExtractor(endpoint string, timeout time.Duration, logger *slog.Logger, fallback ...Extractor) *ExternalExtractor {
+	if timeout <= 0 {
+		timeout = 2 * time.Second
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ExternalExtractor{
+		endpoint:   endpoint,
+		httpClient: &http.Client{Timeout: timeout},
+		fallback:   fallback,
+		logger:     logger,
+	}
+}
+
+// Name identifies the extractor in the registry.
+func (e *ExternalExtractor) Name() string { return "external" }
+
+// Extract scores the signal window remotely, falling back to local detectors on failure.
+func (e *ExternalExtractor) Extract(ctx context.Context, in Input) []Anomaly {
+	start := time.Now()
+	anomalies, err := e.score(ctx, in)
+	outcome := metrics.OutcomeSuccess
+	if err != nil {
+		outcome = metrics.OutcomeError
+		if errors.Is(err, context.DeadlineExceeded) || isTimeout(err) {
+			outcome = metrics.OutcomeTimeout
+		}
+	}
+	metrics.ObserveExternalScoring(time.Since(start), outcome)
+	if err == nil {
+		return anomalies
+	}
+
+	e.logger.Warn("external scoring failed; using local detectors", slog.String("endpoint", e.endpoint), slog.Any("error", err))
+	var local []Anomaly
+	for _, fallback := range e.fallback {
+		local = append(local, fallback.Extract(ctx, in)...)
+	}
+	return local
+}
+
+type externalSample struct {
+	Metric    string    `json:"metric,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+	Value     float64   `json:"value"`
+}
+
+type externalLog struct {
+	Timestamp time.Time `json:"timestamp"`
+	Message   string    `json:"message"`
+	Severity  string    `json:"severity"`
+	Count     int       `json:"count"`
+}
+
+type externalSpan struct {
+	TraceID    string    `json:"trace_id"`
+	Service    string    `json:"service"`
+	Operation  string    `json:"operation"`
+	DurationMs float64   `json:"duration_ms"`
+	Status     string    `json:"status"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+type externalAnomaly struct {
+	DataType  string    `json:"data_type"`
+	Service   string    `json:"service"`
+	Selector  string    `json:"selector"`
+	Event     string    `json:"event"`
+	Timestamp time.Time `json:"timestamp"`
+	Value     float64   `json:"value"`
+	Score     float64   `json:"score"`
+	Threshold float64   `json:"threshold"`
+}
+
+func (e *ExternalExtractor) score(ctx context.Context, in Input) ([]Anomaly, error) {
+	if e.endpoint == "" {
+		return nil, fmt.Errorf("external scoring endpoint not configured")
+	}
+
+	payload := struct {
+		TenantID  string           `json:"tenant_id"`
+		Service   string           `json:"service"`
+		Threshold float64          `json:"threshold"`
+		Metrics   []externalSample `json:"metrics"`
+		Logs      []externalLog    `json:"logs"`
+		Traces    []externalSpan   `json:"traces"`
+	}{
+		TenantID:  in.TenantID,
+		Service:   in.Service,
+		Threshold: in.Threshold,
+		Metrics:   make([]externalSample, 0, len(in.Metrics)),
+		Logs:      make([]externalLog, 0, len(in.Logs)),
+		Traces:    make([]externalSpan, 0, len(in.Traces)),
+	}
+	for _, m := range in.Metrics {
+		payload.Metrics = append(payload.Metrics, externalSample{Metric: m.Name, Timestamp: m.Timestamp, Value: m.Value})
+	}
+	for _, l := range in.Logs {
+		payload.Logs = append(payload.Logs, externalLog{Timestamp: l.Timestamp, Message: l.Message, Severity: l.Severity, Count: l.Count})
+	}
+	for _, s := range in.Traces {
+		payload.Traces = append(payload.Traces, externalSpan{
+			TraceID:    s.TraceID,
+			Service:    s.Service,
+			Operation:  s.Operation,
+			DurationMs: float64(s.Duration) / float64(time.Millisecond),
+			Status:     s.Status,
+			Timestamp:  s.Timestamp,
+		})
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("encode scoring payload: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 2048))
+		return nil, fmt.Errorf("model server returned %d: %s", resp.StatusCode, string(msg))
+	}
+
+	var response struct {
+		Anomalies []externalAnomaly `json:"anomalies"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("decode scoring response: %w", err)
+	}
+
+	threshold := in.Threshold
+	if threshold <= 0 {
+		threshold = 2.5
+	}
+	anomalies := make([]Anomaly, 0, len(response.Anomalies))
+	for _, a := range response.Anomalies {
+		limit := threshold
+		if a.Threshold > 0 {
+			limit = a.Threshold
+		}
+		if a.Score < limit {
+			continue
+		}
+		event := a.Event
+		if event == "" {
+			event = "Model-scored anomaly"
+		}
+		dataType := models.DataType(a.DataType)
+		if dataType == "" {
+			dataType = models.DataTypeMetrics
+		}
+		anomalies = append(anomalies, Anomaly{
+			Detector:  e.Name(),
+			DataType:  dataType,
+			Service:   a.Service,
+			Selector:  a.Selector,
+			Event:     event,
+			Timestamp: a.Timestamp,
+			Value:     a.Value,
+			Score:     a.Score,
+			Threshold: limit,
+		})
+	}
+	return anomalies, nil
+}
+
+func isTimeout(err error) bool {
+	var timeout interface{ Timeout() bool }
+	return errors.As(err, &timeout) && timeout.Timeout()
+}
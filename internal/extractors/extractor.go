@@ -0,0 +1,44 @@
+package extractors
+
+import (
+	"context"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+	"github.com/miradorstack/mirador-rca/internal/repo"
+)
+
+// Input bundles the signals and parameters handed to every extractor.
+type Input struct {
+	TenantID  string
+	Service   string
+	Threshold float64
+	Metrics   []repo.MetricPoint
+	// Baseline holds the same window from an earlier period; when present metric scoring is relative to it.
+	Baseline []repo.MetricPoint
+	Logs     []repo.LogEntry
+	Traces   []repo.TraceSpan
+}
+
+// Anomaly is the detector-agnostic representation consumed by the pipeline.
+type Anomaly struct {
+	Detector  string
+	DataType  models.DataType
+	Service   string
+	Selector  string
+	Event     string
+	Timestamp time.Time
+	Value     float64
+	Score     float64
+	Threshold float64
+	// Severity overrides the score-derived severity when set.
+	Severity models.Severity
+	// Direction says whether the signal spiked or dropped; empty when the detector does not tell.
+	Direction models.Direction
+}
+
+// Extractor is implemented by every anomaly detector the pipeline can run.
+type Extractor interface {
+	Name() string
+	Extract(ctx context.Context, in Input) []Anomaly
+}
@@ -0,0 +1,135 @@
+package extractors
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/miradorstack/mirador-rca/internal/repo"
+)
+
+// SignatureKind classifies a failure signature parsed from a log message.
+type SignatureKind string
+
+const (
+	// SignatureHTTP is an HTTP status code (4xx/5xx).
+	SignatureHTTP SignatureKind = "http"
+	// SignatureGRPC is a gRPC status code.
+	SignatureGRPC SignatureKind = "grpc"
+	// SignatureException is an exception or error class name.
+	SignatureException SignatureKind = "exception"
+)
+
+// FailureSignature is a concrete failure fingerprint and how often it was observed.
+type FailureSignature struct {
+	Kind  SignatureKind
+	Code  string
+	Count int
+}
+
+// Key renders the signature for selectors, e.g. "http_503" or "grpc_unavailable".
+func (s FailureSignature) Key() string {
+	return string(s.Kind) + "_" + strings.ToLower(s.Code)
+}
+
+// Label renders the signature for human-facing events, e.g. "503" or "gRPC Unavailable".
+func (s FailureSignature) Label() string {
+	if s.Kind == SignatureGRPC {
+		return "gRPC " + s.Code
+	}
+	return s.Code
+}
+
+var (
+	httpStatusPattern = regexp.MustCompile(`(?i)\b(?:http(?:/\d(?:\.\d)?)?|status(?:[ _-]?code)?)[\s=:]*([45]\d{2})\b`)
+	httpReasonPattern = regexp.MustCompile(`\b([45]\d{2})\s+(?:Bad Request|Unauthorized|Forbidden|Not Found|Conflict|Too Many Requests|Internal Server Error|Bad Gateway|Service Unavailable|Gateway Timeout)\b`)
+	grpcCodePattern   = regexp.MustCompile(`(?i)(?:rpc error: code|grpc[-_ ]?(?:status|code))\s*[=:]\s*([A-Za-z_]+)`)
+	exceptionPattern  = regexp.MustCompile(`\b((?:[A-Za-z_$][\w$]*\.)*[A-Z][\w$]*(?:Exception|Error))\b`)
+)
+
+// grpcCodes maps lower-cased gRPC code spellings (CamelCase and SCREAMING_CASE) to their canonical names.
+var grpcCodes = map[string]string{
+	"canceled":           "Canceled",
+	"cancelled":          "Canceled",
+	"unknown":            "Unknown",
+	"invalidargument":    "InvalidArgument",
+	"deadlineexceeded":   "DeadlineExceeded",
+	"notfound":           "NotFound",
+	"alreadyexists":      "AlreadyExists",
+	"permissiondenied":   "PermissionDenied",
+	"resourceexhausted":  "ResourceExhausted",
+	"failedprecondition": "FailedPrecondition",
+	"aborted":            "Aborted",
+	"outofrange":         "OutOfRange",
+	"unimplemented":      "Unimplemented",
+	"internal":           "Internal",
+	"unavailable":        "Unavailable",
+	"dataloss":           "DataLoss",
+	"unauthenticated":    "Unauthenticated",
+}
+
+// ParseSignatures extracts HTTP status codes, gRPC codes, and exception class names from a log message.
+// Each distinct signature is reported once with a count of 1.
+func ParseSignatures(message string) []FailureSignature {
+	if message == "" {
+		return nil
+	}
+
+	seen := make(map[string]struct{})
+	signatures := make([]FailureSignature, 0)
+	add := func(kind SignatureKind, code string) {
+		sig := FailureSignature{Kind: kind, Code: code, Count: 1}
+		if _, ok := seen[sig.Key()]; ok {
+			return
+		}
+		seen[sig.Key()] = struct{}{}
+		signatures = append(signatures, sig)
+	}
+
+	for _, pattern := range []*regexp.Regexp{httpStatusPattern, httpReasonPattern} {
+		for _, match := range pattern.FindAllStringSubmatch(message, -1) {
+			add(SignatureHTTP, match[1])
+		}
+	}
+	for _, match := range grpcCodePattern.FindAllStringSubmatch(message, -1) {
+		if code, ok := grpcCodes[strings.ToLower(strings.ReplaceAll(match[1], "_", ""))]; ok {
+			add(SignatureGRPC, code)
+		}
+	}
+	for _, match := range exceptionPattern.FindAllStringSubmatch(message, -1) {
+		add(SignatureException, match[1])
+	}
+	return signatures
+}
+
+// AggregateSignatures parses every entry and sums signature occurrences weighted by the entry count,
+// most frequent first.
+func AggregateSignatures(entries []repo.LogEntry) []FailureSignature {
+	totals := make(map[string]*FailureSignature)
+	for _, entry := range entries {
+		weight := entry.Count
+		if weight <= 0 {
+			weight = 1
+		}
+		for _, sig := range ParseSignatures(entry.Message) {
+			if existing, ok := totals[sig.Key()]; ok {
+				existing.Count += weight
+				continue
+			}
+			sig.Count = weight
+			totals[sig.Key()] = &sig
+		}
+	}
+
+	aggregated := make([]FailureSignature, 0, len(totals))
+	for _, sig := range totals {
+		aggregated = append(aggregated, *sig)
+	}
+	sort.Slice(aggregated, func(i, j int) bool {
+		if aggregated[i].Count != aggregated[j].Count {
+			return aggregated[i].Count > aggregated[j].Count
+		}
+		return aggregated[i].Key() < aggregated[j].Key()
+	})
+	return aggregated
+}
@@ -1,11 +1,14 @@
 package extractors
 
 import (
+	"context"
+	"fmt"
 	"math"
 	"sort"
 	"strings"
 	"time"
 
+	"github.com/miradorstack/mirador-rca/internal/models"
 	"github.com/miradorstack/mirador-rca/internal/repo"
 )
 
@@ -15,6 +18,9 @@ type LogAnomaly struct {
 	Severity  string
 	Count     int
 	Score     float64
+	// Signatures lists failure fingerprints (status codes, exception classes) parsed from the message,
+	// ordered by how often each occurs across the whole window.
+	Signatures []FailureSignature
 }
 
 // LogsExtractor spots volume spikes vs baseline.
@@ -25,6 +31,38 @@ func NewLogsExtractor() *LogsExtractor {
 	return &LogsExtractor{}
 }
 
+// Name identifies the extractor in the registry.
+func (e *LogsExtractor) Name() string { return "logs" }
+
+// Extract adapts Detect to the Extractor interface.
+func (e *LogsExtractor) Extract(_ context.Context, in Input) []Anomaly {
+	detected := e.Detect(in.Logs)
+	anomalies := make([]Anomaly, 0, len(detected))
+	for _, l := range detected {
+		selector := fmt.Sprintf("logs:%s", l.Severity)
+		event := fmt.Sprintf("Log spike (%s)", l.Severity)
+		if len(l.Signatures) > 0 {
+			sig := l.Signatures[0]
+			selector = fmt.Sprintf("%s:%s", selector, sig.Key())
+			event = fmt.Sprintf("Surge of %s", sig.Label())
+			if in.Service != "" {
+				event = fmt.Sprintf("%s from %s", event, in.Service)
+			}
+		}
+		anomalies = append(anomalies, Anomaly{
+			Detector:  e.Name(),
+			DataType:  models.DataTypeLogs,
+			Selector:  selector,
+			Event:     event,
+			Timestamp: l.Timestamp,
+			Value:     float64(l.Count),
+			Score:     l.Score,
+			Threshold: 3,
+		})
+	}
+	return anomalies
+}
+
 // Detect identifies log spikes using simple deviation from the rolling median.
 func (e *LogsExtractor) Detect(entries []repo.LogEntry) []LogAnomaly {
 	if len(entries) == 0 {
@@ -42,24 +80,34 @@ func (e *LogsExtractor) Detect(entries []repo.LogEntry) []LogAnomaly {
 		mad = 1
 	}
 
+	totals := make(map[string]int)
+	for _, sig := range AggregateSignatures(entries) {
+		totals[sig.Key()] = sig.Count
+	}
+
 	anomalies := make([]LogAnomaly, 0)
 	for _, entry := range entries {
 		score := math.Abs(float64(entry.Count)-median) / mad
-		if score >= 3 {
-			anomalies = append(anomalies, LogAnomaly{
-				Timestamp: entry.Timestamp,
-				Severity:  entry.Severity,
-				Count:     entry.Count,
-				Score:     score,
-			})
-		} else if strings.EqualFold(entry.Severity, "error") && entry.Count > int(median*1.3) {
-			anomalies = append(anomalies, LogAnomaly{
-				Timestamp: entry.Timestamp,
-				Severity:  entry.Severity,
-				Count:     entry.Count,
-				Score:     3,
-			})
+		if score < 3 {
+			if !strings.EqualFold(entry.Severity, "error") || entry.Count <= int(median*1.3) {
+				continue
+			}
+			score = 3
+		}
+		signatures := ParseSignatures(entry.Message)
+		for i := range signatures {
+			signatures[i].Count = totals[signatures[i].Key()]
 		}
+		sort.SliceStable(signatures, func(i, j int) bool {
+			return signatures[i].Count > signatures[j].Count
+		})
+		anomalies = append(anomalies, LogAnomaly{
+			Timestamp:  entry.Timestamp,
+			Severity:   entry.Severity,
+			Count:      entry.Count,
+			Score:      score,
+			Signatures: signatures,
+		})
 	}
 	return anomalies
 }
@@ -1,18 +1,22 @@
 package extractors
 
 import (
+	"context"
 	"math"
 	"time"
 
+	"github.com/miradorstack/mirador-rca/internal/models"
 	"github.com/miradorstack/mirador-rca/internal/repo"
 )
 
-// MetricAnomaly captures an anomalous metric sample.
+// MetricAnomaly captures an anomalous metric sample. Score is the absolute deviation; Direction carries the sign.
 type MetricAnomaly struct {
+	Metric    string
 	Timestamp time.Time
 	Value     float64
 	Score     float64
 	Threshold float64
+	Direction models.Direction
 }
 
 // MetricExtractor detects anomalies using a z-score approach as an STL+ESD stand-in.
@@ -23,44 +27,150 @@ func NewMetricExtractor() *MetricExtractor {
 	return &MetricExtractor{}
 }
 
-// Detect finds metric anomalies exceeding the provided threshold.
+// Name identifies the extractor in the registry.
+func (e *MetricExtractor) Name() string { return "metrics" }
+
+// Extract adapts Detect to the Extractor interface.
+func (e *MetricExtractor) Extract(_ context.Context, in Input) []Anomaly {
+	detected := e.DetectAgainstBaseline(in.Metrics, in.Baseline, in.Threshold)
+	anomalies := make([]Anomaly, 0, len(detected))
+	for _, m := range detected {
+		event := "Metric anomaly detected"
+		if m.Direction == models.DirectionDown {
+			event = "Metric drop detected"
+		}
+		anomalies = append(anomalies, Anomaly{
+			Detector:  e.Name(),
+			DataType:  models.DataTypeMetrics,
+			Selector:  MetricSelector(m.Metric),
+			Event:     event,
+			Timestamp: m.Timestamp,
+			Value:     m.Value,
+			Score:     m.Score,
+			Threshold: m.Threshold,
+			Direction: m.Direction,
+		})
+	}
+	return anomalies
+}
+
+// Detect finds metric anomalies whose deviation exceeds the provided threshold in either direction.
+// Samples are grouped by metric name and each series is scored against its own baseline.
 func (e *MetricExtractor) Detect(series []repo.MetricPoint, threshold float64) []MetricAnomaly {
+	return e.DetectAgainstBaseline(series, nil, threshold)
+}
+
+// DetectAgainstBaseline scores each metric series against the matching historical series sample by sample,
+// so expected daily peaks present in the baseline do not register as anomalies. Metrics without enough baseline
+// samples fall back to their own mean and deviation.
+func (e *MetricExtractor) DetectAgainstBaseline(series, baseline []repo.MetricPoint, threshold float64) []MetricAnomaly {
 	if len(series) == 0 {
 		return nil
 	}
 
 	if threshold <= 0 {
-		threshold = 2.5
+		threshold = DefaultMetricThreshold
 	}
 
-	mean := 0.0
-	for _, point := range series {
-		mean += point.Value
+	history := make(map[string][]repo.MetricPoint)
+	for _, group := range groupByMetric(baseline) {
+		history[group[0].Name] = group
 	}
-	mean /= float64(len(series))
 
-	variance := 0.0
-	for _, point := range series {
-		variance += math.Pow(point.Value-mean, 2)
+	anomalies := make([]MetricAnomaly, 0)
+	for _, group := range groupByMetric(series) {
+		reference := history[group[0].Name]
+		if len(reference) < minBaselineSamples {
+			reference = nil
+		}
+		anomalies = append(anomalies, detectSeries(group, reference, threshold)...)
+	}
+	return anomalies
+}
+
+// MetricSelector renders the anchor selector for a metric; unnamed legacy series map to cpu_usage.
+func MetricSelector(name string) string {
+	if name == "" {
+		name = defaultMetricName
 	}
-	variance /= float64(len(series))
-	stdDev := math.Sqrt(variance)
+	return "metrics:" + name
+}
+
+// DefaultMetricThreshold is the deviation, in standard deviations, a metric sample must reach when the
+// request sets no anomaly threshold.
+const DefaultMetricThreshold = 2.5
+
+const (
+	defaultMetricName  = "cpu_usage"
+	minBaselineSamples = 3
+)
+
+// detectSeries scores samples against the series mean, or, when a historical reference is supplied, against
+// the reference sample at the same relative position in the window using the reference's spread.
+func detectSeries(series, reference []repo.MetricPoint, threshold float64) []MetricAnomaly {
+	spread := series
+	if reference != nil {
+		spread = reference
+	}
+	mean, stdDev := meanStdDev(spread)
 	if stdDev == 0 {
 		stdDev = 0.01
 	}
 
 	anomalies := make([]MetricAnomaly, 0)
-	for _, point := range series {
-		score := (point.Value - mean) / stdDev
+	for i, point := range series {
+		expected := mean
+		if reference != nil {
+			expected = reference[i*len(reference)/len(series)].Value
+		}
+		score := (point.Value - expected) / stdDev
+		direction := models.DirectionUp
+		if score < 0 {
+			direction = models.DirectionDown
+			score = -score
+		}
 		if score >= threshold {
 			anomalies = append(anomalies, MetricAnomaly{
+				Metric:    point.Name,
 				Timestamp: point.Timestamp,
 				Value:     point.Value,
 				Score:     score,
 				Threshold: threshold,
+				Direction: direction,
 			})
 		}
 	}
 
 	return anomalies
 }
+
+func meanStdDev(points []repo.MetricPoint) (float64, float64) {
+	mean := 0.0
+	for _, point := range points {
+		mean += point.Value
+	}
+	mean /= float64(len(points))
+
+	variance := 0.0
+	for _, point := range points {
+		variance += math.Pow(point.Value-mean, 2)
+	}
+	variance /= float64(len(points))
+	return mean, math.Sqrt(variance)
+}
+
+// groupByMetric splits samples into per-metric series, preserving first-seen metric order.
+func groupByMetric(points []repo.MetricPoint) [][]repo.MetricPoint {
+	index := make(map[string]int)
+	groups := make([][]repo.MetricPoint, 0, 1)
+	for _, point := range points {
+		i, ok := index[point.Name]
+		if !ok {
+			i = len(groups)
+			index[point.Name] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], point)
+	}
+	return groups
+}
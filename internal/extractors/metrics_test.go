@@ -1,9 +1,15 @@
 package extractors
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/miradorstack/mirador-rca/internal/models"
 	"github.com/miradorstack/mirador-rca/internal/repo"
 )
 
@@ -49,6 +55,47 @@ func TestLogsExtractorDetect(t *testing.T) {
 	}
 }
 
+func TestParseSignatures(t *testing.T) {
+	message := "upstream returned HTTP 503 Service Unavailable: rpc error: code = Unavailable desc = java.net.ConnectException"
+	signatures := ParseSignatures(message)
+
+	keys := make([]string, 0, len(signatures))
+	for _, sig := range signatures {
+		keys = append(keys, sig.Key())
+	}
+	want := []string{"http_503", "grpc_unavailable", "exception_java.net.connectexception"}
+	if strings.Join(keys, ",") != strings.Join(want, ",") {
+		t.Fatalf("expected %v, got %v", want, keys)
+	}
+
+	if got := ParseSignatures("served 1200 requests in 503ms"); len(got) != 0 {
+		t.Fatalf("expected bare numbers to be ignored, got %+v", got)
+	}
+}
+
+func TestLogsExtractorReferencesFailureSignature(t *testing.T) {
+	extractor := NewLogsExtractor()
+
+	start := time.Now().Add(-10 * time.Minute)
+	entries := []repo.LogEntry{
+		{Timestamp: start, Severity: "info", Message: "request served", Count: 10},
+		{Timestamp: start.Add(time.Minute), Severity: "info", Message: "request served", Count: 11},
+		{Timestamp: start.Add(2 * time.Minute), Severity: "info", Message: "request served", Count: 9},
+		{Timestamp: start.Add(3 * time.Minute), Severity: "error", Message: "payments call failed: status=503", Count: 80},
+	}
+
+	anomalies := extractor.Extract(context.Background(), Input{Service: "payments", Logs: entries})
+	if len(anomalies) != 1 {
+		t.Fatalf("expected one log anomaly, got %+v", anomalies)
+	}
+	if anomalies[0].Selector != "logs:error:http_503" {
+		t.Fatalf("unexpected selector %q", anomalies[0].Selector)
+	}
+	if anomalies[0].Event != "Surge of 503 from payments" {
+		t.Fatalf("unexpected event %q", anomalies[0].Event)
+	}
+}
+
 func TestTracesExtractorDetect(t *testing.T) {
 	extractor := NewTracesExtractor()
 
@@ -77,3 +124,180 @@ func TestTracesExtractorDetect(t *testing.T) {
 		t.Fatalf("expected trace anomalies, got none")
 	}
 }
+
+func TestTracesExtractorBaselinesPerOperation(t *testing.T) {
+	extractor := NewTracesExtractor()
+
+	start := time.Now().Add(-5 * time.Minute)
+	spans := make([]repo.TraceSpan, 0, 16)
+	for i := 0; i < 8; i++ {
+		fast := 20 * time.Millisecond
+		if i == 7 {
+			fast = 90 * time.Millisecond
+		}
+		spans = append(spans,
+			repo.TraceSpan{Service: "checkout", Operation: "GET /cart", Duration: fast, Status: "ok", Timestamp: start.Add(time.Duration(i) * time.Second)},
+			repo.TraceSpan{Service: "checkout", Operation: "POST /report", Duration: time.Duration(900+i*10) * time.Millisecond, Status: "ok", Timestamp: start.Add(time.Duration(i) * time.Second)},
+		)
+	}
+
+	anomalies := extractor.Detect(spans)
+	if len(anomalies) != 1 {
+		t.Fatalf("expected only the fast-operation outlier, got %+v", anomalies)
+	}
+	if anomalies[0].Span.Operation != "GET /cart" {
+		t.Fatalf("expected GET /cart outlier, got %s", anomalies[0].Span.Operation)
+	}
+}
+
+func TestRegistryTenantOverride(t *testing.T) {
+	registry := NewDefaultRegistry()
+	if got := len(registry.ForTenant("any")); got != 3 {
+		t.Fatalf("expected 3 default extractors, got %d", got)
+	}
+	if err := registry.SetTenant("tenant-a", []string{"logs"}); err != nil {
+		t.Fatalf("set tenant: %v", err)
+	}
+	enabled := registry.ForTenant("tenant-a")
+	if len(enabled) != 1 || enabled[0].Name() != "logs" {
+		t.Fatalf("unexpected tenant extractors: %v", enabled)
+	}
+	if err := registry.SetTenant("tenant-b", []string{"unknown"}); err == nil {
+		t.Fatalf("expected error for unknown extractor")
+	}
+	if err := registry.Register(NewLogsExtractor()); err == nil {
+		t.Fatalf("expected duplicate registration to fail")
+	}
+}
+
+func TestChangePointExtractorDetectsLevelShift(t *testing.T) {
+	extractor := NewChangePointExtractor()
+
+	start := time.Now().Add(-20 * time.Minute)
+	series := make([]repo.MetricPoint, 0, 20)
+	for i := 0; i < 20; i++ {
+		value := 1.0 + 0.02*float64(i%3)
+		if i >= 12 {
+			value = 1.6 + 0.02*float64(i%3)
+		}
+		series = append(series, repo.MetricPoint{Timestamp: start.Add(time.Duration(i) * time.Minute), Value: value})
+	}
+
+	points := extractor.Detect(series)
+	if len(points) == 0 {
+		t.Fatalf("expected change point, got none")
+	}
+	if !points[0].Timestamp.Equal(series[12].Timestamp) {
+		t.Fatalf("expected change at sample 12, got %v", points[0].SampleIndex)
+	}
+	if points[0].AfterMean <= points[0].BeforeMean {
+		t.Fatalf("expected upward shift: %+v", points[0])
+	}
+}
+
+func TestMetricExtractorDetectsDrop(t *testing.T) {
+	extractor := NewMetricExtractor()
+
+	start := time.Now().Add(-15 * time.Minute)
+	series := make([]repo.MetricPoint, 0, 15)
+	for i := 0; i < 15; i++ {
+		value := 100.0
+		if i == 12 {
+			value = 5
+		}
+		series = append(series, repo.MetricPoint{Timestamp: start.Add(time.Duration(i) * time.Minute), Value: value})
+	}
+
+	anomalies := extractor.Detect(series, 2.5)
+	if len(anomalies) != 1 {
+		t.Fatalf("expected one drop anomaly, got %d", len(anomalies))
+	}
+	if anomalies[0].Direction != models.DirectionDown || anomalies[0].Score <= 0 {
+		t.Fatalf("expected positive-magnitude drop, got %+v", anomalies[0])
+	}
+	extracted := extractor.Extract(context.Background(), Input{Metrics: series, Threshold: 2.5})
+	if len(extracted) != 1 || extracted[0].Event != "Metric drop detected" || extracted[0].Direction != models.DirectionDown {
+		t.Fatalf("unexpected extracted anomalies: %+v", extracted)
+	}
+}
+
+func TestMetricExtractorScoresEachSeries(t *testing.T) {
+	extractor := NewMetricExtractor()
+
+	start := time.Now().Add(-15 * time.Minute)
+	series := make([]repo.MetricPoint, 0, 30)
+	for i := 0; i < 15; i++ {
+		ts := start.Add(time.Duration(i) * time.Minute)
+		latency := 120.0
+		if i == 14 {
+			latency = 900
+		}
+		series = append(series,
+			repo.MetricPoint{Name: "latency_p95", Timestamp: ts, Value: latency},
+			repo.MetricPoint{Name: "error_rate", Timestamp: ts, Value: 0.01},
+		)
+	}
+
+	anomalies := extractor.Extract(context.Background(), Input{Metrics: series, Threshold: 2.5})
+	if len(anomalies) != 1 {
+		t.Fatalf("expected a single latency anomaly, got %+v", anomalies)
+	}
+	if anomalies[0].Selector != "metrics:latency_p95" {
+		t.Fatalf("expected real metric selector, got %s", anomalies[0].Selector)
+	}
+}
+
+func TestMetricExtractorBaselineSuppressesExpectedPeak(t *testing.T) {
+	extractor := NewMetricExtractor()
+
+	start := time.Now().Add(-15 * time.Minute)
+	current := make([]repo.MetricPoint, 0, 15)
+	history := make([]repo.MetricPoint, 0, 15)
+	for i := 0; i < 15; i++ {
+		value := 100.0 + float64(i%3)
+		if i >= 13 {
+			value = 180 // daily peak
+		}
+		current = append(current, repo.MetricPoint{Timestamp: start.Add(time.Duration(i) * time.Minute), Value: value})
+		history = append(history, repo.MetricPoint{Timestamp: start.Add(time.Duration(i)*time.Minute - 7*24*time.Hour), Value: value + float64(i%2)*20})
+	}
+
+	if anomalies := extractor.Detect(current, 2.0); len(anomalies) == 0 {
+		t.Fatalf("expected peak to be anomalous without a baseline")
+	}
+	if anomalies := extractor.DetectAgainstBaseline(current, history, 2.0); len(anomalies) != 0 {
+		t.Fatalf("expected baseline to absorb the daily peak, got %+v", anomalies)
+	}
+}
+
+func TestExternalExtractorScoresAndFallsBack(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			Service string `json:"service"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"anomalies": []map[string]any{
+				{"data_type": "metrics", "service": payload.Service, "selector": "metrics:latency_p95", "score": 4.2},
+				{"data_type": "metrics", "service": payload.Service, "selector": "metrics:cpu_usage", "score": 0.4},
+			},
+		})
+	}))
+	defer server.Close()
+
+	in := Input{Service: "checkout", Logs: []repo.LogEntry{
+		{Severity: "info", Count: 10}, {Severity: "info", Count: 10}, {Severity: "error", Count: 90},
+	}}
+
+	remote := NewExternalExtractor(server.URL, time.Second, nil, NewLogsExtractor())
+	anomalies := remote.Extract(context.Background(), in)
+	if len(anomalies) != 1 || anomalies[0].Selector != "metrics:latency_p95" || anomalies[0].Detector != "external" {
+		t.Fatalf("unexpected remote anomalies: %+v", anomalies)
+	}
+
+	server.Close()
+	fallback := remote.Extract(context.Background(), in)
+	if len(fallback) == 0 || fallback[0].Detector != "logs" {
+		t.Fatalf("expected local fallback anomalies, got %+v", fallback)
+	}
+}
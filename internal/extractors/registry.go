@@ -0,0 +1,125 @@
+package extractors
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Registry holds the available extractors and which of them are enabled by default or per tenant.
+type Registry struct {
+	mu         sync.RWMutex
+	extractors map[string]Extractor
+	defaults   []string
+	tenants    map[string][]string
+}
+
+// NewRegistry constructs an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		extractors: make(map[string]Extractor),
+		tenants:    make(map[string][]string),
+	}
+}
+
+// NewDefaultRegistry returns a registry with all built-in detectors registered; only the metrics, logs, and
+// traces detectors are enabled by default.
+func NewDefaultRegistry() *Registry {
+	r := NewRegistry()
+	for _, e := range []Extractor{NewMetricExtractor(), NewLogsExtractor(), NewTracesExtractor(), NewChangePointExtractor()} {
+		_ = r.Register(e)
+	}
+	_ = r.SetDefault([]string{"metrics", "logs", "traces"})
+	return r
+}
+
+// Register adds an extractor under its Name; duplicate names are rejected.
+func (r *Registry) Register(e Extractor) error {
+	if e == nil || e.Name() == "" {
+		return fmt.Errorf("extractor must have a name")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.extractors[e.Name()]; exists {
+		return fmt.Errorf("extractor %q already registered", e.Name())
+	}
+	r.extractors[e.Name()] = e
+	return nil
+}
+
+// SetDefault selects the extractors used for tenants without an explicit override.
+func (r *Registry) SetDefault(names []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.validate(names); err != nil {
+		return err
+	}
+	r.defaults = append([]string(nil), names...)
+	return nil
+}
+
+// SetTenant overrides the enabled extractors for a single tenant.
+func (r *Registry) SetTenant(tenantID string, names []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.validate(names); err != nil {
+		return err
+	}
+	r.tenants[tenantID] = append([]string(nil), names...)
+	return nil
+}
+
+// ForTenant returns the enabled extractors for the tenant in configured order.
+func (r *Registry) ForTenant(tenantID string) []Extractor {
+	if r == nil {
+		return nil
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names, ok := r.tenants[tenantID]
+	if !ok {
+		names = r.defaults
+	}
+	result := make([]Extractor, 0, len(names))
+	for _, name := range names {
+		if e, ok := r.extractors[name]; ok {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+// Select returns the named extractors in the given order, rejecting names that are not registered.
+func (r *Registry) Select(names []string) ([]Extractor, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if err := r.validate(names); err != nil {
+		return nil, err
+	}
+	result := make([]Extractor, 0, len(names))
+	for _, name := range names {
+		result = append(result, r.extractors[name])
+	}
+	return result, nil
+}
+
+// Names lists all registered extractor names in sorted order.
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.extractors))
+	for name := range r.extractors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (r *Registry) validate(names []string) error {
+	for _, name := range names {
+		if _, ok := r.extractors[name]; !ok {
+			return fmt.Errorf("unknown extractor %q", name)
+		}
+	}
+	return nil
+}
@@ -1,8 +1,11 @@
 package extractors
 
 import (
+	"context"
+	"fmt"
 	"math"
 
+	"github.com/miradorstack/mirador-rca/internal/models"
 	"github.com/miradorstack/mirador-rca/internal/repo"
 )
 
@@ -13,41 +16,80 @@ type TraceAnomaly struct {
 	Median float64
 }
 
-// TracesExtractor detects slow/error spans using a simple z-score heuristic.
+// TracesExtractor detects slow/error spans using a z-score heuristic computed per service+operation.
 type TracesExtractor struct {
-	threshold float64
+	threshold  float64
+	minSamples int
 }
 
-// NewTracesExtractor constructs a TracesExtractor with default threshold (2.0).
+// NewTracesExtractor constructs a TracesExtractor with default threshold (2.0). Operations with fewer than
+// five spans are scored against the population baseline instead of their own.
 func NewTracesExtractor() *TracesExtractor {
-	return &TracesExtractor{threshold: 2.0}
+	return &TracesExtractor{threshold: 2.0, minSamples: 5}
 }
 
-// Detect returns spans whose duration significantly exceeds the population mean.
+// Name identifies the extractor in the registry.
+func (e *TracesExtractor) Name() string { return "traces" }
+
+// Extract adapts Detect to the Extractor interface; error spans are always reported as high severity.
+func (e *TracesExtractor) Extract(_ context.Context, in Input) []Anomaly {
+	detected := e.Detect(in.Traces)
+	anomalies := make([]Anomaly, 0, len(detected))
+	for _, t := range detected {
+		anomaly := Anomaly{
+			Detector:  e.Name(),
+			DataType:  models.DataTypeTraces,
+			Service:   t.Span.Service,
+			Selector:  fmt.Sprintf("trace:%s", t.Span.Operation),
+			Event:     fmt.Sprintf("Slow span: %s", t.Span.Operation),
+			Timestamp: t.Span.Timestamp,
+			Value:     t.Span.Duration.Seconds(),
+			Score:     t.Score,
+			Threshold: e.threshold,
+		}
+		if t.Span.Status == "error" {
+			anomaly.Severity = models.SeverityHigh
+		}
+		anomalies = append(anomalies, anomaly)
+	}
+	return anomalies
+}
+
+// Detect returns spans whose duration significantly exceeds the mean of their service+operation group,
+// so naturally slow operations are not penalised against fast ones.
 func (e *TracesExtractor) Detect(spans []repo.TraceSpan) []TraceAnomaly {
 	if len(spans) == 0 {
 		return nil
 	}
 
 	durations := make([]float64, len(spans))
+	groups := make(map[string][]float64)
 	for i, span := range spans {
 		durations[i] = span.Duration.Seconds()
+		key := operationKey(span)
+		groups[key] = append(groups[key], durations[i])
 	}
 
-	mean := mean(durations)
-	std := stdDev(durations, mean)
-	if std == 0 {
-		std = 0.01
+	global := newDurationBaseline(durations)
+	baselines := make(map[string]durationBaseline, len(groups))
+	for key, values := range groups {
+		if len(values) >= e.minSamples {
+			baselines[key] = newDurationBaseline(values)
+		}
 	}
 
 	anomalies := make([]TraceAnomaly, 0)
 	for i, span := range spans {
-		score := (durations[i] - mean) / std
+		baseline, ok := baselines[operationKey(span)]
+		if !ok {
+			baseline = global
+		}
+		score := (durations[i] - baseline.mean) / baseline.std
 		if score >= e.threshold || span.Status == "error" {
 			anomalies = append(anomalies, TraceAnomaly{
 				Span:   span,
 				Score:  score,
-				Median: mean,
+				Median: baseline.mean,
 			})
 		}
 	}
@@ -55,6 +97,24 @@ func (e *TracesExtractor) Detect(spans []repo.TraceSpan) []TraceAnomaly {
 	return anomalies
 }
 
+type durationBaseline struct {
+	mean float64
+	std  float64
+}
+
+func newDurationBaseline(values []float64) durationBaseline {
+	m := mean(values)
+	std := stdDev(values, m)
+	if std == 0 {
+		std = 0.01
+	}
+	return durationBaseline{mean: m, std: std}
+}
+
+func operationKey(span repo.TraceSpan) string {
+	return span.Service + "\x00" + span.Operation
+}
+
 func mean(values []float64) float64 {
 	total := 0.0
 	for _, v := range values {
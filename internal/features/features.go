@@ -0,0 +1,104 @@
+package features
+
+import (
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"sync/atomic"
+)
+
+// Flags consulted by the engine. A flag without a rule takes its default from Defaults.
+const (
+	// ParallelFetch fetches the service graph, metrics, logs, and traces of an investigation concurrently.
+	ParallelFetch = "parallel_fetch"
+	// PatternMatching reuses the recommendations of the most similar past incident before falling back to
+	// the rule pack.
+	PatternMatching = "pattern_matching"
+	// LLMNarration lets the configured language model rewrite the summary and suggest recommendations.
+	LLMNarration = "llm_narration"
+	// ThresholdTuning detects anomalies with the tuned per-service threshold when a request sets none.
+	ThresholdTuning = "threshold_tuning"
+)
+
+// Defaults holds the value of each known flag when no rule configures it; unknown flags default to off.
+var Defaults = map[string]bool{
+	ParallelFetch:   false,
+	PatternMatching: true,
+	LLMNarration:    true,
+	ThresholdTuning: false,
+}
+
+// Rule rolls a flag out. A disabled rule turns the flag off for every tenant. An enabled rule turns it on
+// for the listed Tenants and for Percentage percent of the rest; with neither set it is on for everyone. A nil
+// Percentage is unset, while zero enables no tenant beyond the listed ones.
+type Rule struct {
+	Enabled    bool
+	Tenants    []string
+	Percentage *float64
+}
+
+// Set evaluates flags per tenant. It is safe for concurrent use, and a nil *Set reports the defaults.
+type Set struct {
+	rules atomic.Pointer[map[string]Rule]
+}
+
+// NewSet validates rules and returns a Set that evaluates them.
+func NewSet(rules map[string]Rule) (*Set, error) {
+	s := &Set{}
+	if err := s.Update(rules); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Update validates rules and swaps them in; nothing changes on error.
+func (s *Set) Update(rules map[string]Rule) error {
+	copied := make(map[string]Rule, len(rules))
+	for name, rule := range rules {
+		if name == "" {
+			return fmt.Errorf("feature flag name must not be empty")
+		}
+		if p := rule.Percentage; p != nil && (*p < 0 || *p > 100) {
+			return fmt.Errorf("feature flag %s: percentage %v must be between 0 and 100", name, *p)
+		}
+		rule.Tenants = slices.Clone(rule.Tenants)
+		if rule.Percentage != nil {
+			percentage := *rule.Percentage
+			rule.Percentage = &percentage
+		}
+		copied[name] = rule
+	}
+	s.rules.Store(&copied)
+	return nil
+}
+
+// Enabled reports whether flag is on for tenantID. Percentage rollouts bucket tenants by a stable hash of
+// the flag and tenant, so raising the percentage only adds tenants and each flag picks a different subset.
+func (s *Set) Enabled(flag, tenantID string) bool {
+	if s == nil {
+		return Defaults[flag]
+	}
+	rule, ok := (*s.rules.Load())[flag]
+	if !ok {
+		return Defaults[flag]
+	}
+	switch {
+	case !rule.Enabled:
+		return false
+	case slices.Contains(rule.Tenants, tenantID):
+		return true
+	case rule.Percentage == nil:
+		return len(rule.Tenants) == 0
+	default:
+		return bucket(flag, tenantID) < *rule.Percentage
+	}
+}
+
+// bucket maps a flag and tenant to [0, 100) in steps of 0.01.
+func bucket(flag, tenantID string) float64 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(flag))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(tenantID))
+	return float64(h.Sum32()%10000) / 100
+}
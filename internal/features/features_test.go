@@ -0,0 +1,99 @@
+package features
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSetEnabled(t *testing.T) {
+	set, err := NewSet(map[string]Rule{
+		"everyone":    {Enabled: true},
+		"listed":      {Enabled: true, Tenants: []string{"acme"}},
+		"off":         {Enabled: false, Tenants: []string{"acme"}},
+		ParallelFetch: {Enabled: true, Percentage: percent(100)},
+	})
+	if err != nil {
+		t.Fatalf("new set: %v", err)
+	}
+
+	cases := []struct {
+		flag, tenant string
+		want         bool
+	}{
+		{"everyone", "globex", true},
+		{"listed", "acme", true},
+		{"listed", "globex", false},
+		{"off", "acme", false},
+		{"unknown", "acme", false},
+		{ParallelFetch, "globex", true},
+		{PatternMatching, "acme", true},
+	}
+	for _, tc := range cases {
+		if got := set.Enabled(tc.flag, tc.tenant); got != tc.want {
+			t.Errorf("Enabled(%q, %q) = %v, want %v", tc.flag, tc.tenant, got, tc.want)
+		}
+	}
+
+	var unset *Set
+	if unset.Enabled(ParallelFetch, "acme") || !unset.Enabled(PatternMatching, "acme") {
+		t.Fatalf("expected a nil set to report the defaults")
+	}
+}
+
+func TestSetPercentageRollout(t *testing.T) {
+	count := func(percentage float64) (map[string]bool, int) {
+		set, err := NewSet(map[string]Rule{"rollout": {Enabled: true, Percentage: percent(percentage)}})
+		if err != nil {
+			t.Fatalf("new set: %v", err)
+		}
+		on := map[string]bool{}
+		for i := 0; i < 1000; i++ {
+			tenant := fmt.Sprintf("tenant-%d", i)
+			if set.Enabled("rollout", tenant) {
+				on[tenant] = true
+			}
+		}
+		return on, len(on)
+	}
+
+	if _, n := count(0); n != 0 {
+		t.Fatalf("expected a 0%% rollout to enable no tenant, got %d", n)
+	}
+	quarter, n := count(25)
+	if n < 200 || n > 300 {
+		t.Fatalf("expected about 250 of 1000 tenants at 25%%, got %d", n)
+	}
+	half, _ := count(50)
+	for tenant := range quarter {
+		if !half[tenant] {
+			t.Fatalf("raising the percentage dropped tenant %s", tenant)
+		}
+	}
+
+	set, _ := NewSet(nil)
+	if err := set.Update(map[string]Rule{"rollout": {Enabled: true, Percentage: percent(120)}}); err == nil {
+		t.Fatalf("expected a percentage above 100 to be rejected")
+	}
+}
+
+func TestSetZeroPercentEnablesOnlyListedTenants(t *testing.T) {
+	set, err := NewSet(map[string]Rule{
+		LLMNarration: {Enabled: true, Percentage: percent(0)},
+		"pilot":      {Enabled: true, Tenants: []string{"acme"}, Percentage: percent(0)},
+	})
+	if err != nil {
+		t.Fatalf("new set: %v", err)
+	}
+	for _, tenant := range []string{"acme", "globex", "initech"} {
+		if set.Enabled(LLMNarration, tenant) {
+			t.Fatalf("expected a staged 0%% rollout to keep %s off despite the flag's default", tenant)
+		}
+	}
+	if !set.Enabled("pilot", "acme") || set.Enabled("pilot", "globex") {
+		t.Fatalf("expected a 0%% rollout with tenants to enable only the listed tenants")
+	}
+}
+
+func percent(value float64) *float64 {
+	return &value
+}
@@ -21,6 +21,119 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type CorrelationStatus int32
+
+const (
+	CorrelationStatus_CORRELATION_STATUS_UNSPECIFIED CorrelationStatus = 0
+	CorrelationStatus_CORRELATION_STATUS_OPEN        CorrelationStatus = 1
+	CorrelationStatus_CORRELATION_STATUS_CONFIRMED   CorrelationStatus = 2
+	CorrelationStatus_CORRELATION_STATUS_REJECTED    CorrelationStatus = 3
+	CorrelationStatus_CORRELATION_STATUS_RESOLVED    CorrelationStatus = 4
+)
+
+// Enum value maps for CorrelationStatus.
+var (
+	CorrelationStatus_name = map[int32]string{
+		0: "CORRELATION_STATUS_UNSPECIFIED",
+		1: "CORRELATION_STATUS_OPEN",
+		2: "CORRELATION_STATUS_CONFIRMED",
+		3: "CORRELATION_STATUS_REJECTED",
+		4: "CORRELATION_STATUS_RESOLVED",
+	}
+	CorrelationStatus_value = map[string]int32{
+		"CORRELATION_STATUS_UNSPECIFIED": 0,
+		"CORRELATION_STATUS_OPEN":        1,
+		"CORRELATION_STATUS_CONFIRMED":   2,
+		"CORRELATION_STATUS_REJECTED":    3,
+		"CORRELATION_STATUS_RESOLVED":    4,
+	}
+)
+
+func (x CorrelationStatus) Enum() *CorrelationStatus {
+	p := new(CorrelationStatus)
+	*p = x
+	return p
+}
+
+func (x CorrelationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CorrelationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_rca_proto_enumTypes[0].Descriptor()
+}
+
+func (CorrelationStatus) Type() protoreflect.EnumType {
+	return &file_rca_proto_enumTypes[0]
+}
+
+func (x CorrelationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CorrelationStatus.Descriptor instead.
+func (CorrelationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{0}
+}
+
+type RootCauseCategory int32
+
+const (
+	RootCauseCategory_ROOT_CAUSE_CATEGORY_UNSPECIFIED        RootCauseCategory = 0
+	RootCauseCategory_ROOT_CAUSE_CATEGORY_DEPLOYMENT         RootCauseCategory = 1
+	RootCauseCategory_ROOT_CAUSE_CATEGORY_CAPACITY           RootCauseCategory = 2
+	RootCauseCategory_ROOT_CAUSE_CATEGORY_DEPENDENCY_FAILURE RootCauseCategory = 3
+	RootCauseCategory_ROOT_CAUSE_CATEGORY_CONFIG             RootCauseCategory = 4
+	RootCauseCategory_ROOT_CAUSE_CATEGORY_NETWORK            RootCauseCategory = 5
+)
+
+// Enum value maps for RootCauseCategory.
+var (
+	RootCauseCategory_name = map[int32]string{
+		0: "ROOT_CAUSE_CATEGORY_UNSPECIFIED",
+		1: "ROOT_CAUSE_CATEGORY_DEPLOYMENT",
+		2: "ROOT_CAUSE_CATEGORY_CAPACITY",
+		3: "ROOT_CAUSE_CATEGORY_DEPENDENCY_FAILURE",
+		4: "ROOT_CAUSE_CATEGORY_CONFIG",
+		5: "ROOT_CAUSE_CATEGORY_NETWORK",
+	}
+	RootCauseCategory_value = map[string]int32{
+		"ROOT_CAUSE_CATEGORY_UNSPECIFIED":        0,
+		"ROOT_CAUSE_CATEGORY_DEPLOYMENT":         1,
+		"ROOT_CAUSE_CATEGORY_CAPACITY":           2,
+		"ROOT_CAUSE_CATEGORY_DEPENDENCY_FAILURE": 3,
+		"ROOT_CAUSE_CATEGORY_CONFIG":             4,
+		"ROOT_CAUSE_CATEGORY_NETWORK":            5,
+	}
+)
+
+func (x RootCauseCategory) Enum() *RootCauseCategory {
+	p := new(RootCauseCategory)
+	*p = x
+	return p
+}
+
+func (x RootCauseCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RootCauseCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_rca_proto_enumTypes[1].Descriptor()
+}
+
+func (RootCauseCategory) Type() protoreflect.EnumType {
+	return &file_rca_proto_enumTypes[1]
+}
+
+func (x RootCauseCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RootCauseCategory.Descriptor instead.
+func (RootCauseCategory) EnumDescriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{1}
+}
+
 type DataType int32
 
 const (
@@ -57,11 +170,11 @@ func (x DataType) String() string {
 }
 
 func (DataType) Descriptor() protoreflect.EnumDescriptor {
-	return file_rca_proto_enumTypes[0].Descriptor()
+	return file_rca_proto_enumTypes[2].Descriptor()
 }
 
 func (DataType) Type() protoreflect.EnumType {
-	return &file_rca_proto_enumTypes[0]
+	return &file_rca_proto_enumTypes[2]
 }
 
 func (x DataType) Number() protoreflect.EnumNumber {
@@ -70,7 +183,7 @@ func (x DataType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DataType.Descriptor instead.
 func (DataType) EnumDescriptor() ([]byte, []int) {
-	return file_rca_proto_rawDescGZIP(), []int{0}
+	return file_rca_proto_rawDescGZIP(), []int{2}
 }
 
 type Severity int32
@@ -112,11 +225,11 @@ func (x Severity) String() string {
 }
 
 func (Severity) Descriptor() protoreflect.EnumDescriptor {
-	return file_rca_proto_enumTypes[1].Descriptor()
+	return file_rca_proto_enumTypes[3].Descriptor()
 }
 
 func (Severity) Type() protoreflect.EnumType {
-	return &file_rca_proto_enumTypes[1]
+	return &file_rca_proto_enumTypes[3]
 }
 
 func (x Severity) Number() protoreflect.EnumNumber {
@@ -125,7 +238,59 @@ func (x Severity) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Severity.Descriptor instead.
 func (Severity) EnumDescriptor() ([]byte, []int) {
-	return file_rca_proto_rawDescGZIP(), []int{1}
+	return file_rca_proto_rawDescGZIP(), []int{3}
+}
+
+type RecommendationActionType int32
+
+const (
+	RecommendationActionType_RECOMMENDATION_ACTION_TYPE_UNSPECIFIED RecommendationActionType = 0
+	RecommendationActionType_RECOMMENDATION_ACTION_TYPE_RUNBOOK     RecommendationActionType = 1
+	RecommendationActionType_RECOMMENDATION_ACTION_TYPE_DASHBOARD   RecommendationActionType = 2
+	RecommendationActionType_RECOMMENDATION_ACTION_TYPE_WEBHOOK     RecommendationActionType = 3
+)
+
+// Enum value maps for RecommendationActionType.
+var (
+	RecommendationActionType_name = map[int32]string{
+		0: "RECOMMENDATION_ACTION_TYPE_UNSPECIFIED",
+		1: "RECOMMENDATION_ACTION_TYPE_RUNBOOK",
+		2: "RECOMMENDATION_ACTION_TYPE_DASHBOARD",
+		3: "RECOMMENDATION_ACTION_TYPE_WEBHOOK",
+	}
+	RecommendationActionType_value = map[string]int32{
+		"RECOMMENDATION_ACTION_TYPE_UNSPECIFIED": 0,
+		"RECOMMENDATION_ACTION_TYPE_RUNBOOK":     1,
+		"RECOMMENDATION_ACTION_TYPE_DASHBOARD":   2,
+		"RECOMMENDATION_ACTION_TYPE_WEBHOOK":     3,
+	}
+)
+
+func (x RecommendationActionType) Enum() *RecommendationActionType {
+	p := new(RecommendationActionType)
+	*p = x
+	return p
+}
+
+func (x RecommendationActionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecommendationActionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rca_proto_enumTypes[4].Descriptor()
+}
+
+func (RecommendationActionType) Type() protoreflect.EnumType {
+	return &file_rca_proto_enumTypes[4]
+}
+
+func (x RecommendationActionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecommendationActionType.Descriptor instead.
+func (RecommendationActionType) EnumDescriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{4}
 }
 
 type RCAInvestigationRequest struct {
@@ -133,12 +298,18 @@ type RCAInvestigationRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IncidentId       string     `protobuf:"bytes,1,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
-	Symptoms         []string   `protobuf:"bytes,2,rep,name=symptoms,proto3" json:"symptoms,omitempty"`
-	TimeRange        *TimeRange `protobuf:"bytes,3,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
-	AffectedServices []string   `protobuf:"bytes,4,rep,name=affected_services,json=affectedServices,proto3" json:"affected_services,omitempty"`
-	AnomalyThreshold float64    `protobuf:"fixed64,5,opt,name=anomaly_threshold,json=anomalyThreshold,proto3" json:"anomaly_threshold,omitempty"`
-	TenantId         string     `protobuf:"bytes,6,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	IncidentId       string            `protobuf:"bytes,1,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
+	Symptoms         []string          `protobuf:"bytes,2,rep,name=symptoms,proto3" json:"symptoms,omitempty"`
+	TimeRange        *TimeRange        `protobuf:"bytes,3,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	AffectedServices []string          `protobuf:"bytes,4,rep,name=affected_services,json=affectedServices,proto3" json:"affected_services,omitempty"`
+	AnomalyThreshold float64           `protobuf:"fixed64,5,opt,name=anomaly_threshold,json=anomalyThreshold,proto3" json:"anomaly_threshold,omitempty"`
+	TenantId         string            `protobuf:"bytes,6,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Labels           map[string]string `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Incident         *IncidentMetadata `protobuf:"bytes,8,opt,name=incident,proto3" json:"incident,omitempty"`
+	// Named investigation preset (e.g. fast, deep, logs-heavy); empty uses the defaults.
+	Preset string `protobuf:"bytes,9,opt,name=preset,proto3" json:"preset,omitempty"`
+	// Deployment environment (e.g. prod, staging) every signal query is scoped to; empty leaves them unscoped.
+	Environment string `protobuf:"bytes,10,opt,name=environment,proto3" json:"environment,omitempty"`
 }
 
 func (x *RCAInvestigationRequest) Reset() {
@@ -215,6 +386,105 @@ func (x *RCAInvestigationRequest) GetTenantId() string {
 	return ""
 }
 
+func (x *RCAInvestigationRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *RCAInvestigationRequest) GetIncident() *IncidentMetadata {
+	if x != nil {
+		return x.Incident
+	}
+	return nil
+}
+
+func (x *RCAInvestigationRequest) GetPreset() string {
+	if x != nil {
+		return x.Preset
+	}
+	return ""
+}
+
+func (x *RCAInvestigationRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+type IncidentMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title             string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description       string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	AlertFingerprints []string `protobuf:"bytes,3,rep,name=alert_fingerprints,json=alertFingerprints,proto3" json:"alert_fingerprints,omitempty"`
+	TicketUrl         string   `protobuf:"bytes,4,opt,name=ticket_url,json=ticketUrl,proto3" json:"ticket_url,omitempty"`
+}
+
+func (x *IncidentMetadata) Reset() {
+	*x = IncidentMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IncidentMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncidentMetadata) ProtoMessage() {}
+
+func (x *IncidentMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncidentMetadata.ProtoReflect.Descriptor instead.
+func (*IncidentMetadata) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IncidentMetadata) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *IncidentMetadata) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *IncidentMetadata) GetAlertFingerprints() []string {
+	if x != nil {
+		return x.AlertFingerprints
+	}
+	return nil
+}
+
+func (x *IncidentMetadata) GetTicketUrl() string {
+	if x != nil {
+		return x.TicketUrl
+	}
+	return ""
+}
+
 type TimeRange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -227,7 +497,7 @@ type TimeRange struct {
 func (x *TimeRange) Reset() {
 	*x = TimeRange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rca_proto_msgTypes[1]
+		mi := &file_rca_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -240,7 +510,7 @@ func (x *TimeRange) String() string {
 func (*TimeRange) ProtoMessage() {}
 
 func (x *TimeRange) ProtoReflect() protoreflect.Message {
-	mi := &file_rca_proto_msgTypes[1]
+	mi := &file_rca_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -253,7 +523,7 @@ func (x *TimeRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimeRange.ProtoReflect.Descriptor instead.
 func (*TimeRange) Descriptor() ([]byte, []int) {
-	return file_rca_proto_rawDescGZIP(), []int{1}
+	return file_rca_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *TimeRange) GetStart() *timestamppb.Timestamp {
@@ -275,21 +545,44 @@ type CorrelationResult struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CorrelationId    string                 `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
-	IncidentId       string                 `protobuf:"bytes,2,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
-	RootCause        string                 `protobuf:"bytes,3,opt,name=root_cause,json=rootCause,proto3" json:"root_cause,omitempty"`
-	Confidence       float64                `protobuf:"fixed64,4,opt,name=confidence,proto3" json:"confidence,omitempty"`
-	AffectedServices []string               `protobuf:"bytes,5,rep,name=affected_services,json=affectedServices,proto3" json:"affected_services,omitempty"`
-	RedAnchors       []*RedAnchor           `protobuf:"bytes,6,rep,name=red_anchors,json=redAnchors,proto3" json:"red_anchors,omitempty"`
-	Timeline         []*TimelineEvent       `protobuf:"bytes,7,rep,name=timeline,proto3" json:"timeline,omitempty"`
-	Recommendations  []string               `protobuf:"bytes,8,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
-	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CorrelationId    string           `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	IncidentId       string           `protobuf:"bytes,2,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
+	RootCause        string           `protobuf:"bytes,3,opt,name=root_cause,json=rootCause,proto3" json:"root_cause,omitempty"`
+	Confidence       float64          `protobuf:"fixed64,4,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	AffectedServices []string         `protobuf:"bytes,5,rep,name=affected_services,json=affectedServices,proto3" json:"affected_services,omitempty"`
+	RedAnchors       []*RedAnchor     `protobuf:"bytes,6,rep,name=red_anchors,json=redAnchors,proto3" json:"red_anchors,omitempty"`
+	Timeline         []*TimelineEvent `protobuf:"bytes,7,rep,name=timeline,proto3" json:"timeline,omitempty"`
+	// Plain-text recommendations, kept for older clients; recommendation_details carries the same items with actions.
+	Recommendations       []string               `protobuf:"bytes,8,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	BlastRadius           []*ServiceImpact       `protobuf:"bytes,10,rep,name=blast_radius,json=blastRadius,proto3" json:"blast_radius,omitempty"`
+	Category              RootCauseCategory      `protobuf:"varint,11,opt,name=category,proto3,enum=rca.v1.RootCauseCategory" json:"category,omitempty"`
+	UnavailableSources    []DataType             `protobuf:"varint,12,rep,packed,name=unavailable_sources,json=unavailableSources,proto3,enum=rca.v1.DataType" json:"unavailable_sources,omitempty"`
+	DuplicateOf           string                 `protobuf:"bytes,13,opt,name=duplicate_of,json=duplicateOf,proto3" json:"duplicate_of,omitempty"`
+	RelatedCorrelations   []string               `protobuf:"bytes,14,rep,name=related_correlations,json=relatedCorrelations,proto3" json:"related_correlations,omitempty"`
+	Status                CorrelationStatus      `protobuf:"varint,15,opt,name=status,proto3,enum=rca.v1.CorrelationStatus" json:"status,omitempty"`
+	Annotations           []*Annotation          `protobuf:"bytes,16,rep,name=annotations,proto3" json:"annotations,omitempty"`
+	Labels                map[string]string      `protobuf:"bytes,17,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Incident              *IncidentMetadata      `protobuf:"bytes,18,opt,name=incident,proto3" json:"incident,omitempty"`
+	RecommendationDetails []*Recommendation      `protobuf:"bytes,19,rep,name=recommendation_details,json=recommendationDetails,proto3" json:"recommendation_details,omitempty"`
+	// Narrative paragraph describing the correlation, rendered from the tenant's summary template.
+	Summary string `protobuf:"bytes,20,opt,name=summary,proto3" json:"summary,omitempty"`
+	// Set when the requested window held too few samples and a wider one was analysed.
+	WindowExpansion *WindowExpansion `protobuf:"bytes,21,opt,name=window_expansion,json=windowExpansion,proto3" json:"window_expansion,omitempty"`
+	// Deployment environment the signals were fetched from; empty when unscoped.
+	Environment string `protobuf:"bytes,22,opt,name=environment,proto3" json:"environment,omitempty"`
+	// Resource utilisation of the suspected root service; unset when capacity analysis is off.
+	Capacity *CapacityAnalysis `protobuf:"bytes,23,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// Customer impact in [0, 1]: the fastest error budget burn among slo_impacts relative to the critical burn
+	// rate; 0 when the investigated service has no SLOs.
+	ImpactScore float64      `protobuf:"fixed64,24,opt,name=impact_score,json=impactScore,proto3" json:"impact_score,omitempty"`
+	SloImpacts  []*SLOImpact `protobuf:"bytes,25,rep,name=slo_impacts,json=sloImpacts,proto3" json:"slo_impacts,omitempty"`
 }
 
 func (x *CorrelationResult) Reset() {
 	*x = CorrelationResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rca_proto_msgTypes[2]
+		mi := &file_rca_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -302,7 +595,7 @@ func (x *CorrelationResult) String() string {
 func (*CorrelationResult) ProtoMessage() {}
 
 func (x *CorrelationResult) ProtoReflect() protoreflect.Message {
-	mi := &file_rca_proto_msgTypes[2]
+	mi := &file_rca_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -315,7 +608,7 @@ func (x *CorrelationResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CorrelationResult.ProtoReflect.Descriptor instead.
 func (*CorrelationResult) Descriptor() ([]byte, []int) {
-	return file_rca_proto_rawDescGZIP(), []int{2}
+	return file_rca_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CorrelationResult) GetCorrelationId() string {
@@ -381,108 +674,137 @@ func (x *CorrelationResult) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type RedAnchor struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *CorrelationResult) GetBlastRadius() []*ServiceImpact {
+	if x != nil {
+		return x.BlastRadius
+	}
+	return nil
+}
 
-	Service      string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
-	Selector     string                 `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
-	DataType     DataType               `protobuf:"varint,3,opt,name=data_type,json=dataType,proto3,enum=rca.v1.DataType" json:"data_type,omitempty"`
-	Timestamp    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	AnomalyScore float64                `protobuf:"fixed64,5,opt,name=anomaly_score,json=anomalyScore,proto3" json:"anomaly_score,omitempty"`
-	Threshold    float64                `protobuf:"fixed64,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+func (x *CorrelationResult) GetCategory() RootCauseCategory {
+	if x != nil {
+		return x.Category
+	}
+	return RootCauseCategory_ROOT_CAUSE_CATEGORY_UNSPECIFIED
 }
 
-func (x *RedAnchor) Reset() {
-	*x = RedAnchor{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rca_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CorrelationResult) GetUnavailableSources() []DataType {
+	if x != nil {
+		return x.UnavailableSources
 	}
+	return nil
 }
 
-func (x *RedAnchor) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CorrelationResult) GetDuplicateOf() string {
+	if x != nil {
+		return x.DuplicateOf
+	}
+	return ""
 }
 
-func (*RedAnchor) ProtoMessage() {}
+func (x *CorrelationResult) GetRelatedCorrelations() []string {
+	if x != nil {
+		return x.RelatedCorrelations
+	}
+	return nil
+}
 
-func (x *RedAnchor) ProtoReflect() protoreflect.Message {
-	mi := &file_rca_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CorrelationResult) GetStatus() CorrelationStatus {
+	if x != nil {
+		return x.Status
 	}
-	return mi.MessageOf(x)
+	return CorrelationStatus_CORRELATION_STATUS_UNSPECIFIED
 }
 
-// Deprecated: Use RedAnchor.ProtoReflect.Descriptor instead.
-func (*RedAnchor) Descriptor() ([]byte, []int) {
-	return file_rca_proto_rawDescGZIP(), []int{3}
+func (x *CorrelationResult) GetAnnotations() []*Annotation {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
 }
 
-func (x *RedAnchor) GetService() string {
+func (x *CorrelationResult) GetLabels() map[string]string {
 	if x != nil {
-		return x.Service
+		return x.Labels
 	}
-	return ""
+	return nil
 }
 
-func (x *RedAnchor) GetSelector() string {
+func (x *CorrelationResult) GetIncident() *IncidentMetadata {
 	if x != nil {
-		return x.Selector
+		return x.Incident
 	}
-	return ""
+	return nil
 }
 
-func (x *RedAnchor) GetDataType() DataType {
+func (x *CorrelationResult) GetRecommendationDetails() []*Recommendation {
 	if x != nil {
-		return x.DataType
+		return x.RecommendationDetails
 	}
-	return DataType_DATA_TYPE_UNSPECIFIED
+	return nil
 }
 
-func (x *RedAnchor) GetTimestamp() *timestamppb.Timestamp {
+func (x *CorrelationResult) GetSummary() string {
 	if x != nil {
-		return x.Timestamp
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *CorrelationResult) GetWindowExpansion() *WindowExpansion {
+	if x != nil {
+		return x.WindowExpansion
 	}
 	return nil
 }
 
-func (x *RedAnchor) GetAnomalyScore() float64 {
+func (x *CorrelationResult) GetEnvironment() string {
 	if x != nil {
-		return x.AnomalyScore
+		return x.Environment
 	}
-	return 0
+	return ""
 }
 
-func (x *RedAnchor) GetThreshold() float64 {
+func (x *CorrelationResult) GetCapacity() *CapacityAnalysis {
 	if x != nil {
-		return x.Threshold
+		return x.Capacity
+	}
+	return nil
+}
+
+func (x *CorrelationResult) GetImpactScore() float64 {
+	if x != nil {
+		return x.ImpactScore
 	}
 	return 0
 }
 
-type TimelineEvent struct {
+func (x *CorrelationResult) GetSloImpacts() []*SLOImpact {
+	if x != nil {
+		return x.SloImpacts
+	}
+	return nil
+}
+
+// Error budget burned by one service-level objective during the investigation window.
+type SLOImpact struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Time         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
-	Event        string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
-	Service      string                 `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
-	Severity     Severity               `protobuf:"varint,4,opt,name=severity,proto3,enum=rca.v1.Severity" json:"severity,omitempty"`
-	AnomalyScore float64                `protobuf:"fixed64,5,opt,name=anomaly_score,json=anomalyScore,proto3" json:"anomaly_score,omitempty"`
-	DataSource   DataType               `protobuf:"varint,6,opt,name=data_source,json=dataSource,proto3,enum=rca.v1.DataType" json:"data_source,omitempty"`
+	Service   string  `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Slo       string  `protobuf:"bytes,2,opt,name=slo,proto3" json:"slo,omitempty"`
+	Objective float64 `protobuf:"fixed64,3,opt,name=objective,proto3" json:"objective,omitempty"`
+	// Share of bad events, or of bad samples for threshold SLOs, in the window.
+	BadFraction float64 `protobuf:"fixed64,4,opt,name=bad_fraction,json=badFraction,proto3" json:"bad_fraction,omitempty"`
+	// bad_fraction divided by the error budget (1 - objective); at 1 the budget lasts exactly its period.
+	BurnRate float64 `protobuf:"fixed64,5,opt,name=burn_rate,json=burnRate,proto3" json:"burn_rate,omitempty"`
+	// Fraction of the period's error budget consumed during the window.
+	BudgetSpent float64 `protobuf:"fixed64,6,opt,name=budget_spent,json=budgetSpent,proto3" json:"budget_spent,omitempty"`
 }
 
-func (x *TimelineEvent) Reset() {
-	*x = TimelineEvent{}
+func (x *SLOImpact) Reset() {
+	*x = SLOImpact{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rca_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -490,13 +812,13 @@ func (x *TimelineEvent) Reset() {
 	}
 }
 
-func (x *TimelineEvent) String() string {
+func (x *SLOImpact) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimelineEvent) ProtoMessage() {}
+func (*SLOImpact) ProtoMessage() {}
 
-func (x *TimelineEvent) ProtoReflect() protoreflect.Message {
+func (x *SLOImpact) ProtoReflect() protoreflect.Message {
 	mi := &file_rca_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -508,68 +830,68 @@ func (x *TimelineEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimelineEvent.ProtoReflect.Descriptor instead.
-func (*TimelineEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use SLOImpact.ProtoReflect.Descriptor instead.
+func (*SLOImpact) Descriptor() ([]byte, []int) {
 	return file_rca_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *TimelineEvent) GetTime() *timestamppb.Timestamp {
+func (x *SLOImpact) GetService() string {
 	if x != nil {
-		return x.Time
+		return x.Service
 	}
-	return nil
+	return ""
 }
 
-func (x *TimelineEvent) GetEvent() string {
+func (x *SLOImpact) GetSlo() string {
 	if x != nil {
-		return x.Event
+		return x.Slo
 	}
 	return ""
 }
 
-func (x *TimelineEvent) GetService() string {
+func (x *SLOImpact) GetObjective() float64 {
 	if x != nil {
-		return x.Service
+		return x.Objective
 	}
-	return ""
+	return 0
 }
 
-func (x *TimelineEvent) GetSeverity() Severity {
+func (x *SLOImpact) GetBadFraction() float64 {
 	if x != nil {
-		return x.Severity
+		return x.BadFraction
 	}
-	return Severity_SEVERITY_UNSPECIFIED
+	return 0
 }
 
-func (x *TimelineEvent) GetAnomalyScore() float64 {
+func (x *SLOImpact) GetBurnRate() float64 {
 	if x != nil {
-		return x.AnomalyScore
+		return x.BurnRate
 	}
 	return 0
 }
 
-func (x *TimelineEvent) GetDataSource() DataType {
+func (x *SLOImpact) GetBudgetSpent() float64 {
 	if x != nil {
-		return x.DataSource
+		return x.BudgetSpent
 	}
-	return DataType_DATA_TYPE_UNSPECIFIED
+	return 0
 }
 
-type ListCorrelationsRequest struct {
+type CapacityAnalysis struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TenantId  string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Service   string                 `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
-	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	PageSize  int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	// Set when a resource saturated no later than the service's first anomaly.
+	SaturationDriven bool `protobuf:"varint,2,opt,name=saturation_driven,json=saturationDriven,proto3" json:"saturation_driven,omitempty"`
+	// Utilisation, as a fraction of the limit, at which a resource counts as saturated.
+	Saturation float64          `protobuf:"fixed64,3,opt,name=saturation,proto3" json:"saturation,omitempty"`
+	Resources  []*ResourceUsage `protobuf:"bytes,4,rep,name=resources,proto3" json:"resources,omitempty"`
 }
 
-func (x *ListCorrelationsRequest) Reset() {
-	*x = ListCorrelationsRequest{}
+func (x *CapacityAnalysis) Reset() {
+	*x = CapacityAnalysis{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rca_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -577,13 +899,13 @@ func (x *ListCorrelationsRequest) Reset() {
 	}
 }
 
-func (x *ListCorrelationsRequest) String() string {
+func (x *CapacityAnalysis) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCorrelationsRequest) ProtoMessage() {}
+func (*CapacityAnalysis) ProtoMessage() {}
 
-func (x *ListCorrelationsRequest) ProtoReflect() protoreflect.Message {
+func (x *CapacityAnalysis) ProtoReflect() protoreflect.Message {
 	mi := &file_rca_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -595,64 +917,60 @@ func (x *ListCorrelationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCorrelationsRequest.ProtoReflect.Descriptor instead.
-func (*ListCorrelationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CapacityAnalysis.ProtoReflect.Descriptor instead.
+func (*CapacityAnalysis) Descriptor() ([]byte, []int) {
 	return file_rca_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ListCorrelationsRequest) GetTenantId() string {
-	if x != nil {
-		return x.TenantId
-	}
-	return ""
-}
-
-func (x *ListCorrelationsRequest) GetService() string {
+func (x *CapacityAnalysis) GetService() string {
 	if x != nil {
 		return x.Service
 	}
 	return ""
 }
 
-func (x *ListCorrelationsRequest) GetStartTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartTime
-	}
-	return nil
-}
-
-func (x *ListCorrelationsRequest) GetEndTime() *timestamppb.Timestamp {
+func (x *CapacityAnalysis) GetSaturationDriven() bool {
 	if x != nil {
-		return x.EndTime
+		return x.SaturationDriven
 	}
-	return nil
+	return false
 }
 
-func (x *ListCorrelationsRequest) GetPageSize() int32 {
+func (x *CapacityAnalysis) GetSaturation() float64 {
 	if x != nil {
-		return x.PageSize
+		return x.Saturation
 	}
 	return 0
 }
 
-func (x *ListCorrelationsRequest) GetPageToken() string {
+func (x *CapacityAnalysis) GetResources() []*ResourceUsage {
 	if x != nil {
-		return x.PageToken
+		return x.Resources
 	}
-	return ""
+	return nil
 }
 
-type ListCorrelationsResponse struct {
+// Utilisation of one resource over the window, as fractions of its limit.
+type ResourceUsage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Correlations  []*CorrelationResult `protobuf:"bytes,1,rep,name=correlations,proto3" json:"correlations,omitempty"`
-	NextPageToken string               `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-}
-
-func (x *ListCorrelationsResponse) Reset() {
-	*x = ListCorrelationsResponse{}
+	Resource string  `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Peak     float64 `protobuf:"fixed64,2,opt,name=peak,proto3" json:"peak,omitempty"`
+	Latest   float64 `protobuf:"fixed64,3,opt,name=latest,proto3" json:"latest,omitempty"`
+	// Share of the limit left unused at the peak.
+	Headroom float64 `protobuf:"fixed64,4,opt,name=headroom,proto3" json:"headroom,omitempty"`
+	// When utilisation first reached the saturation level; unset when it never did.
+	SaturatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=saturated_at,json=saturatedAt,proto3" json:"saturated_at,omitempty"`
+	// Growth of the limit, as a fraction of it, that keeps the peak at the saturation level.
+	ExtraCapacity float64 `protobuf:"fixed64,6,opt,name=extra_capacity,json=extraCapacity,proto3" json:"extra_capacity,omitempty"`
+	// Projected seconds until the limit is reached at the window's trend; 0 when not rising.
+	TimeToExhaustionSeconds float64 `protobuf:"fixed64,7,opt,name=time_to_exhaustion_seconds,json=timeToExhaustionSeconds,proto3" json:"time_to_exhaustion_seconds,omitempty"`
+}
+
+func (x *ResourceUsage) Reset() {
+	*x = ResourceUsage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rca_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -660,13 +978,13 @@ func (x *ListCorrelationsResponse) Reset() {
 	}
 }
 
-func (x *ListCorrelationsResponse) String() string {
+func (x *ResourceUsage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCorrelationsResponse) ProtoMessage() {}
+func (*ResourceUsage) ProtoMessage() {}
 
-func (x *ListCorrelationsResponse) ProtoReflect() protoreflect.Message {
+func (x *ResourceUsage) ProtoReflect() protoreflect.Message {
 	mi := &file_rca_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -678,36 +996,78 @@ func (x *ListCorrelationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCorrelationsResponse.ProtoReflect.Descriptor instead.
-func (*ListCorrelationsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResourceUsage.ProtoReflect.Descriptor instead.
+func (*ResourceUsage) Descriptor() ([]byte, []int) {
 	return file_rca_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListCorrelationsResponse) GetCorrelations() []*CorrelationResult {
+func (x *ResourceUsage) GetResource() string {
 	if x != nil {
-		return x.Correlations
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *ResourceUsage) GetPeak() float64 {
+	if x != nil {
+		return x.Peak
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetLatest() float64 {
+	if x != nil {
+		return x.Latest
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetHeadroom() float64 {
+	if x != nil {
+		return x.Headroom
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetSaturatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SaturatedAt
 	}
 	return nil
 }
 
-func (x *ListCorrelationsResponse) GetNextPageToken() string {
+func (x *ResourceUsage) GetExtraCapacity() float64 {
 	if x != nil {
-		return x.NextPageToken
+		return x.ExtraCapacity
 	}
-	return ""
+	return 0
 }
 
-type GetPatternsRequest struct {
+func (x *ResourceUsage) GetTimeToExhaustionSeconds() float64 {
+	if x != nil {
+		return x.TimeToExhaustionSeconds
+	}
+	return 0
+}
+
+type WindowExpansion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Service  string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	// Window first fetched: the request's, padded by its preset.
+	Requested *TimeRange `protobuf:"bytes,1,opt,name=requested,proto3" json:"requested,omitempty"`
+	Analysed  *TimeRange `protobuf:"bytes,2,opt,name=analysed,proto3" json:"analysed,omitempty"`
+	// Sources that held too few samples in the requested window.
+	SparseSources []DataType `protobuf:"varint,3,rep,packed,name=sparse_sources,json=sparseSources,proto3,enum=rca.v1.DataType" json:"sparse_sources,omitempty"`
+	// Fewest samples a sparse source held in the analysed window; below min_samples when the maximum expansion
+	// was reached first.
+	Samples    int32 `protobuf:"varint,4,opt,name=samples,proto3" json:"samples,omitempty"`
+	MinSamples int32 `protobuf:"varint,5,opt,name=min_samples,json=minSamples,proto3" json:"min_samples,omitempty"`
 }
 
-func (x *GetPatternsRequest) Reset() {
-	*x = GetPatternsRequest{}
+func (x *WindowExpansion) Reset() {
+	*x = WindowExpansion{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rca_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -715,13 +1075,13 @@ func (x *GetPatternsRequest) Reset() {
 	}
 }
 
-func (x *GetPatternsRequest) String() string {
+func (x *WindowExpansion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPatternsRequest) ProtoMessage() {}
+func (*WindowExpansion) ProtoMessage() {}
 
-func (x *GetPatternsRequest) ProtoReflect() protoreflect.Message {
+func (x *WindowExpansion) ProtoReflect() protoreflect.Message {
 	mi := &file_rca_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -733,42 +1093,58 @@ func (x *GetPatternsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPatternsRequest.ProtoReflect.Descriptor instead.
-func (*GetPatternsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WindowExpansion.ProtoReflect.Descriptor instead.
+func (*WindowExpansion) Descriptor() ([]byte, []int) {
 	return file_rca_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetPatternsRequest) GetTenantId() string {
+func (x *WindowExpansion) GetRequested() *TimeRange {
 	if x != nil {
-		return x.TenantId
+		return x.Requested
 	}
-	return ""
+	return nil
 }
 
-func (x *GetPatternsRequest) GetService() string {
+func (x *WindowExpansion) GetAnalysed() *TimeRange {
 	if x != nil {
-		return x.Service
+		return x.Analysed
 	}
-	return ""
+	return nil
 }
 
-type Pattern struct {
+func (x *WindowExpansion) GetSparseSources() []DataType {
+	if x != nil {
+		return x.SparseSources
+	}
+	return nil
+}
+
+func (x *WindowExpansion) GetSamples() int32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *WindowExpansion) GetMinSamples() int32 {
+	if x != nil {
+		return x.MinSamples
+	}
+	return 0
+}
+
+type Annotation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Services        []string               `protobuf:"bytes,4,rep,name=services,proto3" json:"services,omitempty"`
-	AnchorTemplates []*AnchorTemplate      `protobuf:"bytes,5,rep,name=anchor_templates,json=anchorTemplates,proto3" json:"anchor_templates,omitempty"`
-	Prevalence      float64                `protobuf:"fixed64,6,opt,name=prevalence,proto3" json:"prevalence,omitempty"`
-	LastSeen        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
-	Quality         *Quality               `protobuf:"bytes,8,opt,name=quality,proto3" json:"quality,omitempty"`
+	Author    string                 `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	Text      string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *Pattern) Reset() {
-	*x = Pattern{}
+func (x *Annotation) Reset() {
+	*x = Annotation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rca_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -776,13 +1152,13 @@ func (x *Pattern) Reset() {
 	}
 }
 
-func (x *Pattern) String() string {
+func (x *Annotation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pattern) ProtoMessage() {}
+func (*Annotation) ProtoMessage() {}
 
-func (x *Pattern) ProtoReflect() protoreflect.Message {
+func (x *Annotation) ProtoReflect() protoreflect.Message {
 	mi := &file_rca_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -794,81 +1170,44 @@ func (x *Pattern) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pattern.ProtoReflect.Descriptor instead.
-func (*Pattern) Descriptor() ([]byte, []int) {
+// Deprecated: Use Annotation.ProtoReflect.Descriptor instead.
+func (*Annotation) Descriptor() ([]byte, []int) {
 	return file_rca_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Pattern) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Pattern) GetName() string {
+func (x *Annotation) GetAuthor() string {
 	if x != nil {
-		return x.Name
+		return x.Author
 	}
 	return ""
 }
 
-func (x *Pattern) GetDescription() string {
+func (x *Annotation) GetText() string {
 	if x != nil {
-		return x.Description
+		return x.Text
 	}
 	return ""
 }
 
-func (x *Pattern) GetServices() []string {
-	if x != nil {
-		return x.Services
-	}
-	return nil
-}
-
-func (x *Pattern) GetAnchorTemplates() []*AnchorTemplate {
-	if x != nil {
-		return x.AnchorTemplates
-	}
-	return nil
-}
-
-func (x *Pattern) GetPrevalence() float64 {
-	if x != nil {
-		return x.Prevalence
-	}
-	return 0
-}
-
-func (x *Pattern) GetLastSeen() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LastSeen
-	}
-	return nil
-}
-
-func (x *Pattern) GetQuality() *Quality {
+func (x *Annotation) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Quality
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type AnchorTemplate struct {
+type ServiceImpact struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Service        string  `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
-	SignalType     string  `protobuf:"bytes,2,opt,name=signal_type,json=signalType,proto3" json:"signal_type,omitempty"`
-	Selector       string  `protobuf:"bytes,3,opt,name=selector,proto3" json:"selector,omitempty"`
-	TypicalLeadLag float64 `protobuf:"fixed64,4,opt,name=typical_lead_lag,json=typicalLeadLag,proto3" json:"typical_lead_lag,omitempty"`
-	Threshold      float64 `protobuf:"fixed64,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Service string  `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Score   float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Depth   int32   `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
 }
 
-func (x *AnchorTemplate) Reset() {
-	*x = AnchorTemplate{}
+func (x *ServiceImpact) Reset() {
+	*x = ServiceImpact{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rca_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -876,13 +1215,13 @@ func (x *AnchorTemplate) Reset() {
 	}
 }
 
-func (x *AnchorTemplate) String() string {
+func (x *ServiceImpact) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnchorTemplate) ProtoMessage() {}
+func (*ServiceImpact) ProtoMessage() {}
 
-func (x *AnchorTemplate) ProtoReflect() protoreflect.Message {
+func (x *ServiceImpact) ProtoReflect() protoreflect.Message {
 	mi := &file_rca_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -894,57 +1233,53 @@ func (x *AnchorTemplate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnchorTemplate.ProtoReflect.Descriptor instead.
-func (*AnchorTemplate) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServiceImpact.ProtoReflect.Descriptor instead.
+func (*ServiceImpact) Descriptor() ([]byte, []int) {
 	return file_rca_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *AnchorTemplate) GetService() string {
+func (x *ServiceImpact) GetService() string {
 	if x != nil {
 		return x.Service
 	}
 	return ""
 }
 
-func (x *AnchorTemplate) GetSignalType() string {
-	if x != nil {
-		return x.SignalType
-	}
-	return ""
-}
-
-func (x *AnchorTemplate) GetSelector() string {
-	if x != nil {
-		return x.Selector
-	}
-	return ""
-}
-
-func (x *AnchorTemplate) GetTypicalLeadLag() float64 {
+func (x *ServiceImpact) GetScore() float64 {
 	if x != nil {
-		return x.TypicalLeadLag
+		return x.Score
 	}
 	return 0
 }
 
-func (x *AnchorTemplate) GetThreshold() float64 {
+func (x *ServiceImpact) GetDepth() int32 {
 	if x != nil {
-		return x.Threshold
+		return x.Depth
 	}
 	return 0
 }
 
-type Quality struct {
+type RedAnchor struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Precision float64 `protobuf:"fixed64,1,opt,name=precision,proto3" json:"precision,omitempty"`
-	Recall    float64 `protobuf:"fixed64,2,opt,name=recall,proto3" json:"recall,omitempty"`
+	Service      string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Selector     string                 `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
+	DataType     DataType               `protobuf:"varint,3,opt,name=data_type,json=dataType,proto3,enum=rca.v1.DataType" json:"data_type,omitempty"`
+	Timestamp    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	AnomalyScore float64                `protobuf:"fixed64,5,opt,name=anomaly_score,json=anomalyScore,proto3" json:"anomaly_score,omitempty"`
+	Threshold    float64                `protobuf:"fixed64,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Evidence     *Evidence              `protobuf:"bytes,7,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	Link         string                 `protobuf:"bytes,8,opt,name=link,proto3" json:"link,omitempty"`
+	Environment  string                 `protobuf:"bytes,9,opt,name=environment,proto3" json:"environment,omitempty"`
+	// "up" when the signal spiked above its baseline, "down" when it dropped below it; empty when the detector
+	// does not tell.
+	Direction string `protobuf:"bytes,10,opt,name=direction,proto3" json:"direction,omitempty"`
 }
 
-func (x *Quality) Reset() {
-	*x = Quality{}
+func (x *RedAnchor) Reset() {
+	*x = RedAnchor{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rca_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -952,13 +1287,13 @@ func (x *Quality) Reset() {
 	}
 }
 
-func (x *Quality) String() string {
+func (x *RedAnchor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Quality) ProtoMessage() {}
+func (*RedAnchor) ProtoMessage() {}
 
-func (x *Quality) ProtoReflect() protoreflect.Message {
+func (x *RedAnchor) ProtoReflect() protoreflect.Message {
 	mi := &file_rca_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -970,35 +1305,95 @@ func (x *Quality) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Quality.ProtoReflect.Descriptor instead.
-func (*Quality) Descriptor() ([]byte, []int) {
+// Deprecated: Use RedAnchor.ProtoReflect.Descriptor instead.
+func (*RedAnchor) Descriptor() ([]byte, []int) {
 	return file_rca_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Quality) GetPrecision() float64 {
+func (x *RedAnchor) GetService() string {
 	if x != nil {
-		return x.Precision
+		return x.Service
+	}
+	return ""
+}
+
+func (x *RedAnchor) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *RedAnchor) GetDataType() DataType {
+	if x != nil {
+		return x.DataType
+	}
+	return DataType_DATA_TYPE_UNSPECIFIED
+}
+
+func (x *RedAnchor) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *RedAnchor) GetAnomalyScore() float64 {
+	if x != nil {
+		return x.AnomalyScore
 	}
 	return 0
 }
 
-func (x *Quality) GetRecall() float64 {
+func (x *RedAnchor) GetThreshold() float64 {
 	if x != nil {
-		return x.Recall
+		return x.Threshold
 	}
 	return 0
 }
 
-type GetPatternsResponse struct {
+func (x *RedAnchor) GetEvidence() *Evidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+func (x *RedAnchor) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *RedAnchor) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *RedAnchor) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+type Evidence struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Patterns []*Pattern `protobuf:"bytes,1,rep,name=patterns,proto3" json:"patterns,omitempty"`
+	LogLines     []string        `protobuf:"bytes,1,rep,name=log_lines,json=logLines,proto3" json:"log_lines,omitempty"`
+	MetricValues []*MetricSample `protobuf:"bytes,2,rep,name=metric_values,json=metricValues,proto3" json:"metric_values,omitempty"`
+	TraceIds     []string        `protobuf:"bytes,3,rep,name=trace_ids,json=traceIds,proto3" json:"trace_ids,omitempty"`
+	// Slowest or failing spans behind a trace anchor, errors first.
+	Exemplars []*TraceExemplar `protobuf:"bytes,4,rep,name=exemplars,proto3" json:"exemplars,omitempty"`
 }
 
-func (x *GetPatternsResponse) Reset() {
-	*x = GetPatternsResponse{}
+func (x *Evidence) Reset() {
+	*x = Evidence{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rca_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1006,13 +1401,13 @@ func (x *GetPatternsResponse) Reset() {
 	}
 }
 
-func (x *GetPatternsResponse) String() string {
+func (x *Evidence) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPatternsResponse) ProtoMessage() {}
+func (*Evidence) ProtoMessage() {}
 
-func (x *GetPatternsResponse) ProtoReflect() protoreflect.Message {
+func (x *Evidence) ProtoReflect() protoreflect.Message {
 	mi := &file_rca_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1024,31 +1419,57 @@ func (x *GetPatternsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPatternsResponse.ProtoReflect.Descriptor instead.
-func (*GetPatternsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Evidence.ProtoReflect.Descriptor instead.
+func (*Evidence) Descriptor() ([]byte, []int) {
 	return file_rca_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetPatternsResponse) GetPatterns() []*Pattern {
+func (x *Evidence) GetLogLines() []string {
 	if x != nil {
-		return x.Patterns
+		return x.LogLines
 	}
 	return nil
 }
 
-type FeedbackRequest struct {
+func (x *Evidence) GetMetricValues() []*MetricSample {
+	if x != nil {
+		return x.MetricValues
+	}
+	return nil
+}
+
+func (x *Evidence) GetTraceIds() []string {
+	if x != nil {
+		return x.TraceIds
+	}
+	return nil
+}
+
+func (x *Evidence) GetExemplars() []*TraceExemplar {
+	if x != nil {
+		return x.Exemplars
+	}
+	return nil
+}
+
+type TraceExemplar struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TenantId      string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	CorrelationId string `protobuf:"bytes,2,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
-	Correct       bool   `protobuf:"varint,3,opt,name=correct,proto3" json:"correct,omitempty"`
-	Notes         string `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	TraceId string `protobuf:"bytes,1,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	// Empty when the trace backend reports whole traces only.
+	SpanId     string                 `protobuf:"bytes,2,opt,name=span_id,json=spanId,proto3" json:"span_id,omitempty"`
+	Operation  string                 `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	Status     string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	DurationMs float64                `protobuf:"fixed64,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Timestamp  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Opens the trace in the tracing UI; empty without links.traceTemplate.
+	Link string `protobuf:"bytes,7,opt,name=link,proto3" json:"link,omitempty"`
 }
 
-func (x *FeedbackRequest) Reset() {
-	*x = FeedbackRequest{}
+func (x *TraceExemplar) Reset() {
+	*x = TraceExemplar{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rca_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1056,13 +1477,13 @@ func (x *FeedbackRequest) Reset() {
 	}
 }
 
-func (x *FeedbackRequest) String() string {
+func (x *TraceExemplar) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FeedbackRequest) ProtoMessage() {}
+func (*TraceExemplar) ProtoMessage() {}
 
-func (x *FeedbackRequest) ProtoReflect() protoreflect.Message {
+func (x *TraceExemplar) ProtoReflect() protoreflect.Message {
 	mi := &file_rca_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1074,50 +1495,71 @@ func (x *FeedbackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FeedbackRequest.ProtoReflect.Descriptor instead.
-func (*FeedbackRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TraceExemplar.ProtoReflect.Descriptor instead.
+func (*TraceExemplar) Descriptor() ([]byte, []int) {
 	return file_rca_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *FeedbackRequest) GetTenantId() string {
+func (x *TraceExemplar) GetTraceId() string {
 	if x != nil {
-		return x.TenantId
+		return x.TraceId
 	}
 	return ""
 }
 
-func (x *FeedbackRequest) GetCorrelationId() string {
+func (x *TraceExemplar) GetSpanId() string {
 	if x != nil {
-		return x.CorrelationId
+		return x.SpanId
 	}
 	return ""
 }
 
-func (x *FeedbackRequest) GetCorrect() bool {
+func (x *TraceExemplar) GetOperation() string {
 	if x != nil {
-		return x.Correct
+		return x.Operation
 	}
-	return false
+	return ""
 }
 
-func (x *FeedbackRequest) GetNotes() string {
+func (x *TraceExemplar) GetStatus() string {
 	if x != nil {
-		return x.Notes
+		return x.Status
 	}
 	return ""
 }
 
-type FeedbackAck struct {
+func (x *TraceExemplar) GetDurationMs() float64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *TraceExemplar) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *TraceExemplar) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+type MetricSample struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CorrelationId string `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
-	Accepted      bool   `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Value     float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *FeedbackAck) Reset() {
-	*x = FeedbackAck{}
+func (x *MetricSample) Reset() {
+	*x = MetricSample{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rca_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1125,13 +1567,13 @@ func (x *FeedbackAck) Reset() {
 	}
 }
 
-func (x *FeedbackAck) String() string {
+func (x *MetricSample) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FeedbackAck) ProtoMessage() {}
+func (*MetricSample) ProtoMessage() {}
 
-func (x *FeedbackAck) ProtoReflect() protoreflect.Message {
+func (x *MetricSample) ProtoReflect() protoreflect.Message {
 	mi := &file_rca_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1143,33 +1585,49 @@ func (x *FeedbackAck) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FeedbackAck.ProtoReflect.Descriptor instead.
-func (*FeedbackAck) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetricSample.ProtoReflect.Descriptor instead.
+func (*MetricSample) Descriptor() ([]byte, []int) {
 	return file_rca_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *FeedbackAck) GetCorrelationId() string {
+func (x *MetricSample) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CorrelationId
+		return x.Timestamp
 	}
-	return ""
+	return nil
 }
 
-func (x *FeedbackAck) GetAccepted() bool {
+func (x *MetricSample) GetValue() float64 {
 	if x != nil {
-		return x.Accepted
+		return x.Value
 	}
-	return false
+	return 0
 }
 
-type HealthRequest struct {
+type TimelineEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Time         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Event        string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Service      string                 `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Severity     Severity               `protobuf:"varint,4,opt,name=severity,proto3,enum=rca.v1.Severity" json:"severity,omitempty"`
+	AnomalyScore float64                `protobuf:"fixed64,5,opt,name=anomaly_score,json=anomalyScore,proto3" json:"anomaly_score,omitempty"`
+	DataSource   DataType               `protobuf:"varint,6,opt,name=data_source,json=dataSource,proto3,enum=rca.v1.DataType" json:"data_source,omitempty"`
+	Link         string                 `protobuf:"bytes,7,opt,name=link,proto3" json:"link,omitempty"`
+	// Bounds of a sustained anomaly such as an error plateau; unset for an instant, whose time is the only one.
+	Start *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=start,proto3" json:"start,omitempty"`
+	End   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=end,proto3" json:"end,omitempty"`
+	// Set when a sustained anomaly was still anomalous at the last sample analysed.
+	Ongoing bool `protobuf:"varint,10,opt,name=ongoing,proto3" json:"ongoing,omitempty"`
+	// "up" when the signal spiked above its baseline, "down" when it dropped below it; empty when the detector
+	// does not tell.
+	Direction string `protobuf:"bytes,11,opt,name=direction,proto3" json:"direction,omitempty"`
 }
 
-func (x *HealthRequest) Reset() {
-	*x = HealthRequest{}
+func (x *TimelineEvent) Reset() {
+	*x = TimelineEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rca_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1177,13 +1635,13 @@ func (x *HealthRequest) Reset() {
 	}
 }
 
-func (x *HealthRequest) String() string {
+func (x *TimelineEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthRequest) ProtoMessage() {}
+func (*TimelineEvent) ProtoMessage() {}
 
-func (x *HealthRequest) ProtoReflect() protoreflect.Message {
+func (x *TimelineEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_rca_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1195,353 +1653,4872 @@ func (x *HealthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthRequest.ProtoReflect.Descriptor instead.
-func (*HealthRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TimelineEvent.ProtoReflect.Descriptor instead.
+func (*TimelineEvent) Descriptor() ([]byte, []int) {
 	return file_rca_proto_rawDescGZIP(), []int{14}
 }
 
-type HealthResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+func (x *TimelineEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
 }
 
-func (x *HealthResponse) Reset() {
-	*x = HealthResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rca_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TimelineEvent) GetEvent() string {
+	if x != nil {
+		return x.Event
 	}
+	return ""
 }
 
-func (x *HealthResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TimelineEvent) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
 }
 
-func (*HealthResponse) ProtoMessage() {}
+func (x *TimelineEvent) GetSeverity() Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return Severity_SEVERITY_UNSPECIFIED
+}
 
-func (x *HealthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rca_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TimelineEvent) GetAnomalyScore() float64 {
+	if x != nil {
+		return x.AnomalyScore
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
-func (*HealthResponse) Descriptor() ([]byte, []int) {
-	return file_rca_proto_rawDescGZIP(), []int{15}
+func (x *TimelineEvent) GetDataSource() DataType {
+	if x != nil {
+		return x.DataSource
+	}
+	return DataType_DATA_TYPE_UNSPECIFIED
 }
 
-func (x *HealthResponse) GetStatus() string {
+func (x *TimelineEvent) GetLink() string {
 	if x != nil {
-		return x.Status
+		return x.Link
 	}
 	return ""
 }
 
-var File_rca_proto protoreflect.FileDescriptor
+func (x *TimelineEvent) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
 
-var file_rca_proto_rawDesc = []byte{
-	0x0a, 0x09, 0x72, 0x63, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x72, 0x63, 0x61,
-	0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xff, 0x01, 0x0a, 0x17, 0x52, 0x43, 0x41, 0x49, 0x6e, 0x76, 0x65,
-	0x73, 0x74, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x49,
-	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x79, 0x6d, 0x70, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x79, 0x6d, 0x70, 0x74, 0x6f, 0x6d, 0x73, 0x12, 0x30, 0x0a,
-	0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52,
-	0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12,
-	0x2b, 0x0a, 0x11, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x66, 0x66, 0x65,
-	0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11,
-	0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79,
-	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e,
-	0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x6b, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61,
-	0x6e, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03,
-	0x65, 0x6e, 0x64, 0x22, 0x93, 0x03, 0x0a, 0x11, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72,
-	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x49,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x63, 0x61, 0x75, 0x73, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x43, 0x61, 0x75, 0x73, 0x65,
-	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65,
-	0x12, 0x2b, 0x0a, 0x11, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x66, 0x66,
-	0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x32, 0x0a,
-	0x0b, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x64, 0x41,
-	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x52, 0x0a, 0x72, 0x65, 0x64, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72,
-	0x73, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x07, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x72,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xed, 0x01, 0x0a, 0x09, 0x52, 0x65,
-	0x64, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2d, 0x0a,
-	0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x10, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c,
-	0x79, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x61,
-	0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
-	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0xf5, 0x01, 0x0a, 0x0d, 0x54, 0x69,
-	0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x73,
-	0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
-	0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52,
-	0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6e, 0x6f,
-	0x6d, 0x61, 0x6c, 0x79, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x0c, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x31,
-	0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74,
-	0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x22, 0xfe, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
-	0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65,
-	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
-	0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x22, 0x81, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x72, 0x72, 0x65,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x52, 0x0c, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26,
-	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
-	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x61, 0x74,
-	0x74, 0x65, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
-	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x22, 0xb2, 0x02, 0x0a, 0x07, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x12, 0x41, 0x0a, 0x10, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x63,
-	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x52, 0x0f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x61, 0x6c, 0x65, 0x6e,
-	0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x61, 0x6c,
-	0x65, 0x6e, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65,
-	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x29, 0x0a,
-	0x07, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52,
-	0x07, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x22, 0xaf, 0x01, 0x0a, 0x0e, 0x41, 0x6e, 0x63,
-	0x68, 0x6f, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x79, 0x70, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x6c, 0x65,
-	0x61, 0x64, 0x5f, 0x6c, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x74, 0x79,
-	0x70, 0x69, 0x63, 0x61, 0x6c, 0x4c, 0x65, 0x61, 0x64, 0x4c, 0x61, 0x67, 0x12, 0x1c, 0x0a, 0x09,
-	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x3f, 0x0a, 0x07, 0x51, 0x75,
-	0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x22, 0x42, 0x0a, 0x13, 0x47,
-	0x65, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
-	0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x08, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x22,
-	0x85, 0x01, 0x0a, 0x0f, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64,
-	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65,
-	0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x50, 0x0a, 0x0b, 0x46, 0x65, 0x65, 0x64, 0x62,
-	0x61, 0x63, 0x6b, 0x41, 0x63, 0x6b, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a,
-	0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x22, 0x0f, 0x0a, 0x0d, 0x48, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x28, 0x0a, 0x0e, 0x48, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x2a, 0x66, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x19, 0x0a, 0x15, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x44,
-	0x41, 0x54, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53,
-	0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x4c, 0x4f, 0x47, 0x53, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x43, 0x45, 0x53, 0x10, 0x03, 0x2a, 0x75, 0x0a, 0x08,
-	0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x45, 0x56, 0x45,
-	0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4c,
-	0x4f, 0x57, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59,
-	0x5f, 0x4d, 0x45, 0x44, 0x49, 0x55, 0x4d, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x45, 0x56,
-	0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11,
-	0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x43, 0x52, 0x49, 0x54, 0x49, 0x43, 0x41,
-	0x4c, 0x10, 0x04, 0x32, 0xfb, 0x02, 0x0a, 0x09, 0x52, 0x43, 0x41, 0x45, 0x6e, 0x67, 0x69, 0x6e,
-	0x65, 0x12, 0x51, 0x0a, 0x13, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x69, 0x67, 0x61, 0x74, 0x65,
-	0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x43, 0x41, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x69, 0x67, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x63, 0x61, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x55, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x72, 0x72,
-	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x63, 0x61, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x47,
-	0x65, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x1a, 0x2e, 0x72, 0x63, 0x61,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x46, 0x65, 0x65,
-	0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x17, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x46,
-	0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
-	0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b,
-	0x41, 0x63, 0x6b, 0x12, 0x3c, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x12, 0x15, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x63, 0x61, 0x2e,
-	0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x42, 0x4a, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x6d, 0x69, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x6d, 0x69, 0x72,
-	0x61, 0x64, 0x6f, 0x72, 0x2d, 0x72, 0x63, 0x61, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64,
-	0x2f, 0x72, 0x63, 0x61, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x63, 0x61, 0x76, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *TimelineEvent) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
 }
 
-var (
-	file_rca_proto_rawDescOnce sync.Once
-	file_rca_proto_rawDescData = file_rca_proto_rawDesc
-)
+func (x *TimelineEvent) GetOngoing() bool {
+	if x != nil {
+		return x.Ongoing
+	}
+	return false
+}
 
-func file_rca_proto_rawDescGZIP() []byte {
-	file_rca_proto_rawDescOnce.Do(func() {
-		file_rca_proto_rawDescData = protoimpl.X.CompressGZIP(file_rca_proto_rawDescData)
-	})
-	return file_rca_proto_rawDescData
+func (x *TimelineEvent) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
 }
 
-var file_rca_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_rca_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
-var file_rca_proto_goTypes = []any{
-	(DataType)(0),                    // 0: rca.v1.DataType
-	(Severity)(0),                    // 1: rca.v1.Severity
-	(*RCAInvestigationRequest)(nil),  // 2: rca.v1.RCAInvestigationRequest
-	(*TimeRange)(nil),                // 3: rca.v1.TimeRange
-	(*CorrelationResult)(nil),        // 4: rca.v1.CorrelationResult
-	(*RedAnchor)(nil),                // 5: rca.v1.RedAnchor
-	(*TimelineEvent)(nil),            // 6: rca.v1.TimelineEvent
-	(*ListCorrelationsRequest)(nil),  // 7: rca.v1.ListCorrelationsRequest
-	(*ListCorrelationsResponse)(nil), // 8: rca.v1.ListCorrelationsResponse
-	(*GetPatternsRequest)(nil),       // 9: rca.v1.GetPatternsRequest
-	(*Pattern)(nil),                  // 10: rca.v1.Pattern
-	(*AnchorTemplate)(nil),           // 11: rca.v1.AnchorTemplate
-	(*Quality)(nil),                  // 12: rca.v1.Quality
-	(*GetPatternsResponse)(nil),      // 13: rca.v1.GetPatternsResponse
-	(*FeedbackRequest)(nil),          // 14: rca.v1.FeedbackRequest
-	(*FeedbackAck)(nil),              // 15: rca.v1.FeedbackAck
-	(*HealthRequest)(nil),            // 16: rca.v1.HealthRequest
-	(*HealthResponse)(nil),           // 17: rca.v1.HealthResponse
-	(*timestamppb.Timestamp)(nil),    // 18: google.protobuf.Timestamp
+type ListCorrelationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId  string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Service   string                 `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	PageSize  int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Category  RootCauseCategory      `protobuf:"varint,7,opt,name=category,proto3,enum=rca.v1.RootCauseCategory" json:"category,omitempty"`
+	// Only correlations carrying every listed label are returned.
+	Labels map[string]string `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
-var file_rca_proto_depIdxs = []int32{
-	3,  // 0: rca.v1.RCAInvestigationRequest.time_range:type_name -> rca.v1.TimeRange
-	18, // 1: rca.v1.TimeRange.start:type_name -> google.protobuf.Timestamp
-	18, // 2: rca.v1.TimeRange.end:type_name -> google.protobuf.Timestamp
-	5,  // 3: rca.v1.CorrelationResult.red_anchors:type_name -> rca.v1.RedAnchor
-	6,  // 4: rca.v1.CorrelationResult.timeline:type_name -> rca.v1.TimelineEvent
-	18, // 5: rca.v1.CorrelationResult.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 6: rca.v1.RedAnchor.data_type:type_name -> rca.v1.DataType
-	18, // 7: rca.v1.RedAnchor.timestamp:type_name -> google.protobuf.Timestamp
-	18, // 8: rca.v1.TimelineEvent.time:type_name -> google.protobuf.Timestamp
-	1,  // 9: rca.v1.TimelineEvent.severity:type_name -> rca.v1.Severity
-	0,  // 10: rca.v1.TimelineEvent.data_source:type_name -> rca.v1.DataType
-	18, // 11: rca.v1.ListCorrelationsRequest.start_time:type_name -> google.protobuf.Timestamp
-	18, // 12: rca.v1.ListCorrelationsRequest.end_time:type_name -> google.protobuf.Timestamp
-	4,  // 13: rca.v1.ListCorrelationsResponse.correlations:type_name -> rca.v1.CorrelationResult
-	11, // 14: rca.v1.Pattern.anchor_templates:type_name -> rca.v1.AnchorTemplate
-	18, // 15: rca.v1.Pattern.last_seen:type_name -> google.protobuf.Timestamp
-	12, // 16: rca.v1.Pattern.quality:type_name -> rca.v1.Quality
-	10, // 17: rca.v1.GetPatternsResponse.patterns:type_name -> rca.v1.Pattern
-	2,  // 18: rca.v1.RCAEngine.InvestigateIncident:input_type -> rca.v1.RCAInvestigationRequest
-	7,  // 19: rca.v1.RCAEngine.ListCorrelations:input_type -> rca.v1.ListCorrelationsRequest
-	9,  // 20: rca.v1.RCAEngine.GetPatterns:input_type -> rca.v1.GetPatternsRequest
-	14, // 21: rca.v1.RCAEngine.SubmitFeedback:input_type -> rca.v1.FeedbackRequest
-	16, // 22: rca.v1.RCAEngine.HealthCheck:input_type -> rca.v1.HealthRequest
-	4,  // 23: rca.v1.RCAEngine.InvestigateIncident:output_type -> rca.v1.CorrelationResult
-	8,  // 24: rca.v1.RCAEngine.ListCorrelations:output_type -> rca.v1.ListCorrelationsResponse
-	13, // 25: rca.v1.RCAEngine.GetPatterns:output_type -> rca.v1.GetPatternsResponse
-	15, // 26: rca.v1.RCAEngine.SubmitFeedback:output_type -> rca.v1.FeedbackAck
-	17, // 27: rca.v1.RCAEngine.HealthCheck:output_type -> rca.v1.HealthResponse
-	23, // [23:28] is the sub-list for method output_type
-	18, // [18:23] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+
+func (x *ListCorrelationsRequest) Reset() {
+	*x = ListCorrelationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func init() { file_rca_proto_init() }
-func file_rca_proto_init() {
-	if File_rca_proto != nil {
-		return
+func (x *ListCorrelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCorrelationsRequest) ProtoMessage() {}
+
+func (x *ListCorrelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_rca_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*RCAInvestigationRequest); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCorrelationsRequest.ProtoReflect.Descriptor instead.
+func (*ListCorrelationsRequest) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListCorrelationsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListCorrelationsRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *ListCorrelationsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListCorrelationsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ListCorrelationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCorrelationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCorrelationsRequest) GetCategory() RootCauseCategory {
+	if x != nil {
+		return x.Category
+	}
+	return RootCauseCategory_ROOT_CAUSE_CATEGORY_UNSPECIFIED
+}
+
+func (x *ListCorrelationsRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type ListCorrelationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Correlations  []*CorrelationResult `protobuf:"bytes,1,rep,name=correlations,proto3" json:"correlations,omitempty"`
+	NextPageToken string               `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListCorrelationsResponse) Reset() {
+	*x = ListCorrelationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCorrelationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCorrelationsResponse) ProtoMessage() {}
+
+func (x *ListCorrelationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCorrelationsResponse.ProtoReflect.Descriptor instead.
+func (*ListCorrelationsResponse) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListCorrelationsResponse) GetCorrelations() []*CorrelationResult {
+	if x != nil {
+		return x.Correlations
+	}
+	return nil
+}
+
+func (x *ListCorrelationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetCorrelationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId      string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	CorrelationId string `protobuf:"bytes,2,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+}
+
+func (x *GetCorrelationRequest) Reset() {
+	*x = GetCorrelationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCorrelationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCorrelationRequest) ProtoMessage() {}
+
+func (x *GetCorrelationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCorrelationRequest.ProtoReflect.Descriptor instead.
+func (*GetCorrelationRequest) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetCorrelationRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *GetCorrelationRequest) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+type ExplainCorrelationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId      string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	CorrelationId string `protobuf:"bytes,2,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+}
+
+func (x *ExplainCorrelationRequest) Reset() {
+	*x = ExplainCorrelationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainCorrelationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainCorrelationRequest) ProtoMessage() {}
+
+func (x *ExplainCorrelationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainCorrelationRequest.ProtoReflect.Descriptor instead.
+func (*ExplainCorrelationRequest) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ExplainCorrelationRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ExplainCorrelationRequest) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+// CorrelationExplanation describes how the engine reached a stored correlation.
+type CorrelationExplanation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CorrelationId string            `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	RootCause     string            `protobuf:"bytes,2,opt,name=root_cause,json=rootCause,proto3" json:"root_cause,omitempty"`
+	Confidence    float64           `protobuf:"fixed64,3,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Category      RootCauseCategory `protobuf:"varint,4,opt,name=category,proto3,enum=rca.v1.RootCauseCategory" json:"category,omitempty"`
+	// False for correlations stored before explanations were recorded; only anchors and the rule IDs of the
+	// recommendations are set then.
+	Recorded bool `protobuf:"varint,5,opt,name=recorded,proto3" json:"recorded,omitempty"`
+	// Primary service the detectors analysed.
+	Service string `protobuf:"bytes,6,opt,name=service,proto3" json:"service,omitempty"`
+	// Anomaly threshold detection ran with, from the request or tuned for the service; 0 means each detector
+	// used its default.
+	Threshold        float64        `protobuf:"fixed64,7,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Detectors        []*DetectorRun `protobuf:"bytes,8,rep,name=detectors,proto3" json:"detectors,omitempty"`
+	CausalityScore   float64        `protobuf:"fixed64,9,opt,name=causality_score,json=causalityScore,proto3" json:"causality_score,omitempty"`
+	SuggestedService string         `protobuf:"bytes,10,opt,name=suggested_service,json=suggestedService,proto3" json:"suggested_service,omitempty"`
+	CausalityNotes   []string       `protobuf:"bytes,11,rep,name=causality_notes,json=causalityNotes,proto3" json:"causality_notes,omitempty"`
+	// Confidence from anomaly scores alone, before causality calibration and the missing-source penalty.
+	DetectionConfidence float64 `protobuf:"fixed64,12,opt,name=detection_confidence,json=detectionConfidence,proto3" json:"detection_confidence,omitempty"`
+	MissingSources      int32   `protobuf:"varint,13,opt,name=missing_sources,json=missingSources,proto3" json:"missing_sources,omitempty"`
+	// Anomalies down-weighted because a maintenance window covered them.
+	MaintenanceAnomalies int32 `protobuf:"varint,14,opt,name=maintenance_anomalies,json=maintenanceAnomalies,proto3" json:"maintenance_anomalies,omitempty"`
+	// similar_incident, rules, or default.
+	RecommendationSource string         `protobuf:"bytes,15,opt,name=recommendation_source,json=recommendationSource,proto3" json:"recommendation_source,omitempty"`
+	MatchedRules         []*MatchedRule `protobuf:"bytes,16,rep,name=matched_rules,json=matchedRules,proto3" json:"matched_rules,omitempty"`
+	// Past correlations found for the incident's symptoms, most similar first.
+	SimilarCorrelations []string     `protobuf:"bytes,17,rep,name=similar_correlations,json=similarCorrelations,proto3" json:"similar_correlations,omitempty"`
+	Anchors             []*RedAnchor `protobuf:"bytes,18,rep,name=anchors,proto3" json:"anchors,omitempty"`
+	// Investigation preset the request selected, if any.
+	Preset string `protobuf:"bytes,19,opt,name=preset,proto3" json:"preset,omitempty"`
+}
+
+func (x *CorrelationExplanation) Reset() {
+	*x = CorrelationExplanation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CorrelationExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorrelationExplanation) ProtoMessage() {}
+
+func (x *CorrelationExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorrelationExplanation.ProtoReflect.Descriptor instead.
+func (*CorrelationExplanation) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CorrelationExplanation) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *CorrelationExplanation) GetRootCause() string {
+	if x != nil {
+		return x.RootCause
+	}
+	return ""
+}
+
+func (x *CorrelationExplanation) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *CorrelationExplanation) GetCategory() RootCauseCategory {
+	if x != nil {
+		return x.Category
+	}
+	return RootCauseCategory_ROOT_CAUSE_CATEGORY_UNSPECIFIED
+}
+
+func (x *CorrelationExplanation) GetRecorded() bool {
+	if x != nil {
+		return x.Recorded
+	}
+	return false
+}
+
+func (x *CorrelationExplanation) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *CorrelationExplanation) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *CorrelationExplanation) GetDetectors() []*DetectorRun {
+	if x != nil {
+		return x.Detectors
+	}
+	return nil
+}
+
+func (x *CorrelationExplanation) GetCausalityScore() float64 {
+	if x != nil {
+		return x.CausalityScore
+	}
+	return 0
+}
+
+func (x *CorrelationExplanation) GetSuggestedService() string {
+	if x != nil {
+		return x.SuggestedService
+	}
+	return ""
+}
+
+func (x *CorrelationExplanation) GetCausalityNotes() []string {
+	if x != nil {
+		return x.CausalityNotes
+	}
+	return nil
+}
+
+func (x *CorrelationExplanation) GetDetectionConfidence() float64 {
+	if x != nil {
+		return x.DetectionConfidence
+	}
+	return 0
+}
+
+func (x *CorrelationExplanation) GetMissingSources() int32 {
+	if x != nil {
+		return x.MissingSources
+	}
+	return 0
+}
+
+func (x *CorrelationExplanation) GetMaintenanceAnomalies() int32 {
+	if x != nil {
+		return x.MaintenanceAnomalies
+	}
+	return 0
+}
+
+func (x *CorrelationExplanation) GetRecommendationSource() string {
+	if x != nil {
+		return x.RecommendationSource
+	}
+	return ""
+}
+
+func (x *CorrelationExplanation) GetMatchedRules() []*MatchedRule {
+	if x != nil {
+		return x.MatchedRules
+	}
+	return nil
+}
+
+func (x *CorrelationExplanation) GetSimilarCorrelations() []string {
+	if x != nil {
+		return x.SimilarCorrelations
+	}
+	return nil
+}
+
+func (x *CorrelationExplanation) GetAnchors() []*RedAnchor {
+	if x != nil {
+		return x.Anchors
+	}
+	return nil
+}
+
+func (x *CorrelationExplanation) GetPreset() string {
+	if x != nil {
+		return x.Preset
+	}
+	return ""
+}
+
+type DetectorRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Anomalies int32  `protobuf:"varint,2,opt,name=anomalies,proto3" json:"anomalies,omitempty"`
+	// Highest threshold the detector's anomalies were held to; 0 when it found none.
+	Threshold float64 `protobuf:"fixed64,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	TopScore  float64 `protobuf:"fixed64,4,opt,name=top_score,json=topScore,proto3" json:"top_score,omitempty"`
+}
+
+func (x *DetectorRun) Reset() {
+	*x = DetectorRun{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DetectorRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectorRun) ProtoMessage() {}
+
+func (x *DetectorRun) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectorRun.ProtoReflect.Descriptor instead.
+func (*DetectorRun) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DetectorRun) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DetectorRun) GetAnomalies() int32 {
+	if x != nil {
+		return x.Anomalies
+	}
+	return 0
+}
+
+func (x *DetectorRun) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *DetectorRun) GetTopScore() float64 {
+	if x != nil {
+		return x.TopScore
+	}
+	return 0
+}
+
+type MatchedRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleId string `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	// Outcome of every condition the rule declares.
+	Reasons []string `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons,omitempty"`
+}
+
+func (x *MatchedRule) Reset() {
+	*x = MatchedRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchedRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchedRule) ProtoMessage() {}
+
+func (x *MatchedRule) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchedRule.ProtoReflect.Descriptor instead.
+func (*MatchedRule) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MatchedRule) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *MatchedRule) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+type SearchCorrelationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId string  `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Query    string  `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Alpha    float64 `protobuf:"fixed64,3,opt,name=alpha,proto3" json:"alpha,omitempty"`
+	Limit    int32   `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *SearchCorrelationsRequest) Reset() {
+	*x = SearchCorrelationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchCorrelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCorrelationsRequest) ProtoMessage() {}
+
+func (x *SearchCorrelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCorrelationsRequest.ProtoReflect.Descriptor instead.
+func (*SearchCorrelationsRequest) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SearchCorrelationsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *SearchCorrelationsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchCorrelationsRequest) GetAlpha() float64 {
+	if x != nil {
+		return x.Alpha
+	}
+	return 0
+}
+
+func (x *SearchCorrelationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ScoredCorrelation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Correlation *CorrelationResult `protobuf:"bytes,1,opt,name=correlation,proto3" json:"correlation,omitempty"`
+	Score       float64            `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *ScoredCorrelation) Reset() {
+	*x = ScoredCorrelation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScoredCorrelation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoredCorrelation) ProtoMessage() {}
+
+func (x *ScoredCorrelation) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoredCorrelation.ProtoReflect.Descriptor instead.
+func (*ScoredCorrelation) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ScoredCorrelation) GetCorrelation() *CorrelationResult {
+	if x != nil {
+		return x.Correlation
+	}
+	return nil
+}
+
+func (x *ScoredCorrelation) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type SearchCorrelationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*ScoredCorrelation `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *SearchCorrelationsResponse) Reset() {
+	*x = SearchCorrelationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchCorrelationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCorrelationsResponse) ProtoMessage() {}
+
+func (x *SearchCorrelationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCorrelationsResponse.ProtoReflect.Descriptor instead.
+func (*SearchCorrelationsResponse) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SearchCorrelationsResponse) GetResults() []*ScoredCorrelation {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetPatternsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Service  string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+}
+
+func (x *GetPatternsRequest) Reset() {
+	*x = GetPatternsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPatternsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPatternsRequest) ProtoMessage() {}
+
+func (x *GetPatternsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPatternsRequest.ProtoReflect.Descriptor instead.
+func (*GetPatternsRequest) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetPatternsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *GetPatternsRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+type Pattern struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Services        []string               `protobuf:"bytes,4,rep,name=services,proto3" json:"services,omitempty"`
+	AnchorTemplates []*AnchorTemplate      `protobuf:"bytes,5,rep,name=anchor_templates,json=anchorTemplates,proto3" json:"anchor_templates,omitempty"`
+	Prevalence      float64                `protobuf:"fixed64,6,opt,name=prevalence,proto3" json:"prevalence,omitempty"`
+	LastSeen        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	Quality         *Quality               `protobuf:"bytes,8,opt,name=quality,proto3" json:"quality,omitempty"`
+}
+
+func (x *Pattern) Reset() {
+	*x = Pattern{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pattern) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pattern) ProtoMessage() {}
+
+func (x *Pattern) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pattern.ProtoReflect.Descriptor instead.
+func (*Pattern) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Pattern) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Pattern) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Pattern) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Pattern) GetServices() []string {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *Pattern) GetAnchorTemplates() []*AnchorTemplate {
+	if x != nil {
+		return x.AnchorTemplates
+	}
+	return nil
+}
+
+func (x *Pattern) GetPrevalence() float64 {
+	if x != nil {
+		return x.Prevalence
+	}
+	return 0
+}
+
+func (x *Pattern) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+func (x *Pattern) GetQuality() *Quality {
+	if x != nil {
+		return x.Quality
+	}
+	return nil
+}
+
+type AnchorTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Service        string  `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	SignalType     string  `protobuf:"bytes,2,opt,name=signal_type,json=signalType,proto3" json:"signal_type,omitempty"`
+	Selector       string  `protobuf:"bytes,3,opt,name=selector,proto3" json:"selector,omitempty"`
+	TypicalLeadLag float64 `protobuf:"fixed64,4,opt,name=typical_lead_lag,json=typicalLeadLag,proto3" json:"typical_lead_lag,omitempty"`
+	Threshold      float64 `protobuf:"fixed64,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (x *AnchorTemplate) Reset() {
+	*x = AnchorTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnchorTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnchorTemplate) ProtoMessage() {}
+
+func (x *AnchorTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnchorTemplate.ProtoReflect.Descriptor instead.
+func (*AnchorTemplate) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AnchorTemplate) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *AnchorTemplate) GetSignalType() string {
+	if x != nil {
+		return x.SignalType
+	}
+	return ""
+}
+
+func (x *AnchorTemplate) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *AnchorTemplate) GetTypicalLeadLag() float64 {
+	if x != nil {
+		return x.TypicalLeadLag
+	}
+	return 0
+}
+
+func (x *AnchorTemplate) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+type Quality struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Precision float64 `protobuf:"fixed64,1,opt,name=precision,proto3" json:"precision,omitempty"`
+	Recall    float64 `protobuf:"fixed64,2,opt,name=recall,proto3" json:"recall,omitempty"`
+}
+
+func (x *Quality) Reset() {
+	*x = Quality{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Quality) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quality) ProtoMessage() {}
+
+func (x *Quality) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quality.ProtoReflect.Descriptor instead.
+func (*Quality) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Quality) GetPrecision() float64 {
+	if x != nil {
+		return x.Precision
+	}
+	return 0
+}
+
+func (x *Quality) GetRecall() float64 {
+	if x != nil {
+		return x.Recall
+	}
+	return 0
+}
+
+type GetPatternsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Patterns []*Pattern `protobuf:"bytes,1,rep,name=patterns,proto3" json:"patterns,omitempty"`
+}
+
+func (x *GetPatternsResponse) Reset() {
+	*x = GetPatternsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPatternsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPatternsResponse) ProtoMessage() {}
+
+func (x *GetPatternsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPatternsResponse.ProtoReflect.Descriptor instead.
+func (*GetPatternsResponse) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetPatternsResponse) GetPatterns() []*Pattern {
+	if x != nil {
+		return x.Patterns
+	}
+	return nil
+}
+
+type FeedbackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId      string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	CorrelationId string `protobuf:"bytes,2,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	Correct       bool   `protobuf:"varint,3,opt,name=correct,proto3" json:"correct,omitempty"`
+	Notes         string `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+}
+
+func (x *FeedbackRequest) Reset() {
+	*x = FeedbackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeedbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedbackRequest) ProtoMessage() {}
+
+func (x *FeedbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedbackRequest.ProtoReflect.Descriptor instead.
+func (*FeedbackRequest) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *FeedbackRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *FeedbackRequest) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *FeedbackRequest) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+func (x *FeedbackRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type FeedbackAck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CorrelationId string `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	Accepted      bool   `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`
+}
+
+func (x *FeedbackAck) Reset() {
+	*x = FeedbackAck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeedbackAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedbackAck) ProtoMessage() {}
+
+func (x *FeedbackAck) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedbackAck.ProtoReflect.Descriptor instead.
+func (*FeedbackAck) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *FeedbackAck) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *FeedbackAck) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+type MaintenanceWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Services []string               `protobuf:"bytes,3,rep,name=services,proto3" json:"services,omitempty"`
+	Start    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	Reason   string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *MaintenanceWindow) Reset() {
+	*x = MaintenanceWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintenanceWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceWindow) ProtoMessage() {}
+
+func (x *MaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MaintenanceWindow) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetServices() []string {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CreateMaintenanceWindowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Window *MaintenanceWindow `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (x *CreateMaintenanceWindowRequest) Reset() {
+	*x = CreateMaintenanceWindowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateMaintenanceWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMaintenanceWindowRequest) ProtoMessage() {}
+
+func (x *CreateMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*CreateMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateMaintenanceWindowRequest) GetWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+type ListMaintenanceWindowsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+}
+
+func (x *ListMaintenanceWindowsRequest) Reset() {
+	*x = ListMaintenanceWindowsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMaintenanceWindowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaintenanceWindowsRequest) ProtoMessage() {}
+
+func (x *ListMaintenanceWindowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaintenanceWindowsRequest.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceWindowsRequest) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListMaintenanceWindowsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type ListMaintenanceWindowsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Windows []*MaintenanceWindow `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (x *ListMaintenanceWindowsResponse) Reset() {
+	*x = ListMaintenanceWindowsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMaintenanceWindowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaintenanceWindowsResponse) ProtoMessage() {}
+
+func (x *ListMaintenanceWindowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaintenanceWindowsResponse.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceWindowsResponse) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListMaintenanceWindowsResponse) GetWindows() []*MaintenanceWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+type DeleteMaintenanceWindowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Id       string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteMaintenanceWindowRequest) Reset() {
+	*x = DeleteMaintenanceWindowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteMaintenanceWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMaintenanceWindowRequest) ProtoMessage() {}
+
+func (x *DeleteMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteMaintenanceWindowRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *DeleteMaintenanceWindowRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteMaintenanceWindowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deleted bool `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (x *DeleteMaintenanceWindowResponse) Reset() {
+	*x = DeleteMaintenanceWindowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteMaintenanceWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMaintenanceWindowResponse) ProtoMessage() {}
+
+func (x *DeleteMaintenanceWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMaintenanceWindowResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMaintenanceWindowResponse) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DeleteMaintenanceWindowResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type PurgeTenantDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Only history older than this is removed; unset erases all tenant data.
+	Before *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	DryRun bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *PurgeTenantDataRequest) Reset() {
+	*x = PurgeTenantDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeTenantDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTenantDataRequest) ProtoMessage() {}
+
+func (x *PurgeTenantDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTenantDataRequest.ProtoReflect.Descriptor instead.
+func (*PurgeTenantDataRequest) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PurgeTenantDataRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *PurgeTenantDataRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *PurgeTenantDataRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type PurgeTenantDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Correlations      int32 `protobuf:"varint,1,opt,name=correlations,proto3" json:"correlations,omitempty"`
+	Feedback          int32 `protobuf:"varint,2,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	Patterns          int32 `protobuf:"varint,3,opt,name=patterns,proto3" json:"patterns,omitempty"`
+	DryRun            bool  `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	TopologySnapshots int32 `protobuf:"varint,5,opt,name=topology_snapshots,json=topologySnapshots,proto3" json:"topology_snapshots,omitempty"`
+}
+
+func (x *PurgeTenantDataResponse) Reset() {
+	*x = PurgeTenantDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeTenantDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTenantDataResponse) ProtoMessage() {}
+
+func (x *PurgeTenantDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTenantDataResponse.ProtoReflect.Descriptor instead.
+func (*PurgeTenantDataResponse) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PurgeTenantDataResponse) GetCorrelations() int32 {
+	if x != nil {
+		return x.Correlations
+	}
+	return 0
+}
+
+func (x *PurgeTenantDataResponse) GetFeedback() int32 {
+	if x != nil {
+		return x.Feedback
+	}
+	return 0
+}
+
+func (x *PurgeTenantDataResponse) GetPatterns() int32 {
+	if x != nil {
+		return x.Patterns
+	}
+	return 0
+}
+
+func (x *PurgeTenantDataResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *PurgeTenantDataResponse) GetTopologySnapshots() int32 {
+	if x != nil {
+		return x.TopologySnapshots
+	}
+	return 0
+}
+
+type GetFeedbackStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId  string     `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	TimeRange *TimeRange `protobuf:"bytes,2,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	// Width of the accuracy time buckets; defaults to one day.
+	BucketSeconds int64 `protobuf:"varint,3,opt,name=bucket_seconds,json=bucketSeconds,proto3" json:"bucket_seconds,omitempty"`
+}
+
+func (x *GetFeedbackStatsRequest) Reset() {
+	*x = GetFeedbackStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFeedbackStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedbackStatsRequest) ProtoMessage() {}
+
+func (x *GetFeedbackStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedbackStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetFeedbackStatsRequest) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetFeedbackStatsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *GetFeedbackStatsRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *GetFeedbackStatsRequest) GetBucketSeconds() int64 {
+	if x != nil {
+		return x.BucketSeconds
+	}
+	return 0
+}
+
+type AccuracyStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Total    int32   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Correct  int32   `protobuf:"varint,3,opt,name=correct,proto3" json:"correct,omitempty"`
+	Accuracy float64 `protobuf:"fixed64,4,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+}
+
+func (x *AccuracyStat) Reset() {
+	*x = AccuracyStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccuracyStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccuracyStat) ProtoMessage() {}
+
+func (x *AccuracyStat) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccuracyStat.ProtoReflect.Descriptor instead.
+func (*AccuracyStat) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AccuracyStat) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AccuracyStat) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AccuracyStat) GetCorrect() int32 {
+	if x != nil {
+		return x.Correct
+	}
+	return 0
+}
+
+func (x *AccuracyStat) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+type AccuracyBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Total    int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Correct  int32                  `protobuf:"varint,3,opt,name=correct,proto3" json:"correct,omitempty"`
+	Accuracy float64                `protobuf:"fixed64,4,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+}
+
+func (x *AccuracyBucket) Reset() {
+	*x = AccuracyBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccuracyBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccuracyBucket) ProtoMessage() {}
+
+func (x *AccuracyBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccuracyBucket.ProtoReflect.Descriptor instead.
+func (*AccuracyBucket) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AccuracyBucket) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *AccuracyBucket) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AccuracyBucket) GetCorrect() int32 {
+	if x != nil {
+		return x.Correct
+	}
+	return 0
+}
+
+func (x *AccuracyBucket) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+type GetFeedbackStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total     int32           `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Correct   int32           `protobuf:"varint,2,opt,name=correct,proto3" json:"correct,omitempty"`
+	Accuracy  float64         `protobuf:"fixed64,3,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	ByService []*AccuracyStat `protobuf:"bytes,4,rep,name=by_service,json=byService,proto3" json:"by_service,omitempty"`
+	// Keyed by root-cause category.
+	ByCategory []*AccuracyStat   `protobuf:"bytes,5,rep,name=by_category,json=byCategory,proto3" json:"by_category,omitempty"`
+	ByBucket   []*AccuracyBucket `protobuf:"bytes,6,rep,name=by_bucket,json=byBucket,proto3" json:"by_bucket,omitempty"`
+	// Keyed by the id of each mined failure pattern the reviewed correlation matches.
+	ByPattern []*AccuracyStat `protobuf:"bytes,7,rep,name=by_pattern,json=byPattern,proto3" json:"by_pattern,omitempty"`
+}
+
+func (x *GetFeedbackStatsResponse) Reset() {
+	*x = GetFeedbackStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFeedbackStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedbackStatsResponse) ProtoMessage() {}
+
+func (x *GetFeedbackStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedbackStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetFeedbackStatsResponse) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetFeedbackStatsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetFeedbackStatsResponse) GetCorrect() int32 {
+	if x != nil {
+		return x.Correct
+	}
+	return 0
+}
+
+func (x *GetFeedbackStatsResponse) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *GetFeedbackStatsResponse) GetByService() []*AccuracyStat {
+	if x != nil {
+		return x.ByService
+	}
+	return nil
+}
+
+func (x *GetFeedbackStatsResponse) GetByCategory() []*AccuracyStat {
+	if x != nil {
+		return x.ByCategory
+	}
+	return nil
+}
+
+func (x *GetFeedbackStatsResponse) GetByBucket() []*AccuracyBucket {
+	if x != nil {
+		return x.ByBucket
+	}
+	return nil
+}
+
+func (x *GetFeedbackStatsResponse) GetByPattern() []*AccuracyStat {
+	if x != nil {
+		return x.ByPattern
+	}
+	return nil
+}
+
+type MinePatternsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Correlations created in this range are mined; an unset start uses the configured lookback.
+	TimeRange *TimeRange `protobuf:"bytes,2,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	// Return immediately with a job id instead of waiting for the mined patterns.
+	Async bool `protobuf:"varint,3,opt,name=async,proto3" json:"async,omitempty"`
+}
+
+func (x *MinePatternsRequest) Reset() {
+	*x = MinePatternsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MinePatternsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MinePatternsRequest) ProtoMessage() {}
+
+func (x *MinePatternsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MinePatternsRequest.ProtoReflect.Descriptor instead.
+func (*MinePatternsRequest) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *MinePatternsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *MinePatternsRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *MinePatternsRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+type MinePatternsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Patterns     []*Pattern `protobuf:"bytes,1,rep,name=patterns,proto3" json:"patterns,omitempty"`
+	Correlations int32      `protobuf:"varint,2,opt,name=correlations,proto3" json:"correlations,omitempty"`
+	JobId        string     `protobuf:"bytes,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (x *MinePatternsResponse) Reset() {
+	*x = MinePatternsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MinePatternsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MinePatternsResponse) ProtoMessage() {}
+
+func (x *MinePatternsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MinePatternsResponse.ProtoReflect.Descriptor instead.
+func (*MinePatternsResponse) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MinePatternsResponse) GetPatterns() []*Pattern {
+	if x != nil {
+		return x.Patterns
+	}
+	return nil
+}
+
+func (x *MinePatternsResponse) GetCorrelations() int32 {
+	if x != nil {
+		return x.Correlations
+	}
+	return 0
+}
+
+func (x *MinePatternsResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type UpdateCorrelationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId      string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	CorrelationId string `protobuf:"bytes,2,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	// UNSPECIFIED keeps the current status.
+	Status CorrelationStatus `protobuf:"varint,3,opt,name=status,proto3,enum=rca.v1.CorrelationStatus" json:"status,omitempty"`
+	// Appended to the correlation's existing annotations.
+	Annotations []*Annotation `protobuf:"bytes,4,rep,name=annotations,proto3" json:"annotations,omitempty"`
+	// Merged into the correlation's labels; an empty value removes the label.
+	Labels map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *UpdateCorrelationRequest) Reset() {
+	*x = UpdateCorrelationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCorrelationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCorrelationRequest) ProtoMessage() {}
+
+func (x *UpdateCorrelationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCorrelationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCorrelationRequest) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UpdateCorrelationRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *UpdateCorrelationRequest) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *UpdateCorrelationRequest) GetStatus() CorrelationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CorrelationStatus_CORRELATION_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateCorrelationRequest) GetAnnotations() []*Annotation {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *UpdateCorrelationRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type Recommendation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text    string                  `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	RuleId  string                  `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Actions []*RecommendationAction `protobuf:"bytes,3,rep,name=actions,proto3" json:"actions,omitempty"`
+	// Set when a language model suggested the recommendation; responders should verify it before acting.
+	Generated bool `protobuf:"varint,4,opt,name=generated,proto3" json:"generated,omitempty"`
+}
+
+func (x *Recommendation) Reset() {
+	*x = Recommendation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Recommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recommendation) ProtoMessage() {}
+
+func (x *Recommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recommendation.ProtoReflect.Descriptor instead.
+func (*Recommendation) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Recommendation) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Recommendation) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *Recommendation) GetActions() []*RecommendationAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *Recommendation) GetGenerated() bool {
+	if x != nil {
+		return x.Generated
+	}
+	return false
+}
+
+type RecommendationAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  RecommendationActionType `protobuf:"varint,1,opt,name=type,proto3,enum=rca.v1.RecommendationActionType" json:"type,omitempty"`
+	Label string                   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Url   string                   `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *RecommendationAction) Reset() {
+	*x = RecommendationAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendationAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendationAction) ProtoMessage() {}
+
+func (x *RecommendationAction) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendationAction.ProtoReflect.Descriptor instead.
+func (*RecommendationAction) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RecommendationAction) GetType() RecommendationActionType {
+	if x != nil {
+		return x.Type
+	}
+	return RecommendationActionType_RECOMMENDATION_ACTION_TYPE_UNSPECIFIED
+}
+
+func (x *RecommendationAction) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *RecommendationAction) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type TestRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Sample investigation the rules are evaluated against; an unset time_range defaults to the last 30 minutes.
+	Request  *RCAInvestigationRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Anchors  []*RedAnchor             `protobuf:"bytes,2,rep,name=anchors,proto3" json:"anchors,omitempty"`
+	Timeline []*TimelineEvent         `protobuf:"bytes,3,rep,name=timeline,proto3" json:"timeline,omitempty"`
+	// Rule pack YAML to evaluate instead of the loaded rules, so a pack can be validated before it is deployed.
+	RulesYaml string `protobuf:"bytes,4,opt,name=rules_yaml,json=rulesYaml,proto3" json:"rules_yaml,omitempty"`
+}
+
+func (x *TestRulesRequest) Reset() {
+	*x = TestRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestRulesRequest) ProtoMessage() {}
+
+func (x *TestRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestRulesRequest.ProtoReflect.Descriptor instead.
+func (*TestRulesRequest) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *TestRulesRequest) GetRequest() *RCAInvestigationRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *TestRulesRequest) GetAnchors() []*RedAnchor {
+	if x != nil {
+		return x.Anchors
+	}
+	return nil
+}
+
+func (x *TestRulesRequest) GetTimeline() []*TimelineEvent {
+	if x != nil {
+		return x.Timeline
+	}
+	return nil
+}
+
+func (x *TestRulesRequest) GetRulesYaml() string {
+	if x != nil {
+		return x.RulesYaml
+	}
+	return ""
+}
+
+type RuleEvaluation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleId   string `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Priority int32  `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	Matched  bool   `protobuf:"varint,3,opt,name=matched,proto3" json:"matched,omitempty"`
+	// Outcome of every condition the rule declares.
+	Reasons         []string          `protobuf:"bytes,4,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	Recommendations []*Recommendation `protobuf:"bytes,5,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	// Why a matching rule contributed nothing or less than it offers (group taken, duplicates, limit reached).
+	Skipped string `protobuf:"bytes,6,opt,name=skipped,proto3" json:"skipped,omitempty"`
+}
+
+func (x *RuleEvaluation) Reset() {
+	*x = RuleEvaluation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuleEvaluation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleEvaluation) ProtoMessage() {}
+
+func (x *RuleEvaluation) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleEvaluation.ProtoReflect.Descriptor instead.
+func (*RuleEvaluation) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RuleEvaluation) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *RuleEvaluation) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *RuleEvaluation) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+func (x *RuleEvaluation) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *RuleEvaluation) GetRecommendations() []*Recommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+func (x *RuleEvaluation) GetSkipped() string {
+	if x != nil {
+		return x.Skipped
+	}
+	return ""
+}
+
+type TestRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Every rule in priority order.
+	Evaluations []*RuleEvaluation `protobuf:"bytes,1,rep,name=evaluations,proto3" json:"evaluations,omitempty"`
+	// What InvestigateIncident would attach from the rules.
+	Recommendations []*Recommendation `protobuf:"bytes,2,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+}
+
+func (x *TestRulesResponse) Reset() {
+	*x = TestRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestRulesResponse) ProtoMessage() {}
+
+func (x *TestRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestRulesResponse.ProtoReflect.Descriptor instead.
+func (*TestRulesResponse) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *TestRulesResponse) GetEvaluations() []*RuleEvaluation {
+	if x != nil {
+		return x.Evaluations
+	}
+	return nil
+}
+
+func (x *TestRulesResponse) GetRecommendations() []*Recommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+type GetThresholdRecommendationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Retune from the stored history now instead of returning the last run's recommendations.
+	Refresh bool `protobuf:"varint,2,opt,name=refresh,proto3" json:"refresh,omitempty"`
+}
+
+func (x *GetThresholdRecommendationsRequest) Reset() {
+	*x = GetThresholdRecommendationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetThresholdRecommendationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThresholdRecommendationsRequest) ProtoMessage() {}
+
+func (x *GetThresholdRecommendationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThresholdRecommendationsRequest.ProtoReflect.Descriptor instead.
+func (*GetThresholdRecommendationsRequest) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetThresholdRecommendationsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *GetThresholdRecommendationsRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
+// ThresholdRecommendation is the anomaly threshold tuned for one service from its correlation history.
+type ThresholdRecommendation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Service   string  `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Threshold float64 `protobuf:"fixed64,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// The threshold the service's anomalies were detected with.
+	Current float64 `protobuf:"fixed64,3,opt,name=current,proto3" json:"current,omitempty"`
+	// "feedback" when analyst verdicts decided the threshold, "noise" when the anomaly score distribution did.
+	Basis        string `protobuf:"bytes,4,opt,name=basis,proto3" json:"basis,omitempty"`
+	Correlations int32  `protobuf:"varint,5,opt,name=correlations,proto3" json:"correlations,omitempty"`
+	// How many of the correlations carry analyst feedback.
+	Feedback int32 `protobuf:"varint,6,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	// Mean metric anchors per correlation at the current and the recommended threshold.
+	AnchorsPerCorrelation float64                `protobuf:"fixed64,7,opt,name=anchors_per_correlation,json=anchorsPerCorrelation,proto3" json:"anchors_per_correlation,omitempty"`
+	ExpectedAnchors       float64                `protobuf:"fixed64,8,opt,name=expected_anchors,json=expectedAnchors,proto3" json:"expected_anchors,omitempty"`
+	UpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *ThresholdRecommendation) Reset() {
+	*x = ThresholdRecommendation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThresholdRecommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdRecommendation) ProtoMessage() {}
+
+func (x *ThresholdRecommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdRecommendation.ProtoReflect.Descriptor instead.
+func (*ThresholdRecommendation) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ThresholdRecommendation) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *ThresholdRecommendation) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *ThresholdRecommendation) GetCurrent() float64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *ThresholdRecommendation) GetBasis() string {
+	if x != nil {
+		return x.Basis
+	}
+	return ""
+}
+
+func (x *ThresholdRecommendation) GetCorrelations() int32 {
+	if x != nil {
+		return x.Correlations
+	}
+	return 0
+}
+
+func (x *ThresholdRecommendation) GetFeedback() int32 {
+	if x != nil {
+		return x.Feedback
+	}
+	return 0
+}
+
+func (x *ThresholdRecommendation) GetAnchorsPerCorrelation() float64 {
+	if x != nil {
+		return x.AnchorsPerCorrelation
+	}
+	return 0
+}
+
+func (x *ThresholdRecommendation) GetExpectedAnchors() float64 {
+	if x != nil {
+		return x.ExpectedAnchors
+	}
+	return 0
+}
+
+func (x *ThresholdRecommendation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetThresholdRecommendationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recommendations []*ThresholdRecommendation `protobuf:"bytes,1,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	// Whether investigations of the tenant that set no anomaly threshold use these recommendations.
+	Applied bool `protobuf:"varint,2,opt,name=applied,proto3" json:"applied,omitempty"`
+}
+
+func (x *GetThresholdRecommendationsResponse) Reset() {
+	*x = GetThresholdRecommendationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetThresholdRecommendationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThresholdRecommendationsResponse) ProtoMessage() {}
+
+func (x *GetThresholdRecommendationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThresholdRecommendationsResponse.ProtoReflect.Descriptor instead.
+func (*GetThresholdRecommendationsResponse) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetThresholdRecommendationsResponse) GetRecommendations() []*ThresholdRecommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+func (x *GetThresholdRecommendationsResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+// GetServiceGraphRequest asks for a tenant's service dependency graph with a correlation's anomalies overlaid.
+type GetServiceGraphRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Window the graph covers. Defaults to the overlaid correlation's analysed window when it was widened, and
+	// otherwise to the 15 minutes before it was created, or before now without a correlation.
+	TimeRange *TimeRange `protobuf:"bytes,2,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	// Deployment environment the graph is fetched from. Empty uses the environment of the requested correlation,
+	// and otherwise leaves the graph unscoped.
+	Environment string `protobuf:"bytes,3,opt,name=environment,proto3" json:"environment,omitempty"`
+	// Correlation whose anomalies are overlaid; empty overlays the tenant's latest correlation in the environment.
+	CorrelationId string `protobuf:"bytes,4,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+}
+
+func (x *GetServiceGraphRequest) Reset() {
+	*x = GetServiceGraphRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceGraphRequest) ProtoMessage() {}
+
+func (x *GetServiceGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceGraphRequest.ProtoReflect.Descriptor instead.
+func (*GetServiceGraphRequest) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetServiceGraphRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *GetServiceGraphRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *GetServiceGraphRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *GetServiceGraphRequest) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+// ServiceGraphNode is one service of the graph with the overlaid correlation's view of it.
+type ServiceGraphNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	// Highest anomaly score among the correlation's anchors on the service; 0 when it has none.
+	AnomalyScore float64 `protobuf:"fixed64,2,opt,name=anomaly_score,json=anomalyScore,proto3" json:"anomaly_score,omitempty"`
+	AnchorCount  int32   `protobuf:"varint,3,opt,name=anchor_count,json=anchorCount,proto3" json:"anchor_count,omitempty"`
+	// Blast radius score and depth from the suspected root, which scores 1 at depth 0; a score of 0 means the
+	// service is outside the blast radius.
+	ImpactScore float64 `protobuf:"fixed64,4,opt,name=impact_score,json=impactScore,proto3" json:"impact_score,omitempty"`
+	ImpactDepth int32   `protobuf:"varint,5,opt,name=impact_depth,json=impactDepth,proto3" json:"impact_depth,omitempty"`
+	// Whether the correlation blamed the service.
+	RootCause bool `protobuf:"varint,6,opt,name=root_cause,json=rootCause,proto3" json:"root_cause,omitempty"`
+	// Whether the service is one of the correlation's affected services.
+	Affected bool `protobuf:"varint,7,opt,name=affected,proto3" json:"affected,omitempty"`
+}
+
+func (x *ServiceGraphNode) Reset() {
+	*x = ServiceGraphNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceGraphNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceGraphNode) ProtoMessage() {}
+
+func (x *ServiceGraphNode) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceGraphNode.ProtoReflect.Descriptor instead.
+func (*ServiceGraphNode) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ServiceGraphNode) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *ServiceGraphNode) GetAnomalyScore() float64 {
+	if x != nil {
+		return x.AnomalyScore
+	}
+	return 0
+}
+
+func (x *ServiceGraphNode) GetAnchorCount() int32 {
+	if x != nil {
+		return x.AnchorCount
+	}
+	return 0
+}
+
+func (x *ServiceGraphNode) GetImpactScore() float64 {
+	if x != nil {
+		return x.ImpactScore
+	}
+	return 0
+}
+
+func (x *ServiceGraphNode) GetImpactDepth() int32 {
+	if x != nil {
+		return x.ImpactDepth
+	}
+	return 0
+}
+
+func (x *ServiceGraphNode) GetRootCause() bool {
+	if x != nil {
+		return x.RootCause
+	}
+	return false
+}
+
+func (x *ServiceGraphNode) GetAffected() bool {
+	if x != nil {
+		return x.Affected
+	}
+	return false
+}
+
+type ServiceGraphEdge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// Calls per second.
+	CallRate float64 `protobuf:"fixed64,3,opt,name=call_rate,json=callRate,proto3" json:"call_rate,omitempty"`
+	// Percentage of calls that failed.
+	ErrorRate float64 `protobuf:"fixed64,4,opt,name=error_rate,json=errorRate,proto3" json:"error_rate,omitempty"`
+	// Whether the callee had anomalies in the overlaid correlation, so failures may reach the caller this way.
+	Anomalous bool `protobuf:"varint,5,opt,name=anomalous,proto3" json:"anomalous,omitempty"`
+}
+
+func (x *ServiceGraphEdge) Reset() {
+	*x = ServiceGraphEdge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceGraphEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceGraphEdge) ProtoMessage() {}
+
+func (x *ServiceGraphEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceGraphEdge.ProtoReflect.Descriptor instead.
+func (*ServiceGraphEdge) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ServiceGraphEdge) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ServiceGraphEdge) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ServiceGraphEdge) GetCallRate() float64 {
+	if x != nil {
+		return x.CallRate
+	}
+	return 0
+}
+
+func (x *ServiceGraphEdge) GetErrorRate() float64 {
+	if x != nil {
+		return x.ErrorRate
+	}
+	return 0
+}
+
+func (x *ServiceGraphEdge) GetAnomalous() bool {
+	if x != nil {
+		return x.Anomalous
+	}
+	return false
+}
+
+type GetServiceGraphResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Services sorted by name.
+	Nodes []*ServiceGraphNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges []*ServiceGraphEdge `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	// Window the graph covers.
+	TimeRange *TimeRange `protobuf:"bytes,3,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	// Correlation overlaid on the graph; empty when the tenant has none to overlay.
+	CorrelationId string `protobuf:"bytes,4,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+}
+
+func (x *GetServiceGraphResponse) Reset() {
+	*x = GetServiceGraphResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceGraphResponse) ProtoMessage() {}
+
+func (x *GetServiceGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceGraphResponse.ProtoReflect.Descriptor instead.
+func (*GetServiceGraphResponse) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetServiceGraphResponse) GetNodes() []*ServiceGraphNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *GetServiceGraphResponse) GetEdges() []*ServiceGraphEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *GetServiceGraphResponse) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *GetServiceGraphResponse) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+type HealthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HealthRequest) Reset() {
+	*x = HealthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthRequest) ProtoMessage() {}
+
+func (x *HealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthRequest.ProtoReflect.Descriptor instead.
+func (*HealthRequest) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{59}
+}
+
+type HealthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *HealthResponse) Reset() {
+	*x = HealthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthResponse) ProtoMessage() {}
+
+func (x *HealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
+func (*HealthResponse) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *HealthResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetVersionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetVersionRequest) Reset() {
+	*x = GetVersionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVersionRequest) ProtoMessage() {}
+
+func (x *GetVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVersionRequest.ProtoReflect.Descriptor instead.
+func (*GetVersionRequest) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{61}
+}
+
+// GetVersionResponse identifies the engine build serving the request.
+type GetVersionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Commit  string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	// RFC 3339 UTC build time.
+	BuildDate string `protobuf:"bytes,3,opt,name=build_date,json=buildDate,proto3" json:"build_date,omitempty"`
+	GoVersion string `protobuf:"bytes,4,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
+}
+
+func (x *GetVersionResponse) Reset() {
+	*x = GetVersionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rca_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVersionResponse) ProtoMessage() {}
+
+func (x *GetVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rca_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVersionResponse.ProtoReflect.Descriptor instead.
+func (*GetVersionResponse) Descriptor() ([]byte, []int) {
+	return file_rca_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetVersionResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetVersionResponse) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *GetVersionResponse) GetBuildDate() string {
+	if x != nil {
+		return x.BuildDate
+	}
+	return ""
+}
+
+func (x *GetVersionResponse) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
+	}
+	return ""
+}
+
+var File_rca_proto protoreflect.FileDescriptor
+
+var file_rca_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x72, 0x63, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x72, 0x63, 0x61,
+	0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xef, 0x03, 0x0a, 0x17, 0x52, 0x43, 0x41, 0x49, 0x6e, 0x76, 0x65,
+	0x73, 0x74, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x79, 0x6d, 0x70, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x79, 0x6d, 0x70, 0x74, 0x6f, 0x6d, 0x73, 0x12, 0x30, 0x0a,
+	0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x2b, 0x0a, 0x11, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11,
+	0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x43, 0x41, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x69,
+	0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x39, 0x0a, 0x0b, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x98, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x63, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x5f, 0x66, 0x69, 0x6e,
+	0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x11, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e,
+	0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x55, 0x72,
+	0x6c, 0x22, 0x6b, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x30,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x92,
+	0x0a, 0x0a, 0x11, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x63, 0x61, 0x75, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x43, 0x61, 0x75, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x0b, 0x72, 0x65, 0x64, 0x5f,
+	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x64, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x52, 0x0a, 0x72, 0x65, 0x64, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x31, 0x0a, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x28, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x0c, 0x62, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x61,
+	0x64, 0x69, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x63, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6d, 0x70, 0x61, 0x63,
+	0x74, 0x52, 0x0b, 0x62, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x35,
+	0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x43, 0x61,
+	0x75, 0x73, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x41, 0x0a, 0x13, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x12, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x75, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x12, 0x31, 0x0a, 0x14, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x65, 0x64, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x34, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x16,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72,
+	0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x42, 0x0a, 0x10, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f,
+	0x65, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x45,
+	0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x63,
+	0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
+	0x79, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x69, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x73, 0x6c, 0x6f, 0x5f, 0x69, 0x6d, 0x70, 0x61,
+	0x63, 0x74, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x63, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x4c, 0x4f, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x52, 0x0a, 0x73, 0x6c,
+	0x6f, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xb8, 0x01, 0x0a, 0x09, 0x53, 0x4c, 0x4f, 0x49, 0x6d, 0x70, 0x61, 0x63,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x6c, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6c, 0x6f, 0x12, 0x1c, 0x0a,
+	0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62,
+	0x61, 0x64, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x62, 0x61, 0x64, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b,
+	0x0a, 0x09, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x62, 0x75, 0x72, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x22, 0xae,
+	0x01, 0x0a, 0x10, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x69, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2b, 0x0a,
+	0x11, 0x73, 0x61, 0x74, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x72, 0x69, 0x76,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x61, 0x74, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x69, 0x76, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x61,
+	0x74, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x73, 0x61, 0x74, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x09, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22,
+	0x96, 0x02, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x65, 0x61, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x70, 0x65, 0x61,
+	0x6b, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x65, 0x61,
+	0x64, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x68, 0x65, 0x61,
+	0x64, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x61, 0x74, 0x75, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x73, 0x61, 0x74, 0x75, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x63, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x1a, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x65, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x17, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x45, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xe5, 0x01, 0x0a, 0x0f, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x09,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x2d, 0x0a,
+	0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x0e,
+	0x73, 0x70, 0x61, 0x72, 0x73, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x73, 0x70, 0x61, 0x72, 0x73, 0x65, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73,
+	0x22, 0x73, 0x0a, 0x0a, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x55, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x22, 0xef, 0x02, 0x0a,
+	0x09, 0x52, 0x65, 0x64, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x2d, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6e, 0x6f,
+	0x6d, 0x61, 0x6c, 0x79, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0c, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2c, 0x0a, 0x08,
+	0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69,
+	0x6e, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x20,
+	0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb4,
+	0x01, 0x0a, 0x08, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c,
+	0x6f, 0x67, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x6c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x73,
+	0x12, 0x33, 0x0a, 0x09, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x72, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x63, 0x65, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x72, 0x52, 0x09, 0x65, 0x78, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x72, 0x73, 0x22, 0xe8, 0x01, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x63, 0x65, 0x45,
+	0x78, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x70, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x70, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04,
+	0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b,
+	0x22, 0x5e, 0x0a, 0x0c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0xa1, 0x03, 0x0a, 0x0d, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x5f, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x31, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x72, 0x63, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x64, 0x61,
+	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x30, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c,
+	0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x6f, 0x6e, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f,
+	0x6e, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb5, 0x03, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x72,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x43, 0x61, 0x75, 0x73, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x43, 0x0a, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x72,
+	0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x81, 0x01, 0x0a,
+	0x18, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x72,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0c, 0x63, 0x6f, 0x72, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x5b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x5f, 0x0a,
+	0x19, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xb3,
+	0x06, 0x0a, 0x16, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78,
+	0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x63, 0x61, 0x75, 0x73, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x43, 0x61, 0x75, 0x73, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x35, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x43,
+	0x61, 0x75, 0x73, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x31, 0x0a, 0x09, 0x64, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52,
+	0x75, 0x6e, 0x52, 0x09, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x63, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x63, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x69, 0x74,
+	0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x61,
+	0x75, 0x73, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x14,
+	0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x64, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x27, 0x0a, 0x0f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x15, 0x6d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x65,
+	0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x33, 0x0a,
+	0x15, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x63, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0c,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x14,
+	0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x73, 0x69, 0x6d, 0x69,
+	0x6c, 0x61, 0x72, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x2b, 0x0a, 0x07, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x64, 0x41, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x52, 0x07, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x22, 0x7a, 0x0a, 0x0b, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x75, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6d, 0x61,
+	0x6c, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6d,
+	0x61, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x70, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x74, 0x6f, 0x70, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x22, 0x40, 0x0a, 0x0b, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x73, 0x22, 0x7a, 0x0a, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x72, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x66,
+	0x0a, 0x11, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x51, 0x0a, 0x1a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x4b, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0xb2, 0x02, 0x0a, 0x07, 0x50, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x10, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x0f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x61,
+	0x6c, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x72, 0x65,
+	0x76, 0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x73, 0x65, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e,
+	0x12, 0x29, 0x0a, 0x07, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x07, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x22, 0xaf, 0x01, 0x0a, 0x0e,
+	0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x79, 0x70, 0x69, 0x63, 0x61, 0x6c,
+	0x5f, 0x6c, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0e, 0x74, 0x79, 0x70, 0x69, 0x63, 0x61, 0x6c, 0x4c, 0x65, 0x61, 0x64, 0x4c, 0x61, 0x67, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x3f, 0x0a,
+	0x07, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x70, 0x72, 0x65,
+	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x61, 0x6c, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x22, 0x42,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x08, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0f, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x72,
+	0x72, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x50, 0x0a, 0x0b, 0x46, 0x65,
+	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x41, 0x63, 0x6b, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x22, 0xd4, 0x01, 0x0a,
+	0x11, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a,
+	0x03, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x53, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x3c, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x63, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x22, 0x4d, 0x0a,
+	0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3b, 0x0a, 0x1f,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x16, 0x50, 0x75,
+	0x72, 0x67, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xbd,
+	0x01, 0x0a, 0x17, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12,
+	0x2d, 0x0a, 0x12, 0x74, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x5f, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x74, 0x6f, 0x70,
+	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x22, 0x8f,
+	0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x63,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x22, 0x6c, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x72, 0x72,
+	0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65,
+	0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x22, 0x8e,
+	0x01, 0x0a, 0x0e, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x72,
+	0x72, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x72, 0x72,
+	0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x22,
+	0xbc, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x12, 0x33, 0x0a, 0x0a, 0x62, 0x79, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72,
+	0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x52, 0x09, 0x62, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x35, 0x0a,
+	0x0b, 0x62, 0x79, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x75,
+	0x72, 0x61, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x52, 0x0a, 0x62, 0x79, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x12, 0x33, 0x0a, 0x09, 0x62, 0x79, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52,
+	0x08, 0x62, 0x79, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x33, 0x0a, 0x0a, 0x62, 0x79, 0x5f,
+	0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x52, 0x09, 0x62, 0x79, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0x7a,
+	0x0a, 0x13, 0x4d, 0x69, 0x6e, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x30, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x22, 0x7e, 0x0a, 0x14, 0x4d, 0x69,
+	0x6e, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x08, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12,
+	0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0xc8, 0x02, 0x0a, 0x18, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x72, 0x63,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34,
+	0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x44, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x93, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x22, 0x74, 0x0a, 0x14, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x20, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x6c, 0x22, 0xcc, 0x01, 0x0a, 0x10, 0x54, 0x65, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x43, 0x41, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2b, 0x0a, 0x07, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x64, 0x41,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x52, 0x07, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x31,
+	0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x5f, 0x79, 0x61, 0x6d, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x59, 0x61, 0x6d, 0x6c,
+	0x22, 0xd5, 0x01, 0x0a, 0x0e, 0x52, 0x75, 0x6c, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x40, 0x0a, 0x0f,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0x8f, 0x01, 0x0a, 0x11, 0x54, 0x65, 0x73,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38,
+	0x0a, 0x0b, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c,
+	0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x65, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x40, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5b, 0x0a, 0x22, 0x47, 0x65,
+	0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0xdf, 0x02, 0x0a, 0x17, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x69, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x61, 0x73, 0x69, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63,
+	0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x36, 0x0a, 0x17, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x61, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x73, 0x50, 0x65, 0x72, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x39,
+	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x23, 0x47, 0x65,
+	0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x49, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x63, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x22, 0xb0, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x30,
+	0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xf5, 0x01, 0x0a, 0x10, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6e, 0x6f, 0x6d,
+	0x61, 0x6c, 0x79, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0c, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x69, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x5f, 0x64, 0x65,
+	0x70, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x6d, 0x70, 0x61, 0x63,
+	0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x63,
+	0x61, 0x75, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x6f, 0x6f, 0x74,
+	0x43, 0x61, 0x75, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x22, 0x9c, 0x01, 0x0a, 0x10, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x45, 0x64, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x6f, 0x75, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x6f, 0x75, 0x73,
+	0x22, 0xd2, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05,
+	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x63,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x72, 0x61, 0x70,
+	0x68, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x05,
+	0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x63,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x72, 0x61, 0x70,
+	0x68, 0x45, 0x64, 0x67, 0x65, 0x52, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x0a,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x0f, 0x0a, 0x0d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x28, 0x0a, 0x0e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x84, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x67, 0x6f, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x67, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2a, 0xb8, 0x01, 0x0a,
+	0x11, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x22, 0x0a, 0x1e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x4c,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45,
+	0x4e, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52,
+	0x4d, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x4c, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x4a, 0x45,
+	0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x4c,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x53,
+	0x4f, 0x4c, 0x56, 0x45, 0x44, 0x10, 0x04, 0x2a, 0xeb, 0x01, 0x0a, 0x11, 0x52, 0x6f, 0x6f, 0x74,
+	0x43, 0x61, 0x75, 0x73, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x23, 0x0a,
+	0x1f, 0x52, 0x4f, 0x4f, 0x54, 0x5f, 0x43, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x43, 0x41, 0x54, 0x45,
+	0x47, 0x4f, 0x52, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x52, 0x4f, 0x4f, 0x54, 0x5f, 0x43, 0x41, 0x55, 0x53, 0x45,
+	0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59,
+	0x4d, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x4f, 0x4f, 0x54, 0x5f, 0x43,
+	0x41, 0x55, 0x53, 0x45, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x43, 0x41,
+	0x50, 0x41, 0x43, 0x49, 0x54, 0x59, 0x10, 0x02, 0x12, 0x2a, 0x0a, 0x26, 0x52, 0x4f, 0x4f, 0x54,
+	0x5f, 0x43, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f,
+	0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55,
+	0x52, 0x45, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x4f, 0x4f, 0x54, 0x5f, 0x43, 0x41, 0x55,
+	0x53, 0x45, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x46,
+	0x49, 0x47, 0x10, 0x04, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x4f, 0x4f, 0x54, 0x5f, 0x43, 0x41, 0x55,
+	0x53, 0x45, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x4e, 0x45, 0x54, 0x57,
+	0x4f, 0x52, 0x4b, 0x10, 0x05, 0x2a, 0x66, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11,
+	0x44, 0x41, 0x54, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43,
+	0x53, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4c, 0x4f, 0x47, 0x53, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x41, 0x54, 0x41, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x43, 0x45, 0x53, 0x10, 0x03, 0x2a, 0x75, 0x0a,
+	0x08, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x45, 0x56,
+	0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f,
+	0x4c, 0x4f, 0x57, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54,
+	0x59, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x55, 0x4d, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x45,
+	0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x10, 0x03, 0x12, 0x15, 0x0a,
+	0x11, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x43, 0x52, 0x49, 0x54, 0x49, 0x43,
+	0x41, 0x4c, 0x10, 0x04, 0x2a, 0xc0, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x2a, 0x0a, 0x26, 0x52, 0x45, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x44, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x26, 0x0a,
+	0x22, 0x52, 0x45, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x42,
+	0x4f, 0x4f, 0x4b, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24, 0x52, 0x45, 0x43, 0x4f, 0x4d, 0x4d, 0x45,
+	0x4e, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x44, 0x41, 0x53, 0x48, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x10, 0x02, 0x12,
+	0x26, 0x0a, 0x22, 0x52, 0x45, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x44, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x57, 0x45,
+	0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x10, 0x03, 0x32, 0xcb, 0x0c, 0x0a, 0x09, 0x52, 0x43, 0x41, 0x45,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x51, 0x0a, 0x13, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x69,
+	0x67, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x72,
+	0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x43, 0x41, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x69,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x55, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x72,
+	0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x72, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5b, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x1a, 0x2e, 0x72, 0x63,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x46, 0x65,
+	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x17, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
+	0x6b, 0x41, 0x63, 0x6b, 0x12, 0x3c, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x12, 0x15, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x63, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5c, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x26, 0x2e,
+	0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x12, 0x67, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x25, 0x2e, 0x72, 0x63, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x17, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x26, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x72,
+	0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0f, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1f, 0x2e,
+	0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61,
+	0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62,
+	0x61, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x49, 0x0a, 0x0c, 0x4d, 0x69, 0x6e, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73,
+	0x12, 0x1b, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x50, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x11, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x20, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x72, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x40, 0x0a,
+	0x09, 0x54, 0x65, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x72, 0x63, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65,
+	0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x43, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e,
+	0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x72, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x57, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x72, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x63, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78,
+	0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x76, 0x0a, 0x1b, 0x47, 0x65, 0x74,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x52, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x12, 0x1e, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x63, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x4a, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x73, 0x74, 0x61, 0x63, 0x6b,
+	0x2f, 0x6d, 0x69, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x2d, 0x72, 0x63, 0x61, 0x2f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x64, 0x2f, 0x72, 0x63, 0x61, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x63, 0x61, 0x76,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rca_proto_rawDescOnce sync.Once
+	file_rca_proto_rawDescData = file_rca_proto_rawDesc
+)
+
+func file_rca_proto_rawDescGZIP() []byte {
+	file_rca_proto_rawDescOnce.Do(func() {
+		file_rca_proto_rawDescData = protoimpl.X.CompressGZIP(file_rca_proto_rawDescData)
+	})
+	return file_rca_proto_rawDescData
+}
+
+var file_rca_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_rca_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
+var file_rca_proto_goTypes = []any{
+	(CorrelationStatus)(0),                      // 0: rca.v1.CorrelationStatus
+	(RootCauseCategory)(0),                      // 1: rca.v1.RootCauseCategory
+	(DataType)(0),                               // 2: rca.v1.DataType
+	(Severity)(0),                               // 3: rca.v1.Severity
+	(RecommendationActionType)(0),               // 4: rca.v1.RecommendationActionType
+	(*RCAInvestigationRequest)(nil),             // 5: rca.v1.RCAInvestigationRequest
+	(*IncidentMetadata)(nil),                    // 6: rca.v1.IncidentMetadata
+	(*TimeRange)(nil),                           // 7: rca.v1.TimeRange
+	(*CorrelationResult)(nil),                   // 8: rca.v1.CorrelationResult
+	(*SLOImpact)(nil),                           // 9: rca.v1.SLOImpact
+	(*CapacityAnalysis)(nil),                    // 10: rca.v1.CapacityAnalysis
+	(*ResourceUsage)(nil),                       // 11: rca.v1.ResourceUsage
+	(*WindowExpansion)(nil),                     // 12: rca.v1.WindowExpansion
+	(*Annotation)(nil),                          // 13: rca.v1.Annotation
+	(*ServiceImpact)(nil),                       // 14: rca.v1.ServiceImpact
+	(*RedAnchor)(nil),                           // 15: rca.v1.RedAnchor
+	(*Evidence)(nil),                            // 16: rca.v1.Evidence
+	(*TraceExemplar)(nil),                       // 17: rca.v1.TraceExemplar
+	(*MetricSample)(nil),                        // 18: rca.v1.MetricSample
+	(*TimelineEvent)(nil),                       // 19: rca.v1.TimelineEvent
+	(*ListCorrelationsRequest)(nil),             // 20: rca.v1.ListCorrelationsRequest
+	(*ListCorrelationsResponse)(nil),            // 21: rca.v1.ListCorrelationsResponse
+	(*GetCorrelationRequest)(nil),               // 22: rca.v1.GetCorrelationRequest
+	(*ExplainCorrelationRequest)(nil),           // 23: rca.v1.ExplainCorrelationRequest
+	(*CorrelationExplanation)(nil),              // 24: rca.v1.CorrelationExplanation
+	(*DetectorRun)(nil),                         // 25: rca.v1.DetectorRun
+	(*MatchedRule)(nil),                         // 26: rca.v1.MatchedRule
+	(*SearchCorrelationsRequest)(nil),           // 27: rca.v1.SearchCorrelationsRequest
+	(*ScoredCorrelation)(nil),                   // 28: rca.v1.ScoredCorrelation
+	(*SearchCorrelationsResponse)(nil),          // 29: rca.v1.SearchCorrelationsResponse
+	(*GetPatternsRequest)(nil),                  // 30: rca.v1.GetPatternsRequest
+	(*Pattern)(nil),                             // 31: rca.v1.Pattern
+	(*AnchorTemplate)(nil),                      // 32: rca.v1.AnchorTemplate
+	(*Quality)(nil),                             // 33: rca.v1.Quality
+	(*GetPatternsResponse)(nil),                 // 34: rca.v1.GetPatternsResponse
+	(*FeedbackRequest)(nil),                     // 35: rca.v1.FeedbackRequest
+	(*FeedbackAck)(nil),                         // 36: rca.v1.FeedbackAck
+	(*MaintenanceWindow)(nil),                   // 37: rca.v1.MaintenanceWindow
+	(*CreateMaintenanceWindowRequest)(nil),      // 38: rca.v1.CreateMaintenanceWindowRequest
+	(*ListMaintenanceWindowsRequest)(nil),       // 39: rca.v1.ListMaintenanceWindowsRequest
+	(*ListMaintenanceWindowsResponse)(nil),      // 40: rca.v1.ListMaintenanceWindowsResponse
+	(*DeleteMaintenanceWindowRequest)(nil),      // 41: rca.v1.DeleteMaintenanceWindowRequest
+	(*DeleteMaintenanceWindowResponse)(nil),     // 42: rca.v1.DeleteMaintenanceWindowResponse
+	(*PurgeTenantDataRequest)(nil),              // 43: rca.v1.PurgeTenantDataRequest
+	(*PurgeTenantDataResponse)(nil),             // 44: rca.v1.PurgeTenantDataResponse
+	(*GetFeedbackStatsRequest)(nil),             // 45: rca.v1.GetFeedbackStatsRequest
+	(*AccuracyStat)(nil),                        // 46: rca.v1.AccuracyStat
+	(*AccuracyBucket)(nil),                      // 47: rca.v1.AccuracyBucket
+	(*GetFeedbackStatsResponse)(nil),            // 48: rca.v1.GetFeedbackStatsResponse
+	(*MinePatternsRequest)(nil),                 // 49: rca.v1.MinePatternsRequest
+	(*MinePatternsResponse)(nil),                // 50: rca.v1.MinePatternsResponse
+	(*UpdateCorrelationRequest)(nil),            // 51: rca.v1.UpdateCorrelationRequest
+	(*Recommendation)(nil),                      // 52: rca.v1.Recommendation
+	(*RecommendationAction)(nil),                // 53: rca.v1.RecommendationAction
+	(*TestRulesRequest)(nil),                    // 54: rca.v1.TestRulesRequest
+	(*RuleEvaluation)(nil),                      // 55: rca.v1.RuleEvaluation
+	(*TestRulesResponse)(nil),                   // 56: rca.v1.TestRulesResponse
+	(*GetThresholdRecommendationsRequest)(nil),  // 57: rca.v1.GetThresholdRecommendationsRequest
+	(*ThresholdRecommendation)(nil),             // 58: rca.v1.ThresholdRecommendation
+	(*GetThresholdRecommendationsResponse)(nil), // 59: rca.v1.GetThresholdRecommendationsResponse
+	(*GetServiceGraphRequest)(nil),              // 60: rca.v1.GetServiceGraphRequest
+	(*ServiceGraphNode)(nil),                    // 61: rca.v1.ServiceGraphNode
+	(*ServiceGraphEdge)(nil),                    // 62: rca.v1.ServiceGraphEdge
+	(*GetServiceGraphResponse)(nil),             // 63: rca.v1.GetServiceGraphResponse
+	(*HealthRequest)(nil),                       // 64: rca.v1.HealthRequest
+	(*HealthResponse)(nil),                      // 65: rca.v1.HealthResponse
+	(*GetVersionRequest)(nil),                   // 66: rca.v1.GetVersionRequest
+	(*GetVersionResponse)(nil),                  // 67: rca.v1.GetVersionResponse
+	nil,                                         // 68: rca.v1.RCAInvestigationRequest.LabelsEntry
+	nil,                                         // 69: rca.v1.CorrelationResult.LabelsEntry
+	nil,                                         // 70: rca.v1.ListCorrelationsRequest.LabelsEntry
+	nil,                                         // 71: rca.v1.UpdateCorrelationRequest.LabelsEntry
+	(*timestamppb.Timestamp)(nil),               // 72: google.protobuf.Timestamp
+}
+var file_rca_proto_depIdxs = []int32{
+	7,   // 0: rca.v1.RCAInvestigationRequest.time_range:type_name -> rca.v1.TimeRange
+	68,  // 1: rca.v1.RCAInvestigationRequest.labels:type_name -> rca.v1.RCAInvestigationRequest.LabelsEntry
+	6,   // 2: rca.v1.RCAInvestigationRequest.incident:type_name -> rca.v1.IncidentMetadata
+	72,  // 3: rca.v1.TimeRange.start:type_name -> google.protobuf.Timestamp
+	72,  // 4: rca.v1.TimeRange.end:type_name -> google.protobuf.Timestamp
+	15,  // 5: rca.v1.CorrelationResult.red_anchors:type_name -> rca.v1.RedAnchor
+	19,  // 6: rca.v1.CorrelationResult.timeline:type_name -> rca.v1.TimelineEvent
+	72,  // 7: rca.v1.CorrelationResult.created_at:type_name -> google.protobuf.Timestamp
+	14,  // 8: rca.v1.CorrelationResult.blast_radius:type_name -> rca.v1.ServiceImpact
+	1,   // 9: rca.v1.CorrelationResult.category:type_name -> rca.v1.RootCauseCategory
+	2,   // 10: rca.v1.CorrelationResult.unavailable_sources:type_name -> rca.v1.DataType
+	0,   // 11: rca.v1.CorrelationResult.status:type_name -> rca.v1.CorrelationStatus
+	13,  // 12: rca.v1.CorrelationResult.annotations:type_name -> rca.v1.Annotation
+	69,  // 13: rca.v1.CorrelationResult.labels:type_name -> rca.v1.CorrelationResult.LabelsEntry
+	6,   // 14: rca.v1.CorrelationResult.incident:type_name -> rca.v1.IncidentMetadata
+	52,  // 15: rca.v1.CorrelationResult.recommendation_details:type_name -> rca.v1.Recommendation
+	12,  // 16: rca.v1.CorrelationResult.window_expansion:type_name -> rca.v1.WindowExpansion
+	10,  // 17: rca.v1.CorrelationResult.capacity:type_name -> rca.v1.CapacityAnalysis
+	9,   // 18: rca.v1.CorrelationResult.slo_impacts:type_name -> rca.v1.SLOImpact
+	11,  // 19: rca.v1.CapacityAnalysis.resources:type_name -> rca.v1.ResourceUsage
+	72,  // 20: rca.v1.ResourceUsage.saturated_at:type_name -> google.protobuf.Timestamp
+	7,   // 21: rca.v1.WindowExpansion.requested:type_name -> rca.v1.TimeRange
+	7,   // 22: rca.v1.WindowExpansion.analysed:type_name -> rca.v1.TimeRange
+	2,   // 23: rca.v1.WindowExpansion.sparse_sources:type_name -> rca.v1.DataType
+	72,  // 24: rca.v1.Annotation.created_at:type_name -> google.protobuf.Timestamp
+	2,   // 25: rca.v1.RedAnchor.data_type:type_name -> rca.v1.DataType
+	72,  // 26: rca.v1.RedAnchor.timestamp:type_name -> google.protobuf.Timestamp
+	16,  // 27: rca.v1.RedAnchor.evidence:type_name -> rca.v1.Evidence
+	18,  // 28: rca.v1.Evidence.metric_values:type_name -> rca.v1.MetricSample
+	17,  // 29: rca.v1.Evidence.exemplars:type_name -> rca.v1.TraceExemplar
+	72,  // 30: rca.v1.TraceExemplar.timestamp:type_name -> google.protobuf.Timestamp
+	72,  // 31: rca.v1.MetricSample.timestamp:type_name -> google.protobuf.Timestamp
+	72,  // 32: rca.v1.TimelineEvent.time:type_name -> google.protobuf.Timestamp
+	3,   // 33: rca.v1.TimelineEvent.severity:type_name -> rca.v1.Severity
+	2,   // 34: rca.v1.TimelineEvent.data_source:type_name -> rca.v1.DataType
+	72,  // 35: rca.v1.TimelineEvent.start:type_name -> google.protobuf.Timestamp
+	72,  // 36: rca.v1.TimelineEvent.end:type_name -> google.protobuf.Timestamp
+	72,  // 37: rca.v1.ListCorrelationsRequest.start_time:type_name -> google.protobuf.Timestamp
+	72,  // 38: rca.v1.ListCorrelationsRequest.end_time:type_name -> google.protobuf.Timestamp
+	1,   // 39: rca.v1.ListCorrelationsRequest.category:type_name -> rca.v1.RootCauseCategory
+	70,  // 40: rca.v1.ListCorrelationsRequest.labels:type_name -> rca.v1.ListCorrelationsRequest.LabelsEntry
+	8,   // 41: rca.v1.ListCorrelationsResponse.correlations:type_name -> rca.v1.CorrelationResult
+	1,   // 42: rca.v1.CorrelationExplanation.category:type_name -> rca.v1.RootCauseCategory
+	25,  // 43: rca.v1.CorrelationExplanation.detectors:type_name -> rca.v1.DetectorRun
+	26,  // 44: rca.v1.CorrelationExplanation.matched_rules:type_name -> rca.v1.MatchedRule
+	15,  // 45: rca.v1.CorrelationExplanation.anchors:type_name -> rca.v1.RedAnchor
+	8,   // 46: rca.v1.ScoredCorrelation.correlation:type_name -> rca.v1.CorrelationResult
+	28,  // 47: rca.v1.SearchCorrelationsResponse.results:type_name -> rca.v1.ScoredCorrelation
+	32,  // 48: rca.v1.Pattern.anchor_templates:type_name -> rca.v1.AnchorTemplate
+	72,  // 49: rca.v1.Pattern.last_seen:type_name -> google.protobuf.Timestamp
+	33,  // 50: rca.v1.Pattern.quality:type_name -> rca.v1.Quality
+	31,  // 51: rca.v1.GetPatternsResponse.patterns:type_name -> rca.v1.Pattern
+	72,  // 52: rca.v1.MaintenanceWindow.start:type_name -> google.protobuf.Timestamp
+	72,  // 53: rca.v1.MaintenanceWindow.end:type_name -> google.protobuf.Timestamp
+	37,  // 54: rca.v1.CreateMaintenanceWindowRequest.window:type_name -> rca.v1.MaintenanceWindow
+	37,  // 55: rca.v1.ListMaintenanceWindowsResponse.windows:type_name -> rca.v1.MaintenanceWindow
+	72,  // 56: rca.v1.PurgeTenantDataRequest.before:type_name -> google.protobuf.Timestamp
+	7,   // 57: rca.v1.GetFeedbackStatsRequest.time_range:type_name -> rca.v1.TimeRange
+	72,  // 58: rca.v1.AccuracyBucket.start:type_name -> google.protobuf.Timestamp
+	46,  // 59: rca.v1.GetFeedbackStatsResponse.by_service:type_name -> rca.v1.AccuracyStat
+	46,  // 60: rca.v1.GetFeedbackStatsResponse.by_category:type_name -> rca.v1.AccuracyStat
+	47,  // 61: rca.v1.GetFeedbackStatsResponse.by_bucket:type_name -> rca.v1.AccuracyBucket
+	46,  // 62: rca.v1.GetFeedbackStatsResponse.by_pattern:type_name -> rca.v1.AccuracyStat
+	7,   // 63: rca.v1.MinePatternsRequest.time_range:type_name -> rca.v1.TimeRange
+	31,  // 64: rca.v1.MinePatternsResponse.patterns:type_name -> rca.v1.Pattern
+	0,   // 65: rca.v1.UpdateCorrelationRequest.status:type_name -> rca.v1.CorrelationStatus
+	13,  // 66: rca.v1.UpdateCorrelationRequest.annotations:type_name -> rca.v1.Annotation
+	71,  // 67: rca.v1.UpdateCorrelationRequest.labels:type_name -> rca.v1.UpdateCorrelationRequest.LabelsEntry
+	53,  // 68: rca.v1.Recommendation.actions:type_name -> rca.v1.RecommendationAction
+	4,   // 69: rca.v1.RecommendationAction.type:type_name -> rca.v1.RecommendationActionType
+	5,   // 70: rca.v1.TestRulesRequest.request:type_name -> rca.v1.RCAInvestigationRequest
+	15,  // 71: rca.v1.TestRulesRequest.anchors:type_name -> rca.v1.RedAnchor
+	19,  // 72: rca.v1.TestRulesRequest.timeline:type_name -> rca.v1.TimelineEvent
+	52,  // 73: rca.v1.RuleEvaluation.recommendations:type_name -> rca.v1.Recommendation
+	55,  // 74: rca.v1.TestRulesResponse.evaluations:type_name -> rca.v1.RuleEvaluation
+	52,  // 75: rca.v1.TestRulesResponse.recommendations:type_name -> rca.v1.Recommendation
+	72,  // 76: rca.v1.ThresholdRecommendation.updated_at:type_name -> google.protobuf.Timestamp
+	58,  // 77: rca.v1.GetThresholdRecommendationsResponse.recommendations:type_name -> rca.v1.ThresholdRecommendation
+	7,   // 78: rca.v1.GetServiceGraphRequest.time_range:type_name -> rca.v1.TimeRange
+	61,  // 79: rca.v1.GetServiceGraphResponse.nodes:type_name -> rca.v1.ServiceGraphNode
+	62,  // 80: rca.v1.GetServiceGraphResponse.edges:type_name -> rca.v1.ServiceGraphEdge
+	7,   // 81: rca.v1.GetServiceGraphResponse.time_range:type_name -> rca.v1.TimeRange
+	5,   // 82: rca.v1.RCAEngine.InvestigateIncident:input_type -> rca.v1.RCAInvestigationRequest
+	20,  // 83: rca.v1.RCAEngine.ListCorrelations:input_type -> rca.v1.ListCorrelationsRequest
+	27,  // 84: rca.v1.RCAEngine.SearchCorrelations:input_type -> rca.v1.SearchCorrelationsRequest
+	30,  // 85: rca.v1.RCAEngine.GetPatterns:input_type -> rca.v1.GetPatternsRequest
+	35,  // 86: rca.v1.RCAEngine.SubmitFeedback:input_type -> rca.v1.FeedbackRequest
+	64,  // 87: rca.v1.RCAEngine.HealthCheck:input_type -> rca.v1.HealthRequest
+	38,  // 88: rca.v1.RCAEngine.CreateMaintenanceWindow:input_type -> rca.v1.CreateMaintenanceWindowRequest
+	39,  // 89: rca.v1.RCAEngine.ListMaintenanceWindows:input_type -> rca.v1.ListMaintenanceWindowsRequest
+	41,  // 90: rca.v1.RCAEngine.DeleteMaintenanceWindow:input_type -> rca.v1.DeleteMaintenanceWindowRequest
+	43,  // 91: rca.v1.RCAEngine.PurgeTenantData:input_type -> rca.v1.PurgeTenantDataRequest
+	45,  // 92: rca.v1.RCAEngine.GetFeedbackStats:input_type -> rca.v1.GetFeedbackStatsRequest
+	49,  // 93: rca.v1.RCAEngine.MinePatterns:input_type -> rca.v1.MinePatternsRequest
+	51,  // 94: rca.v1.RCAEngine.UpdateCorrelation:input_type -> rca.v1.UpdateCorrelationRequest
+	54,  // 95: rca.v1.RCAEngine.TestRules:input_type -> rca.v1.TestRulesRequest
+	66,  // 96: rca.v1.RCAEngine.GetVersion:input_type -> rca.v1.GetVersionRequest
+	22,  // 97: rca.v1.RCAEngine.GetCorrelation:input_type -> rca.v1.GetCorrelationRequest
+	23,  // 98: rca.v1.RCAEngine.ExplainCorrelation:input_type -> rca.v1.ExplainCorrelationRequest
+	57,  // 99: rca.v1.RCAEngine.GetThresholdRecommendations:input_type -> rca.v1.GetThresholdRecommendationsRequest
+	60,  // 100: rca.v1.RCAEngine.GetServiceGraph:input_type -> rca.v1.GetServiceGraphRequest
+	8,   // 101: rca.v1.RCAEngine.InvestigateIncident:output_type -> rca.v1.CorrelationResult
+	21,  // 102: rca.v1.RCAEngine.ListCorrelations:output_type -> rca.v1.ListCorrelationsResponse
+	29,  // 103: rca.v1.RCAEngine.SearchCorrelations:output_type -> rca.v1.SearchCorrelationsResponse
+	34,  // 104: rca.v1.RCAEngine.GetPatterns:output_type -> rca.v1.GetPatternsResponse
+	36,  // 105: rca.v1.RCAEngine.SubmitFeedback:output_type -> rca.v1.FeedbackAck
+	65,  // 106: rca.v1.RCAEngine.HealthCheck:output_type -> rca.v1.HealthResponse
+	37,  // 107: rca.v1.RCAEngine.CreateMaintenanceWindow:output_type -> rca.v1.MaintenanceWindow
+	40,  // 108: rca.v1.RCAEngine.ListMaintenanceWindows:output_type -> rca.v1.ListMaintenanceWindowsResponse
+	42,  // 109: rca.v1.RCAEngine.DeleteMaintenanceWindow:output_type -> rca.v1.DeleteMaintenanceWindowResponse
+	44,  // 110: rca.v1.RCAEngine.PurgeTenantData:output_type -> rca.v1.PurgeTenantDataResponse
+	48,  // 111: rca.v1.RCAEngine.GetFeedbackStats:output_type -> rca.v1.GetFeedbackStatsResponse
+	50,  // 112: rca.v1.RCAEngine.MinePatterns:output_type -> rca.v1.MinePatternsResponse
+	8,   // 113: rca.v1.RCAEngine.UpdateCorrelation:output_type -> rca.v1.CorrelationResult
+	56,  // 114: rca.v1.RCAEngine.TestRules:output_type -> rca.v1.TestRulesResponse
+	67,  // 115: rca.v1.RCAEngine.GetVersion:output_type -> rca.v1.GetVersionResponse
+	8,   // 116: rca.v1.RCAEngine.GetCorrelation:output_type -> rca.v1.CorrelationResult
+	24,  // 117: rca.v1.RCAEngine.ExplainCorrelation:output_type -> rca.v1.CorrelationExplanation
+	59,  // 118: rca.v1.RCAEngine.GetThresholdRecommendations:output_type -> rca.v1.GetThresholdRecommendationsResponse
+	63,  // 119: rca.v1.RCAEngine.GetServiceGraph:output_type -> rca.v1.GetServiceGraphResponse
+	101, // [101:120] is the sub-list for method output_type
+	82,  // [82:101] is the sub-list for method input_type
+	82,  // [82:82] is the sub-list for extension type_name
+	82,  // [82:82] is the sub-list for extension extendee
+	0,   // [0:82] is the sub-list for field type_name
+}
+
+func init() { file_rca_proto_init() }
+func file_rca_proto_init() {
+	if File_rca_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rca_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*RCAInvestigationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*IncidentMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*TimeRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*CorrelationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*SLOImpact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*CapacityAnalysis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*ResourceUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*WindowExpansion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*Annotation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*ServiceImpact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*RedAnchor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*Evidence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*TraceExemplar); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*MetricSample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*TimelineEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*ListCorrelationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*ListCorrelationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCorrelationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*ExplainCorrelationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*CorrelationExplanation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*DetectorRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*MatchedRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchCorrelationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*ScoredCorrelation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchCorrelationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPatternsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1552,8 +6529,8 @@ func file_rca_proto_init() {
 				return nil
 			}
 		}
-		file_rca_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*TimeRange); i {
+		file_rca_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*Pattern); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1564,8 +6541,8 @@ func file_rca_proto_init() {
 				return nil
 			}
 		}
-		file_rca_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*CorrelationResult); i {
+		file_rca_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*AnchorTemplate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1576,8 +6553,8 @@ func file_rca_proto_init() {
 				return nil
 			}
 		}
-		file_rca_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*RedAnchor); i {
+		file_rca_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*Quality); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1588,8 +6565,8 @@ func file_rca_proto_init() {
 				return nil
 			}
 		}
-		file_rca_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*TimelineEvent); i {
+		file_rca_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPatternsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1600,8 +6577,8 @@ func file_rca_proto_init() {
 				return nil
 			}
 		}
-		file_rca_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*ListCorrelationsRequest); i {
+		file_rca_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*FeedbackRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1612,8 +6589,8 @@ func file_rca_proto_init() {
 				return nil
 			}
 		}
-		file_rca_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*ListCorrelationsResponse); i {
+		file_rca_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*FeedbackAck); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1624,8 +6601,8 @@ func file_rca_proto_init() {
 				return nil
 			}
 		}
-		file_rca_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPatternsRequest); i {
+		file_rca_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*MaintenanceWindow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1636,8 +6613,8 @@ func file_rca_proto_init() {
 				return nil
 			}
 		}
-		file_rca_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*Pattern); i {
+		file_rca_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateMaintenanceWindowRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1648,8 +6625,8 @@ func file_rca_proto_init() {
 				return nil
 			}
 		}
-		file_rca_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*AnchorTemplate); i {
+		file_rca_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*ListMaintenanceWindowsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1660,8 +6637,8 @@ func file_rca_proto_init() {
 				return nil
 			}
 		}
-		file_rca_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*Quality); i {
+		file_rca_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*ListMaintenanceWindowsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1672,8 +6649,8 @@ func file_rca_proto_init() {
 				return nil
 			}
 		}
-		file_rca_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPatternsResponse); i {
+		file_rca_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteMaintenanceWindowRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1684,8 +6661,8 @@ func file_rca_proto_init() {
 				return nil
 			}
 		}
-		file_rca_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*FeedbackRequest); i {
+		file_rca_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteMaintenanceWindowResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1696,8 +6673,8 @@ func file_rca_proto_init() {
 				return nil
 			}
 		}
-		file_rca_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*FeedbackAck); i {
+		file_rca_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*PurgeTenantDataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1708,7 +6685,247 @@ func file_rca_proto_init() {
 				return nil
 			}
 		}
-		file_rca_proto_msgTypes[14].Exporter = func(v any, i int) any {
+		file_rca_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*PurgeTenantDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*GetFeedbackStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*AccuracyStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*AccuracyBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*GetFeedbackStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*MinePatternsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*MinePatternsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateCorrelationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*Recommendation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*RecommendationAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*TestRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*RuleEvaluation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*TestRulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*GetThresholdRecommendationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*ThresholdRecommendation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*GetThresholdRecommendationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*GetServiceGraphRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*ServiceGraphNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*ServiceGraphEdge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*GetServiceGraphResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[59].Exporter = func(v any, i int) any {
 			switch v := v.(*HealthRequest); i {
 			case 0:
 				return &v.state
@@ -1720,7 +6937,7 @@ func file_rca_proto_init() {
 				return nil
 			}
 		}
-		file_rca_proto_msgTypes[15].Exporter = func(v any, i int) any {
+		file_rca_proto_msgTypes[60].Exporter = func(v any, i int) any {
 			switch v := v.(*HealthResponse); i {
 			case 0:
 				return &v.state
@@ -1732,14 +6949,38 @@ func file_rca_proto_init() {
 				return nil
 			}
 		}
+		file_rca_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*GetVersionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rca_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*GetVersionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rca_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   16,
+			NumEnums:      5,
+			NumMessages:   67,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
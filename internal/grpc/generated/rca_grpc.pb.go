@@ -19,11 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RCAEngine_InvestigateIncident_FullMethodName = "/rca.v1.RCAEngine/InvestigateIncident"
-	RCAEngine_ListCorrelations_FullMethodName    = "/rca.v1.RCAEngine/ListCorrelations"
-	RCAEngine_GetPatterns_FullMethodName         = "/rca.v1.RCAEngine/GetPatterns"
-	RCAEngine_SubmitFeedback_FullMethodName      = "/rca.v1.RCAEngine/SubmitFeedback"
-	RCAEngine_HealthCheck_FullMethodName         = "/rca.v1.RCAEngine/HealthCheck"
+	RCAEngine_InvestigateIncident_FullMethodName         = "/rca.v1.RCAEngine/InvestigateIncident"
+	RCAEngine_ListCorrelations_FullMethodName            = "/rca.v1.RCAEngine/ListCorrelations"
+	RCAEngine_SearchCorrelations_FullMethodName          = "/rca.v1.RCAEngine/SearchCorrelations"
+	RCAEngine_GetPatterns_FullMethodName                 = "/rca.v1.RCAEngine/GetPatterns"
+	RCAEngine_SubmitFeedback_FullMethodName              = "/rca.v1.RCAEngine/SubmitFeedback"
+	RCAEngine_HealthCheck_FullMethodName                 = "/rca.v1.RCAEngine/HealthCheck"
+	RCAEngine_CreateMaintenanceWindow_FullMethodName     = "/rca.v1.RCAEngine/CreateMaintenanceWindow"
+	RCAEngine_ListMaintenanceWindows_FullMethodName      = "/rca.v1.RCAEngine/ListMaintenanceWindows"
+	RCAEngine_DeleteMaintenanceWindow_FullMethodName     = "/rca.v1.RCAEngine/DeleteMaintenanceWindow"
+	RCAEngine_PurgeTenantData_FullMethodName             = "/rca.v1.RCAEngine/PurgeTenantData"
+	RCAEngine_GetFeedbackStats_FullMethodName            = "/rca.v1.RCAEngine/GetFeedbackStats"
+	RCAEngine_MinePatterns_FullMethodName                = "/rca.v1.RCAEngine/MinePatterns"
+	RCAEngine_UpdateCorrelation_FullMethodName           = "/rca.v1.RCAEngine/UpdateCorrelation"
+	RCAEngine_TestRules_FullMethodName                   = "/rca.v1.RCAEngine/TestRules"
+	RCAEngine_GetVersion_FullMethodName                  = "/rca.v1.RCAEngine/GetVersion"
+	RCAEngine_GetCorrelation_FullMethodName              = "/rca.v1.RCAEngine/GetCorrelation"
+	RCAEngine_ExplainCorrelation_FullMethodName          = "/rca.v1.RCAEngine/ExplainCorrelation"
+	RCAEngine_GetThresholdRecommendations_FullMethodName = "/rca.v1.RCAEngine/GetThresholdRecommendations"
+	RCAEngine_GetServiceGraph_FullMethodName             = "/rca.v1.RCAEngine/GetServiceGraph"
 )
 
 // RCAEngineClient is the client API for RCAEngine service.
@@ -32,9 +46,23 @@ const (
 type RCAEngineClient interface {
 	InvestigateIncident(ctx context.Context, in *RCAInvestigationRequest, opts ...grpc.CallOption) (*CorrelationResult, error)
 	ListCorrelations(ctx context.Context, in *ListCorrelationsRequest, opts ...grpc.CallOption) (*ListCorrelationsResponse, error)
+	SearchCorrelations(ctx context.Context, in *SearchCorrelationsRequest, opts ...grpc.CallOption) (*SearchCorrelationsResponse, error)
 	GetPatterns(ctx context.Context, in *GetPatternsRequest, opts ...grpc.CallOption) (*GetPatternsResponse, error)
 	SubmitFeedback(ctx context.Context, in *FeedbackRequest, opts ...grpc.CallOption) (*FeedbackAck, error)
 	HealthCheck(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error)
+	CreateMaintenanceWindow(ctx context.Context, in *CreateMaintenanceWindowRequest, opts ...grpc.CallOption) (*MaintenanceWindow, error)
+	ListMaintenanceWindows(ctx context.Context, in *ListMaintenanceWindowsRequest, opts ...grpc.CallOption) (*ListMaintenanceWindowsResponse, error)
+	DeleteMaintenanceWindow(ctx context.Context, in *DeleteMaintenanceWindowRequest, opts ...grpc.CallOption) (*DeleteMaintenanceWindowResponse, error)
+	PurgeTenantData(ctx context.Context, in *PurgeTenantDataRequest, opts ...grpc.CallOption) (*PurgeTenantDataResponse, error)
+	GetFeedbackStats(ctx context.Context, in *GetFeedbackStatsRequest, opts ...grpc.CallOption) (*GetFeedbackStatsResponse, error)
+	MinePatterns(ctx context.Context, in *MinePatternsRequest, opts ...grpc.CallOption) (*MinePatternsResponse, error)
+	UpdateCorrelation(ctx context.Context, in *UpdateCorrelationRequest, opts ...grpc.CallOption) (*CorrelationResult, error)
+	TestRules(ctx context.Context, in *TestRulesRequest, opts ...grpc.CallOption) (*TestRulesResponse, error)
+	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error)
+	GetCorrelation(ctx context.Context, in *GetCorrelationRequest, opts ...grpc.CallOption) (*CorrelationResult, error)
+	ExplainCorrelation(ctx context.Context, in *ExplainCorrelationRequest, opts ...grpc.CallOption) (*CorrelationExplanation, error)
+	GetThresholdRecommendations(ctx context.Context, in *GetThresholdRecommendationsRequest, opts ...grpc.CallOption) (*GetThresholdRecommendationsResponse, error)
+	GetServiceGraph(ctx context.Context, in *GetServiceGraphRequest, opts ...grpc.CallOption) (*GetServiceGraphResponse, error)
 }
 
 type rCAEngineClient struct {
@@ -65,6 +93,16 @@ func (c *rCAEngineClient) ListCorrelations(ctx context.Context, in *ListCorrelat
 	return out, nil
 }
 
+func (c *rCAEngineClient) SearchCorrelations(ctx context.Context, in *SearchCorrelationsRequest, opts ...grpc.CallOption) (*SearchCorrelationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchCorrelationsResponse)
+	err := c.cc.Invoke(ctx, RCAEngine_SearchCorrelations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rCAEngineClient) GetPatterns(ctx context.Context, in *GetPatternsRequest, opts ...grpc.CallOption) (*GetPatternsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetPatternsResponse)
@@ -95,15 +133,159 @@ func (c *rCAEngineClient) HealthCheck(ctx context.Context, in *HealthRequest, op
 	return out, nil
 }
 
+func (c *rCAEngineClient) CreateMaintenanceWindow(ctx context.Context, in *CreateMaintenanceWindowRequest, opts ...grpc.CallOption) (*MaintenanceWindow, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MaintenanceWindow)
+	err := c.cc.Invoke(ctx, RCAEngine_CreateMaintenanceWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rCAEngineClient) ListMaintenanceWindows(ctx context.Context, in *ListMaintenanceWindowsRequest, opts ...grpc.CallOption) (*ListMaintenanceWindowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMaintenanceWindowsResponse)
+	err := c.cc.Invoke(ctx, RCAEngine_ListMaintenanceWindows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rCAEngineClient) DeleteMaintenanceWindow(ctx context.Context, in *DeleteMaintenanceWindowRequest, opts ...grpc.CallOption) (*DeleteMaintenanceWindowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, RCAEngine_DeleteMaintenanceWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rCAEngineClient) PurgeTenantData(ctx context.Context, in *PurgeTenantDataRequest, opts ...grpc.CallOption) (*PurgeTenantDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeTenantDataResponse)
+	err := c.cc.Invoke(ctx, RCAEngine_PurgeTenantData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rCAEngineClient) GetFeedbackStats(ctx context.Context, in *GetFeedbackStatsRequest, opts ...grpc.CallOption) (*GetFeedbackStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFeedbackStatsResponse)
+	err := c.cc.Invoke(ctx, RCAEngine_GetFeedbackStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rCAEngineClient) MinePatterns(ctx context.Context, in *MinePatternsRequest, opts ...grpc.CallOption) (*MinePatternsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MinePatternsResponse)
+	err := c.cc.Invoke(ctx, RCAEngine_MinePatterns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rCAEngineClient) UpdateCorrelation(ctx context.Context, in *UpdateCorrelationRequest, opts ...grpc.CallOption) (*CorrelationResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CorrelationResult)
+	err := c.cc.Invoke(ctx, RCAEngine_UpdateCorrelation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rCAEngineClient) TestRules(ctx context.Context, in *TestRulesRequest, opts ...grpc.CallOption) (*TestRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestRulesResponse)
+	err := c.cc.Invoke(ctx, RCAEngine_TestRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rCAEngineClient) GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVersionResponse)
+	err := c.cc.Invoke(ctx, RCAEngine_GetVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rCAEngineClient) GetCorrelation(ctx context.Context, in *GetCorrelationRequest, opts ...grpc.CallOption) (*CorrelationResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CorrelationResult)
+	err := c.cc.Invoke(ctx, RCAEngine_GetCorrelation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rCAEngineClient) ExplainCorrelation(ctx context.Context, in *ExplainCorrelationRequest, opts ...grpc.CallOption) (*CorrelationExplanation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CorrelationExplanation)
+	err := c.cc.Invoke(ctx, RCAEngine_ExplainCorrelation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rCAEngineClient) GetThresholdRecommendations(ctx context.Context, in *GetThresholdRecommendationsRequest, opts ...grpc.CallOption) (*GetThresholdRecommendationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetThresholdRecommendationsResponse)
+	err := c.cc.Invoke(ctx, RCAEngine_GetThresholdRecommendations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rCAEngineClient) GetServiceGraph(ctx context.Context, in *GetServiceGraphRequest, opts ...grpc.CallOption) (*GetServiceGraphResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetServiceGraphResponse)
+	err := c.cc.Invoke(ctx, RCAEngine_GetServiceGraph_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RCAEngineServer is the server API for RCAEngine service.
 // All implementations must embed UnimplementedRCAEngineServer
 // for forward compatibility.
 type RCAEngineServer interface {
 	InvestigateIncident(context.Context, *RCAInvestigationRequest) (*CorrelationResult, error)
 	ListCorrelations(context.Context, *ListCorrelationsRequest) (*ListCorrelationsResponse, error)
+	SearchCorrelations(context.Context, *SearchCorrelationsRequest) (*SearchCorrelationsResponse, error)
 	GetPatterns(context.Context, *GetPatternsRequest) (*GetPatternsResponse, error)
 	SubmitFeedback(context.Context, *FeedbackRequest) (*FeedbackAck, error)
 	HealthCheck(context.Context, *HealthRequest) (*HealthResponse, error)
+	CreateMaintenanceWindow(context.Context, *CreateMaintenanceWindowRequest) (*MaintenanceWindow, error)
+	ListMaintenanceWindows(context.Context, *ListMaintenanceWindowsRequest) (*ListMaintenanceWindowsResponse, error)
+	DeleteMaintenanceWindow(context.Context, *DeleteMaintenanceWindowRequest) (*DeleteMaintenanceWindowResponse, error)
+	PurgeTenantData(context.Context, *PurgeTenantDataRequest) (*PurgeTenantDataResponse, error)
+	GetFeedbackStats(context.Context, *GetFeedbackStatsRequest) (*GetFeedbackStatsResponse, error)
+	MinePatterns(context.Context, *MinePatternsRequest) (*MinePatternsResponse, error)
+	UpdateCorrelation(context.Context, *UpdateCorrelationRequest) (*CorrelationResult, error)
+	TestRules(context.Context, *TestRulesRequest) (*TestRulesResponse, error)
+	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
+	GetCorrelation(context.Context, *GetCorrelationRequest) (*CorrelationResult, error)
+	ExplainCorrelation(context.Context, *ExplainCorrelationRequest) (*CorrelationExplanation, error)
+	GetThresholdRecommendations(context.Context, *GetThresholdRecommendationsRequest) (*GetThresholdRecommendationsResponse, error)
+	GetServiceGraph(context.Context, *GetServiceGraphRequest) (*GetServiceGraphResponse, error)
 	mustEmbedUnimplementedRCAEngineServer()
 }
 
@@ -120,6 +302,9 @@ func (UnimplementedRCAEngineServer) InvestigateIncident(context.Context, *RCAInv
 func (UnimplementedRCAEngineServer) ListCorrelations(context.Context, *ListCorrelationsRequest) (*ListCorrelationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCorrelations not implemented")
 }
+func (UnimplementedRCAEngineServer) SearchCorrelations(context.Context, *SearchCorrelationsRequest) (*SearchCorrelationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCorrelations not implemented")
+}
 func (UnimplementedRCAEngineServer) GetPatterns(context.Context, *GetPatternsRequest) (*GetPatternsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPatterns not implemented")
 }
@@ -129,6 +314,45 @@ func (UnimplementedRCAEngineServer) SubmitFeedback(context.Context, *FeedbackReq
 func (UnimplementedRCAEngineServer) HealthCheck(context.Context, *HealthRequest) (*HealthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
 }
+func (UnimplementedRCAEngineServer) CreateMaintenanceWindow(context.Context, *CreateMaintenanceWindowRequest) (*MaintenanceWindow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMaintenanceWindow not implemented")
+}
+func (UnimplementedRCAEngineServer) ListMaintenanceWindows(context.Context, *ListMaintenanceWindowsRequest) (*ListMaintenanceWindowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMaintenanceWindows not implemented")
+}
+func (UnimplementedRCAEngineServer) DeleteMaintenanceWindow(context.Context, *DeleteMaintenanceWindowRequest) (*DeleteMaintenanceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMaintenanceWindow not implemented")
+}
+func (UnimplementedRCAEngineServer) PurgeTenantData(context.Context, *PurgeTenantDataRequest) (*PurgeTenantDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeTenantData not implemented")
+}
+func (UnimplementedRCAEngineServer) GetFeedbackStats(context.Context, *GetFeedbackStatsRequest) (*GetFeedbackStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeedbackStats not implemented")
+}
+func (UnimplementedRCAEngineServer) MinePatterns(context.Context, *MinePatternsRequest) (*MinePatternsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinePatterns not implemented")
+}
+func (UnimplementedRCAEngineServer) UpdateCorrelation(context.Context, *UpdateCorrelationRequest) (*CorrelationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCorrelation not implemented")
+}
+func (UnimplementedRCAEngineServer) TestRules(context.Context, *TestRulesRequest) (*TestRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestRules not implemented")
+}
+func (UnimplementedRCAEngineServer) GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
+}
+func (UnimplementedRCAEngineServer) GetCorrelation(context.Context, *GetCorrelationRequest) (*CorrelationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCorrelation not implemented")
+}
+func (UnimplementedRCAEngineServer) ExplainCorrelation(context.Context, *ExplainCorrelationRequest) (*CorrelationExplanation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainCorrelation not implemented")
+}
+func (UnimplementedRCAEngineServer) GetThresholdRecommendations(context.Context, *GetThresholdRecommendationsRequest) (*GetThresholdRecommendationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThresholdRecommendations not implemented")
+}
+func (UnimplementedRCAEngineServer) GetServiceGraph(context.Context, *GetServiceGraphRequest) (*GetServiceGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceGraph not implemented")
+}
 func (UnimplementedRCAEngineServer) mustEmbedUnimplementedRCAEngineServer() {}
 func (UnimplementedRCAEngineServer) testEmbeddedByValue()                   {}
 
@@ -186,6 +410,24 @@ func _RCAEngine_ListCorrelations_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RCAEngine_SearchCorrelations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCorrelationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RCAEngineServer).SearchCorrelations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RCAEngine_SearchCorrelations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RCAEngineServer).SearchCorrelations(ctx, req.(*SearchCorrelationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RCAEngine_GetPatterns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPatternsRequest)
 	if err := dec(in); err != nil {
@@ -240,6 +482,240 @@ func _RCAEngine_HealthCheck_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RCAEngine_CreateMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RCAEngineServer).CreateMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RCAEngine_CreateMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RCAEngineServer).CreateMaintenanceWindow(ctx, req.(*CreateMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RCAEngine_ListMaintenanceWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMaintenanceWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RCAEngineServer).ListMaintenanceWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RCAEngine_ListMaintenanceWindows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RCAEngineServer).ListMaintenanceWindows(ctx, req.(*ListMaintenanceWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RCAEngine_DeleteMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RCAEngineServer).DeleteMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RCAEngine_DeleteMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RCAEngineServer).DeleteMaintenanceWindow(ctx, req.(*DeleteMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RCAEngine_PurgeTenantData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTenantDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RCAEngineServer).PurgeTenantData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RCAEngine_PurgeTenantData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RCAEngineServer).PurgeTenantData(ctx, req.(*PurgeTenantDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RCAEngine_GetFeedbackStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeedbackStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RCAEngineServer).GetFeedbackStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RCAEngine_GetFeedbackStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RCAEngineServer).GetFeedbackStats(ctx, req.(*GetFeedbackStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RCAEngine_MinePatterns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MinePatternsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RCAEngineServer).MinePatterns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RCAEngine_MinePatterns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RCAEngineServer).MinePatterns(ctx, req.(*MinePatternsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RCAEngine_UpdateCorrelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCorrelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RCAEngineServer).UpdateCorrelation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RCAEngine_UpdateCorrelation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RCAEngineServer).UpdateCorrelation(ctx, req.(*UpdateCorrelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RCAEngine_TestRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RCAEngineServer).TestRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RCAEngine_TestRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RCAEngineServer).TestRules(ctx, req.(*TestRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RCAEngine_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RCAEngineServer).GetVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RCAEngine_GetVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RCAEngineServer).GetVersion(ctx, req.(*GetVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RCAEngine_GetCorrelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCorrelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RCAEngineServer).GetCorrelation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RCAEngine_GetCorrelation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RCAEngineServer).GetCorrelation(ctx, req.(*GetCorrelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RCAEngine_ExplainCorrelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainCorrelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RCAEngineServer).ExplainCorrelation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RCAEngine_ExplainCorrelation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RCAEngineServer).ExplainCorrelation(ctx, req.(*ExplainCorrelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RCAEngine_GetThresholdRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThresholdRecommendationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RCAEngineServer).GetThresholdRecommendations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RCAEngine_GetThresholdRecommendations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RCAEngineServer).GetThresholdRecommendations(ctx, req.(*GetThresholdRecommendationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RCAEngine_GetServiceGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RCAEngineServer).GetServiceGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RCAEngine_GetServiceGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RCAEngineServer).GetServiceGraph(ctx, req.(*GetServiceGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RCAEngine_ServiceDesc is the grpc.ServiceDesc for RCAEngine service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -255,6 +731,10 @@ var RCAEngine_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListCorrelations",
 			Handler:    _RCAEngine_ListCorrelations_Handler,
 		},
+		{
+			MethodName: "SearchCorrelations",
+			Handler:    _RCAEngine_SearchCorrelations_Handler,
+		},
 		{
 			MethodName: "GetPatterns",
 			Handler:    _RCAEngine_GetPatterns_Handler,
@@ -267,6 +747,58 @@ var RCAEngine_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HealthCheck",
 			Handler:    _RCAEngine_HealthCheck_Handler,
 		},
+		{
+			MethodName: "CreateMaintenanceWindow",
+			Handler:    _RCAEngine_CreateMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "ListMaintenanceWindows",
+			Handler:    _RCAEngine_ListMaintenanceWindows_Handler,
+		},
+		{
+			MethodName: "DeleteMaintenanceWindow",
+			Handler:    _RCAEngine_DeleteMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "PurgeTenantData",
+			Handler:    _RCAEngine_PurgeTenantData_Handler,
+		},
+		{
+			MethodName: "GetFeedbackStats",
+			Handler:    _RCAEngine_GetFeedbackStats_Handler,
+		},
+		{
+			MethodName: "MinePatterns",
+			Handler:    _RCAEngine_MinePatterns_Handler,
+		},
+		{
+			MethodName: "UpdateCorrelation",
+			Handler:    _RCAEngine_UpdateCorrelation_Handler,
+		},
+		{
+			MethodName: "TestRules",
+			Handler:    _RCAEngine_TestRules_Handler,
+		},
+		{
+			MethodName: "GetVersion",
+			Handler:    _RCAEngine_GetVersion_Handler,
+		},
+		{
+			MethodName: "GetCorrelation",
+			Handler:    _RCAEngine_GetCorrelation_Handler,
+		},
+		{
+			MethodName: "ExplainCorrelation",
+			Handler:    _RCAEngine_ExplainCorrelation_Handler,
+		},
+		{
+			MethodName: "GetThresholdRecommendations",
+			Handler:    _RCAEngine_GetThresholdRecommendations_Handler,
+		},
+		{
+			MethodName: "GetServiceGraph",
+			Handler:    _RCAEngine_GetServiceGraph_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rca.proto",
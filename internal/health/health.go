@@ -0,0 +1,215 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// DefaultInterval is how often dependencies are probed when no interval is configured.
+	DefaultInterval = 15 * time.Second
+	// DefaultTimeout bounds a single probe when no timeout is configured.
+	DefaultTimeout = 5 * time.Second
+)
+
+// Check probes one dependency; a nil error means it is reachable.
+type Check func(ctx context.Context) error
+
+// Result is the outcome of the latest run of a check.
+type Result struct {
+	Name string `json:"name"`
+	// Required checks gate readiness; optional ones are reported but only degrade the service.
+	Required  bool      `json:"required"`
+	Healthy   bool      `json:"healthy"`
+	Error     string    `json:"error,omitempty"`
+	CheckedAt time.Time `json:"checked_at,omitempty"`
+	LatencyMs float64   `json:"latency_ms"`
+}
+
+type registration struct {
+	name     string
+	required bool
+	check    Check
+}
+
+// Checker probes the engine's dependencies in the background and serves the latest results as liveness and
+// readiness endpoints.
+type Checker struct {
+	interval time.Duration
+	timeout  time.Duration
+	draining atomic.Bool
+
+	mu        sync.RWMutex
+	checks    []registration
+	results   map[string]Result
+	listeners []func(ready bool, results []Result)
+}
+
+// NewChecker returns a checker probing every interval with a per-probe timeout; zero values use the defaults.
+func NewChecker(interval, timeout time.Duration) *Checker {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &Checker{interval: interval, timeout: timeout, results: map[string]Result{}}
+}
+
+// Register adds a check that must pass for the engine to be ready.
+func (c *Checker) Register(name string, check Check) {
+	c.register(name, true, check)
+}
+
+// RegisterOptional adds a check that is reported but does not affect readiness, for dependencies the engine
+// degrades without (the cache, the pattern store).
+func (c *Checker) RegisterOptional(name string, check Check) {
+	c.register(name, false, check)
+}
+
+func (c *Checker) register(name string, required bool, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks = append(c.checks, registration{name: name, required: required, check: check})
+}
+
+// OnUpdate registers fn to receive the readiness verdict after every round of checks and when draining
+// starts, for publishing it elsewhere such as the gRPC health service.
+func (c *Checker) OnUpdate(fn func(ready bool, results []Result)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.listeners = append(c.listeners, fn)
+}
+
+func (c *Checker) notify() {
+	ready, results := c.Ready()
+	c.mu.RLock()
+	listeners := append([]func(bool, []Result){}, c.listeners...)
+	c.mu.RUnlock()
+	for _, fn := range listeners {
+		fn(ready, results)
+	}
+}
+
+// Run probes every dependency immediately and then on each interval until ctx is cancelled.
+func (c *Checker) Run(ctx context.Context) {
+	c.CheckNow(ctx)
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.CheckNow(ctx)
+		}
+	}
+}
+
+// CheckNow runs every registered check concurrently and records the results.
+func (c *Checker) CheckNow(ctx context.Context) {
+	c.mu.RLock()
+	checks := append([]registration(nil), c.checks...)
+	c.mu.RUnlock()
+
+	var wg sync.WaitGroup
+	for _, reg := range checks {
+		wg.Add(1)
+		go func(reg registration) {
+			defer wg.Done()
+			result := c.probe(ctx, reg)
+			c.mu.Lock()
+			c.results[reg.name] = result
+			c.mu.Unlock()
+		}(reg)
+	}
+	wg.Wait()
+	c.notify()
+}
+
+func (c *Checker) probe(ctx context.Context, reg registration) Result {
+	probeCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	start := time.Now()
+	err := reg.check(probeCtx)
+	result := Result{
+		Name:      reg.name,
+		Required:  reg.required,
+		Healthy:   err == nil,
+		CheckedAt: start.UTC(),
+		LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// Drain marks the engine as shutting down: readiness fails from now on so load balancers stop routing new
+// work while in-flight requests finish.
+func (c *Checker) Drain() {
+	c.draining.Store(true)
+	c.notify()
+}
+
+// Draining reports whether Drain has been called.
+func (c *Checker) Draining() bool {
+	return c.draining.Load()
+}
+
+// Ready reports whether the engine should receive traffic, along with the latest result of every check in
+// registration order. A required check that has not completed yet counts as failing.
+func (c *Checker) Ready() (bool, []Result) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	ready := !c.draining.Load()
+	results := make([]Result, 0, len(c.checks))
+	for _, reg := range c.checks {
+		result, ok := c.results[reg.name]
+		if !ok {
+			result = Result{Name: reg.name, Required: reg.required, Error: "not checked yet"}
+		}
+		if reg.required && !result.Healthy {
+			ready = false
+		}
+		results = append(results, result)
+	}
+	return ready, results
+}
+
+// LivenessHandler answers 200 while the process is serving; it deliberately ignores dependencies so an
+// upstream outage does not get the engine restarted.
+func (c *Checker) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Header().Set("Cache-Control", "no-store")
+		_, _ = w.Write([]byte("ok\n"))
+	})
+}
+
+// ReadinessHandler answers 200 when Ready and 503 otherwise, with the per-check results as JSON.
+func (c *Checker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		ready, results := c.Ready()
+		status := "ready"
+		switch {
+		case c.Draining():
+			status = "draining"
+		case !ready:
+			status = "not_ready"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		if !ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(struct {
+			Status string   `json:"status"`
+			Checks []Result `json:"checks"`
+		}{Status: status, Checks: results})
+	})
+}
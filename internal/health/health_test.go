@@ -0,0 +1,89 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func readiness(t *testing.T, c *Checker) (int, string, []Result) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	c.ReadinessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	var body struct {
+		Status string   `json:"status"`
+		Checks []Result `json:"checks"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("decode readiness body: %v", err)
+	}
+	return rec.Code, body.Status, body.Checks
+}
+
+func TestCheckerReadiness(t *testing.T) {
+	coreErr := errors.New("connection refused")
+	var coreDown bool
+	checker := NewChecker(0, 0)
+	checker.Register("mirador_core", func(context.Context) error {
+		if coreDown {
+			return coreErr
+		}
+		return nil
+	})
+	checker.RegisterOptional("valkey", func(context.Context) error { return errors.New("timeout") })
+	var updates []bool
+	checker.OnUpdate(func(ready bool, _ []Result) { updates = append(updates, ready) })
+
+	if code, status, _ := readiness(t, checker); code != http.StatusServiceUnavailable || status != "not_ready" {
+		t.Fatalf("expected not ready before the first check, got %d %s", code, status)
+	}
+
+	checker.CheckNow(context.Background())
+	code, status, checks := readiness(t, checker)
+	if code != http.StatusOK || status != "ready" {
+		t.Fatalf("expected a failing optional check to keep the engine ready, got %d %s", code, status)
+	}
+	if len(checks) != 2 || !checks[0].Healthy || checks[1].Healthy || checks[1].Error != "timeout" {
+		t.Fatalf("unexpected check results: %+v", checks)
+	}
+
+	coreDown = true
+	checker.CheckNow(context.Background())
+	code, _, checks = readiness(t, checker)
+	if code != http.StatusServiceUnavailable || checks[0].Error != coreErr.Error() {
+		t.Fatalf("expected a failing required check to fail readiness, got %d %+v", code, checks)
+	}
+
+	coreDown = false
+	checker.CheckNow(context.Background())
+	checker.Drain()
+	if code, status, _ := readiness(t, checker); code != http.StatusServiceUnavailable || status != "draining" {
+		t.Fatalf("expected readiness to fail while draining, got %d %s", code, status)
+	}
+
+	if want := []bool{true, false, true, false}; !slices.Equal(updates, want) {
+		t.Fatalf("updates = %v, want %v", updates, want)
+	}
+
+	rec := httptest.NewRecorder()
+	checker.LivenessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected liveness to stay up while draining, got %d", rec.Code)
+	}
+}
+
+func TestCheckerTimeout(t *testing.T) {
+	checker := NewChecker(0, 1)
+	checker.Register("weaviate", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	checker.CheckNow(context.Background())
+	if ready, results := checker.Ready(); ready || results[0].Error != context.DeadlineExceeded.Error() {
+		t.Fatalf("expected a hung check to time out, got %+v", results)
+	}
+}
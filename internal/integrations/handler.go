@@ -0,0 +1,218 @@
+package integrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/audit"
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// maxWebhookBody bounds webhook payloads; incident notifications are a few kilobytes.
+const maxWebhookBody = 1 << 20
+
+// ErrUnauthorized is returned by a Source when a webhook fails signature or token verification.
+var ErrUnauthorized = errors.New("webhook verification failed")
+
+// Investigator runs an RCA investigation; *engine.Pipeline satisfies it.
+type Investigator interface {
+	Investigate(ctx context.Context, req models.InvestigationRequest) (models.CorrelationResult, error)
+}
+
+// Incident is a provider-neutral view of an incident notification.
+type Incident struct {
+	// ID is the provider's identifier, used to post the note back.
+	ID           string
+	TenantID     string
+	Title        string
+	Description  string
+	Service      string
+	URL          string
+	Fingerprints []string
+	OccurredAt   time.Time
+	// Environment scopes the investigation's signals; empty leaves them unscoped.
+	Environment string
+}
+
+// Source is an incident management provider that delivers webhooks and accepts notes.
+type Source interface {
+	// Name is the URL segment the provider's webhooks are served under.
+	Name() string
+	// Parse verifies and decodes a webhook. It returns false for events that should not start an
+	// investigation, such as acknowledgements or resolutions.
+	Parse(header http.Header, body []byte) (Incident, bool, error)
+	// PostNote attaches text to the incident.
+	PostNote(ctx context.Context, incident Incident, note string) error
+}
+
+// Handler serves /webhooks/<source> endpoints. Accepted incidents are investigated asynchronously and the
+// root cause is posted back as a note; when maxConcurrent investigations are already running, webhooks are
+// rejected with 503 so the provider retries later.
+type Handler struct {
+	logger       *slog.Logger
+	investigator Investigator
+	sources      map[string]Source
+	lookback     time.Duration
+	timeout      time.Duration
+	slots        chan struct{}
+	wg           sync.WaitGroup
+}
+
+// NewHandler builds a webhook handler. Non-positive lookback, timeout, and maxConcurrent default to 30
+// minutes, two minutes, and 4.
+func NewHandler(logger *slog.Logger, investigator Investigator, lookback, timeout time.Duration, maxConcurrent int, sources ...Source) *Handler {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if lookback <= 0 {
+		lookback = 30 * time.Minute
+	}
+	if timeout <= 0 {
+		timeout = 2 * time.Minute
+	}
+	if maxConcurrent <= 0 {
+		maxConcurrent = 4
+	}
+	h := &Handler{
+		logger:       logger,
+		investigator: investigator,
+		sources:      make(map[string]Source, len(sources)),
+		lookback:     lookback,
+		timeout:      timeout,
+		slots:        make(chan struct{}, maxConcurrent),
+	}
+	for _, source := range sources {
+		h.sources[source.Name()] = source
+	}
+	return h
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	source, ok := h.sources[strings.Trim(strings.TrimPrefix(r.URL.Path, "/webhooks/"), "/")]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBody))
+	if err != nil {
+		http.Error(w, "read body", http.StatusBadRequest)
+		return
+	}
+
+	incident, investigate, err := source.Parse(r.Header, body)
+	if errors.Is(err, ErrUnauthorized) {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	if err != nil {
+		h.logger.Warn("malformed incident webhook", slog.String("source", source.Name()), slog.Any("error", err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !investigate {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	select {
+	case h.slots <- struct{}{}:
+	default:
+		http.Error(w, "investigation capacity exhausted", http.StatusServiceUnavailable)
+		return
+	}
+	h.wg.Add(1)
+	go func() {
+		defer h.wg.Done()
+		defer func() { <-h.slots }()
+		h.process(source, incident)
+	}()
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// Wait blocks until in-flight investigations finish.
+func (h *Handler) Wait() {
+	h.wg.Wait()
+}
+
+func (h *Handler) process(source Source, incident Incident) {
+	ctx, cancel := context.WithTimeout(audit.WithActor(context.Background(), "webhook:"+source.Name()), h.timeout)
+	defer cancel()
+
+	logger := h.logger.With(slog.String("source", source.Name()), slog.String("incident_id", incident.ID), slog.String("tenant_id", incident.TenantID))
+	result, err := h.investigator.Investigate(ctx, investigationRequest(incident, source.Name(), h.lookback, time.Now()))
+	if err != nil {
+		logger.Warn("webhook investigation failed", slog.Any("error", err))
+		return
+	}
+	if err := source.PostNote(ctx, incident, FormatNote(result)); err != nil {
+		logger.Warn("failed to post RCA note", slog.Any("error", err))
+		return
+	}
+	logger.Info("RCA note posted", slog.String("correlation_id", result.CorrelationID))
+}
+
+func investigationRequest(incident Incident, sourceName string, lookback time.Duration, now time.Time) models.InvestigationRequest {
+	end := now.UTC()
+	start := end.Add(-lookback)
+	if !incident.OccurredAt.IsZero() && incident.OccurredAt.Before(end) {
+		start = incident.OccurredAt.UTC().Add(-lookback)
+	}
+	req := models.InvestigationRequest{
+		IncidentID:  sourceName + ":" + incident.ID,
+		TimeRange:   models.TimeRange{Start: start, End: end},
+		TenantID:    incident.TenantID,
+		Environment: incident.Environment,
+		Incident: models.IncidentMetadata{
+			Title:             incident.Title,
+			Description:       incident.Description,
+			AlertFingerprints: append([]string(nil), incident.Fingerprints...),
+			TicketURL:         incident.URL,
+		},
+	}
+	if incident.Service != "" {
+		req.AffectedServices = []string{incident.Service}
+	}
+	if incident.Title != "" {
+		req.Symptoms = []string{incident.Title}
+	}
+	return req
+}
+
+// FormatNote renders a plain-text summary of a correlation for incident timelines.
+func FormatNote(result models.CorrelationResult) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Mirador RCA root cause: %s (confidence %.0f%%)\n", result.RootCause, result.Confidence*100)
+	if len(result.AffectedServices) > 0 {
+		fmt.Fprintf(&b, "Affected services: %s\n", strings.Join(result.AffectedServices, ", "))
+	}
+	if len(result.Recommendations) > 0 {
+		b.WriteString("Recommendations:\n")
+		for _, recommendation := range result.Recommendations {
+			fmt.Fprintf(&b, "- %s\n", recommendation.Text)
+			for _, action := range recommendation.Actions {
+				fmt.Fprintf(&b, "  %s: %s\n", actionLabel(action), action.URL)
+			}
+		}
+	}
+	fmt.Fprintf(&b, "Correlation: %s", result.CorrelationID)
+	return b.String()
+}
+
+func actionLabel(action models.RecommendationAction) string {
+	if action.Label != "" {
+		return action.Label
+	}
+	return string(action.Type)
+}
@@ -0,0 +1,131 @@
+package integrations
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+type investigatorStub struct {
+	mu       sync.Mutex
+	requests []models.InvestigationRequest
+	block    chan struct{}
+}
+
+func (s *investigatorStub) Investigate(ctx context.Context, req models.InvestigationRequest) (models.CorrelationResult, error) {
+	if s.block != nil {
+		<-s.block
+	}
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	s.mu.Unlock()
+	return models.CorrelationResult{
+		CorrelationID:   "corr-1",
+		RootCause:       "payments: db connection pool exhausted",
+		Confidence:      0.82,
+		Recommendations: []models.Recommendation{{Text: "Raise the pool size"}},
+	}, nil
+}
+
+func signPagerDuty(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestPagerDutyWebhookPostsNote(t *testing.T) {
+	var note map[string]map[string]string
+	var notePath, auth string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		notePath, auth = r.URL.Path, r.Header.Get("Authorization")
+		_ = json.NewDecoder(r.Body).Decode(&note)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer api.Close()
+
+	source, err := NewPagerDuty(PagerDutyConfig{TenantID: "acme", Environment: "prod", WebhookSecret: "s3cret", APIToken: "tok", APIURL: api.URL})
+	if err != nil {
+		t.Fatalf("new pagerduty: %v", err)
+	}
+	investigator := &investigatorStub{}
+	handler := NewHandler(nil, investigator, 30*time.Minute, time.Minute, 2, source)
+
+	body := []byte(`{"event":{"event_type":"incident.triggered","occurred_at":"2024-03-01T10:00:00Z","data":{"id":"PGR0VU2","type":"incident","title":"Checkout 5xx","html_url":"https://acme.pagerduty.com/incidents/PGR0VU2","incident_key":"abc123","service":{"summary":"checkout"}}}}`)
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/pagerduty", strings.NewReader(string(body)))
+	req.Header.Set("X-PagerDuty-Signature", "v1=deadbeef, "+signPagerDuty("s3cret", body))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("expected 202, got %d: %s", rec.Code, rec.Body.String())
+	}
+	handler.Wait()
+
+	if len(investigator.requests) != 1 {
+		t.Fatalf("expected one investigation, got %d", len(investigator.requests))
+	}
+	got := investigator.requests[0]
+	if got.TenantID != "acme" || got.Environment != "prod" || got.IncidentID != "pagerduty:PGR0VU2" || got.AffectedServices[0] != "checkout" || got.Incident.TicketURL != "https://acme.pagerduty.com/incidents/PGR0VU2" {
+		t.Fatalf("unexpected investigation request: %+v", got)
+	}
+	if !got.TimeRange.Start.Equal(time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)) {
+		t.Fatalf("expected window to start lookback before the incident, got %s", got.TimeRange.Start)
+	}
+	if notePath != "/incidents/PGR0VU2/notes" || auth != "Token token=tok" {
+		t.Fatalf("unexpected note request %s (%s)", notePath, auth)
+	}
+	if content := note["note"]["content"]; !strings.Contains(content, "db connection pool exhausted") || !strings.Contains(content, "82%") {
+		t.Fatalf("unexpected note content %q", content)
+	}
+
+	bad := httptest.NewRequest(http.MethodPost, "/webhooks/pagerduty", strings.NewReader(string(body)))
+	bad.Header.Set("X-PagerDuty-Signature", "v1=deadbeef")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, bad)
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 for bad signature, got %d", rec.Code)
+	}
+}
+
+func TestOpsgenieWebhookIgnoresNonCreateAndAppliesBackpressure(t *testing.T) {
+	source, err := NewOpsgenie(OpsgenieConfig{TenantID: "acme", Environment: "prod", WebhookToken: "tok", APIKey: "key", APIURL: "http://127.0.0.1:0"})
+	if err != nil {
+		t.Fatalf("new opsgenie: %v", err)
+	}
+	investigator := &investigatorStub{block: make(chan struct{})}
+	handler := NewHandler(nil, investigator, time.Minute, time.Minute, 1, source)
+
+	send := func(body string) int {
+		req := httptest.NewRequest(http.MethodPost, "/webhooks/opsgenie", strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer tok")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	if code := send(`{"action":"Acknowledge","alert":{"alertId":"a-1"}}`); code != http.StatusOK {
+		t.Fatalf("expected acknowledgement to be ignored with 200, got %d", code)
+	}
+	create := `{"action":"Create","alert":{"alertId":"a-1","message":"High latency","tags":["team:core","service:payments","env:staging"],"createdAt":1709287200000}}`
+	if code := send(create); code != http.StatusAccepted {
+		t.Fatalf("expected 202, got %d", code)
+	}
+	if code := send(create); code != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503 while at capacity, got %d", code)
+	}
+	close(investigator.block)
+	handler.Wait()
+
+	if len(investigator.requests) != 1 || investigator.requests[0].AffectedServices[0] != "payments" || investigator.requests[0].Environment != "staging" {
+		t.Fatalf("unexpected investigations: %+v", investigator.requests)
+	}
+}
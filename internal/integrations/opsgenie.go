@@ -0,0 +1,138 @@
+package integrations
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// OpsgenieConfig configures the Opsgenie webhook source. WebhookToken must be sent by the outgoing webhook
+// integration as "Authorization: Bearer <token>"; APIKey authenticates note creation. Environment is used for
+// alerts that do not name their own.
+type OpsgenieConfig struct {
+	TenantID     string
+	Environment  string
+	WebhookToken string
+	APIKey       string
+	APIURL       string
+	Timeout      time.Duration
+}
+
+// Opsgenie consumes Opsgenie outgoing webhooks for alert creation.
+type Opsgenie struct {
+	cfg        OpsgenieConfig
+	httpClient *http.Client
+}
+
+// NewOpsgenie builds an Opsgenie source. APIURL defaults to the US region; use https://api.eu.opsgenie.com
+// for EU accounts.
+func NewOpsgenie(cfg OpsgenieConfig) (*Opsgenie, error) {
+	if cfg.TenantID == "" {
+		return nil, fmt.Errorf("opsgenie tenant is required")
+	}
+	if cfg.WebhookToken == "" {
+		return nil, fmt.Errorf("opsgenie webhook token is required")
+	}
+	if cfg.APIURL == "" {
+		cfg.APIURL = "https://api.opsgenie.com"
+	}
+	cfg.APIURL = strings.TrimRight(cfg.APIURL, "/")
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+	return &Opsgenie{cfg: cfg, httpClient: &http.Client{Timeout: cfg.Timeout}}, nil
+}
+
+// Name implements Source.
+func (o *Opsgenie) Name() string { return "opsgenie" }
+
+// Parse checks the bearer token and maps Create actions to incidents. The service comes from the alert
+// entity or a "service:<name>" tag, and the environment from an "environment" detail or an "env:<name>" or
+// "environment:<name>" tag.
+func (o *Opsgenie) Parse(header http.Header, body []byte) (Incident, bool, error) {
+	token, _ := strings.CutPrefix(header.Get("Authorization"), "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(o.cfg.WebhookToken)) != 1 {
+		return Incident{}, false, ErrUnauthorized
+	}
+	var payload struct {
+		Action string `json:"action"`
+		Alert  struct {
+			AlertID     string            `json:"alertId"`
+			Message     string            `json:"message"`
+			Description string            `json:"description"`
+			Alias       string            `json:"alias"`
+			Entity      string            `json:"entity"`
+			Tags        []string          `json:"tags"`
+			Details     map[string]string `json:"details"`
+			CreatedAt   int64             `json:"createdAt"`
+		} `json:"alert"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return Incident{}, false, fmt.Errorf("decode opsgenie webhook: %w", err)
+	}
+	if payload.Action != "Create" {
+		return Incident{}, false, nil
+	}
+	alert := payload.Alert
+	if alert.AlertID == "" {
+		return Incident{}, false, fmt.Errorf("opsgenie webhook has no alert id")
+	}
+	incident := Incident{
+		ID:          alert.AlertID,
+		TenantID:    o.cfg.TenantID,
+		Title:       alert.Message,
+		Description: alert.Description,
+		Service:     alert.Entity,
+		Environment: alert.Details["environment"],
+	}
+	for _, tag := range alert.Tags {
+		if service, ok := strings.CutPrefix(tag, "service:"); ok && incident.Service == "" {
+			incident.Service = service
+		}
+		if environment, ok := cutEnvironmentTag(tag); ok && incident.Environment == "" {
+			incident.Environment = environment
+		}
+	}
+	if incident.Environment == "" {
+		incident.Environment = o.cfg.Environment
+	}
+	if alert.Alias != "" {
+		incident.Fingerprints = []string{alert.Alias}
+	}
+	if alert.CreatedAt > 0 {
+		incident.OccurredAt = time.UnixMilli(alert.CreatedAt).UTC()
+	}
+	return incident, true, nil
+}
+
+func cutEnvironmentTag(tag string) (string, bool) {
+	if environment, ok := strings.CutPrefix(tag, "environment:"); ok {
+		return environment, true
+	}
+	return strings.CutPrefix(tag, "env:")
+}
+
+// PostNote adds a note to the alert through the Alert API.
+func (o *Opsgenie) PostNote(ctx context.Context, incident Incident, note string) error {
+	if o.cfg.APIKey == "" {
+		return fmt.Errorf("opsgenie api key not configured")
+	}
+	body, err := json.Marshal(map[string]string{"note": note, "source": "mirador-rca"})
+	if err != nil {
+		return err
+	}
+	target := o.cfg.APIURL + "/v2/alerts/" + url.PathEscape(incident.ID) + "/notes?identifierType=id"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "GenieKey "+o.cfg.APIKey)
+	return doNoteRequest(o.httpClient, req, "opsgenie")
+}
@@ -0,0 +1,156 @@
+package integrations
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// PagerDutyConfig configures the PagerDuty webhook source. WebhookSecret verifies v3 webhook signatures;
+// APIToken and FromEmail authenticate note creation through the REST API. PagerDuty incidents carry no
+// environment, so every incident is investigated in Environment.
+type PagerDutyConfig struct {
+	TenantID      string
+	Environment   string
+	WebhookSecret string
+	APIToken      string
+	FromEmail     string
+	APIURL        string
+	Timeout       time.Duration
+}
+
+// PagerDuty consumes PagerDuty v3 incident webhooks.
+type PagerDuty struct {
+	cfg        PagerDutyConfig
+	httpClient *http.Client
+}
+
+// NewPagerDuty builds a PagerDuty source. APIURL defaults to the public API.
+func NewPagerDuty(cfg PagerDutyConfig) (*PagerDuty, error) {
+	if cfg.TenantID == "" {
+		return nil, fmt.Errorf("pagerduty tenant is required")
+	}
+	if cfg.WebhookSecret == "" {
+		return nil, fmt.Errorf("pagerduty webhook secret is required")
+	}
+	if cfg.APIURL == "" {
+		cfg.APIURL = "https://api.pagerduty.com"
+	}
+	cfg.APIURL = strings.TrimRight(cfg.APIURL, "/")
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+	return &PagerDuty{cfg: cfg, httpClient: &http.Client{Timeout: cfg.Timeout}}, nil
+}
+
+// Name implements Source.
+func (p *PagerDuty) Name() string { return "pagerduty" }
+
+// Parse verifies the X-PagerDuty-Signature header and maps incident.triggered events to incidents.
+func (p *PagerDuty) Parse(header http.Header, body []byte) (Incident, bool, error) {
+	if !validPagerDutySignature(p.cfg.WebhookSecret, header.Get("X-PagerDuty-Signature"), body) {
+		return Incident{}, false, ErrUnauthorized
+	}
+	var payload struct {
+		Event struct {
+			EventType  string    `json:"event_type"`
+			OccurredAt time.Time `json:"occurred_at"`
+			Data       struct {
+				ID          string `json:"id"`
+				Type        string `json:"type"`
+				Title       string `json:"title"`
+				HTMLURL     string `json:"html_url"`
+				IncidentKey string `json:"incident_key"`
+				Service     struct {
+					Summary string `json:"summary"`
+				} `json:"service"`
+			} `json:"data"`
+		} `json:"event"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return Incident{}, false, fmt.Errorf("decode pagerduty webhook: %w", err)
+	}
+	event := payload.Event
+	if event.EventType != "incident.triggered" {
+		return Incident{}, false, nil
+	}
+	if event.Data.ID == "" {
+		return Incident{}, false, fmt.Errorf("pagerduty webhook has no incident id")
+	}
+	incident := Incident{
+		ID:          event.Data.ID,
+		TenantID:    p.cfg.TenantID,
+		Title:       event.Data.Title,
+		Service:     event.Data.Service.Summary,
+		Environment: p.cfg.Environment,
+		URL:         event.Data.HTMLURL,
+		OccurredAt:  event.OccurredAt,
+	}
+	if event.Data.IncidentKey != "" {
+		incident.Fingerprints = []string{event.Data.IncidentKey}
+	}
+	return incident, true, nil
+}
+
+// PostNote adds a note to the incident through the REST API.
+func (p *PagerDuty) PostNote(ctx context.Context, incident Incident, note string) error {
+	if p.cfg.APIToken == "" {
+		return fmt.Errorf("pagerduty api token not configured")
+	}
+	body, err := json.Marshal(map[string]interface{}{"note": map[string]string{"content": note}})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.cfg.APIURL+"/incidents/"+url.PathEscape(incident.ID)+"/notes", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/vnd.pagerduty+json;version=2")
+	req.Header.Set("Authorization", "Token token="+p.cfg.APIToken)
+	if p.cfg.FromEmail != "" {
+		req.Header.Set("From", p.cfg.FromEmail)
+	}
+	return doNoteRequest(p.httpClient, req, "pagerduty")
+}
+
+// validPagerDutySignature checks the comma-separated v1=<hex hmac> signatures PagerDuty sends; any match is
+// accepted so secrets can be rotated.
+func validPagerDutySignature(secret, header string, body []byte) bool {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	expected := mac.Sum(nil)
+	for _, signature := range strings.Split(header, ",") {
+		value, ok := strings.CutPrefix(strings.TrimSpace(signature), "v1=")
+		if !ok {
+			continue
+		}
+		decoded, err := hex.DecodeString(value)
+		if err == nil && hmac.Equal(decoded, expected) {
+			return true
+		}
+	}
+	return false
+}
+
+func doNoteRequest(client *http.Client, req *http.Request, provider string) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s add note: %w", provider, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("%s add note: %s: %s", provider, resp.Status, strings.TrimSpace(string(data)))
+	}
+	return nil
+}
@@ -0,0 +1,294 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/audit"
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// Record is one Kafka message.
+type Record struct {
+	Topic     string
+	Partition int32
+	Offset    int64
+	Key       []byte
+	Value     []byte
+}
+
+// Client is the broker access the consumer needs; *RESTProxy implements it.
+type Client interface {
+	// Poll returns the next batch of records, possibly empty.
+	Poll(ctx context.Context) ([]Record, error)
+	// Commit acknowledges records so they are not redelivered to the group.
+	Commit(ctx context.Context, records []Record) error
+	// Produce appends records to topic.
+	Produce(ctx context.Context, topic string, records []Record) error
+	// Reset abandons uncommitted records; they are redelivered from the last committed offsets.
+	Reset(ctx context.Context) error
+}
+
+// Investigator runs an RCA investigation; *engine.Pipeline satisfies it.
+type Investigator interface {
+	Investigate(ctx context.Context, req models.InvestigationRequest) (models.CorrelationResult, error)
+}
+
+// ConsumerConfig tunes the consumer. Non-positive values fall back to the defaults noted per field.
+type ConsumerConfig struct {
+	// DeadLetterTopic receives malformed events and events whose investigation kept failing; when empty they
+	// are logged and acknowledged.
+	DeadLetterTopic string
+	// MaxConcurrent bounds parallel investigations within a batch (default 4).
+	MaxConcurrent int
+	// MaxAttempts is how often an investigation is tried before the event is dead-lettered (default 3).
+	MaxAttempts int
+	// RetryBackoff is the pause between attempts and after broker errors (default 5s).
+	RetryBackoff time.Duration
+	// Lookback is the window analysed before occurredAt when an event has no explicit range (default 30m).
+	Lookback time.Duration
+	// Timeout bounds each investigation (default 2m).
+	Timeout time.Duration
+}
+
+// Event is the JSON payload expected on the incidents topic.
+type Event struct {
+	TenantID         string            `json:"tenantId"`
+	IncidentID       string            `json:"incidentId"`
+	Symptoms         []string          `json:"symptoms"`
+	AffectedServices []string          `json:"affectedServices"`
+	StartTime        *time.Time        `json:"startTime"`
+	EndTime          *time.Time        `json:"endTime"`
+	OccurredAt       *time.Time        `json:"occurredAt"`
+	AnomalyThreshold float64           `json:"anomalyThreshold"`
+	Labels           map[string]string `json:"labels"`
+	Environment      string            `json:"environment"`
+	Incident         struct {
+		Title             string   `json:"title"`
+		Description       string   `json:"description"`
+		AlertFingerprints []string `json:"alertFingerprints"`
+		TicketURL         string   `json:"ticketUrl"`
+	} `json:"incident"`
+}
+
+// deadLetter wraps a failed event with the reason and its origin; Payload keeps the original bytes.
+type deadLetter struct {
+	Reason      string    `json:"reason"`
+	SourceTopic string    `json:"sourceTopic"`
+	Partition   int32     `json:"partition"`
+	Offset      int64     `json:"offset"`
+	FailedAt    time.Time `json:"failedAt"`
+	Payload     []byte    `json:"payload"`
+}
+
+// Consumer turns incident events into investigations. Batches are processed with bounded concurrency and the
+// next poll only happens once the batch is done, so a slow engine throttles consumption instead of piling up
+// work. Offsets are committed after every record of a batch was investigated or dead-lettered; if a
+// dead-letter write fails the batch is abandoned and redelivered.
+type Consumer struct {
+	logger       *slog.Logger
+	client       Client
+	investigator Investigator
+	cfg          ConsumerConfig
+}
+
+// NewConsumer builds a consumer.
+func NewConsumer(logger *slog.Logger, client Client, investigator Investigator, cfg ConsumerConfig) *Consumer {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if cfg.MaxConcurrent <= 0 {
+		cfg.MaxConcurrent = 4
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 3
+	}
+	if cfg.RetryBackoff <= 0 {
+		cfg.RetryBackoff = 5 * time.Second
+	}
+	if cfg.Lookback <= 0 {
+		cfg.Lookback = 30 * time.Minute
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 2 * time.Minute
+	}
+	return &Consumer{logger: logger, client: client, investigator: investigator, cfg: cfg}
+}
+
+// Run consumes until ctx is cancelled, then leaves the consumer group.
+func (c *Consumer) Run(ctx context.Context) {
+	defer func() {
+		if err := c.client.Reset(context.WithoutCancel(ctx)); err != nil {
+			c.logger.Warn("failed to close kafka consumer", slog.Any("error", err))
+		}
+	}()
+	for ctx.Err() == nil {
+		records, err := c.client.Poll(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				c.logger.Warn("kafka poll failed", slog.Any("error", err))
+				sleep(ctx, c.cfg.RetryBackoff)
+			}
+			continue
+		}
+		if len(records) == 0 {
+			continue
+		}
+		if err := c.processBatch(ctx, records); err != nil {
+			c.logger.Warn("kafka batch not acknowledged; it will be redelivered", slog.Int("records", len(records)), slog.Any("error", err))
+			if err := c.client.Reset(ctx); err != nil {
+				c.logger.Warn("failed to reset kafka consumer", slog.Any("error", err))
+			}
+			sleep(ctx, c.cfg.RetryBackoff)
+		}
+	}
+}
+
+func (c *Consumer) processBatch(ctx context.Context, records []Record) error {
+	slots := make(chan struct{}, c.cfg.MaxConcurrent)
+	errs := make([]error, len(records))
+	var wg sync.WaitGroup
+	for i, record := range records {
+		slots <- struct{}{}
+		wg.Add(1)
+		go func(i int, record Record) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			errs[i] = c.process(ctx, record)
+		}(i, record)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return c.client.Commit(ctx, records)
+}
+
+// process investigates one record; the returned error means the record must not be acknowledged.
+func (c *Consumer) process(ctx context.Context, record Record) error {
+	logger := c.logger.With(slog.String("topic", record.Topic), slog.Int("partition", int(record.Partition)), slog.Int64("offset", record.Offset))
+	req, err := DecodeEvent(record.Value, c.cfg.Lookback, time.Now())
+	if err != nil {
+		logger.Warn("malformed incident event", slog.Any("error", err))
+		return c.deadLetter(ctx, record, err)
+	}
+
+	var lastErr error
+	for attempt := 1; attempt <= c.cfg.MaxAttempts; attempt++ {
+		investigateCtx, cancel := context.WithTimeout(audit.WithActor(ctx, "kafka:"+record.Topic), c.cfg.Timeout)
+		result, err := c.investigator.Investigate(investigateCtx, req)
+		cancel()
+		if err == nil {
+			logger.Info("incident event investigated", slog.String("incident_id", req.IncidentID), slog.String("correlation_id", result.CorrelationID))
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		lastErr = err
+		logger.Warn("incident event investigation failed", slog.Int("attempt", attempt), slog.Any("error", err))
+		if attempt < c.cfg.MaxAttempts {
+			sleep(ctx, c.cfg.RetryBackoff)
+		}
+	}
+	return c.deadLetter(ctx, record, fmt.Errorf("investigation failed after %d attempts: %w", c.cfg.MaxAttempts, lastErr))
+}
+
+func (c *Consumer) deadLetter(ctx context.Context, record Record, reason error) error {
+	if c.cfg.DeadLetterTopic == "" {
+		return nil
+	}
+	value, err := json.Marshal(deadLetter{
+		Reason:      reason.Error(),
+		SourceTopic: record.Topic,
+		Partition:   record.Partition,
+		Offset:      record.Offset,
+		FailedAt:    time.Now().UTC(),
+		Payload:     record.Value,
+	})
+	if err != nil {
+		return err
+	}
+	if err := c.client.Produce(ctx, c.cfg.DeadLetterTopic, []Record{{Key: record.Key, Value: value}}); err != nil {
+		return fmt.Errorf("dead-letter offset %d: %w", record.Offset, err)
+	}
+	return nil
+}
+
+// DecodeEvent validates an incident event and converts it into an investigation request. Without an explicit
+// range, the window covers lookback before occurredAt (or now) up to now.
+func DecodeEvent(data []byte, lookback time.Duration, now time.Time) (models.InvestigationRequest, error) {
+	var event Event
+	if err := json.Unmarshal(data, &event); err != nil {
+		return models.InvestigationRequest{}, fmt.Errorf("decode event: %w", err)
+	}
+	if event.TenantID == "" {
+		return models.InvestigationRequest{}, fmt.Errorf("tenantId is required")
+	}
+	if event.IncidentID == "" {
+		return models.InvestigationRequest{}, fmt.Errorf("incidentId is required")
+	}
+	for key := range event.Labels {
+		if key == "" || strings.Contains(key, "=") {
+			return models.InvestigationRequest{}, fmt.Errorf("invalid label key %q", key)
+		}
+	}
+	if ticketURL := event.Incident.TicketURL; ticketURL != "" {
+		parsed, err := url.Parse(ticketURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return models.InvestigationRequest{}, fmt.Errorf("incident.ticketUrl must be an absolute http(s) URL")
+		}
+	}
+
+	end := now.UTC()
+	if event.EndTime != nil {
+		end = event.EndTime.UTC()
+	}
+	start := end.Add(-lookback)
+	switch {
+	case event.StartTime != nil:
+		start = event.StartTime.UTC()
+	case event.OccurredAt != nil:
+		start = event.OccurredAt.UTC().Add(-lookback)
+	}
+	if !start.Before(end) {
+		return models.InvestigationRequest{}, fmt.Errorf("startTime must be before endTime")
+	}
+
+	return models.InvestigationRequest{
+		IncidentID:       event.IncidentID,
+		Symptoms:         event.Symptoms,
+		TimeRange:        models.TimeRange{Start: start, End: end},
+		AffectedServices: event.AffectedServices,
+		AnomalyThreshold: event.AnomalyThreshold,
+		TenantID:         event.TenantID,
+		Labels:           event.Labels,
+		Environment:      strings.TrimSpace(event.Environment),
+		Incident: models.IncidentMetadata{
+			Title:             event.Incident.Title,
+			Description:       event.Incident.Description,
+			AlertFingerprints: event.Incident.AlertFingerprints,
+			TicketURL:         event.Incident.TicketURL,
+		},
+	}, nil
+}
+
+func sleep(ctx context.Context, d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
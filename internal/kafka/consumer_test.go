@@ -0,0 +1,210 @@
+package kafka
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+type fakeClient struct {
+	mu         sync.Mutex
+	batches    [][]Record
+	committed  []Record
+	produced   map[string][]Record
+	resets     int
+	produceErr error
+	cancel     context.CancelFunc
+}
+
+func (f *fakeClient) Poll(ctx context.Context) ([]Record, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.batches) == 0 {
+		f.cancel()
+		return nil, ctx.Err()
+	}
+	batch := f.batches[0]
+	f.batches = f.batches[1:]
+	return batch, nil
+}
+
+func (f *fakeClient) Commit(ctx context.Context, records []Record) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.committed = append(f.committed, records...)
+	return nil
+}
+
+func (f *fakeClient) Produce(ctx context.Context, topic string, records []Record) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.produceErr != nil {
+		return f.produceErr
+	}
+	if f.produced == nil {
+		f.produced = map[string][]Record{}
+	}
+	f.produced[topic] = append(f.produced[topic], records...)
+	return nil
+}
+
+func (f *fakeClient) Reset(ctx context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.resets++
+	return nil
+}
+
+type investigatorStub struct {
+	mu       sync.Mutex
+	requests []models.InvestigationRequest
+	failures map[string]int
+}
+
+func (s *investigatorStub) Investigate(ctx context.Context, req models.InvestigationRequest) (models.CorrelationResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, req)
+	if s.failures[req.IncidentID] > 0 {
+		s.failures[req.IncidentID]--
+		return models.CorrelationResult{}, errors.New("core unavailable")
+	}
+	return models.CorrelationResult{CorrelationID: "corr-" + req.IncidentID}, nil
+}
+
+func TestConsumerInvestigatesAndDeadLetters(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := &fakeClient{cancel: cancel, batches: [][]Record{{
+		{Topic: "incidents", Partition: 0, Offset: 10, Value: []byte(`{"tenantId":"acme","incidentId":"INC-1","affectedServices":["checkout"],"occurredAt":"2024-03-01T10:00:00Z","labels":{"team":"payments"},"environment":"prod"}`)},
+		{Topic: "incidents", Partition: 0, Offset: 11, Value: []byte(`not json`)},
+		{Topic: "incidents", Partition: 1, Offset: 3, Value: []byte(`{"tenantId":"acme","incidentId":"INC-2"}`)},
+	}}}
+	investigator := &investigatorStub{failures: map[string]int{"INC-2": 5}}
+	consumer := NewConsumer(nil, client, investigator, ConsumerConfig{DeadLetterTopic: "incidents.dlq", MaxAttempts: 2, RetryBackoff: time.Millisecond, Lookback: 15 * time.Minute})
+	consumer.Run(ctx)
+
+	if len(client.committed) != 3 {
+		t.Fatalf("expected the whole batch to be committed, got %+v", client.committed)
+	}
+	dead := client.produced["incidents.dlq"]
+	if len(dead) != 2 {
+		t.Fatalf("expected two dead letters, got %d", len(dead))
+	}
+	reasons := map[int64]string{}
+	for _, record := range dead {
+		var letter deadLetter
+		if err := json.Unmarshal(record.Value, &letter); err != nil {
+			t.Fatalf("decode dead letter: %v", err)
+		}
+		reasons[letter.Offset] = letter.Reason
+		if letter.Offset == 11 && string(letter.Payload) != "not json" {
+			t.Fatalf("expected original payload to be kept, got %q", letter.Payload)
+		}
+	}
+	if !strings.Contains(reasons[11], "decode event") || !strings.Contains(reasons[3], "after 2 attempts") {
+		t.Fatalf("unexpected dead-letter reasons: %+v", reasons)
+	}
+
+	var first models.InvestigationRequest
+	for _, req := range investigator.requests {
+		if req.IncidentID == "INC-1" {
+			first = req
+		}
+	}
+	if first.TenantID != "acme" || first.Labels["team"] != "payments" || first.Environment != "prod" || !first.TimeRange.Start.Equal(time.Date(2024, 3, 1, 9, 45, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected investigation request: %+v", first)
+	}
+}
+
+func TestConsumerRedeliversWhenDeadLetterFails(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := &fakeClient{cancel: cancel, produceErr: errors.New("proxy down"), batches: [][]Record{{
+		{Topic: "incidents", Offset: 1, Value: []byte(`{"incidentId":"INC-1"}`)},
+	}}}
+	consumer := NewConsumer(nil, client, &investigatorStub{}, ConsumerConfig{DeadLetterTopic: "incidents.dlq", RetryBackoff: time.Millisecond})
+	consumer.Run(ctx)
+
+	if len(client.committed) != 0 {
+		t.Fatalf("expected nothing to be committed, got %+v", client.committed)
+	}
+	if client.resets < 2 {
+		t.Fatalf("expected the consumer to be reset for redelivery and on shutdown, got %d resets", client.resets)
+	}
+}
+
+func TestRESTProxyConsumesCommitsAndProduces(t *testing.T) {
+	var mu sync.Mutex
+	var subscribed, committed, produced map[string]interface{}
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		base := "/consumers/rca/instances/i-1"
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/consumers/rca":
+			_ = json.NewEncoder(w).Encode(map[string]string{"instance_id": "i-1", "base_uri": server.URL + base})
+		case r.Method == http.MethodPost && r.URL.Path == base+"/subscription":
+			_ = json.NewDecoder(r.Body).Decode(&subscribed)
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && r.URL.Path == base+"/records":
+			value := base64.StdEncoding.EncodeToString([]byte(`{"tenantId":"acme"}`))
+			_ = json.NewEncoder(w).Encode([]map[string]interface{}{{"topic": "incidents", "key": nil, "value": value, "partition": 2, "offset": 7}})
+		case r.Method == http.MethodPost && r.URL.Path == base+"/offsets":
+			_ = json.NewDecoder(r.Body).Decode(&committed)
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodPost && r.URL.Path == "/topics/incidents.dlq":
+			_ = json.NewDecoder(r.Body).Decode(&produced)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"offsets": []map[string]interface{}{{"partition": 0, "offset": 1}}})
+		case r.Method == http.MethodDelete && r.URL.Path == base:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	proxy, err := NewRESTProxy(RESTProxyConfig{URL: server.URL, Group: "rca", Topic: "incidents", PollTimeout: time.Second})
+	if err != nil {
+		t.Fatalf("new proxy: %v", err)
+	}
+	ctx := context.Background()
+	records, err := proxy.Poll(ctx)
+	if err != nil {
+		t.Fatalf("poll: %v", err)
+	}
+	if len(records) != 1 || string(records[0].Value) != `{"tenantId":"acme"}` || records[0].Partition != 2 {
+		t.Fatalf("unexpected records: %+v", records)
+	}
+	if err := proxy.Commit(ctx, records); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+	if err := proxy.Produce(ctx, "incidents.dlq", []Record{{Value: []byte("bad")}}); err != nil {
+		t.Fatalf("produce: %v", err)
+	}
+	if err := proxy.Reset(ctx); err != nil {
+		t.Fatalf("reset: %v", err)
+	}
+
+	if topics := subscribed["topics"].([]interface{}); len(topics) != 1 || topics[0] != "incidents" {
+		t.Fatalf("unexpected subscription: %+v", subscribed)
+	}
+	offset := committed["offsets"].([]interface{})[0].(map[string]interface{})
+	if offset["partition"] != float64(2) || offset["offset"] != float64(7) {
+		t.Fatalf("unexpected commit: %+v", committed)
+	}
+	record := produced["records"].([]interface{})[0].(map[string]interface{})
+	if record["value"] != base64.StdEncoding.EncodeToString([]byte("bad")) {
+		t.Fatalf("unexpected produced record: %+v", produced)
+	}
+}
@@ -0,0 +1,259 @@
+package kafka
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	contentTypeV2 = "application/vnd.kafka.v2+json"
+	binaryTypeV2  = "application/vnd.kafka.binary.v2+json"
+)
+
+// errConsumerGone signals that the proxy dropped the consumer instance, e.g. after an idle timeout.
+var errConsumerGone = errors.New("consumer instance not found")
+
+// RESTProxyConfig configures access to a Kafka REST Proxy (v2 API). Username and Password enable basic auth.
+type RESTProxyConfig struct {
+	URL      string
+	Group    string
+	Topic    string
+	Username string
+	Password string
+	// PollTimeout is how long the proxy waits for records before returning an empty batch.
+	PollTimeout time.Duration
+	// MaxBytes bounds the size of one poll response; zero uses the proxy default.
+	MaxBytes int
+}
+
+// RESTProxy consumes and produces through a Kafka REST Proxy. Records use the binary embedded format so that
+// malformed payloads survive the round trip to the dead-letter topic unchanged. Offsets are committed
+// manually, never automatically.
+type RESTProxy struct {
+	cfg        RESTProxyConfig
+	httpClient *http.Client
+
+	mu       sync.Mutex
+	instance string
+}
+
+// NewRESTProxy validates cfg and builds a proxy client. The consumer instance is created on first poll.
+func NewRESTProxy(cfg RESTProxyConfig) (*RESTProxy, error) {
+	if cfg.URL == "" || cfg.Group == "" || cfg.Topic == "" {
+		return nil, fmt.Errorf("kafka rest proxy url, group, and topic are required")
+	}
+	cfg.URL = strings.TrimRight(cfg.URL, "/")
+	if cfg.PollTimeout <= 0 {
+		cfg.PollTimeout = 5 * time.Second
+	}
+	return &RESTProxy{cfg: cfg, httpClient: &http.Client{Timeout: cfg.PollTimeout + 30*time.Second}}, nil
+}
+
+// Poll implements Client.
+func (p *RESTProxy) Poll(ctx context.Context) ([]Record, error) {
+	base, err := p.consumer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := url.Values{}
+	query.Set("timeout", fmt.Sprint(p.cfg.PollTimeout.Milliseconds()))
+	if p.cfg.MaxBytes > 0 {
+		query.Set("max_bytes", fmt.Sprint(p.cfg.MaxBytes))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, base+"/records?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", binaryTypeV2)
+	var raw []struct {
+		Topic     string  `json:"topic"`
+		Key       *string `json:"key"`
+		Value     *string `json:"value"`
+		Partition int32   `json:"partition"`
+		Offset    int64   `json:"offset"`
+	}
+	if err := p.do(req, &raw); err != nil {
+		if errors.Is(err, errConsumerGone) {
+			p.forget()
+		}
+		return nil, err
+	}
+	records := make([]Record, 0, len(raw))
+	for _, r := range raw {
+		record := Record{Topic: r.Topic, Partition: r.Partition, Offset: r.Offset}
+		if record.Key, err = decodeBinary(r.Key); err != nil {
+			return nil, fmt.Errorf("decode key at %s/%d/%d: %w", r.Topic, r.Partition, r.Offset, err)
+		}
+		if record.Value, err = decodeBinary(r.Value); err != nil {
+			return nil, fmt.Errorf("decode value at %s/%d/%d: %w", r.Topic, r.Partition, r.Offset, err)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// Commit implements Client. The proxy commits the position after each listed offset.
+func (p *RESTProxy) Commit(ctx context.Context, records []Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+	base, err := p.consumer(ctx)
+	if err != nil {
+		return err
+	}
+	type offset struct {
+		Topic     string `json:"topic"`
+		Partition int32  `json:"partition"`
+		Offset    int64  `json:"offset"`
+	}
+	latest := map[string]offset{}
+	for _, r := range records {
+		key := fmt.Sprintf("%s/%d", r.Topic, r.Partition)
+		if current, ok := latest[key]; !ok || r.Offset > current.Offset {
+			latest[key] = offset{Topic: r.Topic, Partition: r.Partition, Offset: r.Offset}
+		}
+	}
+	offsets := make([]offset, 0, len(latest))
+	for _, o := range latest {
+		offsets = append(offsets, o)
+	}
+	return p.post(ctx, base+"/offsets", contentTypeV2, map[string]interface{}{"offsets": offsets}, nil)
+}
+
+// Produce implements Client.
+func (p *RESTProxy) Produce(ctx context.Context, topic string, records []Record) error {
+	type produced struct {
+		Key   *string `json:"key,omitempty"`
+		Value string  `json:"value"`
+	}
+	payload := make([]produced, 0, len(records))
+	for _, r := range records {
+		item := produced{Value: base64.StdEncoding.EncodeToString(r.Value)}
+		if r.Key != nil {
+			key := base64.StdEncoding.EncodeToString(r.Key)
+			item.Key = &key
+		}
+		payload = append(payload, item)
+	}
+	var resp struct {
+		Offsets []struct {
+			Error string `json:"error"`
+		} `json:"offsets"`
+	}
+	if err := p.post(ctx, p.cfg.URL+"/topics/"+url.PathEscape(topic), binaryTypeV2, map[string]interface{}{"records": payload}, &resp); err != nil {
+		return err
+	}
+	for _, o := range resp.Offsets {
+		if o.Error != "" {
+			return fmt.Errorf("produce to %s: %s", topic, o.Error)
+		}
+	}
+	return nil
+}
+
+// Reset implements Client by deleting the consumer instance; the next poll rejoins the group and resumes
+// from the last committed offsets.
+func (p *RESTProxy) Reset(ctx context.Context) error {
+	p.mu.Lock()
+	base := p.instance
+	p.instance = ""
+	p.mu.Unlock()
+	if base == "" {
+		return nil
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, base, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentTypeV2)
+	if err := p.do(req, nil); err != nil && !errors.Is(err, errConsumerGone) {
+		return err
+	}
+	return nil
+}
+
+// consumer returns the base URI of the consumer instance, creating and subscribing it when needed.
+func (p *RESTProxy) consumer(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.instance != "" {
+		return p.instance, nil
+	}
+	var created struct {
+		BaseURI string `json:"base_uri"`
+	}
+	body := map[string]string{"format": "binary", "auto.offset.reset": "earliest", "auto.commit.enable": "false"}
+	if err := p.post(ctx, p.cfg.URL+"/consumers/"+url.PathEscape(p.cfg.Group), contentTypeV2, body, &created); err != nil {
+		return "", fmt.Errorf("create consumer: %w", err)
+	}
+	if created.BaseURI == "" {
+		return "", fmt.Errorf("create consumer: proxy returned no base_uri")
+	}
+	if err := p.post(ctx, created.BaseURI+"/subscription", contentTypeV2, map[string][]string{"topics": {p.cfg.Topic}}, nil); err != nil {
+		return "", fmt.Errorf("subscribe to %s: %w", p.cfg.Topic, err)
+	}
+	p.instance = created.BaseURI
+	return p.instance, nil
+}
+
+func (p *RESTProxy) forget() {
+	p.mu.Lock()
+	p.instance = ""
+	p.mu.Unlock()
+}
+
+func (p *RESTProxy) post(ctx context.Context, target, contentType string, payload, out interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Accept", contentTypeV2)
+	return p.do(req, out)
+}
+
+func (p *RESTProxy) do(req *http.Request, out interface{}) error {
+	if p.cfg.Username != "" {
+		req.SetBasicAuth(p.cfg.Username, p.cfg.Password)
+	}
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound && strings.Contains(req.URL.Path, "/instances/") {
+		return errConsumerGone
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("%s %s: %s: %s", req.Method, req.URL.Path, resp.Status, strings.TrimSpace(string(data)))
+	}
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode %s response: %w", req.URL.Path, err)
+	}
+	return nil
+}
+
+func decodeBinary(value *string) ([]byte, error) {
+	if value == nil {
+		return nil, nil
+	}
+	return base64.StdEncoding.DecodeString(*value)
+}
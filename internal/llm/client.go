@@ -0,0 +1,182 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/metrics"
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// systemPrompt instructs the model; %d is the number of recommendations it may add.
+const systemPrompt = `You help site reliability engineers understand incidents. You receive the structured result of an automated root-cause analysis as JSON. Using only that data, write a concise narrative summary of at most four sentences for the responders, and suggest at most %d concrete remediation steps that are not already among its recommendations. Do not invent services, metrics, or events. Reply with a JSON object only: {"summary": "...", "recommendations": ["..."]}.`
+
+// Config points the client at an OpenAI-compatible chat completions API.
+type Config struct {
+	// Endpoint is the API base URL, such as https://api.openai.com/v1; requests go to Endpoint/chat/completions.
+	Endpoint string
+	APIKey   string
+	// Headers are added to every request, for gateways that authenticate differently.
+	Headers     map[string]string
+	Model       string
+	Timeout     time.Duration
+	MaxTokens   int
+	Temperature float64
+	// MaxRecommendations caps the recommendations the model may add; zero asks for none.
+	MaxRecommendations int
+	Redaction          RedactionConfig
+}
+
+// Client narrates correlations with a language model behind an OpenAI-compatible chat completions API. Only
+// an allowlisted view of the correlation is sent, scrubbed of values that look like credentials or personal
+// data, and never the tenant ID.
+type Client struct {
+	cfg        Config
+	redactor   *redactor
+	httpClient *http.Client
+}
+
+// NewClient validates cfg and builds a client. Timeout defaults to 10s and bounds each call as a whole.
+func NewClient(cfg Config) (*Client, error) {
+	if cfg.Endpoint == "" || cfg.Model == "" {
+		return nil, fmt.Errorf("llm endpoint and model are required")
+	}
+	cfg.Endpoint = strings.TrimRight(cfg.Endpoint, "/")
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+	if cfg.MaxRecommendations < 0 {
+		cfg.MaxRecommendations = 0
+	}
+	redactor, err := newRedactor(cfg.Redaction)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{cfg: cfg, redactor: redactor, httpClient: &http.Client{Timeout: cfg.Timeout}}, nil
+}
+
+// Narrate asks the model for a summary of result in locale (the model's default language when empty) and
+// for recommendations to add.
+func (c *Client) Narrate(ctx context.Context, locale string, result models.CorrelationResult) (models.Narrative, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	narrative, err := c.narrate(ctx, locale, result)
+	outcome := metrics.OutcomeSuccess
+	if err != nil {
+		outcome = metrics.OutcomeError
+		if errors.Is(err, context.DeadlineExceeded) || isTimeout(err) {
+			outcome = metrics.OutcomeTimeout
+		}
+	}
+	metrics.ObserveLLMRequest(time.Since(start), outcome)
+	return narrative, err
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+func (c *Client) narrate(ctx context.Context, locale string, result models.CorrelationResult) (models.Narrative, error) {
+	input, err := json.Marshal(c.redactor.correlation(result))
+	if err != nil {
+		return models.Narrative{}, fmt.Errorf("encode correlation: %w", err)
+	}
+	system := fmt.Sprintf(systemPrompt, c.cfg.MaxRecommendations)
+	if locale != "" {
+		system += fmt.Sprintf(" Write the summary and recommendations in the language of locale %q.", locale)
+	}
+	payload := struct {
+		Model       string        `json:"model"`
+		Messages    []chatMessage `json:"messages"`
+		Temperature float64       `json:"temperature"`
+		MaxTokens   int           `json:"max_tokens,omitempty"`
+	}{
+		Model:       c.cfg.Model,
+		Messages:    []chatMessage{{Role: "system", Content: system}, {Role: "user", Content: string(input)}},
+		Temperature: c.cfg.Temperature,
+		MaxTokens:   c.cfg.MaxTokens,
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return models.Narrative{}, fmt.Errorf("encode chat request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.cfg.Endpoint+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return models.Narrative{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if c.cfg.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.cfg.APIKey)
+	}
+	for name, value := range c.cfg.Headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return models.Narrative{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 2048))
+		return models.Narrative{}, fmt.Errorf("chat completions returned %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	var completion struct {
+		Choices []struct {
+			Message chatMessage `json:"message"`
+		} `json:"choices"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&completion); err != nil {
+		return models.Narrative{}, fmt.Errorf("decode chat response: %w", err)
+	}
+	if len(completion.Choices) == 0 {
+		return models.Narrative{}, fmt.Errorf("chat response has no choices")
+	}
+	return c.parseNarrative(completion.Choices[0].Message.Content)
+}
+
+// parseNarrative reads the model's JSON reply, tolerating a Markdown code fence around it.
+func (c *Client) parseNarrative(content string) (models.Narrative, error) {
+	content = strings.TrimSpace(content)
+	if strings.HasPrefix(content, "```") {
+		content = strings.TrimPrefix(content, "```json")
+		content = strings.TrimPrefix(content, "```")
+		content = strings.TrimSuffix(strings.TrimSpace(content), "```")
+	}
+	var reply struct {
+		Summary         string   `json:"summary"`
+		Recommendations []string `json:"recommendations"`
+	}
+	if err := json.Unmarshal([]byte(content), &reply); err != nil {
+		return models.Narrative{}, fmt.Errorf("decode model reply: %w", err)
+	}
+	narrative := models.Narrative{Summary: strings.TrimSpace(reply.Summary)}
+	if narrative.Summary == "" {
+		return models.Narrative{}, fmt.Errorf("model reply has no summary")
+	}
+	for _, rec := range reply.Recommendations {
+		if len(narrative.Recommendations) == c.cfg.MaxRecommendations {
+			break
+		}
+		if rec = strings.TrimSpace(rec); rec != "" {
+			narrative.Recommendations = append(narrative.Recommendations, rec)
+		}
+	}
+	return narrative, nil
+}
+
+func isTimeout(err error) bool {
+	var timeout interface{ Timeout() bool }
+	return errors.As(err, &timeout) && timeout.Timeout()
+}
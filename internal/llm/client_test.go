@@ -0,0 +1,132 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+func sampleResult() models.CorrelationResult {
+	return models.CorrelationResult{
+		CorrelationID:    "corr-1",
+		RootCause:        "payments: db connection pool exhausted on 10.1.2.3",
+		Confidence:       0.91,
+		AffectedServices: []string{"payments"},
+		Recommendations:  []models.Recommendation{{Text: "Raise the pool size"}},
+		Labels:           map[string]string{"team": "payments", "owner": "oncall@example.com"},
+		Incident:         models.IncidentMetadata{Title: "Checkout errors", Description: "reported by jane@example.com"},
+		Timeline: []models.TimelineEvent{
+			{Time: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC), Event: "db_pool_in_use spiked, token=abc123", Service: "payments", Severity: models.SeverityHigh},
+		},
+	}
+}
+
+func chatReply(content string) map[string]interface{} {
+	return map[string]interface{}{
+		"choices": []map[string]interface{}{{"message": map[string]string{"role": "assistant", "content": content}}},
+	}
+}
+
+func TestClientNarratesRedactedCorrelation(t *testing.T) {
+	var prompt string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/chat/completions" || r.Header.Get("Authorization") != "Bearer key" || r.Header.Get("X-Team") != "sre" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var body struct {
+			Model    string        `json:"model"`
+			Messages []chatMessage `json:"messages"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body.Model != "gpt-test" || len(body.Messages) != 2 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		prompt = body.Messages[0].Content + body.Messages[1].Content
+		reply := "```json\n" + `{"summary": "The payments pool ran dry.", "recommendations": ["Add a pool alert", " ", "Cap retries", "Shard the db"]}` + "\n```"
+		_ = json.NewEncoder(w).Encode(chatReply(reply))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(Config{
+		Endpoint:           server.URL + "/v1/",
+		APIKey:             "key",
+		Headers:            map[string]string{"X-Team": "sre"},
+		Model:              "gpt-test",
+		MaxRecommendations: 2,
+		Redaction:          RedactionConfig{Labels: []string{"team", "owner"}, Patterns: []string{`db_pool_\w+`}},
+	})
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	narrative, err := client.Narrate(context.Background(), "de", sampleResult())
+	if err != nil {
+		t.Fatalf("narrate: %v", err)
+	}
+	if narrative.Summary != "The payments pool ran dry." {
+		t.Fatalf("unexpected summary %q", narrative.Summary)
+	}
+	if len(narrative.Recommendations) != 2 || narrative.Recommendations[1] != "Cap retries" {
+		t.Fatalf("expected two recommendations, got %v", narrative.Recommendations)
+	}
+	for _, leaked := range []string{"jane@example.com", "oncall@example.com", "10.1.2.3", "abc123", "db_pool_in_use", "corr-1"} {
+		if strings.Contains(prompt, leaked) {
+			t.Fatalf("prompt leaked %q: %s", leaked, prompt)
+		}
+	}
+	for _, want := range []string{"Checkout errors", `"team":"payments"`, `locale "de"`, "at most 2"} {
+		if !strings.Contains(prompt, want) {
+			t.Fatalf("prompt is missing %q: %s", want, prompt)
+		}
+	}
+}
+
+func TestClientFailures(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Header.Get("X-Case") {
+		case "status":
+			http.Error(w, "overloaded", http.StatusTooManyRequests)
+		case "empty":
+			_ = json.NewEncoder(w).Encode(chatReply(`{"summary": ""}`))
+		case "slow":
+			<-release
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	for name, check := range map[string]func(error) bool{
+		"status": func(err error) bool { return err != nil && strings.Contains(err.Error(), "overloaded") },
+		"empty":  func(err error) bool { return err != nil && strings.Contains(err.Error(), "no summary") },
+		"slow":   func(err error) bool { return errors.Is(err, context.DeadlineExceeded) || isTimeout(err) },
+	} {
+		client, err := NewClient(Config{
+			Endpoint: server.URL,
+			Model:    "gpt-test",
+			Headers:  map[string]string{"X-Case": name},
+			Timeout:  50 * time.Millisecond,
+		})
+		if err != nil {
+			t.Fatalf("new client: %v", err)
+		}
+		if _, err := client.Narrate(context.Background(), "", sampleResult()); !check(err) {
+			t.Fatalf("%s: unexpected error %v", name, err)
+		}
+	}
+
+	if _, err := NewClient(Config{Endpoint: server.URL}); err == nil {
+		t.Fatalf("expected an error without a model")
+	}
+	if _, err := NewClient(Config{Endpoint: server.URL, Model: "m", Redaction: RedactionConfig{Patterns: []string{"("}}}); err == nil {
+		t.Fatalf("expected an error for an invalid redaction pattern")
+	}
+}
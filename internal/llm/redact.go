@@ -0,0 +1,154 @@
+package llm
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// redacted replaces every match of a redaction pattern.
+const redacted = "[REDACTED]"
+
+// promptTimelineLimit caps the timeline events sent to the model, earliest first.
+const promptTimelineLimit = 20
+
+// defaultRedactions match values that commonly carry credentials or personal data: e-mail addresses, bearer
+// tokens, JWTs, secret-looking key=value pairs, IPv4 addresses, and long hex strings such as API keys.
+var defaultRedactions = []string{
+	`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`,
+	`(?i)bearer\s+[A-Za-z0-9\-._~+/]+=*`,
+	`eyJ[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+`,
+	`(?i)(password|passwd|secret|token|api[_-]?key)\s*[:=]\s*[^\s,;"']+`,
+	`\b(?:\d{1,3}\.){3}\d{1,3}\b`,
+	`\b[0-9a-fA-F]{32,}\b`,
+}
+
+// RedactionConfig controls what leaves the process. Labels lists the correlation label keys that may be sent;
+// every other label is withheld. Patterns are regular expressions replaced in every string sent, in addition
+// to the built-in ones.
+type RedactionConfig struct {
+	Labels   []string
+	Patterns []string
+}
+
+// redactor builds the view of a correlation that is sent to the model. Fields that tend to carry sensitive or
+// free-form data (the incident description, alert fingerprints, ticket URL, annotations, log lines, and trace
+// IDs) are never included, and the remaining strings are scrubbed with the redaction patterns.
+type redactor struct {
+	labels   map[string]bool
+	patterns []*regexp.Regexp
+}
+
+// newRedactor compiles the built-in patterns and those of cfg.
+func newRedactor(cfg RedactionConfig) (*redactor, error) {
+	r := &redactor{labels: make(map[string]bool, len(cfg.Labels))}
+	for _, key := range cfg.Labels {
+		r.labels[key] = true
+	}
+	for _, pattern := range append(append([]string(nil), defaultRedactions...), cfg.Patterns...) {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("redaction pattern %q: %w", pattern, err)
+		}
+		r.patterns = append(r.patterns, re)
+	}
+	return r, nil
+}
+
+// redact replaces every match of the redaction patterns in s.
+func (r *redactor) redact(s string) string {
+	for _, re := range r.patterns {
+		s = re.ReplaceAllString(s, redacted)
+	}
+	return s
+}
+
+type promptCorrelation struct {
+	Title            string            `json:"incidentTitle,omitempty"`
+	RootCause        string            `json:"rootCause"`
+	Category         string            `json:"category,omitempty"`
+	Confidence       float64           `json:"confidence"`
+	AffectedServices []string          `json:"affectedServices"`
+	Anchors          []promptAnchor    `json:"anchors,omitempty"`
+	Timeline         []promptEvent     `json:"timeline,omitempty"`
+	Recommendations  []string          `json:"recommendations,omitempty"`
+	Labels           map[string]string `json:"labels,omitempty"`
+	Summary          string            `json:"templateSummary,omitempty"`
+}
+
+type promptAnchor struct {
+	Service  string  `json:"service"`
+	Signal   string  `json:"signal"`
+	Selector string  `json:"selector"`
+	Score    float64 `json:"anomalyScore"`
+}
+
+type promptEvent struct {
+	Time     time.Time `json:"time"`
+	Service  string    `json:"service"`
+	Severity string    `json:"severity"`
+	Event    string    `json:"event"`
+	// Duration and Ongoing describe sustained events; an instant leaves both unset.
+	Duration string `json:"duration,omitempty"`
+	Ongoing  bool   `json:"ongoing,omitempty"`
+}
+
+// correlation returns the allowlisted, redacted view of result sent to the model.
+func (r *redactor) correlation(result models.CorrelationResult) promptCorrelation {
+	view := promptCorrelation{
+		Title:      r.redact(result.Incident.Title),
+		RootCause:  r.redact(result.RootCause),
+		Category:   string(result.Category),
+		Confidence: result.Confidence,
+		Summary:    r.redact(result.Summary),
+	}
+	for _, service := range result.AffectedServices {
+		view.AffectedServices = append(view.AffectedServices, r.redact(service))
+	}
+	for _, anchor := range result.RedAnchors {
+		view.Anchors = append(view.Anchors, promptAnchor{
+			Service:  r.redact(anchor.Service),
+			Signal:   string(anchor.DataType),
+			Selector: r.redact(anchor.Selector),
+			Score:    anchor.AnomalyScore,
+		})
+	}
+	timeline := append([]models.TimelineEvent(nil), result.Timeline...)
+	sort.SliceStable(timeline, func(i, j int) bool { return timeline[i].Time.Before(timeline[j].Time) })
+	if len(timeline) > promptTimelineLimit {
+		timeline = timeline[:promptTimelineLimit]
+	}
+	for _, event := range timeline {
+		view.Timeline = append(view.Timeline, promptEvent{
+			Time:     event.Time.UTC(),
+			Service:  r.redact(event.Service),
+			Severity: string(event.Severity),
+			Event:    r.redact(event.Event),
+			Duration: durationText(event),
+			Ongoing:  event.Ongoing,
+		})
+	}
+	for _, rec := range result.Recommendations {
+		view.Recommendations = append(view.Recommendations, r.redact(rec.Text))
+	}
+	for key, value := range result.Labels {
+		if r.labels[key] {
+			if view.Labels == nil {
+				view.Labels = map[string]string{}
+			}
+			view.Labels[key] = r.redact(value)
+		}
+	}
+	return view
+}
+
+// durationText renders how long a sustained event lasted, or "" for an instant.
+func durationText(event models.TimelineEvent) string {
+	if !event.Sustained() {
+		return ""
+	}
+	return event.Duration().String()
+}
@@ -1,9 +1,12 @@
 package metrics
 
 import (
+	"context"
+	"fmt"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -11,6 +14,67 @@ const (
 	OutcomeSuccess = "success"
 	// OutcomeError labels failed investigations (pipeline or dependency issues).
 	OutcomeError = "error"
+	// OutcomeTimeout labels external calls that exceeded their deadline.
+	OutcomeTimeout = "timeout"
+
+	// WatchQuiet, WatchTriggered, WatchCooldown, and WatchError label watch-mode scan outcomes.
+	WatchQuiet     = "quiet"
+	WatchTriggered = "triggered"
+	WatchCooldown  = "cooldown"
+	WatchError     = "error"
+
+	// CacheHit, CacheMiss, and CacheStored label cache request outcomes; failures use OutcomeError.
+	CacheHit    = "hit"
+	CacheMiss   = "miss"
+	CacheStored = "stored"
+
+	// Stage* label the investigation pipeline stages timed by pipeline_stage_seconds.
+	StageGraphFetch      = "graph_fetch"
+	StageMetricsFetch    = "metrics_fetch"
+	StageLogsFetch       = "logs_fetch"
+	StageTracesFetch     = "traces_fetch"
+	StageBaselineFetch   = "baseline_fetch"
+	StageDetection       = "detection"
+	StageCausality       = "causality"
+	StageRecommendations = "recommendations"
+	StageClustering      = "clustering"
+	StagePersistence     = "persistence"
+	StageNarration       = "narration"
+	StageCapacity        = "capacity"
+
+	// AuditWritten and AuditDropped label audit record outcomes; sink failures use OutcomeError.
+	AuditWritten = "written"
+	AuditDropped = "dropped"
+
+	// SLOGood and SLOBad label events that met or missed a service-level objective.
+	SLOGood = "good"
+	SLOBad  = "bad"
+
+	// ShadowAgreed and ShadowDiverged label shadow detector runs by whether their root cause matched the
+	// primary detectors'.
+	ShadowAgreed   = "agreed"
+	ShadowDiverged = "diverged"
+
+	// Executor* label investigation admission outcomes: run (possibly after queueing), rejected because the
+	// shared or the tenant's queue was full, or abandoned by the caller while queued.
+	ExecutorAdmitted        = "admitted"
+	ExecutorQueueFull       = "queue_full"
+	ExecutorTenantQueueFull = "tenant_queue_full"
+	ExecutorCancelled       = "cancelled"
+
+	// IncidentClaimed and IncidentShared label investigations that claimed their incident and ran, or received
+	// the result of the caller holding the claim.
+	IncidentClaimed = "claimed"
+	IncidentShared  = "shared"
+
+	// ExpansionSufficient and ExpansionExhausted label widened signal windows by whether they reached the
+	// required samples before the maximum expansion.
+	ExpansionSufficient = "sufficient"
+	ExpansionExhausted  = "exhausted"
+
+	// OTLPReceived and OTLPEvicted label OTLP records taken into the buffer or evicted by its cap.
+	OTLPReceived = "received"
+	OTLPEvicted  = "evicted"
 )
 
 var (
@@ -18,18 +82,322 @@ var (
 		prometheus.CounterOpts{
 			Namespace: "mirador_rca",
 			Name:      "investigations_total",
-			Help:      "Total number of investigations handled, partitioned by outcome.",
+			Help:      "Total number of investigations handled, partitioned by outcome, tenant, and root-cause category.",
 		},
-		[]string{"outcome"},
+		[]string{"outcome", "tenant", "category"},
 	)
 
-	investigationDurationSeconds = prometheus.NewHistogram(
+	investigationDurationSeconds = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: "mirador_rca",
 			Name:      "investigation_seconds",
-			Help:      "Investigation latency in seconds.",
+			Help:      "Investigation latency in seconds, partitioned by tenant and root-cause category.",
 			Buckets:   []float64{0.25, 0.5, 1, 2, 3, 4, 5, 6, 8, 10},
 		},
+		[]string{"tenant", "category"},
+	)
+
+	externalScoringTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mirador_rca",
+			Name:      "external_scoring_requests_total",
+			Help:      "Calls to the external anomaly model server, partitioned by outcome.",
+		},
+		[]string{"outcome"},
+	)
+
+	externalScoringDurationSeconds = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "mirador_rca",
+			Name:      "external_scoring_seconds",
+			Help:      "External anomaly model server latency in seconds.",
+			Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2, 5},
+		},
+	)
+
+	llmRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mirador_rca",
+			Name:      "llm_requests_total",
+			Help:      "Calls to the language model that narrates correlations, partitioned by outcome.",
+		},
+		[]string{"outcome"},
+	)
+
+	llmRequestDurationSeconds = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "mirador_rca",
+			Name:      "llm_request_seconds",
+			Help:      "Language model narration latency in seconds.",
+			Buckets:   []float64{0.25, 0.5, 1, 2, 5, 10, 20},
+		},
+	)
+
+	upstreamRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mirador_rca",
+			Name:      "upstream_requests_total",
+			Help:      "Outbound HTTP requests to mirador-core and Weaviate, partitioned by client, endpoint, and status class.",
+		},
+		[]string{"client", "endpoint", "code"},
+	)
+
+	upstreamRequestDurationSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "mirador_rca",
+			Name:      "upstream_request_seconds",
+			Help:      "Outbound HTTP request latency in seconds, partitioned by client and endpoint.",
+			Buckets:   []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2, 5},
+		},
+		[]string{"client", "endpoint"},
+	)
+
+	purgedObjectsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mirador_rca",
+			Name:      "purged_objects_total",
+			Help:      "History objects deleted by retention or tenant purges (or matched by dry runs), partitioned by class and mode.",
+		},
+		[]string{"class", "mode"},
+	)
+
+	rulesLoaded = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "mirador_rca",
+			Name:      "rules_loaded",
+			Help:      "Number of recommendation rules currently active.",
+		},
+	)
+
+	rulesLastReloadTimestamp = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "mirador_rca",
+			Name:      "rules_last_reload_timestamp_seconds",
+			Help:      "Unix time of the last successful rule load.",
+		},
+	)
+
+	rulesReloadsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mirador_rca",
+			Name:      "rules_reloads_total",
+			Help:      "Rule file loads, partitioned by outcome.",
+		},
+		[]string{"outcome"},
+	)
+
+	configLastReloadTimestamp = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "mirador_rca",
+			Name:      "config_last_reload_timestamp_seconds",
+			Help:      "Unix time of the last successful configuration reload.",
+		},
+	)
+
+	configReloadsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mirador_rca",
+			Name:      "config_reloads_total",
+			Help:      "Configuration reloads, partitioned by outcome.",
+		},
+		[]string{"outcome"},
+	)
+
+	remoteConfigFetchesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mirador_rca",
+			Name:      "remote_config_fetches_total",
+			Help:      "Reads of the remote configuration store, partitioned by outcome.",
+		},
+		[]string{"outcome"},
+	)
+
+	secretRefreshesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mirador_rca",
+			Name:      "secret_refreshes_total",
+			Help:      "Periodic re-resolutions of secret references, partitioned by outcome.",
+		},
+		[]string{"outcome"},
+	)
+
+	watchScansTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mirador_rca",
+			Name:      "watch_scans_total",
+			Help:      "Watch-mode anomaly scans, partitioned by tenant, service, and outcome.",
+		},
+		[]string{"tenant", "service", "outcome"},
+	)
+
+	cacheRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mirador_rca",
+			Name:      "cache_requests_total",
+			Help:      "Cache key lookups and writes, partitioned by key family, operation, and outcome.",
+		},
+		[]string{"family", "operation", "outcome"},
+	)
+
+	cacheRequestDurationSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "mirador_rca",
+			Name:      "cache_request_seconds",
+			Help:      "Cache round-trip latency in seconds, partitioned by key family and operation.",
+			Buckets:   []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25},
+		},
+		[]string{"family", "operation"},
+	)
+
+	pipelineStageDurationSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "mirador_rca",
+			Name:      "pipeline_stage_seconds",
+			Help:      "Investigation pipeline stage latency in seconds, partitioned by stage.",
+			Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2, 4, 8},
+		},
+		[]string{"stage"},
+	)
+
+	auditRecordsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mirador_rca",
+			Name:      "audit_records_total",
+			Help:      "Audit records handled, partitioned by outcome (written, error, dropped).",
+		},
+		[]string{"outcome"},
+	)
+
+	sloEventsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mirador_rca",
+			Name:      "slo_events_total",
+			Help:      "Events counted against a service-level objective, partitioned by slo and outcome (good, bad).",
+		},
+		[]string{"slo", "outcome"},
+	)
+
+	sloBurnRate = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mirador_rca",
+			Name:      "slo_burn_rate",
+			Help:      "Error budget burn rate of a service-level objective over a trailing window; 1 spends the budget exactly.",
+		},
+		[]string{"slo", "window"},
+	)
+
+	shadowComparisonsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mirador_rca",
+			Name:      "shadow_comparisons_total",
+			Help:      "Shadow detector runs compared with the primary detectors, partitioned by outcome (agreed, diverged).",
+		},
+		[]string{"outcome"},
+	)
+
+	shadowAnchorOverlap = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "mirador_rca",
+			Name:      "shadow_anchor_overlap",
+			Help:      "Jaccard similarity of the anchors found by the shadow and primary detectors; 1 means identical.",
+			Buckets:   []float64{0, 0.2, 0.4, 0.6, 0.8, 0.99, 1},
+		},
+	)
+
+	shadowConfidenceDelta = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "mirador_rca",
+			Name:      "shadow_confidence_delta",
+			Help:      "Shadow detector confidence minus primary detector confidence for the same investigation.",
+			Buckets:   []float64{-0.5, -0.25, -0.1, -0.05, 0, 0.05, 0.1, 0.25, 0.5},
+		},
+	)
+
+	shadowDroppedTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "mirador_rca",
+			Name:      "shadow_dropped_total",
+			Help:      "Sampled investigations not shadowed because the maximum number of shadow comparisons was already running.",
+		},
+	)
+
+	executorAdmissionsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mirador_rca",
+			Name:      "executor_admissions_total",
+			Help:      "Investigation admission decisions, partitioned by outcome (admitted, queue_full, tenant_queue_full, cancelled).",
+		},
+		[]string{"outcome"},
+	)
+
+	executorQueueWaitSeconds = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "mirador_rca",
+			Name:      "executor_queue_wait_seconds",
+			Help:      "Time admitted investigations waited for a free slot; zero when one was free on arrival.",
+			Buckets:   []float64{0, 0.05, 0.1, 0.25, 0.5, 1, 2, 4, 8, 16},
+		},
+	)
+
+	executorRunning = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "mirador_rca",
+			Name:      "executor_running",
+			Help:      "Investigations currently running in the executor.",
+		},
+	)
+
+	executorQueued = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "mirador_rca",
+			Name:      "executor_queued",
+			Help:      "Investigations currently waiting for an executor slot.",
+		},
+	)
+
+	incidentClaimsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mirador_rca",
+			Name:      "incident_claims_total",
+			Help:      "Investigations of claimed incidents, partitioned by outcome (claimed, shared).",
+		},
+		[]string{"outcome"},
+	)
+
+	windowExpansionsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mirador_rca",
+			Name:      "window_expansions_total",
+			Help:      "Investigations whose sparse signal window was widened, partitioned by outcome (sufficient, exhausted).",
+		},
+		[]string{"outcome"},
+	)
+
+	otlpRecordsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mirador_rca",
+			Name:      "otlp_records_total",
+			Help:      "OTLP records buffered, or evicted before their retention by the buffer cap, partitioned by signal and outcome (received, evicted).",
+		},
+		[]string{"signal", "outcome"},
+	)
+
+	buildInfo = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mirador_rca",
+			Name:      "build_info",
+			Help:      "Always 1; labelled with the version, commit, build date, and Go version of the running engine.",
+		},
+		[]string{"version", "commit", "build_date", "go_version"},
+	)
+
+	watchAnomalyDensity = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mirador_rca",
+			Name:      "watch_anomaly_density",
+			Help:      "Anomalies per minute found by the latest watch-mode scan of each service.",
+		},
+		[]string{"tenant", "service"},
 	)
 )
 
@@ -38,6 +406,40 @@ func Register(reg prometheus.Registerer) error {
 	collectors := []prometheus.Collector{
 		investigationsTotal,
 		investigationDurationSeconds,
+		externalScoringTotal,
+		externalScoringDurationSeconds,
+		llmRequestsTotal,
+		llmRequestDurationSeconds,
+		upstreamRequestsTotal,
+		upstreamRequestDurationSeconds,
+		purgedObjectsTotal,
+		watchScansTotal,
+		watchAnomalyDensity,
+		rulesLoaded,
+		rulesLastReloadTimestamp,
+		rulesReloadsTotal,
+		configLastReloadTimestamp,
+		configReloadsTotal,
+		secretRefreshesTotal,
+		remoteConfigFetchesTotal,
+		cacheRequestsTotal,
+		cacheRequestDurationSeconds,
+		pipelineStageDurationSeconds,
+		auditRecordsTotal,
+		buildInfo,
+		sloEventsTotal,
+		sloBurnRate,
+		shadowComparisonsTotal,
+		shadowAnchorOverlap,
+		shadowConfidenceDelta,
+		shadowDroppedTotal,
+		executorAdmissionsTotal,
+		executorQueueWaitSeconds,
+		executorRunning,
+		executorQueued,
+		incidentClaimsTotal,
+		windowExpansionsTotal,
+		otlpRecordsTotal,
 	}
 
 	for _, collector := range collectors {
@@ -51,15 +453,249 @@ func Register(reg prometheus.Registerer) error {
 	return nil
 }
 
-// ObserveInvestigation records an investigation duration and outcome label.
-func ObserveInvestigation(duration time.Duration, outcome string) {
+// ObserveInvestigation records an investigation duration and outcome. tenant passes through the tenant label
+// guard (see SetTenantLabels); category is the root-cause category, "unknown" when unclassified and "none"
+// for failed investigations. When ctx carries a sampled span, the latency observation gets a trace_id
+// exemplar so dashboards can link a latency spike to the trace of the investigation behind it.
+func ObserveInvestigation(ctx context.Context, tenant, category string, duration time.Duration, outcome string) {
 	label := outcome
 	if label != OutcomeError {
 		label = OutcomeSuccess
 	}
-	investigationsTotal.WithLabelValues(label).Inc()
+	switch {
+	case label == OutcomeError:
+		category = "none"
+	case category == "":
+		category = "unknown"
+	}
+	tenant = tenants.label(tenant)
+	investigationsTotal.WithLabelValues(label, tenant, category).Inc()
+	if duration < 0 {
+		duration = 0
+	}
+	observeWithTrace(ctx, investigationDurationSeconds.WithLabelValues(tenant, category), duration.Seconds())
+}
+
+// ObserveExternalScoring records an external model server call and its outcome (success, error, timeout).
+func ObserveExternalScoring(duration time.Duration, outcome string) {
+	switch outcome {
+	case OutcomeSuccess, OutcomeTimeout:
+	default:
+		outcome = OutcomeError
+	}
+	externalScoringTotal.WithLabelValues(outcome).Inc()
+	if duration < 0 {
+		duration = 0
+	}
+	externalScoringDurationSeconds.Observe(duration.Seconds())
+}
+
+// ObserveLLMRequest records a language model call and its outcome (success, error, timeout).
+func ObserveLLMRequest(duration time.Duration, outcome string) {
+	switch outcome {
+	case OutcomeSuccess, OutcomeTimeout:
+	default:
+		outcome = OutcomeError
+	}
+	llmRequestsTotal.WithLabelValues(outcome).Inc()
+	if duration < 0 {
+		duration = 0
+	}
+	llmRequestDurationSeconds.Observe(duration.Seconds())
+}
+
+// ObserveUpstreamRequest records an outbound HTTP call. statusCode 0 denotes a transport error.
+func ObserveUpstreamRequest(client, endpoint string, statusCode int, duration time.Duration) {
+	code := "error"
+	if statusCode > 0 {
+		code = fmt.Sprintf("%dxx", statusCode/100)
+	}
+	upstreamRequestsTotal.WithLabelValues(client, endpoint, code).Inc()
+	if duration < 0 {
+		duration = 0
+	}
+	upstreamRequestDurationSeconds.WithLabelValues(client, endpoint).Observe(duration.Seconds())
+}
+
+// ObservePurge records objects deleted from a history class; dry runs are counted under mode "dry_run".
+func ObservePurge(class string, count int, dryRun bool) {
+	if count <= 0 {
+		return
+	}
+	mode := "delete"
+	if dryRun {
+		mode = "dry_run"
+	}
+	purgedObjectsTotal.WithLabelValues(class, mode).Add(float64(count))
+}
+
+// ObserveWatchScan records a watch-mode scan outcome; the density gauge is left unchanged for failed scans.
+func ObserveWatchScan(tenant, service string, density float64, outcome string) {
+	watchScansTotal.WithLabelValues(tenant, service, outcome).Inc()
+	if outcome != WatchError {
+		watchAnomalyDensity.WithLabelValues(tenant, service).Set(density)
+	}
+}
+
+// ObserveRulesReload records a rule load; on error the active rule gauges are left unchanged.
+func ObserveRulesReload(count int, at time.Time, err error) {
+	if err != nil {
+		rulesReloadsTotal.WithLabelValues(OutcomeError).Inc()
+		return
+	}
+	rulesReloadsTotal.WithLabelValues(OutcomeSuccess).Inc()
+	rulesLoaded.Set(float64(count))
+	rulesLastReloadTimestamp.Set(float64(at.Unix()))
+}
+
+// ObserveConfigReload records a configuration reload; the timestamp only moves on success.
+func ObserveConfigReload(at time.Time, err error) {
+	if err != nil {
+		configReloadsTotal.WithLabelValues(OutcomeError).Inc()
+		return
+	}
+	configReloadsTotal.WithLabelValues(OutcomeSuccess).Inc()
+	configLastReloadTimestamp.Set(float64(at.Unix()))
+}
+
+// ObserveSecretRefresh records a periodic secret refresh.
+func ObserveSecretRefresh(err error) {
+	if err != nil {
+		secretRefreshesTotal.WithLabelValues(OutcomeError).Inc()
+		return
+	}
+	secretRefreshesTotal.WithLabelValues(OutcomeSuccess).Inc()
+}
+
+// ObserveRemoteConfigFetch records a read of the remote configuration store.
+func ObserveRemoteConfigFetch(err error) {
+	if err != nil {
+		remoteConfigFetchesTotal.WithLabelValues(OutcomeError).Inc()
+		return
+	}
+	remoteConfigFetchesTotal.WithLabelValues(OutcomeSuccess).Inc()
+}
+
+// ObserveCacheRequest counts keys of one family handled by a cache operation with the given outcome.
+func ObserveCacheRequest(family, operation, outcome string, keys int) {
+	if keys <= 0 {
+		return
+	}
+	cacheRequestsTotal.WithLabelValues(family, operation, outcome).Add(float64(keys))
+}
+
+// ObserveCacheLatency records the duration of one cache round trip.
+func ObserveCacheLatency(family, operation string, duration time.Duration) {
+	if duration < 0 {
+		duration = 0
+	}
+	cacheRequestDurationSeconds.WithLabelValues(family, operation).Observe(duration.Seconds())
+}
+
+// ObservePipelineStage records the duration of one investigation pipeline stage.
+func ObservePipelineStage(stage string, duration time.Duration) {
 	if duration < 0 {
 		duration = 0
 	}
-	investigationDurationSeconds.Observe(duration.Seconds())
+	pipelineStageDurationSeconds.WithLabelValues(stage).Observe(duration.Seconds())
+}
+
+// ObserveAuditRecords counts audit records written to the sink, failed to write, or dropped on a full queue.
+func ObserveAuditRecords(outcome string, records int) {
+	if records <= 0 {
+		return
+	}
+	auditRecordsTotal.WithLabelValues(outcome).Add(float64(records))
+}
+
+// SetBuildInfo publishes the running build on build_info.
+func SetBuildInfo(version, commit, buildDate, goVersion string) {
+	buildInfo.Reset()
+	buildInfo.WithLabelValues(version, commit, buildDate, goVersion).Set(1)
+}
+
+// observeWithTrace records value on observer with a trace_id exemplar when ctx carries a sampled span; spans
+// that were not sampled are never exported, so an exemplar for them would lead nowhere.
+func observeWithTrace(ctx context.Context, observer prometheus.Observer, value float64) {
+	if spanCtx := trace.SpanContextFromContext(ctx); spanCtx.IsSampled() {
+		if exemplars, ok := observer.(prometheus.ExemplarObserver); ok {
+			exemplars.ObserveWithExemplar(value, prometheus.Labels{"trace_id": spanCtx.TraceID().String()})
+			return
+		}
+	}
+	observer.Observe(value)
+}
+
+// ObserveSLOEvent counts one event against slo as good or bad.
+func ObserveSLOEvent(slo string, good bool) {
+	outcome := SLOBad
+	if good {
+		outcome = SLOGood
+	}
+	sloEventsTotal.WithLabelValues(slo, outcome).Inc()
+}
+
+// SetSLOBurnRate publishes the burn rate of slo over window.
+func SetSLOBurnRate(slo, window string, rate float64) {
+	sloBurnRate.WithLabelValues(slo, window).Set(rate)
+}
+
+// ObserveShadowComparison records how a shadow detector run compared with the primary detectors.
+func ObserveShadowComparison(anchorOverlap, confidenceDelta float64, diverged bool) {
+	outcome := ShadowAgreed
+	if diverged {
+		outcome = ShadowDiverged
+	}
+	shadowComparisonsTotal.WithLabelValues(outcome).Inc()
+	shadowAnchorOverlap.Observe(anchorOverlap)
+	shadowConfidenceDelta.Observe(confidenceDelta)
+}
+
+// IncShadowDropped counts a sampled investigation skipped because every shadow slot was busy.
+func IncShadowDropped() {
+	shadowDroppedTotal.Inc()
+}
+
+// ObserveExecutorAdmission records one admission decision; wait is observed for admitted investigations.
+func ObserveExecutorAdmission(outcome string, wait time.Duration) {
+	executorAdmissionsTotal.WithLabelValues(outcome).Inc()
+	if outcome == ExecutorAdmitted {
+		executorQueueWaitSeconds.Observe(wait.Seconds())
+	}
+}
+
+// SetExecutorLoad publishes the investigations running and queued in the executor.
+func SetExecutorLoad(running, queued int) {
+	executorRunning.Set(float64(running))
+	executorQueued.Set(float64(queued))
+}
+
+// ObserveIncidentClaim counts an investigation that either held its incident's claim or shared the holder's
+// result.
+func ObserveIncidentClaim(claimed bool) {
+	outcome := IncidentShared
+	if claimed {
+		outcome = IncidentClaimed
+	}
+	incidentClaimsTotal.WithLabelValues(outcome).Inc()
+}
+
+// ObserveWindowExpansion counts an investigation whose signal window was widened, by whether the wider
+// window held enough samples.
+func ObserveWindowExpansion(sufficient bool) {
+	outcome := ExpansionExhausted
+	if sufficient {
+		outcome = ExpansionSufficient
+	}
+	windowExpansionsTotal.WithLabelValues(outcome).Inc()
+}
+
+// ObserveOTLPRecords counts the records of signal received into the OTLP buffer and those evicted by its cap.
+func ObserveOTLPRecords(signal string, received, evicted int) {
+	if received > 0 {
+		otlpRecordsTotal.WithLabelValues(signal, OTLPReceived).Add(float64(received))
+	}
+	if evicted > 0 {
+		otlpRecordsTotal.WithLabelValues(signal, OTLPEvicted).Add(float64(evicted))
+	}
 }
@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestObserveWithTraceAttachesSampledTraceExemplars(t *testing.T) {
+	histogram := prometheus.NewHistogram(prometheus.HistogramOpts{Name: "test_seconds", Buckets: []float64{1, 5}})
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(histogram)
+
+	traceID := trace.TraceID{0x5b, 0x8e, 0xff, 0xf7, 0x98, 0x03, 0x81, 0x03, 0xd2, 0x69, 0xb6, 0x33, 0x81, 0x3f, 0xc6, 0x0c}
+	spanContext := func(flags trace.TraceFlags) context.Context {
+		return trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     trace.SpanID{0xee, 0xe1, 0x9b, 0x7e, 0xc3, 0xc1, 0xb1, 0x74},
+			TraceFlags: flags,
+		}))
+	}
+	observeWithTrace(spanContext(trace.FlagsSampled), histogram, 0.5)
+	observeWithTrace(spanContext(0), histogram, 3)
+	observeWithTrace(context.Background(), histogram, 3)
+
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("gather: %v", err)
+	}
+	if len(families) != 1 || len(families[0].GetMetric()) != 1 {
+		t.Fatalf("expected one histogram, got %v", families)
+	}
+	observed := families[0].GetMetric()[0].GetHistogram()
+	if observed.GetSampleCount() != 3 {
+		t.Fatalf("expected every observation counted, got %d", observed.GetSampleCount())
+	}
+
+	var exemplars []*dto.Exemplar
+	for _, bucket := range observed.GetBucket() {
+		if bucket.GetExemplar() != nil {
+			exemplars = append(exemplars, bucket.GetExemplar())
+		}
+	}
+	if len(exemplars) != 1 {
+		t.Fatalf("expected an exemplar only for the sampled span, got %v", exemplars)
+	}
+	exemplar := exemplars[0]
+	if exemplar.GetValue() != 0.5 || len(exemplar.GetLabel()) != 1 {
+		t.Fatalf("unexpected exemplar %v", exemplar)
+	}
+	if label := exemplar.GetLabel()[0]; label.GetName() != "trace_id" || label.GetValue() != "5b8efff798038103d269b633813fc60c" {
+		t.Fatalf("expected the sampled trace ID as trace_id, got %s=%s", label.GetName(), label.GetValue())
+	}
+}
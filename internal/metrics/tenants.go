@@ -0,0 +1,61 @@
+package metrics
+
+import "sync"
+
+// OtherTenant labels tenants outside the allowlist or beyond the label cap.
+const OtherTenant = "other"
+
+// DefaultMaxTenants caps distinct tenant label values when no allowlist is configured.
+const DefaultMaxTenants = 20
+
+// tenantLabels bounds the cardinality of tenant labels: only allowlisted tenants, or without an allowlist the
+// first max tenants observed, keep their own value.
+type tenantLabels struct {
+	mu    sync.Mutex
+	allow map[string]bool
+	seen  map[string]bool
+	max   int
+}
+
+var tenants = &tenantLabels{seen: map[string]bool{}, max: DefaultMaxTenants}
+
+// SetTenantLabels configures the tenant label guard. A non-empty allow lists the only tenants that keep their
+// own label value; otherwise the first max distinct tenants do, and max <= 0 uses DefaultMaxTenants. All
+// other tenants are labelled OtherTenant. Series already exported are kept.
+func SetTenantLabels(allow []string, max int) {
+	if max <= 0 {
+		max = DefaultMaxTenants
+	}
+	var allowed map[string]bool
+	if len(allow) > 0 {
+		allowed = make(map[string]bool, len(allow))
+		for _, tenant := range allow {
+			allowed[tenant] = true
+		}
+	}
+	tenants.mu.Lock()
+	defer tenants.mu.Unlock()
+	tenants.allow, tenants.max, tenants.seen = allowed, max, map[string]bool{}
+}
+
+func (t *tenantLabels) label(tenant string) string {
+	if tenant == "" {
+		return OtherTenant
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.allow != nil {
+		if t.allow[tenant] {
+			return tenant
+		}
+		return OtherTenant
+	}
+	if t.seen[tenant] {
+		return tenant
+	}
+	if len(t.seen) < t.max {
+		t.seen[tenant] = true
+		return tenant
+	}
+	return OtherTenant
+}
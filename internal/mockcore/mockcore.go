@@ -0,0 +1,187 @@
+// Package mockcore serves deterministic mirador-core RCA responses for local development: the docker compose
+// stack runs it as a container and `rca-engine --dev` embeds it.
+package mockcore
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Paths served by the handler; they match the mirador-core defaults in the engine configuration.
+const (
+	MetricsPath      = "/api/v1/rca/metrics"
+	LogsPath         = "/api/v1/rca/logs"
+	TracesPath       = "/api/v1/rca/traces"
+	ServiceGraphPath = "/api/v1/rca/service-graph"
+	SLOPath          = "/api/v1/rca/slos"
+	HealthPath       = "/healthz"
+)
+
+type seriesPoint struct {
+	Timestamp time.Time `json:"timestamp"`
+	Value     float64   `json:"value"`
+}
+
+type logEntry struct {
+	Timestamp time.Time `json:"timestamp"`
+	Message   string    `json:"message"`
+	Severity  string    `json:"severity"`
+	Count     int       `json:"count"`
+}
+
+type traceSpan struct {
+	TraceID    string    `json:"trace_id"`
+	SpanID     string    `json:"span_id"`
+	Service    string    `json:"service"`
+	Operation  string    `json:"operation"`
+	DurationMs float64   `json:"duration_ms"`
+	Status     string    `json:"status"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+type slo struct {
+	Name          string  `json:"name"`
+	Service       string  `json:"service"`
+	Indicator     string  `json:"indicator"`
+	Objective     float64 `json:"objective"`
+	Threshold     float64 `json:"threshold"`
+	PeriodSeconds float64 `json:"period_seconds"`
+}
+
+type serviceGraphEdge struct {
+	Source    string  `json:"source"`
+	Target    string  `json:"target"`
+	CallRate  float64 `json:"call_rate"`
+	ErrorRate float64 `json:"error_rate"`
+}
+
+// Handler returns the mock mirador-core API. Signals are timestamped relative to the request time, so any
+// recent investigation window finds them.
+func Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(HealthPath, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok"))
+	})
+
+	mux.HandleFunc(MetricsPath, func(w http.ResponseWriter, r *http.Request) {
+		if !enforcePost(w, r) {
+			return
+		}
+		series := []seriesPoint{
+			{Timestamp: time.Now().Add(-4 * time.Minute), Value: 1.0},
+			{Timestamp: time.Now().Add(-3 * time.Minute), Value: 5.5},
+			{Timestamp: time.Now().Add(-2 * time.Minute), Value: 9.2},
+		}
+		var req struct {
+			Metrics []string `json:"metrics"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if len(req.Metrics) == 0 {
+			writeJSON(w, map[string]any{"series": series})
+			return
+		}
+		// Resource utilisation series, named *_utilization, report fractions of the limit for capacity analysis.
+		utilization := []seriesPoint{
+			{Timestamp: time.Now().Add(-4 * time.Minute), Value: 0.62},
+			{Timestamp: time.Now().Add(-3 * time.Minute), Value: 0.81},
+			{Timestamp: time.Now().Add(-2 * time.Minute), Value: 0.97},
+		}
+		grouped := make([]map[string]any, 0, len(req.Metrics))
+		for _, name := range req.Metrics {
+			if strings.HasSuffix(name, "_utilization") {
+				grouped = append(grouped, map[string]any{"name": name, "series": utilization})
+				continue
+			}
+			grouped = append(grouped, map[string]any{"name": name, "series": series})
+		}
+		writeJSON(w, map[string]any{"metrics": grouped})
+	})
+
+	mux.HandleFunc(LogsPath, func(w http.ResponseWriter, r *http.Request) {
+		if !enforcePost(w, r) {
+			return
+		}
+		writeJSON(w, map[string]any{
+			"entries": []logEntry{
+				{Timestamp: time.Now().Add(-3 * time.Minute), Message: "checkout failed to reach payments", Severity: "error", Count: 42},
+				{Timestamp: time.Now().Add(-2 * time.Minute), Message: "retry exhausted", Severity: "warn", Count: 7},
+			},
+		})
+	})
+
+	mux.HandleFunc(TracesPath, func(w http.ResponseWriter, r *http.Request) {
+		if !enforcePost(w, r) {
+			return
+		}
+		writeJSON(w, map[string]any{
+			"spans": []traceSpan{
+				{
+					TraceID:    "trace-abc",
+					SpanID:     "span-1",
+					Service:    "checkout",
+					Operation:  "HTTP POST /payments",
+					DurationMs: 950,
+					Status:     "error",
+					Timestamp:  time.Now().Add(-90 * time.Second),
+				},
+				{
+					TraceID:    "trace-abc",
+					SpanID:     "span-2",
+					Service:    "payments",
+					Operation:  "DB update",
+					DurationMs: 740,
+					Status:     "ok",
+					Timestamp:  time.Now().Add(-80 * time.Second),
+				},
+			},
+		})
+	})
+
+	mux.HandleFunc(ServiceGraphPath, func(w http.ResponseWriter, r *http.Request) {
+		if !enforcePost(w, r) {
+			return
+		}
+		writeJSON(w, map[string]any{
+			"edges": []serviceGraphEdge{
+				{Source: "checkout", Target: "payments", CallRate: 320.0, ErrorRate: 0.07},
+				{Source: "checkout", Target: "inventory", CallRate: 110.0, ErrorRate: 0.02},
+			},
+		})
+	})
+
+	mux.HandleFunc(SLOPath, func(w http.ResponseWriter, r *http.Request) {
+		if !enforcePost(w, r) {
+			return
+		}
+		var req struct {
+			Service string `json:"service"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		// A latency SLO over the latency_p95 series: samples above 5 are bad, as two of the three mock ones are.
+		writeJSON(w, map[string]any{
+			"slos": []slo{
+				{Name: "latency", Service: req.Service, Indicator: "latency_p95", Objective: 0.99, Threshold: 5, PeriodSeconds: 30 * 24 * 3600},
+			},
+		})
+	})
+	return mux
+}
+
+func enforcePost(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, payload any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(payload); err != nil {
+		log.Printf("encode error: %v", err)
+	}
+}
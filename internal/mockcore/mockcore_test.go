@@ -0,0 +1,54 @@
+package mockcore
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/cache"
+	"github.com/miradorstack/mirador-rca/internal/repo"
+)
+
+func TestHandlerServesTheCoreClient(t *testing.T) {
+	server := httptest.NewServer(Handler())
+	defer server.Close()
+
+	client := repo.NewMiradorCoreClient(server.URL, MetricsPath, LogsPath, TracesPath, ServiceGraphPath, 5*time.Second, cache.NoopProvider{}, 0,
+		repo.WithHealthPath(HealthPath), repo.WithResourceMetrics(map[string]string{"cpu": "cpu_utilization"}), repo.WithSLOPath(SLOPath))
+	ctx := context.Background()
+	end := time.Now()
+	start := end.Add(-15 * time.Minute)
+
+	if err := client.Ping(ctx); err != nil {
+		t.Fatalf("ping: %v", err)
+	}
+	if points, err := client.FetchMetricSeries(ctx, "tenant-a", "checkout", start, end); err != nil || len(points) == 0 {
+		t.Fatalf("expected metric points, got %d, %v", len(points), err)
+	}
+	if entries, err := client.FetchLogEntries(ctx, "tenant-a", "checkout", start, end); err != nil || len(entries) == 0 {
+		t.Fatalf("expected log entries, got %d, %v", len(entries), err)
+	}
+	if spans, err := client.FetchTraceSpans(ctx, "tenant-a", "checkout", start, end); err != nil || len(spans) == 0 {
+		t.Fatalf("expected trace spans, got %d, %v", len(spans), err)
+	}
+	usage, err := client.FetchResourceUsage(ctx, "tenant-a", "checkout", start, end)
+	if err != nil || len(usage) == 0 {
+		t.Fatalf("expected resource usage, got %d, %v", len(usage), err)
+	}
+	for _, point := range usage {
+		if point.Name != "cpu" || point.Value > 1 {
+			t.Fatalf("expected cpu utilisation fractions, got %+v", point)
+		}
+	}
+	slos, err := client.FetchSLOs(ctx, "tenant-a", "checkout")
+	if err != nil || len(slos) == 0 {
+		t.Fatalf("expected SLOs, got %d, %v", len(slos), err)
+	}
+	if slos[0].Service != "checkout" || slos[0].Period != 30*24*time.Hour {
+		t.Fatalf("unexpected SLO %+v", slos[0])
+	}
+	if edges, err := client.FetchServiceGraph(ctx, "tenant-a", start, end); err != nil || len(edges) == 0 {
+		t.Fatalf("expected service graph edges, got %d, %v", len(edges), err)
+	}
+}
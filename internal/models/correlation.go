@@ -1,6 +1,9 @@
 package models
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // CorrelationResult summarises investigation output.
 type CorrelationResult struct {
@@ -11,8 +14,220 @@ type CorrelationResult struct {
 	AffectedServices []string
 	RedAnchors       []RedAnchor
 	Timeline         []TimelineEvent
-	Recommendations  []string
-	CreatedAt        time.Time
+	Recommendations  []Recommendation
+	BlastRadius      []ServiceImpact
+	Category         RootCauseCategory
+	// UnavailableSources lists signal types that could not be fetched; the result is partial when non-empty.
+	UnavailableSources []DataType
+	CreatedAt          time.Time
+	// DuplicateOf names the primary correlation when this result repeats a recent incident.
+	DuplicateOf string
+	// RelatedCorrelations lists recent correlations that overlap this one without being duplicates.
+	RelatedCorrelations []string
+	// Status tracks triage of the correlation; an empty status is treated as open.
+	Status      CorrelationStatus
+	Annotations []Annotation
+	// Labels are key/value tags such as team or environment, copied from the request and editable later.
+	Labels map[string]string
+	// Incident is the metadata supplied with the investigation request.
+	Incident IncidentMetadata
+	// Explanation records how the engine reached this result; nil for correlations stored before it existed.
+	Explanation *Explanation
+	// Summary is a narrative paragraph describing the correlation for humans; empty when summaries are off.
+	Summary string
+	// WindowExpansion records how the signal window was widened because the requested one was too sparse;
+	// nil when the requested window was analysed.
+	WindowExpansion *WindowExpansion
+	// Environment is the deployment environment the signals were fetched from; empty when unscoped.
+	Environment string
+	// Capacity reports the resource utilisation of the suspected root service; nil when capacity analysis is
+	// off or no resource series were available.
+	Capacity *CapacityAnalysis
+	// ImpactScore ranks the correlation by customer impact in [0, 1]: the fastest error budget burn among
+	// SLOImpacts relative to the critical burn rate. Zero when the investigated service has no SLOs.
+	ImpactScore float64
+	// SLOImpacts reports the error budget each SLO of the investigated service burned during the window.
+	SLOImpacts []SLOImpact
+}
+
+// SLOImpact is the error budget burn of one service-level objective over an investigation window.
+type SLOImpact struct {
+	Service   string
+	SLO       string
+	Objective float64
+	// BadFraction is the share of bad events, or of bad samples for threshold SLOs, in the window.
+	BadFraction float64
+	// BurnRate is BadFraction divided by the error budget (1 - Objective): at 1 the budget lasts exactly its
+	// period.
+	BurnRate float64
+	// BudgetSpent is the fraction of the period's error budget the window consumed.
+	BudgetSpent float64
+}
+
+// CapacityAnalysis describes how close the suspected root service ran to its resource limits.
+type CapacityAnalysis struct {
+	Service string
+	// SaturationDriven is set when a resource saturated no later than the service's first anomaly.
+	SaturationDriven bool
+	// Saturation is the utilisation, as a fraction of the limit, at which a resource counts as saturated.
+	Saturation float64
+	Resources  []ResourceUsage
+}
+
+// ResourceUsage summarises one resource's utilisation over the window, as fractions of its limit.
+type ResourceUsage struct {
+	// Resource names the resource, such as cpu, memory, connection_pool, or queue_depth.
+	Resource string
+	Peak     float64
+	Latest   float64
+	// Headroom is the share of the limit left unused at the peak.
+	Headroom float64
+	// SaturatedAt is when utilisation first reached the saturation level; zero when it never did.
+	SaturatedAt time.Time
+	// ExtraCapacity is how much the limit would have to grow, as a fraction of it, to keep the peak at the
+	// saturation level; zero when the resource stayed below it.
+	ExtraCapacity float64
+	// TimeToExhaustion projects how long after the latest sample the limit is reached if utilisation keeps
+	// growing at its trend over the window; zero when it is not rising or the limit is already reached.
+	TimeToExhaustion time.Duration
+}
+
+// Saturated reports whether the resource reached the saturation level.
+func (r ResourceUsage) Saturated() bool {
+	return !r.SaturatedAt.IsZero()
+}
+
+// WindowExpansion describes a signal window widened beyond the requested one.
+type WindowExpansion struct {
+	// Requested is the window first fetched: the request's, padded by its preset.
+	Requested TimeRange
+	// Analysed is the window the signals were finally fetched for.
+	Analysed TimeRange
+	// SparseSources lists the sources that held too few samples in the requested window.
+	SparseSources []DataType
+	// Samples is the fewest samples any sparse source held in the analysed window; below MinSamples when
+	// the maximum expansion was reached first.
+	Samples    int
+	MinSamples int
+}
+
+// CorrelationStatus is the lifecycle state of a correlation.
+type CorrelationStatus string
+
+const (
+	CorrelationOpen      CorrelationStatus = "open"
+	CorrelationConfirmed CorrelationStatus = "confirmed"
+	CorrelationRejected  CorrelationStatus = "rejected"
+	CorrelationResolved  CorrelationStatus = "resolved"
+)
+
+// Valid reports whether s is one of the known lifecycle states.
+func (s CorrelationStatus) Valid() bool {
+	switch s {
+	case CorrelationOpen, CorrelationConfirmed, CorrelationRejected, CorrelationResolved:
+		return true
+	}
+	return false
+}
+
+// Annotation is a free-form note attached to a correlation by a responder.
+type Annotation struct {
+	Author    string
+	Text      string
+	CreatedAt time.Time
+}
+
+// RootCauseCategory buckets a correlation by the kind of failure behind it.
+type RootCauseCategory string
+
+const (
+	CategoryUnknown    RootCauseCategory = ""
+	CategoryDeployment RootCauseCategory = "deployment"
+	CategoryCapacity   RootCauseCategory = "capacity"
+	CategoryDependency RootCauseCategory = "dependency_failure"
+	CategoryConfig     RootCauseCategory = "config"
+	CategoryNetwork    RootCauseCategory = "network"
+)
+
+// Recommendation is a suggested remediation step, optionally produced by a rule and carrying follow-up actions.
+type Recommendation struct {
+	Text string
+	// RuleID names the rule that produced the recommendation; empty for history-derived or default advice.
+	RuleID  string
+	Actions []RecommendationAction
+	// Generated marks a recommendation suggested by a language model rather than by rules, history, or defaults.
+	Generated bool
+}
+
+// Narrative is a generated account of a correlation: a summary paragraph and further recommendations.
+type Narrative struct {
+	Summary         string
+	Recommendations []string
+}
+
+// UnmarshalJSON also accepts a bare string, the encoding used before recommendations carried actions.
+func (r *Recommendation) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		*r = Recommendation{Text: text}
+		return nil
+	}
+	type plain Recommendation
+	return json.Unmarshal(data, (*plain)(r))
+}
+
+// ActionType classifies a recommendation action.
+type ActionType string
+
+const (
+	ActionRunbook   ActionType = "runbook"
+	ActionDashboard ActionType = "dashboard"
+	ActionWebhook   ActionType = "webhook"
+)
+
+// Valid reports whether t is one of the known action types.
+func (t ActionType) Valid() bool {
+	switch t {
+	case ActionRunbook, ActionDashboard, ActionWebhook:
+		return true
+	}
+	return false
+}
+
+// RecommendationAction links a recommendation to a runbook, a dashboard, or an automation webhook that
+// responders can trigger.
+type RecommendationAction struct {
+	Type  ActionType
+	Label string
+	URL   string
+}
+
+// RecommendationTexts returns the plain text of each recommendation.
+func RecommendationTexts(recs []Recommendation) []string {
+	out := make([]string, 0, len(recs))
+	for _, rec := range recs {
+		out = append(out, rec.Text)
+	}
+	return out
+}
+
+// CopyLabels returns a copy of labels, or nil when there are none.
+func CopyLabels(labels map[string]string) map[string]string {
+	if len(labels) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(labels))
+	for k, v := range labels {
+		out[k] = v
+	}
+	return out
+}
+
+// ServiceImpact estimates how strongly a service is affected by the suspected root cause.
+type ServiceImpact struct {
+	Service string
+	Score   float64
+	Depth   int
 }
 
 // RedAnchor highlights a strong anomaly linked to the root cause.
@@ -23,9 +238,44 @@ type RedAnchor struct {
 	Timestamp    time.Time
 	AnomalyScore float64
 	Threshold    float64
+	Evidence     Evidence
+	// Link is an optional dashboard deep link for the anchor's service, selector, and time range.
+	Link string
+	// Environment is the deployment environment the anchor's signal came from.
+	Environment string
+	// Direction is set when the detector knows whether the signal spiked or dropped.
+	Direction Direction
 }
 
-// TimelineEvent records a notable progression during the incident window.
+// Evidence carries raw signal excerpts backing an anchor so responders can verify it without re-querying.
+type Evidence struct {
+	LogLines     []string
+	MetricValues []MetricSample
+	TraceIDs     []string
+	// Exemplars are the slowest or failing spans behind a trace anchor, errors first.
+	Exemplars []TraceExemplar
+}
+
+// TraceExemplar identifies one span behind a trace anchor so responders can open it in their tracing UI.
+type TraceExemplar struct {
+	TraceID   string
+	SpanID    string
+	Operation string
+	Status    string
+	Duration  time.Duration
+	Timestamp time.Time
+	// Link opens the trace in the tracing UI; empty without a trace link template.
+	Link string
+}
+
+// MetricSample is a single metric value captured as evidence.
+type MetricSample struct {
+	Timestamp time.Time
+	Value     float64
+}
+
+// TimelineEvent records a notable progression during the incident window. Sustained anomalies, such as an
+// error plateau, are one event spanning Start to End; Time is then their onset and AnomalyScore their peak.
 type TimelineEvent struct {
 	Time         time.Time
 	Event        string
@@ -33,6 +283,28 @@ type TimelineEvent struct {
 	Severity     Severity
 	AnomalyScore float64
 	DataSource   DataType
+	// Link is an optional dashboard deep link around the event time.
+	Link string
+	// Start and End bound a sustained event; both are zero for an instant.
+	Start time.Time
+	End   time.Time
+	// Ongoing is set when a sustained event was still anomalous at the last sample analysed.
+	Ongoing bool
+	// Direction is set when the detector knows whether the signal spiked or dropped.
+	Direction Direction
+}
+
+// Sustained reports whether the event spans an interval rather than an instant.
+func (e TimelineEvent) Sustained() bool {
+	return !e.Start.IsZero() && !e.End.IsZero()
+}
+
+// Duration returns how long a sustained event lasted, or zero for an instant.
+func (e TimelineEvent) Duration() time.Duration {
+	if !e.Sustained() {
+		return 0
+	}
+	return e.End.Sub(e.Start)
 }
 
 // DataType enumerates signal categories.
@@ -44,6 +316,16 @@ const (
 	DataTypeTraces  DataType = "traces"
 )
 
+// Direction records whether an anomalous signal rose above or fell below its baseline.
+type Direction string
+
+const (
+	// DirectionUp marks spikes above the baseline.
+	DirectionUp Direction = "up"
+	// DirectionDown marks drops below the baseline (traffic cliffs, throughput collapse).
+	DirectionDown Direction = "down"
+)
+
 // Severity captures impact levels.
 type Severity string
 
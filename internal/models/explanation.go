@@ -0,0 +1,58 @@
+package models
+
+// Explanation records how the engine reached a correlation: what the detectors found, how causality and
+// confidence were derived, and where the recommendations came from. It is stored with the correlation so
+// the decision can be audited after the fact.
+type Explanation struct {
+	// Service is the primary service the detectors analysed.
+	Service string
+	// Threshold is the anomaly threshold detection ran with, from the request or tuned for the service; zero
+	// means each detector used its default.
+	Threshold float64
+	// Preset is the investigation preset the request selected, if any.
+	Preset    string
+	Detectors []DetectorRun
+	Causality CausalityExplanation
+	// DetectionConfidence is the confidence derived from anomaly scores alone, before causality calibration
+	// and the penalty for MissingSources.
+	DetectionConfidence float64
+	MissingSources      int
+	// MaintenanceAnomalies counts anomalies down-weighted because a maintenance window covered them.
+	MaintenanceAnomalies int
+	// RecommendationSource is where the recommendations came from.
+	RecommendationSource RecommendationSource
+	MatchedRules         []MatchedRule
+	// SimilarCorrelations lists the past correlations found for the incident's symptoms, most similar first.
+	SimilarCorrelations []string
+}
+
+// DetectorRun summarises one detector's pass over the signals.
+type DetectorRun struct {
+	Name      string
+	Anomalies int
+	// Threshold is the highest threshold the detector's anomalies were held to; zero when it found none.
+	Threshold float64
+	TopScore  float64
+}
+
+// CausalityExplanation records the causality heuristics' verdict and the reasoning behind it.
+type CausalityExplanation struct {
+	Score            float64
+	SuggestedService string
+	Notes            []string
+}
+
+// MatchedRule names a recommendation rule that matched and the outcome of each of its conditions.
+type MatchedRule struct {
+	RuleID  string
+	Reasons []string
+}
+
+// RecommendationSource says where a correlation's recommendations came from.
+type RecommendationSource string
+
+const (
+	RecommendationsFromHistory RecommendationSource = "similar_incident"
+	RecommendationsFromRules   RecommendationSource = "rules"
+	RecommendationsDefault     RecommendationSource = "default"
+)
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// MaintenanceWindow marks a planned maintenance period for a tenant. An empty Services list covers every service.
+type MaintenanceWindow struct {
+	ID       string
+	TenantID string
+	Services []string
+	Start    time.Time
+	End      time.Time
+	Reason   string
+}
+
+// Covers reports whether the window applies to service at the given instant.
+func (w MaintenanceWindow) Covers(service string, at time.Time) bool {
+	if at.Before(w.Start) || !at.Before(w.End) {
+		return false
+	}
+	if len(w.Services) == 0 {
+		return true
+	}
+	for _, s := range w.Services {
+		if strings.EqualFold(s, service) {
+			return true
+		}
+	}
+	return false
+}
@@ -10,6 +10,22 @@ type InvestigationRequest struct {
 	AffectedServices []string
 	AnomalyThreshold float64
 	TenantID         string
+	Labels           map[string]string
+	Incident         IncidentMetadata
+	// Preset names the investigation preset that sets the window padding, detectors, and limits; empty uses
+	// the defaults.
+	Preset string
+	// Environment scopes every signal query to one deployment environment, such as prod or staging; empty
+	// leaves signals unfiltered.
+	Environment string
+}
+
+// IncidentMetadata describes the incident behind an investigation so stored correlations are self-describing.
+type IncidentMetadata struct {
+	Title             string
+	Description       string
+	AlertFingerprints []string
+	TicketURL         string
 }
 
 // TimeRange bounds the signal window for analysis.
@@ -26,6 +42,9 @@ type ListCorrelationsRequest struct {
 	End       time.Time
 	PageSize  int
 	PageToken string
+	Category  RootCauseCategory
+	// Labels restricts results to correlations carrying every listed key/value pair.
+	Labels map[string]string
 }
 
 // ListCorrelationsResponse contains correlation history records and pagination state.
@@ -34,6 +53,54 @@ type ListCorrelationsResponse struct {
 	NextPageToken string
 }
 
+// UpdateCorrelationRequest changes a stored correlation's status, appends annotations, and merges labels. An
+// empty Status leaves the current status unchanged; a label with an empty value is removed.
+type UpdateCorrelationRequest struct {
+	TenantID      string
+	CorrelationID string
+	Status        CorrelationStatus
+	Annotations   []Annotation
+	Labels        map[string]string
+}
+
+// SearchCorrelationsRequest runs a hybrid keyword + vector search over correlation history. Alpha weights
+// vector similarity against BM25 (0 is pure keyword, 1 pure vector).
+type SearchCorrelationsRequest struct {
+	TenantID string
+	Query    string
+	Alpha    float64
+	Limit    int
+}
+
+// ScoredCorrelation pairs a historical correlation with its search ranking score.
+type ScoredCorrelation struct {
+	Correlation CorrelationResult
+	Score       float64
+}
+
+// SearchCorrelationsResponse lists search hits ordered by descending score.
+type SearchCorrelationsResponse struct {
+	Results []ScoredCorrelation
+}
+
+// PurgeRequest selects tenant history for deletion. A zero Before erases all of the tenant's correlations,
+// feedback, patterns, and topology snapshots; otherwise only correlations, feedback, and topology snapshots
+// older than Before are removed.
+type PurgeRequest struct {
+	TenantID string
+	Before   time.Time
+	DryRun   bool
+}
+
+// PurgeResult reports how many objects were (or, for a dry run, would be) deleted per class.
+type PurgeResult struct {
+	Correlations      int
+	Feedback          int
+	Patterns          int
+	TopologySnapshots int
+	DryRun            bool
+}
+
 // Feedback captures user feedback for a correlation result.
 type Feedback struct {
 	TenantID      string
@@ -42,3 +109,57 @@ type Feedback struct {
 	Notes         string
 	SubmittedAt   time.Time
 }
+
+// ListFeedbackRequest filters stored feedback. A nil Correct returns both verdicts.
+type ListFeedbackRequest struct {
+	TenantID      string
+	CorrelationID string
+	Correct       *bool
+	Start         time.Time
+	End           time.Time
+	PageSize      int
+	PageToken     string
+}
+
+// ListFeedbackResponse is a page of feedback, newest first.
+type ListFeedbackResponse struct {
+	Feedback      []Feedback
+	NextPageToken string
+}
+
+// FeedbackStatsRequest selects the feedback window to aggregate; Bucket defaults to one day.
+type FeedbackStatsRequest struct {
+	TenantID string
+	Start    time.Time
+	End      time.Time
+	Bucket   time.Duration
+}
+
+// AccuracyStat counts correct verdicts for one grouping key.
+type AccuracyStat struct {
+	Key      string
+	Total    int
+	Correct  int
+	Accuracy float64
+}
+
+// AccuracyBucket counts correct verdicts within a time bucket starting at Start.
+type AccuracyBucket struct {
+	Start    time.Time
+	Total    int
+	Correct  int
+	Accuracy float64
+}
+
+// FeedbackStats summarises RCA accuracy from analyst feedback. ByCategory groups by the root-cause category of
+// the reviewed correlation and ByPattern by the ID of each mined failure pattern it matches; feedback for
+// correlations no longer stored only counts towards the totals and time buckets.
+type FeedbackStats struct {
+	Total      int
+	Correct    int
+	Accuracy   float64
+	ByService  []AccuracyStat
+	ByCategory []AccuracyStat
+	ByPattern  []AccuracyStat
+	ByBucket   []AccuracyBucket
+}
@@ -0,0 +1,59 @@
+package models
+
+import "time"
+
+// ServiceGraph is a tenant's service dependency graph over a window, overlaid with a correlation's anomalies.
+type ServiceGraph struct {
+	// Nodes are sorted by service name.
+	Nodes     []ServiceGraphNode
+	Edges     []ServiceGraphEdge
+	TimeRange TimeRange
+	// CorrelationID names the overlaid correlation; empty when there was none to overlay.
+	CorrelationID string
+}
+
+// ServiceGraphNode is one service of a ServiceGraph with the overlaid correlation's view of it.
+type ServiceGraphNode struct {
+	Service string
+	// AnomalyScore is the highest score among the correlation's anchors on the service; zero without anchors.
+	AnomalyScore float64
+	AnchorCount  int
+	// ImpactScore and ImpactDepth place the service in the correlation's blast radius; a zero score means it is
+	// outside it.
+	ImpactScore float64
+	ImpactDepth int
+	// RootCause marks the service the correlation blamed.
+	RootCause bool
+	// Affected marks the correlation's affected services.
+	Affected bool
+}
+
+// ServiceGraphEdge is a dependency between two services of a ServiceGraph.
+type ServiceGraphEdge struct {
+	Source string
+	Target string
+	// CallRate is in calls per second and ErrorRate in percent of calls.
+	CallRate  float64
+	ErrorRate float64
+	// Anomalous marks edges into a service with anomalies in the overlaid correlation.
+	Anomalous bool
+}
+
+// TopologySnapshot is the service graph of one environment of a tenant as captured at a point in time, kept so
+// that investigations can tell which dependencies appeared or disappeared before an incident.
+type TopologySnapshot struct {
+	// Environment is empty for graphs that are not scoped to an environment.
+	Environment string
+	// CapturedAt is the end of the window the edges were observed over.
+	CapturedAt time.Time
+	Edges      []TopologyEdge
+}
+
+// TopologyEdge is a dependency observed in a TopologySnapshot.
+type TopologyEdge struct {
+	Source string
+	Target string
+	// CallRate is in calls per second and ErrorRate in percent of calls.
+	CallRate  float64
+	ErrorRate float64
+}
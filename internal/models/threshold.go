@@ -0,0 +1,31 @@
+package models
+
+import "time"
+
+// ThresholdBasis records what a threshold recommendation was derived from.
+type ThresholdBasis string
+
+const (
+	// ThresholdBasisFeedback thresholds best separate the correlations analysts marked correct from those
+	// they marked wrong.
+	ThresholdBasisFeedback ThresholdBasis = "feedback"
+	// ThresholdBasisNoise thresholds keep the metric anchors per correlation within the configured budget.
+	ThresholdBasisNoise ThresholdBasis = "noise"
+)
+
+// ThresholdRecommendation is the anomaly threshold tuned for one service of a tenant from its history.
+type ThresholdRecommendation struct {
+	Service   string
+	Threshold float64
+	// Current is the threshold the service's anomalies were detected with, the lowest one when it varied.
+	Current float64
+	Basis   ThresholdBasis
+	// Correlations and Feedback count the correlations analysed and those of them with a verdict.
+	Correlations int
+	Feedback     int
+	// AnchorsPerCorrelation is the mean number of metric anchors per correlation at Current, and
+	// ExpectedAnchors the mean at Threshold.
+	AnchorsPerCorrelation float64
+	ExpectedAnchors       float64
+	UpdatedAt             time.Time
+}
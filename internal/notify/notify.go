@@ -0,0 +1,223 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync/atomic"
+	"text/template"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// DefaultTemplate renders the root cause, confidence, narrative summary, top anchors with deep links, and
+// recommendations with their action links.
+const DefaultTemplate = `Mirador RCA [{{.TenantID}}]: {{.Correlation.RootCause}} ({{.ConfidencePercent}}% confidence)
+{{- with .Correlation.Summary}}
+{{.}}
+{{- end}}
+{{- range .TopAnchors}}
+• {{.Service}} {{.Selector}} (score {{printf "%.2f" .AnomalyScore}}){{if .Link}} {{.Link}}{{end}}
+{{- end}}
+{{- range .Correlation.Recommendations}}
+→ {{.Text}}{{range .Actions}} [{{if .Label}}{{.Label}}{{else}}{{.Type}}{{end}}]({{.URL}}){{end}}
+{{- end}}
+Correlation: {{.Correlation.CorrelationID}}`
+
+// topAnchorCount is how many anchors are offered to templates as TopAnchors.
+const topAnchorCount = 3
+
+// Notifier delivers completed correlations to external channels.
+type Notifier interface {
+	Notify(ctx context.Context, tenantID string, result models.CorrelationResult) error
+}
+
+// Sender posts a rendered message to one destination.
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// Message is a rendered notification together with the correlation it describes.
+type Message struct {
+	TenantID    string
+	Text        string
+	Correlation models.CorrelationResult
+}
+
+// TemplateData is the value templates are executed against.
+type TemplateData struct {
+	TenantID          string
+	Correlation       models.CorrelationResult
+	ConfidencePercent int
+	TopAnchors        []models.RedAnchor
+}
+
+// Channel is a named destination with its own message template.
+type Channel struct {
+	Name     string
+	Sender   Sender
+	template *template.Template
+}
+
+// NewChannel parses text (DefaultTemplate when empty) and binds it to sender.
+func NewChannel(name string, sender Sender, text string) (Channel, error) {
+	if name == "" {
+		return Channel{}, fmt.Errorf("channel name is required")
+	}
+	if text == "" {
+		text = DefaultTemplate
+	}
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return Channel{}, fmt.Errorf("channel %s: parse template: %w", name, err)
+	}
+	return Channel{Name: name, Sender: sender, template: tmpl}, nil
+}
+
+// Route selects the channels for matching correlations. Empty Tenants and Categories match everything;
+// duplicates of an earlier correlation are skipped unless IncludeDuplicates is set.
+type Route struct {
+	Tenants           []string
+	Categories        []models.RootCauseCategory
+	MinConfidence     float64
+	IncludeDuplicates bool
+	Channels          []string
+}
+
+func (r Route) matches(tenantID string, result models.CorrelationResult) bool {
+	if result.DuplicateOf != "" && !r.IncludeDuplicates {
+		return false
+	}
+	if result.Confidence < r.MinConfidence {
+		return false
+	}
+	if len(r.Tenants) > 0 && !contains(r.Tenants, tenantID) {
+		return false
+	}
+	if len(r.Categories) > 0 {
+		matched := false
+		for _, category := range r.Categories {
+			if category == result.Category {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// Router fans correlations out to channels according to its routes; each channel is notified at most once
+// per correlation even when several routes select it.
+type Router struct {
+	channels map[string]Channel
+	routes   []Route
+}
+
+// NewRouter validates that every route references a known channel.
+func NewRouter(channels []Channel, routes []Route) (*Router, error) {
+	byName := make(map[string]Channel, len(channels))
+	for _, channel := range channels {
+		if _, exists := byName[channel.Name]; exists {
+			return nil, fmt.Errorf("duplicate channel %q", channel.Name)
+		}
+		byName[channel.Name] = channel
+	}
+	for i, route := range routes {
+		if len(route.Channels) == 0 {
+			return nil, fmt.Errorf("route %d has no channels", i)
+		}
+		for _, name := range route.Channels {
+			if _, ok := byName[name]; !ok {
+				return nil, fmt.Errorf("route %d references unknown channel %q", i, name)
+			}
+		}
+	}
+	return &Router{channels: byName, routes: routes}, nil
+}
+
+// Notify renders and sends the correlation to every channel selected by a matching route.
+func (r *Router) Notify(ctx context.Context, tenantID string, result models.CorrelationResult) error {
+	if r == nil {
+		return nil
+	}
+	selected := map[string]bool{}
+	var names []string
+	for _, route := range r.routes {
+		if !route.matches(tenantID, result) {
+			continue
+		}
+		for _, name := range route.Channels {
+			if !selected[name] {
+				selected[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+
+	data := templateData(tenantID, result)
+	var errs []error
+	for _, name := range names {
+		channel := r.channels[name]
+		var buf bytes.Buffer
+		if err := channel.template.Execute(&buf, data); err != nil {
+			errs = append(errs, fmt.Errorf("channel %s: render: %w", name, err))
+			continue
+		}
+		if err := channel.Sender.Send(ctx, Message{TenantID: tenantID, Text: buf.String(), Correlation: result}); err != nil {
+			errs = append(errs, fmt.Errorf("channel %s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Reloadable is a Notifier whose router can be replaced at runtime, for example when the configuration is
+// reloaded. Notifications already being delivered finish with the router they started with; a nil router
+// disables delivery.
+type Reloadable struct {
+	router atomic.Pointer[Router]
+}
+
+// NewReloadable starts with router, which may be nil.
+func NewReloadable(router *Router) *Reloadable {
+	r := &Reloadable{}
+	r.router.Store(router)
+	return r
+}
+
+// Swap makes router handle subsequent notifications.
+func (r *Reloadable) Swap(router *Router) {
+	r.router.Store(router)
+}
+
+// Notify delivers result through the current router.
+func (r *Reloadable) Notify(ctx context.Context, tenantID string, result models.CorrelationResult) error {
+	return r.router.Load().Notify(ctx, tenantID, result)
+}
+
+func templateData(tenantID string, result models.CorrelationResult) TemplateData {
+	anchors := append([]models.RedAnchor(nil), result.RedAnchors...)
+	sort.SliceStable(anchors, func(i, j int) bool { return anchors[i].AnomalyScore > anchors[j].AnomalyScore })
+	if len(anchors) > topAnchorCount {
+		anchors = anchors[:topAnchorCount]
+	}
+	return TemplateData{
+		TenantID:          tenantID,
+		Correlation:       result,
+		ConfidencePercent: int(result.Confidence*100 + 0.5),
+		TopAnchors:        anchors,
+	}
+}
+
+func contains(values []string, target string) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+type recordingSender struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+func (s *recordingSender) Send(ctx context.Context, msg Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages = append(s.messages, msg)
+	return nil
+}
+
+func sampleResult() models.CorrelationResult {
+	return models.CorrelationResult{
+		CorrelationID: "corr-1",
+		RootCause:     "payments: db connection pool exhausted",
+		Confidence:    0.823,
+		Category:      models.CategoryCapacity,
+		RedAnchors: []models.RedAnchor{
+			{Service: "payments", Selector: "latency_p99", AnomalyScore: 0.6},
+			{Service: "payments", Selector: "db_pool_in_use", AnomalyScore: 0.95, Link: "https://grafana.example.com/d/pool"},
+			{Service: "checkout", Selector: "errors", AnomalyScore: 0.7},
+			{Service: "gateway", Selector: "errors", AnomalyScore: 0.2},
+		},
+		Recommendations: []models.Recommendation{{Text: "Raise the pool size"}},
+		Summary:         "Payments ran out of database connections.",
+	}
+}
+
+func TestRouterRoutesAndRendersTemplates(t *testing.T) {
+	slack, teams := &recordingSender{}, &recordingSender{}
+	slackChannel, err := NewChannel("slack", slack, "")
+	if err != nil {
+		t.Fatalf("new channel: %v", err)
+	}
+	teamsChannel, err := NewChannel("teams", teams, "{{.Correlation.RootCause}} {{range .TopAnchors}}[{{.Selector}}]{{end}}")
+	if err != nil {
+		t.Fatalf("new channel: %v", err)
+	}
+	router, err := NewRouter([]Channel{slackChannel, teamsChannel}, []Route{
+		{Channels: []string{"slack"}, MinConfidence: 0.5},
+		{Tenants: []string{"acme"}, Categories: []models.RootCauseCategory{models.CategoryCapacity}, Channels: []string{"slack", "teams"}},
+	})
+	if err != nil {
+		t.Fatalf("new router: %v", err)
+	}
+
+	if err := router.Notify(context.Background(), "acme", sampleResult()); err != nil {
+		t.Fatalf("notify: %v", err)
+	}
+	if len(slack.messages) != 1 {
+		t.Fatalf("expected slack to be notified once, got %d", len(slack.messages))
+	}
+	text := slack.messages[0].Text
+	for _, want := range []string{"[acme]", "db connection pool exhausted", "82% confidence", "db_pool_in_use", "https://grafana.example.com/d/pool", "Raise the pool size", "\nPayments ran out of database connections.\n"} {
+		if !strings.Contains(text, want) {
+			t.Fatalf("expected %q in default message:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "gateway") {
+		t.Fatalf("expected only the top three anchors:\n%s", text)
+	}
+	if len(teams.messages) != 1 || teams.messages[0].Text != "payments: db connection pool exhausted [db_pool_in_use][errors][latency_p99]" {
+		t.Fatalf("unexpected teams messages: %+v", teams.messages)
+	}
+
+	duplicate := sampleResult()
+	duplicate.DuplicateOf = "corr-0"
+	if err := router.Notify(context.Background(), "globex", duplicate); err != nil {
+		t.Fatalf("notify duplicate: %v", err)
+	}
+	if len(slack.messages) != 1 || len(teams.messages) != 1 {
+		t.Fatalf("expected duplicates to be skipped")
+	}
+
+	if _, err := NewRouter([]Channel{slackChannel}, []Route{{Channels: []string{"pager"}}}); err == nil {
+		t.Fatalf("expected unknown channel to be rejected")
+	}
+
+	reloadable := NewReloadable(nil)
+	if err := reloadable.Notify(context.Background(), "acme", sampleResult()); err != nil {
+		t.Fatalf("notify without a router: %v", err)
+	}
+	reloadable.Swap(router)
+	if err := reloadable.Notify(context.Background(), "acme", sampleResult()); err != nil || len(slack.messages) != 2 {
+		t.Fatalf("expected the swapped-in router to deliver, got %d messages (%v)", len(slack.messages), err)
+	}
+}
+
+func TestSendersPostProviderPayloads(t *testing.T) {
+	var mu sync.Mutex
+	bodies := map[string]map[string]interface{}{}
+	var auth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		bodies[r.URL.Path] = body
+		if r.URL.Path == "/hook" {
+			auth = r.Header.Get("Authorization")
+		}
+		mu.Unlock()
+		if r.URL.Path == "/broken" {
+			http.Error(w, "invalid_token", http.StatusForbidden)
+		}
+	}))
+	defer server.Close()
+
+	msg := Message{TenantID: "acme", Text: "line one\nline two", Correlation: sampleResult()}
+	ctx := context.Background()
+	if err := NewSlackSender(server.URL+"/slack", time.Second).Send(ctx, msg); err != nil {
+		t.Fatalf("slack: %v", err)
+	}
+	if err := NewTeamsSender(server.URL+"/teams", time.Second).Send(ctx, msg); err != nil {
+		t.Fatalf("teams: %v", err)
+	}
+	if err := NewWebhookSender(server.URL+"/hook", map[string]string{"Authorization": "Bearer tok"}, time.Second).Send(ctx, msg); err != nil {
+		t.Fatalf("webhook: %v", err)
+	}
+	if err := NewSlackSender(server.URL+"/broken", time.Second).Send(ctx, msg); err == nil || !strings.Contains(err.Error(), "invalid_token") {
+		t.Fatalf("expected error with response body, got %v", err)
+	}
+
+	if bodies["/slack"]["text"] != "line one\nline two" {
+		t.Fatalf("unexpected slack payload: %+v", bodies["/slack"])
+	}
+	if bodies["/teams"]["@type"] != "MessageCard" || bodies["/teams"]["text"] != "line one\n\nline two" {
+		t.Fatalf("unexpected teams payload: %+v", bodies["/teams"])
+	}
+	correlation, _ := bodies["/hook"]["correlation"].(map[string]interface{})
+	if bodies["/hook"]["tenantId"] != "acme" || correlation["CorrelationID"] != "corr-1" || auth != "Bearer tok" {
+		t.Fatalf("unexpected webhook payload: %+v (%s)", bodies["/hook"], auth)
+	}
+}
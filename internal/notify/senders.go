@@ -0,0 +1,85 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// WebhookSender posts messages as JSON to an HTTP endpoint. The payload shape depends on the flavour:
+// Slack incoming webhooks, Microsoft Teams incoming webhooks, or a generic JSON document.
+type WebhookSender struct {
+	kind       string
+	url        string
+	headers    map[string]string
+	httpClient *http.Client
+}
+
+// NewSlackSender builds a sender for a Slack incoming webhook URL.
+func NewSlackSender(url string, timeout time.Duration) *WebhookSender {
+	return newWebhookSender("slack", url, nil, timeout)
+}
+
+// NewTeamsSender builds a sender for a Microsoft Teams incoming webhook URL.
+func NewTeamsSender(url string, timeout time.Duration) *WebhookSender {
+	return newWebhookSender("teams", url, nil, timeout)
+}
+
+// NewWebhookSender builds a generic sender that posts {"tenantId", "text", "correlation"} with the given
+// extra headers, e.g. for authentication.
+func NewWebhookSender(url string, headers map[string]string, timeout time.Duration) *WebhookSender {
+	return newWebhookSender("webhook", url, headers, timeout)
+}
+
+func newWebhookSender(kind, url string, headers map[string]string, timeout time.Duration) *WebhookSender {
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	return &WebhookSender{kind: kind, url: url, headers: headers, httpClient: &http.Client{Timeout: timeout}}
+}
+
+// Send implements Sender.
+func (s *WebhookSender) Send(ctx context.Context, msg Message) error {
+	var payload interface{}
+	switch s.kind {
+	case "slack":
+		payload = map[string]string{"text": msg.Text}
+	case "teams":
+		payload = map[string]string{
+			"@type":    "MessageCard",
+			"@context": "https://schema.org/extensions",
+			"summary":  "Mirador RCA: " + msg.Correlation.RootCause,
+			"text":     strings.ReplaceAll(msg.Text, "\n", "\n\n"),
+		}
+	default:
+		payload = map[string]interface{}{"tenantId": msg.TenantID, "text": msg.Text, "correlation": msg.Correlation}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshal %s payload: %w", s.kind, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range s.headers {
+		req.Header.Set(key, value)
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s notify: %w", s.kind, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("%s notify: %s: %s", s.kind, resp.Status, strings.TrimSpace(string(data)))
+	}
+	return nil
+}
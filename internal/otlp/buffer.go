@@ -0,0 +1,367 @@
+// Package otlp receives OpenTelemetry metrics, logs, and traces pushed over OTLP into a short-term buffer that
+// the pipeline reads in place of mirador-core, so the engine can run without mirador-core's RCA endpoints.
+package otlp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/metrics"
+	"github.com/miradorstack/mirador-rca/internal/repo"
+)
+
+// Signal names label the buffered signal kinds on the OTLP metrics.
+const (
+	SignalMetrics = "metrics"
+	SignalLogs    = "logs"
+	SignalTraces  = "traces"
+)
+
+// ErrTooManyTenants rejects the records of a new tenant while the buffer already holds its maximum number of
+// tenants.
+var ErrTooManyTenants = errors.New("otlp buffer holds the maximum number of tenants")
+
+// origin is the service and deployment environment a record's OTLP resource reported.
+type origin struct {
+	service     string
+	environment string
+	received    time.Time
+}
+
+func (o origin) receivedAt() time.Time { return o.received }
+
+// matches reports whether the record belongs to service and, when environment is set, to that environment.
+func (o origin) matches(service, environment string) bool {
+	return strings.EqualFold(o.service, service) && (environment == "" || strings.EqualFold(o.environment, environment))
+}
+
+type metricRecord struct {
+	origin
+	point repo.MetricPoint
+}
+
+type logRecord struct {
+	origin
+	entry repo.LogEntry
+}
+
+type spanRecord struct {
+	origin
+	span         repo.TraceSpan
+	parentSpanID string
+}
+
+// signals holds one tenant's records of each kind in the order they were received.
+type signals struct {
+	metrics []metricRecord
+	logs    []logRecord
+	spans   []spanRecord
+}
+
+// Buffer keeps the signals received for each tenant for a retention period. It implements repo.MetricSource,
+// repo.LogSource, repo.TraceSource, and repo.ServiceGraphSource over what it holds.
+type Buffer struct {
+	mu         sync.RWMutex
+	retention  time.Duration
+	maxItems   int
+	maxTenants int
+	tenants    map[string]*signals
+	now        func() time.Time
+}
+
+// NewBuffer constructs a buffer keeping signals for retention (default 2h), at most maxItems records of each
+// kind per tenant (default 100000), and at most maxTenants tenants (default 100). The oldest records are
+// evicted first; a new tenant beyond the limit is rejected with ErrTooManyTenants until another tenant's
+// records have all expired.
+func NewBuffer(retention time.Duration, maxItems, maxTenants int) *Buffer {
+	if retention <= 0 {
+		retention = 2 * time.Hour
+	}
+	if maxItems <= 0 {
+		maxItems = 100000
+	}
+	if maxTenants <= 0 {
+		maxTenants = 100
+	}
+	return &Buffer{retention: retention, maxItems: maxItems, maxTenants: maxTenants, tenants: make(map[string]*signals), now: time.Now}
+}
+
+func (b *Buffer) addMetrics(tenantID string, records []metricRecord) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	tenant, err := b.tenant(tenantID)
+	if err != nil {
+		return err
+	}
+	var evicted int
+	tenant.metrics, evicted = prune(append(tenant.metrics, records...), b.now().Add(-b.retention), b.maxItems)
+	metrics.ObserveOTLPRecords(SignalMetrics, len(records), evicted)
+	return nil
+}
+
+func (b *Buffer) addLogs(tenantID string, records []logRecord) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	tenant, err := b.tenant(tenantID)
+	if err != nil {
+		return err
+	}
+	var evicted int
+	tenant.logs, evicted = prune(append(tenant.logs, records...), b.now().Add(-b.retention), b.maxItems)
+	metrics.ObserveOTLPRecords(SignalLogs, len(records), evicted)
+	return nil
+}
+
+func (b *Buffer) addSpans(tenantID string, records []spanRecord) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	tenant, err := b.tenant(tenantID)
+	if err != nil {
+		return err
+	}
+	var evicted int
+	tenant.spans, evicted = prune(append(tenant.spans, records...), b.now().Add(-b.retention), b.maxItems)
+	metrics.ObserveOTLPRecords(SignalTraces, len(records), evicted)
+	return nil
+}
+
+// tenant returns the signals of tenantID, adding the tenant when there is room. At the limit it first drops
+// tenants whose records have all expired.
+func (b *Buffer) tenant(tenantID string) (*signals, error) {
+	if tenant, ok := b.tenants[tenantID]; ok {
+		return tenant, nil
+	}
+	if len(b.tenants) >= b.maxTenants {
+		b.dropExpiredTenants()
+	}
+	if len(b.tenants) >= b.maxTenants {
+		return nil, ErrTooManyTenants
+	}
+	tenant := &signals{}
+	b.tenants[tenantID] = tenant
+	return tenant, nil
+}
+
+func (b *Buffer) dropExpiredTenants() {
+	cutoff := b.now().Add(-b.retention)
+	for tenantID, tenant := range b.tenants {
+		tenant.metrics, _ = prune(tenant.metrics, cutoff, b.maxItems)
+		tenant.logs, _ = prune(tenant.logs, cutoff, b.maxItems)
+		tenant.spans, _ = prune(tenant.spans, cutoff, b.maxItems)
+		if len(tenant.metrics) == 0 && len(tenant.logs) == 0 && len(tenant.spans) == 0 {
+			delete(b.tenants, tenantID)
+		}
+	}
+}
+
+// prune drops the records received before cutoff and then the oldest ones beyond maxItems, which it counts as
+// evicted. Records are held in arrival order, so both are a prefix.
+func prune[T interface{ receivedAt() time.Time }](records []T, cutoff time.Time, maxItems int) ([]T, int) {
+	expired := sort.Search(len(records), func(i int) bool { return !records[i].receivedAt().Before(cutoff) })
+	records = records[expired:]
+	evicted := max(len(records)-maxItems, 0)
+	return records[evicted:], evicted
+}
+
+// FetchMetricSeries returns the samples of service's metrics within the window, named after their metric.
+func (b *Buffer) FetchMetricSeries(ctx context.Context, tenantID, service string, start, end time.Time) ([]repo.MetricPoint, error) {
+	if b == nil {
+		return nil, fmt.Errorf("otlp buffer not initialised")
+	}
+	environment := repo.Environment(ctx)
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	var points []repo.MetricPoint
+	if tenant, ok := b.tenants[tenantID]; ok {
+		for _, record := range tenant.metrics {
+			if record.matches(service, environment) && within(record.point.Timestamp, start, end) {
+				points = append(points, record.point)
+			}
+		}
+	}
+	if len(points) == 0 {
+		return nil, fmt.Errorf("otlp buffer holds no metrics for %s", service)
+	}
+	sort.SliceStable(points, func(i, j int) bool { return points[i].Timestamp.Before(points[j].Timestamp) })
+	return points, nil
+}
+
+// FetchLogEntries returns service's log records within the window, folded per minute, message, and severity.
+func (b *Buffer) FetchLogEntries(ctx context.Context, tenantID, service string, start, end time.Time) ([]repo.LogEntry, error) {
+	if b == nil {
+		return nil, fmt.Errorf("otlp buffer not initialised")
+	}
+	environment := repo.Environment(ctx)
+	type bucketKey struct {
+		minute   int64
+		message  string
+		severity string
+	}
+	buckets := make(map[bucketKey]*repo.LogEntry)
+
+	b.mu.RLock()
+	if tenant, ok := b.tenants[tenantID]; ok {
+		for _, record := range tenant.logs {
+			if !record.matches(service, environment) || !within(record.entry.Timestamp, start, end) {
+				continue
+			}
+			minute := record.entry.Timestamp.Truncate(time.Minute)
+			key := bucketKey{minute: minute.Unix(), message: record.entry.Message, severity: record.entry.Severity}
+			if entry, ok := buckets[key]; ok {
+				entry.Count++
+				continue
+			}
+			buckets[key] = &repo.LogEntry{Timestamp: minute, Message: key.message, Severity: key.severity, Count: 1}
+		}
+	}
+	b.mu.RUnlock()
+
+	entries := make([]repo.LogEntry, 0, len(buckets))
+	for _, entry := range buckets {
+		entries = append(entries, *entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if !entries[i].Timestamp.Equal(entries[j].Timestamp) {
+			return entries[i].Timestamp.Before(entries[j].Timestamp)
+		}
+		return entries[i].Message < entries[j].Message
+	})
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("otlp buffer holds no logs for %s", service)
+	}
+	return entries, nil
+}
+
+// FetchTraceSpans returns every buffered span of the traces in which service has a span within the window.
+func (b *Buffer) FetchTraceSpans(ctx context.Context, tenantID, service string, start, end time.Time) ([]repo.TraceSpan, error) {
+	if b == nil {
+		return nil, fmt.Errorf("otlp buffer not initialised")
+	}
+	environment := repo.Environment(ctx)
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	tenant, ok := b.tenants[tenantID]
+	if !ok {
+		return nil, fmt.Errorf("otlp buffer holds no traces for %s", service)
+	}
+	traces := make(map[string]bool)
+	for _, record := range tenant.spans {
+		if record.matches(service, environment) && within(record.span.Timestamp, start, end) {
+			traces[record.span.TraceID] = true
+		}
+	}
+	var spans []repo.TraceSpan
+	for _, record := range tenant.spans {
+		if traces[record.span.TraceID] {
+			spans = append(spans, record.span)
+		}
+	}
+	if len(spans) == 0 {
+		return nil, fmt.Errorf("otlp buffer holds no traces for %s", service)
+	}
+	sort.SliceStable(spans, func(i, j int) bool { return spans[i].Timestamp.Before(spans[j].Timestamp) })
+	return spans, nil
+}
+
+// FetchServiceGraph derives dependency edges from the window's spans: a span whose parent belongs to another
+// service is a call from that service. CallRate is in calls per second over the window and ErrorRate is the
+// percentage of calls whose span failed.
+func (b *Buffer) FetchServiceGraph(ctx context.Context, tenantID string, start, end time.Time) ([]repo.ServiceGraphEdge, error) {
+	if b == nil {
+		return nil, fmt.Errorf("otlp buffer not initialised")
+	}
+	environment := repo.Environment(ctx)
+	type spanKey struct{ traceID, spanID string }
+	type edgeKey struct{ source, target string }
+	type edgeCounts struct{ calls, errors int }
+
+	b.mu.RLock()
+	var window []spanRecord
+	if tenant, ok := b.tenants[tenantID]; ok {
+		for _, record := range tenant.spans {
+			if within(record.span.Timestamp, start, end) && (environment == "" || strings.EqualFold(record.environment, environment)) {
+				window = append(window, record)
+			}
+		}
+	}
+	b.mu.RUnlock()
+
+	services := make(map[spanKey]string, len(window))
+	for _, record := range window {
+		services[spanKey{record.span.TraceID, record.span.SpanID}] = record.service
+	}
+	counts := make(map[edgeKey]*edgeCounts)
+	var order []edgeKey
+	for _, record := range window {
+		caller, ok := services[spanKey{record.span.TraceID, record.parentSpanID}]
+		if !ok || caller == "" || strings.EqualFold(caller, record.service) {
+			continue
+		}
+		key := edgeKey{caller, record.service}
+		edge, ok := counts[key]
+		if !ok {
+			edge = &edgeCounts{}
+			counts[key] = edge
+			order = append(order, key)
+		}
+		edge.calls++
+		if record.span.Status == "error" {
+			edge.errors++
+		}
+	}
+	if len(order) == 0 {
+		return nil, fmt.Errorf("otlp buffer holds no service calls")
+	}
+
+	seconds := max(end.Sub(start).Seconds(), 1)
+	edges := make([]repo.ServiceGraphEdge, 0, len(order))
+	for _, key := range order {
+		edge := counts[key]
+		edges = append(edges, repo.ServiceGraphEdge{
+			Source:    key.source,
+			Target:    key.target,
+			CallRate:  float64(edge.calls) / seconds,
+			ErrorRate: 100 * float64(edge.errors) / float64(edge.calls),
+		})
+	}
+	return edges, nil
+}
+
+// Resources returns a metric source serving the utilisation series of capacity analysis from the buffer.
+// resources maps resource names to the buffered metrics reporting them; samples are renamed to their resource.
+func (b *Buffer) Resources(resources map[string]string) repo.MetricSource {
+	metricResources := make(map[string]string, len(resources))
+	for resource, metric := range resources {
+		metricResources[metric] = resource
+	}
+	return resourceSource{buffer: b, resources: metricResources}
+}
+
+type resourceSource struct {
+	buffer    *Buffer
+	resources map[string]string
+}
+
+func (s resourceSource) FetchMetricSeries(ctx context.Context, tenantID, service string, start, end time.Time) ([]repo.MetricPoint, error) {
+	points, err := s.buffer.FetchMetricSeries(ctx, tenantID, service, start, end)
+	if err != nil {
+		return nil, err
+	}
+	var usage []repo.MetricPoint
+	for _, point := range points {
+		if resource, ok := s.resources[point.Name]; ok {
+			point.Name = resource
+			usage = append(usage, point)
+		}
+	}
+	return usage, nil
+}
+
+func within(ts, start, end time.Time) bool {
+	return !ts.Before(start) && !ts.After(end)
+}
@@ -0,0 +1,174 @@
+package otlp
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	collectorlogs "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	collectormetrics "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+
+	"github.com/miradorstack/mirador-rca/internal/repo"
+)
+
+// resourceOrigin reads the service name and deployment environment from an OTLP resource.
+func resourceOrigin(resource *resourcepb.Resource, received time.Time) origin {
+	o := origin{received: received}
+	for _, attr := range resource.GetAttributes() {
+		switch attr.GetKey() {
+		case "service.name":
+			o.service = attr.GetValue().GetStringValue()
+		case "deployment.environment", "deployment.environment.name":
+			o.environment = attr.GetValue().GetStringValue()
+		}
+	}
+	return o
+}
+
+// metricRecords flattens an export into one sample per data point of gauges, sums, and histograms; a
+// histogram point is sampled as its mean.
+func metricRecords(req *collectormetrics.ExportMetricsServiceRequest, received time.Time) []metricRecord {
+	var records []metricRecord
+	for _, resourceMetrics := range req.GetResourceMetrics() {
+		o := resourceOrigin(resourceMetrics.GetResource(), received)
+		for _, scope := range resourceMetrics.GetScopeMetrics() {
+			for _, metric := range scope.GetMetrics() {
+				add := func(timeUnixNano uint64, value float64) {
+					records = append(records, metricRecord{origin: o, point: repo.MetricPoint{
+						Name:      metric.GetName(),
+						Timestamp: unixNano(timeUnixNano),
+						Value:     value,
+					}})
+				}
+				for _, point := range metric.GetGauge().GetDataPoints() {
+					add(point.GetTimeUnixNano(), numberValue(point.GetAsDouble(), point.GetAsInt()))
+				}
+				for _, point := range metric.GetSum().GetDataPoints() {
+					add(point.GetTimeUnixNano(), numberValue(point.GetAsDouble(), point.GetAsInt()))
+				}
+				for _, point := range metric.GetHistogram().GetDataPoints() {
+					if point.GetCount() > 0 {
+						add(point.GetTimeUnixNano(), point.GetSum()/float64(point.GetCount()))
+					}
+				}
+			}
+		}
+	}
+	return records
+}
+
+func numberValue(asDouble float64, asInt int64) float64 {
+	if asInt != 0 {
+		return float64(asInt)
+	}
+	return asDouble
+}
+
+// logRecords converts an export into one entry per log record, with its body as the message and a lower-case
+// severity.
+func logRecords(req *collectorlogs.ExportLogsServiceRequest, received time.Time) []logRecord {
+	var records []logRecord
+	for _, resourceLogs := range req.GetResourceLogs() {
+		o := resourceOrigin(resourceLogs.GetResource(), received)
+		for _, scope := range resourceLogs.GetScopeLogs() {
+			for _, log := range scope.GetLogRecords() {
+				timestamp := log.GetTimeUnixNano()
+				if timestamp == 0 {
+					timestamp = log.GetObservedTimeUnixNano()
+				}
+				records = append(records, logRecord{origin: o, entry: repo.LogEntry{
+					Timestamp: unixNano(timestamp),
+					Message:   anyString(log.GetBody()),
+					Severity:  severity(log.GetSeverityText(), log.GetSeverityNumber()),
+					Count:     1,
+				}})
+			}
+		}
+	}
+	return records
+}
+
+// severity prefers the record's severity text and otherwise names the range its severity number falls in.
+func severity(text string, number logspb.SeverityNumber) string {
+	if text != "" {
+		return strings.ToLower(text)
+	}
+	switch {
+	case number >= logspb.SeverityNumber_SEVERITY_NUMBER_FATAL:
+		return "fatal"
+	case number >= logspb.SeverityNumber_SEVERITY_NUMBER_ERROR:
+		return "error"
+	case number >= logspb.SeverityNumber_SEVERITY_NUMBER_WARN:
+		return "warn"
+	case number >= logspb.SeverityNumber_SEVERITY_NUMBER_INFO:
+		return "info"
+	case number >= logspb.SeverityNumber_SEVERITY_NUMBER_DEBUG:
+		return "debug"
+	case number >= logspb.SeverityNumber_SEVERITY_NUMBER_TRACE:
+		return "trace"
+	default:
+		return ""
+	}
+}
+
+func anyString(value *commonpb.AnyValue) string {
+	switch v := value.GetValue().(type) {
+	case *commonpb.AnyValue_StringValue:
+		return v.StringValue
+	case *commonpb.AnyValue_BoolValue:
+		return strconv.FormatBool(v.BoolValue)
+	case *commonpb.AnyValue_IntValue:
+		return strconv.FormatInt(v.IntValue, 10)
+	case *commonpb.AnyValue_DoubleValue:
+		return strconv.FormatFloat(v.DoubleValue, 'g', -1, 64)
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// spanRecords converts an export into one record per span; spans with an error status are marked as failed.
+func spanRecords(req *collectortrace.ExportTraceServiceRequest, received time.Time) []spanRecord {
+	var records []spanRecord
+	for _, resourceSpans := range req.GetResourceSpans() {
+		o := resourceOrigin(resourceSpans.GetResource(), received)
+		for _, scope := range resourceSpans.GetScopeSpans() {
+			for _, span := range scope.GetSpans() {
+				status := "ok"
+				if span.GetStatus().GetCode() == tracepb.Status_STATUS_CODE_ERROR {
+					status = "error"
+				}
+				var duration time.Duration
+				if end, start := span.GetEndTimeUnixNano(), span.GetStartTimeUnixNano(); end > start {
+					duration = time.Duration(end - start)
+				}
+				records = append(records, spanRecord{
+					origin: o,
+					span: repo.TraceSpan{
+						TraceID:   hex.EncodeToString(span.GetTraceId()),
+						SpanID:    hex.EncodeToString(span.GetSpanId()),
+						Service:   o.service,
+						Operation: span.GetName(),
+						Duration:  duration,
+						Status:    status,
+						Timestamp: unixNano(span.GetStartTimeUnixNano()),
+					},
+					parentSpanID: hex.EncodeToString(span.GetParentSpanId()),
+				})
+			}
+		}
+	}
+	return records
+}
+
+func unixNano(ns uint64) time.Time {
+	return time.Unix(0, int64(ns)).UTC()
+}
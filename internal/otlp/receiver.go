@@ -0,0 +1,338 @@
+package otlp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	collectorlogs "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	collectormetrics "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	_ "google.golang.org/grpc/encoding/gzip" // collectors compress OTLP exports with gzip by default
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// MaxExportBytes bounds OTLP exports: HTTP request bodies after decompression, and gRPC messages.
+const MaxExportBytes = 16 << 20
+
+// Paths the OTLP/HTTP handler serves, as the OTLP specification defines them.
+const (
+	MetricsPath = "/v1/metrics"
+	LogsPath    = "/v1/logs"
+	TracesPath  = "/v1/traces"
+)
+
+var (
+	// errNoTenant rejects exports that name no tenant when no default tenant is configured.
+	errNoTenant = errors.New("export names no tenant")
+	// errTenantNotAllowed rejects exports for a tenant missing from the allowlist.
+	errTenantNotAllowed = errors.New("tenant is not allowed")
+)
+
+// Receiver accepts OTLP exports over gRPC and HTTP into a Buffer. OTLP carries no tenant, so each export is
+// attributed to the tenant named by the tenant header, or to the default tenant when the header is absent.
+type Receiver struct {
+	buffer        *Buffer
+	tenantHeader  string
+	defaultTenant string
+	allowed       map[string]bool
+	now           func() time.Time
+}
+
+// NewReceiver constructs a receiver filling buffer. tenantHeader defaults to X-Scope-OrgID; an empty
+// defaultTenant rejects exports without the header. A non-empty allowedTenants rejects exports for any other
+// tenant, so a sender cannot create buffers for arbitrary header values.
+func NewReceiver(buffer *Buffer, tenantHeader, defaultTenant string, allowedTenants []string) *Receiver {
+	if tenantHeader == "" {
+		tenantHeader = "X-Scope-OrgID"
+	}
+	var allowed map[string]bool
+	if len(allowedTenants) > 0 {
+		allowed = make(map[string]bool, len(allowedTenants))
+		for _, tenant := range allowedTenants {
+			allowed[tenant] = true
+		}
+	}
+	return &Receiver{buffer: buffer, tenantHeader: tenantHeader, defaultTenant: defaultTenant, allowed: allowed, now: time.Now}
+}
+
+func (r *Receiver) tenant(value string) (string, error) {
+	tenant := strings.TrimSpace(value)
+	if tenant == "" {
+		if r.defaultTenant == "" {
+			return "", fmt.Errorf("%w: set the %s header", errNoTenant, r.tenantHeader)
+		}
+		tenant = r.defaultTenant
+	}
+	if r.allowed != nil && !r.allowed[tenant] {
+		return "", fmt.Errorf("%w: %s", errTenantNotAllowed, tenant)
+	}
+	return tenant, nil
+}
+
+func (r *Receiver) exportMetrics(tenantID string, req *collectormetrics.ExportMetricsServiceRequest) error {
+	return r.buffer.addMetrics(tenantID, metricRecords(req, r.now()))
+}
+
+func (r *Receiver) exportLogs(tenantID string, req *collectorlogs.ExportLogsServiceRequest) error {
+	return r.buffer.addLogs(tenantID, logRecords(req, r.now()))
+}
+
+func (r *Receiver) exportTraces(tenantID string, req *collectortrace.ExportTraceServiceRequest) error {
+	return r.buffer.addSpans(tenantID, spanRecords(req, r.now()))
+}
+
+// grpcStatus maps a tenant or buffer error to the gRPC status of the export.
+func grpcStatus(err error) error {
+	switch {
+	case errors.Is(err, errTenantNotAllowed):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, ErrTooManyTenants):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+}
+
+// httpStatus maps a tenant or buffer error to the HTTP status of the export.
+func httpStatus(err error) int {
+	switch {
+	case errors.Is(err, errTenantNotAllowed):
+		return http.StatusForbidden
+	case errors.Is(err, ErrTooManyTenants):
+		return http.StatusTooManyRequests
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+// RegisterGRPC serves the OTLP metrics, logs, and trace services on server.
+func (r *Receiver) RegisterGRPC(server grpc.ServiceRegistrar) {
+	collectormetrics.RegisterMetricsServiceServer(server, metricsService{receiver: r})
+	collectorlogs.RegisterLogsServiceServer(server, logsService{receiver: r})
+	collectortrace.RegisterTraceServiceServer(server, traceService{receiver: r})
+}
+
+// grpcTenant resolves the tenant of a gRPC export from its metadata.
+func (r *Receiver) grpcTenant(ctx context.Context) (string, error) {
+	var value string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(r.tenantHeader); len(values) > 0 {
+			value = values[0]
+		}
+	}
+	tenant, err := r.tenant(value)
+	if err != nil {
+		return "", grpcStatus(err)
+	}
+	return tenant, nil
+}
+
+type metricsService struct {
+	collectormetrics.UnimplementedMetricsServiceServer
+	receiver *Receiver
+}
+
+func (s metricsService) Export(ctx context.Context, req *collectormetrics.ExportMetricsServiceRequest) (*collectormetrics.ExportMetricsServiceResponse, error) {
+	tenant, err := s.receiver.grpcTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.receiver.exportMetrics(tenant, req); err != nil {
+		return nil, grpcStatus(err)
+	}
+	return &collectormetrics.ExportMetricsServiceResponse{}, nil
+}
+
+type logsService struct {
+	collectorlogs.UnimplementedLogsServiceServer
+	receiver *Receiver
+}
+
+func (s logsService) Export(ctx context.Context, req *collectorlogs.ExportLogsServiceRequest) (*collectorlogs.ExportLogsServiceResponse, error) {
+	tenant, err := s.receiver.grpcTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.receiver.exportLogs(tenant, req); err != nil {
+		return nil, grpcStatus(err)
+	}
+	return &collectorlogs.ExportLogsServiceResponse{}, nil
+}
+
+type traceService struct {
+	collectortrace.UnimplementedTraceServiceServer
+	receiver *Receiver
+}
+
+func (s traceService) Export(ctx context.Context, req *collectortrace.ExportTraceServiceRequest) (*collectortrace.ExportTraceServiceResponse, error) {
+	tenant, err := s.receiver.grpcTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.receiver.exportTraces(tenant, req); err != nil {
+		return nil, grpcStatus(err)
+	}
+	return &collectortrace.ExportTraceServiceResponse{}, nil
+}
+
+// Handler serves OTLP/HTTP exports at MetricsPath, LogsPath, and TracesPath, encoded as protobuf or JSON and
+// optionally gzip-compressed.
+func (r *Receiver) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(MetricsPath, func(w http.ResponseWriter, req *http.Request) {
+		export := &collectormetrics.ExportMetricsServiceRequest{}
+		if tenant, ok := r.decodeHTTP(w, req, export); ok {
+			if err := r.exportMetrics(tenant, export); err != nil {
+				http.Error(w, err.Error(), httpStatus(err))
+				return
+			}
+			writeExportResponse(w, req, &collectormetrics.ExportMetricsServiceResponse{})
+		}
+	})
+	mux.HandleFunc(LogsPath, func(w http.ResponseWriter, req *http.Request) {
+		export := &collectorlogs.ExportLogsServiceRequest{}
+		if tenant, ok := r.decodeHTTP(w, req, export); ok {
+			if err := r.exportLogs(tenant, export); err != nil {
+				http.Error(w, err.Error(), httpStatus(err))
+				return
+			}
+			writeExportResponse(w, req, &collectorlogs.ExportLogsServiceResponse{})
+		}
+	})
+	mux.HandleFunc(TracesPath, func(w http.ResponseWriter, req *http.Request) {
+		export := &collectortrace.ExportTraceServiceRequest{}
+		if tenant, ok := r.decodeHTTP(w, req, export); ok {
+			if err := r.exportTraces(tenant, export); err != nil {
+				http.Error(w, err.Error(), httpStatus(err))
+				return
+			}
+			writeExportResponse(w, req, &collectortrace.ExportTraceServiceResponse{})
+		}
+	})
+	return mux
+}
+
+// decodeHTTP resolves the tenant of an OTLP/HTTP export and decodes its body into export, writing the error
+// response itself when either fails.
+func (r *Receiver) decodeHTTP(w http.ResponseWriter, req *http.Request, export proto.Message) (string, bool) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return "", false
+	}
+	tenant, err := r.tenant(req.Header.Get(r.tenantHeader))
+	if err != nil {
+		http.Error(w, err.Error(), httpStatus(err))
+		return "", false
+	}
+
+	body := io.Reader(req.Body)
+	if strings.EqualFold(req.Header.Get("Content-Encoding"), "gzip") {
+		gz, err := gzip.NewReader(req.Body)
+		if err != nil {
+			http.Error(w, "invalid gzip body", http.StatusBadRequest)
+			return "", false
+		}
+		defer gz.Close()
+		body = gz
+	}
+	data, err := io.ReadAll(io.LimitReader(body, MaxExportBytes+1))
+	if err != nil {
+		http.Error(w, "read body: "+err.Error(), http.StatusBadRequest)
+		return "", false
+	}
+	if len(data) > MaxExportBytes {
+		http.Error(w, "export too large", http.StatusRequestEntityTooLarge)
+		return "", false
+	}
+
+	switch contentType(req) {
+	case "application/x-protobuf":
+		err = proto.Unmarshal(data, export)
+	case "application/json":
+		err = unmarshalJSON(data, export)
+	default:
+		http.Error(w, "unsupported content type", http.StatusUnsupportedMediaType)
+		return "", false
+	}
+	if err != nil {
+		http.Error(w, "decode export: "+err.Error(), http.StatusBadRequest)
+		return "", false
+	}
+	return tenant, true
+}
+
+func contentType(req *http.Request) string {
+	mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	return mediaType
+}
+
+// writeExportResponse answers an export in the encoding of its request.
+func writeExportResponse(w http.ResponseWriter, req *http.Request, response proto.Message) {
+	var data []byte
+	if contentType(req) == "application/json" {
+		data, _ = protojson.Marshal(response)
+	} else {
+		data, _ = proto.Marshal(response)
+	}
+	w.Header().Set("Content-Type", contentType(req))
+	_, _ = w.Write(data)
+}
+
+// unmarshalJSON decodes OTLP/JSON, which encodes trace and span IDs as hex rather than the base64 protojson
+// expects for bytes fields. Numbers are kept as written so nanosecond timestamps sent as JSON numbers survive
+// the round trip without going through float64.
+func unmarshalJSON(data []byte, export proto.Message) error {
+	var document any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&document); err != nil {
+		return err
+	}
+	if decoder.More() {
+		return errors.New("unexpected data after the JSON document")
+	}
+	hexIDsToBase64(document)
+	normalised, err := json.Marshal(document)
+	if err != nil {
+		return err
+	}
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(normalised, export)
+}
+
+func hexIDsToBase64(node any) {
+	switch v := node.(type) {
+	case map[string]any:
+		for key, value := range v {
+			switch key {
+			case "traceId", "spanId", "parentSpanId":
+				if id, ok := value.(string); ok {
+					if raw, err := hex.DecodeString(id); err == nil {
+						v[key] = base64.StdEncoding.EncodeToString(raw)
+					}
+				}
+			default:
+				hexIDsToBase64(value)
+			}
+		}
+	case []any:
+		for _, value := range v {
+			hexIDsToBase64(value)
+		}
+	}
+}
@@ -0,0 +1,265 @@
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	collectorlogs "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	collectormetrics "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/miradorstack/mirador-rca/internal/repo"
+)
+
+func testResource(service, environment string) *resourcepb.Resource {
+	return &resourcepb.Resource{Attributes: []*commonpb.KeyValue{
+		{Key: "service.name", Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: service}}},
+		{Key: "deployment.environment", Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: environment}}},
+	}}
+}
+
+func TestReceiverBuffersGRPCExports(t *testing.T) {
+	buffer := NewBuffer(time.Hour, 0, 0)
+	server := grpc.NewServer()
+	NewReceiver(buffer, "", "", nil).RegisterGRPC(server)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	metricsExport := &collectormetrics.ExportMetricsServiceRequest{ResourceMetrics: []*metricspb.ResourceMetrics{{
+		Resource: testResource("checkout", "prod"),
+		ScopeMetrics: []*metricspb.ScopeMetrics{{Metrics: []*metricspb.Metric{
+			{Name: "error_rate", Data: &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{DataPoints: []*metricspb.NumberDataPoint{
+				{TimeUnixNano: uint64(now.Add(-2 * time.Minute).UnixNano()), Value: &metricspb.NumberDataPoint_AsDouble{AsDouble: 0.01}},
+				{TimeUnixNano: uint64(now.Add(-time.Minute).UnixNano()), Value: &metricspb.NumberDataPoint_AsDouble{AsDouble: 0.2}},
+			}}}},
+			{Name: "latency", Data: &metricspb.Metric_Histogram{Histogram: &metricspb.Histogram{DataPoints: []*metricspb.HistogramDataPoint{
+				{TimeUnixNano: uint64(now.Add(-time.Minute).UnixNano()), Count: 4, Sum: proto.Float64(2)},
+			}}}},
+		}}},
+	}}}
+
+	// Without the tenant header and a default tenant, exports are refused.
+	_, err = collectormetrics.NewMetricsServiceClient(conn).Export(context.Background(), metricsExport)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument without a tenant, got %v", err)
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "X-Scope-OrgID", "acme")
+	if _, err := collectormetrics.NewMetricsServiceClient(conn).Export(ctx, metricsExport); err != nil {
+		t.Fatalf("export metrics: %v", err)
+	}
+	_, err = collectorlogs.NewLogsServiceClient(conn).Export(ctx, &collectorlogs.ExportLogsServiceRequest{ResourceLogs: []*logspb.ResourceLogs{{
+		Resource: testResource("checkout", "prod"),
+		ScopeLogs: []*logspb.ScopeLogs{{LogRecords: []*logspb.LogRecord{
+			{TimeUnixNano: uint64(now.Add(-90 * time.Second).UnixNano()), SeverityNumber: logspb.SeverityNumber_SEVERITY_NUMBER_ERROR, Body: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: "payment declined"}}},
+			{TimeUnixNano: uint64(now.Add(-80 * time.Second).UnixNano()), SeverityText: "ERROR", Body: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: "payment declined"}}},
+		}}},
+	}}})
+	if err != nil {
+		t.Fatalf("export logs: %v", err)
+	}
+
+	start, end := now.Add(-10*time.Minute), now
+	points, err := buffer.FetchMetricSeries(ctx, "acme", "checkout", start, end)
+	if err != nil || len(points) != 3 {
+		t.Fatalf("expected three metric samples, got %+v, %v", points, err)
+	}
+	if points[2].Name != "latency" || points[2].Value != 0.5 {
+		t.Fatalf("expected the histogram sampled at its mean, got %+v", points[2])
+	}
+	if _, err := buffer.FetchMetricSeries(repo.WithEnvironment(ctx, "staging"), "acme", "checkout", start, end); err == nil {
+		t.Fatalf("expected no staging metrics")
+	}
+	if _, err := buffer.FetchMetricSeries(ctx, "other", "checkout", start, end); err == nil {
+		t.Fatalf("expected no metrics for another tenant")
+	}
+
+	entries, err := buffer.FetchLogEntries(ctx, "acme", "checkout", start, end)
+	if err != nil {
+		t.Fatalf("fetch logs: %v", err)
+	}
+	count := 0
+	for _, entry := range entries {
+		if entry.Message != "payment declined" || entry.Severity != "error" {
+			t.Fatalf("unexpected log entry %+v", entry)
+		}
+		count += entry.Count
+	}
+	if count != 2 {
+		t.Fatalf("expected two error records, got %+v", entries)
+	}
+
+	usage, err := buffer.Resources(map[string]string{"errors": "error_rate"}).FetchMetricSeries(ctx, "acme", "checkout", start, end)
+	if err != nil || len(usage) != 2 || usage[0].Name != "errors" {
+		t.Fatalf("expected the error rate renamed to its resource, got %+v, %v", usage, err)
+	}
+}
+
+func TestReceiverBuffersHTTPJSONTraces(t *testing.T) {
+	buffer := NewBuffer(time.Hour, 0, 0)
+	server := httptest.NewServer(NewReceiver(buffer, "", "acme", nil).Handler())
+	defer server.Close()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	const traceID = "5b8efff798038103d269b633813fc60c"
+	body := fmt.Sprintf(`{"resourceSpans":[
+		{"resource":{"attributes":[{"key":"service.name","value":{"stringValue":"checkout"}}]},
+		 "scopeSpans":[{"spans":[
+			{"traceId":%[1]q,"spanId":"eee19b7ec3c1b174","name":"POST /orders","startTimeUnixNano":"%[2]d","endTimeUnixNano":"%[3]d"}]}]},
+		{"resource":{"attributes":[{"key":"service.name","value":{"stringValue":"payments"}}]},
+		 "scopeSpans":[{"spans":[
+			{"traceId":%[1]q,"spanId":"eee19b7ec3c1b175","parentSpanId":"eee19b7ec3c1b174","name":"charge","startTimeUnixNano":"%[2]d","endTimeUnixNano":"%[3]d","status":{"code":2}}]}]}]}`,
+		traceID, now.Add(-time.Minute).UnixNano(), now.Add(-time.Minute+900*time.Millisecond).UnixNano())
+
+	resp, err := http.Post(server.URL+TracesPath, "application/json", bytes.NewBufferString(body))
+	if err != nil {
+		t.Fatalf("post traces: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "application/json" {
+		t.Fatalf("expected a JSON 200, got %s %q", resp.Status, resp.Header.Get("Content-Type"))
+	}
+
+	start, end := now.Add(-10*time.Minute), now
+	spans, err := buffer.FetchTraceSpans(context.Background(), "acme", "checkout", start, end)
+	if err != nil || len(spans) != 2 {
+		t.Fatalf("expected both spans of checkout's trace, got %+v, %v", spans, err)
+	}
+	for _, span := range spans {
+		if span.TraceID != traceID || span.Duration != 900*time.Millisecond {
+			t.Fatalf("unexpected span %+v", span)
+		}
+		if (span.Service == "payments") != (span.Status == "error") {
+			t.Fatalf("expected only the payments span to fail, got %+v", span)
+		}
+	}
+
+	edges, err := buffer.FetchServiceGraph(context.Background(), "acme", start, end)
+	if err != nil || len(edges) != 1 {
+		t.Fatalf("expected one derived edge, got %+v, %v", edges, err)
+	}
+	if edge := edges[0]; edge.Source != "checkout" || edge.Target != "payments" || edge.ErrorRate != 100 || edge.CallRate != 1.0/600 {
+		t.Fatalf("unexpected edge %+v", edge)
+	}
+
+	resp, err = http.Post(server.URL+TracesPath, "text/plain", bytes.NewBufferString(body))
+	if err != nil {
+		t.Fatalf("post traces: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnsupportedMediaType {
+		t.Fatalf("expected 415 for plain text, got %s", resp.Status)
+	}
+}
+
+func TestBufferEvictsExpiredAndExcessRecords(t *testing.T) {
+	buffer := NewBuffer(time.Hour, 2, 0)
+	now := time.Now()
+	buffer.now = func() time.Time { return now }
+	record := func(value float64, received time.Time) metricRecord {
+		return metricRecord{
+			origin: origin{service: "checkout", received: received},
+			point:  repo.MetricPoint{Name: "error_rate", Timestamp: now, Value: value},
+		}
+	}
+
+	buffer.addMetrics("acme", []metricRecord{record(1, now.Add(-2*time.Hour)), record(2, now)})
+	buffer.addMetrics("acme", []metricRecord{record(3, now), record(4, now)})
+
+	points, err := buffer.FetchMetricSeries(context.Background(), "acme", "checkout", now.Add(-time.Minute), now)
+	if err != nil {
+		t.Fatalf("fetch: %v", err)
+	}
+	if len(points) != 2 || points[0].Value != 3 || points[1].Value != 4 {
+		t.Fatalf("expected the two newest samples, got %+v", points)
+	}
+}
+
+func TestBufferCapsTenants(t *testing.T) {
+	buffer := NewBuffer(time.Hour, 0, 1)
+	now := time.Now()
+	buffer.now = func() time.Time { return now }
+	record := []metricRecord{{origin: origin{service: "checkout", received: now}, point: repo.MetricPoint{Timestamp: now}}}
+
+	if err := buffer.addMetrics("acme", record); err != nil {
+		t.Fatalf("first tenant: %v", err)
+	}
+	if err := buffer.addMetrics("globex", record); !errors.Is(err, ErrTooManyTenants) {
+		t.Fatalf("expected ErrTooManyTenants, got %v", err)
+	}
+	if err := buffer.addMetrics("acme", record); err != nil {
+		t.Fatalf("known tenant must still be accepted: %v", err)
+	}
+
+	now = now.Add(2 * time.Hour)
+	if err := buffer.addMetrics("globex", record); err != nil {
+		t.Fatalf("expected room once acme's records expired: %v", err)
+	}
+}
+
+func TestReceiverRejectsTenantsOutsideTheAllowlist(t *testing.T) {
+	server := httptest.NewServer(NewReceiver(NewBuffer(time.Hour, 0, 0), "", "", []string{"acme"}).Handler())
+	defer server.Close()
+
+	for tenant, want := range map[string]int{"acme": http.StatusOK, "intruder": http.StatusForbidden} {
+		req, _ := http.NewRequest(http.MethodPost, server.URL+MetricsPath, bytes.NewBufferString(`{}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Scope-OrgID", tenant)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("post: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != want {
+			t.Fatalf("tenant %s: expected %d, got %d", tenant, want, resp.StatusCode)
+		}
+	}
+}
+
+func TestUnmarshalJSONKeepsNanosecondTimestamps(t *testing.T) {
+	// 1700000000123456789 is not representable as a float64; the nearest one is ...456768.
+	const startNano, endNano uint64 = 1700000000123456789, 1700000000987654321
+	body := fmt.Sprintf(`{"resourceSpans":[{"scopeSpans":[{"spans":[
+		{"traceId":"5b8efff798038103d269b633813fc60c","spanId":"eee19b7ec3c1b174","name":"GET /","startTimeUnixNano":%d,"endTimeUnixNano":%d}]}]}]}`,
+		startNano, endNano)
+
+	var export collectortrace.ExportTraceServiceRequest
+	if err := unmarshalJSON([]byte(body), &export); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	span := export.GetResourceSpans()[0].GetScopeSpans()[0].GetSpans()[0]
+	if span.GetStartTimeUnixNano() != startNano || span.GetEndTimeUnixNano() != endNano {
+		t.Fatalf("expected exact timestamps %d and %d, got %d and %d", startNano, endNano, span.GetStartTimeUnixNano(), span.GetEndTimeUnixNano())
+	}
+	if fmt.Sprintf("%x", span.GetTraceId()) != "5b8efff798038103d269b633813fc60c" {
+		t.Fatalf("expected the hex trace ID to decode, got %x", span.GetTraceId())
+	}
+}
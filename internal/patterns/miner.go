@@ -17,19 +17,38 @@ type Store interface {
 
 // Miner mines simple frequency-based failure patterns from correlation history.
 type Miner struct {
-	store  Store
-	logger *slog.Logger
+	store          Store
+	logger         *slog.Logger
+	minCoOccurring int
+}
+
+// MinerOption customises a Miner.
+type MinerOption func(*Miner)
+
+// WithMinCoOccurrence sets how many correlations must contain anomalies in the same pair of services before
+// a cross-service pattern is emitted (default 2).
+func WithMinCoOccurrence(n int) MinerOption {
+	return func(m *Miner) {
+		if n > 0 {
+			m.minCoOccurring = n
+		}
+	}
 }
 
 // NewMiner constructs a Miner; store may be nil for dry runs.
-func NewMiner(logger *slog.Logger, store Store) *Miner {
+func NewMiner(logger *slog.Logger, store Store, opts ...MinerOption) *Miner {
 	if logger == nil {
 		logger = slog.Default()
 	}
-	return &Miner{store: store, logger: logger}
+	m := &Miner{store: store, logger: logger, minCoOccurring: 2}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// Mine analyses correlations and returns aggregated patterns by service.
+// Mine analyses correlations and returns single-service hotspot patterns plus cross-service patterns for
+// service pairs whose anomalies repeatedly co-occur within one correlation.
 func (m *Miner) Mine(ctx context.Context, tenantID string, correlations []models.CorrelationResult) ([]models.FailurePattern, error) {
 	if len(correlations) == 0 {
 		return nil, nil
@@ -93,6 +112,7 @@ func (m *Miner) Mine(ctx context.Context, tenantID string, correlations []models
 		}
 		patterns = append(patterns, pattern)
 	}
+	patterns = append(patterns, m.mineCrossService(correlations)...)
 
 	sort.Slice(patterns, func(i, j int) bool {
 		return patterns[i].Prevalence > patterns[j].Prevalence
@@ -154,3 +174,103 @@ func inferSignalType(selector string) string {
 		return "metrics"
 	}
 }
+
+// pairAggregate tracks correlations in which both services of a pair carried red anchors.
+type pairAggregate struct {
+	services     [2]string
+	count        int
+	lastSeen     time.Time
+	anchorCounts [2]map[string]int
+	anchorScores [2]map[string]float64
+	// lagSum accumulates, per service, minutes between the correlation's first anchor and the service's first.
+	lagSum [2]float64
+}
+
+func (m *Miner) mineCrossService(correlations []models.CorrelationResult) []models.FailurePattern {
+	pairs := make(map[[2]string]*pairAggregate)
+	for _, corr := range correlations {
+		first := make(map[string]time.Time)
+		var earliest time.Time
+		for _, anchor := range corr.RedAnchors {
+			if anchor.Service == "" {
+				continue
+			}
+			if ts, ok := first[anchor.Service]; !ok || anchor.Timestamp.Before(ts) {
+				first[anchor.Service] = anchor.Timestamp
+			}
+			if earliest.IsZero() || anchor.Timestamp.Before(earliest) {
+				earliest = anchor.Timestamp
+			}
+		}
+		if len(first) < 2 {
+			continue
+		}
+		services := make([]string, 0, len(first))
+		for service := range first {
+			services = append(services, service)
+		}
+		sort.Strings(services)
+
+		for i := 0; i < len(services); i++ {
+			for j := i + 1; j < len(services); j++ {
+				key := [2]string{services[i], services[j]}
+				agg, ok := pairs[key]
+				if !ok {
+					agg = &pairAggregate{services: key}
+					for k := range agg.anchorCounts {
+						agg.anchorCounts[k] = make(map[string]int)
+						agg.anchorScores[k] = make(map[string]float64)
+					}
+					pairs[key] = agg
+				}
+				agg.count++
+				if corr.CreatedAt.After(agg.lastSeen) {
+					agg.lastSeen = corr.CreatedAt
+				}
+				for k, service := range key {
+					agg.lagSum[k] += first[service].Sub(earliest).Minutes()
+				}
+				for _, anchor := range corr.RedAnchors {
+					for k, service := range key {
+						if anchor.Service == service && anchor.Selector != "" {
+							agg.anchorCounts[k][anchor.Selector]++
+							agg.anchorScores[k][anchor.Selector] += anchor.AnomalyScore
+						}
+					}
+				}
+			}
+		}
+	}
+
+	var patterns []models.FailurePattern
+	for _, agg := range pairs {
+		if agg.count < m.minCoOccurring {
+			continue
+		}
+		a, b := agg.services[0], agg.services[1]
+		pattern := models.FailurePattern{
+			ID:          "pattern-" + a + "+" + b,
+			Name:        a + " + " + b + " co-failure",
+			Description: "Auto-mined pattern of anomalies co-occurring across services",
+			Services:    []string{a, b},
+			Prevalence:  float64(agg.count) / float64(len(correlations)),
+			LastSeen:    agg.lastSeen,
+			Precision:   0.5,
+			Recall:      0.5,
+		}
+		for k, service := range agg.services {
+			selectorAgg := &serviceAggregate{anchorCounts: agg.anchorCounts[k]}
+			for _, sel := range selectorAgg.topSelectors(2) {
+				pattern.AnchorTemplates = append(pattern.AnchorTemplates, models.AnchorTemplate{
+					Service:    service,
+					SignalType: inferSignalType(sel),
+					Selector:   sel,
+					TypicalLag: agg.lagSum[k] / float64(agg.count),
+					Threshold:  agg.anchorScores[k][sel] / float64(agg.anchorCounts[k][sel]),
+				})
+			}
+		}
+		patterns = append(patterns, pattern)
+	}
+	return patterns
+}
@@ -52,3 +52,61 @@ func TestMinerMinesPatterns(t *testing.T) {
 		t.Fatalf("expected patterns to be stored")
 	}
 }
+
+func TestMinerMinesCrossServicePatterns(t *testing.T) {
+	miner := NewMiner(nil, nil)
+
+	base := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	incident := func(id string, offset time.Duration) models.CorrelationResult {
+		return models.CorrelationResult{
+			CorrelationID:    id,
+			AffectedServices: []string{"payments-db", "checkout"},
+			CreatedAt:        base.Add(offset),
+			RedAnchors: []models.RedAnchor{
+				{Service: "payments-db", Selector: "metrics:lock_wait", AnomalyScore: 5, Timestamp: base.Add(offset)},
+				{Service: "checkout", Selector: "logs:timeout", AnomalyScore: 3, Timestamp: base.Add(offset + 2*time.Minute)},
+			},
+		}
+	}
+	correlations := []models.CorrelationResult{
+		incident("c1", 0),
+		incident("c2", time.Hour),
+		{
+			CorrelationID:    "c3",
+			AffectedServices: []string{"search"},
+			CreatedAt:        base,
+			RedAnchors:       []models.RedAnchor{{Service: "search", Selector: "metrics:cpu", Timestamp: base}},
+		},
+	}
+
+	patterns, err := miner.Mine(context.Background(), "tenant", correlations)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var cross *models.FailurePattern
+	for i := range patterns {
+		if len(patterns[i].Services) > 1 {
+			if cross != nil {
+				t.Fatalf("expected a single cross-service pattern, got %+v", patterns)
+			}
+			cross = &patterns[i]
+		}
+	}
+	if cross == nil {
+		t.Fatalf("expected a cross-service pattern, got %+v", patterns)
+	}
+	if cross.ID != "pattern-checkout+payments-db" || cross.Prevalence != 2.0/3.0 || !cross.LastSeen.Equal(base.Add(time.Hour)) {
+		t.Fatalf("unexpected cross-service pattern: %+v", cross)
+	}
+	if len(cross.AnchorTemplates) != 2 || cross.AnchorTemplates[0].Service != "checkout" || cross.AnchorTemplates[0].TypicalLag != 2 || cross.AnchorTemplates[1].TypicalLag != 0 {
+		t.Fatalf("expected checkout to lag payments-db by two minutes, got %+v", cross.AnchorTemplates)
+	}
+
+	strict := NewMiner(nil, nil, WithMinCoOccurrence(3))
+	patterns, _ = strict.Mine(context.Background(), "tenant", correlations)
+	for _, p := range patterns {
+		if len(p.Services) > 1 {
+			t.Fatalf("expected min co-occurrence to suppress pattern %+v", p)
+		}
+	}
+}
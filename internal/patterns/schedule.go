@@ -0,0 +1,144 @@
+package patterns
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed cron expression. It supports the five standard fields (minute, hour, day of month,
+// month, day of week) with "*", lists, ranges, and steps, plus the @hourly, @daily, @weekly, and
+// "@every <duration>" shorthands. Times are evaluated in UTC.
+type Schedule struct {
+	every  time.Duration
+	fields [5]uint64
+	// domStar and dowStar record unrestricted day fields; cron matches either day field when both are set.
+	domStar bool
+	dowStar bool
+}
+
+var cronBounds = [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 6}}
+
+// ParseSchedule parses a cron expression.
+func ParseSchedule(expr string) (Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	switch expr {
+	case "@hourly":
+		expr = "0 * * * *"
+	case "@daily", "@midnight":
+		expr = "0 0 * * *"
+	case "@weekly":
+		expr = "0 0 * * 0"
+	}
+	if rest, ok := strings.CutPrefix(expr, "@every "); ok {
+		d, err := time.ParseDuration(strings.TrimSpace(rest))
+		if err != nil || d < time.Minute {
+			return Schedule{}, fmt.Errorf("invalid schedule %q: @every needs a duration of at least 1m", expr)
+		}
+		return Schedule{every: d}, nil
+	}
+
+	parts := strings.Fields(expr)
+	if len(parts) != 5 {
+		return Schedule{}, fmt.Errorf("invalid schedule %q: expected 5 fields", expr)
+	}
+	var s Schedule
+	for i, part := range parts {
+		bits, err := parseCronField(part, cronBounds[i][0], cronBounds[i][1])
+		if err != nil {
+			return Schedule{}, fmt.Errorf("invalid schedule %q: %w", expr, err)
+		}
+		s.fields[i] = bits
+	}
+	s.domStar = parts[2] == "*"
+	s.dowStar = parts[4] == "*"
+	return s, nil
+}
+
+func parseCronField(field string, lo, hi int) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(field, ",") {
+		step := 1
+		if base, stepText, ok := strings.Cut(item, "/"); ok {
+			n, err := strconv.Atoi(stepText)
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("bad step in %q", item)
+			}
+			item, step = base, n
+		}
+		start, end := lo, hi
+		if item != "*" {
+			from, to, isRange := strings.Cut(item, "-")
+			var err error
+			if start, err = strconv.Atoi(from); err != nil {
+				return 0, fmt.Errorf("bad value %q", item)
+			}
+			end = start
+			if isRange {
+				if end, err = strconv.Atoi(to); err != nil {
+					return 0, fmt.Errorf("bad range %q", item)
+				}
+			} else if step > 1 {
+				end = hi
+			}
+		}
+		if start < lo || end > hi || start > end {
+			return 0, fmt.Errorf("%q out of range %d-%d", item, lo, hi)
+		}
+		for v := start; v <= end; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+// Next returns the first activation strictly after t.
+func (s Schedule) Next(t time.Time) time.Time {
+	t = t.UTC()
+	if s.every > 0 {
+		return t.Truncate(s.every).Add(s.every)
+	}
+	next := t.Truncate(time.Minute).Add(time.Minute)
+	// Four years covers every reachable day-of-month/month/day-of-week combination.
+	limit := next.AddDate(4, 0, 0)
+	for next.Before(limit) {
+		if !s.has(3, int(next.Month())) {
+			next = time.Date(next.Year(), next.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !s.dayMatches(next) {
+			next = time.Date(next.Year(), next.Month(), next.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !s.has(1, next.Hour()) {
+			next = next.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+		if !s.has(0, next.Minute()) {
+			next = next.Add(time.Minute)
+			continue
+		}
+		return next
+	}
+	return time.Time{}
+}
+
+func (s Schedule) has(field, value int) bool {
+	return s.fields[field]&(1<<uint(value)) != 0
+}
+
+func (s Schedule) dayMatches(t time.Time) bool {
+	dom := s.has(2, t.Day())
+	dow := s.has(4, int(t.Weekday()))
+	switch {
+	case s.domStar && s.dowStar:
+		return true
+	case s.domStar:
+		return dow
+	case s.dowStar:
+		return dom
+	default:
+		return dom || dow
+	}
+}
@@ -0,0 +1,188 @@
+package patterns
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// CorrelationLister pages through stored correlations.
+type CorrelationLister interface {
+	ListCorrelations(ctx context.Context, req models.ListCorrelationsRequest) (models.ListCorrelationsResponse, error)
+}
+
+// Locker grants a key to a single caller until ttl expires; cache.Provider satisfies it through Valkey SETNX.
+type Locker interface {
+	SetNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error)
+}
+
+// Scheduler runs the Miner for each tenant on its cron schedule. Every activation is claimed through the
+// Locker first, so with a shared Valkey only one replica mines a given tenant slot.
+type Scheduler struct {
+	logger          *slog.Logger
+	miner           *Miner
+	lister          CorrelationLister
+	locker          Locker
+	schedules       map[string]Schedule
+	lookback        time.Duration
+	maxCorrelations int
+	lockTTL         time.Duration
+	instance        string
+	now             func() time.Time
+}
+
+// NewScheduler builds a scheduler from tenant → cron expression entries; tenants with an empty expression
+// use defaultSchedule. Non-positive lookback and maxCorrelations default to seven days and 5000.
+func NewScheduler(logger *slog.Logger, miner *Miner, lister CorrelationLister, locker Locker, defaultSchedule string, tenants map[string]string, lookback time.Duration, maxCorrelations int) (*Scheduler, error) {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if lookback <= 0 {
+		lookback = 7 * 24 * time.Hour
+	}
+	if maxCorrelations <= 0 {
+		maxCorrelations = 5000
+	}
+	schedules := make(map[string]Schedule, len(tenants))
+	for tenantID, expr := range tenants {
+		if expr == "" {
+			expr = defaultSchedule
+		}
+		schedule, err := ParseSchedule(expr)
+		if err != nil {
+			return nil, fmt.Errorf("tenant %s: %w", tenantID, err)
+		}
+		schedules[tenantID] = schedule
+	}
+	return &Scheduler{
+		logger:          logger,
+		miner:           miner,
+		lister:          lister,
+		locker:          locker,
+		schedules:       schedules,
+		lookback:        lookback,
+		maxCorrelations: maxCorrelations,
+		lockTTL:         10 * time.Minute,
+		instance:        strconv.FormatInt(time.Now().UnixNano(), 36),
+		now:             time.Now,
+	}, nil
+}
+
+// Run waits for the next due tenant slot and mines it, until ctx is cancelled.
+func (s *Scheduler) Run(ctx context.Context) {
+	if s == nil || len(s.schedules) == 0 {
+		return
+	}
+	next := make(map[string]time.Time, len(s.schedules))
+	for tenantID, schedule := range s.schedules {
+		next[tenantID] = schedule.Next(s.now())
+	}
+	for {
+		due := time.Time{}
+		for _, at := range next {
+			if !at.IsZero() && (due.IsZero() || at.Before(due)) {
+				due = at
+			}
+		}
+		if due.IsZero() {
+			return
+		}
+		timer := time.NewTimer(time.Until(due))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		tenantIDs := make([]string, 0, len(next))
+		for tenantID, at := range next {
+			if !at.After(due) {
+				tenantIDs = append(tenantIDs, tenantID)
+			}
+		}
+		sort.Strings(tenantIDs)
+		for _, tenantID := range tenantIDs {
+			if _, err := s.RunTenant(ctx, tenantID, next[tenantID]); err != nil {
+				s.logger.Warn("scheduled pattern mining failed", slog.String("tenant_id", tenantID), slog.Any("error", err))
+			}
+			next[tenantID] = s.schedules[tenantID].Next(due)
+		}
+	}
+}
+
+// RunTenant mines the tenant's correlations from the lookback window ending at slot. It returns false
+// without error when another replica already claimed the slot.
+func (s *Scheduler) RunTenant(ctx context.Context, tenantID string, slot time.Time) (bool, error) {
+	if s.locker != nil {
+		key := fmt.Sprintf("rca:patterns:mine:%s:%d", tenantID, slot.Unix())
+		claimed, err := s.locker.SetNX(ctx, key, []byte(s.instance), s.lockTTL)
+		if err != nil {
+			return false, fmt.Errorf("claim mining slot: %w", err)
+		}
+		if !claimed {
+			return false, nil
+		}
+	}
+
+	if _, _, err := s.MineWindow(ctx, tenantID, slot.Add(-s.lookback), slot); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// MineWindow mines and stores patterns from the tenant's correlations created between start and end,
+// returning the patterns and how many correlations were analysed. A zero start uses the lookback window.
+func (s *Scheduler) MineWindow(ctx context.Context, tenantID string, start, end time.Time) ([]models.FailurePattern, int, error) {
+	if end.IsZero() {
+		end = s.now()
+	}
+	if start.IsZero() {
+		start = end.Add(-s.lookback)
+	}
+	correlations, err := s.listCorrelations(ctx, tenantID, start, end)
+	if err != nil {
+		return nil, 0, err
+	}
+	mined, err := s.miner.Mine(ctx, tenantID, correlations)
+	if err != nil {
+		return nil, 0, err
+	}
+	s.logger.Info("patterns mined",
+		slog.String("tenant_id", tenantID),
+		slog.Int("correlations", len(correlations)),
+		slog.Int("patterns", len(mined)),
+	)
+	return mined, len(correlations), nil
+}
+
+func (s *Scheduler) listCorrelations(ctx context.Context, tenantID string, start, end time.Time) ([]models.CorrelationResult, error) {
+	var correlations []models.CorrelationResult
+	pageToken := ""
+	for len(correlations) < s.maxCorrelations {
+		resp, err := s.lister.ListCorrelations(ctx, models.ListCorrelationsRequest{
+			TenantID:  tenantID,
+			Start:     start,
+			End:       end,
+			PageSize:  100,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list correlations: %w", err)
+		}
+		correlations = append(correlations, resp.Correlations...)
+		if resp.NextPageToken == "" || resp.NextPageToken == pageToken {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+	if len(correlations) > s.maxCorrelations {
+		correlations = correlations[:s.maxCorrelations]
+	}
+	return correlations, nil
+}
@@ -0,0 +1,88 @@
+package patterns
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+func TestScheduleNext(t *testing.T) {
+	base := time.Date(2024, 6, 1, 10, 7, 30, 0, time.UTC) // Saturday
+	cases := []struct {
+		expr string
+		want time.Time
+	}{
+		{"*/15 * * * *", time.Date(2024, 6, 1, 10, 15, 0, 0, time.UTC)},
+		{"0 */6 * * *", time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)},
+		{"30 2 * * 1-5", time.Date(2024, 6, 3, 2, 30, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)},
+		{"@every 2h", time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range cases {
+		schedule, err := ParseSchedule(tc.expr)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.expr, err)
+		}
+		if got := schedule.Next(base); !got.Equal(tc.want) {
+			t.Fatalf("%s: expected %s, got %s", tc.expr, tc.want, got)
+		}
+	}
+
+	for _, bad := range []string{"* * * *", "61 * * * *", "*/0 * * * *", "@every 10s"} {
+		if _, err := ParseSchedule(bad); err == nil {
+			t.Fatalf("expected %q to be rejected", bad)
+		}
+	}
+}
+
+type stubLister struct {
+	requests []models.ListCorrelationsRequest
+}
+
+func (s *stubLister) ListCorrelations(ctx context.Context, req models.ListCorrelationsRequest) (models.ListCorrelationsResponse, error) {
+	s.requests = append(s.requests, req)
+	return models.ListCorrelationsResponse{Correlations: []models.CorrelationResult{{CorrelationID: "c1", AffectedServices: []string{"checkout"}}}}, nil
+}
+
+type memoryLocker struct {
+	keys map[string]bool
+}
+
+func (m *memoryLocker) SetNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	if m.keys[key] {
+		return false, nil
+	}
+	m.keys[key] = true
+	return true, nil
+}
+
+func TestSchedulerRunTenantClaimsSlotOnce(t *testing.T) {
+	store := &fakePatternStore{}
+	lister := &stubLister{}
+	locker := &memoryLocker{keys: map[string]bool{}}
+	scheduler, err := NewScheduler(nil, NewMiner(nil, store), lister, locker, "@hourly", map[string]string{"acme": ""}, 24*time.Hour, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	slot := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	ran, err := scheduler.RunTenant(context.Background(), "acme", slot)
+	if err != nil || !ran {
+		t.Fatalf("expected first replica to mine, got %v (%v)", ran, err)
+	}
+	if store.stored != 1 || !lister.requests[0].Start.Equal(slot.Add(-24*time.Hour)) {
+		t.Fatalf("unexpected mining run: stored=%d requests=%+v", store.stored, lister.requests)
+	}
+
+	ran, err = scheduler.RunTenant(context.Background(), "acme", slot)
+	if err != nil || ran {
+		t.Fatalf("expected claimed slot to be skipped, got %v (%v)", ran, err)
+	}
+
+	if _, err := NewScheduler(nil, NewMiner(nil, store), lister, locker, "bogus", map[string]string{"acme": ""}, 0, 0); err == nil {
+		t.Fatalf("expected invalid default schedule to be rejected")
+	}
+}
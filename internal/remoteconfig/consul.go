@@ -0,0 +1,98 @@
+package remoteconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ConsulConfig points the watcher at a Consul KV prefix. Wait bounds each blocking query (default 30s).
+type ConsulConfig struct {
+	Address string
+	Prefix  string
+	Token   string
+	Wait    time.Duration
+}
+
+// Consul reads keys with blocking queries, so changes arrive as soon as they are written.
+type Consul struct {
+	cfg        ConsulConfig
+	httpClient *http.Client
+}
+
+// NewConsul builds a Consul source.
+func NewConsul(cfg ConsulConfig) (*Consul, error) {
+	if cfg.Address == "" {
+		return nil, fmt.Errorf("consul address is required")
+	}
+	cfg.Address = strings.TrimRight(cfg.Address, "/")
+	cfg.Prefix = strings.Trim(cfg.Prefix, "/")
+	if cfg.Prefix != "" {
+		cfg.Prefix += "/"
+	}
+	if cfg.Wait <= 0 {
+		cfg.Wait = 30 * time.Second
+	}
+	// The client timeout must outlast the blocking query, which Consul may extend by up to wait/16.
+	return &Consul{cfg: cfg, httpClient: &http.Client{Timeout: cfg.Wait + cfg.Wait/8 + 5*time.Second}}, nil
+}
+
+// Wait implements Source.
+func (c *Consul) Wait(ctx context.Context, index uint64) (Snapshot, error) {
+	query := url.Values{}
+	query.Set("recurse", "true")
+	if index > 0 {
+		query.Set("index", strconv.FormatUint(index, 10))
+		query.Set("wait", fmt.Sprintf("%ds", int(c.cfg.Wait.Seconds())))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.cfg.Address+"/v1/kv/"+c.cfg.Prefix+"?"+query.Encode(), nil)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	if c.cfg.Token != "" {
+		req.Header.Set("X-Consul-Token", c.cfg.Token)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	defer resp.Body.Close()
+
+	snapshot := Snapshot{Values: map[string]string{}}
+	snapshot.Index, _ = strconv.ParseUint(resp.Header.Get("X-Consul-Index"), 10, 64)
+	// Consul asks clients to restart from zero when the index goes backwards.
+	if snapshot.Index < index {
+		snapshot.Index = 0
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return snapshot, nil
+	default:
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return Snapshot{}, fmt.Errorf("consul kv %s: %s: %s", c.cfg.Prefix, resp.Status, strings.TrimSpace(string(data)))
+	}
+
+	var entries []struct {
+		Key   string `json:"Key"`
+		Value []byte `json:"Value"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&entries); err != nil {
+		return Snapshot{}, fmt.Errorf("decode consul response: %w", err)
+	}
+	for _, entry := range entries {
+		key := strings.TrimPrefix(entry.Key, c.cfg.Prefix)
+		// Folders created in the Consul UI appear as keys ending in "/" and carry no setting.
+		if key == "" || strings.HasSuffix(key, "/") {
+			continue
+		}
+		snapshot.Values[key] = string(entry.Value)
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,159 @@
+package remoteconfig
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EtcdConfig points the watcher at an etcd v3 key prefix through its JSON gateway. PollInterval is how often
+// the prefix is re-read (default 15s); Username and Password enable etcd authentication.
+type EtcdConfig struct {
+	Endpoint     string
+	Prefix       string
+	Username     string
+	Password     string
+	PollInterval time.Duration
+	Timeout      time.Duration
+}
+
+// Etcd polls a key range of an etcd v3 cluster.
+type Etcd struct {
+	cfg        EtcdConfig
+	httpClient *http.Client
+
+	mu    sync.Mutex
+	token string
+}
+
+// NewEtcd builds an etcd source.
+func NewEtcd(cfg EtcdConfig) (*Etcd, error) {
+	if cfg.Endpoint == "" {
+		return nil, fmt.Errorf("etcd endpoint is required")
+	}
+	cfg.Endpoint = strings.TrimRight(cfg.Endpoint, "/")
+	cfg.Prefix = strings.Trim(cfg.Prefix, "/")
+	if cfg.Prefix != "" {
+		cfg.Prefix += "/"
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = 15 * time.Second
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 5 * time.Second
+	}
+	return &Etcd{cfg: cfg, httpClient: &http.Client{Timeout: cfg.Timeout}}, nil
+}
+
+// Wait implements Source by sleeping for the poll interval after the first read.
+func (e *Etcd) Wait(ctx context.Context, index uint64) (Snapshot, error) {
+	if index > 0 {
+		sleep(ctx, e.cfg.PollInterval)
+		if ctx.Err() != nil {
+			return Snapshot{}, ctx.Err()
+		}
+	}
+	prefix := []byte(e.cfg.Prefix)
+	var payload struct {
+		Header struct {
+			Revision string `json:"revision"`
+		} `json:"header"`
+		Kvs []struct {
+			Key   []byte `json:"key"`
+			Value []byte `json:"value"`
+		} `json:"kvs"`
+	}
+	if err := e.call(ctx, "/v3/kv/range", map[string][]byte{"key": prefix, "range_end": rangeEnd(prefix)}, &payload); err != nil {
+		return Snapshot{}, err
+	}
+
+	snapshot := Snapshot{Values: map[string]string{}}
+	snapshot.Index, _ = strconv.ParseUint(payload.Header.Revision, 10, 64)
+	for _, kv := range payload.Kvs {
+		snapshot.Values[strings.TrimPrefix(string(kv.Key), e.cfg.Prefix)] = string(kv.Value)
+	}
+	return snapshot, nil
+}
+
+// call posts body to the gateway, authenticating first when credentials are configured. A rejected token is
+// dropped so the next call authenticates again.
+func (e *Etcd) call(ctx context.Context, path string, body, out interface{}) error {
+	token, err := e.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+	status, err := e.post(ctx, path, token, body, out)
+	if status == http.StatusUnauthorized {
+		e.mu.Lock()
+		e.token = ""
+		e.mu.Unlock()
+	}
+	return err
+}
+
+func (e *Etcd) authenticate(ctx context.Context) (string, error) {
+	if e.cfg.Username == "" {
+		return "", nil
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.token != "" {
+		return e.token, nil
+	}
+	var payload struct {
+		Token string `json:"token"`
+	}
+	if _, err := e.post(ctx, "/v3/auth/authenticate", "", map[string]string{"name": e.cfg.Username, "password": e.cfg.Password}, &payload); err != nil {
+		return "", fmt.Errorf("etcd authenticate: %w", err)
+	}
+	e.token = payload.Token
+	return e.token, nil
+}
+
+func (e *Etcd) post(ctx context.Context, path, token string, body, out interface{}) (int, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.cfg.Endpoint+path, bytes.NewReader(data))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", token)
+	}
+	resp, err := e.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return resp.StatusCode, fmt.Errorf("etcd %s: %s: %s", path, resp.Status, strings.TrimSpace(string(data)))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return resp.StatusCode, fmt.Errorf("decode etcd response: %w", err)
+	}
+	return resp.StatusCode, nil
+}
+
+// rangeEnd returns the smallest key greater than every key with the given prefix, as etcd range queries
+// expect; an empty prefix ranges over all keys.
+func rangeEnd(prefix []byte) []byte {
+	end := append([]byte(nil), prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return []byte{0}
+}
@@ -0,0 +1,172 @@
+package remoteconfig
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/miradorstack/mirador-rca/internal/metrics"
+)
+
+// Snapshot is the set of keys below the watched prefix. Keys are relative to the prefix and use "/" to
+// separate YAML path segments; values are YAML.
+type Snapshot struct {
+	// Index is the store's version of the snapshot, handed back to Source.Wait.
+	Index  uint64
+	Values map[string]string
+}
+
+// Source reads keys from a remote store; *Consul and *Etcd implement it.
+type Source interface {
+	// Wait returns the keys once they may have changed since index: immediately for index zero, otherwise
+	// after a blocking query or poll interval.
+	Wait(ctx context.Context, index uint64) (Snapshot, error)
+}
+
+// Watcher keeps a YAML overlay built from the keys of a Source, so that settings such as thresholds and TTLs
+// can be tuned fleet-wide. Only keys at or below one of the allowed paths are used; others are logged and
+// ignored.
+type Watcher struct {
+	logger  *slog.Logger
+	source  Source
+	allowed []string
+	retry   time.Duration
+
+	mu      sync.Mutex
+	index   uint64
+	overlay []byte
+}
+
+// NewWatcher builds a watcher. allowed lists slash-separated YAML paths such as "cache/metricsTTL".
+func NewWatcher(logger *slog.Logger, source Source, allowed []string) *Watcher {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Watcher{logger: logger, source: source, allowed: allowed, retry: 5 * time.Second}
+}
+
+// Overlay returns the YAML document built from the last fetched keys, or nil before the first fetch.
+func (w *Watcher) Overlay() []byte {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.overlay
+}
+
+// Sync fetches the keys once, for example before the first configuration load.
+func (w *Watcher) Sync(ctx context.Context) error {
+	_, err := w.poll(ctx)
+	return err
+}
+
+// Run waits for key changes until ctx is cancelled and calls onChange whenever the overlay changed. Fetch
+// errors are retried; the last overlay stays in effect meanwhile.
+func (w *Watcher) Run(ctx context.Context, onChange func()) {
+	for ctx.Err() == nil {
+		changed, err := w.poll(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				w.logger.Warn("remote config fetch failed", slog.Any("error", err))
+				sleep(ctx, w.retry)
+			}
+			continue
+		}
+		if changed {
+			onChange()
+		}
+	}
+}
+
+func (w *Watcher) poll(ctx context.Context) (bool, error) {
+	w.mu.Lock()
+	index := w.index
+	w.mu.Unlock()
+	snapshot, err := w.source.Wait(ctx, index)
+	metrics.ObserveRemoteConfigFetch(err)
+	if err != nil {
+		return false, err
+	}
+	overlay, ignored, err := BuildOverlay(snapshot.Values, w.allowed)
+	if err != nil {
+		return false, err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.index = snapshot.Index
+	if w.overlay != nil && bytes.Equal(overlay, w.overlay) {
+		return false, nil
+	}
+	if len(ignored) > 0 {
+		w.logger.Warn("ignoring remote config keys that cannot be changed at runtime", slog.Any("keys", ignored))
+	}
+	w.overlay = overlay
+	return true, nil
+}
+
+// BuildOverlay turns keys into a YAML document, nesting each key by its path segments and parsing each value
+// as YAML. Keys outside allowed are returned as ignored.
+func BuildOverlay(values map[string]string, allowed []string) ([]byte, []string, error) {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	root := map[string]interface{}{}
+	var ignored []string
+	for _, key := range keys {
+		path := strings.Trim(key, "/")
+		if path == "" || !isAllowed(path, allowed) {
+			ignored = append(ignored, key)
+			continue
+		}
+		var value interface{}
+		if err := yaml.Unmarshal([]byte(values[key]), &value); err != nil {
+			return nil, nil, fmt.Errorf("remote config key %s: %w", key, err)
+		}
+		segments := strings.Split(path, "/")
+		node := root
+		for _, segment := range segments[:len(segments)-1] {
+			child, ok := node[segment].(map[string]interface{})
+			if !ok {
+				if _, exists := node[segment]; exists {
+					return nil, nil, fmt.Errorf("remote config key %s: %s is already set to a value", key, segment)
+				}
+				child = map[string]interface{}{}
+				node[segment] = child
+			}
+			node = child
+		}
+		node[segments[len(segments)-1]] = value
+	}
+	data, err := yaml.Marshal(root)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, ignored, nil
+}
+
+func isAllowed(path string, allowed []string) bool {
+	for _, prefix := range allowed {
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func sleep(ctx context.Context, d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
@@ -0,0 +1,140 @@
+package remoteconfig
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestBuildOverlayNestsAllowedKeys(t *testing.T) {
+	overlay, ignored, err := BuildOverlay(map[string]string{
+		"cache/metricsTTL":        "2m",
+		"logging/level":           "debug",
+		"watch/targets":           "- {tenantId: acme, service: checkout, minDensity: 0.5}",
+		"server/address":          ":1",
+		"cache/similarIncidents/": "",
+	}, []string{"cache/metricsTTL", "logging/level", "watch/targets"})
+	if err != nil {
+		t.Fatalf("build overlay: %v", err)
+	}
+	var decoded struct {
+		Cache struct {
+			MetricsTTL string `yaml:"metricsTTL"`
+		} `yaml:"cache"`
+		Logging map[string]string `yaml:"logging"`
+		Watch   struct {
+			Targets []map[string]interface{} `yaml:"targets"`
+		} `yaml:"watch"`
+		Server interface{} `yaml:"server"`
+	}
+	if err := yaml.Unmarshal(overlay, &decoded); err != nil {
+		t.Fatalf("decode overlay: %v\n%s", err, overlay)
+	}
+	if decoded.Cache.MetricsTTL != "2m" || decoded.Logging["level"] != "debug" || decoded.Watch.Targets[0]["minDensity"] != 0.5 || decoded.Server != nil {
+		t.Fatalf("unexpected overlay:\n%s", overlay)
+	}
+	if len(ignored) != 2 || ignored[1] != "server/address" {
+		t.Fatalf("expected two ignored keys, got %v", ignored)
+	}
+}
+
+func TestWatcherFollowsConsulBlockingQueries(t *testing.T) {
+	var mu sync.Mutex
+	level := "info"
+	index := 7
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, r.URL.RawQuery)
+		if r.URL.Path != "/v1/kv/mirador-rca/" || r.Header.Get("X-Consul-Token") != "acl" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if r.URL.Query().Get("index") == "7" {
+			// Simulate a write landing while the blocking query waits.
+			level, index = "debug", 8
+		}
+		w.Header().Set("X-Consul-Index", strconv.Itoa(index))
+		_ = json.NewEncoder(w).Encode([]map[string]interface{}{
+			{"Key": "mirador-rca/", "Value": nil},
+			{"Key": "mirador-rca/logging/level", "Value": base64.StdEncoding.EncodeToString([]byte(level))},
+		})
+	}))
+	defer server.Close()
+
+	consul, err := NewConsul(ConsulConfig{Address: server.URL, Prefix: "/mirador-rca/", Token: "acl", Wait: time.Second})
+	if err != nil {
+		t.Fatalf("new consul: %v", err)
+	}
+	watcher := NewWatcher(nil, consul, []string{"logging/level"})
+	if err := watcher.Sync(context.Background()); err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+	if got := string(watcher.Overlay()); got != "logging:\n    level: info\n" {
+		t.Fatalf("unexpected initial overlay %q", got)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changes := 0
+	watcher.Run(ctx, func() {
+		changes++
+		cancel()
+	})
+	if changes != 1 || string(watcher.Overlay()) != "logging:\n    level: debug\n" {
+		t.Fatalf("expected one change to debug, got %d %q", changes, watcher.Overlay())
+	}
+	if !strings.Contains(requests[1], "index=7") || !strings.Contains(requests[1], "wait=1s") {
+		t.Fatalf("expected a blocking query, got %v", requests)
+	}
+}
+
+func TestEtcdRangeWithAuthentication(t *testing.T) {
+	var authCalls int
+	var rangeBody map[string][]byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v3/auth/authenticate":
+			authCalls++
+			_ = json.NewEncoder(w).Encode(map[string]string{"token": "tok"})
+		case "/v3/kv/range":
+			if r.Header.Get("Authorization") != "tok" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_ = json.NewDecoder(r.Body).Decode(&rangeBody)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"header": map[string]string{"revision": "42"},
+				"kvs": []map[string][]byte{
+					{"key": []byte("mirador-rca/cache/metricsTTL"), "value": []byte("90s")},
+				},
+			})
+		}
+	}))
+	defer server.Close()
+
+	etcd, err := NewEtcd(EtcdConfig{Endpoint: server.URL, Prefix: "mirador-rca", Username: "rca", Password: "pw"})
+	if err != nil {
+		t.Fatalf("new etcd: %v", err)
+	}
+	snapshot, err := etcd.Wait(context.Background(), 0)
+	if err != nil {
+		t.Fatalf("wait: %v", err)
+	}
+	if snapshot.Index != 42 || snapshot.Values["cache/metricsTTL"] != "90s" || authCalls != 1 {
+		t.Fatalf("unexpected snapshot %+v after %d auth calls", snapshot, authCalls)
+	}
+	if string(rangeBody["key"]) != "mirador-rca/" || string(rangeBody["range_end"]) != "mirador-rca0" {
+		t.Fatalf("unexpected range %q..%q", rangeBody["key"], rangeBody["range_end"])
+	}
+}
@@ -2,6 +2,7 @@ package repo
 
 import (
 	"context"
+	"strings"
 	"sync"
 	"time"
 
@@ -45,6 +46,27 @@ func (s *stubCache) SetNX(ctx context.Context, key string, value []byte, ttl tim
 	return true, nil
 }
 
+func (s *stubCache) MGet(_ context.Context, keys ...string) (map[string][]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	values := make(map[string][]byte, len(keys))
+	for _, key := range keys {
+		if value, ok := s.store[key]; ok {
+			values[key] = append([]byte(nil), value...)
+		}
+	}
+	return values, nil
+}
+
+func (s *stubCache) MSet(_ context.Context, values map[string][]byte, _ time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, value := range values {
+		s.store[key] = append([]byte(nil), value...)
+	}
+	return nil
+}
+
 func (s *stubCache) Del(_ context.Context, key string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -52,4 +74,17 @@ func (s *stubCache) Del(_ context.Context, key string) error {
 	return nil
 }
 
+func (s *stubCache) DelPrefix(_ context.Context, prefix string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	removed := 0
+	for key := range s.store {
+		if strings.HasPrefix(key, prefix) {
+			delete(s.store, key)
+			removed++
+		}
+	}
+	return removed, nil
+}
+
 func (s *stubCache) Close() error { return nil }
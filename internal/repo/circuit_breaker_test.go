@@ -0,0 +1,67 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreakerTransitions(t *testing.T) {
+	now := time.Unix(0, 0)
+	breaker := NewCircuitBreaker("core", 2, time.Minute)
+	breaker.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if err := breaker.Allow(); err != nil {
+			t.Fatalf("closed breaker rejected call: %v", err)
+		}
+		breaker.Record(false)
+	}
+	if breaker.State() != BreakerOpen {
+		t.Fatalf("expected open after threshold, got %s", breaker.State())
+	}
+	if err := breaker.Allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected ErrCircuitOpen, got %v", err)
+	}
+
+	now = now.Add(time.Minute)
+	if err := breaker.Allow(); err != nil {
+		t.Fatalf("expected half-open probe to pass: %v", err)
+	}
+	if err := breaker.Allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected concurrent probe to be rejected, got %v", err)
+	}
+	breaker.Record(true)
+	if breaker.State() != BreakerClosed {
+		t.Fatalf("expected closed after successful probe, got %s", breaker.State())
+	}
+
+	if NewCircuitBreaker("disabled", 0, time.Minute) != nil {
+		t.Fatalf("expected zero threshold to disable the breaker")
+	}
+}
+
+func TestCoreClientCircuitBreakerShortCircuits(t *testing.T) {
+	calls := 0
+	client := NewMiradorCoreClient("https://example.com", "/metrics", "/logs", "/traces", "/graph", time.Second, nil, 0)
+	client.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+		return &http.Response{StatusCode: http.StatusBadGateway, Body: io.NopCloser(strings.NewReader("down")), Header: make(http.Header)}, nil
+	}))
+	WithCircuitBreaker(NewCircuitBreaker("core", 2, time.Minute))(client)
+
+	for i := 0; i < 3; i++ {
+		_, _ = client.FetchLogEntries(context.Background(), "tenant", "checkout", time.Now().Add(-time.Minute), time.Now())
+	}
+	if calls != 2 {
+		t.Fatalf("expected breaker to stop calls after 2 failures, got %d", calls)
+	}
+	_, err := client.FetchLogEntries(context.Background(), "tenant", "checkout", time.Now().Add(-time.Minute), time.Now())
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected ErrCircuitOpen, got %v", err)
+	}
+}
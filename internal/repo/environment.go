@@ -0,0 +1,25 @@
+package repo
+
+import "context"
+
+type environmentKey struct{}
+
+// WithEnvironment scopes the signal fetches made under ctx to one deployment environment. Every backend
+// filters by it: mirador-core receives it in the request body, the VictoriaMetrics and VictoriaLogs templates
+// substitute it for {environment}, and Jaeger and Tempo match the deployment.environment resource attribute.
+// An empty environment leaves fetches unscoped.
+func WithEnvironment(ctx context.Context, environment string) context.Context {
+	if environment == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, environmentKey{}, environment)
+}
+
+// Environment returns the environment set by WithEnvironment, or "".
+func Environment(ctx context.Context) string {
+	environment, _ := ctx.Value(environmentKey{}).(string)
+	return environment
+}
+
+// environmentAttribute is the OpenTelemetry resource attribute trace backends match environments against.
+const environmentAttribute = "deployment.environment"
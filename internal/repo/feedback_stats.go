@@ -0,0 +1,190 @@
+package repo
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// maxStatsFeedback bounds how much feedback a single stats request aggregates.
+const maxStatsFeedback = 10000
+
+// feedbackSource is implemented by every history backend so FeedbackStats can share one aggregation.
+type feedbackSource interface {
+	ListFeedback(ctx context.Context, req models.ListFeedbackRequest) (models.ListFeedbackResponse, error)
+	correlationsByID(ctx context.Context, tenantID string, ids []string) (map[string]models.CorrelationResult, error)
+	FetchPatterns(ctx context.Context, tenantID, service string) ([]models.FailurePattern, error)
+}
+
+// collectFeedbackStats pages through the requested feedback, joins it with the reviewed correlations and the
+// tenant's mined failure patterns, and aggregates accuracy.
+func collectFeedbackStats(ctx context.Context, src feedbackSource, req models.FeedbackStatsRequest) (models.FeedbackStats, error) {
+	var feedback []models.Feedback
+	pageToken := ""
+	for len(feedback) < maxStatsFeedback {
+		resp, err := src.ListFeedback(ctx, models.ListFeedbackRequest{
+			TenantID:  req.TenantID,
+			Start:     req.Start,
+			End:       req.End,
+			PageSize:  100,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return models.FeedbackStats{}, err
+		}
+		feedback = append(feedback, resp.Feedback...)
+		if resp.NextPageToken == "" || resp.NextPageToken == pageToken {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	seen := make(map[string]struct{}, len(feedback))
+	ids := make([]string, 0, len(feedback))
+	for _, fb := range feedback {
+		if _, ok := seen[fb.CorrelationID]; ok || fb.CorrelationID == "" {
+			continue
+		}
+		seen[fb.CorrelationID] = struct{}{}
+		ids = append(ids, fb.CorrelationID)
+	}
+	correlations := map[string]models.CorrelationResult{}
+	if len(ids) > 0 {
+		var err error
+		if correlations, err = src.correlationsByID(ctx, req.TenantID, ids); err != nil {
+			return models.FeedbackStats{}, err
+		}
+	}
+	patterns, err := src.FetchPatterns(ctx, req.TenantID, "")
+	if err != nil {
+		return models.FeedbackStats{}, err
+	}
+	return computeFeedbackStats(feedback, correlations, patterns, req.Bucket), nil
+}
+
+// computeFeedbackStats aggregates verdicts overall, per affected service, per root-cause category, per failure
+// pattern the reviewed correlation matches, and per time bucket (one day when bucket is non-positive).
+func computeFeedbackStats(feedback []models.Feedback, correlations map[string]models.CorrelationResult, patterns []models.FailurePattern, bucket time.Duration) models.FeedbackStats {
+	if bucket <= 0 {
+		bucket = 24 * time.Hour
+	}
+	stats := models.FeedbackStats{}
+	byService := map[string]*models.AccuracyStat{}
+	byCategory := map[string]*models.AccuracyStat{}
+	byPattern := map[string]*models.AccuracyStat{}
+	byBucket := map[time.Time]*models.AccuracyBucket{}
+
+	count := func(total, correct *int, ok bool) {
+		*total++
+		if ok {
+			*correct++
+		}
+	}
+	for _, fb := range feedback {
+		count(&stats.Total, &stats.Correct, fb.Correct)
+
+		start := fb.SubmittedAt.UTC().Truncate(bucket)
+		b, ok := byBucket[start]
+		if !ok {
+			b = &models.AccuracyBucket{Start: start}
+			byBucket[start] = b
+		}
+		count(&b.Total, &b.Correct, fb.Correct)
+
+		correlation, ok := correlations[fb.CorrelationID]
+		if !ok {
+			continue
+		}
+		for _, service := range correlation.AffectedServices {
+			s, ok := byService[service]
+			if !ok {
+				s = &models.AccuracyStat{Key: service}
+				byService[service] = s
+			}
+			count(&s.Total, &s.Correct, fb.Correct)
+		}
+		if correlation.Category != models.CategoryUnknown {
+			key := string(correlation.Category)
+			c, ok := byCategory[key]
+			if !ok {
+				c = &models.AccuracyStat{Key: key}
+				byCategory[key] = c
+			}
+			count(&c.Total, &c.Correct, fb.Correct)
+		}
+		for _, pattern := range patterns {
+			if !matchesPattern(correlation, pattern) {
+				continue
+			}
+			p, ok := byPattern[pattern.ID]
+			if !ok {
+				p = &models.AccuracyStat{Key: pattern.ID}
+				byPattern[pattern.ID] = p
+			}
+			count(&p.Total, &p.Correct, fb.Correct)
+		}
+	}
+
+	stats.Accuracy = ratio(stats.Correct, stats.Total)
+	stats.ByService = sortedAccuracy(byService)
+	stats.ByCategory = sortedAccuracy(byCategory)
+	stats.ByPattern = sortedAccuracy(byPattern)
+	for _, b := range byBucket {
+		b.Accuracy = ratio(b.Correct, b.Total)
+		stats.ByBucket = append(stats.ByBucket, *b)
+	}
+	sort.Slice(stats.ByBucket, func(i, j int) bool { return stats.ByBucket[i].Start.Before(stats.ByBucket[j].Start) })
+	return stats
+}
+
+// matchesPattern reports whether correlation shows pattern: every service of the pattern carries a red anchor
+// and, when the pattern has anchor templates, one of those anchors has a template's service and selector.
+func matchesPattern(correlation models.CorrelationResult, pattern models.FailurePattern) bool {
+	if len(pattern.Services) == 0 {
+		return false
+	}
+	anchored := map[string]struct{}{}
+	selectors := map[[2]string]struct{}{}
+	for _, anchor := range correlation.RedAnchors {
+		anchored[anchor.Service] = struct{}{}
+		selectors[[2]string{anchor.Service, anchor.Selector}] = struct{}{}
+	}
+	for _, service := range pattern.Services {
+		if _, ok := anchored[service]; !ok {
+			return false
+		}
+	}
+	if len(pattern.AnchorTemplates) == 0 {
+		return true
+	}
+	for _, template := range pattern.AnchorTemplates {
+		if _, ok := selectors[[2]string{template.Service, template.Selector}]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedAccuracy(groups map[string]*models.AccuracyStat) []models.AccuracyStat {
+	out := make([]models.AccuracyStat, 0, len(groups))
+	for _, g := range groups {
+		g.Accuracy = ratio(g.Correct, g.Total)
+		out = append(out, *g)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Total != out[j].Total {
+			return out[i].Total > out[j].Total
+		}
+		return out[i].Key < out[j].Key
+	})
+	return out
+}
+
+func ratio(part, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(part) / float64(total)
+}
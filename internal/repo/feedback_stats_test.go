@@ -0,0 +1,75 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+func TestComputeFeedbackStats(t *testing.T) {
+	day := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	feedback := []models.Feedback{
+		{CorrelationID: "c-1", Correct: true, SubmittedAt: day.Add(time.Hour)},
+		{CorrelationID: "c-1", Correct: false, SubmittedAt: day.Add(2 * time.Hour)},
+		{CorrelationID: "c-2", Correct: true, SubmittedAt: day.Add(25 * time.Hour)},
+		{CorrelationID: "gone", Correct: true, SubmittedAt: day.Add(26 * time.Hour)},
+	}
+	correlations := map[string]models.CorrelationResult{
+		"c-1": {CorrelationID: "c-1", AffectedServices: []string{"payments", "checkout"}, Category: models.CategoryDependency, RedAnchors: []models.RedAnchor{
+			{Service: "payments", Selector: "error_rate"},
+			{Service: "checkout", Selector: "latency_p99"},
+		}},
+		"c-2": {CorrelationID: "c-2", AffectedServices: []string{"payments"}, Category: models.CategoryCapacity, RedAnchors: []models.RedAnchor{
+			{Service: "payments", Selector: "cpu_usage"},
+		}},
+	}
+	patterns := []models.FailurePattern{
+		{ID: "pattern-payments", Services: []string{"payments"}, AnchorTemplates: []models.AnchorTemplate{{Service: "payments", Selector: "error_rate"}}},
+		{ID: "pattern-checkout+payments", Services: []string{"checkout", "payments"}},
+		{ID: "pattern-inventory", Services: []string{"inventory"}},
+	}
+
+	stats := computeFeedbackStats(feedback, correlations, patterns, 0)
+	if stats.Total != 4 || stats.Correct != 3 || stats.Accuracy != 0.75 {
+		t.Fatalf("unexpected totals: %+v", stats)
+	}
+	if len(stats.ByService) != 2 || stats.ByService[0].Key != "payments" || stats.ByService[0].Total != 3 || stats.ByService[0].Correct != 2 {
+		t.Fatalf("unexpected per-service stats: %+v", stats.ByService)
+	}
+	if len(stats.ByCategory) != 2 || stats.ByCategory[0].Key != "dependency_failure" || stats.ByCategory[0].Accuracy != 0.5 {
+		t.Fatalf("unexpected per-category stats: %+v", stats.ByCategory)
+	}
+	// c-2 has a payments anchor but not the templated selector, so only c-1's two verdicts count.
+	if len(stats.ByPattern) != 2 || stats.ByPattern[0].Key != "pattern-checkout+payments" || stats.ByPattern[0].Total != 2 ||
+		stats.ByPattern[1].Key != "pattern-payments" || stats.ByPattern[1].Accuracy != 0.5 {
+		t.Fatalf("unexpected per-pattern stats: %+v", stats.ByPattern)
+	}
+	if len(stats.ByBucket) != 2 || !stats.ByBucket[0].Start.Equal(day) || stats.ByBucket[1].Total != 2 {
+		t.Fatalf("unexpected daily buckets: %+v", stats.ByBucket)
+	}
+}
+
+func TestMemoryRepoFeedbackStats(t *testing.T) {
+	r, _ := NewMemoryRepo("")
+	ctx := context.Background()
+	now := time.Now().UTC()
+	_ = r.StoreCorrelation(ctx, "acme", models.CorrelationResult{CorrelationID: "c-1", AffectedServices: []string{"payments"}, Category: models.CategoryConfig})
+	_ = r.StoreFeedback(ctx, models.Feedback{TenantID: "acme", CorrelationID: "c-1", Correct: true, SubmittedAt: now})
+	_ = r.StoreFeedback(ctx, models.Feedback{TenantID: "acme", CorrelationID: "c-1", Correct: false, SubmittedAt: now.Add(-time.Minute)})
+
+	incorrect := false
+	listed, err := r.ListFeedback(ctx, models.ListFeedbackRequest{TenantID: "acme", Correct: &incorrect})
+	if err != nil || len(listed.Feedback) != 1 || listed.Feedback[0].Correct {
+		t.Fatalf("unexpected filtered feedback: %+v (%v)", listed, err)
+	}
+
+	stats, err := r.FeedbackStats(ctx, models.FeedbackStatsRequest{TenantID: "acme", Bucket: time.Hour})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stats.Total != 2 || len(stats.ByCategory) != 1 || stats.ByCategory[0].Key != "config" || stats.ByService[0].Accuracy != 0.5 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+}
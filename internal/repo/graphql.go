@@ -0,0 +1,42 @@
+package repo
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// gqlString quotes value as a GraphQL string literal. JSON string escaping is a valid subset of GraphQL's,
+// so quotes, backslashes, and control characters in tenant or service names cannot break out of the literal.
+func gqlString(value string) string {
+	quoted, err := json.Marshal(value)
+	if err != nil {
+		return `""`
+	}
+	return string(quoted)
+}
+
+// whereOperand renders a single Weaviate where-filter operand. operator and valueType are fixed by callers;
+// path and value are escaped.
+func whereOperand(path, operator, valueType, value string) string {
+	return fmt.Sprintf(`{path: [%s], operator: %s, %s: %s}`, gqlString(path), operator, valueType, gqlString(value))
+}
+
+// whereAnd renders a where argument requiring every operand to match.
+func whereAnd(operands ...string) string {
+	return fmt.Sprintf("where: { operator: And, operands: [%s] }", strings.Join(operands, ", "))
+}
+
+// whereOperandList renders an operand matching any of values, e.g. for ContainsAny.
+func whereOperandList(path, operator, valueType string, values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, gqlString(value))
+	}
+	return fmt.Sprintf(`{path: [%s], operator: %s, %s: [%s]}`, gqlString(path), operator, valueType, strings.Join(quoted, ", "))
+}
+
+// whereBoolOperand renders an Equal operand against a boolean property.
+func whereBoolOperand(path string, value bool) string {
+	return fmt.Sprintf(`{path: [%s], operator: Equal, valueBoolean: %t}`, gqlString(path), value)
+}
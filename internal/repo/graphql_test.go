@@ -0,0 +1,38 @@
+package repo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+func TestWhereClausesEscapeHostileInput(t *testing.T) {
+	hostile := `acme"}] } ) { Get { FailurePattern { patternId } } } #` + "\n\\"
+
+	where := buildCorrelationWhere(models.ListCorrelationsRequest{TenantID: hostile, Service: `pay"ments`})
+	if !strings.Contains(where, `valueString: "acme\"}] } ) { Get { FailurePattern { patternId } } } #\n\\"`) {
+		t.Fatalf("tenant not escaped: %s", where)
+	}
+	if !strings.Contains(where, `valueString: "pay\"ments"`) {
+		t.Fatalf("service not escaped: %s", where)
+	}
+
+	patterns := buildPatternWhere(hostile, "")
+	if strings.Count(patterns, "{path:") != 1 || strings.Contains(patterns, `acme"}`) {
+		t.Fatalf("unexpected pattern where clause: %s", patterns)
+	}
+}
+
+func TestGQLStringRoundTrips(t *testing.T) {
+	for _, value := range []string{"", "plain", `quote " and \ backslash`, "tab\tnewline\n", "unicode ✓"} {
+		quoted := gqlString(value)
+		if !strings.HasPrefix(quoted, `"`) || !strings.HasSuffix(quoted, `"`) {
+			t.Fatalf("expected quoted literal, got %s", quoted)
+		}
+		inner := quoted[1 : len(quoted)-1]
+		if strings.Contains(strings.ReplaceAll(inner, `\"`, ""), `"`) {
+			t.Fatalf("unescaped quote in %s", quoted)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// HistoryStore abstracts persistence of correlation history, failure patterns, feedback, topology snapshots,
+// and maintenance windows so deployments can choose between Weaviate, SQL, and in-memory backends.
+type HistoryStore interface {
+	StoreCorrelation(ctx context.Context, tenantID string, correlation models.CorrelationResult) error
+	ListCorrelations(ctx context.Context, req models.ListCorrelationsRequest) (models.ListCorrelationsResponse, error)
+	SearchCorrelations(ctx context.Context, req models.SearchCorrelationsRequest) (models.SearchCorrelationsResponse, error)
+	SimilarIncidents(ctx context.Context, tenantID string, symptoms []string, limit int) ([]models.CorrelationResult, error)
+	StorePatterns(ctx context.Context, tenantID string, patterns []models.FailurePattern) error
+	FetchPatterns(ctx context.Context, tenantID, service string) ([]models.FailurePattern, error)
+	StoreFeedback(ctx context.Context, feedback models.Feedback) error
+	ListFeedback(ctx context.Context, req models.ListFeedbackRequest) (models.ListFeedbackResponse, error)
+	FeedbackStats(ctx context.Context, req models.FeedbackStatsRequest) (models.FeedbackStats, error)
+	PurgeTenantData(ctx context.Context, req models.PurgeRequest) (models.PurgeResult, error)
+	UpdateCorrelation(ctx context.Context, req models.UpdateCorrelationRequest) (models.CorrelationResult, error)
+	GetCorrelation(ctx context.Context, tenantID, correlationID string) (models.CorrelationResult, error)
+	StoreTopologySnapshot(ctx context.Context, tenantID string, snapshot models.TopologySnapshot) error
+	TopologySnapshots(ctx context.Context, tenantID, environment string, start, end time.Time) ([]models.TopologySnapshot, error)
+	PurgeTopologySnapshots(ctx context.Context, tenantID string, before time.Time) error
+	StoreMaintenanceWindow(ctx context.Context, window models.MaintenanceWindow) error
+	ListMaintenanceWindows(ctx context.Context, tenantID string) ([]models.MaintenanceWindow, error)
+	DeleteMaintenanceWindow(ctx context.Context, tenantID, id string) (bool, error)
+}
+
+// ErrCorrelationNotFound is returned when a lookup or update targets a correlation the tenant does not have.
+var ErrCorrelationNotFound = errors.New("correlation not found")
+
+var _ HistoryStore = (*WeaviateRepo)(nil)
+
+// correlationSearchText flattens the text fields of a correlation for keyword search backends.
+func correlationSearchText(correlation models.CorrelationResult) string {
+	parts := []string{correlation.RootCause, string(correlation.Category), correlation.Incident.Title, correlation.Incident.Description}
+	parts = append(parts, correlation.AffectedServices...)
+	parts = append(parts, models.RecommendationTexts(correlation.Recommendations)...)
+	for _, anchor := range correlation.RedAnchors {
+		parts = append(parts, anchor.Selector)
+		parts = append(parts, anchor.Evidence.LogLines...)
+	}
+	for _, event := range correlation.Timeline {
+		parts = append(parts, event.Event)
+	}
+	return strings.Join(parts, " ")
+}
+
+// applyCorrelationUpdate sets the requested status and appends annotations, stamping any without a time.
+func applyCorrelationUpdate(correlation *models.CorrelationResult, req models.UpdateCorrelationRequest, now time.Time) {
+	if req.Status != "" {
+		correlation.Status = req.Status
+	}
+	for _, annotation := range req.Annotations {
+		if annotation.CreatedAt.IsZero() {
+			annotation.CreatedAt = now
+		}
+		correlation.Annotations = append(correlation.Annotations, annotation)
+	}
+	for key, value := range req.Labels {
+		if value == "" {
+			delete(correlation.Labels, key)
+			continue
+		}
+		if correlation.Labels == nil {
+			correlation.Labels = make(map[string]string, len(req.Labels))
+		}
+		correlation.Labels[key] = value
+	}
+}
+
+// hasLabels reports whether labels contains every key/value pair in want.
+func hasLabels(labels, want map[string]string) bool {
+	for key, value := range want {
+		if labels[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// labelPairs flattens labels into sorted "key=value" strings for backends without a map type.
+func labelPairs(labels map[string]string) []string {
+	pairs := make([]string, 0, len(labels))
+	for key, value := range labels {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return pairs
+}
+
+// parseLabelPairs reverses labelPairs; entries without "=" are ignored.
+func parseLabelPairs(pairs []string) map[string]string {
+	if len(pairs) == 0 {
+		return nil
+	}
+	labels := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		if key, value, ok := strings.Cut(pair, "="); ok {
+			labels[key] = value
+		}
+	}
+	return labels
+}
@@ -0,0 +1,90 @@
+package repo
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+
+	"github.com/miradorstack/mirador-rca/internal/metrics"
+	"github.com/miradorstack/mirador-rca/internal/tracing"
+)
+
+// instrumentedTransport records per-endpoint request counts and latency for an upstream client, and wraps
+// each request in a client span whose trace context is propagated to the upstream.
+type instrumentedTransport struct {
+	next     http.RoundTripper
+	client   string
+	endpoint func(*http.Request) string
+}
+
+func (t *instrumentedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	endpoint := t.endpoint(req)
+	ctx, span := tracing.StartClient(req.Context(), t.client+" "+endpoint,
+		attribute.String("http.request.method", req.Method),
+		attribute.String("server.address", req.URL.Host),
+		attribute.String("url.path", req.URL.Path),
+	)
+	req = req.Clone(ctx)
+	tracing.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	status := 0
+	if err == nil {
+		status = resp.StatusCode
+		span.SetAttributes(attribute.Int("http.response.status_code", status))
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, resp.Status)
+		}
+	}
+	tracing.End(span, err)
+	metrics.ObserveUpstreamRequest(t.client, endpoint, status, time.Since(start))
+	return resp, err
+}
+
+func withInstrumentation(client *http.Client, name string, endpoint func(*http.Request) string) {
+	if client == nil {
+		return
+	}
+	next := client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	client.Transport = &instrumentedTransport{next: next, client: name, endpoint: endpoint}
+}
+
+func (c *MiradorCoreClient) endpointLabel(req *http.Request) string {
+	for _, candidate := range []struct{ label, target string }{
+		{"metrics", c.metricsURL()},
+		{"logs", c.logsURL()},
+		{"traces", c.tracesURL()},
+		{"service_graph", c.serviceGraphURL()},
+		{"health", c.healthURL()},
+		{"slos", c.slosURL()},
+	} {
+		if u, err := url.Parse(candidate.target); err == nil && u.Path == req.URL.Path {
+			return candidate.label
+		}
+	}
+	return "other"
+}
+
+func weaviateEndpointLabel(req *http.Request) string {
+	switch {
+	case strings.HasSuffix(req.URL.Path, "/v1/graphql"):
+		return "graphql"
+	case strings.HasSuffix(req.URL.Path, "/v1/batch/objects"):
+		return "batch"
+	case strings.Contains(req.URL.Path, "/v1/objects"):
+		return "objects"
+	case strings.HasSuffix(req.URL.Path, "/v1/.well-known/ready"):
+		return "ready"
+	default:
+		return "other"
+	}
+}
@@ -0,0 +1,150 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LokiClient executes LogQL queries against Loki /loki/api/v1/query_range and aggregates the matching lines
+// per minute, message, and severity.
+type LokiClient struct {
+	baseURL       string
+	query         string
+	severityLabel string
+	limit         int
+	headers       map[string]string
+	httpClient    *http.Client
+}
+
+// NewLokiClient constructs a Loki log source. query is a LogQL log query in which {service}, {tenant}, and
+// {environment} are substituted; headers are sent with every request with the same substitution, so
+// X-Scope-OrgID: "{tenant}" selects the tenant of a multi-tenant Loki. severityLabel names the stream label
+// holding the level (default "level", falling back to Loki's detected_level) and limit caps the lines read per
+// request (default 5000).
+func NewLokiClient(baseURL, query, severityLabel string, limit int, headers map[string]string, timeout time.Duration) *LokiClient {
+	if severityLabel == "" {
+		severityLabel = "level"
+	}
+	if limit <= 0 {
+		limit = 5000
+	}
+	return &LokiClient{
+		baseURL:       strings.TrimRight(baseURL, "/"),
+		query:         query,
+		severityLabel: severityLabel,
+		limit:         limit,
+		headers:       headers,
+		httpClient:    &http.Client{Timeout: timeout},
+	}
+}
+
+type lokiResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Stream map[string]string `json:"stream"`
+			Values [][2]string       `json:"values"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// FetchLogEntries runs the LogQL query for the service and window.
+func (c *LokiClient) FetchLogEntries(ctx context.Context, tenantID, service string, start, end time.Time) ([]LogEntry, error) {
+	if c == nil {
+		return nil, fmt.Errorf("loki client not initialised")
+	}
+	if c.baseURL == "" {
+		return nil, fmt.Errorf("loki base URL not configured")
+	}
+	if c.query == "" {
+		return nil, fmt.Errorf("loki query not configured")
+	}
+
+	replacer := strings.NewReplacer("{service}", service, "{tenant}", tenantID, "{environment}", Environment(ctx))
+	params := url.Values{}
+	params.Set("query", replacer.Replace(c.query))
+	params.Set("start", strconv.FormatInt(start.UnixNano(), 10))
+	params.Set("end", strconv.FormatInt(end.UnixNano(), 10))
+	params.Set("limit", strconv.Itoa(c.limit))
+	params.Set("direction", "forward")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/loki/api/v1/query_range?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range c.headers {
+		req.Header.Set(key, replacer.Replace(value))
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("loki request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("loki returned %s", resp.Status)
+	}
+
+	var payload lokiResponse
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return nil, fmt.Errorf("decode loki response: %w", err)
+	}
+	if payload.Status != "success" {
+		return nil, fmt.Errorf("loki query failed: status %s", payload.Status)
+	}
+	if payload.Data.ResultType != "streams" {
+		return nil, fmt.Errorf("loki query returned %s, want a log query returning streams", payload.Data.ResultType)
+	}
+
+	type bucketKey struct {
+		minute   int64
+		message  string
+		severity string
+	}
+	buckets := make(map[bucketKey]*LogEntry)
+	for _, stream := range payload.Data.Result {
+		severity := stream.Stream[c.severityLabel]
+		if severity == "" {
+			severity = stream.Stream["detected_level"]
+		}
+		severity = strings.ToLower(severity)
+		for _, value := range stream.Values {
+			ns, err := strconv.ParseInt(value[0], 10, 64)
+			if err != nil {
+				continue
+			}
+			minute := time.Unix(0, ns).UTC().Truncate(time.Minute)
+			key := bucketKey{minute: minute.Unix(), message: value[1], severity: severity}
+			if entry, ok := buckets[key]; ok {
+				entry.Count++
+				continue
+			}
+			buckets[key] = &LogEntry{Timestamp: minute, Message: key.message, Severity: severity, Count: 1}
+		}
+	}
+
+	entries := make([]LogEntry, 0, len(buckets))
+	for _, entry := range buckets {
+		entries = append(entries, *entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if !entries[i].Timestamp.Equal(entries[j].Timestamp) {
+			return entries[i].Timestamp.Before(entries[j].Timestamp)
+		}
+		if entries[i].Message != entries[j].Message {
+			return entries[i].Message < entries[j].Message
+		}
+		return entries[i].Severity < entries[j].Severity
+	})
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("loki returned no entries")
+	}
+	return entries, nil
+}
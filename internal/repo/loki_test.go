@@ -0,0 +1,52 @@
+package repo
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLokiClientAggregatesStreams(t *testing.T) {
+	client := NewLokiClient("http://loki/", `{service_name="{service}"} |= "upstream"`, "", 0, map[string]string{"X-Scope-OrgID": "{tenant}"}, time.Second)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	client.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/loki/api/v1/query_range" {
+			t.Fatalf("unexpected path: %s", req.URL.Path)
+		}
+		if got := req.URL.Query().Get("query"); got != `{service_name="checkout"} |= "upstream"` {
+			t.Fatalf("unexpected query: %s", got)
+		}
+		if req.URL.Query().Get("start") != "1704067200000000000" || req.Header.Get("X-Scope-OrgID") != "tenant" {
+			t.Fatalf("unexpected request: %s %v", req.URL, req.Header)
+		}
+		body := `{"status":"success","data":{"resultType":"streams","result":[
+			{"stream":{"service_name":"checkout","level":"ERROR"},"values":[
+				["1704067205000000000","upstream 503"],["1704067240000000000","upstream 503"],["1704067270000000000","upstream 503"]]},
+			{"stream":{"service_name":"checkout","detected_level":"warn"},"values":[
+				["1704067210000000000","upstream 503"]]}]}}`
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+	}))
+
+	core := NewMiradorCoreClient("", "", "", "", "", time.Second, nil, 0, WithLogSource(client))
+	entries, err := core.FetchLogEntries(context.Background(), "tenant", "checkout", start, start.Add(5*time.Minute))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 3 || entries[0].Count != 2 || entries[0].Severity != "error" || entries[1].Severity != "warn" || entries[2].Count != 1 {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+}
+
+func TestLokiClientRejectsMetricQueries(t *testing.T) {
+	client := NewLokiClient("http://loki", `sum(rate({service_name="{service}"}[1m]))`, "", 0, nil, time.Second)
+	client.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{"status":"success","data":{"resultType":"matrix","result":[]}}`
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+	}))
+	if _, err := client.FetchLogEntries(context.Background(), "tenant", "checkout", time.Now().Add(-time.Hour), time.Now()); err == nil || !strings.Contains(err.Error(), "matrix") {
+		t.Fatalf("expected a metric query to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,579 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/metrics"
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// MemoryRepo is an embedded history store for local development and integration tests. Data lives in memory
+// and, when a snapshot path is set, is written to a JSON file after every change and reloaded at startup.
+type MemoryRepo struct {
+	mu   sync.RWMutex
+	path string
+	data memorySnapshot
+}
+
+type memorySnapshot struct {
+	Correlations map[string][]models.CorrelationResult `json:"correlations"`
+	Feedback     map[string][]models.Feedback          `json:"feedback"`
+	Patterns     map[string][]models.FailurePattern    `json:"patterns"`
+	Topology     map[string][]models.TopologySnapshot  `json:"topology"`
+	// Maintenance holds the maintenance windows created at runtime.
+	Maintenance map[string][]models.MaintenanceWindow `json:"maintenance"`
+}
+
+// NewMemoryRepo builds an in-memory store. A non-empty path enables snapshot persistence; a missing file is
+// treated as an empty store.
+func NewMemoryRepo(path string) (*MemoryRepo, error) {
+	r := &MemoryRepo{
+		path: path,
+		data: memorySnapshot{
+			Correlations: map[string][]models.CorrelationResult{},
+			Feedback:     map[string][]models.Feedback{},
+			Patterns:     map[string][]models.FailurePattern{},
+			Topology:     map[string][]models.TopologySnapshot{},
+			Maintenance:  map[string][]models.MaintenanceWindow{},
+		},
+	}
+	if path == "" {
+		return r, nil
+	}
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read history snapshot: %w", err)
+	}
+	if err := json.Unmarshal(raw, &r.data); err != nil {
+		return nil, fmt.Errorf("decode history snapshot: %w", err)
+	}
+	if r.data.Correlations == nil {
+		r.data.Correlations = map[string][]models.CorrelationResult{}
+	}
+	if r.data.Feedback == nil {
+		r.data.Feedback = map[string][]models.Feedback{}
+	}
+	if r.data.Patterns == nil {
+		r.data.Patterns = map[string][]models.FailurePattern{}
+	}
+	if r.data.Topology == nil {
+		r.data.Topology = map[string][]models.TopologySnapshot{}
+	}
+	if r.data.Maintenance == nil {
+		r.data.Maintenance = map[string][]models.MaintenanceWindow{}
+	}
+	return r, nil
+}
+
+// StoreCorrelation upserts a correlation by ID.
+func (r *MemoryRepo) StoreCorrelation(ctx context.Context, tenantID string, correlation models.CorrelationResult) error {
+	if correlation.CreatedAt.IsZero() {
+		correlation.CreatedAt = time.Now().UTC()
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	items := r.data.Correlations[tenantID]
+	replaced := false
+	for i := range items {
+		if correlation.CorrelationID != "" && items[i].CorrelationID == correlation.CorrelationID {
+			items[i] = correlation
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		items = append(items, correlation)
+	}
+	r.data.Correlations[tenantID] = items
+	return r.persistLocked()
+}
+
+// ListCorrelations filters the tenant's correlations and pages them newest first using offset tokens.
+func (r *MemoryRepo) ListCorrelations(ctx context.Context, req models.ListCorrelationsRequest) (models.ListCorrelationsResponse, error) {
+	limit := req.PageSize
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	offset := 0
+	if req.PageToken != "" {
+		if v, err := strconv.Atoi(req.PageToken); err == nil && v >= 0 {
+			offset = v
+		}
+	}
+
+	var matched []models.CorrelationResult
+	for _, corr := range r.sortedCorrelations(req.TenantID) {
+		if req.Service != "" && !containsString(corr.AffectedServices, req.Service) {
+			continue
+		}
+		if req.Category != models.CategoryUnknown && corr.Category != req.Category {
+			continue
+		}
+		if !hasLabels(corr.Labels, req.Labels) {
+			continue
+		}
+		if !req.Start.IsZero() && corr.CreatedAt.Before(req.Start) {
+			continue
+		}
+		if !req.End.IsZero() && corr.CreatedAt.After(req.End) {
+			continue
+		}
+		matched = append(matched, corr)
+	}
+
+	if offset >= len(matched) {
+		return models.ListCorrelationsResponse{}, nil
+	}
+	page := matched[offset:]
+	nextToken := ""
+	if len(page) > limit {
+		page = page[:limit]
+		nextToken = strconv.Itoa(offset + limit)
+	}
+	return models.ListCorrelationsResponse{Correlations: page, NextPageToken: nextToken}, nil
+}
+
+// SearchCorrelations scores the tenant's correlations by the fraction of query terms they contain.
+func (r *MemoryRepo) SearchCorrelations(ctx context.Context, req models.SearchCorrelationsRequest) (models.SearchCorrelationsResponse, error) {
+	limit := req.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 10
+	}
+	terms := strings.Fields(strings.ToLower(req.Query))
+	if len(terms) == 0 {
+		return models.SearchCorrelationsResponse{}, nil
+	}
+
+	var results []models.ScoredCorrelation
+	for _, corr := range r.sortedCorrelations(req.TenantID) {
+		text := strings.ToLower(correlationSearchText(corr))
+		matched := 0
+		for _, term := range terms {
+			if strings.Contains(text, term) {
+				matched++
+			}
+		}
+		if matched > 0 {
+			results = append(results, models.ScoredCorrelation{Correlation: corr, Score: float64(matched) / float64(len(terms))})
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Score > results[j].Score })
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return models.SearchCorrelationsResponse{Results: results}, nil
+}
+
+// SimilarIncidents returns the correlations that best match the symptoms, falling back to the most recent.
+func (r *MemoryRepo) SimilarIncidents(ctx context.Context, tenantID string, symptoms []string, limit int) ([]models.CorrelationResult, error) {
+	if limit <= 0 {
+		limit = 3
+	}
+	resp, _ := r.SearchCorrelations(ctx, models.SearchCorrelationsRequest{TenantID: tenantID, Query: strings.Join(symptoms, " "), Limit: limit})
+	if len(resp.Results) > 0 {
+		results := make([]models.CorrelationResult, 0, len(resp.Results))
+		for _, hit := range resp.Results {
+			results = append(results, hit.Correlation)
+		}
+		return results, nil
+	}
+	recent := r.sortedCorrelations(tenantID)
+	if len(recent) > limit {
+		recent = recent[:limit]
+	}
+	return recent, nil
+}
+
+// StorePatterns upserts patterns by ID, falling back to name for unnamed IDs.
+func (r *MemoryRepo) StorePatterns(ctx context.Context, tenantID string, patterns []models.FailurePattern) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	items := r.data.Patterns[tenantID]
+	for _, pattern := range patterns {
+		key := firstNonEmpty(pattern.ID, pattern.Name)
+		replaced := false
+		for i := range items {
+			if firstNonEmpty(items[i].ID, items[i].Name) == key {
+				items[i] = pattern
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			items = append(items, pattern)
+		}
+	}
+	r.data.Patterns[tenantID] = items
+	return r.persistLocked()
+}
+
+// FetchPatterns returns the tenant's patterns, optionally restricted to a service.
+func (r *MemoryRepo) FetchPatterns(ctx context.Context, tenantID, service string) ([]models.FailurePattern, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var patterns []models.FailurePattern
+	for _, pattern := range r.data.Patterns[tenantID] {
+		if service != "" && !containsString(pattern.Services, service) {
+			continue
+		}
+		patterns = append(patterns, pattern)
+	}
+	sort.SliceStable(patterns, func(i, j int) bool { return patterns[i].LastSeen.After(patterns[j].LastSeen) })
+	return patterns, nil
+}
+
+// StoreFeedback records analyst feedback.
+func (r *MemoryRepo) StoreFeedback(ctx context.Context, feedback models.Feedback) error {
+	if feedback.SubmittedAt.IsZero() {
+		feedback.SubmittedAt = time.Now().UTC()
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.data.Feedback[feedback.TenantID] = append(r.data.Feedback[feedback.TenantID], feedback)
+	return r.persistLocked()
+}
+
+// ListFeedback returns the tenant's feedback newest first using offset page tokens.
+func (r *MemoryRepo) ListFeedback(ctx context.Context, req models.ListFeedbackRequest) (models.ListFeedbackResponse, error) {
+	limit := req.PageSize
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	offset := 0
+	if req.PageToken != "" {
+		if v, err := strconv.Atoi(req.PageToken); err == nil && v >= 0 {
+			offset = v
+		}
+	}
+
+	r.mu.RLock()
+	var matched []models.Feedback
+	for _, fb := range r.data.Feedback[req.TenantID] {
+		if req.CorrelationID != "" && fb.CorrelationID != req.CorrelationID {
+			continue
+		}
+		if req.Correct != nil && fb.Correct != *req.Correct {
+			continue
+		}
+		if !req.Start.IsZero() && fb.SubmittedAt.Before(req.Start) {
+			continue
+		}
+		if !req.End.IsZero() && fb.SubmittedAt.After(req.End) {
+			continue
+		}
+		matched = append(matched, fb)
+	}
+	r.mu.RUnlock()
+	sort.SliceStable(matched, func(i, j int) bool { return matched[i].SubmittedAt.After(matched[j].SubmittedAt) })
+
+	if offset >= len(matched) {
+		return models.ListFeedbackResponse{}, nil
+	}
+	page := matched[offset:]
+	nextToken := ""
+	if len(page) > limit {
+		page = page[:limit]
+		nextToken = strconv.Itoa(offset + limit)
+	}
+	return models.ListFeedbackResponse{Feedback: page, NextPageToken: nextToken}, nil
+}
+
+// FeedbackStats aggregates feedback accuracy overall, per service, per root-cause category, per failure
+// pattern, and over time.
+func (r *MemoryRepo) FeedbackStats(ctx context.Context, req models.FeedbackStatsRequest) (models.FeedbackStats, error) {
+	return collectFeedbackStats(ctx, r, req)
+}
+
+func (r *MemoryRepo) correlationsByID(ctx context.Context, tenantID string, ids []string) (map[string]models.CorrelationResult, error) {
+	wanted := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		wanted[id] = struct{}{}
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := make(map[string]models.CorrelationResult, len(ids))
+	for _, correlation := range r.data.Correlations[tenantID] {
+		if _, ok := wanted[correlation.CorrelationID]; ok {
+			out[correlation.CorrelationID] = correlation
+		}
+	}
+	return out, nil
+}
+
+// GetCorrelation returns one of the tenant's correlations.
+func (r *MemoryRepo) GetCorrelation(ctx context.Context, tenantID, correlationID string) (models.CorrelationResult, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, correlation := range r.data.Correlations[tenantID] {
+		if correlation.CorrelationID == correlationID {
+			return correlation, nil
+		}
+	}
+	return models.CorrelationResult{}, ErrCorrelationNotFound
+}
+
+// UpdateCorrelation applies a status change and new annotations to a stored correlation.
+func (r *MemoryRepo) UpdateCorrelation(ctx context.Context, req models.UpdateCorrelationRequest) (models.CorrelationResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	items := r.data.Correlations[req.TenantID]
+	for i := range items {
+		if items[i].CorrelationID != req.CorrelationID {
+			continue
+		}
+		applyCorrelationUpdate(&items[i], req, time.Now().UTC())
+		return items[i], r.persistLocked()
+	}
+	return models.CorrelationResult{}, ErrCorrelationNotFound
+}
+
+// PurgeTenantData deletes tenant history with the same semantics as WeaviateRepo.PurgeTenantData.
+func (r *MemoryRepo) PurgeTenantData(ctx context.Context, req models.PurgeRequest) (models.PurgeResult, error) {
+	result := models.PurgeResult{DryRun: req.DryRun}
+	if req.TenantID == "" {
+		return result, fmt.Errorf("tenant id is required")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var keptCorrelations []models.CorrelationResult
+	for _, corr := range r.data.Correlations[req.TenantID] {
+		if req.Before.IsZero() || corr.CreatedAt.Before(req.Before) {
+			result.Correlations++
+			continue
+		}
+		keptCorrelations = append(keptCorrelations, corr)
+	}
+	var keptFeedback []models.Feedback
+	for _, fb := range r.data.Feedback[req.TenantID] {
+		if req.Before.IsZero() || fb.SubmittedAt.Before(req.Before) {
+			result.Feedback++
+			continue
+		}
+		keptFeedback = append(keptFeedback, fb)
+	}
+	var keptTopology []models.TopologySnapshot
+	for _, snapshot := range r.data.Topology[req.TenantID] {
+		if req.Before.IsZero() || snapshot.CapturedAt.Before(req.Before) {
+			result.TopologySnapshots++
+			continue
+		}
+		keptTopology = append(keptTopology, snapshot)
+	}
+	if req.Before.IsZero() {
+		result.Patterns = len(r.data.Patterns[req.TenantID])
+	}
+
+	metrics.ObservePurge("CorrelationRecord", result.Correlations, req.DryRun)
+	metrics.ObservePurge("CorrelationFeedback", result.Feedback, req.DryRun)
+	metrics.ObservePurge("TopologySnapshot", result.TopologySnapshots, req.DryRun)
+	if req.Before.IsZero() {
+		metrics.ObservePurge("FailurePattern", result.Patterns, req.DryRun)
+	}
+	if req.DryRun {
+		return result, nil
+	}
+
+	r.data.Correlations[req.TenantID] = keptCorrelations
+	r.data.Feedback[req.TenantID] = keptFeedback
+	r.data.Topology[req.TenantID] = keptTopology
+	if req.Before.IsZero() {
+		delete(r.data.Correlations, req.TenantID)
+		delete(r.data.Feedback, req.TenantID)
+		delete(r.data.Patterns, req.TenantID)
+		delete(r.data.Topology, req.TenantID)
+	}
+	return result, r.persistLocked()
+}
+
+// StoreTopologySnapshot records a service graph snapshot, replacing one of the same environment captured at
+// the same time.
+func (r *MemoryRepo) StoreTopologySnapshot(ctx context.Context, tenantID string, snapshot models.TopologySnapshot) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	items := r.data.Topology[tenantID]
+	replaced := false
+	for i := range items {
+		if items[i].Environment == snapshot.Environment && items[i].CapturedAt.Equal(snapshot.CapturedAt) {
+			items[i] = snapshot
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		items = append(items, snapshot)
+	}
+	r.data.Topology[tenantID] = items
+	return r.persistLocked()
+}
+
+// PurgeTopologySnapshots deletes the tenant's snapshots captured before before. The snapshot file is only
+// rewritten when one was deleted.
+func (r *MemoryRepo) PurgeTopologySnapshots(ctx context.Context, tenantID string, before time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	items := r.data.Topology[tenantID]
+	kept := items[:0]
+	for _, snapshot := range items {
+		if !snapshot.CapturedAt.Before(before) {
+			kept = append(kept, snapshot)
+		}
+	}
+	if len(kept) == len(items) {
+		return nil
+	}
+	r.data.Topology[tenantID] = kept
+	return r.persistLocked()
+}
+
+// TopologySnapshots returns the snapshots of environment captured between start and end, oldest first.
+func (r *MemoryRepo) TopologySnapshots(ctx context.Context, tenantID, environment string, start, end time.Time) ([]models.TopologySnapshot, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var snapshots []models.TopologySnapshot
+	for _, snapshot := range r.data.Topology[tenantID] {
+		if snapshot.Environment != environment || snapshot.CapturedAt.Before(start) || snapshot.CapturedAt.After(end) {
+			continue
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	sort.SliceStable(snapshots, func(i, j int) bool { return snapshots[i].CapturedAt.Before(snapshots[j].CapturedAt) })
+	return snapshots, nil
+}
+
+// StoreMaintenanceWindow records a maintenance window, replacing one of the tenant with the same ID.
+func (r *MemoryRepo) StoreMaintenanceWindow(ctx context.Context, window models.MaintenanceWindow) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	items := r.data.Maintenance[window.TenantID]
+	replaced := false
+	for i := range items {
+		if items[i].ID == window.ID {
+			items[i] = window
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		items = append(items, window)
+	}
+	r.data.Maintenance[window.TenantID] = items
+	return r.persistLocked()
+}
+
+// ListMaintenanceWindows returns the tenant's stored maintenance windows ordered by start time.
+func (r *MemoryRepo) ListMaintenanceWindows(ctx context.Context, tenantID string) ([]models.MaintenanceWindow, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	windows := append([]models.MaintenanceWindow(nil), r.data.Maintenance[tenantID]...)
+	sort.SliceStable(windows, func(i, j int) bool { return windows[i].Start.Before(windows[j].Start) })
+	return windows, nil
+}
+
+// DeleteMaintenanceWindow deletes a tenant's maintenance window by ID and reports whether it existed.
+func (r *MemoryRepo) DeleteMaintenanceWindow(ctx context.Context, tenantID, id string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	items := r.data.Maintenance[tenantID]
+	for i := range items {
+		if items[i].ID == id {
+			r.data.Maintenance[tenantID] = append(items[:i:i], items[i+1:]...)
+			return true, r.persistLocked()
+		}
+	}
+	return false, nil
+}
+
+func (r *MemoryRepo) sortedCorrelations(tenantID string) []models.CorrelationResult {
+	r.mu.RLock()
+	items := append([]models.CorrelationResult(nil), r.data.Correlations[tenantID]...)
+	r.mu.RUnlock()
+
+	sort.SliceStable(items, func(i, j int) bool { return items[i].CreatedAt.After(items[j].CreatedAt) })
+	return items
+}
+
+// persistLocked writes the snapshot atomically; callers must hold the write lock.
+func (r *MemoryRepo) persistLocked() error {
+	if r.path == "" {
+		return nil
+	}
+	raw, err := json.Marshal(r.data)
+	if err != nil {
+		return fmt.Errorf("encode history snapshot: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(r.path), filepath.Base(r.path)+".*")
+	if err != nil {
+		return fmt.Errorf("write history snapshot: %w", err)
+	}
+	if _, err := tmp.Write(raw); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("write history snapshot: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("write history snapshot: %w", err)
+	}
+	return os.Rename(tmp.Name(), r.path)
+}
+
+// Tenants lists every tenant with stored correlations, feedback, or topology snapshots.
+func (r *MemoryRepo) Tenants(ctx context.Context) ([]string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	seen := map[string]bool{}
+	for tenantID := range r.data.Correlations {
+		seen[tenantID] = true
+	}
+	for tenantID := range r.data.Feedback {
+		seen[tenantID] = true
+	}
+	for tenantID := range r.data.Topology {
+		seen[tenantID] = true
+	}
+	tenants := make([]string, 0, len(seen))
+	for tenantID := range seen {
+		tenants = append(tenants, tenantID)
+	}
+	sort.Strings(tenants)
+	return tenants, nil
+}
+
+func containsString(values []string, target string) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
+
+var _ HistoryStore = (*MemoryRepo)(nil)
@@ -0,0 +1,117 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+func TestMemoryRepoListAndSearch(t *testing.T) {
+	r, err := NewMemoryRepo("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctx := context.Background()
+	now := time.Now()
+	for i, corr := range []models.CorrelationResult{
+		{CorrelationID: "c-1", RootCause: "payments db lock timeout", AffectedServices: []string{"payments"}, Category: models.CategoryDependency, CreatedAt: now.Add(-2 * time.Hour)},
+		{CorrelationID: "c-2", RootCause: "checkout cpu saturation", AffectedServices: []string{"checkout"}, Category: models.CategoryCapacity, CreatedAt: now.Add(-time.Hour)},
+		{CorrelationID: "c-3", RootCause: "payments pod restarts", AffectedServices: []string{"payments"}, CreatedAt: now},
+	} {
+		if err := r.StoreCorrelation(ctx, "tenant", corr); err != nil {
+			t.Fatalf("store %d: %v", i, err)
+		}
+	}
+
+	first, err := r.ListCorrelations(ctx, models.ListCorrelationsRequest{TenantID: "tenant", Service: "payments", PageSize: 1})
+	if err != nil || len(first.Correlations) != 1 || first.Correlations[0].CorrelationID != "c-3" || first.NextPageToken != "1" {
+		t.Fatalf("unexpected first page: %+v (%v)", first, err)
+	}
+	second, _ := r.ListCorrelations(ctx, models.ListCorrelationsRequest{TenantID: "tenant", Service: "payments", PageSize: 1, PageToken: first.NextPageToken})
+	if len(second.Correlations) != 1 || second.Correlations[0].CorrelationID != "c-1" || second.NextPageToken != "" {
+		t.Fatalf("unexpected second page: %+v", second)
+	}
+
+	search, _ := r.SearchCorrelations(ctx, models.SearchCorrelationsRequest{TenantID: "tenant", Query: "payments lock"})
+	if len(search.Results) != 2 || search.Results[0].Correlation.CorrelationID != "c-1" || search.Results[0].Score != 1 {
+		t.Fatalf("unexpected search results: %+v", search.Results)
+	}
+
+	other, _ := r.ListCorrelations(ctx, models.ListCorrelationsRequest{TenantID: "other"})
+	if len(other.Correlations) != 0 {
+		t.Fatalf("expected tenant isolation, got %+v", other.Correlations)
+	}
+}
+
+func TestMemoryRepoSnapshotAndPurge(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.json")
+	r, err := NewMemoryRepo(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctx := context.Background()
+	old := time.Now().Add(-48 * time.Hour)
+	_ = r.StoreCorrelation(ctx, "tenant", models.CorrelationResult{CorrelationID: "old", CreatedAt: old})
+	_ = r.StoreCorrelation(ctx, "tenant", models.CorrelationResult{CorrelationID: "new"})
+	_ = r.StoreFeedback(ctx, models.Feedback{TenantID: "tenant", CorrelationID: "old", SubmittedAt: old})
+	_ = r.StorePatterns(ctx, "tenant", []models.FailurePattern{{ID: "p1", Services: []string{"payments"}}})
+	edges := []models.TopologyEdge{{Source: "checkout", Target: "payments"}}
+	_ = r.StoreTopologySnapshot(ctx, "tenant", models.TopologySnapshot{CapturedAt: old, Edges: edges})
+	_ = r.StoreTopologySnapshot(ctx, "tenant", models.TopologySnapshot{CapturedAt: time.Now(), Edges: edges})
+
+	reloaded, err := NewMemoryRepo(path)
+	if err != nil {
+		t.Fatalf("reload: %v", err)
+	}
+	if patterns, _ := reloaded.FetchPatterns(ctx, "tenant", "payments"); len(patterns) != 1 {
+		t.Fatalf("expected pattern to survive reload, got %+v", patterns)
+	}
+
+	result, err := reloaded.PurgeTenantData(ctx, models.PurgeRequest{TenantID: "tenant", Before: time.Now().Add(-24 * time.Hour)})
+	if err != nil {
+		t.Fatalf("purge: %v", err)
+	}
+	if result.Correlations != 1 || result.Feedback != 1 || result.Patterns != 0 || result.TopologySnapshots != 1 {
+		t.Fatalf("unexpected purge result: %+v", result)
+	}
+	if snapshots, _ := reloaded.TopologySnapshots(ctx, "tenant", "", old, time.Now()); len(snapshots) != 1 || snapshots[0].Edges[0].Target != "payments" {
+		t.Fatalf("expected only the recent topology snapshot to remain, got %+v", snapshots)
+	}
+	remaining, _ := reloaded.ListCorrelations(ctx, models.ListCorrelationsRequest{TenantID: "tenant"})
+	if len(remaining.Correlations) != 1 || remaining.Correlations[0].CorrelationID != "new" {
+		t.Fatalf("expected only recent correlation to remain, got %+v", remaining.Correlations)
+	}
+}
+
+func TestMemoryRepoLabelsFilterAndUpdate(t *testing.T) {
+	r, _ := NewMemoryRepo("")
+	ctx := context.Background()
+	_ = r.StoreCorrelation(ctx, "tenant", models.CorrelationResult{CorrelationID: "c-1", Labels: map[string]string{"team": "payments", "env": "prod"}})
+	_ = r.StoreCorrelation(ctx, "tenant", models.CorrelationResult{CorrelationID: "c-2", Labels: map[string]string{"team": "payments", "env": "staging"}})
+
+	resp, _ := r.ListCorrelations(ctx, models.ListCorrelationsRequest{TenantID: "tenant", Labels: map[string]string{"team": "payments", "env": "prod"}})
+	if len(resp.Correlations) != 1 || resp.Correlations[0].CorrelationID != "c-1" {
+		t.Fatalf("expected label filter to match c-1, got %+v", resp.Correlations)
+	}
+
+	updated, err := r.UpdateCorrelation(ctx, models.UpdateCorrelationRequest{TenantID: "tenant", CorrelationID: "c-2", Labels: map[string]string{"env": "", "severity": "sev2"}})
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if len(updated.Labels) != 2 || updated.Labels["severity"] != "sev2" || updated.Labels["team"] != "payments" {
+		t.Fatalf("unexpected merged labels: %+v", updated.Labels)
+	}
+	if _, err := r.UpdateCorrelation(ctx, models.UpdateCorrelationRequest{TenantID: "tenant", CorrelationID: "missing", Status: models.CorrelationResolved}); !errors.Is(err, ErrCorrelationNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+	if got, err := r.GetCorrelation(ctx, "tenant", "c-2"); err != nil || got.Labels["severity"] != "sev2" {
+		t.Fatalf("expected the updated correlation, got %+v (%v)", got, err)
+	}
+	if _, err := r.GetCorrelation(ctx, "other", "c-2"); !errors.Is(err, ErrCorrelationNotFound) {
+		t.Fatalf("expected another tenant's lookup to miss, got %v", err)
+	}
+}
@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS rca_correlations (
+    tenant_id         TEXT             NOT NULL,
+    correlation_id    TEXT             NOT NULL,
+    incident_id       TEXT             NOT NULL DEFAULT '',
+    root_cause        TEXT             NOT NULL DEFAULT '',
+    confidence        DOUBLE PRECISION NOT NULL DEFAULT 0,
+    category          TEXT             NOT NULL DEFAULT '',
+    affected_services TEXT[]           NOT NULL DEFAULT '{}',
+    search_text       TEXT             NOT NULL DEFAULT '',
+    created_at        TIMESTAMPTZ      NOT NULL,
+    document          JSONB            NOT NULL,
+    PRIMARY KEY (tenant_id, correlation_id)
+);
+
+CREATE INDEX IF NOT EXISTS rca_correlations_tenant_created_idx ON rca_correlations (tenant_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS rca_correlations_services_idx ON rca_correlations USING GIN (affected_services);
+CREATE INDEX IF NOT EXISTS rca_correlations_search_idx ON rca_correlations USING GIN (to_tsvector('english', search_text));
+
+CREATE TABLE IF NOT EXISTS rca_feedback (
+    id             BIGSERIAL   PRIMARY KEY,
+    tenant_id      TEXT        NOT NULL,
+    correlation_id TEXT        NOT NULL,
+    correct        BOOLEAN     NOT NULL,
+    notes          TEXT        NOT NULL DEFAULT '',
+    submitted_at   TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS rca_feedback_tenant_submitted_idx ON rca_feedback (tenant_id, submitted_at);
+
+CREATE TABLE IF NOT EXISTS rca_patterns (
+    tenant_id  TEXT        NOT NULL,
+    pattern_id TEXT        NOT NULL,
+    services   TEXT[]      NOT NULL DEFAULT '{}',
+    last_seen  TIMESTAMPTZ,
+    document   JSONB       NOT NULL,
+    PRIMARY KEY (tenant_id, pattern_id)
+);
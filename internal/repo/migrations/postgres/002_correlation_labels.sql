@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS rca_correlations_labels_idx ON rca_correlations USING GIN ((document->'Labels') jsonb_path_ops);
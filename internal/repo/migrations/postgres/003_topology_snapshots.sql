@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS rca_topology_snapshots (
+    tenant_id   TEXT        NOT NULL,
+    environment TEXT        NOT NULL DEFAULT '',
+    captured_at TIMESTAMPTZ NOT NULL,
+    edges       JSONB       NOT NULL,
+    PRIMARY KEY (tenant_id, environment, captured_at)
+);
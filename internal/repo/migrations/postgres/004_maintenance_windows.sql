@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS rca_maintenance_windows (
+    tenant_id TEXT        NOT NULL,
+    id        TEXT        NOT NULL,
+    services  TEXT[]      NOT NULL DEFAULT '{}',
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at   TIMESTAMPTZ NOT NULL,
+    reason    TEXT        NOT NULL DEFAULT '',
+    PRIMARY KEY (tenant_id, id)
+);
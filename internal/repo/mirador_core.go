@@ -5,17 +5,21 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/miradorstack/mirador-rca/internal/cache"
 )
 
-// MetricPoint represents a single metric sample returned by mirador-core.
+// MetricPoint represents a single metric sample returned by mirador-core. Name identifies the series the
+// sample belongs to; it is empty for legacy single-series responses.
 type MetricPoint struct {
+	Name      string
 	Timestamp time.Time
 	Value     float64
 }
@@ -54,35 +58,163 @@ type MiradorCoreClient struct {
 	logsPath         string
 	tracesPath       string
 	serviceGraphPath string
+	healthPath       string
+	sloPath          string
 	httpClient       *http.Client
-	cache            cache.Provider
-	serviceGraphTTL  time.Duration
+	cache            *cache.Loader
+	serviceGraphTTL  cacheTTL
+	metricNames      []string
+	baselineOffset   time.Duration
+	auth             CoreAuth
+	pageSize         int
+	maxItems         int
+	metricSource     MetricSource
+	logSource        LogSource
+	traceSource      TraceSource
+	graphSource      ServiceGraphSource
+	resourceMetrics  map[string]string
+	resourceSource   MetricSource
+	metricsTTL       cacheTTL
+	logsTTL          cacheTTL
+	tracesTTL        cacheTTL
 }
 
-// NewMiradorCoreClient constructs a client targeting the configured mirador-core instance.
-func NewMiradorCoreClient(baseURL, metricsPath, logsPath, tracesPath, serviceGraphPath string, timeout time.Duration, cacheProvider cache.Provider, serviceGraphTTL time.Duration) *MiradorCoreClient {
-	if cacheProvider == nil {
-		cacheProvider = cache.NoopProvider{}
+// CoreAuth carries credentials attached to every mirador-core request. A tenant entry in TenantTokens
+// replaces BearerToken for that tenant's calls.
+type CoreAuth struct {
+	BearerToken  string
+	APIKey       string
+	APIKeyHeader string
+	Headers      map[string]string
+	TenantTokens map[string]string
+}
+
+// CoreClientOption customises optional MiradorCoreClient behaviour.
+type CoreClientOption func(*MiradorCoreClient)
+
+// WithMetricNames requests the named metric series (e.g. latency_p95, error_rate) for every investigated service.
+func WithMetricNames(names ...string) CoreClientOption {
+	return func(c *MiradorCoreClient) {
+		for _, name := range names {
+			if strings.TrimSpace(name) != "" {
+				c.metricNames = append(c.metricNames, name)
+			}
+		}
+	}
+}
+
+// WithBaselineOffset enables historical-baseline fetches of the same window shifted back by offset (e.g. 7 days).
+func WithBaselineOffset(offset time.Duration) CoreClientOption {
+	return func(c *MiradorCoreClient) {
+		if offset > 0 {
+			c.baselineOffset = offset
+		}
+	}
+}
+
+// WithAuth authenticates requests against secured mirador-core deployments.
+func WithAuth(auth CoreAuth) CoreClientOption {
+	return func(c *MiradorCoreClient) {
+		if auth.APIKeyHeader == "" {
+			auth.APIKeyHeader = "X-API-Key"
+		}
+		c.auth = auth
 	}
-	return &MiradorCoreClient{
+}
+
+// WithPagination requests logs and traces in pages of pageSize, following next_page_token until the upstream
+// is exhausted or maxItems entries have been accumulated. Zero values leave page size to the server and the
+// result uncapped.
+func WithPagination(pageSize, maxItems int) CoreClientOption {
+	return func(c *MiradorCoreClient) {
+		if pageSize > 0 {
+			c.pageSize = pageSize
+		}
+		if maxItems > 0 {
+			c.maxItems = maxItems
+		}
+	}
+}
+
+// WithSignalCache caches metrics, logs, and traces responses keyed by tenant, service, and the window rounded
+// to the minute, so repeated investigations of the same incident reuse the data. A zero TTL leaves that signal
+// uncached.
+func WithSignalCache(metricsTTL, logsTTL, tracesTTL time.Duration) CoreClientOption {
+	return func(c *MiradorCoreClient) {
+		c.metricsTTL.set(metricsTTL)
+		c.logsTTL.set(logsTTL)
+		c.tracesTTL.set(tracesTTL)
+	}
+}
+
+// WithHealthPath overrides the endpoint Ping requests (default /healthz).
+func WithHealthPath(p string) CoreClientOption {
+	return func(c *MiradorCoreClient) {
+		if p != "" {
+			c.healthPath = p
+		}
+	}
+}
+
+// WithCircuitBreaker short-circuits mirador-core calls while the upstream is failing.
+func WithCircuitBreaker(breaker *CircuitBreaker) CoreClientOption {
+	return func(c *MiradorCoreClient) {
+		withBreaker(c.httpClient, breaker)
+	}
+}
+
+// NewMiradorCoreClient constructs a client targeting the configured mirador-core instance.
+func NewMiradorCoreClient(baseURL, metricsPath, logsPath, tracesPath, serviceGraphPath string, timeout time.Duration, cacheProvider cache.Provider, serviceGraphTTL time.Duration, opts ...CoreClientOption) *MiradorCoreClient {
+	client := &MiradorCoreClient{
 		baseURL:          strings.TrimRight(baseURL, "/"),
 		metricsPath:      metricsPath,
 		logsPath:         logsPath,
 		tracesPath:       tracesPath,
 		serviceGraphPath: serviceGraphPath,
+		healthPath:       "/healthz",
 		httpClient: &http.Client{
 			Timeout: timeout,
 		},
-		cache:           cacheProvider,
-		serviceGraphTTL: serviceGraphTTL,
+		cache: cache.NewLoader(cacheProvider, 0),
+	}
+	client.serviceGraphTTL.set(serviceGraphTTL)
+	withInstrumentation(client.httpClient, "mirador_core", client.endpointLabel)
+	for _, opt := range opts {
+		opt(client)
 	}
+	return client
+}
+
+// SetCacheTTLs changes the service graph and signal cache lifetimes at runtime; entries already cached keep
+// their original expiry.
+func (c *MiradorCoreClient) SetCacheTTLs(serviceGraphTTL, metricsTTL, logsTTL, tracesTTL time.Duration) {
+	c.serviceGraphTTL.set(serviceGraphTTL)
+	c.metricsTTL.set(metricsTTL)
+	c.logsTTL.set(logsTTL)
+	c.tracesTTL.set(tracesTTL)
 }
 
-// FetchMetricSeries queries mirador-core for metric samples.
+// FetchMetricSeries queries mirador-core for metric samples. When metric names are configured they are sent
+// with the request and every returned sample is tagged with the series it belongs to.
 func (c *MiradorCoreClient) FetchMetricSeries(ctx context.Context, tenantID, service string, start, end time.Time) ([]MetricPoint, error) {
 	if c == nil {
 		return nil, fmt.Errorf("mirador-core client not initialised")
 	}
+	return cachedFetch(ctx, c.cache, c.metricsTTL.get(), 0, signalCacheKey("metrics", tenantID, Environment(ctx), service, start, end), func() ([]MetricPoint, error) {
+		return c.fetchMetricSeries(ctx, tenantID, service, start, end)
+	})
+}
+
+func (c *MiradorCoreClient) fetchMetricSeries(ctx context.Context, tenantID, service string, start, end time.Time) ([]MetricPoint, error) {
+	if c.metricSource != nil {
+		return c.metricSource.FetchMetricSeries(ctx, tenantID, service, start, end)
+	}
+	return c.queryMetrics(ctx, tenantID, service, start, end, c.metricNames)
+}
+
+// queryMetrics requests the named series, or the legacy single series when names is empty, from the
+// mirador-core metrics endpoint.
+func (c *MiradorCoreClient) queryMetrics(ctx context.Context, tenantID, service string, start, end time.Time, names []string) ([]MetricPoint, error) {
 	if c.baseURL == "" {
 		return nil, fmt.Errorf("mirador-core base URL not configured")
 	}
@@ -93,21 +225,36 @@ func (c *MiradorCoreClient) FetchMetricSeries(ctx context.Context, tenantID, ser
 		"start":     start.Format(time.RFC3339),
 		"end":       end.Format(time.RFC3339),
 	}
+	setEnvironment(ctx, payload)
+	if len(names) > 0 {
+		payload["metrics"] = names
+	}
 
+	type sample struct {
+		Metric    string    `json:"metric"`
+		Timestamp time.Time `json:"timestamp"`
+		Value     float64   `json:"value"`
+	}
 	var response struct {
-		Series []struct {
-			Timestamp time.Time `json:"timestamp"`
-			Value     float64   `json:"value"`
-		} `json:"series"`
+		Series  []sample `json:"series"`
+		Metrics []struct {
+			Name   string   `json:"name"`
+			Series []sample `json:"series"`
+		} `json:"metrics"`
 	}
 
-	if err := c.postJSON(ctx, c.metricsURL(), payload, &response); err != nil {
+	if err := c.postJSON(ctx, tenantID, c.metricsURL(), payload, &response); err != nil {
 		return nil, fmt.Errorf("mirador-core metrics request failed: %w", err)
 	}
 
 	points := make([]MetricPoint, 0, len(response.Series))
-	for _, sample := range response.Series {
-		points = append(points, MetricPoint{Timestamp: sample.Timestamp, Value: sample.Value})
+	for _, s := range response.Series {
+		points = append(points, MetricPoint{Name: s.Metric, Timestamp: s.Timestamp, Value: s.Value})
+	}
+	for _, named := range response.Metrics {
+		for _, s := range named.Series {
+			points = append(points, MetricPoint{Name: firstNonEmpty(named.Name, s.Metric), Timestamp: s.Timestamp, Value: s.Value})
+		}
 	}
 	if len(points) == 0 {
 		return nil, fmt.Errorf("mirador-core metrics returned no samples")
@@ -115,11 +262,33 @@ func (c *MiradorCoreClient) FetchMetricSeries(ctx context.Context, tenantID, ser
 	return points, nil
 }
 
-// FetchLogEntries queries mirador-core for log aggregates.
+// FetchBaselineMetricSeries fetches the investigation window shifted back by the configured baseline offset.
+// It returns no samples when baseline mode is disabled.
+func (c *MiradorCoreClient) FetchBaselineMetricSeries(ctx context.Context, tenantID, service string, start, end time.Time) ([]MetricPoint, error) {
+	if c == nil || c.baselineOffset <= 0 {
+		return nil, nil
+	}
+	points, err := c.FetchMetricSeries(ctx, tenantID, service, start.Add(-c.baselineOffset), end.Add(-c.baselineOffset))
+	if err != nil {
+		return nil, fmt.Errorf("baseline window: %w", err)
+	}
+	return points, nil
+}
+
+// FetchLogEntries queries mirador-core for log aggregates, served from the cache when WithSignalCache is set.
 func (c *MiradorCoreClient) FetchLogEntries(ctx context.Context, tenantID, service string, start, end time.Time) ([]LogEntry, error) {
 	if c == nil {
 		return nil, fmt.Errorf("mirador-core client not initialised")
 	}
+	return cachedFetch(ctx, c.cache, c.logsTTL.get(), 0, signalCacheKey("logs", tenantID, Environment(ctx), service, start, end), func() ([]LogEntry, error) {
+		return c.fetchLogEntries(ctx, tenantID, service, start, end)
+	})
+}
+
+func (c *MiradorCoreClient) fetchLogEntries(ctx context.Context, tenantID, service string, start, end time.Time) ([]LogEntry, error) {
+	if c.logSource != nil {
+		return c.logSource.FetchLogEntries(ctx, tenantID, service, start, end)
+	}
 	if c.baseURL == "" {
 		return nil, fmt.Errorf("mirador-core base URL not configured")
 	}
@@ -130,28 +299,40 @@ func (c *MiradorCoreClient) FetchLogEntries(ctx context.Context, tenantID, servi
 		"start":     start.Format(time.RFC3339),
 		"end":       end.Format(time.RFC3339),
 	}
+	setEnvironment(ctx, payload)
 
-	var response struct {
-		Entries []struct {
-			Timestamp time.Time `json:"timestamp"`
-			Message   string    `json:"message"`
-			Severity  string    `json:"severity"`
-			Count     int       `json:"count"`
-		} `json:"entries"`
-	}
+	var entries []LogEntry
+	for token := ""; ; {
+		c.setPage(payload, token)
 
-	if err := c.postJSON(ctx, c.logsURL(), payload, &response); err != nil {
-		return nil, fmt.Errorf("mirador-core logs request failed: %w", err)
-	}
+		var response struct {
+			Entries []struct {
+				Timestamp time.Time `json:"timestamp"`
+				Message   string    `json:"message"`
+				Severity  string    `json:"severity"`
+				Count     int       `json:"count"`
+			} `json:"entries"`
+			NextPageToken string `json:"next_page_token"`
+		}
+		if err := c.postJSON(ctx, tenantID, c.logsURL(), payload, &response); err != nil {
+			return nil, fmt.Errorf("mirador-core logs request failed: %w", err)
+		}
 
-	entries := make([]LogEntry, 0, len(response.Entries))
-	for _, e := range response.Entries {
-		entries = append(entries, LogEntry{
-			Timestamp: e.Timestamp,
-			Message:   e.Message,
-			Severity:  e.Severity,
-			Count:     e.Count,
-		})
+		for _, e := range response.Entries {
+			if c.capped(len(entries)) {
+				break
+			}
+			entries = append(entries, LogEntry{
+				Timestamp: e.Timestamp,
+				Message:   e.Message,
+				Severity:  e.Severity,
+				Count:     e.Count,
+			})
+		}
+		if !c.nextPage(response.NextPageToken, token, len(entries)) {
+			break
+		}
+		token = response.NextPageToken
 	}
 	if len(entries) == 0 {
 		return nil, fmt.Errorf("mirador-core logs returned no entries")
@@ -159,11 +340,21 @@ func (c *MiradorCoreClient) FetchLogEntries(ctx context.Context, tenantID, servi
 	return entries, nil
 }
 
-// FetchTraceSpans queries mirador-core for trace span anomalies.
+// FetchTraceSpans queries mirador-core for trace span anomalies, served from the cache when WithSignalCache
+// is set.
 func (c *MiradorCoreClient) FetchTraceSpans(ctx context.Context, tenantID, service string, start, end time.Time) ([]TraceSpan, error) {
 	if c == nil {
 		return nil, fmt.Errorf("mirador-core client not initialised")
 	}
+	return cachedFetch(ctx, c.cache, c.tracesTTL.get(), 0, signalCacheKey("traces", tenantID, Environment(ctx), service, start, end), func() ([]TraceSpan, error) {
+		return c.fetchTraceSpans(ctx, tenantID, service, start, end)
+	})
+}
+
+func (c *MiradorCoreClient) fetchTraceSpans(ctx context.Context, tenantID, service string, start, end time.Time) ([]TraceSpan, error) {
+	if c.traceSource != nil {
+		return c.traceSource.FetchTraceSpans(ctx, tenantID, service, start, end)
+	}
 	if c.baseURL == "" {
 		return nil, fmt.Errorf("mirador-core base URL not configured")
 	}
@@ -174,35 +365,46 @@ func (c *MiradorCoreClient) FetchTraceSpans(ctx context.Context, tenantID, servi
 		"start":     start.Format(time.RFC3339),
 		"end":       end.Format(time.RFC3339),
 	}
+	setEnvironment(ctx, payload)
+
+	var spans []TraceSpan
+	for token := ""; ; {
+		c.setPage(payload, token)
+
+		var response struct {
+			Spans []struct {
+				TraceID    string    `json:"trace_id"`
+				SpanID     string    `json:"span_id"`
+				Service    string    `json:"service"`
+				Operation  string    `json:"operation"`
+				DurationMs float64   `json:"duration_ms"`
+				Status     string    `json:"status"`
+				Timestamp  time.Time `json:"timestamp"`
+			} `json:"spans"`
+			NextPageToken string `json:"next_page_token"`
+		}
+		if err := c.postJSON(ctx, tenantID, c.tracesURL(), payload, &response); err != nil {
+			return nil, fmt.Errorf("mirador-core traces request failed: %w", err)
+		}
 
-	var response struct {
-		Spans []struct {
-			TraceID    string    `json:"trace_id"`
-			SpanID     string    `json:"span_id"`
-			Service    string    `json:"service"`
-			Operation  string    `json:"operation"`
-			DurationMs float64   `json:"duration_ms"`
-			Status     string    `json:"status"`
-			Timestamp  time.Time `json:"timestamp"`
-		} `json:"spans"`
-	}
-
-	if err := c.postJSON(ctx, c.tracesURL(), payload, &response); err != nil {
-		return nil, fmt.Errorf("mirador-core traces request failed: %w", err)
-	}
-
-	spans := make([]TraceSpan, 0, len(response.Spans))
-	for _, span := range response.Spans {
-		duration := time.Duration(span.DurationMs * float64(time.Millisecond))
-		spans = append(spans, TraceSpan{
-			TraceID:   span.TraceID,
-			SpanID:    span.SpanID,
-			Service:   firstNonEmpty(span.Service, service),
-			Operation: span.Operation,
-			Duration:  duration,
-			Status:    span.Status,
-			Timestamp: span.Timestamp,
-		})
+		for _, span := range response.Spans {
+			if c.capped(len(spans)) {
+				break
+			}
+			spans = append(spans, TraceSpan{
+				TraceID:   span.TraceID,
+				SpanID:    span.SpanID,
+				Service:   firstNonEmpty(span.Service, service),
+				Operation: span.Operation,
+				Duration:  time.Duration(span.DurationMs * float64(time.Millisecond)),
+				Status:    span.Status,
+				Timestamp: span.Timestamp,
+			})
+		}
+		if !c.nextPage(response.NextPageToken, token, len(spans)) {
+			break
+		}
+		token = response.NextPageToken
 	}
 	if len(spans) == 0 {
 		return nil, fmt.Errorf("mirador-core traces returned no spans")
@@ -210,31 +412,50 @@ func (c *MiradorCoreClient) FetchTraceSpans(ctx context.Context, tenantID, servi
 	return spans, nil
 }
 
+// ServiceGraphSource fetches a tenant's service dependency edges. MiradorCoreClient implements it natively;
+// other backends plug in through WithServiceGraphSource.
+type ServiceGraphSource interface {
+	FetchServiceGraph(ctx context.Context, tenantID string, start, end time.Time) ([]ServiceGraphEdge, error)
+}
+
+// WithServiceGraphSource routes service graph fetches to source instead of the mirador-core RCA endpoint.
+func WithServiceGraphSource(source ServiceGraphSource) CoreClientOption {
+	return func(c *MiradorCoreClient) {
+		c.graphSource = source
+	}
+}
+
 // FetchServiceGraph retrieves service dependency edges derived from servicegraph metrics.
 func (c *MiradorCoreClient) FetchServiceGraph(ctx context.Context, tenantID string, start, end time.Time) ([]ServiceGraphEdge, error) {
 	if c == nil {
 		return nil, fmt.Errorf("mirador-core client not initialised")
 	}
-	if c.baseURL == "" {
+	if c.graphSource == nil && c.baseURL == "" {
 		return nil, fmt.Errorf("mirador-core base URL not configured")
 	}
 
-	cacheKey := ""
-	if c.serviceGraphTTL > 0 {
-		cacheKey = serviceGraphCacheKey(tenantID, start, end)
-		if data, err := c.cache.Get(ctx, cacheKey); err == nil {
-			var cached []ServiceGraphEdge
-			if err := json.Unmarshal(data, &cached); err == nil {
-				return cached, nil
-			}
-		}
+	edges, err := cachedFetch(ctx, c.cache, c.serviceGraphTTL.get(), 0, serviceGraphCacheKey(tenantID, Environment(ctx), start, end), func() ([]ServiceGraphEdge, error) {
+		return c.fetchServiceGraph(ctx, tenantID, start, end)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(edges) == 0 {
+		return nil, fmt.Errorf("mirador-core service graph returned no edges")
 	}
+	return edges, nil
+}
 
+func (c *MiradorCoreClient) fetchServiceGraph(ctx context.Context, tenantID string, start, end time.Time) ([]ServiceGraphEdge, error) {
+	if c.graphSource != nil {
+		return c.graphSource.FetchServiceGraph(ctx, tenantID, start, end)
+	}
 	payload := map[string]interface{}{
 		"tenant_id": tenantID,
 		"start":     start.Format(time.RFC3339),
 		"end":       end.Format(time.RFC3339),
 	}
+	setEnvironment(ctx, payload)
 
 	var response struct {
 		Edges []struct {
@@ -245,7 +466,7 @@ func (c *MiradorCoreClient) FetchServiceGraph(ctx context.Context, tenantID stri
 		} `json:"edges"`
 	}
 
-	if err := c.postJSON(ctx, c.serviceGraphURL(), payload, &response); err != nil {
+	if err := c.postJSON(ctx, tenantID, c.serviceGraphURL(), payload, &response); err != nil {
 		return nil, fmt.Errorf("mirador-core service graph request failed: %w", err)
 	}
 
@@ -258,26 +479,99 @@ func (c *MiradorCoreClient) FetchServiceGraph(ctx context.Context, tenantID stri
 			ErrorRate: edge.ErrorRate,
 		})
 	}
+	return edges, nil
+}
+
+func (c *MiradorCoreClient) setPage(payload map[string]interface{}, token string) {
+	if c.pageSize > 0 {
+		payload["page_size"] = c.pageSize
+	}
+	if token != "" {
+		payload["page_token"] = token
+	} else {
+		delete(payload, "page_token")
+	}
+}
+
+func (c *MiradorCoreClient) capped(count int) bool {
+	return c.maxItems > 0 && count >= c.maxItems
+}
+
+// nextPage reports whether another page should be requested; a repeated token is treated as the end to
+// guard against servers that echo the cursor back.
+func (c *MiradorCoreClient) nextPage(next, current string, count int) bool {
+	return next != "" && next != current && !c.capped(count)
+}
 
-	if c.serviceGraphTTL > 0 && cacheKey != "" {
-		if payload, err := json.Marshal(edges); err == nil {
-			_ = c.cache.Set(ctx, cacheKey, payload, c.serviceGraphTTL)
+// cacheTTL is a cache lifetime that configuration reloads can change while requests read it.
+type cacheTTL struct {
+	nanos atomic.Int64
+}
+
+func (t *cacheTTL) get() time.Duration { return time.Duration(t.nanos.Load()) }
+
+func (t *cacheTTL) set(ttl time.Duration) { t.nanos.Store(int64(ttl)) }
+
+// cachedFetch serves fetch through the loader when ttl is positive, so concurrent misses in this process and
+// across replicas share one upstream call. Empty results are cached for negativeTTL (capped at ttl) so that
+// repeated lookups during an incident do not re-run identical empty queries; zero leaves them uncached.
+func cachedFetch[T any](ctx context.Context, loader *cache.Loader, ttl, negativeTTL time.Duration, key string, fetch func() ([]T, error)) ([]T, error) {
+	if ttl <= 0 {
+		return fetch()
+	}
+	if negativeTTL > ttl {
+		negativeTTL = ttl
+	}
+	var fresh []T
+	fetched := false
+	data, err := loader.Load(ctx, key, func(context.Context) ([]byte, time.Duration, error) {
+		values, err := fetch()
+		if err != nil {
+			return nil, 0, err
 		}
+		fresh, fetched = values, true
+		if len(values) == 0 {
+			return []byte("[]"), negativeTTL, nil
+		}
+		payload, err := json.Marshal(values)
+		return payload, ttl, err
+	})
+	if err != nil {
+		return nil, err
 	}
-	if len(edges) == 0 {
-		return nil, fmt.Errorf("mirador-core service graph returned no edges")
+	if fetched {
+		return fresh, nil
 	}
-	return edges, nil
+	var cached []T
+	if err := json.Unmarshal(data, &cached); err != nil {
+		return fetch()
+	}
+	if len(cached) == 0 {
+		return nil, nil
+	}
+	return cached, nil
+}
+
+func signalCacheKey(kind, tenantID, environment, service string, start, end time.Time) string {
+	return fmt.Sprintf("%s:%s:%s:%s:%d:%d", kind, tenantID, environment, service, start.Truncate(time.Minute).Unix(), end.Truncate(time.Minute).Unix())
+}
+
+func serviceGraphCacheKey(tenantID, environment string, start, end time.Time) string {
+	return fmt.Sprintf("servicegraph:%s:%s:%d:%d", tenantID, environment, start.Unix(), end.Unix())
 }
 
-func serviceGraphCacheKey(tenantID string, start, end time.Time) string {
-	return fmt.Sprintf("servicegraph:%s:%d:%d", tenantID, start.Unix(), end.Unix())
+// setEnvironment adds the environment fetches under ctx are scoped to, if any, to a mirador-core payload.
+func setEnvironment(ctx context.Context, payload map[string]interface{}) {
+	if environment := Environment(ctx); environment != "" {
+		payload["environment"] = environment
+	}
 }
 
 func (c *MiradorCoreClient) metricsURL() string      { return c.resolvePath(c.metricsPath) }
 func (c *MiradorCoreClient) logsURL() string         { return c.resolvePath(c.logsPath) }
 func (c *MiradorCoreClient) tracesURL() string       { return c.resolvePath(c.tracesPath) }
 func (c *MiradorCoreClient) serviceGraphURL() string { return c.resolvePath(c.serviceGraphPath) }
+func (c *MiradorCoreClient) healthURL() string       { return c.resolvePath(c.healthPath) }
 
 func (c *MiradorCoreClient) resolvePath(p string) string {
 	if c.baseURL == "" {
@@ -292,7 +586,33 @@ func (c *MiradorCoreClient) resolvePath(p string) string {
 	return u.String()
 }
 
-func (c *MiradorCoreClient) postJSON(ctx context.Context, endpoint string, payload any, out any) error {
+// Ping checks that mirador-core is reachable and healthy by requesting its health endpoint; it goes through
+// the circuit breaker, so an open breaker reports the upstream as down without a round trip.
+func (c *MiradorCoreClient) Ping(ctx context.Context) error {
+	endpoint := c.healthURL()
+	if endpoint == "" {
+		return fmt.Errorf("empty endpoint")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	c.authorize(req, "")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("mirador-core returned %s", resp.Status)
+	}
+	return nil
+}
+
+func (c *MiradorCoreClient) postJSON(ctx context.Context, tenantID, endpoint string, payload any, out any) error {
 	if endpoint == "" {
 		return fmt.Errorf("empty endpoint")
 	}
@@ -305,6 +625,7 @@ func (c *MiradorCoreClient) postJSON(ctx context.Context, endpoint string, paylo
 		return err
 	}
 	req.Header.Set("Content-Type", "application/json")
+	c.authorize(req, tenantID)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
@@ -325,6 +646,22 @@ func (c *MiradorCoreClient) postJSON(ctx context.Context, endpoint string, paylo
 	return nil
 }
 
+func (c *MiradorCoreClient) authorize(req *http.Request, tenantID string) {
+	for key, value := range c.auth.Headers {
+		req.Header.Set(key, value)
+	}
+	if c.auth.APIKey != "" {
+		req.Header.Set(c.auth.APIKeyHeader, c.auth.APIKey)
+	}
+	token := c.auth.BearerToken
+	if tenantToken, ok := c.auth.TenantTokens[tenantID]; ok && tenantToken != "" {
+		token = tenantToken
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+}
+
 func firstNonEmpty(values ...string) string {
 	for _, v := range values {
 		if strings.TrimSpace(v) != "" {
@@ -62,3 +62,220 @@ func TestFetchServiceGraphCachesResults(t *testing.T) {
 		t.Fatalf("unexpected cached payload: %+v", cached)
 	}
 }
+
+func TestFetchMetricSeriesNamedMetrics(t *testing.T) {
+	client := NewMiradorCoreClient("https://example.com", "/metrics", "/logs", "/traces", "/graph", time.Second, nil, 0, WithMetricNames("latency_p95", "error_rate"))
+	client.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		var payload map[string]any
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode payload: %v", err)
+		}
+		if names, ok := payload["metrics"].([]any); !ok || len(names) != 2 {
+			t.Fatalf("expected metric names in payload, got %v", payload["metrics"])
+		}
+		body := []byte(`{"metrics":[{"name":"latency_p95","series":[{"timestamp":"2024-01-02T15:04:05Z","value":120}]},{"name":"error_rate","series":[{"timestamp":"2024-01-02T15:04:05Z","value":0.2}]}]}`)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body)), Header: make(http.Header)}, nil
+	}))
+
+	points, err := client.FetchMetricSeries(context.Background(), "tenant", "checkout", time.Now().Add(-time.Minute), time.Now())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(points) != 2 || points[0].Name != "latency_p95" || points[1].Name != "error_rate" {
+		t.Fatalf("unexpected points: %+v", points)
+	}
+}
+
+func TestFetchBaselineMetricSeriesShiftsWindow(t *testing.T) {
+	end := time.Date(2024, 1, 9, 12, 0, 0, 0, time.UTC)
+	start := end.Add(-15 * time.Minute)
+
+	client := NewMiradorCoreClient("https://example.com", "/metrics", "/logs", "/traces", "/graph", time.Second, nil, 0, WithBaselineOffset(7*24*time.Hour))
+	client.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		var payload map[string]any
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode payload: %v", err)
+		}
+		if payload["start"] != "2024-01-02T11:45:00Z" || payload["end"] != "2024-01-02T12:00:00Z" {
+			t.Fatalf("expected window shifted by a week, got %v - %v", payload["start"], payload["end"])
+		}
+		body := []byte(`{"series":[{"timestamp":"2024-01-02T11:50:00Z","value":42}]}`)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body)), Header: make(http.Header)}, nil
+	}))
+
+	points, err := client.FetchBaselineMetricSeries(context.Background(), "tenant", "checkout", start, end)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(points) != 1 || points[0].Value != 42 {
+		t.Fatalf("unexpected baseline points: %+v", points)
+	}
+
+	disabled := NewMiradorCoreClient("https://example.com", "/metrics", "/logs", "/traces", "/graph", time.Second, nil, 0)
+	if points, err := disabled.FetchBaselineMetricSeries(context.Background(), "tenant", "checkout", start, end); err != nil || points != nil {
+		t.Fatalf("expected disabled baseline to return nothing, got %v %v", points, err)
+	}
+}
+
+func TestMiradorCoreClientAppliesAuth(t *testing.T) {
+	client := NewMiradorCoreClient("https://example.com", "/metrics", "/logs", "/traces", "/graph", time.Second, nil, 0,
+		WithAuth(CoreAuth{
+			BearerToken:  "static",
+			APIKey:       "key-1",
+			Headers:      map[string]string{"X-Org": "acme"},
+			TenantTokens: map[string]string{"tenant-b": "tenant-token"},
+		}))
+	var got http.Header
+	client.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		got = req.Header.Clone()
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader([]byte(`{"entries":[{"timestamp":"2024-01-01T00:00:00Z","message":"ok","severity":"info","count":1}]}`))), Header: make(http.Header)}, nil
+	}))
+
+	start := time.Unix(1_700_000_000, 0)
+	if _, err := client.FetchLogEntries(context.Background(), "tenant-a", "checkout", start, start.Add(time.Minute)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Get("Authorization") != "Bearer static" || got.Get("X-API-Key") != "key-1" || got.Get("X-Org") != "acme" {
+		t.Fatalf("unexpected headers: %v", got)
+	}
+
+	if _, err := client.FetchLogEntries(context.Background(), "tenant-b", "checkout", start, start.Add(time.Minute)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Get("Authorization") != "Bearer tenant-token" {
+		t.Fatalf("expected tenant token, got %q", got.Get("Authorization"))
+	}
+}
+
+func TestMiradorCoreClientPing(t *testing.T) {
+	client := NewMiradorCoreClient("https://example.com/core", "/metrics", "/logs", "/traces", "/graph", time.Second, nil, 0,
+		WithHealthPath("/api/v1/health"))
+	status := http.StatusOK
+	var gotPath string
+	client.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		gotPath = req.URL.Path
+		return &http.Response{StatusCode: status, Status: http.StatusText(status), Body: io.NopCloser(bytes.NewReader(nil)), Header: make(http.Header)}, nil
+	}))
+
+	if err := client.Ping(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotPath != "/core/api/v1/health" {
+		t.Fatalf("unexpected health path %q", gotPath)
+	}
+	status = http.StatusServiceUnavailable
+	if err := client.Ping(context.Background()); err == nil {
+		t.Fatalf("expected an unhealthy upstream to fail the ping")
+	}
+}
+
+func TestFetchTraceSpansFollowsPagesUpToCap(t *testing.T) {
+	client := NewMiradorCoreClient("https://example.com", "/metrics", "/logs", "/traces", "/graph", time.Second, nil, 0, WithPagination(2, 3))
+	var tokens []string
+	client.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		var payload map[string]any
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode payload: %v", err)
+		}
+		if payload["page_size"] != float64(2) {
+			t.Fatalf("expected page_size 2, got %v", payload["page_size"])
+		}
+		token, _ := payload["page_token"].(string)
+		tokens = append(tokens, token)
+		body := `{"spans":[{"trace_id":"a","duration_ms":10},{"trace_id":"b","duration_ms":10}],"next_page_token":"p2"}`
+		if token == "p2" {
+			body = `{"spans":[{"trace_id":"c","duration_ms":10},{"trace_id":"d","duration_ms":10}],"next_page_token":"p3"}`
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader([]byte(body))), Header: make(http.Header)}, nil
+	}))
+
+	start := time.Unix(1_700_000_000, 0)
+	spans, err := client.FetchTraceSpans(context.Background(), "tenant", "checkout", start, start.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tokens) != 2 || tokens[0] != "" || tokens[1] != "p2" {
+		t.Fatalf("unexpected page requests: %v", tokens)
+	}
+	if len(spans) != 3 || spans[2].TraceID != "c" {
+		t.Fatalf("expected 3 capped spans, got %+v", spans)
+	}
+}
+
+func TestFetchLogEntriesCachesRoundedWindow(t *testing.T) {
+	hits := 0
+	client := NewMiradorCoreClient("https://example.com", "/metrics", "/logs", "/traces", "/graph", time.Second, newStubCache(), 0,
+		WithSignalCache(0, time.Minute, 0))
+	client.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		hits++
+		body := `{"entries":[{"timestamp":"2024-01-01T00:00:00Z","message":"timeout","severity":"error","count":4}]}`
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader([]byte(body))), Header: make(http.Header)}, nil
+	}))
+
+	start := time.Date(2024, 1, 1, 0, 0, 10, 0, time.UTC)
+	ctx := context.Background()
+	if _, err := client.FetchLogEntries(ctx, "tenant", "checkout", start, start.Add(10*time.Minute)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	entries, err := client.FetchLogEntries(ctx, "tenant", "checkout", start.Add(20*time.Second), start.Add(10*time.Minute+20*time.Second))
+	if err != nil {
+		t.Fatalf("unexpected cached error: %v", err)
+	}
+	if hits != 1 {
+		t.Fatalf("expected the same rounded window to hit the cache, got %d upstream calls", hits)
+	}
+	if len(entries) != 1 || entries[0].Count != 4 {
+		t.Fatalf("unexpected cached entries: %+v", entries)
+	}
+	if _, err := client.FetchTraceSpans(ctx, "tenant", "checkout", start, start.Add(10*time.Minute)); err == nil {
+		t.Fatalf("expected uncached traces request to reach upstream")
+	}
+	if hits != 2 {
+		t.Fatalf("traces should bypass the cache, got %d upstream calls", hits)
+	}
+}
+
+func TestFetchLogEntriesScopesEnvironment(t *testing.T) {
+	var environments []any
+	client := NewMiradorCoreClient("https://example.com", "/metrics", "/logs", "/traces", "/graph", time.Second, newStubCache(), 0,
+		WithSignalCache(0, time.Minute, 0))
+	client.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		var payload map[string]any
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode payload: %v", err)
+		}
+		environments = append(environments, payload["environment"])
+		body := `{"entries":[{"timestamp":"2024-01-01T00:00:00Z","message":"timeout","severity":"error","count":4}]}`
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader([]byte(body))), Header: make(http.Header)}, nil
+	}))
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, environment := range []string{"prod", "staging", "prod", ""} {
+		ctx := WithEnvironment(context.Background(), environment)
+		if _, err := client.FetchLogEntries(ctx, "tenant", "checkout", start, start.Add(10*time.Minute)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if len(environments) != 3 || environments[0] != "prod" || environments[1] != "staging" || environments[2] != nil {
+		t.Fatalf("expected one upstream call per environment, each scoped to it, got %v", environments)
+	}
+}
+
+func TestEndpointLabels(t *testing.T) {
+	client := NewMiradorCoreClient("https://example.com/core", "/api/v1/rca/metrics", "/api/v1/rca/logs", "/api/v1/rca/traces", "/api/v1/rca/service-graph", time.Second, nil, 0)
+	cases := map[string]string{
+		"https://example.com/core/api/v1/rca/metrics":       "metrics",
+		"https://example.com/core/api/v1/rca/service-graph": "service_graph",
+		"https://example.com/core/unknown":                  "other",
+	}
+	for target, want := range cases {
+		req, _ := http.NewRequest(http.MethodPost, target, nil)
+		if got := client.endpointLabel(req); got != want {
+			t.Fatalf("%s: expected %s, got %s", target, want, got)
+		}
+	}
+	req, _ := http.NewRequest(http.MethodPost, "https://weaviate/v1/objects", nil)
+	if got := weaviateEndpointLabel(req); got != "objects" {
+		t.Fatalf("expected objects, got %s", got)
+	}
+}
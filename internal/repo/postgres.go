@@ -0,0 +1,656 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/miradorstack/mirador-rca/internal/metrics"
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+//go:embed migrations/postgres/*.sql
+var postgresMigrations embed.FS
+
+// PostgresRepo stores correlation history, patterns, and feedback in PostgreSQL for teams that cannot run
+// Weaviate. Similarity and search use PostgreSQL full-text ranking instead of vectors.
+type PostgresRepo struct {
+	db *sql.DB
+}
+
+// NewPostgresRepo opens a connection pool for dsn. Call Migrate before first use.
+func NewPostgresRepo(dsn string, maxOpenConns int) (*PostgresRepo, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("open postgres: %w", err)
+	}
+	if maxOpenConns > 0 {
+		db.SetMaxOpenConns(maxOpenConns)
+	}
+	return &PostgresRepo{db: db}, nil
+}
+
+// Close releases the connection pool.
+func (r *PostgresRepo) Close() error {
+	if r == nil || r.db == nil {
+		return nil
+	}
+	return r.db.Close()
+}
+
+// Migrate applies embedded schema migrations that have not yet been recorded in rca_schema_migrations.
+func (r *PostgresRepo) Migrate(ctx context.Context) error {
+	if _, err := r.db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS rca_schema_migrations (
+    version    INTEGER     PRIMARY KEY,
+    applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+)`); err != nil {
+		return fmt.Errorf("create migrations table: %w", err)
+	}
+
+	migrations, err := loadMigrations(postgresMigrations, "migrations/postgres")
+	if err != nil {
+		return err
+	}
+	for _, m := range migrations {
+		var applied bool
+		if err := r.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM rca_schema_migrations WHERE version = $1)`, m.version).Scan(&applied); err != nil {
+			return fmt.Errorf("check migration %d: %w", m.version, err)
+		}
+		if applied {
+			continue
+		}
+		tx, err := r.db.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, m.sql); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("apply migration %d: %w", m.version, err)
+		}
+		if _, err := tx.ExecContext(ctx, `INSERT INTO rca_schema_migrations (version) VALUES ($1)`, m.version); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("record migration %d: %w", m.version, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("commit migration %d: %w", m.version, err)
+		}
+	}
+	return nil
+}
+
+type migration struct {
+	version int
+	sql     string
+}
+
+// loadMigrations reads NNN_name.sql files from dir ordered by their numeric prefix.
+func loadMigrations(fsys fs.FS, dir string) ([]migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("read migrations: %w", err)
+	}
+	migrations := make([]migration, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".sql") {
+			continue
+		}
+		prefix, _, _ := strings.Cut(name, "_")
+		version, err := strconv.Atoi(prefix)
+		if err != nil {
+			return nil, fmt.Errorf("migration %s: missing numeric prefix", name)
+		}
+		data, err := fs.ReadFile(fsys, dir+"/"+name)
+		if err != nil {
+			return nil, err
+		}
+		migrations = append(migrations, migration{version: version, sql: string(data)})
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].version < migrations[j].version })
+	return migrations, nil
+}
+
+// StoreCorrelation upserts a correlation record.
+func (r *PostgresRepo) StoreCorrelation(ctx context.Context, tenantID string, correlation models.CorrelationResult) error {
+	if correlation.CreatedAt.IsZero() {
+		correlation.CreatedAt = time.Now().UTC()
+	}
+	document, err := json.Marshal(correlation)
+	if err != nil {
+		return fmt.Errorf("marshal correlation: %w", err)
+	}
+	_, err = r.db.ExecContext(ctx, `INSERT INTO rca_correlations
+    (tenant_id, correlation_id, incident_id, root_cause, confidence, category, affected_services, search_text, created_at, document)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+ON CONFLICT (tenant_id, correlation_id) DO UPDATE SET
+    incident_id = EXCLUDED.incident_id,
+    root_cause = EXCLUDED.root_cause,
+    confidence = EXCLUDED.confidence,
+    category = EXCLUDED.category,
+    affected_services = EXCLUDED.affected_services,
+    search_text = EXCLUDED.search_text,
+    created_at = EXCLUDED.created_at,
+    document = EXCLUDED.document`,
+		tenantID, correlation.CorrelationID, correlation.IncidentID, correlation.RootCause, correlation.Confidence,
+		string(correlation.Category), pq.Array(nonNilStrings(correlation.AffectedServices)), correlationSearchText(correlation),
+		correlation.CreatedAt.UTC(), document)
+	if err != nil {
+		return fmt.Errorf("postgres store correlation: %w", err)
+	}
+	return nil
+}
+
+// ListCorrelations returns the tenant's correlations newest first using offset page tokens.
+func (r *PostgresRepo) ListCorrelations(ctx context.Context, req models.ListCorrelationsRequest) (models.ListCorrelationsResponse, error) {
+	limit := req.PageSize
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	offset := 0
+	if req.PageToken != "" {
+		if v, err := strconv.Atoi(req.PageToken); err == nil && v >= 0 {
+			offset = v
+		}
+	}
+
+	query, args := buildCorrelationListQuery(req, limit, offset)
+	correlations, err := r.queryCorrelations(ctx, query, args...)
+	if err != nil {
+		return models.ListCorrelationsResponse{}, err
+	}
+
+	nextToken := ""
+	if len(correlations) == limit {
+		nextToken = strconv.Itoa(offset + len(correlations))
+	}
+	return models.ListCorrelationsResponse{Correlations: correlations, NextPageToken: nextToken}, nil
+}
+
+func buildCorrelationListQuery(req models.ListCorrelationsRequest, limit, offset int) (string, []any) {
+	conditions := []string{"tenant_id = $1"}
+	args := []any{req.TenantID}
+	add := func(condition string, value any) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+	if req.Service != "" {
+		add("$%d = ANY(affected_services)", req.Service)
+	}
+	if req.Category != models.CategoryUnknown {
+		add("category = $%d", string(req.Category))
+	}
+	if len(req.Labels) > 0 {
+		labels, _ := json.Marshal(req.Labels)
+		add("document->'Labels' @> $%d::jsonb", string(labels))
+	}
+	if !req.Start.IsZero() {
+		add("created_at >= $%d", req.Start.UTC())
+	}
+	if !req.End.IsZero() {
+		add("created_at <= $%d", req.End.UTC())
+	}
+	args = append(args, limit, offset)
+	query := fmt.Sprintf(`SELECT document FROM rca_correlations WHERE %s ORDER BY created_at DESC, correlation_id LIMIT $%d OFFSET $%d`,
+		strings.Join(conditions, " AND "), len(args)-1, len(args))
+	return query, args
+}
+
+// SearchCorrelations ranks the tenant's correlations against free text with ts_rank; Alpha is ignored since
+// no vector index is available.
+func (r *PostgresRepo) SearchCorrelations(ctx context.Context, req models.SearchCorrelationsRequest) (models.SearchCorrelationsResponse, error) {
+	limit := req.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 10
+	}
+	rows, err := r.db.QueryContext(ctx, `SELECT document, ts_rank(to_tsvector('english', search_text), query) AS score
+FROM rca_correlations, plainto_tsquery('english', $2) AS query
+WHERE tenant_id = $1 AND to_tsvector('english', search_text) @@ query
+ORDER BY score DESC, created_at DESC
+LIMIT $3`, req.TenantID, req.Query, limit)
+	if err != nil {
+		return models.SearchCorrelationsResponse{}, fmt.Errorf("postgres search correlations: %w", err)
+	}
+	defer rows.Close()
+
+	var results []models.ScoredCorrelation
+	for rows.Next() {
+		var document []byte
+		var score float64
+		if err := rows.Scan(&document, &score); err != nil {
+			return models.SearchCorrelationsResponse{}, err
+		}
+		var correlation models.CorrelationResult
+		if err := json.Unmarshal(document, &correlation); err != nil {
+			return models.SearchCorrelationsResponse{}, fmt.Errorf("decode correlation: %w", err)
+		}
+		results = append(results, models.ScoredCorrelation{Correlation: correlation, Score: score})
+	}
+	return models.SearchCorrelationsResponse{Results: results}, rows.Err()
+}
+
+// SimilarIncidents returns the tenant's correlations that best match the symptoms, falling back to the most
+// recent ones when nothing matches.
+func (r *PostgresRepo) SimilarIncidents(ctx context.Context, tenantID string, symptoms []string, limit int) ([]models.CorrelationResult, error) {
+	if limit <= 0 {
+		limit = 3
+	}
+	if len(symptoms) > 0 {
+		resp, err := r.SearchCorrelations(ctx, models.SearchCorrelationsRequest{TenantID: tenantID, Query: strings.Join(symptoms, " "), Limit: limit})
+		if err != nil {
+			return nil, err
+		}
+		if len(resp.Results) > 0 {
+			results := make([]models.CorrelationResult, 0, len(resp.Results))
+			for _, hit := range resp.Results {
+				results = append(results, hit.Correlation)
+			}
+			return results, nil
+		}
+	}
+	return r.queryCorrelations(ctx, `SELECT document FROM rca_correlations WHERE tenant_id = $1 ORDER BY created_at DESC LIMIT $2`, tenantID, limit)
+}
+
+// GetCorrelation returns one of the tenant's correlations.
+func (r *PostgresRepo) GetCorrelation(ctx context.Context, tenantID, correlationID string) (models.CorrelationResult, error) {
+	correlations, err := r.queryCorrelations(ctx, `SELECT document FROM rca_correlations WHERE tenant_id = $1 AND correlation_id = $2`, tenantID, correlationID)
+	if err != nil {
+		return models.CorrelationResult{}, err
+	}
+	if len(correlations) == 0 {
+		return models.CorrelationResult{}, ErrCorrelationNotFound
+	}
+	return correlations[0], nil
+}
+
+// UpdateCorrelation applies a status change and new annotations to a stored correlation, locking the row so
+// concurrent annotations are not lost.
+func (r *PostgresRepo) UpdateCorrelation(ctx context.Context, req models.UpdateCorrelationRequest) (models.CorrelationResult, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.CorrelationResult{}, err
+	}
+	defer tx.Rollback()
+
+	var document []byte
+	err = tx.QueryRowContext(ctx, `SELECT document FROM rca_correlations WHERE tenant_id = $1 AND correlation_id = $2 FOR UPDATE`,
+		req.TenantID, req.CorrelationID).Scan(&document)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.CorrelationResult{}, ErrCorrelationNotFound
+	}
+	if err != nil {
+		return models.CorrelationResult{}, fmt.Errorf("postgres load correlation: %w", err)
+	}
+	var correlation models.CorrelationResult
+	if err := json.Unmarshal(document, &correlation); err != nil {
+		return models.CorrelationResult{}, fmt.Errorf("decode correlation: %w", err)
+	}
+
+	applyCorrelationUpdate(&correlation, req, time.Now().UTC())
+	if document, err = json.Marshal(correlation); err != nil {
+		return models.CorrelationResult{}, fmt.Errorf("marshal correlation: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE rca_correlations SET document = $3 WHERE tenant_id = $1 AND correlation_id = $2`,
+		req.TenantID, req.CorrelationID, document); err != nil {
+		return models.CorrelationResult{}, fmt.Errorf("postgres update correlation: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return models.CorrelationResult{}, err
+	}
+	return correlation, nil
+}
+
+func (r *PostgresRepo) queryCorrelations(ctx context.Context, query string, args ...any) ([]models.CorrelationResult, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("postgres query correlations: %w", err)
+	}
+	defer rows.Close()
+
+	var correlations []models.CorrelationResult
+	for rows.Next() {
+		var document []byte
+		if err := rows.Scan(&document); err != nil {
+			return nil, err
+		}
+		var correlation models.CorrelationResult
+		if err := json.Unmarshal(document, &correlation); err != nil {
+			return nil, fmt.Errorf("decode correlation: %w", err)
+		}
+		correlations = append(correlations, correlation)
+	}
+	return correlations, rows.Err()
+}
+
+// StorePatterns upserts mined failure patterns.
+func (r *PostgresRepo) StorePatterns(ctx context.Context, tenantID string, patterns []models.FailurePattern) error {
+	for _, pattern := range patterns {
+		document, err := json.Marshal(pattern)
+		if err != nil {
+			return fmt.Errorf("marshal pattern: %w", err)
+		}
+		var lastSeen any
+		if !pattern.LastSeen.IsZero() {
+			lastSeen = pattern.LastSeen.UTC()
+		}
+		if _, err := r.db.ExecContext(ctx, `INSERT INTO rca_patterns (tenant_id, pattern_id, services, last_seen, document)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (tenant_id, pattern_id) DO UPDATE SET
+    services = EXCLUDED.services,
+    last_seen = EXCLUDED.last_seen,
+    document = EXCLUDED.document`,
+			tenantID, firstNonEmpty(pattern.ID, pattern.Name), pq.Array(nonNilStrings(pattern.Services)), lastSeen, document); err != nil {
+			return fmt.Errorf("postgres store pattern: %w", err)
+		}
+	}
+	return nil
+}
+
+// FetchPatterns returns the tenant's patterns, optionally restricted to a service.
+func (r *PostgresRepo) FetchPatterns(ctx context.Context, tenantID, service string) ([]models.FailurePattern, error) {
+	query := `SELECT document FROM rca_patterns WHERE tenant_id = $1`
+	args := []any{tenantID}
+	if service != "" {
+		query += ` AND $2 = ANY(services)`
+		args = append(args, service)
+	}
+	query += ` ORDER BY last_seen DESC NULLS LAST`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("postgres fetch patterns: %w", err)
+	}
+	defer rows.Close()
+
+	var patterns []models.FailurePattern
+	for rows.Next() {
+		var document []byte
+		if err := rows.Scan(&document); err != nil {
+			return nil, err
+		}
+		var pattern models.FailurePattern
+		if err := json.Unmarshal(document, &pattern); err != nil {
+			return nil, fmt.Errorf("decode pattern: %w", err)
+		}
+		patterns = append(patterns, pattern)
+	}
+	return patterns, rows.Err()
+}
+
+// StoreFeedback records analyst feedback.
+func (r *PostgresRepo) StoreFeedback(ctx context.Context, feedback models.Feedback) error {
+	submitted := feedback.SubmittedAt
+	if submitted.IsZero() {
+		submitted = time.Now().UTC()
+	}
+	if _, err := r.db.ExecContext(ctx, `INSERT INTO rca_feedback (tenant_id, correlation_id, correct, notes, submitted_at) VALUES ($1, $2, $3, $4, $5)`,
+		feedback.TenantID, feedback.CorrelationID, feedback.Correct, feedback.Notes, submitted.UTC()); err != nil {
+		return fmt.Errorf("postgres store feedback: %w", err)
+	}
+	return nil
+}
+
+// ListFeedback returns the tenant's feedback newest first using offset page tokens.
+func (r *PostgresRepo) ListFeedback(ctx context.Context, req models.ListFeedbackRequest) (models.ListFeedbackResponse, error) {
+	limit := req.PageSize
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	offset := 0
+	if req.PageToken != "" {
+		if v, err := strconv.Atoi(req.PageToken); err == nil && v >= 0 {
+			offset = v
+		}
+	}
+
+	conditions := []string{"tenant_id = $1"}
+	args := []any{req.TenantID}
+	add := func(condition string, value any) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+	if req.CorrelationID != "" {
+		add("correlation_id = $%d", req.CorrelationID)
+	}
+	if req.Correct != nil {
+		add("correct = $%d", *req.Correct)
+	}
+	if !req.Start.IsZero() {
+		add("submitted_at >= $%d", req.Start.UTC())
+	}
+	if !req.End.IsZero() {
+		add("submitted_at <= $%d", req.End.UTC())
+	}
+	args = append(args, limit, offset)
+	query := fmt.Sprintf(`SELECT tenant_id, correlation_id, correct, notes, submitted_at FROM rca_feedback WHERE %s ORDER BY submitted_at DESC, id DESC LIMIT $%d OFFSET $%d`,
+		strings.Join(conditions, " AND "), len(args)-1, len(args))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return models.ListFeedbackResponse{}, fmt.Errorf("postgres list feedback: %w", err)
+	}
+	defer rows.Close()
+
+	var feedback []models.Feedback
+	for rows.Next() {
+		var fb models.Feedback
+		if err := rows.Scan(&fb.TenantID, &fb.CorrelationID, &fb.Correct, &fb.Notes, &fb.SubmittedAt); err != nil {
+			return models.ListFeedbackResponse{}, err
+		}
+		feedback = append(feedback, fb)
+	}
+	if err := rows.Err(); err != nil {
+		return models.ListFeedbackResponse{}, err
+	}
+
+	nextToken := ""
+	if len(feedback) == limit {
+		nextToken = strconv.Itoa(offset + len(feedback))
+	}
+	return models.ListFeedbackResponse{Feedback: feedback, NextPageToken: nextToken}, nil
+}
+
+// FeedbackStats aggregates feedback accuracy overall, per service, per root-cause category, per failure
+// pattern, and over time.
+func (r *PostgresRepo) FeedbackStats(ctx context.Context, req models.FeedbackStatsRequest) (models.FeedbackStats, error) {
+	return collectFeedbackStats(ctx, r, req)
+}
+
+func (r *PostgresRepo) correlationsByID(ctx context.Context, tenantID string, ids []string) (map[string]models.CorrelationResult, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT correlation_id, affected_services, category FROM rca_correlations WHERE tenant_id = $1 AND correlation_id = ANY($2)`,
+		tenantID, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("postgres lookup correlations: %w", err)
+	}
+	defer rows.Close()
+
+	out := make(map[string]models.CorrelationResult, len(ids))
+	for rows.Next() {
+		var correlation models.CorrelationResult
+		var category string
+		if err := rows.Scan(&correlation.CorrelationID, pq.Array(&correlation.AffectedServices), &category); err != nil {
+			return nil, err
+		}
+		correlation.Category = models.RootCauseCategory(category)
+		out[correlation.CorrelationID] = correlation
+	}
+	return out, rows.Err()
+}
+
+// StoreTopologySnapshot records a service graph snapshot, replacing one of the same environment captured at
+// the same time.
+func (r *PostgresRepo) StoreTopologySnapshot(ctx context.Context, tenantID string, snapshot models.TopologySnapshot) error {
+	if snapshot.Edges == nil {
+		snapshot.Edges = []models.TopologyEdge{}
+	}
+	edges, err := json.Marshal(snapshot.Edges)
+	if err != nil {
+		return fmt.Errorf("marshal topology snapshot: %w", err)
+	}
+	if _, err := r.db.ExecContext(ctx, `INSERT INTO rca_topology_snapshots (tenant_id, environment, captured_at, edges)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (tenant_id, environment, captured_at) DO UPDATE SET edges = EXCLUDED.edges`,
+		tenantID, snapshot.Environment, snapshot.CapturedAt.UTC(), edges); err != nil {
+		return fmt.Errorf("postgres store topology snapshot: %w", err)
+	}
+	return nil
+}
+
+// PurgeTopologySnapshots deletes the tenant's snapshots captured before before.
+func (r *PostgresRepo) PurgeTopologySnapshots(ctx context.Context, tenantID string, before time.Time) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM rca_topology_snapshots WHERE tenant_id = $1 AND captured_at < $2`, tenantID, before.UTC()); err != nil {
+		return fmt.Errorf("postgres purge topology snapshots: %w", err)
+	}
+	return nil
+}
+
+// TopologySnapshots returns the snapshots of environment captured between start and end, oldest first.
+func (r *PostgresRepo) TopologySnapshots(ctx context.Context, tenantID, environment string, start, end time.Time) ([]models.TopologySnapshot, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT captured_at, edges FROM rca_topology_snapshots
+WHERE tenant_id = $1 AND environment = $2 AND captured_at >= $3 AND captured_at <= $4
+ORDER BY captured_at`, tenantID, environment, start.UTC(), end.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("postgres topology snapshots: %w", err)
+	}
+	defer rows.Close()
+
+	var snapshots []models.TopologySnapshot
+	for rows.Next() {
+		snapshot := models.TopologySnapshot{Environment: environment}
+		var edges []byte
+		if err := rows.Scan(&snapshot.CapturedAt, &edges); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(edges, &snapshot.Edges); err != nil {
+			return nil, fmt.Errorf("decode topology snapshot: %w", err)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, rows.Err()
+}
+
+// StoreMaintenanceWindow records a maintenance window, replacing one of the tenant with the same ID.
+func (r *PostgresRepo) StoreMaintenanceWindow(ctx context.Context, window models.MaintenanceWindow) error {
+	if _, err := r.db.ExecContext(ctx, `INSERT INTO rca_maintenance_windows (tenant_id, id, services, starts_at, ends_at, reason)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (tenant_id, id) DO UPDATE SET services = EXCLUDED.services, starts_at = EXCLUDED.starts_at,
+    ends_at = EXCLUDED.ends_at, reason = EXCLUDED.reason`,
+		window.TenantID, window.ID, pq.Array(nonNilStrings(window.Services)), window.Start.UTC(), window.End.UTC(), window.Reason); err != nil {
+		return fmt.Errorf("postgres store maintenance window: %w", err)
+	}
+	return nil
+}
+
+// ListMaintenanceWindows returns the tenant's stored maintenance windows ordered by start time.
+func (r *PostgresRepo) ListMaintenanceWindows(ctx context.Context, tenantID string) ([]models.MaintenanceWindow, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT id, services, starts_at, ends_at, reason FROM rca_maintenance_windows
+WHERE tenant_id = $1 ORDER BY starts_at`, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("postgres list maintenance windows: %w", err)
+	}
+	defer rows.Close()
+
+	var windows []models.MaintenanceWindow
+	for rows.Next() {
+		window := models.MaintenanceWindow{TenantID: tenantID}
+		if err := rows.Scan(&window.ID, pq.Array(&window.Services), &window.Start, &window.End, &window.Reason); err != nil {
+			return nil, err
+		}
+		windows = append(windows, window)
+	}
+	return windows, rows.Err()
+}
+
+// DeleteMaintenanceWindow deletes a tenant's maintenance window by ID and reports whether it existed.
+func (r *PostgresRepo) DeleteMaintenanceWindow(ctx context.Context, tenantID, id string) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM rca_maintenance_windows WHERE tenant_id = $1 AND id = $2`, tenantID, id)
+	if err != nil {
+		return false, fmt.Errorf("postgres delete maintenance window: %w", err)
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return deleted > 0, nil
+}
+
+// PurgeTenantData deletes tenant history with the same semantics as WeaviateRepo.PurgeTenantData; dry runs
+// count matching rows instead.
+func (r *PostgresRepo) PurgeTenantData(ctx context.Context, req models.PurgeRequest) (models.PurgeResult, error) {
+	result := models.PurgeResult{DryRun: req.DryRun}
+	if req.TenantID == "" {
+		return result, fmt.Errorf("tenant id is required")
+	}
+
+	type purgeTarget struct {
+		class     string
+		table     string
+		timeField string
+		count     *int
+	}
+	targets := []purgeTarget{
+		{"CorrelationRecord", "rca_correlations", "created_at", &result.Correlations},
+		{"CorrelationFeedback", "rca_feedback", "submitted_at", &result.Feedback},
+		{"TopologySnapshot", "rca_topology_snapshots", "captured_at", &result.TopologySnapshots},
+	}
+	if req.Before.IsZero() {
+		targets = append(targets, purgeTarget{"FailurePattern", "rca_patterns", "", &result.Patterns})
+	}
+
+	for _, target := range targets {
+		where := "tenant_id = $1"
+		args := []any{req.TenantID}
+		if !req.Before.IsZero() {
+			where += fmt.Sprintf(" AND %s < $2", target.timeField)
+			args = append(args, req.Before.UTC())
+		}
+		var count int64
+		if req.DryRun {
+			if err := r.db.QueryRowContext(ctx, fmt.Sprintf("SELECT count(*) FROM %s WHERE %s", target.table, where), args...).Scan(&count); err != nil {
+				return result, fmt.Errorf("purge %s: %w", target.class, err)
+			}
+		} else {
+			res, err := r.db.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE %s", target.table, where), args...)
+			if err != nil {
+				return result, fmt.Errorf("purge %s: %w", target.class, err)
+			}
+			count, _ = res.RowsAffected()
+		}
+		*target.count = int(count)
+		metrics.ObservePurge(target.class, int(count), req.DryRun)
+	}
+	return result, nil
+}
+
+// Tenants lists every tenant with stored correlations, feedback, or topology snapshots.
+func (r *PostgresRepo) Tenants(ctx context.Context) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT tenant_id FROM rca_correlations
+UNION SELECT tenant_id FROM rca_feedback
+UNION SELECT tenant_id FROM rca_topology_snapshots
+ORDER BY tenant_id`)
+	if err != nil {
+		return nil, fmt.Errorf("list tenants: %w", err)
+	}
+	defer rows.Close()
+
+	var tenants []string
+	for rows.Next() {
+		var tenantID string
+		if err := rows.Scan(&tenantID); err != nil {
+			return nil, err
+		}
+		tenants = append(tenants, tenantID)
+	}
+	return tenants, rows.Err()
+}
+
+var _ HistoryStore = (*PostgresRepo)(nil)
@@ -0,0 +1,58 @@
+package repo
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+func TestBuildCorrelationListQuery(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	query, args := buildCorrelationListQuery(models.ListCorrelationsRequest{
+		TenantID: "tenant",
+		Service:  "checkout",
+		Category: models.CategoryNetwork,
+		Start:    start,
+	}, 20, 40)
+
+	for _, want := range []string{"tenant_id = $1", "$2 = ANY(affected_services)", "category = $3", "created_at >= $4", "LIMIT $5 OFFSET $6"} {
+		if !strings.Contains(query, want) {
+			t.Fatalf("expected %q in query: %s", want, query)
+		}
+	}
+	if strings.Contains(query, "created_at <=") {
+		t.Fatalf("unexpected end filter: %s", query)
+	}
+	if len(args) != 6 || args[1] != "checkout" || args[2] != "network" || args[4] != 20 || args[5] != 40 {
+		t.Fatalf("unexpected args: %v", args)
+	}
+
+	query, args = buildCorrelationListQuery(models.ListCorrelationsRequest{TenantID: "tenant", Labels: map[string]string{"team": "payments"}}, 20, 0)
+	if !strings.Contains(query, "document->'Labels' @> $2::jsonb") || args[1] != `{"team":"payments"}` {
+		t.Fatalf("expected label containment filter, got %s %v", query, args)
+	}
+}
+
+func TestLoadMigrationsOrdersByVersion(t *testing.T) {
+	fsys := fstest.MapFS{
+		"m/010_later.sql": {Data: []byte("SELECT 10;")},
+		"m/002_next.sql":  {Data: []byte("SELECT 2;")},
+		"m/001_init.sql":  {Data: []byte("SELECT 1;")},
+		"m/README.md":     {Data: []byte("ignored")},
+	}
+	migrations, err := loadMigrations(fsys, "m")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(migrations) != 3 || migrations[0].version != 1 || migrations[1].version != 2 || migrations[2].version != 10 {
+		t.Fatalf("unexpected order: %+v", migrations)
+	}
+
+	embedded, err := loadMigrations(postgresMigrations, "migrations/postgres")
+	if err != nil || len(embedded) == 0 || embedded[0].version != 1 {
+		t.Fatalf("expected embedded initial migration, got %+v (%v)", embedded, err)
+	}
+}
@@ -0,0 +1,388 @@
+package repo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/snappy"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// maxRemoteReadBody bounds remote-read responses before decompression.
+const maxRemoteReadBody = 64 << 20
+
+// PrometheusClient reads series over the Prometheus remote-read protocol, so any compatible TSDB (Prometheus,
+// Thanos, Mimir, Cortex, ...) can serve metrics without mirador-core. Remote read returns raw samples rather
+// than evaluating PromQL, so selectors should name series that are already rates or ratios, such as
+// recording rules.
+type PrometheusClient struct {
+	url        string
+	selectors  map[string]string
+	headers    map[string]string
+	step       time.Duration
+	httpClient *http.Client
+}
+
+// NewPrometheusClient constructs a remote-read metric source against url (for example
+// http://prometheus:9090/api/v1/read). selectors maps series names to PromQL series selectors such as
+// `job:http_errors:ratio_rate5m{service="{service}"}`; {service}, {tenant}, and {environment} are substituted
+// per request in selectors and header values. Samples are averaged into step-wide buckets, by default 30s.
+func NewPrometheusClient(url string, selectors, headers map[string]string, step, timeout time.Duration) (*PrometheusClient, error) {
+	placeholders := strings.NewReplacer("{service}", "service", "{tenant}", "tenant", "{environment}", "environment")
+	for name, selector := range selectors {
+		if _, err := parseSelector(placeholders.Replace(selector)); err != nil {
+			return nil, fmt.Errorf("prometheus selector %s: %w", name, err)
+		}
+	}
+	if step <= 0 {
+		step = 30 * time.Second
+	}
+	return &PrometheusClient{
+		url:        url,
+		selectors:  selectors,
+		headers:    headers,
+		step:       step,
+		httpClient: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// FetchMetricSeries reads every configured selector over the window in one remote-read request, tagging
+// samples with the selector name. The samples of all series a selector matches are averaged per step.
+func (c *PrometheusClient) FetchMetricSeries(ctx context.Context, tenantID, service string, start, end time.Time) ([]MetricPoint, error) {
+	if c == nil {
+		return nil, fmt.Errorf("prometheus client not initialised")
+	}
+	if c.url == "" {
+		return nil, fmt.Errorf("prometheus remote-read URL not configured")
+	}
+	if len(c.selectors) == 0 {
+		return nil, fmt.Errorf("prometheus selectors not configured")
+	}
+
+	replacer := strings.NewReplacer("{service}", service, "{tenant}", tenantID, "{environment}", Environment(ctx))
+	names := make([]string, 0, len(c.selectors))
+	for name := range c.selectors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	queries := make([][]labelMatcher, 0, len(names))
+	for _, name := range names {
+		matchers, err := parseSelector(replacer.Replace(c.selectors[name]))
+		if err != nil {
+			return nil, fmt.Errorf("prometheus selector %s: %w", name, err)
+		}
+		queries = append(queries, matchers)
+	}
+
+	results, err := c.read(ctx, replacer, encodeReadRequest(queries, start, end))
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != len(names) {
+		return nil, fmt.Errorf("prometheus returned %d results for %d queries", len(results), len(names))
+	}
+
+	var points []MetricPoint
+	for i, name := range names {
+		points = append(points, c.downsample(name, results[i])...)
+	}
+	if len(points) == 0 {
+		return nil, fmt.Errorf("prometheus returned no samples")
+	}
+	return points, nil
+}
+
+func (c *PrometheusClient) read(ctx context.Context, replacer *strings.Replacer, request []byte) ([][]remoteSample, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(snappy.Encode(nil, request)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("Content-Encoding", "snappy")
+	req.Header.Set("X-Prometheus-Remote-Read-Version", "0.1.0")
+	for key, value := range c.headers {
+		req.Header.Set(key, replacer.Replace(value))
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("prometheus remote-read request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("prometheus remote-read returned %s", resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxRemoteReadBody))
+	if err != nil {
+		return nil, fmt.Errorf("read prometheus response: %w", err)
+	}
+	decoded, err := snappy.Decode(nil, body)
+	if err != nil {
+		return nil, fmt.Errorf("decompress prometheus response: %w", err)
+	}
+	results, err := decodeReadResponse(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("decode prometheus response: %w", err)
+	}
+	return results, nil
+}
+
+// downsample averages samples into step-wide buckets, each timestamped at its start.
+func (c *PrometheusClient) downsample(name string, samples []remoteSample) []MetricPoint {
+	type bucket struct {
+		sum   float64
+		count int
+	}
+	buckets := make(map[int64]*bucket)
+	for _, sample := range samples {
+		if math.IsNaN(sample.value) {
+			// Prometheus marks stale series with a NaN sample.
+			continue
+		}
+		key := sample.timestamp - sample.timestamp%c.step.Milliseconds()
+		b, ok := buckets[key]
+		if !ok {
+			b = &bucket{}
+			buckets[key] = b
+		}
+		b.sum += sample.value
+		b.count++
+	}
+	points := make([]MetricPoint, 0, len(buckets))
+	for key, b := range buckets {
+		points = append(points, MetricPoint{Name: name, Timestamp: time.UnixMilli(key).UTC(), Value: b.sum / float64(b.count)})
+	}
+	sort.Slice(points, func(i, j int) bool { return points[i].Timestamp.Before(points[j].Timestamp) })
+	return points
+}
+
+// Label matcher types, numbered as in the remote-read protocol.
+const (
+	matchEqual = iota
+	matchNotEqual
+	matchRegexp
+	matchNotRegexp
+)
+
+type labelMatcher struct {
+	kind  int
+	name  string
+	value string
+}
+
+// parseSelector parses a PromQL series selector, metric_name{label="value", label=~"regexp", ...}, into
+// label matchers; either the metric name or the braces may be omitted.
+func parseSelector(selector string) ([]labelMatcher, error) {
+	s := strings.TrimSpace(selector)
+	var matchers []labelMatcher
+	name := strings.TrimSpace(s[:len(s)-len(strings.TrimLeft(s, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789_:"))])
+	s = strings.TrimSpace(s[len(name):])
+	if name != "" {
+		matchers = append(matchers, labelMatcher{kind: matchEqual, name: "__name__", value: name})
+	}
+	if s != "" {
+		if !strings.HasPrefix(s, "{") || !strings.HasSuffix(s, "}") {
+			return nil, fmt.Errorf("invalid selector %q", selector)
+		}
+		body := s[1 : len(s)-1]
+		for strings.TrimSpace(body) != "" {
+			matcher, rest, err := parseMatcher(body)
+			if err != nil {
+				return nil, fmt.Errorf("invalid selector %q: %w", selector, err)
+			}
+			matchers = append(matchers, matcher)
+			rest = strings.TrimSpace(rest)
+			if rest != "" && !strings.HasPrefix(rest, ",") {
+				return nil, fmt.Errorf("invalid selector %q: want , between matchers", selector)
+			}
+			body = strings.TrimPrefix(rest, ",")
+		}
+	}
+	if len(matchers) == 0 {
+		return nil, fmt.Errorf("empty selector")
+	}
+	return matchers, nil
+}
+
+// parseMatcher parses one label matcher from the start of s and returns the remainder.
+func parseMatcher(s string) (labelMatcher, string, error) {
+	s = strings.TrimSpace(s)
+	end := strings.IndexAny(s, "=!")
+	if end <= 0 {
+		return labelMatcher{}, "", fmt.Errorf("want label name and operator in %q", s)
+	}
+	matcher := labelMatcher{name: strings.TrimSpace(s[:end])}
+	s = s[end:]
+	switch {
+	case strings.HasPrefix(s, "=~"):
+		matcher.kind, s = matchRegexp, s[2:]
+	case strings.HasPrefix(s, "!~"):
+		matcher.kind, s = matchNotRegexp, s[2:]
+	case strings.HasPrefix(s, "!="):
+		matcher.kind, s = matchNotEqual, s[2:]
+	case strings.HasPrefix(s, "="):
+		matcher.kind, s = matchEqual, s[1:]
+	default:
+		return labelMatcher{}, "", fmt.Errorf("unknown operator in %q", s)
+	}
+	value, rest, err := parseQuoted(strings.TrimSpace(s))
+	if err != nil {
+		return labelMatcher{}, "", err
+	}
+	matcher.value = value
+	return matcher, rest, nil
+}
+
+// parseQuoted reads a single-, double-, or backtick-quoted string from the start of s.
+func parseQuoted(s string) (string, string, error) {
+	if s == "" || !strings.ContainsRune(`"'`+"`", rune(s[0])) {
+		return "", "", fmt.Errorf("want a quoted value in %q", s)
+	}
+	quote := s[0]
+	var value strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch {
+		case s[i] == quote:
+			return value.String(), s[i+1:], nil
+		case s[i] == '\\' && quote != '`' && i+1 < len(s):
+			i++
+			switch s[i] {
+			case 'n':
+				value.WriteByte('\n')
+			case 't':
+				value.WriteByte('\t')
+			default:
+				value.WriteByte(s[i])
+			}
+		default:
+			value.WriteByte(s[i])
+		}
+	}
+	return "", "", fmt.Errorf("unterminated value in %q", s)
+}
+
+// encodeReadRequest encodes a remote-read ReadRequest with one query per matcher set over the window.
+func encodeReadRequest(queries [][]labelMatcher, start, end time.Time) []byte {
+	var request []byte
+	for _, matchers := range queries {
+		var query []byte
+		query = protowire.AppendTag(query, 1, protowire.VarintType)
+		query = protowire.AppendVarint(query, uint64(start.UnixMilli()))
+		query = protowire.AppendTag(query, 2, protowire.VarintType)
+		query = protowire.AppendVarint(query, uint64(end.UnixMilli()))
+		for _, m := range matchers {
+			var matcher []byte
+			matcher = protowire.AppendTag(matcher, 1, protowire.VarintType)
+			matcher = protowire.AppendVarint(matcher, uint64(m.kind))
+			matcher = protowire.AppendTag(matcher, 2, protowire.BytesType)
+			matcher = protowire.AppendString(matcher, m.name)
+			matcher = protowire.AppendTag(matcher, 3, protowire.BytesType)
+			matcher = protowire.AppendString(matcher, m.value)
+			query = protowire.AppendTag(query, 3, protowire.BytesType)
+			query = protowire.AppendBytes(query, matcher)
+		}
+		request = protowire.AppendTag(request, 1, protowire.BytesType)
+		request = protowire.AppendBytes(request, query)
+	}
+	return request
+}
+
+type remoteSample struct {
+	value     float64
+	timestamp int64
+}
+
+// decodeReadResponse decodes a remote-read ReadResponse into the samples of each query result, in query order.
+func decodeReadResponse(data []byte) ([][]remoteSample, error) {
+	var results [][]remoteSample
+	err := walkMessage(data, func(field protowire.Number, value []byte) error {
+		if field != 1 {
+			return nil
+		}
+		var samples []remoteSample
+		err := walkMessage(value, func(field protowire.Number, series []byte) error {
+			if field != 1 {
+				return nil
+			}
+			return walkMessage(series, func(field protowire.Number, raw []byte) error {
+				if field != 2 {
+					return nil
+				}
+				sample, err := decodeSample(raw)
+				samples = append(samples, sample)
+				return err
+			})
+		})
+		results = append(results, samples)
+		return err
+	})
+	return results, err
+}
+
+func decodeSample(data []byte) (remoteSample, error) {
+	var sample remoteSample
+	for len(data) > 0 {
+		field, kind, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return sample, protowire.ParseError(n)
+		}
+		data = data[n:]
+		switch {
+		case field == 1 && kind == protowire.Fixed64Type:
+			bits, n := protowire.ConsumeFixed64(data)
+			if n < 0 {
+				return sample, protowire.ParseError(n)
+			}
+			sample.value, data = math.Float64frombits(bits), data[n:]
+		case field == 2 && kind == protowire.VarintType:
+			ts, n := protowire.ConsumeVarint(data)
+			if n < 0 {
+				return sample, protowire.ParseError(n)
+			}
+			sample.timestamp, data = int64(ts), data[n:]
+		default:
+			n := protowire.ConsumeFieldValue(field, kind, data)
+			if n < 0 {
+				return sample, protowire.ParseError(n)
+			}
+			data = data[n:]
+		}
+	}
+	return sample, nil
+}
+
+// walkMessage calls fn with each length-delimited field of a protobuf message, skipping other fields.
+func walkMessage(data []byte, fn func(field protowire.Number, value []byte) error) error {
+	for len(data) > 0 {
+		field, kind, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+		if kind != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(field, kind, data)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			data = data[n:]
+			continue
+		}
+		value, n := protowire.ConsumeBytes(data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+		if err := fn(field, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package repo
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/snappy"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// encodeTestReadResponse encodes a ReadResponse holding one single-series result per sample list.
+func encodeTestReadResponse(results ...[]remoteSample) []byte {
+	var response []byte
+	for _, samples := range results {
+		var series []byte
+		for _, sample := range samples {
+			var raw []byte
+			raw = protowire.AppendTag(raw, 1, protowire.Fixed64Type)
+			raw = protowire.AppendFixed64(raw, math.Float64bits(sample.value))
+			raw = protowire.AppendTag(raw, 2, protowire.VarintType)
+			raw = protowire.AppendVarint(raw, uint64(sample.timestamp))
+			series = protowire.AppendTag(series, 2, protowire.BytesType)
+			series = protowire.AppendBytes(series, raw)
+		}
+		var result []byte
+		result = protowire.AppendTag(result, 1, protowire.BytesType)
+		result = protowire.AppendBytes(result, series)
+		response = protowire.AppendTag(response, 1, protowire.BytesType)
+		response = protowire.AppendBytes(response, result)
+	}
+	return response
+}
+
+func TestPrometheusClientReadsSelectors(t *testing.T) {
+	client, err := NewPrometheusClient("http://prometheus/api/v1/read", map[string]string{
+		"error_rate": `job:errors:ratio_rate5m{service="{service}", env!~'stag.*'}`,
+		"latency":    `{__name__="job:latency:p95", service="{service}"}`,
+	}, map[string]string{"X-Scope-OrgID": "{tenant}"}, time.Minute, time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	start := time.Unix(1_699_999_980, 0)
+	client.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Header.Get("Content-Encoding") != "snappy" || req.Header.Get("X-Scope-OrgID") != "tenant-a" {
+			t.Fatalf("unexpected headers: %v", req.Header)
+		}
+		compressed, _ := io.ReadAll(req.Body)
+		body, err := snappy.Decode(nil, compressed)
+		if err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		var queries [][]labelMatcher
+		err = walkMessage(body, func(_ protowire.Number, query []byte) error {
+			var matchers []labelMatcher
+			err := walkMessage(query, func(_ protowire.Number, raw []byte) error {
+				var m labelMatcher
+				for len(raw) > 0 {
+					field, kind, n := protowire.ConsumeTag(raw)
+					raw = raw[n:]
+					if kind == protowire.VarintType {
+						v, n := protowire.ConsumeVarint(raw)
+						m.kind, raw = int(v), raw[n:]
+						continue
+					}
+					v, n := protowire.ConsumeString(raw)
+					if field == 2 {
+						m.name = v
+					} else {
+						m.value = v
+					}
+					raw = raw[n:]
+				}
+				matchers = append(matchers, m)
+				return nil
+			})
+			queries = append(queries, matchers)
+			return err
+		})
+		if err != nil {
+			t.Fatalf("decode read request: %v", err)
+		}
+		want := [][]labelMatcher{
+			{{matchEqual, "__name__", "job:errors:ratio_rate5m"}, {matchEqual, "service", "checkout"}, {matchNotRegexp, "env", "stag.*"}},
+			{{matchEqual, "__name__", "job:latency:p95"}, {matchEqual, "service", "checkout"}},
+		}
+		if len(queries) != len(want) {
+			t.Fatalf("unexpected queries: %+v", queries)
+		}
+		for i := range want {
+			if len(queries[i]) != len(want[i]) {
+				t.Fatalf("unexpected matchers: %+v", queries[i])
+			}
+			for j := range want[i] {
+				if queries[i][j] != want[i][j] {
+					t.Fatalf("unexpected matcher: %+v, want %+v", queries[i][j], want[i][j])
+				}
+			}
+		}
+
+		ms := start.UnixMilli()
+		response := encodeTestReadResponse(
+			[]remoteSample{{0.5, ms}, {1.5, ms + 15_000}, {2, ms + 60_000}, {math.NaN(), ms + 75_000}},
+			[]remoteSample{{0.25, ms + 30_000}},
+		)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(snappy.Encode(nil, response))), Header: make(http.Header)}, nil
+	}))
+
+	core := NewMiradorCoreClient("", "", "", "", "", time.Second, nil, 0, WithMetricSource(client))
+	points, err := core.FetchMetricSeries(context.Background(), "tenant-a", "checkout", start, start.Add(5*time.Minute))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(points) != 3 {
+		t.Fatalf("unexpected points: %+v", points)
+	}
+	if points[0].Name != "error_rate" || points[0].Value != 1 || !points[0].Timestamp.Equal(start) {
+		t.Fatalf("expected the first minute averaged, got %+v", points[0])
+	}
+	if points[1].Value != 2 || points[2].Name != "latency" || !points[2].Timestamp.Equal(start) {
+		t.Fatalf("unexpected points: %+v", points)
+	}
+}
+
+func TestNewPrometheusClientRejectsInvalidSelectors(t *testing.T) {
+	for _, selector := range []string{`{}`, `up{service="checkout"`, `up{service=checkout}`, `up{service~"a"}`} {
+		if _, err := NewPrometheusClient("http://prometheus/api/v1/read", map[string]string{"up": selector}, nil, 0, time.Second); err == nil {
+			t.Fatalf("expected %q to be rejected", selector)
+		}
+	}
+}
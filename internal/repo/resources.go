@@ -0,0 +1,76 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// WithResourceMetrics requests resource utilisation series for capacity analysis. resources maps resource
+// names such as cpu or memory to the mirador-core series reporting the resource's utilisation as a fraction
+// of its limit.
+func WithResourceMetrics(resources map[string]string) CoreClientOption {
+	return func(c *MiradorCoreClient) {
+		for resource, series := range resources {
+			if strings.TrimSpace(resource) == "" || strings.TrimSpace(series) == "" {
+				continue
+			}
+			if c.resourceMetrics == nil {
+				c.resourceMetrics = make(map[string]string)
+			}
+			c.resourceMetrics[resource] = series
+		}
+	}
+}
+
+// WithResourceSource routes resource utilisation fetches to source, which names each sample after its
+// resource, instead of the mirador-core RCA endpoint.
+func WithResourceSource(source MetricSource) CoreClientOption {
+	return func(c *MiradorCoreClient) {
+		c.resourceSource = source
+	}
+}
+
+// FetchResourceUsage fetches the utilisation of service's resources, each sample named after its resource,
+// served from the cache when WithSignalCache is set. It returns no samples when no resources are configured.
+func (c *MiradorCoreClient) FetchResourceUsage(ctx context.Context, tenantID, service string, start, end time.Time) ([]MetricPoint, error) {
+	if c == nil {
+		return nil, fmt.Errorf("mirador-core client not initialised")
+	}
+	if c.resourceSource == nil && len(c.resourceMetrics) == 0 {
+		return nil, nil
+	}
+	return cachedFetch(ctx, c.cache, c.metricsTTL.get(), 0, signalCacheKey("resources", tenantID, Environment(ctx), service, start, end), func() ([]MetricPoint, error) {
+		return c.fetchResourceUsage(ctx, tenantID, service, start, end)
+	})
+}
+
+func (c *MiradorCoreClient) fetchResourceUsage(ctx context.Context, tenantID, service string, start, end time.Time) ([]MetricPoint, error) {
+	if c.resourceSource != nil {
+		return c.resourceSource.FetchMetricSeries(ctx, tenantID, service, start, end)
+	}
+
+	resources := make(map[string]string, len(c.resourceMetrics))
+	names := make([]string, 0, len(c.resourceMetrics))
+	for resource, series := range c.resourceMetrics {
+		resources[series] = resource
+		names = append(names, series)
+	}
+	sort.Strings(names)
+	points, err := c.queryMetrics(ctx, tenantID, service, start, end, names)
+	if err != nil {
+		return nil, fmt.Errorf("resource usage: %w", err)
+	}
+	usage := make([]MetricPoint, 0, len(points))
+	for _, point := range points {
+		resource, ok := resources[point.Name]
+		if !ok {
+			continue
+		}
+		point.Name = resource
+		usage = append(usage, point)
+	}
+	return usage, nil
+}
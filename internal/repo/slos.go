@@ -0,0 +1,75 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// SLO is a service-level objective of a service. Indicator names the metric series measuring it: each sample
+// is the fraction of bad events when Threshold is zero, or a bad sample when it exceeds Threshold (for example
+// a p95 latency above 300ms). Period is the window the error budget covers.
+type SLO struct {
+	Name      string
+	Service   string
+	Indicator string
+	Objective float64
+	Threshold float64
+	Period    time.Duration
+}
+
+// WithSLOPath enables fetching service SLO definitions from the mirador-core endpoint at p.
+func WithSLOPath(p string) CoreClientOption {
+	return func(c *MiradorCoreClient) {
+		c.sloPath = p
+	}
+}
+
+// FetchSLOs requests the SLO definitions of service from mirador-core. It returns none when no SLO path is
+// configured.
+func (c *MiradorCoreClient) FetchSLOs(ctx context.Context, tenantID, service string) ([]SLO, error) {
+	if c == nil {
+		return nil, fmt.Errorf("mirador-core client not initialised")
+	}
+	if c.sloPath == "" {
+		return nil, nil
+	}
+	if c.baseURL == "" {
+		return nil, fmt.Errorf("mirador-core base URL not configured")
+	}
+
+	payload := map[string]interface{}{
+		"tenant_id": tenantID,
+		"service":   service,
+	}
+	setEnvironment(ctx, payload)
+
+	var response struct {
+		SLOs []struct {
+			Name          string  `json:"name"`
+			Service       string  `json:"service"`
+			Indicator     string  `json:"indicator"`
+			Objective     float64 `json:"objective"`
+			Threshold     float64 `json:"threshold"`
+			PeriodSeconds float64 `json:"period_seconds"`
+		} `json:"slos"`
+	}
+	if err := c.postJSON(ctx, tenantID, c.slosURL(), payload, &response); err != nil {
+		return nil, fmt.Errorf("mirador-core SLO request failed: %w", err)
+	}
+
+	slos := make([]SLO, 0, len(response.SLOs))
+	for _, slo := range response.SLOs {
+		slos = append(slos, SLO{
+			Name:      slo.Name,
+			Service:   firstNonEmpty(slo.Service, service),
+			Indicator: slo.Indicator,
+			Objective: slo.Objective,
+			Threshold: slo.Threshold,
+			Period:    time.Duration(slo.PeriodSeconds * float64(time.Second)),
+		})
+	}
+	return slos, nil
+}
+
+func (c *MiradorCoreClient) slosURL() string { return c.resolvePath(c.sloPath) }
@@ -0,0 +1,257 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TraceSource fetches spans for a service. MiradorCoreClient implements it natively; Jaeger and Tempo
+// backends plug in through WithTraceSource.
+type TraceSource interface {
+	FetchTraceSpans(ctx context.Context, tenantID, service string, start, end time.Time) ([]TraceSpan, error)
+}
+
+// WithTraceSource routes trace fetches to source instead of the mirador-core RCA endpoint.
+func WithTraceSource(source TraceSource) CoreClientOption {
+	return func(c *MiradorCoreClient) {
+		c.traceSource = source
+	}
+}
+
+// JaegerClient fetches spans from the Jaeger query API (/api/traces).
+type JaegerClient struct {
+	baseURL    string
+	limit      int
+	httpClient *http.Client
+}
+
+// NewJaegerClient constructs a Jaeger trace source; limit caps the traces returned per request (default 100).
+func NewJaegerClient(baseURL string, limit int, timeout time.Duration) *JaegerClient {
+	if limit <= 0 {
+		limit = 100
+	}
+	return &JaegerClient{baseURL: strings.TrimRight(baseURL, "/"), limit: limit, httpClient: &http.Client{Timeout: timeout}}
+}
+
+// FetchTraceSpans returns every span of the service's traces within the window, restricted to the
+// environment's traces when the context carries one.
+func (c *JaegerClient) FetchTraceSpans(ctx context.Context, tenantID, service string, start, end time.Time) ([]TraceSpan, error) {
+	if c == nil {
+		return nil, fmt.Errorf("jaeger client not initialised")
+	}
+	if c.baseURL == "" {
+		return nil, fmt.Errorf("jaeger base URL not configured")
+	}
+
+	params := url.Values{}
+	params.Set("service", service)
+	params.Set("start", strconv.FormatInt(start.UnixMicro(), 10))
+	params.Set("end", strconv.FormatInt(end.UnixMicro(), 10))
+	params.Set("limit", strconv.Itoa(c.limit))
+	if environment := Environment(ctx); environment != "" {
+		tags, err := json.Marshal(map[string]string{environmentAttribute: environment})
+		if err != nil {
+			return nil, err
+		}
+		params.Set("tags", string(tags))
+	}
+
+	var response struct {
+		Data []struct {
+			TraceID string `json:"traceID"`
+			Spans   []struct {
+				TraceID       string `json:"traceID"`
+				SpanID        string `json:"spanID"`
+				OperationName string `json:"operationName"`
+				StartTime     int64  `json:"startTime"`
+				Duration      int64  `json:"duration"`
+				ProcessID     string `json:"processID"`
+				Tags          []struct {
+					Key   string `json:"key"`
+					Value any    `json:"value"`
+				} `json:"tags"`
+			} `json:"spans"`
+			Processes map[string]struct {
+				ServiceName string `json:"serviceName"`
+			} `json:"processes"`
+		} `json:"data"`
+	}
+	if err := getJSON(ctx, c.httpClient, c.baseURL+"/api/traces?"+params.Encode(), &response); err != nil {
+		return nil, fmt.Errorf("jaeger traces request failed: %w", err)
+	}
+
+	var spans []TraceSpan
+	for _, trace := range response.Data {
+		for _, span := range trace.Spans {
+			status := "ok"
+			for _, tag := range span.Tags {
+				if isErrorTag(tag.Key, tag.Value) {
+					status = "error"
+					break
+				}
+			}
+			spans = append(spans, TraceSpan{
+				TraceID:   firstNonEmpty(span.TraceID, trace.TraceID),
+				SpanID:    span.SpanID,
+				Service:   firstNonEmpty(trace.Processes[span.ProcessID].ServiceName, service),
+				Operation: span.OperationName,
+				Duration:  time.Duration(span.Duration) * time.Microsecond,
+				Status:    status,
+				Timestamp: time.UnixMicro(span.StartTime).UTC(),
+			})
+		}
+	}
+	if len(spans) == 0 {
+		return nil, fmt.Errorf("jaeger returned no spans")
+	}
+	return spans, nil
+}
+
+// TempoClient fetches spans from the Grafana Tempo search API using TraceQL.
+type TempoClient struct {
+	baseURL    string
+	limit      int
+	httpClient *http.Client
+}
+
+// NewTempoClient constructs a Tempo trace source; limit caps the traces returned per request (default 100).
+func NewTempoClient(baseURL string, limit int, timeout time.Duration) *TempoClient {
+	if limit <= 0 {
+		limit = 100
+	}
+	return &TempoClient{baseURL: strings.TrimRight(baseURL, "/"), limit: limit, httpClient: &http.Client{Timeout: timeout}}
+}
+
+// FetchTraceSpans returns the service's matching spans within the window, and environment when the context
+// carries one. Traces without span sets are mapped to a single root span.
+func (c *TempoClient) FetchTraceSpans(ctx context.Context, tenantID, service string, start, end time.Time) ([]TraceSpan, error) {
+	if c == nil {
+		return nil, fmt.Errorf("tempo client not initialised")
+	}
+	if c.baseURL == "" {
+		return nil, fmt.Errorf("tempo base URL not configured")
+	}
+
+	params := url.Values{}
+	filter := fmt.Sprintf(`resource.service.name=%q`, service)
+	if environment := Environment(ctx); environment != "" {
+		filter += fmt.Sprintf(` && resource.%s=%q`, environmentAttribute, environment)
+	}
+	params.Set("q", fmt.Sprintf(`{%s} | select(status, name)`, filter))
+	params.Set("start", strconv.FormatInt(start.Unix(), 10))
+	params.Set("end", strconv.FormatInt(end.Unix(), 10))
+	params.Set("limit", strconv.Itoa(c.limit))
+
+	type attribute struct {
+		Key   string `json:"key"`
+		Value struct {
+			StringValue string `json:"stringValue"`
+		} `json:"value"`
+	}
+	var response struct {
+		Traces []struct {
+			TraceID           string `json:"traceID"`
+			RootServiceName   string `json:"rootServiceName"`
+			RootTraceName     string `json:"rootTraceName"`
+			StartTimeUnixNano string `json:"startTimeUnixNano"`
+			DurationMs        int64  `json:"durationMs"`
+			SpanSets          []struct {
+				Spans []struct {
+					SpanID            string      `json:"spanID"`
+					Name              string      `json:"name"`
+					StartTimeUnixNano string      `json:"startTimeUnixNano"`
+					DurationNanos     string      `json:"durationNanos"`
+					Attributes        []attribute `json:"attributes"`
+				} `json:"spans"`
+			} `json:"spanSets"`
+		} `json:"traces"`
+	}
+	if err := getJSON(ctx, c.httpClient, c.baseURL+"/api/search?"+params.Encode(), &response); err != nil {
+		return nil, fmt.Errorf("tempo search request failed: %w", err)
+	}
+
+	var spans []TraceSpan
+	for _, trace := range response.Traces {
+		matched := false
+		for _, set := range trace.SpanSets {
+			for _, span := range set.Spans {
+				matched = true
+				status := "ok"
+				for _, attr := range span.Attributes {
+					if attr.Key == "status" && strings.EqualFold(attr.Value.StringValue, "error") {
+						status = "error"
+					}
+				}
+				durationNanos, _ := strconv.ParseInt(span.DurationNanos, 10, 64)
+				spans = append(spans, TraceSpan{
+					TraceID:   trace.TraceID,
+					SpanID:    span.SpanID,
+					Service:   service,
+					Operation: firstNonEmpty(span.Name, trace.RootTraceName),
+					Duration:  time.Duration(durationNanos),
+					Status:    status,
+					Timestamp: unixNano(span.StartTimeUnixNano),
+				})
+			}
+		}
+		if !matched {
+			spans = append(spans, TraceSpan{
+				TraceID:   trace.TraceID,
+				Service:   firstNonEmpty(trace.RootServiceName, service),
+				Operation: trace.RootTraceName,
+				Duration:  time.Duration(trace.DurationMs) * time.Millisecond,
+				Status:    "ok",
+				Timestamp: unixNano(trace.StartTimeUnixNano),
+			})
+		}
+	}
+	if len(spans) == 0 {
+		return nil, fmt.Errorf("tempo returned no spans")
+	}
+	return spans, nil
+}
+
+func isErrorTag(key string, value any) bool {
+	switch key {
+	case "error":
+		b, ok := value.(bool)
+		return ok && b
+	case "otel.status_code":
+		s, ok := value.(string)
+		return ok && strings.EqualFold(s, "error")
+	}
+	return false
+}
+
+func unixNano(raw string) time.Time {
+	n, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(0, n).UTC()
+}
+
+func getJSON(ctx context.Context, client *http.Client, endpoint string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("upstream returned %s", resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
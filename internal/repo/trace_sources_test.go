@@ -0,0 +1,81 @@
+package repo
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestJaegerClientMapsSpans(t *testing.T) {
+	client := NewJaegerClient("http://jaeger", 0, time.Second)
+	client.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/api/traces" || req.URL.Query().Get("service") != "checkout" {
+			t.Fatalf("unexpected request: %s", req.URL)
+		}
+		body := `{"data":[{"traceID":"t1","spans":[{"traceID":"t1","spanID":"s1","operationName":"POST /pay","startTime":1700000000000000,"duration":250000,"processID":"p1","tags":[{"key":"error","value":true}]}],"processes":{"p1":{"serviceName":"payments"}}}]}`
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+	}))
+
+	core := NewMiradorCoreClient("", "", "", "", "", time.Second, nil, 0, WithTraceSource(client))
+	start := time.Unix(1_700_000_000, 0)
+	spans, err := core.FetchTraceSpans(context.Background(), "tenant", "checkout", start, start.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(spans) != 1 || spans[0].Service != "payments" || spans[0].Status != "error" || spans[0].Duration != 250*time.Millisecond {
+		t.Fatalf("unexpected spans: %+v", spans)
+	}
+}
+
+func TestTempoClientMapsSpanSets(t *testing.T) {
+	client := NewTempoClient("http://tempo", 0, time.Second)
+	client.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/api/search" || !strings.Contains(req.URL.Query().Get("q"), `resource.service.name="checkout"`) {
+			t.Fatalf("unexpected request: %s", req.URL)
+		}
+		body := `{"traces":[{"traceID":"t1","rootServiceName":"checkout","rootTraceName":"GET /","startTimeUnixNano":"1700000000000000000","durationMs":40,"spanSets":[{"spans":[{"spanID":"s1","name":"db.query","startTimeUnixNano":"1700000000000000000","durationNanos":"30000000","attributes":[{"key":"status","value":{"stringValue":"error"}}]}]}]},{"traceID":"t2","rootServiceName":"checkout","rootTraceName":"GET /health","startTimeUnixNano":"1700000001000000000","durationMs":5}]}`
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+	}))
+
+	start := time.Unix(1_700_000_000, 0)
+	spans, err := client.FetchTraceSpans(context.Background(), "tenant", "checkout", start, start.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(spans) != 2 || spans[0].Operation != "db.query" || spans[0].Status != "error" || spans[1].Duration != 5*time.Millisecond {
+		t.Fatalf("unexpected spans: %+v", spans)
+	}
+}
+
+func TestTraceSourcesScopeEnvironment(t *testing.T) {
+	ctx := WithEnvironment(context.Background(), "staging")
+	start := time.Unix(1_700_000_000, 0)
+	ok := func(body string) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+	}
+
+	jaeger := NewJaegerClient("http://jaeger", 0, time.Second)
+	jaeger.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if got := req.URL.Query().Get("tags"); got != `{"deployment.environment":"staging"}` {
+			t.Fatalf("unexpected jaeger tags: %s", got)
+		}
+		return ok(`{"data":[{"traceID":"t1","spans":[{"spanID":"s1","processID":"p1"}],"processes":{"p1":{"serviceName":"checkout"}}}]}`)
+	}))
+	if _, err := jaeger.FetchTraceSpans(ctx, "tenant", "checkout", start, start.Add(time.Minute)); err != nil {
+		t.Fatalf("unexpected jaeger error: %v", err)
+	}
+
+	tempo := NewTempoClient("http://tempo", 0, time.Second)
+	tempo.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if got := req.URL.Query().Get("q"); !strings.Contains(got, `resource.deployment.environment="staging"`) {
+			t.Fatalf("unexpected tempo query: %s", got)
+		}
+		return ok(`{"traces":[{"traceID":"t1","rootServiceName":"checkout","startTimeUnixNano":"1700000000000000000","durationMs":5}]}`)
+	}))
+	if _, err := tempo.FetchTraceSpans(ctx, "tenant", "checkout", start, start.Add(time.Minute)); err != nil {
+		t.Fatalf("unexpected tempo error: %v", err)
+	}
+}
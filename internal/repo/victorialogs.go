@@ -0,0 +1,139 @@
+package repo
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LogSource fetches log aggregates for a service. MiradorCoreClient implements it natively; alternative
+// backends plug in through WithLogSource.
+type LogSource interface {
+	FetchLogEntries(ctx context.Context, tenantID, service string, start, end time.Time) ([]LogEntry, error)
+}
+
+// WithLogSource routes log fetches to source instead of the mirador-core RCA endpoint.
+func WithLogSource(source LogSource) CoreClientOption {
+	return func(c *MiradorCoreClient) {
+		c.logSource = source
+	}
+}
+
+// VictoriaLogsClient executes LogsQL queries against VictoriaLogs /select/logsql/query and aggregates the
+// matching lines per minute, message, and severity.
+type VictoriaLogsClient struct {
+	baseURL       string
+	query         string
+	severityField string
+	limit         int
+	httpClient    *http.Client
+}
+
+// NewVictoriaLogsClient constructs a VictoriaLogs log source. query is a LogsQL template in which {service},
+// {tenant}, and {environment} are substituted; {tenant} may also appear in baseURL. severityField names the log
+// field holding the level (default "level") and limit caps the lines read per request (default 5000).
+func NewVictoriaLogsClient(baseURL, query, severityField string, limit int, timeout time.Duration) *VictoriaLogsClient {
+	if severityField == "" {
+		severityField = "level"
+	}
+	if limit <= 0 {
+		limit = 5000
+	}
+	return &VictoriaLogsClient{
+		baseURL:       strings.TrimRight(baseURL, "/"),
+		query:         query,
+		severityField: severityField,
+		limit:         limit,
+		httpClient:    &http.Client{Timeout: timeout},
+	}
+}
+
+// FetchLogEntries runs the LogsQL query for the service and window.
+func (c *VictoriaLogsClient) FetchLogEntries(ctx context.Context, tenantID, service string, start, end time.Time) ([]LogEntry, error) {
+	if c == nil {
+		return nil, fmt.Errorf("victorialogs client not initialised")
+	}
+	if c.baseURL == "" {
+		return nil, fmt.Errorf("victorialogs base URL not configured")
+	}
+	if c.query == "" {
+		return nil, fmt.Errorf("victorialogs query not configured")
+	}
+
+	replacer := strings.NewReplacer("{service}", service, "{tenant}", tenantID, "{environment}", Environment(ctx))
+	params := url.Values{}
+	params.Set("query", replacer.Replace(c.query))
+	params.Set("start", start.UTC().Format(time.RFC3339))
+	params.Set("end", end.UTC().Format(time.RFC3339))
+	params.Set("limit", strconv.Itoa(c.limit))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, replacer.Replace(c.baseURL)+"/select/logsql/query?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("victorialogs request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("victorialogs returned %s", resp.Status)
+	}
+
+	type bucketKey struct {
+		minute   int64
+		message  string
+		severity string
+	}
+	buckets := make(map[bucketKey]*LogEntry)
+
+	// The response is newline-delimited JSON; lines are folded into buckets as they stream in.
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var fields map[string]string
+		if err := json.Unmarshal(line, &fields); err != nil {
+			continue
+		}
+		ts, err := time.Parse(time.RFC3339Nano, fields["_time"])
+		if err != nil {
+			continue
+		}
+		minute := ts.Truncate(time.Minute)
+		key := bucketKey{minute: minute.Unix(), message: fields["_msg"], severity: strings.ToLower(fields[c.severityField])}
+		if entry, ok := buckets[key]; ok {
+			entry.Count++
+			continue
+		}
+		buckets[key] = &LogEntry{Timestamp: minute, Message: key.message, Severity: key.severity, Count: 1}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read victorialogs response: %w", err)
+	}
+
+	entries := make([]LogEntry, 0, len(buckets))
+	for _, entry := range buckets {
+		entries = append(entries, *entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if !entries[i].Timestamp.Equal(entries[j].Timestamp) {
+			return entries[i].Timestamp.Before(entries[j].Timestamp)
+		}
+		return entries[i].Message < entries[j].Message
+	})
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("victorialogs returned no entries")
+	}
+	return entries, nil
+}
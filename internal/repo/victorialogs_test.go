@@ -0,0 +1,39 @@
+package repo
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVictoriaLogsClientAggregatesLines(t *testing.T) {
+	client := NewVictoriaLogsClient("http://victorialogs", `service:"{service}"`, "", 0, time.Second)
+	client.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/select/logsql/query" {
+			t.Fatalf("unexpected path: %s", req.URL.Path)
+		}
+		if got := req.URL.Query().Get("query"); got != `service:"checkout"` {
+			t.Fatalf("unexpected query: %s", got)
+		}
+		body := strings.Join([]string{
+			`{"_time":"2024-01-01T00:00:05Z","_msg":"upstream 503","level":"ERROR"}`,
+			`{"_time":"2024-01-01T00:00:40Z","_msg":"upstream 503","level":"ERROR"}`,
+			`{"_time":"2024-01-01T00:01:10Z","_msg":"upstream 503","level":"ERROR"}`,
+			`not json`,
+		}, "\n")
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+	}))
+
+	core := NewMiradorCoreClient("", "", "", "", "", time.Second, nil, 0, WithLogSource(client))
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	entries, err := core.FetchLogEntries(context.Background(), "tenant", "checkout", start, start.Add(5*time.Minute))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 2 || entries[0].Count != 2 || entries[1].Count != 1 || entries[0].Severity != "error" {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+}
@@ -0,0 +1,144 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MetricSource fetches metric samples for a service. MiradorCoreClient implements it natively; alternative
+// backends plug in through WithMetricSource.
+type MetricSource interface {
+	FetchMetricSeries(ctx context.Context, tenantID, service string, start, end time.Time) ([]MetricPoint, error)
+}
+
+// WithMetricSource routes metric (and baseline) fetches to source instead of the mirador-core RCA endpoint.
+func WithMetricSource(source MetricSource) CoreClientOption {
+	return func(c *MiradorCoreClient) {
+		c.metricSource = source
+	}
+}
+
+// VictoriaMetricsClient queries VictoriaMetrics /api/v1/query_range directly using PromQL templates, for
+// deployments where the mirador-core RCA helper endpoints are unavailable.
+type VictoriaMetricsClient struct {
+	baseURL    string
+	queries    map[string]string
+	step       time.Duration
+	httpClient *http.Client
+}
+
+// NewVictoriaMetricsClient constructs a VictoriaMetrics metric source. queries maps series names to PromQL
+// templates; {service}, {tenant}, and {environment} are substituted per request, and {tenant} may also appear
+// in baseURL (e.g. a cluster select path). A non-positive step defaults to 30s.
+func NewVictoriaMetricsClient(baseURL string, queries map[string]string, step, timeout time.Duration) *VictoriaMetricsClient {
+	if step <= 0 {
+		step = 30 * time.Second
+	}
+	return &VictoriaMetricsClient{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		queries:    queries,
+		step:       step,
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+// FetchMetricSeries evaluates every configured PromQL template over the window, tagging samples with the
+// template name.
+func (c *VictoriaMetricsClient) FetchMetricSeries(ctx context.Context, tenantID, service string, start, end time.Time) ([]MetricPoint, error) {
+	if c == nil {
+		return nil, fmt.Errorf("victoriametrics client not initialised")
+	}
+	if c.baseURL == "" {
+		return nil, fmt.Errorf("victoriametrics base URL not configured")
+	}
+	if len(c.queries) == 0 {
+		return nil, fmt.Errorf("victoriametrics queries not configured")
+	}
+
+	replacer := strings.NewReplacer("{service}", service, "{tenant}", tenantID, "{environment}", Environment(ctx))
+	names := make([]string, 0, len(c.queries))
+	for name := range c.queries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var points []MetricPoint
+	for _, name := range names {
+		series, err := c.queryRange(ctx, replacer.Replace(c.baseURL), replacer.Replace(c.queries[name]), start, end)
+		if err != nil {
+			return nil, fmt.Errorf("victoriametrics query %s: %w", name, err)
+		}
+		for _, s := range series {
+			points = append(points, MetricPoint{Name: name, Timestamp: s.Timestamp, Value: s.Value})
+		}
+	}
+	if len(points) == 0 {
+		return nil, fmt.Errorf("victoriametrics returned no samples")
+	}
+	return points, nil
+}
+
+func (c *VictoriaMetricsClient) queryRange(ctx context.Context, baseURL, query string, start, end time.Time) ([]MetricPoint, error) {
+	params := url.Values{}
+	params.Set("query", query)
+	params.Set("start", strconv.FormatInt(start.Unix(), 10))
+	params.Set("end", strconv.FormatInt(end.Unix(), 10))
+	params.Set("step", c.step.String())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"/api/v1/query_range?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("victoriametrics returned %s", resp.Status)
+	}
+
+	var response struct {
+		Status string `json:"status"`
+		Error  string `json:"error"`
+		Data   struct {
+			Result []struct {
+				Values [][2]any `json:"values"`
+			} `json:"result"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	if response.Status != "success" {
+		return nil, fmt.Errorf("query failed: %s", response.Error)
+	}
+
+	var points []MetricPoint
+	for _, series := range response.Data.Result {
+		for _, pair := range series.Values {
+			ts, ok := pair[0].(float64)
+			if !ok {
+				continue
+			}
+			raw, ok := pair[1].(string)
+			if !ok {
+				continue
+			}
+			value, err := strconv.ParseFloat(raw, 64)
+			if err != nil {
+				continue
+			}
+			sec, frac := int64(ts), ts-float64(int64(ts))
+			points = append(points, MetricPoint{Timestamp: time.Unix(sec, int64(frac*float64(time.Second))).UTC(), Value: value})
+		}
+	}
+	return points, nil
+}
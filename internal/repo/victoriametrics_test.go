@@ -0,0 +1,39 @@
+package repo
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestVictoriaMetricsClientRendersTemplates(t *testing.T) {
+	client := NewVictoriaMetricsClient("http://vmselect/select/{tenant}/prometheus", map[string]string{
+		"error_rate": `sum(rate(errors_total{service="{service}"}[5m]))`,
+	}, time.Minute, time.Second)
+	client.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/select/tenant-a/prometheus/api/v1/query_range" {
+			t.Fatalf("unexpected path: %s", req.URL.Path)
+		}
+		if got := req.URL.Query().Get("query"); got != `sum(rate(errors_total{service="checkout"}[5m]))` {
+			t.Fatalf("unexpected query: %s", got)
+		}
+		if req.URL.Query().Get("step") != "1m0s" {
+			t.Fatalf("unexpected step: %s", req.URL.Query().Get("step"))
+		}
+		body := `{"status":"success","data":{"resultType":"matrix","result":[{"metric":{},"values":[[1700000000,"0.5"],[1700000060,"1.5"]]}]}}`
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader([]byte(body))), Header: make(http.Header)}, nil
+	}))
+
+	core := NewMiradorCoreClient("", "", "", "", "", time.Second, nil, 0, WithMetricSource(client))
+	start := time.Unix(1_700_000_000, 0)
+	points, err := core.FetchMetricSeries(context.Background(), "tenant-a", "checkout", start, start.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(points) != 2 || points[1].Name != "error_rate" || points[1].Value != 1.5 || !points[1].Timestamp.Equal(start.Add(time.Minute)) {
+		t.Fatalf("unexpected points: %+v", points)
+	}
+}
@@ -0,0 +1,148 @@
+package repo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// maxAnnotationObjects bounds one annotation lookup; it covers a page of correlations with a long discussion
+// each.
+const maxAnnotationObjects = 10000
+
+// Annotations are stored as CorrelationAnnotation objects rather than rewritten into the correlation record:
+// Weaviate has no conditional update, so two annotators appending to the record's array at once would each
+// overwrite the other's entry. Creating an object per annotation never loses one. Records written before
+// this keep their embedded annotations, which are listed first.
+
+// storeAnnotation creates one annotation object for a correlation.
+func (r *WeaviateRepo) storeAnnotation(ctx context.Context, tenantID, correlationID string, annotation models.Annotation) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"class":  "CorrelationAnnotation",
+		"tenant": tenantID,
+		"properties": map[string]interface{}{
+			"tenantId":      tenantID,
+			"correlationId": correlationID,
+			"author":        annotation.Author,
+			"text":          annotation.Text,
+			"createdAt":     annotation.CreatedAt.UTC().Format(time.RFC3339Nano),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.endpoint+"/v1/objects", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	r.authorize(req)
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("weaviate store annotation: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("store annotation failed: %s", strings.TrimSpace(string(data)))
+	}
+	return nil
+}
+
+// attachAnnotations appends the annotation objects of correlations to them, oldest first after any embedded
+// annotations.
+func (r *WeaviateRepo) attachAnnotations(ctx context.Context, tenantID string, correlations []*models.CorrelationResult) error {
+	if len(correlations) == 0 {
+		return nil
+	}
+	byID := make(map[string]*models.CorrelationResult, len(correlations))
+	ids := make([]string, 0, len(correlations))
+	for _, correlation := range correlations {
+		if _, ok := byID[correlation.CorrelationID]; !ok {
+			ids = append(ids, correlation.CorrelationID)
+		}
+		byID[correlation.CorrelationID] = correlation
+	}
+
+	gql := fmt.Sprintf(`{
+  Get {
+    CorrelationAnnotation(
+      limit: %d
+      %s
+      sort: [{path: "createdAt", order: asc}]
+    ) {
+      correlationId
+      author
+      text
+      createdAt
+    }
+  }
+}`, maxAnnotationObjects, whereAnd(
+		whereOperand("tenantId", "Equal", "valueString", tenantID),
+		whereOperandList("correlationId", "ContainsAny", "valueString", ids),
+	))
+
+	payload, err := json.Marshal(map[string]interface{}{"query": gql})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.endpoint+"/v1/graphql", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	r.authorize(req)
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("weaviate list annotations: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("weaviate list annotations returned %s", resp.Status)
+	}
+
+	var response struct {
+		Data struct {
+			Get struct {
+				CorrelationAnnotation []struct {
+					CorrelationID string `json:"correlationId"`
+					Author        string `json:"author"`
+					Text          string `json:"text"`
+					CreatedAt     string `json:"createdAt"`
+				} `json:"CorrelationAnnotation"`
+			} `json:"Get"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return fmt.Errorf("decode annotations: %w", err)
+	}
+
+	appended := map[string]int{}
+	for _, rec := range response.Data.Get.CorrelationAnnotation {
+		correlation, ok := byID[rec.CorrelationID]
+		if !ok {
+			continue
+		}
+		if _, seen := appended[rec.CorrelationID]; !seen {
+			appended[rec.CorrelationID] = len(correlation.Annotations)
+		}
+		createdAt, _ := time.Parse(time.RFC3339Nano, rec.CreatedAt)
+		correlation.Annotations = append(correlation.Annotations, models.Annotation{Author: rec.Author, Text: rec.Text, CreatedAt: createdAt})
+	}
+	for id, from := range appended {
+		objects := byID[id].Annotations[from:]
+		sort.SliceStable(objects, func(i, j int) bool { return objects[i].CreatedAt.Before(objects[j].CreatedAt) })
+	}
+	return nil
+}
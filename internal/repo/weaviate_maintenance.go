@@ -0,0 +1,156 @@
+package repo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// maxMaintenanceWindows bounds one maintenance window lookup.
+const maxMaintenanceWindows = 1000
+
+// StoreMaintenanceWindow persists a maintenance window as a MaintenanceWindow object.
+func (r *WeaviateRepo) StoreMaintenanceWindow(ctx context.Context, window models.MaintenanceWindow) error {
+	if r == nil {
+		return fmt.Errorf("weaviate repo not initialised")
+	}
+	if r.endpoint == "" {
+		return nil
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"class":  "MaintenanceWindow",
+		"tenant": window.TenantID,
+		"properties": map[string]interface{}{
+			"tenantId": window.TenantID,
+			"windowId": window.ID,
+			"services": nonNilStrings(window.Services),
+			"start":    window.Start.UTC().Format(time.RFC3339),
+			"end":      window.End.UTC().Format(time.RFC3339),
+			"reason":   window.Reason,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.endpoint+"/v1/objects", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	r.authorize(req)
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("weaviate store maintenance window: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("store maintenance window failed: %s", strings.TrimSpace(string(data)))
+	}
+	return nil
+}
+
+// ListMaintenanceWindows returns up to 1000 of the tenant's stored maintenance windows ordered by start time.
+func (r *WeaviateRepo) ListMaintenanceWindows(ctx context.Context, tenantID string) ([]models.MaintenanceWindow, error) {
+	if r == nil {
+		return nil, fmt.Errorf("weaviate repo not initialised")
+	}
+	if r.endpoint == "" {
+		return nil, nil
+	}
+
+	gql := fmt.Sprintf(`{
+  Get {
+    MaintenanceWindow(
+      limit: %d
+      %s
+      sort: [{path: "start", order: asc}]
+    ) {
+      windowId
+      services
+      start
+      end
+      reason
+    }
+  }
+}`, maxMaintenanceWindows, whereAnd(whereOperand("tenantId", "Equal", "valueString", tenantID)))
+
+	payload, err := json.Marshal(map[string]interface{}{"query": gql})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.endpoint+"/v1/graphql", bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	r.authorize(req)
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("weaviate list maintenance windows: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("weaviate list maintenance windows returned %s", resp.Status)
+	}
+
+	var response struct {
+		Data struct {
+			Get struct {
+				MaintenanceWindow []struct {
+					WindowID string    `json:"windowId"`
+					Services []string  `json:"services"`
+					Start    time.Time `json:"start"`
+					End      time.Time `json:"end"`
+					Reason   string    `json:"reason"`
+				} `json:"MaintenanceWindow"`
+			} `json:"Get"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("decode maintenance windows: %w", err)
+	}
+
+	windows := make([]models.MaintenanceWindow, 0, len(response.Data.Get.MaintenanceWindow))
+	for _, rec := range response.Data.Get.MaintenanceWindow {
+		windows = append(windows, models.MaintenanceWindow{
+			ID:       rec.WindowID,
+			TenantID: tenantID,
+			Services: rec.Services,
+			Start:    rec.Start,
+			End:      rec.End,
+			Reason:   rec.Reason,
+		})
+	}
+	return windows, nil
+}
+
+// DeleteMaintenanceWindow deletes a tenant's maintenance window by ID and reports whether it existed.
+func (r *WeaviateRepo) DeleteMaintenanceWindow(ctx context.Context, tenantID, id string) (bool, error) {
+	if r == nil {
+		return false, fmt.Errorf("weaviate repo not initialised")
+	}
+	if r.endpoint == "" {
+		return false, nil
+	}
+	where := map[string]interface{}{"operator": "And", "operands": []map[string]interface{}{
+		{"path": []string{"tenantId"}, "operator": "Equal", "valueText": tenantID},
+		{"path": []string{"windowId"}, "operator": "Equal", "valueText": id},
+	}}
+	deleted, err := r.batchDelete(ctx, "MaintenanceWindow", tenantID, where, false)
+	if err != nil {
+		return false, fmt.Errorf("delete maintenance window: %w", err)
+	}
+	return deleted > 0, nil
+}
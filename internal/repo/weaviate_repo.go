@@ -7,49 +7,111 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"sort"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/miradorstack/mirador-rca/internal/cache"
+	"github.com/miradorstack/mirador-rca/internal/metrics"
 	"github.com/miradorstack/mirador-rca/internal/models"
 )
 
 // WeaviateRepo provides read access to previously stored incidents and patterns.
 type WeaviateRepo struct {
 	endpoint   string
-	apiKey     string
+	apiKey     atomic.Pointer[string]
 	httpClient *http.Client
-	cache      cache.Provider
-	similarTTL time.Duration
-	patternTTL time.Duration
+	cache      *cache.Loader
+	similarTTL cacheTTL
+	patternTTL cacheTTL
+	// negativeTTL caches empty similar-incident and pattern results; zero leaves them uncached.
+	negativeTTL cacheTTL
 }
 
-// NewWeaviateRepo constructs a Weaviate client.
-func NewWeaviateRepo(endpoint, apiKey string, timeout time.Duration, cacheProvider cache.Provider, similarTTL, patternTTL time.Duration) *WeaviateRepo {
-	if cacheProvider == nil {
-		cacheProvider = cache.NoopProvider{}
+// WeaviateOption customises optional WeaviateRepo behaviour.
+type WeaviateOption func(*WeaviateRepo)
+
+// WithWeaviateCircuitBreaker short-circuits Weaviate calls while the cluster is failing; reads then fall back
+// to their degraded responses immediately instead of waiting for timeouts.
+func WithWeaviateCircuitBreaker(breaker *CircuitBreaker) WeaviateOption {
+	return func(r *WeaviateRepo) {
+		withBreaker(r.httpClient, breaker)
+	}
+}
+
+// WithNegativeCacheTTL caches empty similar-incident and pattern results for ttl (at most the positive TTL),
+// so repeated investigations during an incident do not re-run identical empty queries.
+func WithNegativeCacheTTL(ttl time.Duration) WeaviateOption {
+	return func(r *WeaviateRepo) {
+		if ttl > 0 {
+			r.negativeTTL.set(ttl)
+		}
 	}
+}
+
+// NewWeaviateRepo constructs a Weaviate client.
+func NewWeaviateRepo(endpoint, apiKey string, timeout time.Duration, cacheProvider cache.Provider, similarTTL, patternTTL time.Duration, opts ...WeaviateOption) *WeaviateRepo {
 	if timeout <= 0 {
 		timeout = 5 * time.Second
 	}
-	if similarTTL < 0 {
-		similarTTL = 0
-	}
- 	if patternTTL < 0 {
- 		patternTTL = 0
- 	}
-	return &WeaviateRepo{
+	repo := &WeaviateRepo{
 		endpoint:   strings.TrimRight(endpoint, "/"),
-		apiKey:     apiKey,
 		httpClient: &http.Client{Timeout: timeout},
-		cache:      cacheProvider,
-		similarTTL: similarTTL,
-		patternTTL: patternTTL,
+		cache:      cache.NewLoader(cacheProvider, 0),
+	}
+	repo.SetAPIKey(apiKey)
+	repo.SetCacheTTLs(similarTTL, patternTTL, 0)
+	withInstrumentation(repo.httpClient, "weaviate", weaviateEndpointLabel)
+	for _, opt := range opts {
+		opt(repo)
+	}
+	return repo
+}
+
+// SetAPIKey changes the bearer token sent to Weaviate, for example after a secret rotation; it applies to
+// requests started afterwards.
+func (r *WeaviateRepo) SetAPIKey(apiKey string) {
+	r.apiKey.Store(&apiKey)
+}
+
+func (r *WeaviateRepo) authorize(req *http.Request) {
+	if apiKey := *r.apiKey.Load(); apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+apiKey)
 	}
 }
 
+// Ready checks that Weaviate is up and able to serve queries via its readiness endpoint.
+func (r *WeaviateRepo) Ready(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.endpoint+"/v1/.well-known/ready", nil)
+	if err != nil {
+		return err
+	}
+	r.authorize(req)
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("weaviate not ready: %s", resp.Status)
+	}
+	return nil
+}
+
+// SetCacheTTLs changes the similar-incident, pattern, and negative cache lifetimes at runtime; negative
+// values are treated as zero and entries already cached keep their original expiry.
+func (r *WeaviateRepo) SetCacheTTLs(similarTTL, patternTTL, negativeTTL time.Duration) {
+	r.similarTTL.set(max(similarTTL, 0))
+	r.patternTTL.set(max(patternTTL, 0))
+	r.negativeTTL.set(max(negativeTTL, 0))
+}
+
 // StorePatterns persists mined failure patterns.
 func (r *WeaviateRepo) StorePatterns(ctx context.Context, tenantID string, patterns []models.FailurePattern) error {
 	if r == nil {
@@ -58,6 +120,7 @@ func (r *WeaviateRepo) StorePatterns(ctx context.Context, tenantID string, patte
 	if r.endpoint == "" {
 		return nil
 	}
+	defer r.invalidatePatterns(ctx, tenantID, patterns)
 
 	for _, pattern := range patterns {
 		payload := map[string]interface{}{
@@ -79,9 +142,7 @@ func (r *WeaviateRepo) StorePatterns(ctx context.Context, tenantID string, patte
 			return err
 		}
 		req.Header.Set("Content-Type", "application/json")
-		if r.apiKey != "" {
-			req.Header.Set("Authorization", "Bearer "+r.apiKey)
-		}
+		r.authorize(req)
 
 		resp, err := r.httpClient.Do(req)
 		if err != nil {
@@ -123,9 +184,7 @@ func (r *WeaviateRepo) StoreFeedback(ctx context.Context, feedback models.Feedba
 		return err
 	}
 	req.Header.Set("Content-Type", "application/json")
-	if r.apiKey != "" {
-		req.Header.Set("Authorization", "Bearer "+r.apiKey)
-	}
+	r.authorize(req)
 
 	resp, err := r.httpClient.Do(req)
 	if err != nil {
@@ -138,9 +197,192 @@ func (r *WeaviateRepo) StoreFeedback(ctx context.Context, feedback models.Feedba
 		return fmt.Errorf("store feedback failed: %s", strings.TrimSpace(string(data)))
 	}
 
+	_ = r.cache.InvalidatePrefix(ctx, cacheSimilarIncidentsPrefix(feedback.TenantID))
 	return nil
 }
 
+// ListFeedback returns the tenant's feedback newest first using offset page tokens.
+func (r *WeaviateRepo) ListFeedback(ctx context.Context, req models.ListFeedbackRequest) (models.ListFeedbackResponse, error) {
+	if r == nil {
+		return models.ListFeedbackResponse{}, fmt.Errorf("weaviate repo not initialised")
+	}
+	if r.endpoint == "" {
+		return models.ListFeedbackResponse{}, nil
+	}
+
+	limit := req.PageSize
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	offset := 0
+	if req.PageToken != "" {
+		if v, err := strconv.Atoi(req.PageToken); err == nil && v >= 0 {
+			offset = v
+		}
+	}
+
+	gql := fmt.Sprintf(`{
+  Get {
+    CorrelationFeedback(
+      limit: %d
+      offset: %d
+      %s
+      sort: [{path: "submittedAt", order: desc}]
+    ) {
+      tenantId
+      correlationId
+      correct
+      notes
+      submittedAt
+    }
+  }
+}`, limit, offset, buildFeedbackWhere(req))
+
+	payload, err := json.Marshal(map[string]interface{}{"query": gql})
+	if err != nil {
+		return models.ListFeedbackResponse{}, err
+	}
+
+	reqHTTP, err := http.NewRequestWithContext(ctx, http.MethodPost, r.endpoint+"/v1/graphql", bytes.NewReader(payload))
+	if err != nil {
+		return models.ListFeedbackResponse{}, err
+	}
+	reqHTTP.Header.Set("Content-Type", "application/json")
+	r.authorize(reqHTTP)
+
+	resp, err := r.httpClient.Do(reqHTTP)
+	if err != nil {
+		return models.ListFeedbackResponse{}, fmt.Errorf("weaviate list feedback: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return models.ListFeedbackResponse{}, fmt.Errorf("weaviate list feedback returned %s", resp.Status)
+	}
+
+	var response struct {
+		Data struct {
+			Get struct {
+				CorrelationFeedback []struct {
+					TenantID      string    `json:"tenantId"`
+					CorrelationID string    `json:"correlationId"`
+					Correct       bool      `json:"correct"`
+					Notes         string    `json:"notes"`
+					SubmittedAt   time.Time `json:"submittedAt"`
+				} `json:"CorrelationFeedback"`
+			} `json:"Get"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return models.ListFeedbackResponse{}, fmt.Errorf("decode feedback response: %w", err)
+	}
+
+	feedback := make([]models.Feedback, 0, len(response.Data.Get.CorrelationFeedback))
+	for _, rec := range response.Data.Get.CorrelationFeedback {
+		feedback = append(feedback, models.Feedback{
+			TenantID:      rec.TenantID,
+			CorrelationID: rec.CorrelationID,
+			Correct:       rec.Correct,
+			Notes:         rec.Notes,
+			SubmittedAt:   rec.SubmittedAt,
+		})
+	}
+
+	nextToken := ""
+	if len(feedback) == limit {
+		nextToken = strconv.Itoa(offset + len(feedback))
+	}
+	return models.ListFeedbackResponse{Feedback: feedback, NextPageToken: nextToken}, nil
+}
+
+// FeedbackStats aggregates feedback accuracy overall, per service, per root-cause category, per failure
+// pattern, and over time.
+func (r *WeaviateRepo) FeedbackStats(ctx context.Context, req models.FeedbackStatsRequest) (models.FeedbackStats, error) {
+	if r == nil {
+		return models.FeedbackStats{}, fmt.Errorf("weaviate repo not initialised")
+	}
+	return collectFeedbackStats(ctx, r, req)
+}
+
+// correlationsByID loads the services and category of the given correlations in batches of 100.
+func (r *WeaviateRepo) correlationsByID(ctx context.Context, tenantID string, ids []string) (map[string]models.CorrelationResult, error) {
+	out := make(map[string]models.CorrelationResult, len(ids))
+	if r.endpoint == "" {
+		return out, nil
+	}
+	for start := 0; start < len(ids); start += 100 {
+		batch := ids[start:min(start+100, len(ids))]
+		gql := fmt.Sprintf(`{
+  Get {
+    CorrelationRecord(
+      limit: %d
+      %s
+    ) {
+      correlationId
+      affectedServices
+      category
+    }
+  }
+}`, len(batch), whereAnd(
+			whereOperand("tenantId", "Equal", "valueString", tenantID),
+			whereOperandList("correlationId", "ContainsAny", "valueString", batch),
+		))
+
+		payload, err := json.Marshal(map[string]interface{}{"query": gql})
+		if err != nil {
+			return nil, err
+		}
+		reqHTTP, err := http.NewRequestWithContext(ctx, http.MethodPost, r.endpoint+"/v1/graphql", bytes.NewReader(payload))
+		if err != nil {
+			return nil, err
+		}
+		reqHTTP.Header.Set("Content-Type", "application/json")
+		r.authorize(reqHTTP)
+
+		resp, err := r.httpClient.Do(reqHTTP)
+		if err != nil {
+			return nil, fmt.Errorf("weaviate lookup correlations: %w", err)
+		}
+		var response struct {
+			Data struct {
+				Get struct {
+					CorrelationRecord []correlationRecord `json:"CorrelationRecord"`
+				} `json:"Get"`
+			} `json:"data"`
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("weaviate lookup correlations returned %s", resp.Status)
+		}
+		err = json.NewDecoder(resp.Body).Decode(&response)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("decode correlation lookup: %w", err)
+		}
+		for _, rec := range response.Data.Get.CorrelationRecord {
+			correlation := rec.toModel()
+			out[correlation.CorrelationID] = correlation
+		}
+	}
+	return out, nil
+}
+
+func buildFeedbackWhere(req models.ListFeedbackRequest) string {
+	operands := []string{whereOperand("tenantId", "Equal", "valueString", req.TenantID)}
+	if req.CorrelationID != "" {
+		operands = append(operands, whereOperand("correlationId", "Equal", "valueString", req.CorrelationID))
+	}
+	if req.Correct != nil {
+		operands = append(operands, whereBoolOperand("correct", *req.Correct))
+	}
+	if !req.Start.IsZero() {
+		operands = append(operands, whereOperand("submittedAt", "GreaterThanEqual", "valueDate", req.Start.Format(time.RFC3339)))
+	}
+	if !req.End.IsZero() {
+		operands = append(operands, whereOperand("submittedAt", "LessThanEqual", "valueDate", req.End.Format(time.RFC3339)))
+	}
+	return whereAnd(operands...)
+}
+
 // StoreCorrelation persists a correlation record for later recall.
 func (r *WeaviateRepo) StoreCorrelation(ctx context.Context, tenantID string, correlation models.CorrelationResult) error {
 	if r == nil {
@@ -172,9 +414,7 @@ func (r *WeaviateRepo) StoreCorrelation(ctx context.Context, tenantID string, co
 		return err
 	}
 	req.Header.Set("Content-Type", "application/json")
-	if r.apiKey != "" {
-		req.Header.Set("Authorization", "Bearer "+r.apiKey)
-	}
+	r.authorize(req)
 
 	resp, err := r.httpClient.Do(req)
 	if err != nil {
@@ -190,6 +430,145 @@ func (r *WeaviateRepo) StoreCorrelation(ctx context.Context, tenantID string, co
 	return nil
 }
 
+// GetCorrelation returns one of the tenant's correlations.
+func (r *WeaviateRepo) GetCorrelation(ctx context.Context, tenantID, correlationID string) (models.CorrelationResult, error) {
+	if r == nil {
+		return models.CorrelationResult{}, fmt.Errorf("weaviate repo not initialised")
+	}
+	if r.endpoint == "" {
+		return models.CorrelationResult{}, ErrCorrelationNotFound
+	}
+	correlation, _, err := r.getCorrelation(ctx, tenantID, correlationID)
+	return correlation, err
+}
+
+// UpdateCorrelation applies a status change and new annotations to a stored correlation. Each new annotation
+// is created as its own object, so concurrent annotators never overwrite each other, and the status and labels
+// are then merged into the record with a PATCH.
+func (r *WeaviateRepo) UpdateCorrelation(ctx context.Context, req models.UpdateCorrelationRequest) (models.CorrelationResult, error) {
+	if r == nil {
+		return models.CorrelationResult{}, fmt.Errorf("weaviate repo not initialised")
+	}
+	if r.endpoint == "" {
+		return models.CorrelationResult{}, ErrCorrelationNotFound
+	}
+
+	correlation, objectID, err := r.getCorrelation(ctx, req.TenantID, req.CorrelationID)
+	if err != nil {
+		return models.CorrelationResult{}, err
+	}
+	added := len(correlation.Annotations)
+	applyCorrelationUpdate(&correlation, req, time.Now().UTC())
+	for _, annotation := range correlation.Annotations[added:] {
+		if err := r.storeAnnotation(ctx, req.TenantID, req.CorrelationID, annotation); err != nil {
+			return models.CorrelationResult{}, err
+		}
+	}
+
+	payload := map[string]interface{}{
+		"class": "CorrelationRecord",
+		"properties": map[string]interface{}{
+			"status": string(correlation.Status),
+			"labels": labelPairs(correlation.Labels),
+		},
+	}
+	if req.TenantID != "" {
+		payload["tenant"] = req.TenantID
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return models.CorrelationResult{}, fmt.Errorf("marshal correlation update: %w", err)
+	}
+
+	target := r.endpoint + "/v1/objects/CorrelationRecord/" + url.PathEscape(objectID)
+	if req.TenantID != "" {
+		target += "?tenant=" + url.QueryEscape(req.TenantID)
+	}
+	reqHTTP, err := http.NewRequestWithContext(ctx, http.MethodPatch, target, bytes.NewReader(body))
+	if err != nil {
+		return models.CorrelationResult{}, err
+	}
+	reqHTTP.Header.Set("Content-Type", "application/json")
+	r.authorize(reqHTTP)
+
+	resp, err := r.httpClient.Do(reqHTTP)
+	if err != nil {
+		return models.CorrelationResult{}, fmt.Errorf("weaviate update correlation: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return models.CorrelationResult{}, ErrCorrelationNotFound
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(resp.Body)
+		return models.CorrelationResult{}, fmt.Errorf("weaviate update correlation failed: %s", strings.TrimSpace(string(data)))
+	}
+	return correlation, nil
+}
+
+// getCorrelation loads one correlation together with its Weaviate object ID.
+func (r *WeaviateRepo) getCorrelation(ctx context.Context, tenantID, correlationID string) (models.CorrelationResult, string, error) {
+	gql := fmt.Sprintf(`{
+  Get {
+    CorrelationRecord(
+      limit: 1
+      %s
+    ) {
+%s
+_additional { id }
+    }
+  }
+}`, whereAnd(
+		whereOperand("tenantId", "Equal", "valueString", tenantID),
+		whereOperand("correlationId", "Equal", "valueString", correlationID),
+	), correlationFields)
+
+	payload, err := json.Marshal(map[string]interface{}{"query": gql})
+	if err != nil {
+		return models.CorrelationResult{}, "", err
+	}
+	reqHTTP, err := http.NewRequestWithContext(ctx, http.MethodPost, r.endpoint+"/v1/graphql", bytes.NewReader(payload))
+	if err != nil {
+		return models.CorrelationResult{}, "", err
+	}
+	reqHTTP.Header.Set("Content-Type", "application/json")
+	r.authorize(reqHTTP)
+
+	resp, err := r.httpClient.Do(reqHTTP)
+	if err != nil {
+		return models.CorrelationResult{}, "", fmt.Errorf("weaviate get correlation: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return models.CorrelationResult{}, "", fmt.Errorf("weaviate get correlation returned %s", resp.Status)
+	}
+
+	var response struct {
+		Data struct {
+			Get struct {
+				CorrelationRecord []struct {
+					correlationRecord
+					Additional struct {
+						ID string `json:"id"`
+					} `json:"_additional"`
+				} `json:"CorrelationRecord"`
+			} `json:"Get"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return models.CorrelationResult{}, "", fmt.Errorf("decode correlation: %w", err)
+	}
+	if len(response.Data.Get.CorrelationRecord) == 0 {
+		return models.CorrelationResult{}, "", ErrCorrelationNotFound
+	}
+	rec := response.Data.Get.CorrelationRecord[0]
+	correlation := rec.toModel()
+	if err := r.attachAnnotations(ctx, tenantID, []*models.CorrelationResult{&correlation}); err != nil {
+		return models.CorrelationResult{}, "", err
+	}
+	return correlation, rec.Additional.ID, nil
+}
+
 // SimilarIncidents returns nearest-neighbour correlations for additional context.
 func (r *WeaviateRepo) SimilarIncidents(ctx context.Context, tenantID string, symptoms []string, limit int) ([]models.CorrelationResult, error) {
 	if r == nil {
@@ -197,33 +576,23 @@ func (r *WeaviateRepo) SimilarIncidents(ctx context.Context, tenantID string, sy
 	}
 
 	if r.endpoint == "" {
-		return syntheticSimilarIncidents(symptoms, limit), nil
-	}
-
-	cacheKey := ""
-	if r.similarTTL > 0 {
-		sorted := append([]string(nil), symptoms...)
-		sort.Strings(sorted)
-		cacheKey = cacheSimilarIncidentsKey(tenantID, sorted, limit)
-		if data, err := r.cache.Get(ctx, cacheKey); err == nil {
-			var cached []models.CorrelationResult
-			if err := json.Unmarshal(data, &cached); err == nil {
-				return cached, nil
-			}
-		}
+		return nil, nil
 	}
 
+	sorted := append([]string(nil), symptoms...)
+	sort.Strings(sorted)
+	return cachedFetch(ctx, r.cache, r.similarTTL.get(), r.negativeTTL.get(), cacheSimilarIncidentsKey(tenantID, sorted, limit), func() ([]models.CorrelationResult, error) {
+		return r.querySimilarIncidents(ctx, tenantID, limit)
+	})
+}
+
+func (r *WeaviateRepo) querySimilarIncidents(ctx context.Context, tenantID string, limit int) ([]models.CorrelationResult, error) {
 	gql := map[string]interface{}{
 		"query": fmt.Sprintf(`{
           Get {
             CorrelationRecord(
               limit: %d
-              where: {
-                operator: And
-                operands: [
-                  {path: ["tenantId"], operator: Equal, valueString: "%s"}
-                ]
-              }
+              %s
             ) {
               correlationId
               incidentId
@@ -231,10 +600,20 @@ func (r *WeaviateRepo) SimilarIncidents(ctx context.Context, tenantID string, sy
               confidence
               affectedServices
               recommendations
+              recommendationDetails {
+                text
+                ruleId
+                actions {
+                  type
+                  label
+                  url
+                }
+                generated
+              }
               createdAt
             }
           }
-        }`, limit, tenantID),
+        }`, limit, whereAnd(whereOperand("tenantId", "Equal", "valueString", tenantID))),
 	}
 
 	payload, err := json.Marshal(gql)
@@ -247,37 +626,36 @@ func (r *WeaviateRepo) SimilarIncidents(ctx context.Context, tenantID string, sy
 		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/json")
-	if r.apiKey != "" {
-		req.Header.Set("Authorization", "Bearer "+r.apiKey)
-	}
+	r.authorize(req)
 
 	resp, err := r.httpClient.Do(req)
-	if err != nil || resp.StatusCode != http.StatusOK {
-		if resp != nil {
-			resp.Body.Close()
-		}
-		return syntheticSimilarIncidents(symptoms, limit), nil
+	if err != nil {
+		return nil, fmt.Errorf("weaviate similar incidents: %w", err)
 	}
 	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("weaviate similar incidents returned %s", resp.Status)
+	}
 
 	var response struct {
 		Data struct {
 			Get struct {
 				CorrelationRecord []struct {
-					CorrelationID    string    `json:"correlationId"`
-					IncidentID       string    `json:"incidentId"`
-					RootCause        string    `json:"rootCause"`
-					Confidence       float64   `json:"confidence"`
-					AffectedServices []string  `json:"affectedServices"`
-					Recommendations  []string  `json:"recommendations"`
-					CreatedAt        time.Time `json:"createdAt"`
+					CorrelationID    string                 `json:"correlationId"`
+					IncidentID       string                 `json:"incidentId"`
+					RootCause        string                 `json:"rootCause"`
+					Confidence       float64                `json:"confidence"`
+					AffectedServices []string               `json:"affectedServices"`
+					Recommendations  []string               `json:"recommendations"`
+					Details          []recommendationRecord `json:"recommendationDetails"`
+					CreatedAt        time.Time              `json:"createdAt"`
 				} `json:"CorrelationRecord"`
 			} `json:"Get"`
 		} `json:"data"`
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		return syntheticSimilarIncidents(symptoms, limit), nil
+		return nil, fmt.Errorf("decode similar incidents response: %w", err)
 	}
 
 	results := make([]models.CorrelationResult, 0, len(response.Data.Get.CorrelationRecord))
@@ -288,23 +666,22 @@ func (r *WeaviateRepo) SimilarIncidents(ctx context.Context, tenantID string, sy
 			RootCause:        rec.RootCause,
 			Confidence:       rec.Confidence,
 			AffectedServices: rec.AffectedServices,
-			Recommendations:  rec.Recommendations,
+			Recommendations:  recommendationsFromRecord(rec.Recommendations, rec.Details),
 			CreatedAt:        rec.CreatedAt,
 		})
 	}
 
-	if r.similarTTL > 0 && cacheKey != "" && len(results) > 0 {
-		if payload, err := json.Marshal(results); err == nil {
-			_ = r.cache.Set(ctx, cacheKey, payload, r.similarTTL)
-		}
-	}
-
 	return results, nil
 }
 
 func cacheSimilarIncidentsKey(tenantID string, symptoms []string, limit int) string {
 	joined := strings.Join(symptoms, "|")
-	return fmt.Sprintf("weaviate:similar:%s:%d:%s", tenantID, limit, joined)
+	return fmt.Sprintf("%s%d:%s", cacheSimilarIncidentsPrefix(tenantID), limit, joined)
+}
+
+// cacheSimilarIncidentsPrefix covers every cached similar-incident lookup of a tenant, whatever the symptoms.
+func cacheSimilarIncidentsPrefix(tenantID string) string {
+	return fmt.Sprintf("weaviate:similar:%s:", tenantID)
 }
 
 // ListCorrelations returns historical correlations filtered by tenant/service/time.
@@ -314,7 +691,7 @@ func (r *WeaviateRepo) ListCorrelations(ctx context.Context, req models.ListCorr
 	}
 
 	if r.endpoint == "" {
-		return syntheticCorrelationList(req), nil
+		return models.ListCorrelationsResponse{}, nil
 	}
 
 	limit := req.PageSize
@@ -339,32 +716,10 @@ func (r *WeaviateRepo) ListCorrelations(ctx context.Context, req models.ListCorr
       %s
       sort: [{path: "createdAt", order: desc}]
     ) {
-      correlationId
-      incidentId
-      rootCause
-      confidence
-      affectedServices
-      recommendations
-      createdAt
-      redAnchors {
-        service
-        selector
-        dataType
-        timestamp
-        anomalyScore
-        threshold
-      }
-      timeline {
-        time
-        event
-        service
-        severity
-        anomalyScore
-        dataSource
-      }
+%s
     }
   }
-}`, limit, offset, whereClause)
+}`, limit, offset, whereClause, correlationFields)
 
 	payload, err := json.Marshal(map[string]interface{}{"query": gql})
 	if err != nil {
@@ -376,47 +731,21 @@ func (r *WeaviateRepo) ListCorrelations(ctx context.Context, req models.ListCorr
 		return models.ListCorrelationsResponse{}, err
 	}
 	reqHTTP.Header.Set("Content-Type", "application/json")
-	if r.apiKey != "" {
-		reqHTTP.Header.Set("Authorization", "Bearer "+r.apiKey)
-	}
+	r.authorize(reqHTTP)
 
 	resp, err := r.httpClient.Do(reqHTTP)
-	if err != nil || resp.StatusCode != http.StatusOK {
-		if resp != nil {
-			resp.Body.Close()
-		}
-		return syntheticCorrelationList(req), nil
+	if err != nil {
+		return models.ListCorrelationsResponse{}, fmt.Errorf("weaviate list correlations: %w", err)
 	}
 	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return models.ListCorrelationsResponse{}, fmt.Errorf("weaviate list correlations returned %s", resp.Status)
+	}
 
 	var response struct {
 		Data struct {
 			Get struct {
-				CorrelationRecord []struct {
-					CorrelationID    string   `json:"correlationId"`
-					IncidentID       string   `json:"incidentId"`
-					RootCause        string   `json:"rootCause"`
-					Confidence       float64  `json:"confidence"`
-					AffectedServices []string `json:"affectedServices"`
-					Recommendations  []string `json:"recommendations"`
-					CreatedAt        string   `json:"createdAt"`
-					RedAnchors       []struct {
-						Service      string  `json:"service"`
-						Selector     string  `json:"selector"`
-						DataType     string  `json:"dataType"`
-						Timestamp    string  `json:"timestamp"`
-						AnomalyScore float64 `json:"anomalyScore"`
-						Threshold    float64 `json:"threshold"`
-					} `json:"redAnchors"`
-					Timeline []struct {
-						Time         string  `json:"time"`
-						Event        string  `json:"event"`
-						Service      string  `json:"service"`
-						Severity     string  `json:"severity"`
-						AnomalyScore float64 `json:"anomalyScore"`
-						DataSource   string  `json:"dataSource"`
-					} `json:"timeline"`
-				} `json:"CorrelationRecord"`
+				CorrelationRecord []correlationRecord `json:"CorrelationRecord"`
 			} `json:"Get"`
 		} `json:"data"`
 	}
@@ -427,44 +756,14 @@ func (r *WeaviateRepo) ListCorrelations(ctx context.Context, req models.ListCorr
 
 	correlations := make([]models.CorrelationResult, 0, len(response.Data.Get.CorrelationRecord))
 	for _, rec := range response.Data.Get.CorrelationRecord {
-		createdAt, _ := time.Parse(time.RFC3339, rec.CreatedAt)
-		anchors := make([]models.RedAnchor, 0, len(rec.RedAnchors))
-		for _, anchor := range rec.RedAnchors {
-			ts, _ := time.Parse(time.RFC3339, anchor.Timestamp)
-			anchors = append(anchors, models.RedAnchor{
-				Service:      anchor.Service,
-				Selector:     anchor.Selector,
-				DataType:     parseDataType(anchor.DataType),
-				Timestamp:    ts,
-				AnomalyScore: anchor.AnomalyScore,
-				Threshold:    anchor.Threshold,
-			})
-		}
-
-		timeline := make([]models.TimelineEvent, 0, len(rec.Timeline))
-		for _, event := range rec.Timeline {
-			ts, _ := time.Parse(time.RFC3339, event.Time)
-			timeline = append(timeline, models.TimelineEvent{
-				Time:         ts,
-				Event:        event.Event,
-				Service:      event.Service,
-				Severity:     parseSeverity(event.Severity),
-				AnomalyScore: event.AnomalyScore,
-				DataSource:   parseDataType(event.DataSource),
-			})
-		}
-
-		correlations = append(correlations, models.CorrelationResult{
-			CorrelationID:    rec.CorrelationID,
-			IncidentID:       rec.IncidentID,
-			RootCause:        rec.RootCause,
-			Confidence:       rec.Confidence,
-			AffectedServices: rec.AffectedServices,
-			Recommendations:  rec.Recommendations,
-			CreatedAt:        createdAt,
-			RedAnchors:       anchors,
-			Timeline:         timeline,
-		})
+		correlations = append(correlations, rec.toModel())
+	}
+	annotated := make([]*models.CorrelationResult, 0, len(correlations))
+	for i := range correlations {
+		annotated = append(annotated, &correlations[i])
+	}
+	if err := r.attachAnnotations(ctx, req.TenantID, annotated); err != nil {
+		return models.ListCorrelationsResponse{}, err
 	}
 
 	nextToken := ""
@@ -478,38 +777,718 @@ func (r *WeaviateRepo) ListCorrelations(ctx context.Context, req models.ListCorr
 	}, nil
 }
 
-// FetchPatterns retrieves failure patterns for the tenant.
-func (r *WeaviateRepo) FetchPatterns(ctx context.Context, tenantID, service string) ([]models.FailurePattern, error) {
+// SearchCorrelations runs a Weaviate hybrid (BM25 + vector) search over the tenant's correlation history and
+// returns hits with their ranking scores.
+func (r *WeaviateRepo) SearchCorrelations(ctx context.Context, req models.SearchCorrelationsRequest) (models.SearchCorrelationsResponse, error) {
 	if r == nil {
-		return nil, fmt.Errorf("weaviate repo not initialised")
+		return models.SearchCorrelationsResponse{}, fmt.Errorf("weaviate repo not initialised")
 	}
 
 	if r.endpoint == "" {
-		return syntheticPatterns(service), nil
+		return models.SearchCorrelationsResponse{}, nil
 	}
 
-	cacheKey := ""
-	if r.patternTTL > 0 {
-		cacheKey = cachePatternsKey(tenantID, service)
-		if data, err := r.cache.Get(ctx, cacheKey); err == nil {
-			var cached []models.FailurePattern
-			if err := json.Unmarshal(data, &cached); err == nil {
-				return cached, nil
-			}
-		}
+	limit := req.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 10
+	}
+	alpha := req.Alpha
+	if alpha <= 0 || alpha > 1 {
+		alpha = 0.5
+	}
+
+	gql := fmt.Sprintf(`{
+  Get {
+    CorrelationRecord(
+      limit: %d
+      hybrid: {query: %s, alpha: %s}
+      %s
+    ) {
+%s
+      _additional {
+        score
+      }
+    }
+  }
+}`, limit, gqlString(req.Query), strconv.FormatFloat(alpha, 'f', -1, 64), whereAnd(whereOperand("tenantId", "Equal", "valueString", req.TenantID)), correlationFields)
+
+	payload, err := json.Marshal(map[string]interface{}{"query": gql})
+	if err != nil {
+		return models.SearchCorrelationsResponse{}, err
+	}
+
+	reqHTTP, err := http.NewRequestWithContext(ctx, http.MethodPost, r.endpoint+"/v1/graphql", bytes.NewReader(payload))
+	if err != nil {
+		return models.SearchCorrelationsResponse{}, err
+	}
+	reqHTTP.Header.Set("Content-Type", "application/json")
+	r.authorize(reqHTTP)
+
+	resp, err := r.httpClient.Do(reqHTTP)
+	if err != nil {
+		return models.SearchCorrelationsResponse{}, fmt.Errorf("weaviate hybrid search: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return models.SearchCorrelationsResponse{}, fmt.Errorf("weaviate hybrid search returned %s", resp.Status)
+	}
+
+	var response struct {
+		Data struct {
+			Get struct {
+				CorrelationRecord []struct {
+					correlationRecord
+					Additional struct {
+						Score string `json:"score"`
+					} `json:"_additional"`
+				} `json:"CorrelationRecord"`
+			} `json:"Get"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return models.SearchCorrelationsResponse{}, fmt.Errorf("decode hybrid search response: %w", err)
+	}
+
+	results := make([]models.ScoredCorrelation, 0, len(response.Data.Get.CorrelationRecord))
+	for _, rec := range response.Data.Get.CorrelationRecord {
+		score, _ := strconv.ParseFloat(rec.Additional.Score, 64)
+		results = append(results, models.ScoredCorrelation{Correlation: rec.toModel(), Score: score})
+	}
+	annotated := make([]*models.CorrelationResult, 0, len(results))
+	for i := range results {
+		annotated = append(annotated, &results[i].Correlation)
+	}
+	if err := r.attachAnnotations(ctx, req.TenantID, annotated); err != nil {
+		return models.SearchCorrelationsResponse{}, err
+	}
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Score > results[j].Score })
+	return models.SearchCorrelationsResponse{Results: results}, nil
+}
+
+// PurgeTenantData deletes tenant history via Weaviate batch deletes. With a zero Before every correlation,
+// feedback, pattern, and topology snapshot object of the tenant is erased; otherwise correlations, feedback,
+// and topology snapshots older than Before are removed. DryRun reports match counts without deleting.
+func (r *WeaviateRepo) PurgeTenantData(ctx context.Context, req models.PurgeRequest) (models.PurgeResult, error) {
+	result := models.PurgeResult{DryRun: req.DryRun}
+	if r == nil {
+		return result, fmt.Errorf("weaviate repo not initialised")
+	}
+	if req.TenantID == "" {
+		return result, fmt.Errorf("tenant id is required")
+	}
+	if r.endpoint == "" {
+		return result, nil
+	}
+
+	type purgeTarget struct {
+		class     string
+		timeField string
+		count     *int
+	}
+	var annotations int
+	targets := []purgeTarget{
+		{"CorrelationRecord", "createdAt", &result.Correlations},
+		{"CorrelationAnnotation", "createdAt", &annotations},
+		{"CorrelationFeedback", "submittedAt", &result.Feedback},
+		{"TopologySnapshot", "capturedAt", &result.TopologySnapshots},
+	}
+	if req.Before.IsZero() {
+		targets = append(targets, purgeTarget{"FailurePattern", "", &result.Patterns})
+	}
+
+	for _, target := range targets {
+		operands := []map[string]interface{}{
+			{"path": []string{"tenantId"}, "operator": "Equal", "valueText": req.TenantID},
+		}
+		if !req.Before.IsZero() {
+			operands = append(operands, map[string]interface{}{
+				"path": []string{target.timeField}, "operator": "LessThan", "valueDate": req.Before.UTC().Format(time.RFC3339),
+			})
+		}
+		count, err := r.batchDelete(ctx, target.class, req.TenantID, map[string]interface{}{"operator": "And", "operands": operands}, req.DryRun)
+		if err != nil {
+			return result, fmt.Errorf("purge %s: %w", target.class, err)
+		}
+		*target.count = count
+		metrics.ObservePurge(target.class, count, req.DryRun)
+	}
+	if !req.DryRun {
+		_ = r.cache.InvalidatePrefix(ctx, cacheSimilarIncidentsPrefix(req.TenantID))
+		_ = r.cache.InvalidatePrefix(ctx, cachePatternsPrefix(req.TenantID))
+	}
+	return result, nil
+}
+
+// Tenants lists the tenants of the classes retention purges by age, from Weaviate's multi-tenancy schema.
+func (r *WeaviateRepo) Tenants(ctx context.Context) ([]string, error) {
+	if r == nil || r.endpoint == "" {
+		return nil, nil
+	}
+	seen := map[string]bool{}
+	for _, class := range []string{"CorrelationRecord", "CorrelationFeedback", "TopologySnapshot"} {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.endpoint+"/v1/schema/"+class+"/tenants", nil)
+		if err != nil {
+			return nil, err
+		}
+		r.authorize(req)
+
+		resp, err := r.httpClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("weaviate list tenants: %w", err)
+		}
+		var tenants []struct {
+			Name string `json:"name"`
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("weaviate list %s tenants returned %s", class, resp.Status)
+		}
+		err = json.NewDecoder(resp.Body).Decode(&tenants)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("decode %s tenants: %w", class, err)
+		}
+		for _, tenant := range tenants {
+			seen[tenant.Name] = true
+		}
+	}
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (r *WeaviateRepo) batchDelete(ctx context.Context, class, tenantID string, where map[string]interface{}, dryRun bool) (int, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"match":  map[string]interface{}{"class": class, "where": where},
+		"dryRun": dryRun,
+		"output": "minimal",
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, r.endpoint+"/v1/batch/objects?tenant="+url.QueryEscape(tenantID), bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	r.authorize(req)
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(resp.Body)
+		return 0, fmt.Errorf("batch delete failed: %s", strings.TrimSpace(string(data)))
+	}
+
+	var response struct {
+		Results struct {
+			Matches    int `json:"matches"`
+			Successful int `json:"successful"`
+			Failed     int `json:"failed"`
+		} `json:"results"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return 0, fmt.Errorf("decode batch delete response: %w", err)
+	}
+	if dryRun {
+		return response.Results.Matches, nil
+	}
+	if response.Results.Failed > 0 {
+		return response.Results.Successful, fmt.Errorf("batch delete: %d objects failed", response.Results.Failed)
+	}
+	return response.Results.Successful, nil
+}
+
+// correlationFields is the GraphQL selection for a full CorrelationRecord.
+const correlationFields = `correlationId
+incidentId
+rootCause
+confidence
+affectedServices
+recommendations
+recommendationDetails {
+  text
+  ruleId
+  actions {
+    type
+    label
+    url
+  }
+  generated
+}
+category
+unavailableSources
+duplicateOf
+relatedCorrelations
+status
+labels
+incidentTitle
+incidentDescription
+alertFingerprints
+ticketUrl
+summary
+environment
+annotations {
+  author
+  text
+  createdAt
+}
+createdAt
+redAnchors {
+  service
+  selector
+  dataType
+  timestamp
+  anomalyScore
+  threshold
+  environment
+  link
+  direction
+  evidence {
+    logLines
+    traceIds
+    metricValues {
+      timestamp
+      value
+    }
+    exemplars {
+      traceId
+      spanId
+      operation
+      status
+      durationMs
+      timestamp
+      link
+    }
+  }
+}
+timeline {
+  time
+  event
+  service
+  severity
+  anomalyScore
+  dataSource
+  start
+  end
+  ongoing
+  link
+  direction
+}
+blastRadius {
+  service
+  score
+  depth
+}
+explanation {
+  service
+  threshold
+  preset
+  detectors {
+    name
+    anomalies
+    threshold
+    topScore
+  }
+  causalityScore
+  suggestedService
+  causalityNotes
+  detectionConfidence
+  missingSources
+  maintenanceAnomalies
+  recommendationSource
+  matchedRules {
+    ruleId
+    reasons
+  }
+  similarCorrelations
+}
+windowExpansion {
+  requestedStart
+  requestedEnd
+  analysedStart
+  analysedEnd
+  sparseSources
+  samples
+  minSamples
+}
+capacity {
+  service
+  saturationDriven
+  saturation
+  resources {
+    resource
+    peak
+    latest
+    headroom
+    saturatedAt
+    extraCapacity
+    timeToExhaustionSeconds
+  }
+}
+impactScore
+sloImpacts {
+  service
+  slo
+  objective
+  badFraction
+  burnRate
+  budgetSpent
+}`
+
+// correlationRecord mirrors a CorrelationRecord returned by GraphQL Get queries.
+type correlationRecord struct {
+	CorrelationID    string                 `json:"correlationId"`
+	IncidentID       string                 `json:"incidentId"`
+	RootCause        string                 `json:"rootCause"`
+	Confidence       float64                `json:"confidence"`
+	AffectedServices []string               `json:"affectedServices"`
+	Recommendations  []string               `json:"recommendations"`
+	Details          []recommendationRecord `json:"recommendationDetails"`
+	Category         string                 `json:"category"`
+	Unavailable      []string               `json:"unavailableSources"`
+	DuplicateOf      string                 `json:"duplicateOf"`
+	Related          []string               `json:"relatedCorrelations"`
+	Status           string                 `json:"status"`
+	Labels           []string               `json:"labels"`
+	IncidentTitle    string                 `json:"incidentTitle"`
+	IncidentDesc     string                 `json:"incidentDescription"`
+	Fingerprints     []string               `json:"alertFingerprints"`
+	TicketURL        string                 `json:"ticketUrl"`
+	Summary          string                 `json:"summary"`
+	Environment      string                 `json:"environment"`
+	Annotations      []struct {
+		Author    string `json:"author"`
+		Text      string `json:"text"`
+		CreatedAt string `json:"createdAt"`
+	} `json:"annotations"`
+	CreatedAt  string `json:"createdAt"`
+	RedAnchors []struct {
+		Service      string  `json:"service"`
+		Selector     string  `json:"selector"`
+		DataType     string  `json:"dataType"`
+		Timestamp    string  `json:"timestamp"`
+		AnomalyScore float64 `json:"anomalyScore"`
+		Threshold    float64 `json:"threshold"`
+		Environment  string  `json:"environment"`
+		Link         string  `json:"link"`
+		Direction    string  `json:"direction"`
+		Evidence     struct {
+			LogLines     []string `json:"logLines"`
+			TraceIDs     []string `json:"traceIds"`
+			MetricValues []struct {
+				Timestamp string  `json:"timestamp"`
+				Value     float64 `json:"value"`
+			} `json:"metricValues"`
+			Exemplars []struct {
+				TraceID    string  `json:"traceId"`
+				SpanID     string  `json:"spanId"`
+				Operation  string  `json:"operation"`
+				Status     string  `json:"status"`
+				DurationMs float64 `json:"durationMs"`
+				Timestamp  string  `json:"timestamp"`
+				Link       string  `json:"link"`
+			} `json:"exemplars"`
+		} `json:"evidence"`
+	} `json:"redAnchors"`
+	Timeline []struct {
+		Time         string  `json:"time"`
+		Event        string  `json:"event"`
+		Service      string  `json:"service"`
+		Severity     string  `json:"severity"`
+		AnomalyScore float64 `json:"anomalyScore"`
+		DataSource   string  `json:"dataSource"`
+		Start        string  `json:"start"`
+		End          string  `json:"end"`
+		Ongoing      bool    `json:"ongoing"`
+		Link         string  `json:"link"`
+		Direction    string  `json:"direction"`
+	} `json:"timeline"`
+	BlastRadius []struct {
+		Service string  `json:"service"`
+		Score   float64 `json:"score"`
+		Depth   int     `json:"depth"`
+	} `json:"blastRadius"`
+	Explanation     *explanationRecord     `json:"explanation"`
+	WindowExpansion *windowExpansionRecord `json:"windowExpansion"`
+	Capacity        *capacityRecord        `json:"capacity"`
+	ImpactScore     float64                `json:"impactScore"`
+	SLOImpacts      []sloImpactRecord      `json:"sloImpacts"`
+}
+
+// sloImpactRecord mirrors a nested sloImpacts object of a CorrelationRecord.
+type sloImpactRecord struct {
+	Service     string  `json:"service"`
+	SLO         string  `json:"slo"`
+	Objective   float64 `json:"objective"`
+	BadFraction float64 `json:"badFraction"`
+	BurnRate    float64 `json:"burnRate"`
+	BudgetSpent float64 `json:"budgetSpent"`
+}
+
+// capacityRecord mirrors the nested capacity object of a CorrelationRecord.
+type capacityRecord struct {
+	Service          string  `json:"service"`
+	SaturationDriven bool    `json:"saturationDriven"`
+	Saturation       float64 `json:"saturation"`
+	Resources        []struct {
+		Resource                string  `json:"resource"`
+		Peak                    float64 `json:"peak"`
+		Latest                  float64 `json:"latest"`
+		Headroom                float64 `json:"headroom"`
+		SaturatedAt             string  `json:"saturatedAt"`
+		ExtraCapacity           float64 `json:"extraCapacity"`
+		TimeToExhaustionSeconds float64 `json:"timeToExhaustionSeconds"`
+	} `json:"resources"`
+}
+
+func (rec *capacityRecord) toModel() *models.CapacityAnalysis {
+	if rec == nil {
+		return nil
+	}
+	analysis := &models.CapacityAnalysis{
+		Service:          rec.Service,
+		SaturationDriven: rec.SaturationDriven,
+		Saturation:       rec.Saturation,
+	}
+	for _, resource := range rec.Resources {
+		usage := models.ResourceUsage{
+			Resource:         resource.Resource,
+			Peak:             resource.Peak,
+			Latest:           resource.Latest,
+			Headroom:         resource.Headroom,
+			ExtraCapacity:    resource.ExtraCapacity,
+			TimeToExhaustion: time.Duration(resource.TimeToExhaustionSeconds * float64(time.Second)),
+		}
+		usage.SaturatedAt, _ = time.Parse(time.RFC3339, resource.SaturatedAt)
+		analysis.Resources = append(analysis.Resources, usage)
+	}
+	return analysis
+}
+
+// windowExpansionRecord mirrors the nested windowExpansion object of a CorrelationRecord.
+type windowExpansionRecord struct {
+	RequestedStart string   `json:"requestedStart"`
+	RequestedEnd   string   `json:"requestedEnd"`
+	AnalysedStart  string   `json:"analysedStart"`
+	AnalysedEnd    string   `json:"analysedEnd"`
+	SparseSources  []string `json:"sparseSources"`
+	Samples        int      `json:"samples"`
+	MinSamples     int      `json:"minSamples"`
+}
+
+func (rec *windowExpansionRecord) toModel() *models.WindowExpansion {
+	if rec == nil {
+		return nil
+	}
+	expansion := &models.WindowExpansion{
+		SparseSources: parseDataTypes(rec.SparseSources),
+		Samples:       rec.Samples,
+		MinSamples:    rec.MinSamples,
+	}
+	expansion.Requested.Start, _ = time.Parse(time.RFC3339, rec.RequestedStart)
+	expansion.Requested.End, _ = time.Parse(time.RFC3339, rec.RequestedEnd)
+	expansion.Analysed.Start, _ = time.Parse(time.RFC3339, rec.AnalysedStart)
+	expansion.Analysed.End, _ = time.Parse(time.RFC3339, rec.AnalysedEnd)
+	return expansion
+}
+
+// explanationRecord mirrors the nested explanation object of a CorrelationRecord.
+type explanationRecord struct {
+	Service   string  `json:"service"`
+	Threshold float64 `json:"threshold"`
+	Preset    string  `json:"preset"`
+	Detectors []struct {
+		Name      string  `json:"name"`
+		Anomalies int     `json:"anomalies"`
+		Threshold float64 `json:"threshold"`
+		TopScore  float64 `json:"topScore"`
+	} `json:"detectors"`
+	CausalityScore       float64  `json:"causalityScore"`
+	SuggestedService     string   `json:"suggestedService"`
+	CausalityNotes       []string `json:"causalityNotes"`
+	DetectionConfidence  float64  `json:"detectionConfidence"`
+	MissingSources       int      `json:"missingSources"`
+	MaintenanceAnomalies int      `json:"maintenanceAnomalies"`
+	RecommendationSource string   `json:"recommendationSource"`
+	MatchedRules         []struct {
+		RuleID  string   `json:"ruleId"`
+		Reasons []string `json:"reasons"`
+	} `json:"matchedRules"`
+	SimilarCorrelations []string `json:"similarCorrelations"`
+}
+
+func (rec *explanationRecord) toModel() *models.Explanation {
+	if rec == nil {
+		return nil
+	}
+	explanation := &models.Explanation{
+		Service:   rec.Service,
+		Threshold: rec.Threshold,
+		Preset:    rec.Preset,
+		Causality: models.CausalityExplanation{
+			Score:            rec.CausalityScore,
+			SuggestedService: rec.SuggestedService,
+			Notes:            rec.CausalityNotes,
+		},
+		DetectionConfidence:  rec.DetectionConfidence,
+		MissingSources:       rec.MissingSources,
+		MaintenanceAnomalies: rec.MaintenanceAnomalies,
+		RecommendationSource: models.RecommendationSource(rec.RecommendationSource),
+		SimilarCorrelations:  rec.SimilarCorrelations,
+	}
+	for _, detector := range rec.Detectors {
+		explanation.Detectors = append(explanation.Detectors, models.DetectorRun{
+			Name:      detector.Name,
+			Anomalies: detector.Anomalies,
+			Threshold: detector.Threshold,
+			TopScore:  detector.TopScore,
+		})
+	}
+	for _, rule := range rec.MatchedRules {
+		explanation.MatchedRules = append(explanation.MatchedRules, models.MatchedRule{RuleID: rule.RuleID, Reasons: rule.Reasons})
+	}
+	return explanation
+}
+
+func (rec correlationRecord) toModel() models.CorrelationResult {
+	createdAt, _ := time.Parse(time.RFC3339, rec.CreatedAt)
+	anchors := make([]models.RedAnchor, 0, len(rec.RedAnchors))
+	for _, anchor := range rec.RedAnchors {
+		ts, _ := time.Parse(time.RFC3339, anchor.Timestamp)
+		evidence := models.Evidence{LogLines: anchor.Evidence.LogLines, TraceIDs: anchor.Evidence.TraceIDs}
+		for _, sample := range anchor.Evidence.MetricValues {
+			sampleTS, _ := time.Parse(time.RFC3339, sample.Timestamp)
+			evidence.MetricValues = append(evidence.MetricValues, models.MetricSample{Timestamp: sampleTS, Value: sample.Value})
+		}
+		for _, exemplar := range anchor.Evidence.Exemplars {
+			exemplarTS, _ := time.Parse(time.RFC3339, exemplar.Timestamp)
+			evidence.Exemplars = append(evidence.Exemplars, models.TraceExemplar{
+				TraceID:   exemplar.TraceID,
+				SpanID:    exemplar.SpanID,
+				Operation: exemplar.Operation,
+				Status:    exemplar.Status,
+				Duration:  time.Duration(exemplar.DurationMs * float64(time.Millisecond)),
+				Timestamp: exemplarTS,
+				Link:      exemplar.Link,
+			})
+		}
+		anchors = append(anchors, models.RedAnchor{
+			Service:      anchor.Service,
+			Selector:     anchor.Selector,
+			DataType:     parseDataType(anchor.DataType),
+			Timestamp:    ts,
+			AnomalyScore: anchor.AnomalyScore,
+			Threshold:    anchor.Threshold,
+			Evidence:     evidence,
+			Link:         anchor.Link,
+			Environment:  anchor.Environment,
+			Direction:    models.Direction(anchor.Direction),
+		})
+	}
+
+	timeline := make([]models.TimelineEvent, 0, len(rec.Timeline))
+	for _, event := range rec.Timeline {
+		ts, _ := time.Parse(time.RFC3339, event.Time)
+		start, _ := time.Parse(time.RFC3339, event.Start)
+		end, _ := time.Parse(time.RFC3339, event.End)
+		timeline = append(timeline, models.TimelineEvent{
+			Time:         ts,
+			Event:        event.Event,
+			Service:      event.Service,
+			Severity:     parseSeverity(event.Severity),
+			AnomalyScore: event.AnomalyScore,
+			DataSource:   parseDataType(event.DataSource),
+			Link:         event.Link,
+			Start:        start,
+			End:          end,
+			Ongoing:      event.Ongoing,
+			Direction:    models.Direction(event.Direction),
+		})
+	}
+
+	impacts := make([]models.ServiceImpact, 0, len(rec.BlastRadius))
+	for _, impact := range rec.BlastRadius {
+		impacts = append(impacts, models.ServiceImpact{
+			Service: impact.Service,
+			Score:   impact.Score,
+			Depth:   impact.Depth,
+		})
+	}
+
+	var sloImpacts []models.SLOImpact
+	for _, impact := range rec.SLOImpacts {
+		sloImpacts = append(sloImpacts, models.SLOImpact(impact))
+	}
+
+	var annotations []models.Annotation
+	for _, annotation := range rec.Annotations {
+		ts, _ := time.Parse(time.RFC3339, annotation.CreatedAt)
+		annotations = append(annotations, models.Annotation{Author: annotation.Author, Text: annotation.Text, CreatedAt: ts})
+	}
+
+	return models.CorrelationResult{
+		CorrelationID:       rec.CorrelationID,
+		IncidentID:          rec.IncidentID,
+		RootCause:           rec.RootCause,
+		Confidence:          rec.Confidence,
+		AffectedServices:    rec.AffectedServices,
+		Recommendations:     recommendationsFromRecord(rec.Recommendations, rec.Details),
+		Category:            models.RootCauseCategory(rec.Category),
+		CreatedAt:           createdAt,
+		UnavailableSources:  parseDataTypes(rec.Unavailable),
+		DuplicateOf:         rec.DuplicateOf,
+		RelatedCorrelations: rec.Related,
+		Status:              models.CorrelationStatus(rec.Status),
+		Annotations:         annotations,
+		Labels:              parseLabelPairs(rec.Labels),
+		WindowExpansion:     rec.WindowExpansion.toModel(),
+		Capacity:            rec.Capacity.toModel(),
+		ImpactScore:         rec.ImpactScore,
+		SLOImpacts:          sloImpacts,
+		Incident: models.IncidentMetadata{
+			Title:             rec.IncidentTitle,
+			Description:       rec.IncidentDesc,
+			AlertFingerprints: rec.Fingerprints,
+			TicketURL:         rec.TicketURL,
+		},
+		RedAnchors:  anchors,
+		Timeline:    timeline,
+		BlastRadius: impacts,
+		Explanation: rec.Explanation.toModel(),
+		Summary:     rec.Summary,
+		Environment: rec.Environment,
+	}
+}
+
+// FetchPatterns retrieves failure patterns for the tenant.
+func (r *WeaviateRepo) FetchPatterns(ctx context.Context, tenantID, service string) ([]models.FailurePattern, error) {
+	if r == nil {
+		return nil, fmt.Errorf("weaviate repo not initialised")
 	}
 
+	if r.endpoint == "" {
+		return nil, nil
+	}
+
+	return cachedFetch(ctx, r.cache, r.patternTTL.get(), r.negativeTTL.get(), cachePatternsKey(tenantID, service), func() ([]models.FailurePattern, error) {
+		return r.queryPatterns(ctx, tenantID, service)
+	})
+}
+
+func (r *WeaviateRepo) queryPatterns(ctx context.Context, tenantID, service string) ([]models.FailurePattern, error) {
 	gql := map[string]interface{}{
 		"query": fmt.Sprintf(`{
           Get {
             FailurePattern(
-              where: {
-                operator: And
-                operands: [
-                  {path: ["tenantId"], operator: Equal, valueString: "%s"}
-                  %s
-                ]
-              }
+              %s
             ) {
               patternId
               name
@@ -530,7 +1509,7 @@ func (r *WeaviateRepo) FetchPatterns(ctx context.Context, tenantID, service stri
               }
             }
           }
-        }`, tenantID, optionalServiceOperand(service)),
+        }`, buildPatternWhere(tenantID, service)),
 	}
 
 	body, err := json.Marshal(gql)
@@ -543,18 +1522,16 @@ func (r *WeaviateRepo) FetchPatterns(ctx context.Context, tenantID, service stri
 		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/json")
-	if r.apiKey != "" {
-		req.Header.Set("Authorization", "Bearer "+r.apiKey)
-	}
+	r.authorize(req)
 
 	resp, err := r.httpClient.Do(req)
-	if err != nil || resp.StatusCode != http.StatusOK {
-		if resp != nil {
-			resp.Body.Close()
-		}
-		return syntheticPatterns(service), nil
+	if err != nil {
+		return nil, fmt.Errorf("weaviate fetch patterns: %w", err)
 	}
 	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("weaviate fetch patterns returned %s", resp.Status)
+	}
 
 	var response struct {
 		Data struct {
@@ -583,7 +1560,7 @@ func (r *WeaviateRepo) FetchPatterns(ctx context.Context, tenantID, service stri
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		return syntheticPatterns(service), nil
+		return nil, fmt.Errorf("decode patterns response: %w", err)
 	}
 
 	patterns := make([]models.FailurePattern, 0, len(response.Data.Get.FailurePattern))
@@ -612,69 +1589,197 @@ func (r *WeaviateRepo) FetchPatterns(ctx context.Context, tenantID, service stri
 		})
 	}
 
-	if r.patternTTL > 0 && cacheKey != "" && len(patterns) > 0 {
-		if payload, err := json.Marshal(patterns); err == nil {
-			_ = r.cache.Set(ctx, cacheKey, payload, r.patternTTL)
-		}
-	}
-
 	return patterns, nil
 }
 
-func cachePatternsKey(tenantID, service string) string {
-	return fmt.Sprintf("weaviate:patterns:%s:%s", tenantID, service)
+// StoreTopologySnapshot persists a service graph snapshot.
+func (r *WeaviateRepo) StoreTopologySnapshot(ctx context.Context, tenantID string, snapshot models.TopologySnapshot) error {
+	if r == nil {
+		return fmt.Errorf("weaviate repo not initialised")
+	}
+	if r.endpoint == "" {
+		return nil
+	}
+
+	edges := make([]map[string]interface{}, 0, len(snapshot.Edges))
+	for _, edge := range snapshot.Edges {
+		edges = append(edges, map[string]interface{}{
+			"source":    edge.Source,
+			"target":    edge.Target,
+			"callRate":  edge.CallRate,
+			"errorRate": edge.ErrorRate,
+		})
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"class":  "TopologySnapshot",
+		"tenant": tenantID,
+		"properties": map[string]interface{}{
+			"tenantId":    tenantID,
+			"environment": snapshot.Environment,
+			"capturedAt":  snapshot.CapturedAt.UTC().Format(time.RFC3339),
+			"edges":       edges,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.endpoint+"/v1/objects", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	r.authorize(req)
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("store topology snapshot failed: %s", strings.TrimSpace(string(data)))
+	}
+	return nil
 }
 
-func optionalServiceOperand(service string) string {
-	if service == "" {
-		return ""
+// PurgeTopologySnapshots deletes the tenant's snapshots captured before before.
+func (r *WeaviateRepo) PurgeTopologySnapshots(ctx context.Context, tenantID string, before time.Time) error {
+	if r == nil {
+		return fmt.Errorf("weaviate repo not initialised")
 	}
-	return fmt.Sprintf(`, {path: ["services"], operator: ContainsAny, valueString: "%s"}`, service)
+	if r.endpoint == "" {
+		return nil
+	}
+	where := map[string]interface{}{"operator": "And", "operands": []map[string]interface{}{
+		{"path": []string{"tenantId"}, "operator": "Equal", "valueText": tenantID},
+		{"path": []string{"capturedAt"}, "operator": "LessThan", "valueDate": before.UTC().Format(time.RFC3339)},
+	}}
+	if _, err := r.batchDelete(ctx, "TopologySnapshot", tenantID, where, false); err != nil {
+		return fmt.Errorf("purge topology snapshots: %w", err)
+	}
+	return nil
 }
 
-func syntheticSimilarIncidents(symptoms []string, limit int) []models.CorrelationResult {
-	if limit <= 0 {
-		limit = 3
+// TopologySnapshots returns up to 500 snapshots of environment captured between start and end, oldest first.
+func (r *WeaviateRepo) TopologySnapshots(ctx context.Context, tenantID, environment string, start, end time.Time) ([]models.TopologySnapshot, error) {
+	if r == nil {
+		return nil, fmt.Errorf("weaviate repo not initialised")
 	}
-	results := make([]models.CorrelationResult, 0, limit)
-	for i := 0; i < limit; i++ {
-		results = append(results, models.CorrelationResult{
-			CorrelationID:    fmt.Sprintf("synthetic-%d", i+1),
-			IncidentID:       fmt.Sprintf("incident-%d", i+1),
-			RootCause:        "synthetic-service",
-			Confidence:       0.55 + float64(i)*0.05,
-			AffectedServices: []string{"synthetic-service"},
-			Recommendations:  []string{"Check downstream dependencies", "Review recent deploy"},
-			CreatedAt:        time.Now().Add(-time.Duration(i) * time.Hour),
-		})
+	if r.endpoint == "" {
+		return nil, nil
 	}
-	if len(symptoms) > 0 {
-		results[0].Recommendations = append(results[0].Recommendations, fmt.Sprintf("Symptom hint: %s", symptoms[0]))
+
+	where := whereAnd(
+		whereOperand("tenantId", "Equal", "valueString", tenantID),
+		whereOperand("environment", "Equal", "valueString", environment),
+		whereOperand("capturedAt", "GreaterThanEqual", "valueDate", start.UTC().Format(time.RFC3339)),
+		whereOperand("capturedAt", "LessThanEqual", "valueDate", end.UTC().Format(time.RFC3339)),
+	)
+	gql := fmt.Sprintf(`{
+  Get {
+    TopologySnapshot(
+      limit: 500
+      %s
+      sort: [{path: "capturedAt", order: asc}]
+    ) {
+      capturedAt
+      edges {
+        source
+        target
+        callRate
+        errorRate
+      }
+    }
+  }
+}`, where)
+
+	payload, err := json.Marshal(map[string]interface{}{"query": gql})
+	if err != nil {
+		return nil, err
 	}
-	return results
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.endpoint+"/v1/graphql", bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	r.authorize(req)
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("weaviate topology snapshots: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("weaviate topology snapshots returned %s", resp.Status)
+	}
+
+	var response struct {
+		Data struct {
+			Get struct {
+				TopologySnapshot []struct {
+					CapturedAt time.Time `json:"capturedAt"`
+					Edges      []struct {
+						Source    string  `json:"source"`
+						Target    string  `json:"target"`
+						CallRate  float64 `json:"callRate"`
+						ErrorRate float64 `json:"errorRate"`
+					} `json:"edges"`
+				} `json:"TopologySnapshot"`
+			} `json:"Get"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("decode topology snapshots response: %w", err)
+	}
+
+	snapshots := make([]models.TopologySnapshot, 0, len(response.Data.Get.TopologySnapshot))
+	for _, rec := range response.Data.Get.TopologySnapshot {
+		snapshot := models.TopologySnapshot{Environment: environment, CapturedAt: rec.CapturedAt}
+		for _, edge := range rec.Edges {
+			snapshot.Edges = append(snapshot.Edges, models.TopologyEdge{
+				Source:    edge.Source,
+				Target:    edge.Target,
+				CallRate:  edge.CallRate,
+				ErrorRate: edge.ErrorRate,
+			})
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+func cachePatternsKey(tenantID, service string) string {
+	return cachePatternsPrefix(tenantID) + service
+}
+
+func cachePatternsPrefix(tenantID string) string {
+	return fmt.Sprintf("weaviate:patterns:%s:", tenantID)
 }
 
-func syntheticPatterns(service string) []models.FailurePattern {
-	svc := service
-	if svc == "" {
-		svc = "synthetic-service"
+// invalidatePatterns drops the cached pattern lookups that stored patterns can appear in: the tenant-wide
+// lookup and one per service they cover. Invalidation is best effort; the pattern TTL bounds staleness when
+// the cache is unreachable.
+func (r *WeaviateRepo) invalidatePatterns(ctx context.Context, tenantID string, patterns []models.FailurePattern) {
+	keys := []string{cachePatternsKey(tenantID, "")}
+	seen := map[string]bool{"": true}
+	for _, pattern := range patterns {
+		for _, service := range pattern.Services {
+			if !seen[service] {
+				seen[service] = true
+				keys = append(keys, cachePatternsKey(tenantID, service))
+			}
+		}
 	}
-	return []models.FailurePattern{
-		{
-			ID:          "pattern-1",
-			Name:        "CPU saturation",
-			Description: "Sudden CPU saturation followed by error spikes",
-			Services:    []string{svc},
-			AnchorTemplates: []models.AnchorTemplate{
-				{Service: svc, SignalType: "metrics", Selector: "cpu_usage", TypicalLag: 1, Threshold: 0.8},
-				{Service: svc, SignalType: "logs", Selector: "error", TypicalLag: 2, Threshold: 10},
-			},
-			Prevalence: 0.32,
-			LastSeen:   time.Now().Add(-24 * time.Hour),
-			Precision:  0.68,
-			Recall:     0.44,
-		},
+	_ = r.cache.Invalidate(ctx, keys...)
+}
+
+func buildPatternWhere(tenantID, service string) string {
+	operands := []string{whereOperand("tenantId", "Equal", "valueString", tenantID)}
+	if service != "" {
+		operands = append(operands, whereOperand("services", "ContainsAny", "valueString", service))
 	}
+	return whereAnd(operands...)
 }
 
 func buildPatternProperties(tenantID string, pattern models.FailurePattern) map[string]interface{} {
@@ -734,6 +1839,25 @@ func buildCorrelationProperties(tenantID string, correlation models.CorrelationR
 
 	anchors := make([]map[string]interface{}, 0, len(correlation.RedAnchors))
 	for _, anchor := range correlation.RedAnchors {
+		samples := make([]map[string]interface{}, 0, len(anchor.Evidence.MetricValues))
+		for _, sample := range anchor.Evidence.MetricValues {
+			samples = append(samples, map[string]interface{}{
+				"timestamp": sample.Timestamp.UTC().Format(time.RFC3339),
+				"value":     sample.Value,
+			})
+		}
+		exemplars := make([]map[string]interface{}, 0, len(anchor.Evidence.Exemplars))
+		for _, exemplar := range anchor.Evidence.Exemplars {
+			exemplars = append(exemplars, map[string]interface{}{
+				"traceId":    exemplar.TraceID,
+				"spanId":     exemplar.SpanID,
+				"operation":  exemplar.Operation,
+				"status":     exemplar.Status,
+				"durationMs": float64(exemplar.Duration) / float64(time.Millisecond),
+				"timestamp":  exemplar.Timestamp.UTC().Format(time.RFC3339),
+				"link":       exemplar.Link,
+			})
+		}
 		anchors = append(anchors, map[string]interface{}{
 			"service":      anchor.Service,
 			"selector":     anchor.Selector,
@@ -741,49 +1865,253 @@ func buildCorrelationProperties(tenantID string, correlation models.CorrelationR
 			"timestamp":    anchor.Timestamp.UTC().Format(time.RFC3339),
 			"anomalyScore": anchor.AnomalyScore,
 			"threshold":    anchor.Threshold,
+			"environment":  anchor.Environment,
+			"link":         anchor.Link,
+			"direction":    string(anchor.Direction),
+			"evidence": map[string]interface{}{
+				"logLines":     nonNilStrings(anchor.Evidence.LogLines),
+				"traceIds":     nonNilStrings(anchor.Evidence.TraceIDs),
+				"metricValues": samples,
+				"exemplars":    exemplars,
+			},
 		})
 	}
 
 	timeline := make([]map[string]interface{}, 0, len(correlation.Timeline))
 	for _, event := range correlation.Timeline {
-		timeline = append(timeline, map[string]interface{}{
+		fields := map[string]interface{}{
 			"time":         event.Time.UTC().Format(time.RFC3339),
 			"event":        event.Event,
 			"service":      event.Service,
 			"severity":     string(event.Severity),
 			"anomalyScore": event.AnomalyScore,
 			"dataSource":   string(event.DataSource),
+			"ongoing":      event.Ongoing,
+			"link":         event.Link,
+			"direction":    string(event.Direction),
+		}
+		if event.Sustained() {
+			fields["start"] = event.Start.UTC().Format(time.RFC3339)
+			fields["end"] = event.End.UTC().Format(time.RFC3339)
+		}
+		timeline = append(timeline, fields)
+	}
+
+	impacts := make([]map[string]interface{}, 0, len(correlation.BlastRadius))
+	for _, impact := range correlation.BlastRadius {
+		impacts = append(impacts, map[string]interface{}{
+			"service": impact.Service,
+			"score":   impact.Score,
+			"depth":   impact.Depth,
+		})
+	}
+
+	properties := map[string]interface{}{
+		"correlationId":         correlation.CorrelationID,
+		"incidentId":            correlation.IncidentID,
+		"tenantId":              tenantID,
+		"rootCause":             correlation.RootCause,
+		"confidence":            correlation.Confidence,
+		"affectedServices":      correlation.AffectedServices,
+		"recommendations":       models.RecommendationTexts(correlation.Recommendations),
+		"recommendationDetails": recommendationProperties(correlation.Recommendations),
+		"category":              string(correlation.Category),
+		"unavailableSources":    unavailableSources(correlation.UnavailableSources),
+		"duplicateOf":           correlation.DuplicateOf,
+		"relatedCorrelations":   correlation.RelatedCorrelations,
+		"status":                string(correlation.Status),
+		"annotations":           annotationProperties(correlation.Annotations),
+		"labels":                labelPairs(correlation.Labels),
+		"incidentTitle":         correlation.Incident.Title,
+		"incidentDescription":   correlation.Incident.Description,
+		"alertFingerprints":     nonNilStrings(correlation.Incident.AlertFingerprints),
+		"ticketUrl":             correlation.Incident.TicketURL,
+		"summary":               correlation.Summary,
+		"impactScore":           correlation.ImpactScore,
+		"environment":           correlation.Environment,
+		"createdAt":             createdAt.Format(time.RFC3339),
+		"redAnchors":            anchors,
+		"timeline":              timeline,
+		"blastRadius":           impacts,
+	}
+	if explanation := explanationProperties(correlation.Explanation); explanation != nil {
+		properties["explanation"] = explanation
+	}
+	if expansion := correlation.WindowExpansion; expansion != nil {
+		properties["windowExpansion"] = map[string]interface{}{
+			"requestedStart": expansion.Requested.Start.UTC().Format(time.RFC3339),
+			"requestedEnd":   expansion.Requested.End.UTC().Format(time.RFC3339),
+			"analysedStart":  expansion.Analysed.Start.UTC().Format(time.RFC3339),
+			"analysedEnd":    expansion.Analysed.End.UTC().Format(time.RFC3339),
+			"sparseSources":  unavailableSources(expansion.SparseSources),
+			"samples":        expansion.Samples,
+			"minSamples":     expansion.MinSamples,
+		}
+	}
+	if len(correlation.SLOImpacts) > 0 {
+		sloImpacts := make([]map[string]interface{}, 0, len(correlation.SLOImpacts))
+		for _, impact := range correlation.SLOImpacts {
+			sloImpacts = append(sloImpacts, map[string]interface{}{
+				"service":     impact.Service,
+				"slo":         impact.SLO,
+				"objective":   impact.Objective,
+				"badFraction": impact.BadFraction,
+				"burnRate":    impact.BurnRate,
+				"budgetSpent": impact.BudgetSpent,
+			})
+		}
+		properties["sloImpacts"] = sloImpacts
+	}
+	if capacity := correlation.Capacity; capacity != nil {
+		resources := make([]map[string]interface{}, 0, len(capacity.Resources))
+		for _, usage := range capacity.Resources {
+			resource := map[string]interface{}{
+				"resource":                usage.Resource,
+				"peak":                    usage.Peak,
+				"latest":                  usage.Latest,
+				"headroom":                usage.Headroom,
+				"extraCapacity":           usage.ExtraCapacity,
+				"timeToExhaustionSeconds": usage.TimeToExhaustion.Seconds(),
+			}
+			if usage.Saturated() {
+				resource["saturatedAt"] = usage.SaturatedAt.UTC().Format(time.RFC3339)
+			}
+			resources = append(resources, resource)
+		}
+		properties["capacity"] = map[string]interface{}{
+			"service":          capacity.Service,
+			"saturationDriven": capacity.SaturationDriven,
+			"saturation":       capacity.Saturation,
+			"resources":        resources,
+		}
+	}
+	return properties
+}
+
+// recommendationRecord mirrors a nested recommendationDetails object.
+type recommendationRecord struct {
+	Text    string `json:"text"`
+	RuleID  string `json:"ruleId"`
+	Actions []struct {
+		Type  string `json:"type"`
+		Label string `json:"label"`
+		URL   string `json:"url"`
+	} `json:"actions"`
+	Generated bool `json:"generated"`
+}
+
+// recommendationsFromRecord prefers the structured details and falls back to the plain texts stored by
+// records written before recommendations carried actions.
+func recommendationsFromRecord(texts []string, details []recommendationRecord) []models.Recommendation {
+	if len(details) == 0 {
+		out := make([]models.Recommendation, 0, len(texts))
+		for _, text := range texts {
+			out = append(out, models.Recommendation{Text: text})
+		}
+		return out
+	}
+	out := make([]models.Recommendation, 0, len(details))
+	for _, detail := range details {
+		rec := models.Recommendation{Text: detail.Text, RuleID: detail.RuleID, Generated: detail.Generated}
+		for _, action := range detail.Actions {
+			rec.Actions = append(rec.Actions, models.RecommendationAction{Type: models.ActionType(action.Type), Label: action.Label, URL: action.URL})
+		}
+		out = append(out, rec)
+	}
+	return out
+}
+
+func recommendationProperties(recs []models.Recommendation) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(recs))
+	for _, rec := range recs {
+		actions := make([]map[string]interface{}, 0, len(rec.Actions))
+		for _, action := range rec.Actions {
+			actions = append(actions, map[string]interface{}{
+				"type":  string(action.Type),
+				"label": action.Label,
+				"url":   action.URL,
+			})
+		}
+		out = append(out, map[string]interface{}{
+			"text":      rec.Text,
+			"ruleId":    rec.RuleID,
+			"actions":   actions,
+			"generated": rec.Generated,
 		})
 	}
+	return out
+}
 
+// explanationProperties encodes an explanation as a nested object; correlations without one get none.
+func explanationProperties(explanation *models.Explanation) map[string]interface{} {
+	if explanation == nil {
+		return nil
+	}
+	detectors := make([]map[string]interface{}, 0, len(explanation.Detectors))
+	for _, detector := range explanation.Detectors {
+		detectors = append(detectors, map[string]interface{}{
+			"name":      detector.Name,
+			"anomalies": detector.Anomalies,
+			"threshold": detector.Threshold,
+			"topScore":  detector.TopScore,
+		})
+	}
+	rules := make([]map[string]interface{}, 0, len(explanation.MatchedRules))
+	for _, rule := range explanation.MatchedRules {
+		rules = append(rules, map[string]interface{}{
+			"ruleId":  rule.RuleID,
+			"reasons": nonNilStrings(rule.Reasons),
+		})
+	}
 	return map[string]interface{}{
-		"correlationId":    correlation.CorrelationID,
-		"incidentId":       correlation.IncidentID,
-		"tenantId":         tenantID,
-		"rootCause":        correlation.RootCause,
-		"confidence":       correlation.Confidence,
-		"affectedServices": correlation.AffectedServices,
-		"recommendations":  correlation.Recommendations,
-		"createdAt":        createdAt.Format(time.RFC3339),
-		"redAnchors":       anchors,
-		"timeline":         timeline,
+		"service":              explanation.Service,
+		"threshold":            explanation.Threshold,
+		"preset":               explanation.Preset,
+		"detectors":            detectors,
+		"causalityScore":       explanation.Causality.Score,
+		"suggestedService":     explanation.Causality.SuggestedService,
+		"causalityNotes":       nonNilStrings(explanation.Causality.Notes),
+		"detectionConfidence":  explanation.DetectionConfidence,
+		"missingSources":       explanation.MissingSources,
+		"maintenanceAnomalies": explanation.MaintenanceAnomalies,
+		"recommendationSource": string(explanation.RecommendationSource),
+		"matchedRules":         rules,
+		"similarCorrelations":  nonNilStrings(explanation.SimilarCorrelations),
+	}
+}
+
+func annotationProperties(annotations []models.Annotation) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(annotations))
+	for _, annotation := range annotations {
+		out = append(out, map[string]interface{}{
+			"author":    annotation.Author,
+			"text":      annotation.Text,
+			"createdAt": annotation.CreatedAt.UTC().Format(time.RFC3339),
+		})
 	}
+	return out
 }
 
 func buildCorrelationWhere(req models.ListCorrelationsRequest) string {
-	filters := []string{fmt.Sprintf(`{path: ["tenantId"], operator: Equal, valueString: "%s"}`, req.TenantID)}
+	operands := []string{whereOperand("tenantId", "Equal", "valueString", req.TenantID)}
 
 	if req.Service != "" {
-		filters = append(filters, fmt.Sprintf(`{path: ["affectedServices"], operator: ContainsAny, valueString: "%s"}`, req.Service))
+		operands = append(operands, whereOperand("affectedServices", "ContainsAny", "valueString", req.Service))
+	}
+	if req.Category != models.CategoryUnknown {
+		operands = append(operands, whereOperand("category", "Equal", "valueString", string(req.Category)))
+	}
+	if len(req.Labels) > 0 {
+		operands = append(operands, whereOperandList("labels", "ContainsAll", "valueString", labelPairs(req.Labels)))
 	}
 	if !req.Start.IsZero() {
-		filters = append(filters, fmt.Sprintf(`{path: ["createdAt"], operator: GreaterThanEqual, valueDate: "%s"}`, req.Start.Format(time.RFC3339)))
+		operands = append(operands, whereOperand("createdAt", "GreaterThanEqual", "valueDate", req.Start.Format(time.RFC3339)))
 	}
 	if !req.End.IsZero() {
-		filters = append(filters, fmt.Sprintf(`{path: ["createdAt"], operator: LessThanEqual, valueDate: "%s"}`, req.End.Format(time.RFC3339)))
+		operands = append(operands, whereOperand("createdAt", "LessThanEqual", "valueDate", req.End.Format(time.RFC3339)))
 	}
 
-	return fmt.Sprintf("where: { operator: And, operands: [%s] }", strings.Join(filters, ","))
+	return whereAnd(operands...)
 }
 
 func parseDataType(value string) models.DataType {
@@ -799,6 +2127,25 @@ func parseDataType(value string) models.DataType {
 	}
 }
 
+func parseDataTypes(values []string) []models.DataType {
+	if len(values) == 0 {
+		return nil
+	}
+	types := make([]models.DataType, 0, len(values))
+	for _, v := range values {
+		types = append(types, parseDataType(v))
+	}
+	return types
+}
+
+func unavailableSources(types []models.DataType) []string {
+	values := make([]string, 0, len(types))
+	for _, t := range types {
+		values = append(values, string(t))
+	}
+	return values
+}
+
 func parseSeverity(value string) models.Severity {
 	switch strings.ToLower(value) {
 	case "low":
@@ -814,43 +2161,9 @@ func parseSeverity(value string) models.Severity {
 	}
 }
 
-func syntheticCorrelationList(req models.ListCorrelationsRequest) models.ListCorrelationsResponse {
-	service := req.Service
-	if service == "" {
-		service = "synthetic-service"
-	}
-
-	items := []models.CorrelationResult{
-		{
-			CorrelationID:    "synthetic-corr-1",
-			IncidentID:       "synthetic-incident-1",
-			RootCause:        fmt.Sprintf("%s: cpu usage anomaly", service),
-			Confidence:       0.65,
-			AffectedServices: []string{service},
-			Recommendations:  []string{"Scale service", "Review upstream errors"},
-			CreatedAt:        time.Now().Add(-1 * time.Hour),
-			RedAnchors: []models.RedAnchor{
-				{
-					Service:      service,
-					Selector:     "metrics:cpu_usage",
-					DataType:     models.DataTypeMetrics,
-					Timestamp:    time.Now().Add(-70 * time.Minute),
-					AnomalyScore: 3.2,
-					Threshold:    2.0,
-				},
-			},
-			Timeline: []models.TimelineEvent{
-				{
-					Time:         time.Now().Add(-75 * time.Minute),
-					Event:        "Metric anomaly detected",
-					Service:      service,
-					Severity:     models.SeverityHigh,
-					AnomalyScore: 3.2,
-					DataSource:   models.DataTypeMetrics,
-				},
-			},
-		},
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
 	}
-
-	return models.ListCorrelationsResponse{Correlations: items}
+	return values
 }
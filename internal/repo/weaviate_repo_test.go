@@ -3,8 +3,14 @@ package repo
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -36,14 +42,48 @@ func TestStoreFeedbackNoEndpoint(t *testing.T) {
 	}
 }
 
-func TestListCorrelationsSynthetic(t *testing.T) {
+func TestListCorrelationsNoEndpoint(t *testing.T) {
 	r := NewWeaviateRepo("", "", time.Second, cache.NoopProvider{}, 0, 0)
 	resp, err := r.ListCorrelations(context.Background(), models.ListCorrelationsRequest{TenantID: "tenant", Service: "checkout"})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if len(resp.Correlations) == 0 {
-		t.Fatalf("expected synthetic correlations")
+	if len(resp.Correlations) != 0 {
+		t.Fatalf("expected no correlations without an endpoint, got %+v", resp.Correlations)
+	}
+	if where := buildCorrelationWhere(models.ListCorrelationsRequest{TenantID: "tenant", Category: models.CategoryNetwork}); !strings.Contains(where, `path: ["category"], operator: Equal, valueString: "network"`) {
+		t.Fatalf("expected category filter in where clause: %s", where)
+	}
+	if where := buildCorrelationWhere(models.ListCorrelationsRequest{TenantID: "tenant", Labels: map[string]string{"team": "payments", "env": "prod"}}); !strings.Contains(where, `path: ["labels"], operator: ContainsAll, valueString: ["env=prod", "team=payments"]`) {
+		t.Fatalf("expected label filter in where clause: %s", where)
+	}
+}
+
+func TestListCorrelationsUpstreamError(t *testing.T) {
+	r := NewWeaviateRepo("https://weaviate.test", "", time.Second, cache.NoopProvider{}, 0, 0)
+	r.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusServiceUnavailable, Status: "503 Service Unavailable", Body: io.NopCloser(strings.NewReader("")), Header: make(http.Header)}, nil
+	}))
+	if _, err := r.ListCorrelations(context.Background(), models.ListCorrelationsRequest{TenantID: "tenant"}); err == nil {
+		t.Fatalf("expected upstream failure to surface")
+	}
+}
+
+func TestWeaviateReady(t *testing.T) {
+	r := NewWeaviateRepo("https://weaviate.test", "secret", time.Second, cache.NoopProvider{}, 0, 0)
+	status := http.StatusOK
+	r.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v1/.well-known/ready" || req.Header.Get("Authorization") != "Bearer secret" {
+			t.Fatalf("unexpected readiness request: %s %v", req.URL.Path, req.Header)
+		}
+		return &http.Response{StatusCode: status, Status: http.StatusText(status), Body: io.NopCloser(strings.NewReader("")), Header: make(http.Header)}, nil
+	}))
+	if err := r.Ready(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	status = http.StatusServiceUnavailable
+	if err := r.Ready(context.Background()); err == nil {
+		t.Fatalf("expected a 503 to report Weaviate as not ready")
 	}
 }
 
@@ -89,6 +129,40 @@ func TestSimilarIncidentsCachesResults(t *testing.T) {
 	}
 }
 
+func TestSimilarIncidentsNegativeCache(t *testing.T) {
+	var hits int
+	handler := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		hits++
+		body := []byte(`{"data":{"Get":{"CorrelationRecord":[]}}}`)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body)), Header: make(http.Header)}, nil
+	})
+	ctx := context.Background()
+
+	repo := NewWeaviateRepo("https://weaviate.test", "", time.Second, newStubCache(), time.Minute, 0, WithNegativeCacheTTL(30*time.Second))
+	repo.httpClient = newTestClient(handler)
+	for i := 0; i < 3; i++ {
+		results, err := repo.SimilarIncidents(ctx, "tenant-a", []string{"checkout"}, 2)
+		if err != nil || len(results) != 0 {
+			t.Fatalf("call %d: unexpected result %+v (%v)", i, results, err)
+		}
+	}
+	if hits != 1 {
+		t.Fatalf("expected the empty result to be cached, got %d upstream calls", hits)
+	}
+
+	hits = 0
+	repo = NewWeaviateRepo("https://weaviate.test", "", time.Second, newStubCache(), time.Minute, 0)
+	repo.httpClient = newTestClient(handler)
+	for i := 0; i < 2; i++ {
+		if _, err := repo.SimilarIncidents(ctx, "tenant-a", []string{"checkout"}, 2); err != nil {
+			t.Fatalf("call %d: %v", i, err)
+		}
+	}
+	if hits != 2 {
+		t.Fatalf("expected empty results to stay uncached without a negative TTL, got %d upstream calls", hits)
+	}
+}
+
 func TestFetchPatternsCachesResults(t *testing.T) {
 	var hits int
 	cacheStub := newStubCache()
@@ -129,3 +203,425 @@ func TestFetchPatternsCachesResults(t *testing.T) {
 		t.Fatalf("unexpected cached pattern payload: %+v", second)
 	}
 }
+
+func TestWritesInvalidateCachedLookups(t *testing.T) {
+	cacheStub := newStubCache()
+	repo := NewWeaviateRepo("https://weaviate.test", "", time.Second, cacheStub, time.Minute, time.Hour)
+	repo.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`)), Header: make(http.Header)}, nil
+	}))
+	ctx := context.Background()
+	for _, key := range []string{
+		cacheSimilarIncidentsKey("tenant-a", []string{"checkout"}, 5),
+		cacheSimilarIncidentsKey("tenant-b", []string{"checkout"}, 5),
+		cachePatternsKey("tenant-a", ""),
+		cachePatternsKey("tenant-a", "payments"),
+		cachePatternsKey("tenant-a", "search"),
+	} {
+		_ = cacheStub.Set(ctx, key, []byte("[]"), time.Minute)
+	}
+
+	if err := repo.StoreFeedback(ctx, models.Feedback{TenantID: "tenant-a", CorrelationID: "c-1", Correct: true}); err != nil {
+		t.Fatalf("store feedback: %v", err)
+	}
+	if err := repo.StorePatterns(ctx, "tenant-a", []models.FailurePattern{{ID: "p1", Services: []string{"payments"}}}); err != nil {
+		t.Fatalf("store patterns: %v", err)
+	}
+
+	for key, want := range map[string]bool{
+		cacheSimilarIncidentsKey("tenant-a", []string{"checkout"}, 5): false,
+		cacheSimilarIncidentsKey("tenant-b", []string{"checkout"}, 5): true,
+		cachePatternsKey("tenant-a", ""):                              false,
+		cachePatternsKey("tenant-a", "payments"):                      false,
+		cachePatternsKey("tenant-a", "search"):                        true,
+	} {
+		if _, err := cacheStub.Get(ctx, key); (err == nil) != want {
+			t.Fatalf("key %s: expected cached=%v", key, want)
+		}
+	}
+}
+
+func TestSearchCorrelationsHybrid(t *testing.T) {
+	repo := NewWeaviateRepo("https://weaviate.test", "", time.Second, nil, 0, 0)
+	repo.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		data, _ := io.ReadAll(req.Body)
+		if strings.Contains(string(data), "CorrelationAnnotation") {
+			body := `{"data":{"Get":{"CorrelationAnnotation":[{"correlationId":"c-1","author":"sre","text":"confirmed","createdAt":"2024-01-02T15:04:05Z"}]}}}`
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+		}
+		if !strings.Contains(string(data), `hybrid: {query: \"payment db \\\"lock\\\" timeout\", alpha: 0.7}`) {
+			t.Fatalf("expected escaped hybrid clause, got %s", data)
+		}
+		body := `{"data":{"Get":{"CorrelationRecord":[` +
+			`{"correlationId":"c-2","rootCause":"payments db","category":"dependency_failure","_additional":{"score":"0.41"}},` +
+			`{"correlationId":"c-1","rootCause":"payments lock timeout","_additional":{"score":"0.87"}}]}}}`
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+	}))
+
+	resp, err := repo.SearchCorrelations(context.Background(), models.SearchCorrelationsRequest{TenantID: "tenant", Query: `payment db "lock" timeout`, Alpha: 0.7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Results) != 2 || resp.Results[0].Correlation.CorrelationID != "c-1" || resp.Results[0].Score != 0.87 {
+		t.Fatalf("expected hits ordered by score, got %+v", resp.Results)
+	}
+	if resp.Results[1].Correlation.Category != models.CategoryDependency {
+		t.Fatalf("expected category decoded, got %q", resp.Results[1].Correlation.Category)
+	}
+	if annotations := resp.Results[0].Correlation.Annotations; len(annotations) != 1 || annotations[0].Text != "confirmed" || len(resp.Results[1].Correlation.Annotations) != 0 {
+		t.Fatalf("expected annotation objects attached to their correlation, got %+v", resp.Results)
+	}
+}
+
+func TestTenantsListsSchemaTenants(t *testing.T) {
+	repo := NewWeaviateRepo("https://weaviate.test", "", time.Second, nil, 0, 0)
+	var paths []string
+	repo.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		paths = append(paths, req.URL.Path)
+		body := `[{"name":"acme","activityStatus":"HOT"}]`
+		if req.URL.Path == "/v1/schema/TopologySnapshot/tenants" {
+			body = `[{"name":"globex","activityStatus":"HOT"},{"name":"acme","activityStatus":"HOT"}]`
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+	}))
+
+	tenants, err := repo.Tenants(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(paths) != 3 || paths[0] != "/v1/schema/CorrelationRecord/tenants" {
+		t.Fatalf("unexpected requests: %v", paths)
+	}
+	if len(tenants) != 2 || tenants[0] != "acme" || tenants[1] != "globex" {
+		t.Fatalf("expected the deduplicated tenants, got %v", tenants)
+	}
+}
+
+func TestPurgeTenantDataBatchDeletes(t *testing.T) {
+	repo := NewWeaviateRepo("https://weaviate.test", "", time.Second, nil, 0, 0)
+	var classes []string
+	repo.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodDelete || req.URL.Path != "/v1/batch/objects" || req.URL.Query().Get("tenant") != "acme" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL)
+		}
+		var body struct {
+			Match struct {
+				Class string `json:"class"`
+				Where struct {
+					Operands []map[string]any `json:"operands"`
+				} `json:"where"`
+			} `json:"match"`
+			DryRun bool `json:"dryRun"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if !body.DryRun || len(body.Match.Where.Operands) != 2 {
+			t.Fatalf("expected dry-run delete with age filter, got %+v", body)
+		}
+		classes = append(classes, body.Match.Class)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"results":{"matches":4,"successful":0,"failed":0}}`)), Header: make(http.Header)}, nil
+	}))
+
+	result, err := repo.PurgeTenantData(context.Background(), models.PurgeRequest{TenantID: "acme", Before: time.Now().Add(-24 * time.Hour), DryRun: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(classes) != 4 || classes[0] != "CorrelationRecord" || classes[1] != "CorrelationAnnotation" || classes[2] != "CorrelationFeedback" || classes[3] != "TopologySnapshot" {
+		t.Fatalf("patterns must be kept for age-based purges, got %v", classes)
+	}
+	if result.Correlations != 4 || result.Feedback != 4 || result.TopologySnapshots != 4 || result.Patterns != 0 || !result.DryRun {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestListFeedbackFilters(t *testing.T) {
+	repo := NewWeaviateRepo("https://weaviate.test", "", time.Second, nil, 0, 0)
+	repo.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		data, _ := io.ReadAll(req.Body)
+		if !strings.Contains(string(data), `{path: [\"correct\"], operator: Equal, valueBoolean: false}`) {
+			t.Fatalf("expected correct filter, got %s", data)
+		}
+		body := `{"data":{"Get":{"CorrelationFeedback":[{"tenantId":"acme","correlationId":"c-1","correct":false,"notes":"wrong service","submittedAt":"2024-06-01T10:00:00Z"}]}}}`
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+	}))
+
+	incorrect := false
+	resp, err := repo.ListFeedback(context.Background(), models.ListFeedbackRequest{TenantID: "acme", Correct: &incorrect, PageSize: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Feedback) != 1 || resp.Feedback[0].Notes != "wrong service" || resp.NextPageToken != "1" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+}
+
+func TestUpdateCorrelationPatchesLifecycle(t *testing.T) {
+	r := NewWeaviateRepo("https://weaviate.test", "", time.Second, cache.NoopProvider{}, 0, 0)
+	var patched, created map[string]interface{}
+	r.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := []byte(`{}`)
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/graphql":
+			body = []byte(`{"data":{"Get":{"CorrelationRecord":[{"correlationId":"c-1","status":"open","annotations":[{"author":"sre","text":"paged db team","createdAt":"2024-01-02T15:04:05Z"}],"_additional":{"id":"6f2c0a8e-0000-4000-8000-000000000001"}}]}}}`)
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/objects":
+			if err := json.NewDecoder(req.Body).Decode(&created); err != nil {
+				t.Fatalf("decode annotation: %v", err)
+			}
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/objects/CorrelationRecord/6f2c0a8e-0000-4000-8000-000000000001":
+			if req.URL.Query().Get("tenant") != "tenant" {
+				t.Fatalf("expected tenant query, got %s", req.URL.RawQuery)
+			}
+			if err := json.NewDecoder(req.Body).Decode(&patched); err != nil {
+				t.Fatalf("decode patch: %v", err)
+			}
+		default:
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body)), Header: make(http.Header)}, nil
+	}))
+
+	updated, err := r.UpdateCorrelation(context.Background(), models.UpdateCorrelationRequest{
+		TenantID:      "tenant",
+		CorrelationID: "c-1",
+		Status:        models.CorrelationResolved,
+		Annotations:   []models.Annotation{{Author: "sre", Text: "rolled back release"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated.Status != models.CorrelationResolved || len(updated.Annotations) != 2 || updated.Annotations[1].CreatedAt.IsZero() {
+		t.Fatalf("unexpected update result: %+v", updated)
+	}
+	props, _ := patched["properties"].(map[string]interface{})
+	if props["status"] != "resolved" {
+		t.Fatalf("expected status in patch, got %+v", patched)
+	}
+	if _, ok := props["annotations"]; ok {
+		t.Fatalf("expected annotations to stay out of the record patch, got %+v", props)
+	}
+	annotation, _ := created["properties"].(map[string]interface{})
+	if created["class"] != "CorrelationAnnotation" || annotation["correlationId"] != "c-1" || annotation["text"] != "rolled back release" {
+		t.Fatalf("expected the new annotation created as its own object, got %+v", created)
+	}
+}
+
+// fakeAnnotationStore is a Weaviate stand-in holding one correlation record and its annotation objects.
+type fakeAnnotationStore struct {
+	mu          sync.Mutex
+	annotations []map[string]interface{}
+}
+
+func (f *fakeAnnotationStore) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	data, _ := io.ReadAll(req.Body)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch {
+	case req.Method == http.MethodPost && req.URL.Path == "/v1/graphql" && strings.Contains(string(data), "CorrelationAnnotation"):
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"Get": map[string]interface{}{"CorrelationAnnotation": f.annotations}}})
+	case req.Method == http.MethodPost && req.URL.Path == "/v1/graphql":
+		// Give concurrent updates time to read the same record state.
+		time.Sleep(20 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"data":{"Get":{"CorrelationRecord":[{"correlationId":"c-1","status":"open","_additional":{"id":"6f2c0a8e-0000-4000-8000-000000000001"}}]}}}`))
+	case req.Method == http.MethodPost && req.URL.Path == "/v1/objects":
+		var object struct {
+			Properties map[string]interface{} `json:"properties"`
+		}
+		_ = json.Unmarshal(data, &object)
+		f.annotations = append(f.annotations, object.Properties)
+		_, _ = w.Write([]byte(`{}`))
+	case req.Method == http.MethodPatch:
+		_, _ = w.Write([]byte(`{}`))
+	default:
+		http.Error(w, "unexpected request", http.StatusBadRequest)
+	}
+}
+
+func TestConcurrentAnnotationsAreAllKept(t *testing.T) {
+	server := httptest.NewServer(&fakeAnnotationStore{})
+	defer server.Close()
+	r := NewWeaviateRepo(server.URL, "", time.Second, cache.NoopProvider{}, 0, 0)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 2)
+	for _, author := range []string{"alice", "bob"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := r.UpdateCorrelation(context.Background(), models.UpdateCorrelationRequest{
+				TenantID:      "tenant",
+				CorrelationID: "c-1",
+				Annotations:   []models.Annotation{{Author: author, Text: author + " was here"}},
+			})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("update: %v", err)
+		}
+	}
+
+	correlation, err := r.GetCorrelation(context.Background(), "tenant", "c-1")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	authors := map[string]bool{}
+	for _, annotation := range correlation.Annotations {
+		authors[annotation.Author] = true
+	}
+	if len(correlation.Annotations) != 2 || !authors["alice"] || !authors["bob"] {
+		t.Fatalf("expected both concurrent annotations to be kept, got %+v", correlation.Annotations)
+	}
+}
+
+func TestUpdateCorrelationNotFound(t *testing.T) {
+	r := NewWeaviateRepo("https://weaviate.test", "", time.Second, cache.NoopProvider{}, 0, 0)
+	r.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := []byte(`{"data":{"Get":{"CorrelationRecord":[]}}}`)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body)), Header: make(http.Header)}, nil
+	}))
+	_, err := r.UpdateCorrelation(context.Background(), models.UpdateCorrelationRequest{TenantID: "tenant", CorrelationID: "missing", Status: models.CorrelationRejected})
+	if !errors.Is(err, ErrCorrelationNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+}
+
+func TestCorrelationExplanationRoundTrips(t *testing.T) {
+	explanation := &models.Explanation{
+		Service:              "checkout",
+		Threshold:            2.5,
+		Detectors:            []models.DetectorRun{{Name: "metrics_zscore", Anomalies: 3, Threshold: 2.5, TopScore: 4.2}},
+		Causality:            models.CausalityExplanation{Score: 0.7, SuggestedService: "payments", Notes: []string{"payments degraded first"}},
+		DetectionConfidence:  0.6,
+		MissingSources:       1,
+		RecommendationSource: models.RecommendationsFromRules,
+		MatchedRules:         []models.MatchedRule{{RuleID: "rule-1", Reasons: []string{"service checkout matched"}}},
+		SimilarCorrelations:  []string{"c-0"},
+	}
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	expansion := &models.WindowExpansion{
+		Requested:     models.TimeRange{Start: start, End: start.Add(10 * time.Minute)},
+		Analysed:      models.TimeRange{Start: start.Add(-10 * time.Minute), End: start.Add(20 * time.Minute)},
+		SparseSources: []models.DataType{models.DataTypeMetrics},
+		Samples:       12,
+		MinSamples:    10,
+	}
+	exemplar := models.TraceExemplar{TraceID: "abc123", SpanID: "def456", Operation: "HTTP POST", Status: "error", Duration: 1500 * time.Millisecond, Timestamp: start, Link: "https://tempo.example/trace/abc123"}
+	capacity := &models.CapacityAnalysis{
+		Service:          "checkout",
+		SaturationDriven: true,
+		Saturation:       0.9,
+		Resources: []models.ResourceUsage{
+			{Resource: "cpu", Peak: 0.97, Latest: 0.95, Headroom: 0.03, SaturatedAt: start, ExtraCapacity: 0.97/0.9 - 1},
+			{Resource: "memory", Peak: 0.7, Latest: 0.7, Headroom: 0.3, TimeToExhaustion: 40 * time.Minute},
+		},
+	}
+	sloImpacts := []models.SLOImpact{{Service: "checkout", SLO: "availability", Objective: 0.999, BadFraction: 0.0115, BurnRate: 11.5, BudgetSpent: 0.004}}
+	props := buildCorrelationProperties("tenant", models.CorrelationResult{
+		CorrelationID:   "c-1",
+		Capacity:        capacity,
+		ImpactScore:     0.8,
+		SLOImpacts:      sloImpacts,
+		Explanation:     explanation,
+		Summary:         "Checkout slowed down.",
+		WindowExpansion: expansion,
+		Environment:     "staging",
+		RedAnchors:      []models.RedAnchor{{Service: "checkout", Environment: "staging", Link: "https://grafana.example/d/checkout", Direction: models.DirectionDown, Evidence: models.Evidence{Exemplars: []models.TraceExemplar{exemplar}}}},
+		Timeline: []models.TimelineEvent{
+			{Time: start, Event: "Metric drop detected", Link: "https://grafana.example/d/checkout?from=1", Direction: models.DirectionDown},
+			{Time: start, Event: "Log spike (error)", Start: start, End: start.Add(12 * time.Minute), Ongoing: true},
+		},
+	})
+	data, err := json.Marshal(props)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var rec correlationRecord
+	if err := json.Unmarshal(data, &rec); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if got := rec.toModel().Explanation; !reflect.DeepEqual(got, explanation) {
+		t.Fatalf("explanation did not round-trip:\n got %+v\nwant %+v", got, explanation)
+	}
+	if got := rec.toModel().Summary; got != "Checkout slowed down." {
+		t.Fatalf("summary did not round-trip: %q", got)
+	}
+	if got := rec.toModel(); got.Environment != "staging" || len(got.RedAnchors) != 1 || got.RedAnchors[0].Environment != "staging" {
+		t.Fatalf("environment did not round-trip: %q %+v", got.Environment, got.RedAnchors)
+	}
+	if got := rec.toModel().RedAnchors[0].Evidence.Exemplars; len(got) != 1 || !reflect.DeepEqual(got[0], exemplar) {
+		t.Fatalf("exemplars did not round-trip: %+v", got)
+	}
+	if got := rec.toModel(); got.RedAnchors[0].Link != "https://grafana.example/d/checkout" || got.Timeline[0].Link != "https://grafana.example/d/checkout?from=1" {
+		t.Fatalf("links did not round-trip: %+v %+v", got.RedAnchors, got.Timeline)
+	}
+	if got := rec.toModel(); got.RedAnchors[0].Direction != models.DirectionDown || got.Timeline[0].Direction != models.DirectionDown || got.Timeline[1].Direction != "" {
+		t.Fatalf("directions did not round-trip: %+v %+v", got.RedAnchors, got.Timeline)
+	}
+	if got := rec.toModel().Timeline; len(got) != 2 || got[0].Sustained() || got[1].Duration() != 12*time.Minute || !got[1].Ongoing {
+		t.Fatalf("timeline intervals did not round-trip: %+v", got)
+	}
+	if got := rec.toModel().WindowExpansion; !reflect.DeepEqual(got, expansion) {
+		t.Fatalf("window expansion did not round-trip:\n got %+v\nwant %+v", got, expansion)
+	}
+	if got := rec.toModel().Capacity; !reflect.DeepEqual(got, capacity) {
+		t.Fatalf("capacity did not round-trip:\n got %+v\nwant %+v", got, capacity)
+	}
+	if got := rec.toModel(); got.ImpactScore != 0.8 || !reflect.DeepEqual(got.SLOImpacts, sloImpacts) {
+		t.Fatalf("SLO impact did not round-trip: %f %+v", got.ImpactScore, got.SLOImpacts)
+	}
+
+	props = buildCorrelationProperties("tenant", models.CorrelationResult{CorrelationID: "c-2"})
+	if _, ok := props["explanation"]; ok {
+		t.Fatalf("expected no explanation property for an unexplained correlation")
+	}
+}
+
+func TestMaintenanceWindowsRoundTrip(t *testing.T) {
+	repo := NewWeaviateRepo("https://weaviate.test", "", time.Second, nil, 0, 0)
+	start := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	var stored map[string]any
+	repo.httpClient = newTestClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{}`
+		switch req.URL.Path {
+		case "/v1/objects":
+			var object struct {
+				Class      string         `json:"class"`
+				Tenant     string         `json:"tenant"`
+				Properties map[string]any `json:"properties"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&object); err != nil || object.Class != "MaintenanceWindow" || object.Tenant != "acme" {
+				t.Fatalf("unexpected object: %+v (%v)", object, err)
+			}
+			stored = object.Properties
+		case "/v1/graphql":
+			body = `{"data":{"Get":{"MaintenanceWindow":[{"windowId":"mw-1","services":["checkout"],"start":"2024-06-01T10:00:00Z","end":"2024-06-01T11:00:00Z","reason":"db upgrade"}]}}}`
+		case "/v1/batch/objects":
+			body = `{"results":{"matches":1,"successful":1}}`
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL)
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+	}))
+
+	window := models.MaintenanceWindow{ID: "mw-1", TenantID: "acme", Services: []string{"checkout"}, Start: start, End: start.Add(time.Hour), Reason: "db upgrade"}
+	if err := repo.StoreMaintenanceWindow(context.Background(), window); err != nil {
+		t.Fatalf("store: %v", err)
+	}
+	if stored["windowId"] != "mw-1" || stored["start"] != "2024-06-01T10:00:00Z" {
+		t.Fatalf("unexpected stored properties: %+v", stored)
+	}
+
+	windows, err := repo.ListMaintenanceWindows(context.Background(), "acme")
+	if err != nil || len(windows) != 1 || windows[0].ID != "mw-1" || windows[0].TenantID != "acme" || !windows[0].Start.Equal(start) || windows[0].Services[0] != "checkout" {
+		t.Fatalf("unexpected windows: %+v (%v)", windows, err)
+	}
+
+	deleted, err := repo.DeleteMaintenanceWindow(context.Background(), "acme", "mw-1")
+	if err != nil || !deleted {
+		t.Fatalf("expected window deleted, got %v (%v)", deleted, err)
+	}
+}
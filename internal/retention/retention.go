@@ -0,0 +1,127 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// Purger deletes tenant history older than a cutoff.
+type Purger interface {
+	PurgeTenantData(ctx context.Context, req models.PurgeRequest) (models.PurgeResult, error)
+}
+
+// TenantLister enumerates the tenants with stored history. Purgers that implement it have DefaultAge applied
+// to tenants missing from the configured map as well.
+type TenantLister interface {
+	Tenants(ctx context.Context) ([]string, error)
+}
+
+// Job periodically deletes correlation and feedback history older than each tenant's retention age.
+type Job struct {
+	logger     *slog.Logger
+	purger     Purger
+	interval   time.Duration
+	defaultAge time.Duration
+	tenants    map[string]time.Duration
+	dryRun     bool
+	now        func() time.Time
+}
+
+// NewJob constructs a retention job. Listed tenants use their own age, or defaultAge when it is zero; when
+// purger is a TenantLister, every other stored tenant uses defaultAge. A non-positive interval defaults to one
+// hour.
+func NewJob(logger *slog.Logger, purger Purger, interval, defaultAge time.Duration, tenants map[string]time.Duration, dryRun bool) *Job {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	return &Job{
+		logger:     logger,
+		purger:     purger,
+		interval:   interval,
+		defaultAge: defaultAge,
+		tenants:    tenants,
+		dryRun:     dryRun,
+		now:        time.Now,
+	}
+}
+
+// Run applies retention immediately and then every interval until ctx is cancelled.
+func (j *Job) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+	for {
+		if err := j.RunOnce(ctx); err != nil {
+			j.logger.Warn("retention run failed", slog.Any("error", err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce purges every configured and stored tenant once. Tenants without a positive age are skipped;
+// failures for one tenant do not stop the others.
+func (j *Job) RunOnce(ctx context.Context) error {
+	if j == nil || j.purger == nil {
+		return nil
+	}
+	var errs []error
+	seen := make(map[string]bool, len(j.tenants))
+	tenantIDs := make([]string, 0, len(j.tenants))
+	for tenantID := range j.tenants {
+		seen[tenantID] = true
+		tenantIDs = append(tenantIDs, tenantID)
+	}
+	if lister, ok := j.purger.(TenantLister); ok && j.defaultAge > 0 {
+		stored, err := lister.Tenants(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("list tenants: %w", err))
+		}
+		for _, tenantID := range stored {
+			if tenantID != "" && !seen[tenantID] {
+				seen[tenantID] = true
+				tenantIDs = append(tenantIDs, tenantID)
+			}
+		}
+	}
+	sort.Strings(tenantIDs)
+
+	for _, tenantID := range tenantIDs {
+		age := j.tenants[tenantID]
+		if age <= 0 {
+			age = j.defaultAge
+		}
+		if age <= 0 {
+			continue
+		}
+		result, err := j.purger.PurgeTenantData(ctx, models.PurgeRequest{
+			TenantID: tenantID,
+			Before:   j.now().Add(-age),
+			DryRun:   j.dryRun,
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("tenant %s: %w", tenantID, err))
+			continue
+		}
+		j.logger.Info("retention applied",
+			slog.String("tenant_id", tenantID),
+			slog.Duration("age", age),
+			slog.Int("correlations", result.Correlations),
+			slog.Int("feedback", result.Feedback),
+			slog.Int("topology_snapshots", result.TopologySnapshots),
+			slog.Bool("dry_run", result.DryRun),
+		)
+	}
+	return errors.Join(errs...)
+}
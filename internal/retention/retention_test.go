@@ -0,0 +1,79 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+type recordingPurger struct {
+	requests []models.PurgeRequest
+	failFor  string
+}
+
+func (p *recordingPurger) PurgeTenantData(ctx context.Context, req models.PurgeRequest) (models.PurgeResult, error) {
+	p.requests = append(p.requests, req)
+	if req.TenantID == p.failFor {
+		return models.PurgeResult{}, errors.New("weaviate down")
+	}
+	return models.PurgeResult{Correlations: 2, DryRun: req.DryRun}, nil
+}
+
+func TestRunOnceAppliesPerTenantAge(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	purger := &recordingPurger{failFor: "broken"}
+	job := NewJob(nil, purger, time.Hour, 30*24*time.Hour, map[string]time.Duration{
+		"acme":   7 * 24 * time.Hour,
+		"broken": 0,
+		"globex": 0,
+	}, true)
+	job.now = func() time.Time { return now }
+
+	err := job.RunOnce(context.Background())
+	if err == nil {
+		t.Fatalf("expected error for failing tenant")
+	}
+	if len(purger.requests) != 3 {
+		t.Fatalf("expected every tenant to be attempted, got %d", len(purger.requests))
+	}
+	acme := purger.requests[0]
+	if acme.TenantID != "acme" || !acme.Before.Equal(now.Add(-7*24*time.Hour)) || !acme.DryRun {
+		t.Fatalf("unexpected acme request: %+v", acme)
+	}
+	globex := purger.requests[2]
+	if globex.TenantID != "globex" || !globex.Before.Equal(now.Add(-30*24*time.Hour)) {
+		t.Fatalf("expected default age for globex: %+v", globex)
+	}
+}
+
+type listingPurger struct {
+	recordingPurger
+	tenants []string
+}
+
+func (p *listingPurger) Tenants(ctx context.Context) ([]string, error) {
+	return p.tenants, nil
+}
+
+func TestRunOnceAppliesDefaultAgeToUnlistedTenants(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	purger := &listingPurger{tenants: []string{"acme", "initech"}}
+	job := NewJob(nil, purger, time.Hour, 30*24*time.Hour, map[string]time.Duration{"acme": 7 * 24 * time.Hour}, false)
+	job.now = func() time.Time { return now }
+
+	if err := job.RunOnce(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(purger.requests) != 2 {
+		t.Fatalf("expected each tenant to be purged once, got %+v", purger.requests)
+	}
+	if acme := purger.requests[0]; acme.TenantID != "acme" || !acme.Before.Equal(now.Add(-7*24*time.Hour)) {
+		t.Fatalf("listed tenant must keep its own age: %+v", acme)
+	}
+	if initech := purger.requests[1]; initech.TenantID != "initech" || !initech.Before.Equal(now.Add(-30*24*time.Hour)) {
+		t.Fatalf("expected default age for the unlisted tenant: %+v", initech)
+	}
+}
@@ -0,0 +1,142 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Schemes lists the reference prefixes understood by Resolver: file://<path>, vault://<path>, and
+// awssm://<secret id>. Each may end in #<field> to pick one key of a JSON (or Vault KV) secret.
+var Schemes = []string{"file", "vault", "awssm"}
+
+// Backend fetches one secret. field is empty when the reference names no field.
+type Backend interface {
+	Resolve(ctx context.Context, path, field string) (string, error)
+}
+
+// Resolver replaces secret references with their values and returns any other value unchanged, so plaintext
+// configuration keeps working. The file backend is always available; vault and awssm need a backend.
+type Resolver struct {
+	backends map[string]Backend
+}
+
+// Option registers a backend on a Resolver.
+type Option func(*Resolver)
+
+// WithBackend serves references with the given scheme from backend.
+func WithBackend(scheme string, backend Backend) Option {
+	return func(r *Resolver) {
+		r.backends[scheme] = backend
+	}
+}
+
+// NewResolver builds a resolver.
+func NewResolver(opts ...Option) *Resolver {
+	r := &Resolver{backends: map[string]Backend{"file": File{}}}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// IsReference reports whether value is a secret reference rather than a plaintext value.
+func IsReference(value string) bool {
+	_, _, _, ok := parse(value)
+	return ok
+}
+
+// Scheme returns the scheme of a secret reference, or "" for plaintext values.
+func Scheme(value string) string {
+	scheme, _, _, _ := parse(value)
+	return scheme
+}
+
+// Resolve returns the secret value referenced by value, or value itself when it is not a reference.
+func (r *Resolver) Resolve(ctx context.Context, value string) (string, error) {
+	scheme, path, field, ok := parse(value)
+	if !ok {
+		return value, nil
+	}
+	backend, ok := r.backends[scheme]
+	if !ok {
+		return "", fmt.Errorf("secret %q: no %s backend configured", value, scheme)
+	}
+	resolved, err := backend.Resolve(ctx, path, field)
+	if err != nil {
+		return "", fmt.Errorf("secret %q: %w", value, err)
+	}
+	return resolved, nil
+}
+
+// ResolveAll resolves every value of values into a new map, leaving values untouched.
+func (r *Resolver) ResolveAll(ctx context.Context, values map[string]string) (map[string]string, error) {
+	if values == nil {
+		return nil, nil
+	}
+	resolved := make(map[string]string, len(values))
+	for key, value := range values {
+		secret, err := r.Resolve(ctx, value)
+		if err != nil {
+			return nil, err
+		}
+		resolved[key] = secret
+	}
+	return resolved, nil
+}
+
+func parse(value string) (scheme, path, field string, ok bool) {
+	for _, candidate := range Schemes {
+		if rest, found := strings.CutPrefix(value, candidate+"://"); found && rest != "" {
+			path, field, _ = strings.Cut(rest, "#")
+			return candidate, path, field, path != ""
+		}
+	}
+	return "", "", "", false
+}
+
+// File reads secrets from mounted files such as Kubernetes secret volumes. Trailing newlines are trimmed;
+// with a field, the file must hold a JSON object.
+type File struct{}
+
+// Resolve implements Backend.
+func (File) Resolve(ctx context.Context, path, field string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return pick(strings.TrimRight(string(data), "\r\n"), field)
+}
+
+// pick returns raw, or the string value of field when raw is a JSON object.
+func pick(raw, field string) (string, error) {
+	if field == "" {
+		return raw, nil
+	}
+	var object map[string]interface{}
+	if err := json.Unmarshal([]byte(raw), &object); err != nil {
+		return "", fmt.Errorf("field %q requested but the secret is not a JSON object", field)
+	}
+	return fieldValue(object, field)
+}
+
+func fieldValue(object map[string]interface{}, field string) (string, error) {
+	value, ok := object[field]
+	if !ok {
+		return "", fmt.Errorf("field %q not found", field)
+	}
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case nil:
+		return "", nil
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	}
+}
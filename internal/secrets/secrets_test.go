@@ -0,0 +1,130 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestResolverFilesAndPlaintext(t *testing.T) {
+	dir := t.TempDir()
+	plain := filepath.Join(dir, "password")
+	creds := filepath.Join(dir, "creds.json")
+	if err := os.WriteFile(plain, []byte("s3cret\n"), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if err := os.WriteFile(creds, []byte(`{"apiKey":"abc","port":6379}`), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	resolver := NewResolver()
+	ctx := context.Background()
+
+	for value, want := range map[string]string{
+		"plaintext":                   "plaintext",
+		"https://hooks.example.com/":  "https://hooks.example.com/",
+		"file://" + plain:             "s3cret",
+		"file://" + creds + "#apiKey": "abc",
+		"file://" + creds + "#port":   "6379",
+	} {
+		got, err := resolver.Resolve(ctx, value)
+		if err != nil || got != want {
+			t.Fatalf("resolve %q: got %q, %v; want %q", value, got, err, want)
+		}
+	}
+	if _, err := resolver.Resolve(ctx, "file://"+creds+"#missing"); err == nil {
+		t.Fatalf("expected a missing field to fail")
+	}
+	if _, err := resolver.Resolve(ctx, "vault://secret/data/app#key"); err == nil || !strings.Contains(err.Error(), "no vault backend") {
+		t.Fatalf("expected an unconfigured backend to fail, got %v", err)
+	}
+	if !IsReference("awssm://prod/app") || IsReference("vault://") || Scheme("file:///x") != "file" {
+		t.Fatalf("unexpected reference detection")
+	}
+}
+
+func TestVaultReadsKVVersions(t *testing.T) {
+	var tokens []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokens = append(tokens, r.Header.Get("X-Vault-Token"))
+		switch r.URL.Path {
+		case "/v1/secret/data/mirador-rca":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{
+				"data":     map[string]interface{}{"weaviateApiKey": "wv-key", "cachePassword": "vk-pass"},
+				"metadata": map[string]interface{}{"version": 3},
+			}})
+		case "/v1/kv/slack":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"url": "https://hooks.slack.com/x"}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("t-1\n"), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	vault, err := NewVault(VaultConfig{Address: server.URL, TokenFile: tokenFile})
+	if err != nil {
+		t.Fatalf("new vault: %v", err)
+	}
+	resolver := NewResolver(WithBackend("vault", vault))
+	ctx := context.Background()
+
+	if got, err := resolver.Resolve(ctx, "vault://secret/data/mirador-rca#cachePassword"); err != nil || got != "vk-pass" {
+		t.Fatalf("kv v2: got %q, %v", got, err)
+	}
+	if _, err := resolver.Resolve(ctx, "vault://secret/data/mirador-rca"); err == nil {
+		t.Fatalf("expected a multi-key secret without a field to fail")
+	}
+	if err := os.WriteFile(tokenFile, []byte("t-2"), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if got, err := resolver.Resolve(ctx, "vault://kv/slack"); err != nil || got != "https://hooks.slack.com/x" {
+		t.Fatalf("kv v1: got %q, %v", got, err)
+	}
+	if _, err := resolver.Resolve(ctx, "vault://kv/missing#key"); err == nil {
+		t.Fatalf("expected a missing path to fail")
+	}
+	if tokens[0] != "t-1" || tokens[2] != "t-2" {
+		t.Fatalf("expected the token file to be re-read, got %v", tokens)
+	}
+}
+
+func TestSecretsManagerGetSecretValueSigned(t *testing.T) {
+	var got *http.Request
+	var body map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_ = json.NewEncoder(w).Encode(map[string]string{"SecretString": `{"cachePassword":"vk-pass"}`})
+	}))
+	defer server.Close()
+
+	manager, err := NewSecretsManager(AWSConfig{Endpoint: server.URL, Region: "eu-west-1", AccessKeyID: "AKID", SecretAccessKey: "secret", SessionToken: "session"})
+	if err != nil {
+		t.Fatalf("new secrets manager: %v", err)
+	}
+	manager.now = func() time.Time { return time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC) }
+
+	value, err := NewResolver(WithBackend("awssm", manager)).Resolve(context.Background(), "awssm://prod/mirador-rca#cachePassword")
+	if err != nil || value != "vk-pass" {
+		t.Fatalf("resolve: got %q, %v", value, err)
+	}
+	if body["SecretId"] != "prod/mirador-rca" || got.Header.Get("X-Amz-Target") != "secretsmanager.GetSecretValue" {
+		t.Fatalf("unexpected request: %v %v", body, got.Header)
+	}
+	auth := got.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKID/20240601/eu-west-1/secretsmanager/aws4_request, SignedHeaders=content-type;host;x-amz-date;x-amz-security-token;x-amz-target, Signature=") {
+		t.Fatalf("unexpected authorization header: %s", auth)
+	}
+	if got.Header.Get("X-Amz-Security-Token") != "session" {
+		t.Fatalf("expected the session token to be sent")
+	}
+}
@@ -0,0 +1,111 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// VaultConfig configures reads from HashiCorp Vault. Address and Token fall back to VAULT_ADDR and
+// VAULT_TOKEN; TokenFile (for example a Vault Agent sink) is re-read on every lookup so rotated tokens are
+// picked up.
+type VaultConfig struct {
+	Address   string
+	Token     string
+	TokenFile string
+	Namespace string
+	Timeout   time.Duration
+}
+
+// Vault reads KV secrets over the HTTP API. References name the API path below /v1/, such as
+// vault://secret/data/mirador-rca#weaviateApiKey for KV version 2 or vault://secret/mirador-rca#key for
+// version 1.
+type Vault struct {
+	cfg        VaultConfig
+	httpClient *http.Client
+}
+
+// NewVault builds a Vault backend.
+func NewVault(cfg VaultConfig) (*Vault, error) {
+	if cfg.Address == "" {
+		cfg.Address = os.Getenv("VAULT_ADDR")
+	}
+	if cfg.Token == "" && cfg.TokenFile == "" {
+		cfg.Token = os.Getenv("VAULT_TOKEN")
+	}
+	if cfg.Address == "" {
+		return nil, fmt.Errorf("vault address is required")
+	}
+	if cfg.Token == "" && cfg.TokenFile == "" {
+		return nil, fmt.Errorf("vault token or token file is required")
+	}
+	cfg.Address = strings.TrimRight(cfg.Address, "/")
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 5 * time.Second
+	}
+	return &Vault{cfg: cfg, httpClient: &http.Client{Timeout: cfg.Timeout}}, nil
+}
+
+// Resolve implements Backend. Without a field, the secret must hold exactly one key.
+func (v *Vault) Resolve(ctx context.Context, path, field string) (string, error) {
+	token, err := v.token()
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.cfg.Address+"/v1/"+strings.TrimLeft(path, "/"), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	if v.cfg.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.cfg.Namespace)
+	}
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return "", fmt.Errorf("vault read %s: %s: %s", path, resp.Status, strings.TrimSpace(string(data)))
+	}
+
+	var payload struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return "", fmt.Errorf("decode vault response: %w", err)
+	}
+	data := payload.Data
+	// KV version 2 nests the secret under data.data next to its metadata.
+	if inner, ok := data["data"].(map[string]interface{}); ok {
+		if _, versioned := data["metadata"]; versioned {
+			data = inner
+		}
+	}
+	if field == "" {
+		if len(data) != 1 {
+			return "", fmt.Errorf("vault secret %s has %d keys; name one with #field", path, len(data))
+		}
+		for key := range data {
+			field = key
+		}
+	}
+	return fieldValue(data, field)
+}
+
+func (v *Vault) token() (string, error) {
+	if v.cfg.TokenFile == "" {
+		return v.cfg.Token, nil
+	}
+	data, err := os.ReadFile(v.cfg.TokenFile)
+	if err != nil {
+		return "", fmt.Errorf("read vault token: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
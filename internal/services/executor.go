@@ -0,0 +1,179 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/metrics"
+)
+
+var (
+	// ErrQueueFull reports that every investigation slot is busy and the shared queue is at capacity.
+	ErrQueueFull = errors.New("investigation queue is full")
+	// ErrTenantQueueFull reports that the tenant already has its maximum number of queued investigations.
+	ErrTenantQueueFull = errors.New("tenant investigation queue is full")
+)
+
+// ExecutorConfig bounds investigation execution. MaxConcurrent pipelines run at once; up to QueueDepth more
+// wait for a slot, at most TenantQueueDepth of them from one tenant. Freed slots go to queued tenants in
+// turn, so a burst from one tenant cannot starve the others.
+type ExecutorConfig struct {
+	MaxConcurrent    int
+	QueueDepth       int
+	TenantQueueDepth int
+}
+
+// Executor admits investigations against the limits of an ExecutorConfig. Callers beyond them are rejected
+// instead of piling up goroutines and memory.
+type Executor struct {
+	cfg ExecutorConfig
+
+	mu      sync.Mutex
+	running int
+	queued  int
+	waiting map[string][]*executorWaiter
+	// turns lists tenants with queued work in the order they get the next free slot.
+	turns []string
+}
+
+type executorWaiter struct {
+	ready   chan struct{}
+	granted bool
+}
+
+// NewExecutor returns an executor. A non-positive MaxConcurrent defaults to 16, a negative QueueDepth to
+// zero (no queueing), and a non-positive TenantQueueDepth, or one above QueueDepth, to QueueDepth.
+func NewExecutor(cfg ExecutorConfig) *Executor {
+	if cfg.MaxConcurrent <= 0 {
+		cfg.MaxConcurrent = 16
+	}
+	if cfg.QueueDepth < 0 {
+		cfg.QueueDepth = 0
+	}
+	if cfg.TenantQueueDepth <= 0 || cfg.TenantQueueDepth > cfg.QueueDepth {
+		cfg.TenantQueueDepth = cfg.QueueDepth
+	}
+	return &Executor{cfg: cfg, waiting: make(map[string][]*executorWaiter)}
+}
+
+// Acquire waits for an investigation slot for tenant and returns the function that frees it. It fails with
+// ErrQueueFull or ErrTenantQueueFull when the request cannot be queued, or with the context error when ctx
+// ends first.
+func (e *Executor) Acquire(ctx context.Context, tenant string) (func(), error) {
+	e.mu.Lock()
+	if e.running < e.cfg.MaxConcurrent && e.queued == 0 {
+		e.running++
+		e.publishLocked()
+		e.mu.Unlock()
+		metrics.ObserveExecutorAdmission(metrics.ExecutorAdmitted, 0)
+		return e.releaseFunc(), nil
+	}
+	if e.queued >= e.cfg.QueueDepth {
+		e.mu.Unlock()
+		metrics.ObserveExecutorAdmission(metrics.ExecutorQueueFull, 0)
+		return nil, ErrQueueFull
+	}
+	if len(e.waiting[tenant]) >= e.cfg.TenantQueueDepth {
+		e.mu.Unlock()
+		metrics.ObserveExecutorAdmission(metrics.ExecutorTenantQueueFull, 0)
+		return nil, ErrTenantQueueFull
+	}
+	waiter := &executorWaiter{ready: make(chan struct{})}
+	if len(e.waiting[tenant]) == 0 {
+		e.turns = append(e.turns, tenant)
+	}
+	e.waiting[tenant] = append(e.waiting[tenant], waiter)
+	e.queued++
+	e.publishLocked()
+	e.mu.Unlock()
+
+	start := time.Now()
+	select {
+	case <-waiter.ready:
+		metrics.ObserveExecutorAdmission(metrics.ExecutorAdmitted, time.Since(start))
+		return e.releaseFunc(), nil
+	case <-ctx.Done():
+	}
+
+	e.mu.Lock()
+	if waiter.granted {
+		// The slot arrived together with the cancellation; hand it on.
+		e.running--
+		e.dispatchLocked()
+	} else {
+		e.removeLocked(tenant, waiter)
+	}
+	e.publishLocked()
+	e.mu.Unlock()
+	metrics.ObserveExecutorAdmission(metrics.ExecutorCancelled, time.Since(start))
+	return nil, ctx.Err()
+}
+
+// Stats reports the investigations running and queued right now.
+func (e *Executor) Stats() (running, queued int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.running, e.queued
+}
+
+func (e *Executor) releaseFunc() func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			e.mu.Lock()
+			defer e.mu.Unlock()
+			e.running--
+			e.dispatchLocked()
+			e.publishLocked()
+		})
+	}
+}
+
+// dispatchLocked hands free slots to queued waiters, one tenant at a time in turn.
+func (e *Executor) dispatchLocked() {
+	for e.running < e.cfg.MaxConcurrent && len(e.turns) > 0 {
+		tenant := e.turns[0]
+		e.turns = e.turns[1:]
+		queue := e.waiting[tenant]
+		waiter := queue[0]
+		if len(queue) == 1 {
+			delete(e.waiting, tenant)
+		} else {
+			e.waiting[tenant] = queue[1:]
+			e.turns = append(e.turns, tenant)
+		}
+		e.queued--
+		e.running++
+		waiter.granted = true
+		close(waiter.ready)
+	}
+}
+
+func (e *Executor) removeLocked(tenant string, waiter *executorWaiter) {
+	queue := e.waiting[tenant]
+	for i, candidate := range queue {
+		if candidate != waiter {
+			continue
+		}
+		queue = append(queue[:i:i], queue[i+1:]...)
+		e.queued--
+		break
+	}
+	if len(queue) > 0 {
+		e.waiting[tenant] = queue
+		return
+	}
+	delete(e.waiting, tenant)
+	for i, candidate := range e.turns {
+		if candidate == tenant {
+			e.turns = append(e.turns[:i:i], e.turns[i+1:]...)
+			break
+		}
+	}
+}
+
+func (e *Executor) publishLocked() {
+	metrics.SetExecutorLoad(e.running, e.queued)
+}
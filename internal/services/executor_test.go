@@ -0,0 +1,160 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/miradorstack/mirador-rca/internal/cache"
+	"github.com/miradorstack/mirador-rca/internal/engine"
+	rcav1 "github.com/miradorstack/mirador-rca/internal/grpc/generated"
+	"github.com/miradorstack/mirador-rca/internal/repo"
+)
+
+func TestExecutorQueuesFairlyAndRejectsBeyondLimits(t *testing.T) {
+	executor := NewExecutor(ExecutorConfig{MaxConcurrent: 1, QueueDepth: 3, TenantQueueDepth: 2})
+	ctx := context.Background()
+
+	release, err := executor.Acquire(ctx, "busy")
+	if err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+
+	// Two queued calls from "busy" arrive before one from "quiet"; the turns must alternate between tenants.
+	order := make(chan string, 3)
+	queue := func(tenant string) {
+		_, queued := executor.Stats()
+		go func() {
+			done, err := executor.Acquire(ctx, tenant)
+			if err != nil {
+				order <- "error: " + err.Error()
+				return
+			}
+			order <- tenant
+			done()
+		}()
+		waitForQueued(t, executor, queued+1)
+	}
+	queue("busy")
+	queue("busy")
+	if _, err := executor.Acquire(ctx, "busy"); !errors.Is(err, ErrTenantQueueFull) {
+		t.Fatalf("expected ErrTenantQueueFull, got %v", err)
+	}
+	queue("quiet")
+	if _, err := executor.Acquire(ctx, "other"); !errors.Is(err, ErrQueueFull) {
+		t.Fatalf("expected ErrQueueFull, got %v", err)
+	}
+
+	release()
+	var got []string
+	for range 3 {
+		got = append(got, <-order)
+	}
+	if got[0] != "busy" || got[1] != "quiet" || got[2] != "busy" {
+		t.Fatalf("expected busy, quiet, busy; got %v", got)
+	}
+	if running, queued := executor.Stats(); running != 0 || queued != 0 {
+		t.Fatalf("expected an idle executor, got %d running and %d queued", running, queued)
+	}
+}
+
+func TestExecutorCancelledWaiterLeavesQueue(t *testing.T) {
+	executor := NewExecutor(ExecutorConfig{MaxConcurrent: 1, QueueDepth: 1})
+	release, err := executor.Acquire(context.Background(), "t1")
+	if err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := executor.Acquire(ctx, "t1"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the deadline error, got %v", err)
+	}
+	if _, queued := executor.Stats(); queued != 0 {
+		t.Fatalf("expected the cancelled waiter to leave the queue, %d still queued", queued)
+	}
+
+	release()
+	release()
+	next, err := executor.Acquire(context.Background(), "t2")
+	if err != nil {
+		t.Fatalf("expected a free slot after release, got %v", err)
+	}
+	next()
+	if running, _ := executor.Stats(); running != 0 {
+		t.Fatalf("expected repeated releases to free one slot, %d running", running)
+	}
+}
+
+// stuckCoreClient blocks service graph fetches until release is closed, ignoring cancellation like a slow
+// upstream call would.
+type stuckCoreClient struct {
+	countingCoreClient
+	entered chan struct{}
+	release chan struct{}
+}
+
+func (c *stuckCoreClient) FetchServiceGraph(ctx context.Context, tenantID string, start, end time.Time) ([]repo.ServiceGraphEdge, error) {
+	c.entered <- struct{}{}
+	<-c.release
+	return nil, nil
+}
+
+func TestCancelledInvestigationHoldsItsSlotUntilThePipelineStops(t *testing.T) {
+	core := &stuckCoreClient{entered: make(chan struct{}, 1), release: make(chan struct{})}
+	claims := engine.NewIncidentClaims(cache.NewMemoryProvider(), time.Minute, time.Minute, nil)
+	service := NewRCAService(nil, nil, engine.NewPipeline(nil, core, nil, nil, nil, nil, engine.WithIncidentClaims(claims)), nil,
+		WithExecutor(NewExecutor(ExecutorConfig{MaxConcurrent: 1})))
+
+	end := time.Now()
+	request := func(incident string) *rcav1.RCAInvestigationRequest {
+		return &rcav1.RCAInvestigationRequest{
+			IncidentId:       incident,
+			TenantId:         "t1",
+			AffectedServices: []string{"checkout"},
+			TimeRange:        &rcav1.TimeRange{Start: timestamppb.New(end.Add(-15 * time.Minute)), End: timestamppb.New(end)},
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		_, err := service.InvestigateIncident(ctx, request("inc-1"))
+		done <- err
+	}()
+	<-core.entered
+	cancel()
+
+	select {
+	case err := <-done:
+		t.Fatalf("expected the call to wait for its pipeline, it returned %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+	if _, err := service.InvestigateIncident(context.Background(), request("inc-2")); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected the running pipeline to keep the only slot, got %v", err)
+	}
+
+	close(core.release)
+	<-done
+	if running, _ := service.executor.Stats(); running != 0 {
+		t.Fatalf("expected the slot to be freed once the pipeline stopped, %d running", running)
+	}
+}
+
+// waitForQueued blocks until want investigations are queued.
+func waitForQueued(t *testing.T, executor *Executor, want int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if _, queued := executor.Stats(); queued == want {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d queued investigations", want)
+}
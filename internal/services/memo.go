@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/miradorstack/mirador-rca/internal/cache"
+	rcav1 "github.com/miradorstack/mirador-rca/internal/grpc/generated"
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// investigationKeyPrefix starts every memoized investigation key; the tenant follows so a purge can drop
+// one tenant's entries.
+const investigationKeyPrefix = "investigation:"
+
+// WithResultCache memoizes InvestigateIncident results in provider for ttl. Repeated requests for the same
+// incident, services, window (rounded to the minute), and threshold share one pipeline run while it is in
+// flight, across replicas when the provider is shared, and then get its result until ttl passes. A zero ttl
+// disables it. wait bounds how long other replicas wait for the first request's result before investigating
+// themselves and should cover the investigation budget.
+func WithResultCache(provider cache.Provider, ttl, wait time.Duration) ServiceOption {
+	return func(s *RCAService) {
+		s.results = cache.NewLoader(provider, wait)
+		s.resultTTL.Store(int64(ttl))
+	}
+}
+
+// SetResultTTL changes how long memoized investigation results stay fresh; a configuration reload calls it.
+func (s *RCAService) SetResultTTL(ttl time.Duration) {
+	s.resultTTL.Store(int64(ttl))
+}
+
+// investigateMemoized serves req from the result cache when a fresh result exists and runs the pipeline
+// otherwise. Partial results, missing a signal source, are returned but not cached.
+func (s *RCAService) investigateMemoized(ctx context.Context, req models.InvestigationRequest) (*rcav1.CorrelationResult, error) {
+	ttl := time.Duration(s.resultTTL.Load())
+	if s.results == nil || ttl <= 0 {
+		return s.investigate(ctx, req)
+	}
+
+	var fresh *rcav1.CorrelationResult
+	data, err := s.results.Load(ctx, investigationCacheKey(req), func(ctx context.Context) ([]byte, time.Duration, error) {
+		result, err := s.investigate(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		fresh = result
+		payload, err := proto.Marshal(result)
+		if len(result.GetUnavailableSources()) > 0 {
+			return payload, 0, err
+		}
+		return payload, ttl, err
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); !ok {
+			err = status.FromContextError(err).Err()
+		}
+		return nil, err
+	}
+	if fresh != nil {
+		return fresh, nil
+	}
+	var cached rcav1.CorrelationResult
+	if err := proto.Unmarshal(data, &cached); err != nil {
+		return s.investigate(ctx, req)
+	}
+	s.logger.Debug("serving memoized investigation", slog.String("incident_id", req.IncidentID),
+		slog.String("tenant_id", req.TenantID), slog.String("correlation_id", cached.GetCorrelationId()))
+	return &cached, nil
+}
+
+// invalidateResults drops the memoized investigations of tenant.
+func (s *RCAService) invalidateResults(ctx context.Context, tenant string) {
+	if s.results == nil {
+		return
+	}
+	if err := s.results.InvalidatePrefix(ctx, investigationKeyPrefix+tenant+":"); err != nil {
+		s.logger.Warn("failed to drop memoized investigations", slog.String("tenant_id", tenant), slog.Any("error", err))
+	}
+}
+
+// investigationCacheKey identifies requests that would produce the same result: the tenant, the set of
+// affected services, the window rounded to the minute, the anomaly threshold, the preset, and the incident
+// itself. The incident ID, labels, and metadata are stamped on the stored correlation and symptoms steer the
+// similar-incident lookups, so requests differing in any of them never share a result.
+func investigationCacheKey(req models.InvestigationRequest) string {
+	services := make([]string, 0, len(req.AffectedServices))
+	for _, service := range req.AffectedServices {
+		if service = strings.TrimSpace(service); service != "" {
+			services = append(services, service)
+		}
+	}
+	slices.Sort(services)
+	services = slices.Compact(services)
+
+	parts := []string{
+		strings.Join(services, ","),
+		strconv.FormatInt(req.TimeRange.Start.Truncate(time.Minute).Unix(), 10),
+		strconv.FormatInt(req.TimeRange.End.Truncate(time.Minute).Unix(), 10),
+		strconv.FormatFloat(req.AnomalyThreshold, 'g', -1, 64),
+	}
+	parts = append(parts, incidentKeyParts(req)...)
+	// Only preset and environment-scoped requests get extra parts, so keys of requests without them stay as they
+	// were.
+	if req.Preset != "" {
+		parts = append(parts, req.Preset)
+	}
+	if req.Environment != "" {
+		parts = append(parts, "environment="+req.Environment)
+	}
+	digest := sha256.Sum256([]byte(strings.Join(parts, "\n")))
+	return investigationKeyPrefix + req.TenantID + ":" + hex.EncodeToString(digest[:16])
+}
+
+// incidentKeyParts lists the incident-specific fields of req in a stable order: symptoms as given, since the
+// lookups query them in order, and labels sorted by key.
+func incidentKeyParts(req models.InvestigationRequest) []string {
+	labels := make([]string, 0, len(req.Labels))
+	for key, value := range req.Labels {
+		labels = append(labels, key+"="+value)
+	}
+	slices.Sort(labels)
+
+	return []string{
+		"incident=" + req.IncidentID,
+		"symptoms=" + strings.Join(req.Symptoms, "\x1f"),
+		"labels=" + strings.Join(labels, "\x1f"),
+		"title=" + req.Incident.Title,
+		"description=" + req.Incident.Description,
+		"fingerprints=" + strings.Join(req.Incident.AlertFingerprints, "\x1f"),
+		"ticket=" + req.Incident.TicketURL,
+	}
+}
@@ -0,0 +1,225 @@
+package services
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/miradorstack/mirador-rca/internal/cache"
+	"github.com/miradorstack/mirador-rca/internal/engine"
+	rcav1 "github.com/miradorstack/mirador-rca/internal/grpc/generated"
+	"github.com/miradorstack/mirador-rca/internal/models"
+	"github.com/miradorstack/mirador-rca/internal/repo"
+)
+
+type countingCoreClient struct {
+	graphs atomic.Int32
+}
+
+func (c *countingCoreClient) FetchMetricSeries(ctx context.Context, tenantID, service string, start, end time.Time) ([]repo.MetricPoint, error) {
+	return nil, nil
+}
+
+func (c *countingCoreClient) FetchLogEntries(ctx context.Context, tenantID, service string, start, end time.Time) ([]repo.LogEntry, error) {
+	return nil, nil
+}
+
+func (c *countingCoreClient) FetchTraceSpans(ctx context.Context, tenantID, service string, start, end time.Time) ([]repo.TraceSpan, error) {
+	return nil, nil
+}
+
+func (c *countingCoreClient) FetchServiceGraph(ctx context.Context, tenantID string, start, end time.Time) ([]repo.ServiceGraphEdge, error) {
+	c.graphs.Add(1)
+	return nil, nil
+}
+
+func TestInvestigateIncidentMemoizesIdenticalRequests(t *testing.T) {
+	core := &countingCoreClient{}
+	service := NewRCAService(nil, nil, engine.NewPipeline(nil, core, nil, nil, nil, nil), nil,
+		WithResultCache(cache.NewMemoryProvider(), time.Minute, time.Minute))
+
+	end := time.Date(2026, 3, 1, 12, 0, 30, 0, time.UTC)
+	request := func(incident string, services []string, threshold float64) *rcav1.RCAInvestigationRequest {
+		return &rcav1.RCAInvestigationRequest{
+			IncidentId:       incident,
+			TenantId:         "t1",
+			AffectedServices: services,
+			AnomalyThreshold: threshold,
+			TimeRange:        &rcav1.TimeRange{Start: timestamppb.New(end.Add(-15 * time.Minute)), End: timestamppb.New(end)},
+		}
+	}
+
+	first, err := service.InvestigateIncident(context.Background(), request("inc-1", []string{"checkout", "payments"}, 0))
+	if err != nil {
+		t.Fatalf("investigate: %v", err)
+	}
+	second, err := service.InvestigateIncident(context.Background(), request("inc-1", []string{"payments", "checkout"}, 0))
+	if err != nil {
+		t.Fatalf("investigate: %v", err)
+	}
+	if runs := core.graphs.Load(); runs != 1 {
+		t.Fatalf("expected one pipeline run for identical requests, got %d", runs)
+	}
+	if second.GetCorrelationId() != first.GetCorrelationId() {
+		t.Fatalf("expected the memoized correlation %q, got %q", first.GetCorrelationId(), second.GetCorrelationId())
+	}
+
+	if _, err := service.InvestigateIncident(context.Background(), request("inc-3", []string{"checkout", "payments"}, 3)); err != nil {
+		t.Fatalf("investigate: %v", err)
+	}
+	if runs := core.graphs.Load(); runs != 2 {
+		t.Fatalf("expected a different threshold to run the pipeline, got %d runs", runs)
+	}
+
+	service.SetResultTTL(0)
+	if _, err := service.InvestigateIncident(context.Background(), request("inc-4", []string{"checkout", "payments"}, 0)); err != nil {
+		t.Fatalf("investigate: %v", err)
+	}
+	if runs := core.graphs.Load(); runs != 3 {
+		t.Fatalf("expected a zero TTL to disable memoization, got %d runs", runs)
+	}
+
+	unknown := request("inc-5", []string{"checkout"}, 0)
+	unknown.Preset = "thorough"
+	if _, err := service.InvestigateIncident(context.Background(), unknown); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected an unknown preset to be rejected, got %v", err)
+	}
+	if runs := core.graphs.Load(); runs != 3 {
+		t.Fatalf("expected an unknown preset not to run the pipeline, got %d runs", runs)
+	}
+}
+
+func TestInvestigateIncidentMemoizesPerIncident(t *testing.T) {
+	core := &countingCoreClient{}
+	service := NewRCAService(nil, nil, engine.NewPipeline(nil, core, nil, nil, nil, nil), nil,
+		WithResultCache(cache.NewMemoryProvider(), time.Minute, time.Minute))
+
+	end := time.Date(2026, 3, 1, 12, 0, 30, 0, time.UTC)
+	request := func(incident, team string, symptoms ...string) *rcav1.RCAInvestigationRequest {
+		return &rcav1.RCAInvestigationRequest{
+			IncidentId:       incident,
+			TenantId:         "t1",
+			Symptoms:         symptoms,
+			AffectedServices: []string{"checkout"},
+			Labels:           map[string]string{"team": team},
+			TimeRange:        &rcav1.TimeRange{Start: timestamppb.New(end.Add(-15 * time.Minute)), End: timestamppb.New(end)},
+		}
+	}
+
+	first, err := service.InvestigateIncident(context.Background(), request("INC-C", "c", "latency"))
+	if err != nil {
+		t.Fatalf("investigate: %v", err)
+	}
+	second, err := service.InvestigateIncident(context.Background(), request("INC-D", "d", "latency"))
+	if err != nil {
+		t.Fatalf("investigate: %v", err)
+	}
+	if runs := core.graphs.Load(); runs != 2 {
+		t.Fatalf("expected separate incidents to run the pipeline separately, got %d runs", runs)
+	}
+	if first.GetIncidentId() != "INC-C" || first.GetLabels()["team"] != "c" {
+		t.Fatalf("unexpected first result: incident %q labels %v", first.GetIncidentId(), first.GetLabels())
+	}
+	if second.GetIncidentId() != "INC-D" || second.GetLabels()["team"] != "d" {
+		t.Fatalf("expected INC-D with its own labels, got incident %q labels %v", second.GetIncidentId(), second.GetLabels())
+	}
+
+	if _, err := service.InvestigateIncident(context.Background(), request("INC-D", "d", "errors")); err != nil {
+		t.Fatalf("investigate: %v", err)
+	}
+	if runs := core.graphs.Load(); runs != 3 {
+		t.Fatalf("expected different symptoms to run the pipeline, got %d runs", runs)
+	}
+	repeat, err := service.InvestigateIncident(context.Background(), request("INC-D", "d", "latency"))
+	if err != nil {
+		t.Fatalf("investigate: %v", err)
+	}
+	if runs := core.graphs.Load(); runs != 3 || repeat.GetCorrelationId() != second.GetCorrelationId() {
+		t.Fatalf("expected a repeated request to be memoized, got %d runs and correlation %q", runs, repeat.GetCorrelationId())
+	}
+}
+
+func TestInvestigationCacheKeyRoundsWindow(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	base := models.InvestigationRequest{
+		TenantID:         "t1",
+		AffectedServices: []string{"checkout"},
+		TimeRange:        models.TimeRange{Start: start, End: start.Add(15 * time.Minute)},
+	}
+	shifted := base
+	shifted.TimeRange = models.TimeRange{Start: start.Add(20 * time.Second), End: start.Add(15*time.Minute + 40*time.Second)}
+	if investigationCacheKey(base) != investigationCacheKey(shifted) {
+		t.Fatalf("expected windows within the same minute to share a key")
+	}
+	shifted.TimeRange.End = start.Add(16 * time.Minute)
+	if investigationCacheKey(base) == investigationCacheKey(shifted) {
+		t.Fatalf("expected a different minute to change the key")
+	}
+	other := base
+	other.TenantID = "t2"
+	if investigationCacheKey(base) == investigationCacheKey(other) {
+		t.Fatalf("expected tenants to have separate keys")
+	}
+	deep := base
+	deep.Preset = "deep"
+	if investigationCacheKey(base) == investigationCacheKey(deep) {
+		t.Fatalf("expected presets to have separate keys")
+	}
+	staging := base
+	staging.Environment = "staging"
+	if investigationCacheKey(base) == investigationCacheKey(staging) {
+		t.Fatalf("expected environments to have separate keys")
+	}
+	for name, mutate := range map[string]func(*models.InvestigationRequest){
+		"incident": func(r *models.InvestigationRequest) { r.IncidentID = "inc-2" },
+		"symptoms": func(r *models.InvestigationRequest) { r.Symptoms = []string{"timeouts"} },
+		"labels":   func(r *models.InvestigationRequest) { r.Labels = map[string]string{"team": "d"} },
+		"metadata": func(r *models.InvestigationRequest) { r.Incident.TicketURL = "https://tickets/2" },
+	} {
+		changed := base
+		mutate(&changed)
+		if investigationCacheKey(base) == investigationCacheKey(changed) {
+			t.Fatalf("expected %s to change the key", name)
+		}
+	}
+}
+
+func TestCancelledMemoizedInvestigationHoldsItsSlot(t *testing.T) {
+	core := &stuckCoreClient{entered: make(chan struct{}, 1), release: make(chan struct{})}
+	service := NewRCAService(nil, nil, engine.NewPipeline(nil, core, nil, nil, nil, nil), nil,
+		WithResultCache(cache.NewMemoryProvider(), time.Minute, time.Minute),
+		WithExecutor(NewExecutor(ExecutorConfig{MaxConcurrent: 1})))
+
+	end := time.Now()
+	request := func(incident string) *rcav1.RCAInvestigationRequest {
+		return &rcav1.RCAInvestigationRequest{
+			IncidentId:       incident,
+			TenantId:         "t1",
+			AffectedServices: []string{"checkout"},
+			TimeRange:        &rcav1.TimeRange{Start: timestamppb.New(end.Add(-15 * time.Minute)), End: timestamppb.New(end)},
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = service.InvestigateIncident(ctx, request("inc-1"))
+	}()
+	<-core.entered
+	cancel()
+	time.Sleep(50 * time.Millisecond)
+	if _, err := service.InvestigateIncident(context.Background(), request("inc-2")); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected the memoized pipeline to keep the only slot after its caller cancelled, got %v", err)
+	}
+	close(core.release)
+	<-done
+	if running, _ := service.executor.Stats(); running != 0 {
+		t.Fatalf("expected the slot to be freed once the pipeline stopped, %d running", running)
+	}
+}
@@ -2,27 +2,53 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strconv"
+	"sync/atomic"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/miradorstack/mirador-rca/internal/api"
+	"github.com/miradorstack/mirador-rca/internal/audit"
+	"github.com/miradorstack/mirador-rca/internal/cache"
 	"github.com/miradorstack/mirador-rca/internal/engine"
 	rcav1 "github.com/miradorstack/mirador-rca/internal/grpc/generated"
 	"github.com/miradorstack/mirador-rca/internal/metrics"
 	"github.com/miradorstack/mirador-rca/internal/models"
 	"github.com/miradorstack/mirador-rca/internal/repo"
+	"github.com/miradorstack/mirador-rca/internal/slo"
 	"github.com/miradorstack/mirador-rca/internal/utils"
+	"github.com/miradorstack/mirador-rca/internal/version"
 )
 
 // CorrelationPatternRepo defines storage operations required for correlation history and patterns.
 type CorrelationPatternRepo interface {
 	ListCorrelations(ctx context.Context, req models.ListCorrelationsRequest) (models.ListCorrelationsResponse, error)
+	SearchCorrelations(ctx context.Context, req models.SearchCorrelationsRequest) (models.SearchCorrelationsResponse, error)
 	FetchPatterns(ctx context.Context, tenantID, service string) ([]models.FailurePattern, error)
 	StoreFeedback(ctx context.Context, feedback models.Feedback) error
+	FeedbackStats(ctx context.Context, req models.FeedbackStatsRequest) (models.FeedbackStats, error)
+	UpdateCorrelation(ctx context.Context, req models.UpdateCorrelationRequest) (models.CorrelationResult, error)
+	GetCorrelation(ctx context.Context, tenantID, correlationID string) (models.CorrelationResult, error)
+}
+
+// DataPurger erases tenant history for retention and GDPR-style requests.
+type DataPurger interface {
+	PurgeTenantData(ctx context.Context, req models.PurgeRequest) (models.PurgeResult, error)
+}
+
+// PatternMiner mines and stores failure patterns from a tenant's correlations in a time window.
+type PatternMiner interface {
+	MineWindow(ctx context.Context, tenantID string, start, end time.Time) ([]models.FailurePattern, int, error)
+}
+
+// ThresholdTuner recommends per-service anomaly thresholds from a tenant's history.
+type ThresholdTuner interface {
+	Recommendations(ctx context.Context, tenantID string, refresh bool) ([]models.ThresholdRecommendation, error)
 }
 
 // RCAService implements the gRPC RCAEngine service.
@@ -34,20 +60,97 @@ type RCAService struct {
 	pipeline    *engine.Pipeline
 	historyRepo CorrelationPatternRepo
 	latencies   *utils.LatencyTracker
+	maintenance *engine.MaintenanceCalendar
+	purger      DataPurger
+	miner       PatternMiner
+	tuner       ThresholdTuner
+	mineJobs    atomic.Uint64
+	rules       *engine.RuleEngine
+	auditor     *audit.Logger
+	slo         *slo.LatencyTracker
+	executor    *Executor
+	results     *cache.Loader
+	resultTTL   atomic.Int64
+}
+
+// ServiceOption customises optional RCAService dependencies.
+type ServiceOption func(*RCAService)
+
+// WithMaintenanceCalendar enables the maintenance-window management RPCs.
+func WithMaintenanceCalendar(calendar *engine.MaintenanceCalendar) ServiceOption {
+	return func(s *RCAService) {
+		s.maintenance = calendar
+	}
+}
+
+// WithDataPurger enables the PurgeTenantData admin RPC.
+func WithDataPurger(purger DataPurger) ServiceOption {
+	return func(s *RCAService) {
+		s.purger = purger
+	}
+}
+
+// WithPatternMiner enables the MinePatterns admin RPC.
+func WithPatternMiner(miner PatternMiner) ServiceOption {
+	return func(s *RCAService) {
+		s.miner = miner
+	}
+}
+
+// WithThresholdTuner enables the GetThresholdRecommendations RPC.
+func WithThresholdTuner(tuner ThresholdTuner) ServiceOption {
+	return func(s *RCAService) {
+		s.tuner = tuner
+	}
+}
+
+// WithRuleEngine lets the TestRules admin RPC evaluate the loaded rule pack; inline packs work without it.
+func WithRuleEngine(rules *engine.RuleEngine) ServiceOption {
+	return func(s *RCAService) {
+		s.rules = rules
+	}
+}
+
+// WithSLO counts every InvestigateIncident call, failed ones included, against the investigation latency
+// objective.
+func WithSLO(tracker *slo.LatencyTracker) ServiceOption {
+	return func(s *RCAService) {
+		s.slo = tracker
+	}
+}
+
+// WithAuditor records feedback submissions and admin RPCs in the audit log; investigations are audited by
+// the pipeline.
+func WithAuditor(auditor *audit.Logger) ServiceOption {
+	return func(s *RCAService) {
+		s.auditor = auditor
+	}
+}
+
+// WithExecutor bounds concurrent InvestigateIncident pipelines; calls beyond its queue fail with
+// ResourceExhausted. Without it every call runs immediately.
+func WithExecutor(executor *Executor) ServiceOption {
+	return func(s *RCAService) {
+		s.executor = executor
+	}
 }
 
 // NewRCAService constructs the RCA service facade.
-func NewRCAService(logger *slog.Logger, coreClient *repo.MiradorCoreClient, pipeline *engine.Pipeline, historyRepo CorrelationPatternRepo) *RCAService {
+func NewRCAService(logger *slog.Logger, coreClient *repo.MiradorCoreClient, pipeline *engine.Pipeline, historyRepo CorrelationPatternRepo, opts ...ServiceOption) *RCAService {
 	if logger == nil {
 		logger = slog.Default()
 	}
-	return &RCAService{
+	service := &RCAService{
 		logger:      logger,
 		coreClient:  coreClient,
 		pipeline:    pipeline,
 		historyRepo: historyRepo,
-		latencies:   utils.NewLatencyTracker(1024),
+		latencies:   utils.NewLatencyTracker(utils.DefaultLatencyWindow),
+	}
+	for _, opt := range opts {
+		opt(service)
 	}
+	return service
 }
 
 // InvestigateIncident orchestrates anomaly extraction and ranking (to be implemented).
@@ -65,25 +168,50 @@ func (s *RCAService) InvestigateIncident(ctx context.Context, req *rcav1.RCAInve
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	if !s.pipeline.HasPreset(domainReq.Preset) {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown preset %q", domainReq.Preset))
+	}
+
+	return s.investigateMemoized(ctx, domainReq)
+}
+
+// investigate runs the pipeline for one request once the executor admits it.
+func (s *RCAService) investigate(ctx context.Context, domainReq models.InvestigationRequest) (*rcav1.CorrelationResult, error) {
+	if s.executor != nil {
+		release, err := s.executor.Acquire(ctx, domainReq.TenantID)
+		if err != nil {
+			return nil, executorError(err)
+		}
+		defer release()
+	}
 
 	start := time.Now()
 	result, err := s.pipeline.Investigate(ctx, domainReq)
 	duration := time.Since(start)
+	s.slo.Observe(duration)
 	if err != nil {
-		metrics.ObserveInvestigation(duration, metrics.OutcomeError)
+		metrics.ObserveInvestigation(ctx, domainReq.TenantID, "", duration, metrics.OutcomeError)
 		s.logger.Error("pipeline investigation failed", slog.Any("error", err))
 		return nil, status.Error(codes.Internal, fmt.Sprintf("investigation failed: %v", err))
 	}
 	s.latencies.Observe(duration)
-	metrics.ObserveInvestigation(duration, metrics.OutcomeSuccess)
-	if count := s.latencies.Count(); count >= 20 && count%20 == 0 {
-		p95 := s.latencies.Percentile(95)
-		s.logger.Info("investigation latency", slog.Duration("p95", p95), slog.Int("samples", count))
+	metrics.ObserveInvestigation(ctx, domainReq.TenantID, string(result.Category), duration, metrics.OutcomeSuccess)
+	if summary := s.latencies.Summary(); summary.Count >= 20 && summary.Count%20 == 0 {
+		s.logger.Info("investigation latency", slog.Float64("p50_ms", summary.P50Ms), slog.Float64("p95_ms", summary.P95Ms),
+			slog.Float64("p99_ms", summary.P99Ms), slog.Int("samples", summary.Count))
 	}
 
 	return api.ToProtoCorrelationResult(result), nil
 }
 
+// executorError maps an admission failure to its gRPC status.
+func executorError(err error) error {
+	if errors.Is(err, ErrQueueFull) || errors.Is(err, ErrTenantQueueFull) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return status.FromContextError(err).Err()
+}
+
 // ListCorrelations returns historical correlations (placeholder).
 func (s *RCAService) ListCorrelations(ctx context.Context, req *rcav1.ListCorrelationsRequest) (*rcav1.ListCorrelationsResponse, error) {
 	if req == nil {
@@ -107,6 +235,76 @@ func (s *RCAService) ListCorrelations(ctx context.Context, req *rcav1.ListCorrel
 	return api.ToProtoListCorrelationsResponse(resp), nil
 }
 
+// GetCorrelation returns one stored correlation of a tenant.
+func (s *RCAService) GetCorrelation(ctx context.Context, req *rcav1.GetCorrelationRequest) (*rcav1.CorrelationResult, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if req.GetTenantId() == "" || req.GetCorrelationId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant_id and correlation_id are required")
+	}
+	if s.historyRepo == nil {
+		return nil, status.Error(codes.FailedPrecondition, "history repository not configured")
+	}
+
+	correlation, err := s.historyRepo.GetCorrelation(ctx, req.GetTenantId(), req.GetCorrelationId())
+	if errors.Is(err, repo.ErrCorrelationNotFound) {
+		return nil, status.Error(codes.NotFound, "correlation not found")
+	}
+	if err != nil {
+		s.logger.Error("get correlation failed", slog.String("tenant_id", req.GetTenantId()), slog.String("correlation_id", req.GetCorrelationId()), slog.Any("error", err))
+		return nil, status.Error(codes.Internal, "failed to get correlation")
+	}
+	return api.ToProtoCorrelationResult(correlation), nil
+}
+
+// ExplainCorrelation describes how a stored correlation was reached: the detectors that fired and their
+// thresholds, the causality reasoning, and the rules or past incidents behind its recommendations.
+func (s *RCAService) ExplainCorrelation(ctx context.Context, req *rcav1.ExplainCorrelationRequest) (*rcav1.CorrelationExplanation, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if req.GetTenantId() == "" || req.GetCorrelationId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant_id and correlation_id are required")
+	}
+	if s.historyRepo == nil {
+		return nil, status.Error(codes.FailedPrecondition, "history repository not configured")
+	}
+
+	correlation, err := s.historyRepo.GetCorrelation(ctx, req.GetTenantId(), req.GetCorrelationId())
+	if errors.Is(err, repo.ErrCorrelationNotFound) {
+		return nil, status.Error(codes.NotFound, "correlation not found")
+	}
+	if err != nil {
+		s.logger.Error("explain correlation failed", slog.String("tenant_id", req.GetTenantId()), slog.String("correlation_id", req.GetCorrelationId()), slog.Any("error", err))
+		return nil, status.Error(codes.Internal, "failed to get correlation")
+	}
+	return api.ToProtoCorrelationExplanation(correlation), nil
+}
+
+// SearchCorrelations finds past incidents by free text combined with vector similarity.
+func (s *RCAService) SearchCorrelations(ctx context.Context, req *rcav1.SearchCorrelationsRequest) (*rcav1.SearchCorrelationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if s.historyRepo == nil {
+		return nil, status.Error(codes.FailedPrecondition, "history repository not configured")
+	}
+
+	domainReq, err := api.FromProtoSearchCorrelationsRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := s.historyRepo.SearchCorrelations(ctx, domainReq)
+	if err != nil {
+		s.logger.Error("search correlations failed", slog.Any("error", err))
+		return nil, status.Error(codes.Internal, "failed to search correlations")
+	}
+
+	return api.ToProtoSearchCorrelationsResponse(resp), nil
+}
+
 // GetPatterns returns known failure patterns (placeholder).
 func (s *RCAService) GetPatterns(ctx context.Context, req *rcav1.GetPatternsRequest) (*rcav1.GetPatternsResponse, error) {
 	if req == nil {
@@ -139,7 +337,16 @@ func (s *RCAService) SubmitFeedback(ctx context.Context, req *rcav1.FeedbackRequ
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if err := s.historyRepo.StoreFeedback(ctx, feedback); err != nil {
+	start := time.Now()
+	err = s.historyRepo.StoreFeedback(ctx, feedback)
+	s.audit(ctx, audit.Record{
+		Action:      audit.ActionSubmitFeedback,
+		TenantID:    feedback.TenantID,
+		RequestHash: audit.Hash(req),
+		ResultID:    feedback.CorrelationID,
+		Details:     map[string]string{"correct": strconv.FormatBool(feedback.Correct)},
+	}, start, err)
+	if err != nil {
 		s.logger.Error("store feedback failed", slog.Any("error", err))
 		return nil, status.Error(codes.Internal, "failed to persist feedback")
 	}
@@ -147,12 +354,428 @@ func (s *RCAService) SubmitFeedback(ctx context.Context, req *rcav1.FeedbackRequ
 	return &rcav1.FeedbackAck{CorrelationId: feedback.CorrelationID, Accepted: true}, nil
 }
 
+// UpdateCorrelation sets a correlation's lifecycle status and appends responder annotations.
+func (s *RCAService) UpdateCorrelation(ctx context.Context, req *rcav1.UpdateCorrelationRequest) (*rcav1.CorrelationResult, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if s.historyRepo == nil {
+		return nil, status.Error(codes.FailedPrecondition, "history repository not configured")
+	}
+
+	domainReq, err := api.FromProtoUpdateCorrelationRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	start := time.Now()
+	updated, err := s.historyRepo.UpdateCorrelation(ctx, domainReq)
+	s.audit(ctx, audit.Record{
+		Action:      audit.ActionUpdateCorrelation,
+		TenantID:    domainReq.TenantID,
+		RequestHash: audit.Hash(req),
+		ResultID:    domainReq.CorrelationID,
+		Details:     map[string]string{"status": string(domainReq.Status), "annotations": strconv.Itoa(len(domainReq.Annotations))},
+	}, start, err)
+	if errors.Is(err, repo.ErrCorrelationNotFound) {
+		return nil, status.Error(codes.NotFound, "correlation not found")
+	}
+	if err != nil {
+		s.logger.Error("update correlation failed", slog.String("tenant_id", domainReq.TenantID), slog.String("correlation_id", domainReq.CorrelationID), slog.Any("error", err))
+		return nil, status.Error(codes.Internal, "failed to update correlation")
+	}
+
+	return api.ToProtoCorrelationResult(updated), nil
+}
+
+// GetFeedbackStats reports RCA accuracy derived from analyst feedback.
+func (s *RCAService) GetFeedbackStats(ctx context.Context, req *rcav1.GetFeedbackStatsRequest) (*rcav1.GetFeedbackStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if s.historyRepo == nil {
+		return nil, status.Error(codes.FailedPrecondition, "feedback repository not configured")
+	}
+	if req.GetTenantId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant_id is required")
+	}
+
+	domainReq, err := api.FromProtoFeedbackStatsRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	stats, err := s.historyRepo.FeedbackStats(ctx, domainReq)
+	if err != nil {
+		s.logger.Error("feedback stats failed", slog.String("tenant_id", domainReq.TenantID), slog.Any("error", err))
+		return nil, status.Error(codes.Internal, "failed to compute feedback stats")
+	}
+
+	return api.ToProtoFeedbackStatsResponse(stats), nil
+}
+
+// GetThresholdRecommendations returns the anomaly thresholds tuned for each of a tenant's services and
+// whether its investigations use them.
+func (s *RCAService) GetThresholdRecommendations(ctx context.Context, req *rcav1.GetThresholdRecommendationsRequest) (*rcav1.GetThresholdRecommendationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if s.tuner == nil {
+		return nil, status.Error(codes.FailedPrecondition, "threshold tuning not configured")
+	}
+	if req.GetTenantId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant_id is required")
+	}
+
+	recs, err := s.tuner.Recommendations(ctx, req.GetTenantId(), req.GetRefresh())
+	if err != nil {
+		s.logger.Error("threshold tuning failed", slog.String("tenant_id", req.GetTenantId()), slog.Any("error", err))
+		return nil, status.Error(codes.Internal, "failed to tune thresholds")
+	}
+	return api.ToProtoThresholdRecommendations(recs, s.pipeline.TunesThresholds(req.GetTenantId())), nil
+}
+
+// defaultGraphWindow is the window GetServiceGraph covers when neither the request nor the overlaid
+// correlation sets one.
+const defaultGraphWindow = 15 * time.Minute
+
+// latestCorrelationScan bounds how many recent correlations GetServiceGraph scans for the latest one in the
+// requested environment.
+const latestCorrelationScan = 50
+
+// GetServiceGraph returns a tenant's service dependency graph with a correlation's anomalies and blast radius
+// overlaid, the latest correlation by default, so the UI can draw the dependency map beside an RCA result.
+func (s *RCAService) GetServiceGraph(ctx context.Context, req *rcav1.GetServiceGraphRequest) (*rcav1.GetServiceGraphResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if req.GetTenantId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant_id is required")
+	}
+	if s.pipeline == nil {
+		return nil, status.Error(codes.FailedPrecondition, "pipeline not configured")
+	}
+	var window models.TimeRange
+	if tr := req.GetTimeRange(); tr != nil {
+		if tr.GetStart() == nil || tr.GetEnd() == nil {
+			return nil, status.Error(codes.InvalidArgument, "time_range.start and time_range.end are required")
+		}
+		window = models.TimeRange{Start: tr.GetStart().AsTime(), End: tr.GetEnd().AsTime()}
+		if !window.End.After(window.Start) {
+			return nil, status.Error(codes.InvalidArgument, "time_range.end must be after time_range.start")
+		}
+	}
+
+	correlation, err := s.graphCorrelation(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if window.End.IsZero() {
+		window.End = time.Now()
+		if correlation != nil && correlation.WindowExpansion != nil {
+			window = correlation.WindowExpansion.Analysed
+		} else if correlation != nil && !correlation.CreatedAt.IsZero() {
+			window.End = correlation.CreatedAt
+		}
+		if window.Start.IsZero() {
+			window.Start = window.End.Add(-defaultGraphWindow)
+		}
+	}
+
+	// A correlation's anchors belong to the environment it was investigated in, so its overlay is drawn on
+	// that environment's graph unless the caller asks for another.
+	environment := req.GetEnvironment()
+	if environment == "" && correlation != nil {
+		environment = correlation.Environment
+	}
+	edges, err := s.pipeline.ServiceGraph(ctx, req.GetTenantId(), environment, window)
+	if err != nil {
+		s.logger.Error("service graph fetch failed", slog.String("tenant_id", req.GetTenantId()), slog.Any("error", err))
+		return nil, status.Error(codes.Unavailable, "failed to fetch service graph")
+	}
+	return api.ToProtoServiceGraph(engine.OverlayServiceGraph(edges, correlation, window)), nil
+}
+
+// graphCorrelation loads the correlation GetServiceGraph overlays: the requested one, or else the tenant's
+// latest in the requested environment. It returns nil when there is none to overlay.
+func (s *RCAService) graphCorrelation(ctx context.Context, req *rcav1.GetServiceGraphRequest) (*models.CorrelationResult, error) {
+	if req.GetCorrelationId() != "" {
+		if s.historyRepo == nil {
+			return nil, status.Error(codes.FailedPrecondition, "history repository not configured")
+		}
+		correlation, err := s.historyRepo.GetCorrelation(ctx, req.GetTenantId(), req.GetCorrelationId())
+		if errors.Is(err, repo.ErrCorrelationNotFound) {
+			return nil, status.Error(codes.NotFound, "correlation not found")
+		}
+		if err != nil {
+			s.logger.Error("get correlation failed", slog.String("tenant_id", req.GetTenantId()), slog.String("correlation_id", req.GetCorrelationId()), slog.Any("error", err))
+			return nil, status.Error(codes.Internal, "failed to get correlation")
+		}
+		return &correlation, nil
+	}
+	if s.historyRepo == nil {
+		return nil, nil
+	}
+
+	resp, err := s.historyRepo.ListCorrelations(ctx, models.ListCorrelationsRequest{TenantID: req.GetTenantId(), PageSize: latestCorrelationScan})
+	if err != nil {
+		// The graph is still useful without an overlay.
+		s.logger.Warn("list correlations for service graph overlay failed", slog.String("tenant_id", req.GetTenantId()), slog.Any("error", err))
+		return nil, nil
+	}
+	for _, correlation := range resp.Correlations {
+		if correlation.Environment == req.GetEnvironment() {
+			return &correlation, nil
+		}
+	}
+	return nil, nil
+}
+
+// MinePatterns mines failure patterns for a tenant on demand. Async requests return a job id at once and
+// report the outcome in the service log.
+func (s *RCAService) MinePatterns(ctx context.Context, req *rcav1.MinePatternsRequest) (*rcav1.MinePatternsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if s.miner == nil {
+		return nil, status.Error(codes.FailedPrecondition, "pattern miner not configured")
+	}
+	if req.GetTenantId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant_id is required")
+	}
+
+	tenantID := req.GetTenantId()
+	var start, end time.Time
+	if tr := req.GetTimeRange(); tr != nil {
+		if tr.Start != nil {
+			start = tr.Start.AsTime()
+		}
+		if tr.End != nil {
+			end = tr.End.AsTime()
+		}
+	}
+	if !start.IsZero() && !end.IsZero() && !end.After(start) {
+		return nil, status.Error(codes.InvalidArgument, "time_range end must be after start")
+	}
+
+	record := audit.Record{Action: audit.ActionMinePatterns, TenantID: tenantID, RequestHash: audit.Hash(req)}
+	began := time.Now()
+	if req.GetAsync() {
+		jobID := fmt.Sprintf("mine-%d", s.mineJobs.Add(1))
+		record.ResultID = jobID
+		record.Details = map[string]string{"async": "true"}
+		s.audit(ctx, record, began, nil)
+		go func() {
+			jobCtx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+			defer cancel()
+			mined, correlations, err := s.miner.MineWindow(jobCtx, tenantID, start, end)
+			if err != nil {
+				s.logger.Error("pattern mining job failed", slog.String("job_id", jobID), slog.String("tenant_id", tenantID), slog.Any("error", err))
+				return
+			}
+			s.logger.Info("pattern mining job finished",
+				slog.String("job_id", jobID),
+				slog.String("tenant_id", tenantID),
+				slog.Int("correlations", correlations),
+				slog.Int("patterns", len(mined)),
+			)
+		}()
+		return &rcav1.MinePatternsResponse{JobId: jobID}, nil
+	}
+
+	mined, correlations, err := s.miner.MineWindow(ctx, tenantID, start, end)
+	record.Details = map[string]string{"correlations": strconv.Itoa(correlations), "patterns": strconv.Itoa(len(mined))}
+	s.audit(ctx, record, began, err)
+	if err != nil {
+		s.logger.Error("pattern mining failed", slog.String("tenant_id", tenantID), slog.Any("error", err))
+		return nil, status.Error(codes.Internal, "failed to mine patterns")
+	}
+	resp := api.ToProtoPatternsResponse(mined)
+	return &rcav1.MinePatternsResponse{Patterns: resp.GetPatterns(), Correlations: int32(correlations)}, nil
+}
+
+// CreateMaintenanceWindow registers a planned maintenance window for a tenant.
+func (s *RCAService) CreateMaintenanceWindow(ctx context.Context, req *rcav1.CreateMaintenanceWindowRequest) (*rcav1.MaintenanceWindow, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if s.maintenance == nil {
+		return nil, status.Error(codes.FailedPrecondition, "maintenance calendar not configured")
+	}
+
+	window, err := api.FromProtoMaintenanceWindow(req.GetWindow())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	start := time.Now()
+	stored, err := s.maintenance.Add(ctx, window)
+	s.audit(ctx, audit.Record{Action: audit.ActionCreateMaintenanceWindow, TenantID: window.TenantID, RequestHash: audit.Hash(req), ResultID: stored.ID}, start, err)
+	if errors.Is(err, engine.ErrInvalidMaintenanceWindow) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		s.logger.Error("create maintenance window failed", slog.String("tenant_id", window.TenantID), slog.Any("error", err))
+		return nil, status.Error(codes.Internal, "failed to create maintenance window")
+	}
+	return api.ToProtoMaintenanceWindow(stored), nil
+}
+
+// ListMaintenanceWindows returns the tenant's maintenance windows.
+func (s *RCAService) ListMaintenanceWindows(ctx context.Context, req *rcav1.ListMaintenanceWindowsRequest) (*rcav1.ListMaintenanceWindowsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if s.maintenance == nil {
+		return nil, status.Error(codes.FailedPrecondition, "maintenance calendar not configured")
+	}
+
+	windows, err := s.maintenance.List(ctx, req.GetTenantId())
+	if err != nil {
+		s.logger.Error("list maintenance windows failed", slog.String("tenant_id", req.GetTenantId()), slog.Any("error", err))
+		return nil, status.Error(codes.Internal, "failed to list maintenance windows")
+	}
+	resp := &rcav1.ListMaintenanceWindowsResponse{}
+	for _, w := range windows {
+		resp.Windows = append(resp.Windows, api.ToProtoMaintenanceWindow(w))
+	}
+	return resp, nil
+}
+
+// DeleteMaintenanceWindow removes a tenant maintenance window.
+func (s *RCAService) DeleteMaintenanceWindow(ctx context.Context, req *rcav1.DeleteMaintenanceWindowRequest) (*rcav1.DeleteMaintenanceWindowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if s.maintenance == nil {
+		return nil, status.Error(codes.FailedPrecondition, "maintenance calendar not configured")
+	}
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	start := time.Now()
+	deleted, err := s.maintenance.Remove(ctx, req.GetTenantId(), req.GetId())
+	s.audit(ctx, audit.Record{
+		Action:      audit.ActionDeleteMaintenanceWindow,
+		TenantID:    req.GetTenantId(),
+		RequestHash: audit.Hash(req),
+		ResultID:    req.GetId(),
+		Details:     map[string]string{"deleted": strconv.FormatBool(deleted)},
+	}, start, err)
+	if err != nil {
+		s.logger.Error("delete maintenance window failed", slog.String("tenant_id", req.GetTenantId()), slog.Any("error", err))
+		return nil, status.Error(codes.Internal, "failed to delete maintenance window")
+	}
+	return &rcav1.DeleteMaintenanceWindowResponse{Deleted: deleted}, nil
+}
+
+// PurgeTenantData erases (or, with dry_run, counts) a tenant's stored history.
+func (s *RCAService) PurgeTenantData(ctx context.Context, req *rcav1.PurgeTenantDataRequest) (*rcav1.PurgeTenantDataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if s.purger == nil {
+		return nil, status.Error(codes.FailedPrecondition, "data purger not configured")
+	}
+	if req.GetTenantId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant_id is required")
+	}
+
+	purge := models.PurgeRequest{TenantID: req.GetTenantId(), DryRun: req.GetDryRun()}
+	if req.Before != nil {
+		purge.Before = req.Before.AsTime()
+	}
+	start := time.Now()
+	result, err := s.purger.PurgeTenantData(ctx, purge)
+	s.audit(ctx, audit.Record{
+		Action:      audit.ActionPurgeTenantData,
+		TenantID:    purge.TenantID,
+		RequestHash: audit.Hash(req),
+		Details: map[string]string{
+			"dry_run":            strconv.FormatBool(purge.DryRun),
+			"correlations":       strconv.Itoa(result.Correlations),
+			"feedback":           strconv.Itoa(result.Feedback),
+			"patterns":           strconv.Itoa(result.Patterns),
+			"topology_snapshots": strconv.Itoa(result.TopologySnapshots),
+		},
+	}, start, err)
+	if err != nil {
+		s.logger.Error("purge tenant data failed", slog.String("tenant_id", purge.TenantID), slog.Any("error", err))
+		return nil, status.Error(codes.Internal, "failed to purge tenant data")
+	}
+	if !result.DryRun {
+		s.invalidateResults(ctx, purge.TenantID)
+	}
+	s.logger.Info("tenant data purged",
+		slog.String("tenant_id", purge.TenantID),
+		slog.Int("correlations", result.Correlations),
+		slog.Int("feedback", result.Feedback),
+		slog.Int("patterns", result.Patterns),
+		slog.Int("topology_snapshots", result.TopologySnapshots),
+		slog.Bool("dry_run", result.DryRun),
+	)
+
+	return &rcav1.PurgeTenantDataResponse{
+		Correlations:      int32(result.Correlations),
+		Feedback:          int32(result.Feedback),
+		Patterns:          int32(result.Patterns),
+		TopologySnapshots: int32(result.TopologySnapshots),
+		DryRun:            result.DryRun,
+	}, nil
+}
+
+// TestRules evaluates a rule pack against a sample investigation and reports which rules matched and why.
+// The request's rules_yaml is validated and evaluated instead of the loaded pack when set.
+func (s *RCAService) TestRules(ctx context.Context, req *rcav1.TestRulesRequest) (*rcav1.TestRulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	rules := s.rules
+	if req.GetRulesYaml() != "" {
+		parsed, err := engine.ParseRules([]byte(req.GetRulesYaml()))
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid rules_yaml: %v", err))
+		}
+		rules = parsed
+	}
+	if rules == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no rules loaded; pass rules_yaml to test a pack")
+	}
+
+	sample, anchors, timeline, err := api.FromProtoTestRulesRequest(req, time.Now().UTC())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return api.ToProtoTestRulesResponse(rules.Evaluate(sample, anchors, timeline)), nil
+}
+
 // HealthCheck returns the current health state.
 func (s *RCAService) HealthCheck(ctx context.Context, req *rcav1.HealthRequest) (*rcav1.HealthResponse, error) {
 	return &rcav1.HealthResponse{Status: "SERVING"}, nil
 }
 
-// LatencyP95 returns the current p95 investigation latency.
+// GetVersion reports the engine build serving the request.
+func (s *RCAService) GetVersion(ctx context.Context, req *rcav1.GetVersionRequest) (*rcav1.GetVersionResponse, error) {
+	info := version.Get()
+	return &rcav1.GetVersionResponse{
+		Version:   info.Version,
+		Commit:    info.Commit,
+		BuildDate: info.BuildDate,
+		GoVersion: info.GoVersion,
+	}, nil
+}
+
+// audit records an action on behalf of the caller in ctx, timed from start.
+func (s *RCAService) audit(ctx context.Context, rec audit.Record, start time.Time, err error) {
+	if s.auditor == nil {
+		return
+	}
+	rec.Finish(start, err)
+	s.auditor.Record(ctx, rec)
+}
+
+// LatencyP95 returns the p95 investigation latency over the last utils.DefaultLatencyWindow.
 func (s *RCAService) LatencyP95() time.Duration {
 	if s.latencies == nil {
 		return 0
@@ -2,13 +2,21 @@ package services
 
 import (
 	"context"
+	"runtime"
+	"sync"
 	"testing"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/miradorstack/mirador-rca/internal/audit"
+	"github.com/miradorstack/mirador-rca/internal/engine"
 	rcav1 "github.com/miradorstack/mirador-rca/internal/grpc/generated"
 	"github.com/miradorstack/mirador-rca/internal/models"
+	"github.com/miradorstack/mirador-rca/internal/repo"
+	"github.com/miradorstack/mirador-rca/internal/version"
 )
 
 type feedbackRepoStub struct {
@@ -20,6 +28,10 @@ func (f *feedbackRepoStub) ListCorrelations(ctx context.Context, req models.List
 	return models.ListCorrelationsResponse{}, nil
 }
 
+func (f *feedbackRepoStub) SearchCorrelations(ctx context.Context, req models.SearchCorrelationsRequest) (models.SearchCorrelationsResponse, error) {
+	return models.SearchCorrelationsResponse{Results: []models.ScoredCorrelation{{Correlation: models.CorrelationResult{CorrelationID: "corr-1"}, Score: 0.9}}}, nil
+}
+
 func (f *feedbackRepoStub) FetchPatterns(ctx context.Context, tenantID, service string) ([]models.FailurePattern, error) {
 	return nil, nil
 }
@@ -29,6 +41,31 @@ func (f *feedbackRepoStub) StoreFeedback(ctx context.Context, feedback models.Fe
 	return f.err
 }
 
+func (f *feedbackRepoStub) FeedbackStats(ctx context.Context, req models.FeedbackStatsRequest) (models.FeedbackStats, error) {
+	return models.FeedbackStats{
+		Total:     4,
+		Correct:   3,
+		Accuracy:  0.75,
+		ByService: []models.AccuracyStat{{Key: "payments", Total: 4, Correct: 3, Accuracy: 0.75}},
+		ByPattern: []models.AccuracyStat{{Key: "pattern-payments", Total: 2, Correct: 2, Accuracy: 1}},
+		ByBucket:  []models.AccuracyBucket{{Start: req.Start, Total: 4, Correct: 3, Accuracy: 0.75}},
+	}, nil
+}
+
+func (f *feedbackRepoStub) UpdateCorrelation(ctx context.Context, req models.UpdateCorrelationRequest) (models.CorrelationResult, error) {
+	if req.CorrelationID != "corr-1" {
+		return models.CorrelationResult{}, repo.ErrCorrelationNotFound
+	}
+	return models.CorrelationResult{CorrelationID: req.CorrelationID, Status: req.Status, Annotations: req.Annotations}, nil
+}
+
+func (f *feedbackRepoStub) GetCorrelation(ctx context.Context, tenantID, correlationID string) (models.CorrelationResult, error) {
+	if correlationID != "corr-1" {
+		return models.CorrelationResult{}, repo.ErrCorrelationNotFound
+	}
+	return models.CorrelationResult{CorrelationID: correlationID, RootCause: "db failover"}, nil
+}
+
 func TestSubmitFeedback(t *testing.T) {
 	repo := &feedbackRepoStub{}
 	service := NewRCAService(nil, nil, nil, repo)
@@ -51,3 +88,419 @@ func TestSubmitFeedbackMissingCorrelation(t *testing.T) {
 		t.Fatalf("expected invalid argument, got %v", err)
 	}
 }
+
+func TestMaintenanceWindowRPCs(t *testing.T) {
+	calendar, err := engine.NewMaintenanceCalendar(nil)
+	if err != nil {
+		t.Fatalf("calendar: %v", err)
+	}
+	service := NewRCAService(nil, nil, nil, nil, WithMaintenanceCalendar(calendar))
+
+	now := time.Now()
+	created, err := service.CreateMaintenanceWindow(context.Background(), &rcav1.CreateMaintenanceWindowRequest{
+		Window: &rcav1.MaintenanceWindow{
+			TenantId: "tenant",
+			Services: []string{"payments"},
+			Start:    timestamppb.New(now),
+			End:      timestamppb.New(now.Add(time.Hour)),
+			Reason:   "db upgrade",
+		},
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if created.GetId() == "" {
+		t.Fatalf("expected generated id")
+	}
+
+	listed, err := service.ListMaintenanceWindows(context.Background(), &rcav1.ListMaintenanceWindowsRequest{TenantId: "tenant"})
+	if err != nil || len(listed.GetWindows()) != 1 {
+		t.Fatalf("expected one window, got %v (%v)", listed, err)
+	}
+
+	deleted, err := service.DeleteMaintenanceWindow(context.Background(), &rcav1.DeleteMaintenanceWindowRequest{TenantId: "tenant", Id: created.GetId()})
+	if err != nil || !deleted.GetDeleted() {
+		t.Fatalf("expected window deleted, got %v (%v)", deleted, err)
+	}
+
+	_, err = service.CreateMaintenanceWindow(context.Background(), &rcav1.CreateMaintenanceWindowRequest{
+		Window: &rcav1.MaintenanceWindow{TenantId: "tenant", Start: timestamppb.New(now), End: timestamppb.New(now.Add(-time.Hour))},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument for inverted window, got %v", err)
+	}
+}
+
+func TestSearchCorrelations(t *testing.T) {
+	service := NewRCAService(nil, nil, nil, &feedbackRepoStub{})
+
+	if _, err := service.SearchCorrelations(context.Background(), &rcav1.SearchCorrelationsRequest{TenantId: "tenant"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument for empty query, got %v", err)
+	}
+
+	resp, err := service.SearchCorrelations(context.Background(), &rcav1.SearchCorrelationsRequest{TenantId: "tenant", Query: "payment db lock timeout", Alpha: 0.7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetResults()) != 1 || resp.GetResults()[0].GetScore() != 0.9 || resp.GetResults()[0].GetCorrelation().GetCorrelationId() != "corr-1" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+}
+
+func TestUpdateCorrelation(t *testing.T) {
+	service := NewRCAService(nil, nil, nil, &feedbackRepoStub{})
+
+	resp, err := service.UpdateCorrelation(context.Background(), &rcav1.UpdateCorrelationRequest{
+		TenantId:      "tenant",
+		CorrelationId: "corr-1",
+		Status:        rcav1.CorrelationStatus_CORRELATION_STATUS_CONFIRMED,
+		Annotations:   []*rcav1.Annotation{{Author: "sre", Text: "matches the db failover"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.GetStatus() != rcav1.CorrelationStatus_CORRELATION_STATUS_CONFIRMED || len(resp.GetAnnotations()) != 1 || resp.GetAnnotations()[0].GetCreatedAt() == nil {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+
+	_, err = service.UpdateCorrelation(context.Background(), &rcav1.UpdateCorrelationRequest{TenantId: "tenant", CorrelationId: "corr-1"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument for empty update, got %v", err)
+	}
+	_, err = service.UpdateCorrelation(context.Background(), &rcav1.UpdateCorrelationRequest{TenantId: "tenant", CorrelationId: "missing", Status: rcav1.CorrelationStatus_CORRELATION_STATUS_REJECTED})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected not found, got %v", err)
+	}
+}
+
+func TestGetCorrelation(t *testing.T) {
+	service := NewRCAService(nil, nil, nil, &feedbackRepoStub{})
+
+	resp, err := service.GetCorrelation(context.Background(), &rcav1.GetCorrelationRequest{TenantId: "tenant", CorrelationId: "corr-1"})
+	if err != nil || resp.GetRootCause() != "db failover" {
+		t.Fatalf("unexpected response: %+v (%v)", resp, err)
+	}
+	if _, err := service.GetCorrelation(context.Background(), &rcav1.GetCorrelationRequest{TenantId: "tenant", CorrelationId: "missing"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected not found, got %v", err)
+	}
+	if _, err := service.GetCorrelation(context.Background(), &rcav1.GetCorrelationRequest{CorrelationId: "corr-1"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument without a tenant, got %v", err)
+	}
+}
+
+func TestExplainCorrelation(t *testing.T) {
+	service := NewRCAService(nil, nil, nil, &feedbackRepoStub{})
+
+	resp, err := service.ExplainCorrelation(context.Background(), &rcav1.ExplainCorrelationRequest{TenantId: "tenant", CorrelationId: "corr-1"})
+	if err != nil || resp.GetRootCause() != "db failover" || resp.GetRecorded() {
+		t.Fatalf("unexpected response: %+v (%v)", resp, err)
+	}
+	if _, err := service.ExplainCorrelation(context.Background(), &rcav1.ExplainCorrelationRequest{TenantId: "tenant", CorrelationId: "missing"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected not found, got %v", err)
+	}
+	if _, err := NewRCAService(nil, nil, nil, nil).ExplainCorrelation(context.Background(), &rcav1.ExplainCorrelationRequest{TenantId: "tenant", CorrelationId: "corr-1"}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected failed precondition without history, got %v", err)
+	}
+}
+
+// graphCoreClient serves a fixed service graph and records the window and environment it was asked for.
+type graphCoreClient struct {
+	countingCoreClient
+	edges       []repo.ServiceGraphEdge
+	start, end  time.Time
+	environment string
+}
+
+func (g *graphCoreClient) FetchServiceGraph(ctx context.Context, tenantID string, start, end time.Time) ([]repo.ServiceGraphEdge, error) {
+	g.start, g.end, g.environment = start, end, repo.Environment(ctx)
+	return g.edges, nil
+}
+
+func TestGetServiceGraph(t *testing.T) {
+	history, err := repo.NewMemoryRepo("")
+	if err != nil {
+		t.Fatalf("memory repo: %v", err)
+	}
+	created := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	for _, correlation := range []models.CorrelationResult{
+		{
+			CorrelationID:    "corr-prod",
+			Environment:      "prod",
+			CreatedAt:        created,
+			AffectedServices: []string{"checkout"},
+			RedAnchors:       []models.RedAnchor{{Service: "payments", AnomalyScore: 0.9}, {Service: "payments", AnomalyScore: 0.7}},
+			BlastRadius:      []models.ServiceImpact{{Service: "payments", Score: 1}, {Service: "checkout", Score: 0.6, Depth: 1}},
+			Explanation:      &models.Explanation{Service: "checkout", Causality: models.CausalityExplanation{SuggestedService: "payments"}},
+		},
+		{CorrelationID: "corr-staging", Environment: "staging", CreatedAt: created.Add(time.Minute)},
+	} {
+		if err := history.StoreCorrelation(context.Background(), "tenant", correlation); err != nil {
+			t.Fatalf("store: %v", err)
+		}
+	}
+	core := &graphCoreClient{edges: []repo.ServiceGraphEdge{
+		{Source: "checkout", Target: "payments", CallRate: 20, ErrorRate: 12},
+		{Source: "frontend", Target: "checkout", CallRate: 25},
+	}}
+	service := NewRCAService(nil, nil, engine.NewPipeline(nil, core, nil, nil, nil, nil), history)
+
+	resp, err := service.GetServiceGraph(context.Background(), &rcav1.GetServiceGraphRequest{TenantId: "tenant", Environment: "prod"})
+	if err != nil {
+		t.Fatalf("get service graph: %v", err)
+	}
+	if resp.GetCorrelationId() != "corr-prod" || core.environment != "prod" {
+		t.Fatalf("expected the latest prod correlation over the prod graph, got %q in %q", resp.GetCorrelationId(), core.environment)
+	}
+	if !core.end.Equal(created) || !core.start.Equal(created.Add(-15*time.Minute)) {
+		t.Fatalf("expected the 15 minutes before the correlation, got %s to %s", core.start, core.end)
+	}
+	if len(resp.GetNodes()) != 3 || len(resp.GetEdges()) != 2 {
+		t.Fatalf("unexpected graph: %+v", resp)
+	}
+	checkout, frontend, payments := resp.GetNodes()[0], resp.GetNodes()[1], resp.GetNodes()[2]
+	if !checkout.GetAffected() || checkout.GetRootCause() || checkout.GetImpactDepth() != 1 || frontend.GetImpactScore() != 0 {
+		t.Fatalf("unexpected nodes: %+v", resp.GetNodes())
+	}
+	if !payments.GetRootCause() || payments.GetAnchorCount() != 2 || payments.GetAnomalyScore() != 0.9 {
+		t.Fatalf("expected payments blamed with two anchors, got %+v", payments)
+	}
+	if !resp.GetEdges()[0].GetAnomalous() || resp.GetEdges()[1].GetAnomalous() {
+		t.Fatalf("expected only the edge into payments to be anomalous, got %+v", resp.GetEdges())
+	}
+
+	window := &rcav1.TimeRange{Start: timestamppb.New(created.Add(-time.Hour)), End: timestamppb.New(created)}
+	resp, err = service.GetServiceGraph(context.Background(), &rcav1.GetServiceGraphRequest{TenantId: "tenant", Environment: "staging", TimeRange: window})
+	if err != nil || resp.GetCorrelationId() != "corr-staging" || !core.start.Equal(created.Add(-time.Hour)) {
+		t.Fatalf("expected the staging correlation over the requested window, got %+v (%v)", resp, err)
+	}
+	core.environment = ""
+	resp, err = service.GetServiceGraph(context.Background(), &rcav1.GetServiceGraphRequest{TenantId: "tenant", CorrelationId: "corr-prod"})
+	if err != nil || resp.GetCorrelationId() != "corr-prod" || core.environment != "prod" {
+		t.Fatalf("expected the correlation's prod graph without an explicit environment, got %q (%v)", core.environment, err)
+	}
+	if _, err := service.GetServiceGraph(context.Background(), &rcav1.GetServiceGraphRequest{TenantId: "tenant", CorrelationId: "missing"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected not found, got %v", err)
+	}
+	if _, err := service.GetServiceGraph(context.Background(), &rcav1.GetServiceGraphRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument without a tenant, got %v", err)
+	}
+}
+
+type purgerStub struct {
+	req models.PurgeRequest
+}
+
+func (p *purgerStub) PurgeTenantData(ctx context.Context, req models.PurgeRequest) (models.PurgeResult, error) {
+	p.req = req
+	return models.PurgeResult{Correlations: 3, Feedback: 1, Patterns: 2, DryRun: req.DryRun}, nil
+}
+
+type recordingSink struct {
+	mu      sync.Mutex
+	records []audit.Record
+}
+
+func (r *recordingSink) Write(_ context.Context, records []audit.Record) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.records = append(r.records, records...)
+	return nil
+}
+
+func (r *recordingSink) Close() error { return nil }
+
+func TestAdminRPCsAreAudited(t *testing.T) {
+	sink := &recordingSink{}
+	auditor := audit.NewLogger(nil, sink, 0)
+	service := NewRCAService(nil, nil, nil, &feedbackRepoStub{}, WithDataPurger(&purgerStub{}), WithAuditor(auditor))
+
+	ctx := audit.WithActor(context.Background(), "sre@example.com")
+	if _, err := service.SubmitFeedback(ctx, &rcav1.FeedbackRequest{TenantId: "acme", CorrelationId: "corr-1", Correct: true}); err != nil {
+		t.Fatalf("submit feedback: %v", err)
+	}
+	if _, err := service.PurgeTenantData(ctx, &rcav1.PurgeTenantDataRequest{TenantId: "acme", DryRun: true}); err != nil {
+		t.Fatalf("purge: %v", err)
+	}
+	if err := auditor.Close(context.Background()); err != nil {
+		t.Fatalf("close auditor: %v", err)
+	}
+
+	if len(sink.records) != 2 {
+		t.Fatalf("expected two audit records, got %+v", sink.records)
+	}
+	feedback, purge := sink.records[0], sink.records[1]
+	if feedback.Action != audit.ActionSubmitFeedback || feedback.Actor != "sre@example.com" || feedback.ResultID != "corr-1" || feedback.Outcome != audit.OutcomeSuccess || feedback.RequestHash == "" {
+		t.Fatalf("unexpected feedback record: %+v", feedback)
+	}
+	if purge.Action != audit.ActionPurgeTenantData || purge.TenantID != "acme" || purge.Details["dry_run"] != "true" || purge.Details["correlations"] != "3" {
+		t.Fatalf("unexpected purge record: %+v", purge)
+	}
+}
+
+func TestPurgeTenantData(t *testing.T) {
+	purger := &purgerStub{}
+	service := NewRCAService(nil, nil, nil, nil, WithDataPurger(purger))
+
+	if _, err := service.PurgeTenantData(context.Background(), &rcav1.PurgeTenantDataRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument without tenant, got %v", err)
+	}
+
+	resp, err := service.PurgeTenantData(context.Background(), &rcav1.PurgeTenantDataRequest{TenantId: "acme", DryRun: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !purger.req.Before.IsZero() || !purger.req.DryRun || purger.req.TenantID != "acme" {
+		t.Fatalf("unexpected purge request: %+v", purger.req)
+	}
+	if resp.GetCorrelations() != 3 || resp.GetPatterns() != 2 || !resp.GetDryRun() {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+}
+
+func TestGetFeedbackStats(t *testing.T) {
+	service := NewRCAService(nil, nil, nil, &feedbackRepoStub{})
+
+	if _, err := service.GetFeedbackStats(context.Background(), &rcav1.GetFeedbackStatsRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument without tenant, got %v", err)
+	}
+
+	resp, err := service.GetFeedbackStats(context.Background(), &rcav1.GetFeedbackStatsRequest{TenantId: "acme", BucketSeconds: 3600})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.GetTotal() != 4 || resp.GetAccuracy() != 0.75 || len(resp.GetByService()) != 1 || resp.GetByService()[0].GetKey() != "payments" || len(resp.GetByBucket()) != 1 ||
+		len(resp.GetByPattern()) != 1 || resp.GetByPattern()[0].GetKey() != "pattern-payments" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+}
+
+type minerStub struct {
+	tenantID   string
+	start, end time.Time
+	done       chan struct{}
+}
+
+func (m *minerStub) MineWindow(ctx context.Context, tenantID string, start, end time.Time) ([]models.FailurePattern, int, error) {
+	m.tenantID, m.start, m.end = tenantID, start, end
+	if m.done != nil {
+		defer close(m.done)
+	}
+	return []models.FailurePattern{{ID: "pattern-checkout", Services: []string{"checkout"}}}, 12, nil
+}
+
+func TestMinePatterns(t *testing.T) {
+	miner := &minerStub{}
+	service := NewRCAService(nil, nil, nil, nil, WithPatternMiner(miner))
+
+	if _, err := service.MinePatterns(context.Background(), &rcav1.MinePatternsRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument without tenant, got %v", err)
+	}
+
+	start := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	resp, err := service.MinePatterns(context.Background(), &rcav1.MinePatternsRequest{
+		TenantId:  "acme",
+		TimeRange: &rcav1.TimeRange{Start: timestamppb.New(start)},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if miner.tenantID != "acme" || !miner.start.Equal(start) || !miner.end.IsZero() {
+		t.Fatalf("unexpected miner call: %+v", miner)
+	}
+	if len(resp.GetPatterns()) != 1 || resp.GetPatterns()[0].GetId() != "pattern-checkout" || resp.GetCorrelations() != 12 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+
+	async := &minerStub{done: make(chan struct{})}
+	service = NewRCAService(nil, nil, nil, nil, WithPatternMiner(async))
+	resp, err = service.MinePatterns(context.Background(), &rcav1.MinePatternsRequest{TenantId: "acme", Async: true})
+	if err != nil || resp.GetJobId() == "" || len(resp.GetPatterns()) != 0 {
+		t.Fatalf("expected async job id, got %+v (%v)", resp, err)
+	}
+	select {
+	case <-async.done:
+	case <-time.After(time.Second):
+		t.Fatalf("async mining job did not run")
+	}
+}
+
+type tunerStub struct {
+	refresh bool
+}
+
+func (s *tunerStub) Recommendations(ctx context.Context, tenantID string, refresh bool) ([]models.ThresholdRecommendation, error) {
+	s.refresh = refresh
+	return []models.ThresholdRecommendation{{Service: "checkout", Threshold: 3.4, Current: 2.5, Basis: models.ThresholdBasisNoise, Correlations: 12}}, nil
+}
+
+func TestGetThresholdRecommendations(t *testing.T) {
+	service := NewRCAService(nil, nil, nil, nil)
+	if _, err := service.GetThresholdRecommendations(context.Background(), &rcav1.GetThresholdRecommendationsRequest{TenantId: "acme"}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected failed precondition without a tuner, got %v", err)
+	}
+
+	tuner := &tunerStub{}
+	service = NewRCAService(nil, nil, nil, nil, WithThresholdTuner(tuner))
+	if _, err := service.GetThresholdRecommendations(context.Background(), &rcav1.GetThresholdRecommendationsRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument without tenant, got %v", err)
+	}
+	resp, err := service.GetThresholdRecommendations(context.Background(), &rcav1.GetThresholdRecommendationsRequest{TenantId: "acme", Refresh: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !tuner.refresh || resp.GetApplied() || len(resp.GetRecommendations()) != 1 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if rec := resp.GetRecommendations()[0]; rec.GetService() != "checkout" || rec.GetThreshold() != 3.4 || rec.GetBasis() != "noise" {
+		t.Fatalf("unexpected recommendation: %+v", rec)
+	}
+}
+
+func TestTestRules(t *testing.T) {
+	service := NewRCAService(nil, nil, nil, nil)
+	if _, err := service.TestRules(context.Background(), &rcav1.TestRulesRequest{}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected failed precondition without rules, got %v", err)
+	}
+	if _, err := service.TestRules(context.Background(), &rcav1.TestRulesRequest{RulesYaml: "rules:\n  - id: empty\n"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected an invalid pack to be rejected, got %v", err)
+	}
+
+	resp, err := service.TestRules(context.Background(), &rcav1.TestRulesRequest{
+		Request: &rcav1.RCAInvestigationRequest{AffectedServices: []string{"checkout"}},
+		Anchors: []*rcav1.RedAnchor{{Service: "checkout", Selector: "metrics:cpu_usage"}},
+		RulesYaml: `rules:
+  - id: cpu
+    match:
+      service: checkout
+      selector_contains: ["cpu"]
+    recommendations: ["Scale checkout"]
+  - id: errors
+    match:
+      severity: critical
+    recommendations: ["Inspect error logs"]
+`,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetEvaluations()) != 2 || !resp.GetEvaluations()[0].GetMatched() || resp.GetEvaluations()[1].GetMatched() {
+		t.Fatalf("unexpected evaluations: %+v", resp.GetEvaluations())
+	}
+	if len(resp.GetRecommendations()) != 1 || resp.GetRecommendations()[0].GetRuleId() != "cpu" {
+		t.Fatalf("unexpected recommendations: %+v", resp.GetRecommendations())
+	}
+}
+
+func TestGetVersion(t *testing.T) {
+	previous := version.Version
+	version.Version = "v1.2.3"
+	defer func() { version.Version = previous }()
+
+	resp, err := NewRCAService(nil, nil, nil, nil).GetVersion(context.Background(), &rcav1.GetVersionRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.GetVersion() != "v1.2.3" || resp.GetCommit() == "" || resp.GetBuildDate() == "" || resp.GetGoVersion() != runtime.Version() {
+		t.Fatalf("unexpected version: %+v", resp)
+	}
+}
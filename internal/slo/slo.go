@@ -0,0 +1,169 @@
+package slo
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/metrics"
+)
+
+// InvestigationLatency names the investigation latency objective in metrics and logs.
+const InvestigationLatency = "investigation_latency"
+
+// resolution is the width of the buckets that events are counted in; windows are rounded up to it.
+const resolution = time.Minute
+
+// Burn is the error budget burn over one trailing window. Rate is the fraction of bad events divided by the
+// budget (1 - objective): 1 spends the budget exactly as fast as the objective allows, and 10 exhausts a
+// 30-day budget in 3 days.
+type Burn struct {
+	Window time.Duration
+	Total  uint64
+	Bad    uint64
+	Rate   float64
+}
+
+// LatencyTracker measures a latency objective: objective of events finish within threshold. It keeps
+// per-minute counts for the longest window, so memory stays constant however busy the engine is.
+type LatencyTracker struct {
+	name      string
+	threshold time.Duration
+	objective float64
+	windows   []time.Duration
+	now       func() time.Time
+
+	mu      sync.Mutex
+	buckets []bucket
+}
+
+type bucket struct {
+	minute int64
+	total  uint64
+	bad    uint64
+}
+
+// NewLatencyTracker creates a tracker for the objective name over the given trailing windows.
+func NewLatencyTracker(name string, threshold time.Duration, objective float64, windows []time.Duration) *LatencyTracker {
+	var longest time.Duration
+	for _, window := range windows {
+		longest = max(longest, window)
+	}
+	size := int((longest + resolution - 1) / resolution)
+	return &LatencyTracker{
+		name:      name,
+		threshold: threshold,
+		objective: objective,
+		windows:   append([]time.Duration(nil), windows...),
+		now:       time.Now,
+		buckets:   make([]bucket, max(size, 1)),
+	}
+}
+
+// Observe counts one event that took d; it is bad when d exceeds the threshold. Nil trackers ignore it.
+func (t *LatencyTracker) Observe(d time.Duration) {
+	if t == nil {
+		return
+	}
+	good := d <= t.threshold
+	metrics.ObserveSLOEvent(t.name, good)
+
+	minute := t.now().UnixNano() / int64(resolution)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	b := &t.buckets[minute%int64(len(t.buckets))]
+	if b.minute != minute {
+		*b = bucket{minute: minute}
+	}
+	b.total++
+	if !good {
+		b.bad++
+	}
+}
+
+// Burn returns the burn over each window, in the order the windows were given.
+func (t *LatencyTracker) Burn() []Burn {
+	minute := t.now().UnixNano() / int64(resolution)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	burns := make([]Burn, len(t.windows))
+	for i, window := range t.windows {
+		burn := Burn{Window: window}
+		span := int64((window + resolution - 1) / resolution)
+		for _, b := range t.buckets {
+			if b.total > 0 && minute-b.minute < span {
+				burn.Total += b.total
+				burn.Bad += b.bad
+			}
+		}
+		if burn.Total > 0 {
+			burn.Rate = float64(burn.Bad) / float64(burn.Total) / (1 - t.objective)
+		}
+		burns[i] = burn
+	}
+	return burns
+}
+
+// Run publishes the burn rates as metrics every interval, and logs them, until ctx is cancelled. The log
+// line is a warning while every window burns faster than 1, which means the objective is being missed
+// consistently rather than during a brief spike.
+func (t *LatencyTracker) Run(ctx context.Context, logger *slog.Logger, interval time.Duration) {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			t.report(logger)
+		}
+	}
+}
+
+func (t *LatencyTracker) report(logger *slog.Logger) {
+	burns := t.Burn()
+	attrs := []any{
+		slog.String("slo", t.name),
+		slog.Duration("threshold", t.threshold),
+		slog.Float64("objective", t.objective),
+	}
+	exhausting := len(burns) > 0
+	for _, burn := range burns {
+		label := WindowLabel(burn.Window)
+		metrics.SetSLOBurnRate(t.name, label, burn.Rate)
+		attrs = append(attrs, slog.Group("window_"+label,
+			slog.Float64("burn_rate", burn.Rate),
+			slog.Uint64("events", burn.Total),
+			slog.Uint64("bad", burn.Bad),
+		))
+		if burn.Rate <= 1 {
+			exhausting = false
+		}
+	}
+	if exhausting {
+		logger.Warn("SLO error budget burning faster than the objective allows", attrs...)
+		return
+	}
+	logger.Info("SLO burn rate", attrs...)
+}
+
+// WindowLabel renders window compactly for the window metric label: 15m, 1h, 6h, 1h30m.
+func WindowLabel(window time.Duration) string {
+	label := window.String()
+	if strings.HasSuffix(label, "m0s") {
+		label = strings.TrimSuffix(label, "0s")
+	}
+	if strings.HasSuffix(label, "h0m") {
+		label = strings.TrimSuffix(label, "0m")
+	}
+	return label
+}
@@ -0,0 +1,82 @@
+package slo
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLatencyTrackerBurn(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	tracker := NewLatencyTracker(InvestigationLatency, 4*time.Second, 0.95, []time.Duration{5 * time.Minute, time.Hour})
+	tracker.now = func() time.Time { return now }
+
+	// An hour ago: 100 fast investigations.
+	for i := 0; i < 100; i++ {
+		tracker.Observe(time.Second)
+	}
+	now = now.Add(50 * time.Minute)
+	// Now: 10 investigations, 2 of them slow.
+	for i := 0; i < 10; i++ {
+		if i < 2 {
+			tracker.Observe(6 * time.Second)
+		} else {
+			tracker.Observe(time.Second)
+		}
+	}
+
+	burns := tracker.Burn()
+	if len(burns) != 2 {
+		t.Fatalf("expected two windows, got %d", len(burns))
+	}
+	// 5m: 2 bad of 10 is 20%, four times the 5% budget.
+	if short := burns[0]; short.Total != 10 || short.Bad != 2 || short.Rate < 3.99 || short.Rate > 4.01 {
+		t.Fatalf("unexpected 5m burn: %+v", short)
+	}
+	// 1h: 2 bad of 110.
+	if long := burns[1]; long.Total != 110 || long.Bad != 2 || long.Rate > 0.37 {
+		t.Fatalf("unexpected 1h burn: %+v", long)
+	}
+
+	now = now.Add(2 * time.Hour)
+	if burns := tracker.Burn(); burns[0].Total != 0 || burns[1].Total != 0 || burns[1].Rate != 0 {
+		t.Fatalf("expected old events to age out, got %+v", burns)
+	}
+}
+
+func TestLatencyTrackerReport(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	tracker := NewLatencyTracker(InvestigationLatency, 4*time.Second, 0.95, []time.Duration{15 * time.Minute, time.Hour})
+	tracker.now = func() time.Time { return now }
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+
+	tracker.Observe(time.Second)
+	tracker.report(logger)
+	if out := buf.String(); !strings.Contains(out, "level=INFO") || !strings.Contains(out, "window_15m.burn_rate=0") {
+		t.Fatalf("expected an info line with the 15m burn rate, got %q", out)
+	}
+
+	buf.Reset()
+	tracker.Observe(10 * time.Second)
+	tracker.report(logger)
+	if out := buf.String(); !strings.Contains(out, "level=WARN") || !strings.Contains(out, "window_1h.bad=1") {
+		t.Fatalf("expected a warning while every window overspends, got %q", out)
+	}
+}
+
+func TestWindowLabel(t *testing.T) {
+	for window, want := range map[time.Duration]string{
+		time.Minute:      "1m",
+		15 * time.Minute: "15m",
+		time.Hour:        "1h",
+		90 * time.Minute: "1h30m",
+		6 * time.Hour:    "6h",
+	} {
+		if got := WindowLabel(window); got != want {
+			t.Fatalf("%v: expected %q, got %q", window, want, got)
+		}
+	}
+}
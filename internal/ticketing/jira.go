@@ -0,0 +1,128 @@
+package ticketing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// JiraConfig configures issue creation through the Jira REST API (v2). Email and APIToken authenticate with
+// basic auth, as Jira Cloud API tokens require.
+type JiraConfig struct {
+	BaseURL   string
+	Email     string
+	APIToken  string
+	Project   string
+	IssueType string
+	// Labels are added to every issue alongside the idempotency label.
+	Labels  []string
+	Timeout time.Duration
+}
+
+// Jira opens issues in a Jira project, tagging each with its ticket key as a label.
+type Jira struct {
+	cfg        JiraConfig
+	httpClient *http.Client
+}
+
+// NewJira validates cfg and builds a Jira tracker. IssueType defaults to Task.
+func NewJira(cfg JiraConfig) (*Jira, error) {
+	if cfg.BaseURL == "" || cfg.Project == "" {
+		return nil, fmt.Errorf("jira base url and project are required")
+	}
+	cfg.BaseURL = strings.TrimRight(cfg.BaseURL, "/")
+	if cfg.IssueType == "" {
+		cfg.IssueType = "Task"
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+	return &Jira{cfg: cfg, httpClient: &http.Client{Timeout: cfg.Timeout}}, nil
+}
+
+// Name implements Tracker.
+func (j *Jira) Name() string { return "jira" }
+
+// Find implements Tracker by searching the project for an issue labelled with key.
+func (j *Jira) Find(ctx context.Context, key string) (string, error) {
+	query := url.Values{}
+	query.Set("jql", fmt.Sprintf("project = %q AND labels = %q", j.cfg.Project, key))
+	query.Set("fields", "key")
+	query.Set("maxResults", "1")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, j.cfg.BaseURL+"/rest/api/2/search?"+query.Encode(), nil)
+	if err != nil {
+		return "", err
+	}
+	var resp struct {
+		Issues []struct {
+			Key string `json:"key"`
+		} `json:"issues"`
+	}
+	if err := j.do(req, &resp); err != nil {
+		return "", err
+	}
+	if len(resp.Issues) == 0 {
+		return "", nil
+	}
+	return resp.Issues[0].Key, nil
+}
+
+// Create implements Tracker.
+func (j *Jira) Create(ctx context.Context, ticket Ticket) (string, error) {
+	fields := map[string]interface{}{
+		"project":     map[string]string{"key": j.cfg.Project},
+		"issuetype":   map[string]string{"name": j.cfg.IssueType},
+		"summary":     ticket.Summary,
+		"description": ticket.Description,
+		"labels":      append([]string{ticket.Key}, j.cfg.Labels...),
+	}
+	body, err := json.Marshal(map[string]interface{}{"fields": fields})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, j.cfg.BaseURL+"/rest/api/2/issue", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	var resp struct {
+		Key string `json:"key"`
+	}
+	if err := j.do(req, &resp); err != nil {
+		return "", err
+	}
+	return resp.Key, nil
+}
+
+func (j *Jira) do(req *http.Request, out interface{}) error {
+	req.Header.Set("Accept", "application/json")
+	if j.cfg.Email != "" || j.cfg.APIToken != "" {
+		req.SetBasicAuth(j.cfg.Email, j.cfg.APIToken)
+	}
+	return doJSON(j.httpClient, req, out)
+}
+
+func doJSON(client *http.Client, req *http.Request, out interface{}) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("%s %s: %s: %s", req.Method, req.URL.Path, resp.Status, strings.TrimSpace(string(data)))
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode %s response: %w", req.URL.Path, err)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package ticketing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ServiceNowConfig configures record creation through the ServiceNow Table API. The ticket key is stored in
+// the record's correlation_id field.
+type ServiceNowConfig struct {
+	InstanceURL     string
+	Username        string
+	Password        string
+	Table           string
+	AssignmentGroup string
+	Timeout         time.Duration
+}
+
+// ServiceNow opens records (incidents by default) in a ServiceNow table.
+type ServiceNow struct {
+	cfg        ServiceNowConfig
+	httpClient *http.Client
+}
+
+// NewServiceNow validates cfg and builds a ServiceNow tracker. Table defaults to incident.
+func NewServiceNow(cfg ServiceNowConfig) (*ServiceNow, error) {
+	if cfg.InstanceURL == "" {
+		return nil, fmt.Errorf("servicenow instance url is required")
+	}
+	cfg.InstanceURL = strings.TrimRight(cfg.InstanceURL, "/")
+	if cfg.Table == "" {
+		cfg.Table = "incident"
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+	return &ServiceNow{cfg: cfg, httpClient: &http.Client{Timeout: cfg.Timeout}}, nil
+}
+
+// Name implements Tracker.
+func (s *ServiceNow) Name() string { return "servicenow" }
+
+// Find implements Tracker by querying the table for a record with correlation_id = key.
+func (s *ServiceNow) Find(ctx context.Context, key string) (string, error) {
+	query := url.Values{}
+	query.Set("sysparm_query", "correlation_id="+key)
+	query.Set("sysparm_fields", "number")
+	query.Set("sysparm_limit", "1")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.tableURL()+"?"+query.Encode(), nil)
+	if err != nil {
+		return "", err
+	}
+	var resp struct {
+		Result []struct {
+			Number string `json:"number"`
+		} `json:"result"`
+	}
+	if err := s.do(req, &resp); err != nil {
+		return "", err
+	}
+	if len(resp.Result) == 0 {
+		return "", nil
+	}
+	return resp.Result[0].Number, nil
+}
+
+// Create implements Tracker.
+func (s *ServiceNow) Create(ctx context.Context, ticket Ticket) (string, error) {
+	record := map[string]string{
+		"short_description":   ticket.Summary,
+		"description":         ticket.Description,
+		"correlation_id":      ticket.Key,
+		"correlation_display": "mirador-rca",
+	}
+	if s.cfg.AssignmentGroup != "" {
+		record["assignment_group"] = s.cfg.AssignmentGroup
+	}
+	body, err := json.Marshal(record)
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.tableURL(), bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	var resp struct {
+		Result struct {
+			Number string `json:"number"`
+		} `json:"result"`
+	}
+	if err := s.do(req, &resp); err != nil {
+		return "", err
+	}
+	return resp.Result.Number, nil
+}
+
+func (s *ServiceNow) tableURL() string {
+	return s.cfg.InstanceURL + "/api/now/table/" + url.PathEscape(s.cfg.Table)
+}
+
+func (s *ServiceNow) do(req *http.Request, out interface{}) error {
+	req.Header.Set("Accept", "application/json")
+	if s.cfg.Username != "" {
+		req.SetBasicAuth(s.cfg.Username, s.cfg.Password)
+	}
+	return doJSON(s.httpClient, req, out)
+}
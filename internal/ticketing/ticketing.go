@@ -0,0 +1,191 @@
+package ticketing
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// DefaultSummaryTemplate is used when no summary template is configured.
+const DefaultSummaryTemplate = `[RCA] {{.Correlation.RootCause}} ({{.ConfidencePercent}}%)`
+
+// maxRememberedTickets bounds the in-process cache of created tickets; the tracker lookup remains the
+// source of truth once it is cleared.
+const maxRememberedTickets = 10000
+
+// maxSummaryLength keeps summaries within the single-line limits of Jira (255) and ServiceNow (160).
+const maxSummaryLength = 160
+
+// Ticket is the tracker-neutral content of a ticket. Key identifies the correlation so that retries find
+// the existing ticket instead of opening another one.
+type Ticket struct {
+	Key         string
+	Summary     string
+	Description string
+}
+
+// Tracker is implemented by ticketing backends.
+type Tracker interface {
+	Name() string
+	// Find returns the reference of an existing ticket with the given key, or "" when there is none.
+	Find(ctx context.Context, key string) (string, error)
+	// Create opens the ticket and returns its reference (issue key or record number).
+	Create(ctx context.Context, ticket Ticket) (string, error)
+}
+
+// Config controls which correlations open tickets. Empty Tenants matches every tenant.
+type Config struct {
+	MinConfidence   float64
+	Tenants         []string
+	SummaryTemplate string
+}
+
+// Creator opens one ticket per qualifying correlation. Duplicates flagged by clustering are skipped because
+// the primary correlation already has a ticket.
+type Creator struct {
+	tracker Tracker
+	cfg     Config
+	summary *template.Template
+
+	mu      sync.Mutex
+	created map[string]string
+}
+
+type summaryData struct {
+	TenantID          string
+	Correlation       models.CorrelationResult
+	ConfidencePercent int
+}
+
+// NewCreator parses the summary template and binds it to tracker.
+func NewCreator(tracker Tracker, cfg Config) (*Creator, error) {
+	if tracker == nil {
+		return nil, fmt.Errorf("ticket tracker is required")
+	}
+	text := cfg.SummaryTemplate
+	if text == "" {
+		text = DefaultSummaryTemplate
+	}
+	tmpl, err := template.New("summary").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parse summary template: %w", err)
+	}
+	return &Creator{tracker: tracker, cfg: cfg, summary: tmpl, created: map[string]string{}}, nil
+}
+
+// Notify opens a ticket for result when it qualifies and none exists yet. Creation is serialised so that
+// concurrent deliveries of the same correlation cannot race past the existence check.
+func (c *Creator) Notify(ctx context.Context, tenantID string, result models.CorrelationResult) error {
+	if c == nil || !c.qualifies(tenantID, result) {
+		return nil
+	}
+	key := TicketKey(result.CorrelationID)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.created[key]; ok {
+		return nil
+	}
+	ref, err := c.tracker.Find(ctx, key)
+	if err != nil {
+		return fmt.Errorf("%s: find ticket: %w", c.tracker.Name(), err)
+	}
+	if ref == "" {
+		ticket, err := c.render(tenantID, result, key)
+		if err != nil {
+			return err
+		}
+		if ref, err = c.tracker.Create(ctx, ticket); err != nil {
+			return fmt.Errorf("%s: create ticket: %w", c.tracker.Name(), err)
+		}
+	}
+	if len(c.created) >= maxRememberedTickets {
+		c.created = map[string]string{}
+	}
+	c.created[key] = ref
+	return nil
+}
+
+func (c *Creator) qualifies(tenantID string, result models.CorrelationResult) bool {
+	if result.CorrelationID == "" || result.DuplicateOf != "" || result.Confidence < c.cfg.MinConfidence {
+		return false
+	}
+	if len(c.cfg.Tenants) == 0 {
+		return true
+	}
+	for _, tenant := range c.cfg.Tenants {
+		if tenant == tenantID {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Creator) render(tenantID string, result models.CorrelationResult, key string) (Ticket, error) {
+	var buf bytes.Buffer
+	data := summaryData{TenantID: tenantID, Correlation: result, ConfidencePercent: int(result.Confidence*100 + 0.5)}
+	if err := c.summary.Execute(&buf, data); err != nil {
+		return Ticket{}, fmt.Errorf("render summary: %w", err)
+	}
+	summary := strings.Join(strings.Fields(buf.String()), " ")
+	if runes := []rune(summary); len(runes) > maxSummaryLength {
+		summary = strings.TrimSpace(string(runes[:maxSummaryLength-3])) + "..."
+	}
+	return Ticket{Key: key, Summary: summary, Description: Description(tenantID, result)}, nil
+}
+
+// TicketKey is the idempotency key stored on tickets for a correlation.
+func TicketKey(correlationID string) string {
+	return "mirador-rca-" + correlationID
+}
+
+// Description renders the plain-text ticket body: root cause, recommendations, and timeline.
+func Description(tenantID string, result models.CorrelationResult) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Root cause: %s\n", result.RootCause)
+	fmt.Fprintf(&b, "Confidence: %.0f%%\n", result.Confidence*100)
+	fmt.Fprintf(&b, "Tenant: %s\n", tenantID)
+	fmt.Fprintf(&b, "Correlation: %s\n", result.CorrelationID)
+	if result.IncidentID != "" {
+		fmt.Fprintf(&b, "Incident: %s\n", result.IncidentID)
+	}
+	if len(result.AffectedServices) > 0 {
+		fmt.Fprintf(&b, "Affected services: %s\n", strings.Join(result.AffectedServices, ", "))
+	}
+	if len(result.Recommendations) > 0 {
+		b.WriteString("\nRecommendations:\n")
+		for _, rec := range result.Recommendations {
+			fmt.Fprintf(&b, "- %s\n", rec.Text)
+			for _, action := range rec.Actions {
+				label := action.Label
+				if label == "" {
+					label = string(action.Type)
+				}
+				fmt.Fprintf(&b, "  %s: %s\n", label, action.URL)
+			}
+		}
+	}
+	if len(result.Timeline) > 0 {
+		b.WriteString("\nTimeline:\n")
+		for _, event := range result.Timeline {
+			fmt.Fprintf(&b, "- %s [%s] %s: %s", event.Time.UTC().Format(time.RFC3339), event.Severity, event.Service, event.Event)
+			if event.Sustained() {
+				fmt.Fprintf(&b, " for %s", event.Duration())
+				if event.Ongoing {
+					b.WriteString(", ongoing")
+				}
+			}
+			if event.Link != "" {
+				fmt.Fprintf(&b, " (%s)", event.Link)
+			}
+			b.WriteString("\n")
+		}
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
@@ -0,0 +1,145 @@
+package ticketing
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+func sampleResult() models.CorrelationResult {
+	return models.CorrelationResult{
+		CorrelationID:   "corr-1",
+		RootCause:       "payments: db connection pool exhausted",
+		Confidence:      0.91,
+		Recommendations: []models.Recommendation{{Text: "Raise the pool size"}},
+		Timeline: []models.TimelineEvent{
+			{Time: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC), Event: "db_pool_in_use spiked", Service: "payments", Severity: models.SeverityHigh},
+		},
+	}
+}
+
+func TestJiraCreatorIsIdempotent(t *testing.T) {
+	var mu sync.Mutex
+	labels := map[string]string{}
+	var created []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if user, pass, _ := r.BasicAuth(); user != "bot@example.com" || pass != "tok" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/2/search":
+			var issues []map[string]string
+			for label, key := range labels {
+				if strings.Contains(r.URL.Query().Get("jql"), `labels = "`+label+`"`) {
+					issues = append(issues, map[string]string{"key": key})
+				}
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"issues": issues})
+		case r.Method == http.MethodPost && r.URL.Path == "/rest/api/2/issue":
+			var body struct {
+				Fields map[string]interface{} `json:"fields"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			created = append(created, body.Fields)
+			labels[body.Fields["labels"].([]interface{})[0].(string)] = "OPS-1"
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]string{"key": "OPS-1"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	jira, err := NewJira(JiraConfig{BaseURL: server.URL, Email: "bot@example.com", APIToken: "tok", Project: "OPS", Labels: []string{"rca"}})
+	if err != nil {
+		t.Fatalf("new jira: %v", err)
+	}
+	creator, err := NewCreator(jira, Config{MinConfidence: 0.8, SummaryTemplate: "{{.TenantID}}: {{.Correlation.RootCause}}"})
+	if err != nil {
+		t.Fatalf("new creator: %v", err)
+	}
+
+	ctx := context.Background()
+	if err := creator.Notify(ctx, "acme", sampleResult()); err != nil {
+		t.Fatalf("notify: %v", err)
+	}
+	// A fresh creator has no local memory of the ticket and must find it through the tracker.
+	retry, _ := NewCreator(jira, Config{MinConfidence: 0.8})
+	if err := retry.Notify(ctx, "acme", sampleResult()); err != nil {
+		t.Fatalf("retry notify: %v", err)
+	}
+	low := sampleResult()
+	low.CorrelationID, low.Confidence = "corr-2", 0.5
+	duplicate := sampleResult()
+	duplicate.CorrelationID, duplicate.DuplicateOf = "corr-3", "corr-1"
+	for _, result := range []models.CorrelationResult{low, duplicate} {
+		if err := creator.Notify(ctx, "acme", result); err != nil {
+			t.Fatalf("notify: %v", err)
+		}
+	}
+
+	if len(created) != 1 {
+		t.Fatalf("expected exactly one issue, got %d", len(created))
+	}
+	fields := created[0]
+	if fields["summary"] != "acme: payments: db connection pool exhausted" || fields["issuetype"].(map[string]interface{})["name"] != "Task" {
+		t.Fatalf("unexpected issue fields: %+v", fields)
+	}
+	description, _ := fields["description"].(string)
+	for _, want := range []string{"Raise the pool size", "2024-03-01T10:00:00Z [high] payments: db_pool_in_use spiked"} {
+		if !strings.Contains(description, want) {
+			t.Fatalf("expected %q in description:\n%s", want, description)
+		}
+	}
+}
+
+func TestServiceNowCreatesRecordInQueue(t *testing.T) {
+	var record map[string]string
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/now/table/incident" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodGet {
+			query = r.URL.Query().Get("sysparm_query")
+			_, _ = w.Write([]byte(`{"result":[]}`))
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&record)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"result":{"number":"INC0010001"}}`))
+	}))
+	defer server.Close()
+
+	snow, err := NewServiceNow(ServiceNowConfig{InstanceURL: server.URL, Username: "bot", Password: "pw", AssignmentGroup: "SRE"})
+	if err != nil {
+		t.Fatalf("new servicenow: %v", err)
+	}
+	creator, err := NewCreator(snow, Config{MinConfidence: 0.8, Tenants: []string{"acme"}})
+	if err != nil {
+		t.Fatalf("new creator: %v", err)
+	}
+	if err := creator.Notify(context.Background(), "globex", sampleResult()); err != nil || record != nil {
+		t.Fatalf("expected other tenants to be skipped, got %v %+v", err, record)
+	}
+	if err := creator.Notify(context.Background(), "acme", sampleResult()); err != nil {
+		t.Fatalf("notify: %v", err)
+	}
+	if query != "correlation_id=mirador-rca-corr-1" {
+		t.Fatalf("unexpected lookup query %q", query)
+	}
+	if record["correlation_id"] != "mirador-rca-corr-1" || record["assignment_group"] != "SRE" || record["short_description"] != "[RCA] payments: db connection pool exhausted (91%)" {
+		t.Fatalf("unexpected record: %+v", record)
+	}
+}
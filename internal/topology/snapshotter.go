@@ -0,0 +1,213 @@
+package topology
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+	"github.com/miradorstack/mirador-rca/internal/repo"
+)
+
+// Store persists service graph snapshots, reads them back, and deletes expired ones.
+type Store interface {
+	StoreTopologySnapshot(ctx context.Context, tenantID string, snapshot models.TopologySnapshot) error
+	TopologySnapshots(ctx context.Context, tenantID, environment string, start, end time.Time) ([]models.TopologySnapshot, error)
+	PurgeTopologySnapshots(ctx context.Context, tenantID string, before time.Time) error
+}
+
+// GraphSource fetches a tenant's service graph over a window, scoped to an environment.
+type GraphSource interface {
+	ServiceGraph(ctx context.Context, tenantID, environment string, window models.TimeRange) ([]repo.ServiceGraphEdge, error)
+}
+
+// Locker grants a key to a single caller until ttl expires; cache.Provider satisfies it through Valkey SETNX.
+type Locker interface {
+	SetNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error)
+}
+
+// Config controls snapshotting. Zero values take the defaults documented on each field.
+type Config struct {
+	// Interval between snapshots, and the window each one observes (default 5m).
+	Interval time.Duration
+	// Tenants are snapshotted from the first run, in each of Environments; other tenants are snapshotted in
+	// the environments they have been investigated in.
+	Tenants []string
+	// Environments of Tenants to snapshot; empty snapshots their unscoped graph.
+	Environments []string
+	// ScopeTTL stops snapshotting an environment that has not been investigated for this long (default 24h).
+	// Environments of Tenants are always snapshotted.
+	ScopeTTL time.Duration
+	// Retention deletes a tenant's snapshots older than this after each snapshot it stores (default 7d).
+	Retention time.Duration
+}
+
+// scope is one environment of a tenant.
+type scope struct {
+	tenantID    string
+	environment string
+}
+
+// Snapshotter stores each known tenant's service graph every Interval. Snapshots are taken at multiples of
+// Interval and every slot is claimed through the Locker first, so with a shared Valkey only one replica
+// stores a given snapshot.
+type Snapshotter struct {
+	logger   *slog.Logger
+	store    Store
+	locker   Locker
+	cfg      Config
+	instance string
+	now      func() time.Time
+
+	mu sync.Mutex
+	// scopes maps each snapshotted environment to when it was last investigated; configured ones map to the
+	// zero time and never expire.
+	scopes map[scope]time.Time
+}
+
+// NewSnapshotter builds a snapshotter storing into store. A nil locker lets every replica store its own
+// snapshots.
+func NewSnapshotter(logger *slog.Logger, store Store, locker Locker, cfg Config) *Snapshotter {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = 5 * time.Minute
+	}
+	if cfg.ScopeTTL <= 0 {
+		cfg.ScopeTTL = 24 * time.Hour
+	}
+	if cfg.Retention <= 0 {
+		cfg.Retention = 7 * 24 * time.Hour
+	}
+	environments := cfg.Environments
+	if len(environments) == 0 {
+		environments = []string{""}
+	}
+	s := &Snapshotter{
+		logger:   logger,
+		store:    store,
+		locker:   locker,
+		cfg:      cfg,
+		instance: strconv.FormatInt(time.Now().UnixNano(), 36),
+		now:      time.Now,
+		scopes:   map[scope]time.Time{},
+	}
+	for _, tenantID := range cfg.Tenants {
+		for _, environment := range environments {
+			s.scopes[scope{tenantID, environment}] = time.Time{}
+		}
+	}
+	return s
+}
+
+// TopologySnapshots returns the snapshots of environment captured between start and end, oldest first. The
+// environment is snapshotted from the next run on, until it goes uninvestigated for ScopeTTL.
+func (s *Snapshotter) TopologySnapshots(ctx context.Context, tenantID, environment string, start, end time.Time) ([]models.TopologySnapshot, error) {
+	s.mu.Lock()
+	sc := scope{tenantID, environment}
+	if lastUsed, ok := s.scopes[sc]; !ok || !lastUsed.IsZero() {
+		s.scopes[sc] = s.now()
+	}
+	s.mu.Unlock()
+	return s.store.TopologySnapshots(ctx, tenantID, environment, start, end)
+}
+
+// Run snapshots every known tenant environment from graph at the latest slot immediately and then at every
+// following slot until ctx is cancelled. The graph source is passed here rather than to NewSnapshotter since
+// the pipeline that fetches graphs reads snapshots back through the snapshotter.
+func (s *Snapshotter) Run(ctx context.Context, graph GraphSource) {
+	if s == nil {
+		return
+	}
+	for {
+		slot := s.now().Truncate(s.cfg.Interval)
+		idleSince := s.now().Add(-s.cfg.ScopeTTL)
+		s.mu.Lock()
+		scopes := make([]scope, 0, len(s.scopes))
+		for sc, lastUsed := range s.scopes {
+			if !lastUsed.IsZero() && lastUsed.Before(idleSince) {
+				delete(s.scopes, sc)
+				continue
+			}
+			scopes = append(scopes, sc)
+		}
+		s.mu.Unlock()
+		sort.Slice(scopes, func(i, j int) bool {
+			if scopes[i].tenantID != scopes[j].tenantID {
+				return scopes[i].tenantID < scopes[j].tenantID
+			}
+			return scopes[i].environment < scopes[j].environment
+		})
+		for _, sc := range scopes {
+			if _, err := s.Capture(ctx, graph, sc.tenantID, sc.environment, slot); err != nil && ctx.Err() == nil {
+				s.logger.Warn("topology snapshot failed",
+					slog.String("tenant_id", sc.tenantID),
+					slog.String("environment", sc.environment),
+					slog.Any("error", err),
+				)
+			}
+		}
+
+		timer := time.NewTimer(slot.Add(s.cfg.Interval).Sub(s.now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// Capture stores the service graph of the tenant environment over the Interval ending at slot, as graph
+// reports it, and then deletes the tenant's snapshots older than Retention. It returns false without error
+// when another replica already claimed the slot or the graph has no edges; an empty graph usually means the
+// backend had no data rather than that every dependency went away.
+func (s *Snapshotter) Capture(ctx context.Context, graph GraphSource, tenantID, environment string, slot time.Time) (bool, error) {
+	if s.locker != nil {
+		key := fmt.Sprintf("rca:topology:snapshot:%s:%s:%d", tenantID, environment, slot.Unix())
+		claimed, err := s.locker.SetNX(ctx, key, []byte(s.instance), s.cfg.Interval)
+		if err != nil {
+			return false, fmt.Errorf("claim snapshot slot: %w", err)
+		}
+		if !claimed {
+			return false, nil
+		}
+	}
+
+	edges, err := graph.ServiceGraph(ctx, tenantID, environment, models.TimeRange{Start: slot.Add(-s.cfg.Interval), End: slot})
+	if err != nil {
+		return false, fmt.Errorf("fetch service graph: %w", err)
+	}
+	snapshot := models.TopologySnapshot{Environment: environment, CapturedAt: slot.UTC()}
+	for _, edge := range edges {
+		if edge.Source == "" || edge.Target == "" {
+			continue
+		}
+		snapshot.Edges = append(snapshot.Edges, models.TopologyEdge{
+			Source:    edge.Source,
+			Target:    edge.Target,
+			CallRate:  edge.CallRate,
+			ErrorRate: edge.ErrorRate,
+		})
+	}
+	if len(snapshot.Edges) == 0 {
+		return false, nil
+	}
+	if err := s.store.StoreTopologySnapshot(ctx, tenantID, snapshot); err != nil {
+		return false, fmt.Errorf("store snapshot: %w", err)
+	}
+	if err := s.store.PurgeTopologySnapshots(ctx, tenantID, slot.Add(-s.cfg.Retention)); err != nil {
+		return true, fmt.Errorf("purge expired snapshots: %w", err)
+	}
+	s.logger.Debug("topology snapshot stored",
+		slog.String("tenant_id", tenantID),
+		slog.String("environment", environment),
+		slog.Int("edges", len(snapshot.Edges)),
+	)
+	return true, nil
+}
@@ -0,0 +1,120 @@
+package topology
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/cache"
+	"github.com/miradorstack/mirador-rca/internal/models"
+	"github.com/miradorstack/mirador-rca/internal/repo"
+)
+
+type graphStub struct {
+	edges   []repo.ServiceGraphEdge
+	windows []models.TimeRange
+	envs    []string
+}
+
+func (g *graphStub) ServiceGraph(ctx context.Context, tenantID, environment string, window models.TimeRange) ([]repo.ServiceGraphEdge, error) {
+	g.windows = append(g.windows, window)
+	g.envs = append(g.envs, environment)
+	return g.edges, nil
+}
+
+func TestSnapshotterCapturesClaimedSlots(t *testing.T) {
+	store, err := repo.NewMemoryRepo("")
+	if err != nil {
+		t.Fatalf("new memory repo: %v", err)
+	}
+	locks := cache.NewMemoryProvider()
+	graph := &graphStub{edges: []repo.ServiceGraphEdge{
+		{Source: "checkout", Target: "payments", CallRate: 12, ErrorRate: 1},
+		{Source: "", Target: "payments"},
+	}}
+	ctx := context.Background()
+	slot := time.Date(2024, 6, 1, 10, 5, 0, 0, time.UTC)
+
+	first := NewSnapshotter(nil, store, locks, Config{Tenants: []string{"acme"}})
+	second := NewSnapshotter(nil, store, locks, Config{})
+	if stored, err := first.Capture(ctx, graph, "acme", "", slot); err != nil || !stored {
+		t.Fatalf("expected the first replica to store the slot, got %v, %v", stored, err)
+	}
+	if stored, err := second.Capture(ctx, graph, "acme", "", slot); err != nil || stored {
+		t.Fatalf("expected the second replica to find the slot claimed, got %v, %v", stored, err)
+	}
+	if len(graph.windows) != 1 || !graph.windows[0].Start.Equal(slot.Add(-5*time.Minute)) || !graph.windows[0].End.Equal(slot) {
+		t.Fatalf("expected one fetch of the five minutes before the slot, got %+v", graph.windows)
+	}
+
+	snapshots, err := second.TopologySnapshots(ctx, "acme", "", slot.Add(-time.Hour), slot)
+	if err != nil || len(snapshots) != 1 {
+		t.Fatalf("expected one snapshot, got %+v, %v", snapshots, err)
+	}
+	if edges := snapshots[0].Edges; len(edges) != 1 || edges[0].Source != "checkout" || edges[0].CallRate != 12 || !snapshots[0].CapturedAt.Equal(slot) {
+		t.Fatalf("expected the named edge captured at the slot, got %+v", snapshots[0])
+	}
+
+	// An empty graph is not stored, so it cannot read as every dependency going away.
+	if stored, err := first.Capture(ctx, &graphStub{}, "acme", "", slot.Add(5*time.Minute)); err != nil || stored {
+		t.Fatalf("expected an empty graph to be skipped, got %v, %v", stored, err)
+	}
+
+	// Reading snapshots enrols the environment for the next run.
+	if _, err := first.TopologySnapshots(ctx, "globex", "prod", slot, slot); err != nil {
+		t.Fatalf("topology snapshots: %v", err)
+	}
+	first.locker = nil
+	first.now = func() time.Time { return slot.Add(12 * time.Minute) }
+	runCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	graph.envs = nil
+	first.Run(runCtx, graph)
+	if len(graph.envs) != 2 || graph.envs[0] != "" || graph.envs[1] != "prod" {
+		t.Fatalf("expected acme's unscoped graph and globex's prod graph, got %v", graph.envs)
+	}
+	if snapshots, _ := store.TopologySnapshots(ctx, "globex", "prod", slot, slot.Add(time.Hour)); len(snapshots) != 1 || !snapshots[0].CapturedAt.Equal(slot.Add(10*time.Minute)) {
+		t.Fatalf("expected globex snapshotted at the latest slot, got %+v", snapshots)
+	}
+}
+
+func TestSnapshotterExpiresIdleScopesAndOldSnapshots(t *testing.T) {
+	store, err := repo.NewMemoryRepo("")
+	if err != nil {
+		t.Fatalf("new memory repo: %v", err)
+	}
+	graph := &graphStub{edges: []repo.ServiceGraphEdge{{Source: "checkout", Target: "payments"}}}
+	ctx := context.Background()
+	start := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	s := NewSnapshotter(nil, store, nil, Config{Tenants: []string{"acme"}, ScopeTTL: time.Hour, Retention: 2 * time.Hour})
+
+	if _, err := s.Capture(ctx, graph, "acme", "", start); err != nil {
+		t.Fatalf("capture: %v", err)
+	}
+	if _, err := s.Capture(ctx, graph, "acme", "", start.Add(3*time.Hour)); err != nil {
+		t.Fatalf("capture: %v", err)
+	}
+	if snapshots, _ := store.TopologySnapshots(ctx, "acme", "", start.Add(-time.Hour), start.Add(4*time.Hour)); len(snapshots) != 1 || !snapshots[0].CapturedAt.Equal(start.Add(3*time.Hour)) {
+		t.Fatalf("expected only the snapshot within retention to remain, got %+v", snapshots)
+	}
+
+	now := start
+	s.now = func() time.Time { return now }
+	if _, err := s.TopologySnapshots(ctx, "globex", "pr-1234", start, start); err != nil {
+		t.Fatalf("topology snapshots: %v", err)
+	}
+	run := func() []string {
+		runCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		graph.envs = nil
+		s.Run(runCtx, graph)
+		return graph.envs
+	}
+	if envs := run(); len(envs) != 2 {
+		t.Fatalf("expected acme and the investigated globex environment, got %v", envs)
+	}
+	now = start.Add(2 * time.Hour)
+	if envs := run(); len(envs) != 1 || envs[0] != "" {
+		t.Fatalf("expected the idle globex environment to expire and configured acme to stay, got %v", envs)
+	}
+}
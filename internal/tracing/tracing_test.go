@@ -0,0 +1,56 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestStartEndAndInject(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous, previousPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer func() {
+		otel.SetTracerProvider(previous)
+		otel.SetTextMapPropagator(previousPropagator)
+	}()
+
+	ctx, parent := Start(context.Background(), "rca.investigate")
+	childCtx, child := StartClient(ctx, "mirador_core metrics")
+	header := http.Header{}
+	Inject(childCtx, propagation.HeaderCarrier(header))
+	End(child, errors.New("core unavailable"))
+	End(parent, nil)
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("expected two spans, got %d", len(spans))
+	}
+	client := spans[0]
+	if client.Name() != "mirador_core metrics" || client.SpanKind() != trace.SpanKindClient {
+		t.Fatalf("unexpected client span: %s %v", client.Name(), client.SpanKind())
+	}
+	if client.Parent().SpanID() != spans[1].SpanContext().SpanID() {
+		t.Fatalf("expected the client span to be a child of the investigation span")
+	}
+	if client.Status().Code != codes.Error || len(client.Events()) != 1 {
+		t.Fatalf("expected the error to be recorded, got %+v", client.Status())
+	}
+	if spans[1].Status().Code == codes.Error {
+		t.Fatalf("expected the parent span to succeed")
+	}
+	want := "00-" + client.SpanContext().TraceID().String() + "-" + client.SpanContext().SpanID().String() + "-01"
+	if got := header.Get("traceparent"); got != want {
+		t.Fatalf("traceparent = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,402 @@
+package tuning
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/extractors"
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+// History pages through stored correlations and the feedback analysts left on them.
+type History interface {
+	ListCorrelations(ctx context.Context, req models.ListCorrelationsRequest) (models.ListCorrelationsResponse, error)
+	ListFeedback(ctx context.Context, req models.ListFeedbackRequest) (models.ListFeedbackResponse, error)
+}
+
+// Config controls how thresholds are tuned. Zero values take the defaults documented on each field.
+type Config struct {
+	// Interval between tuning runs (default 6h).
+	Interval time.Duration
+	// Lookback is the history window analysed (default 14 days).
+	Lookback time.Duration
+	// MaxCorrelations bounds the correlations read per tenant and run (default 5000).
+	MaxCorrelations int
+	// MinCorrelations is how many correlations with metric anchors a service needs before it is tuned
+	// (default 10).
+	MinCorrelations int
+	// MinFeedback is how many of those correlations need a verdict, at least one of them wrong, before
+	// feedback rather than noise decides the threshold (default 5).
+	MinFeedback int
+	// TargetAnchors is the mean number of metric anchors per correlation a noise-based threshold allows
+	// (default 3).
+	TargetAnchors float64
+	// MinThreshold and MaxThreshold bound every recommendation (defaults 1.5 and 10).
+	MinThreshold float64
+	MaxThreshold float64
+	// Tenants are tuned from the first run; other tenants are tuned once they have been investigated.
+	Tenants []string
+}
+
+// Tuner recommends a per-service anomaly threshold for each tenant from the anomaly scores of its stored
+// correlations and, where analysts left enough verdicts, from which of them were right. Recommendations are
+// recomputed every Interval and kept in memory; every replica computes the same ones from the shared history.
+type Tuner struct {
+	logger  *slog.Logger
+	history History
+	cfg     Config
+	now     func() time.Time
+
+	mu      sync.RWMutex
+	tenants map[string]map[string]models.ThresholdRecommendation
+	seen    map[string]bool
+}
+
+// NewTuner builds a tuner over history.
+func NewTuner(logger *slog.Logger, history History, cfg Config) *Tuner {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = 6 * time.Hour
+	}
+	if cfg.Lookback <= 0 {
+		cfg.Lookback = 14 * 24 * time.Hour
+	}
+	if cfg.MaxCorrelations <= 0 {
+		cfg.MaxCorrelations = 5000
+	}
+	if cfg.MinCorrelations <= 0 {
+		cfg.MinCorrelations = 10
+	}
+	if cfg.MinFeedback <= 0 {
+		cfg.MinFeedback = 5
+	}
+	if cfg.TargetAnchors <= 0 {
+		cfg.TargetAnchors = 3
+	}
+	if cfg.MinThreshold <= 0 {
+		cfg.MinThreshold = 1.5
+	}
+	if cfg.MaxThreshold <= 0 {
+		cfg.MaxThreshold = 10
+	}
+	t := &Tuner{
+		logger:  logger,
+		history: history,
+		cfg:     cfg,
+		now:     time.Now,
+		tenants: map[string]map[string]models.ThresholdRecommendation{},
+		seen:    map[string]bool{},
+	}
+	for _, tenantID := range cfg.Tenants {
+		t.seen[tenantID] = true
+	}
+	return t
+}
+
+// Threshold returns the tuned threshold of service for tenantID, and false when it has not been tuned. The
+// tenant is tuned from the next run on.
+func (t *Tuner) Threshold(tenantID, service string) (float64, bool) {
+	if t == nil {
+		return 0, false
+	}
+	t.mu.RLock()
+	rec, ok := t.tenants[tenantID][service]
+	seen := t.seen[tenantID]
+	t.mu.RUnlock()
+	if !seen {
+		t.mu.Lock()
+		t.seen[tenantID] = true
+		t.mu.Unlock()
+	}
+	return rec.Threshold, ok
+}
+
+// Recommendations returns the tenant's recommendations ordered by service, tuning it first when refresh is
+// set or it has not been tuned yet.
+func (t *Tuner) Recommendations(ctx context.Context, tenantID string, refresh bool) ([]models.ThresholdRecommendation, error) {
+	t.mu.RLock()
+	tuned, ok := t.tenants[tenantID]
+	t.mu.RUnlock()
+	if refresh || !ok {
+		var err error
+		if tuned, err = t.Tune(ctx, tenantID); err != nil {
+			return nil, err
+		}
+	}
+	recs := make([]models.ThresholdRecommendation, 0, len(tuned))
+	for _, rec := range tuned {
+		recs = append(recs, rec)
+	}
+	sort.Slice(recs, func(i, j int) bool { return recs[i].Service < recs[j].Service })
+	return recs, nil
+}
+
+// Run tunes every known tenant immediately and then every Interval until ctx is cancelled.
+func (t *Tuner) Run(ctx context.Context) {
+	if t == nil {
+		return
+	}
+	ticker := time.NewTicker(t.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		t.mu.RLock()
+		tenantIDs := make([]string, 0, len(t.seen))
+		for tenantID := range t.seen {
+			tenantIDs = append(tenantIDs, tenantID)
+		}
+		t.mu.RUnlock()
+		sort.Strings(tenantIDs)
+		for _, tenantID := range tenantIDs {
+			if _, err := t.Tune(ctx, tenantID); err != nil && ctx.Err() == nil {
+				t.logger.Warn("threshold tuning failed", slog.String("tenant_id", tenantID), slog.Any("error", err))
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tune recomputes the tenant's recommendations from the lookback window and keeps them.
+func (t *Tuner) Tune(ctx context.Context, tenantID string) (map[string]models.ThresholdRecommendation, error) {
+	end := t.now()
+	start := end.Add(-t.cfg.Lookback)
+	correlations, err := t.listCorrelations(ctx, tenantID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	verdicts, err := t.listVerdicts(ctx, tenantID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	tuned := t.recommend(correlations, verdicts, end)
+
+	t.mu.Lock()
+	t.tenants[tenantID] = tuned
+	t.seen[tenantID] = true
+	t.mu.Unlock()
+	t.logger.Info("anomaly thresholds tuned",
+		slog.String("tenant_id", tenantID),
+		slog.Int("correlations", len(correlations)),
+		slog.Int("services", len(tuned)),
+	)
+	return tuned, nil
+}
+
+// observation is one correlation's metric anchors on a service, with the analysts' verdict when there is one.
+type observation struct {
+	scores  []float64
+	verdict *bool
+}
+
+func (o observation) top() float64 {
+	top := 0.0
+	for _, score := range o.scores {
+		top = max(top, score)
+	}
+	return top
+}
+
+// recommend tunes every service with enough correlations.
+func (t *Tuner) recommend(correlations []models.CorrelationResult, verdicts map[string]bool, now time.Time) map[string]models.ThresholdRecommendation {
+	byService := map[string][]observation{}
+	current := map[string]float64{}
+	for _, corr := range correlations {
+		var verdict *bool
+		if correct, ok := verdicts[corr.CorrelationID]; ok {
+			verdict = &correct
+		}
+		perService := map[string]*observation{}
+		for _, anchor := range corr.RedAnchors {
+			if anchor.DataType != models.DataTypeMetrics || anchor.Service == "" {
+				continue
+			}
+			obs, ok := perService[anchor.Service]
+			if !ok {
+				obs = &observation{verdict: verdict}
+				perService[anchor.Service] = obs
+			}
+			obs.scores = append(obs.scores, anchor.AnomalyScore)
+			threshold := anchor.Threshold
+			if threshold <= 0 {
+				threshold = extractors.DefaultMetricThreshold
+			}
+			if floor, ok := current[anchor.Service]; !ok || threshold < floor {
+				current[anchor.Service] = threshold
+			}
+		}
+		for service, obs := range perService {
+			byService[service] = append(byService[service], *obs)
+		}
+	}
+
+	tuned := make(map[string]models.ThresholdRecommendation, len(byService))
+	for service, observations := range byService {
+		if len(observations) < t.cfg.MinCorrelations {
+			continue
+		}
+		rec := models.ThresholdRecommendation{
+			Service:               service,
+			Current:               current[service],
+			Correlations:          len(observations),
+			AnchorsPerCorrelation: meanAnchors(observations, current[service]),
+			UpdatedAt:             now.UTC(),
+		}
+		labelled, wrong := 0, 0
+		for _, obs := range observations {
+			if obs.verdict != nil {
+				labelled++
+				if !*obs.verdict {
+					wrong++
+				}
+			}
+		}
+		rec.Feedback = labelled
+		if labelled >= t.cfg.MinFeedback && wrong > 0 {
+			rec.Basis = models.ThresholdBasisFeedback
+			rec.Threshold = feedbackThreshold(observations, rec.Current)
+		} else {
+			rec.Basis = models.ThresholdBasisNoise
+			rec.Threshold = noiseThreshold(observations, rec.Current, t.cfg.TargetAnchors)
+		}
+		rec.Threshold = math.Min(math.Max(rec.Threshold, t.cfg.MinThreshold), t.cfg.MaxThreshold)
+		rec.ExpectedAnchors = meanAnchors(observations, rec.Threshold)
+		tuned[service] = rec
+	}
+	return tuned
+}
+
+// feedbackThreshold picks the threshold that best separates correct from wrong correlations: a correlation is
+// kept when its top metric anchor reaches the threshold, and the candidate with the best F1 score over the
+// labelled correlations wins, the lowest on a tie. Thresholds below current cannot be judged, since nothing
+// below it was detected.
+func feedbackThreshold(observations []observation, current float64) float64 {
+	candidates := []float64{current}
+	for _, obs := range observations {
+		if obs.verdict != nil && obs.top() > current {
+			candidates = append(candidates, obs.top())
+		}
+	}
+	sort.Float64s(candidates)
+
+	best, bestScore := current, -1.0
+	for _, candidate := range candidates {
+		tp, fp, fn := 0, 0, 0
+		for _, obs := range observations {
+			if obs.verdict == nil {
+				continue
+			}
+			kept := obs.top() >= candidate
+			switch {
+			case kept && *obs.verdict:
+				tp++
+			case kept:
+				fp++
+			case *obs.verdict:
+				fn++
+			}
+		}
+		score := 0.0
+		if tp > 0 {
+			score = float64(2*tp) / float64(2*tp+fp+fn)
+		}
+		if score > bestScore {
+			best, bestScore = candidate, score
+		}
+	}
+	return math.Floor(best*100) / 100
+}
+
+// noiseThreshold keeps current when the service averages at most target metric anchors per correlation, and
+// otherwise raises the threshold, in steps of 0.1, just far enough to bring the average within target.
+func noiseThreshold(observations []observation, current, target float64) float64 {
+	var scores []float64
+	for _, obs := range observations {
+		scores = append(scores, obs.scores...)
+	}
+	allowed := int(target * float64(len(observations)))
+	if len(scores) <= allowed {
+		return current
+	}
+	sort.Sort(sort.Reverse(sort.Float64Slice(scores)))
+	// Every score above the allowed count must fall below the threshold.
+	return math.Max(current, (math.Floor(scores[allowed]*10)+1)/10)
+}
+
+// meanAnchors is the mean number of metric anchors per correlation scoring at least threshold.
+func meanAnchors(observations []observation, threshold float64) float64 {
+	kept := 0
+	for _, obs := range observations {
+		for _, score := range obs.scores {
+			if score >= threshold {
+				kept++
+			}
+		}
+	}
+	return math.Round(float64(kept)/float64(len(observations))*100) / 100
+}
+
+func (t *Tuner) listCorrelations(ctx context.Context, tenantID string, start, end time.Time) ([]models.CorrelationResult, error) {
+	var correlations []models.CorrelationResult
+	pageToken := ""
+	for len(correlations) < t.cfg.MaxCorrelations {
+		resp, err := t.history.ListCorrelations(ctx, models.ListCorrelationsRequest{
+			TenantID:  tenantID,
+			Start:     start,
+			End:       end,
+			PageSize:  100,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list correlations: %w", err)
+		}
+		correlations = append(correlations, resp.Correlations...)
+		if resp.NextPageToken == "" || resp.NextPageToken == pageToken {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+	if len(correlations) > t.cfg.MaxCorrelations {
+		correlations = correlations[:t.cfg.MaxCorrelations]
+	}
+	return correlations, nil
+}
+
+// listVerdicts returns the latest verdict on each correlation reviewed in the window.
+func (t *Tuner) listVerdicts(ctx context.Context, tenantID string, start, end time.Time) (map[string]bool, error) {
+	verdicts := map[string]bool{}
+	pageToken := ""
+	for read := 0; read < t.cfg.MaxCorrelations; {
+		resp, err := t.history.ListFeedback(ctx, models.ListFeedbackRequest{
+			TenantID:  tenantID,
+			Start:     start,
+			End:       end,
+			PageSize:  100,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list feedback: %w", err)
+		}
+		for _, fb := range resp.Feedback {
+			// Feedback is listed newest first, so the first verdict seen is the latest.
+			if _, ok := verdicts[fb.CorrelationID]; !ok && fb.CorrelationID != "" {
+				verdicts[fb.CorrelationID] = fb.Correct
+			}
+		}
+		read += len(resp.Feedback)
+		if resp.NextPageToken == "" || resp.NextPageToken == pageToken {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+	return verdicts, nil
+}
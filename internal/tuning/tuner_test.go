@@ -0,0 +1,102 @@
+package tuning
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/miradorstack/mirador-rca/internal/models"
+)
+
+type historyStub struct {
+	correlations []models.CorrelationResult
+	feedback     []models.Feedback
+}
+
+func (h *historyStub) ListCorrelations(ctx context.Context, req models.ListCorrelationsRequest) (models.ListCorrelationsResponse, error) {
+	return models.ListCorrelationsResponse{Correlations: h.correlations}, nil
+}
+
+func (h *historyStub) ListFeedback(ctx context.Context, req models.ListFeedbackRequest) (models.ListFeedbackResponse, error) {
+	return models.ListFeedbackResponse{Feedback: h.feedback}, nil
+}
+
+// correlation returns a correlation with one metric anchor on service per score, detected at 2.5.
+func correlation(id, service string, scores ...float64) models.CorrelationResult {
+	corr := models.CorrelationResult{CorrelationID: id}
+	for i, score := range scores {
+		corr.RedAnchors = append(corr.RedAnchors, models.RedAnchor{
+			Service:      service,
+			Selector:     fmt.Sprintf("metrics:m%d", i),
+			DataType:     models.DataTypeMetrics,
+			AnomalyScore: score,
+			Threshold:    2.5,
+		})
+	}
+	return corr
+}
+
+func TestTunerRaisesNoisyServices(t *testing.T) {
+	history := &historyStub{}
+	for i := 0; i < 10; i++ {
+		// checkout carries five anchors per correlation; payments one.
+		history.correlations = append(history.correlations,
+			correlation(fmt.Sprintf("c%d", i), "checkout", 2.6, 2.7, 2.8, 4.0, 5.0),
+			correlation(fmt.Sprintf("p%d", i), "payments", 3.0),
+		)
+	}
+	history.correlations = append(history.correlations, correlation("rare", "search", 9))
+	tuner := NewTuner(nil, history, Config{TargetAnchors: 2})
+
+	recs, err := tuner.Recommendations(context.Background(), "acme", false)
+	if err != nil {
+		t.Fatalf("recommendations: %v", err)
+	}
+	if len(recs) != 2 || recs[0].Service != "checkout" || recs[1].Service != "payments" {
+		t.Fatalf("expected checkout and payments to be tuned, got %+v", recs)
+	}
+	checkout := recs[0]
+	if checkout.Basis != models.ThresholdBasisNoise || checkout.Threshold != 2.9 || checkout.Current != 2.5 {
+		t.Fatalf("expected checkout raised to 2.9, got %+v", checkout)
+	}
+	if checkout.AnchorsPerCorrelation != 5 || checkout.ExpectedAnchors != 2 {
+		t.Fatalf("expected 5 anchors per correlation cut to 2, got %+v", checkout)
+	}
+	if recs[1].Threshold != 2.5 {
+		t.Fatalf("expected payments to keep its threshold, got %+v", recs[1])
+	}
+
+	if threshold, ok := tuner.Threshold("acme", "checkout"); !ok || threshold != 2.9 {
+		t.Fatalf("expected the tuned checkout threshold, got %v %v", threshold, ok)
+	}
+	if _, ok := tuner.Threshold("globex", "checkout"); ok {
+		t.Fatalf("expected no threshold for an untuned tenant")
+	}
+	if !tuner.seen["globex"] {
+		t.Fatalf("expected the looked-up tenant to be tuned from the next run")
+	}
+}
+
+func TestTunerUsesFeedback(t *testing.T) {
+	history := &historyStub{}
+	for i := 0; i < 12; i++ {
+		id := fmt.Sprintf("c%d", i)
+		// Correlations whose top anomaly stays below 4 were judged wrong; the rest right.
+		top := 3.0 + float64(i%4)*0.5
+		history.correlations = append(history.correlations, correlation(id, "checkout", top))
+		history.feedback = append(history.feedback, models.Feedback{CorrelationID: id, Correct: top >= 4})
+	}
+	// An older contrary verdict on c3 is superseded by the newer one listed first.
+	history.feedback = append(history.feedback, models.Feedback{CorrelationID: "c3", Correct: false})
+
+	recs, err := NewTuner(nil, history, Config{}).Recommendations(context.Background(), "acme", true)
+	if err != nil {
+		t.Fatalf("recommendations: %v", err)
+	}
+	if len(recs) != 1 || recs[0].Basis != models.ThresholdBasisFeedback || recs[0].Feedback != 12 {
+		t.Fatalf("expected a feedback-based recommendation, got %+v", recs)
+	}
+	if recs[0].Threshold != 4 {
+		t.Fatalf("expected the threshold to separate right from wrong at 4, got %v", recs[0].Threshold)
+	}
+}
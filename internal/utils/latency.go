@@ -1,96 +1,246 @@
 package utils
 
 import (
-	"sort"
+	"math/bits"
 	"sync"
 	"time"
 )
 
-// LatencyTracker stores recent duration samples and computes percentiles.
+// DefaultLatencyWindow is how far back a LatencyTracker looks when none is given.
+const DefaultLatencyWindow = 5 * time.Minute
+
+// Samples are bucketed in microseconds on a log-linear scale, as in HDR histograms: values below
+// 2*latencySubBuckets are exact, and every power of two above that is split into latencySubBuckets buckets,
+// so a reported percentile is within 1/(2*latencySubBuckets) (under 1%) of the true value. Samples above
+// latencyMaxMicros are clamped.
+const (
+	latencySubBits    = 6
+	latencySubBuckets = 1 << latencySubBits
+	latencyMaxMicros  = 1<<32 - 1
+	latencyBuckets    = 2*latencySubBuckets + (32-latencySubBits-1)*latencySubBuckets
+	latencySlots      = 10
+)
+
+// LatencyTracker keeps a sliding window of duration samples in a fixed-size histogram. Observe is O(1)
+// and Percentile scans the buckets without copying or sorting samples.
 type LatencyTracker struct {
-	mu      sync.RWMutex
-	samples []time.Duration
-	maxSize int
+	mu    sync.Mutex
+	width time.Duration
+	slots [latencySlots]latencySlot
+	now   func() time.Time
+}
+
+// latencySlot holds the samples of one width-long slice of the window.
+type latencySlot struct {
+	epoch  int64
+	count  uint64
+	max    time.Duration
+	counts []uint32
+}
+
+// LatencySummary reports the percentiles of a window of samples.
+type LatencySummary struct {
+	Count int     `json:"count"`
+	P50Ms float64 `json:"p50_ms"`
+	P95Ms float64 `json:"p95_ms"`
+	P99Ms float64 `json:"p99_ms"`
+	MaxMs float64 `json:"max_ms"`
 }
 
-// NewLatencyTracker creates a tracker storing up to maxSize samples.
-func NewLatencyTracker(maxSize int) *LatencyTracker {
-	if maxSize <= 0 {
-		maxSize = 512
+// NewLatencyTracker creates a tracker over the last window of samples; zero means DefaultLatencyWindow.
+func NewLatencyTracker(window time.Duration) *LatencyTracker {
+	if window <= 0 {
+		window = DefaultLatencyWindow
+	}
+	width := window / latencySlots
+	if width <= 0 {
+		width = 1
 	}
-	return &LatencyTracker{maxSize: maxSize}
+	return &LatencyTracker{width: width, now: time.Now}
 }
 
 // Observe records a new duration.
 func (l *LatencyTracker) Observe(d time.Duration) {
+	if d < 0 {
+		d = 0
+	}
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
-	l.samples = append(l.samples, d)
-	if len(l.samples) > l.maxSize {
-		// Drop oldest sample to bound memory.
-		copy(l.samples[0:], l.samples[1:])
-		l.samples = l.samples[:l.maxSize]
+	epoch := l.now().UnixNano() / int64(l.width)
+	slot := &l.slots[epoch%latencySlots]
+	if slot.epoch != epoch || slot.counts == nil {
+		slot.reset(epoch)
 	}
+	slot.counts[latencyBucket(d)]++
+	slot.count++
+	slot.max = max(slot.max, d)
 }
 
-// Percentile returns the percentile (0-100) duration. Returns zero if no samples.
+// Percentile returns the percentile (0-100) duration of the samples in the window. Returns zero if no samples.
 func (l *LatencyTracker) Percentile(p float64) time.Duration {
-	l.mu.RLock()
-	defer l.mu.RUnlock()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.percentilesLocked(p)[0]
+}
 
-	if len(l.samples) == 0 {
-		return 0
+// Count returns the number of samples in the window.
+func (l *LatencyTracker) Count() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var count uint64
+	for _, slot := range l.liveSlots() {
+		count += slot.count
 	}
-	if p <= 0 {
-		return l.min()
+	return int(count)
+}
+
+// Summary returns the sample count, p50, p95, p99, and maximum of the window.
+func (l *LatencyTracker) Summary() LatencySummary {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	values := l.percentilesLocked(50, 95, 99, 100)
+	summary := LatencySummary{
+		P50Ms: milliseconds(values[0]),
+		P95Ms: milliseconds(values[1]),
+		P99Ms: milliseconds(values[2]),
+		MaxMs: milliseconds(values[3]),
 	}
-	if p >= 100 {
-		return l.max()
+	for _, slot := range l.liveSlots() {
+		summary.Count += int(slot.count)
 	}
+	return summary
+}
 
-	sorted := append([]time.Duration(nil), l.samples...)
-	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+// percentilesLocked answers several percentiles, given in ascending order, with one pass over the buckets.
+func (l *LatencyTracker) percentilesLocked(ps ...float64) []time.Duration {
+	out := make([]time.Duration, len(ps))
+	live := l.liveSlots()
+	var total uint64
+	var largest time.Duration
+	for _, slot := range live {
+		total += slot.count
+		largest = max(largest, slot.max)
+	}
+	if total == 0 {
+		return out
+	}
 
-	index := int((p / 100.0) * float64(len(sorted)-1))
-	if index < 0 {
-		index = 0
+	next := 0
+	var seen uint64
+	for bucket := 0; bucket < latencyBuckets && next < len(ps) && ps[next] < 100; bucket++ {
+		for _, slot := range live {
+			seen += uint64(slot.counts[bucket])
+		}
+		for next < len(ps) && ps[next] < 100 && seen > 0 && float64(seen) >= ps[next]/100*float64(total) {
+			out[next] = min(latencyValue(bucket), largest)
+			next++
+		}
 	}
-	if index >= len(sorted) {
-		index = len(sorted) - 1
+	// The 100th percentile is the exact maximum rather than its bucket.
+	for ; next < len(ps); next++ {
+		out[next] = largest
 	}
-	return sorted[index]
+	return out
 }
 
-// Count returns number of samples recorded.
-func (l *LatencyTracker) Count() int {
-	l.mu.RLock()
-	defer l.mu.RUnlock()
-	return len(l.samples)
+// liveSlots returns the slots whose samples fall inside the window.
+func (l *LatencyTracker) liveSlots() []*latencySlot {
+	epoch := l.now().UnixNano() / int64(l.width)
+	live := make([]*latencySlot, 0, latencySlots)
+	for i := range l.slots {
+		if slot := &l.slots[i]; slot.counts != nil && epoch-slot.epoch < latencySlots {
+			live = append(live, slot)
+		}
+	}
+	return live
 }
 
-func (l *LatencyTracker) min() time.Duration {
-	if len(l.samples) == 0 {
-		return 0
+func (s *latencySlot) reset(epoch int64) {
+	if s.counts == nil {
+		s.counts = make([]uint32, latencyBuckets)
+	} else {
+		clear(s.counts)
 	}
-	min := l.samples[0]
-	for _, s := range l.samples[1:] {
-		if s < min {
-			min = s
-		}
+	s.epoch = epoch
+	s.count = 0
+	s.max = 0
+}
+
+// latencyBucket maps d to its histogram bucket.
+func latencyBucket(d time.Duration) int {
+	v := uint64(d / time.Microsecond)
+	if v > latencyMaxMicros {
+		v = latencyMaxMicros
+	}
+	if v < 2*latencySubBuckets {
+		return int(v)
 	}
-	return min
+	shift := bits.Len64(v) - latencySubBits - 1
+	return 2*latencySubBuckets + (shift-1)*latencySubBuckets + int(v>>shift) - latencySubBuckets
 }
 
-func (l *LatencyTracker) max() time.Duration {
-	if len(l.samples) == 0 {
-		return 0
+// latencyValue returns the midpoint of bucket, the inverse of latencyBucket.
+func latencyValue(bucket int) time.Duration {
+	if bucket < 2*latencySubBuckets {
+		return time.Duration(bucket) * time.Microsecond
 	}
-	max := l.samples[0]
-	for _, s := range l.samples[1:] {
-		if s > max {
-			max = s
+	shift := (bucket-2*latencySubBuckets)/latencySubBuckets + 1
+	mantissa := uint64((bucket-2*latencySubBuckets)%latencySubBuckets + latencySubBuckets)
+	lower := mantissa << shift
+	return time.Duration(lower+(uint64(1)<<shift)/2) * time.Microsecond
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// MethodLatencies keeps a LatencyTracker per RPC method.
+type MethodLatencies struct {
+	mu       sync.RWMutex
+	window   time.Duration
+	trackers map[string]*LatencyTracker
+}
+
+// NewMethodLatencies creates per-method trackers over the last window of samples; zero means
+// DefaultLatencyWindow.
+func NewMethodLatencies(window time.Duration) *MethodLatencies {
+	if window <= 0 {
+		window = DefaultLatencyWindow
+	}
+	return &MethodLatencies{window: window, trackers: make(map[string]*LatencyTracker)}
+}
+
+// Window returns how far back the trackers look.
+func (m *MethodLatencies) Window() time.Duration {
+	return m.window
+}
+
+// Observe records a duration for method.
+func (m *MethodLatencies) Observe(method string, d time.Duration) {
+	m.mu.RLock()
+	tracker, ok := m.trackers[method]
+	m.mu.RUnlock()
+	if !ok {
+		m.mu.Lock()
+		if tracker, ok = m.trackers[method]; !ok {
+			tracker = NewLatencyTracker(m.window)
+			m.trackers[method] = tracker
 		}
+		m.mu.Unlock()
+	}
+	tracker.Observe(d)
+}
+
+// Summary returns the window summary of every observed method.
+func (m *MethodLatencies) Summary() map[string]LatencySummary {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	summaries := make(map[string]LatencySummary, len(m.trackers))
+	for method, tracker := range m.trackers {
+		summaries[method] = tracker.Summary()
 	}
-	return max
+	return summaries
 }
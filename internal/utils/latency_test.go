@@ -6,7 +6,7 @@ import (
 )
 
 func TestLatencyTrackerPercentile(t *testing.T) {
-	tracker := NewLatencyTracker(10)
+	tracker := NewLatencyTracker(time.Minute)
 	durations := []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 30 * time.Millisecond, 40 * time.Millisecond, 50 * time.Millisecond}
 	for _, d := range durations {
 		tracker.Observe(d)
@@ -22,12 +22,72 @@ func TestLatencyTrackerPercentile(t *testing.T) {
 	}
 }
 
-func TestLatencyTrackerBoundedSize(t *testing.T) {
-	tracker := NewLatencyTracker(3)
-	for i := 0; i < 10; i++ {
+func TestLatencyTrackerAccuracy(t *testing.T) {
+	tracker := NewLatencyTracker(time.Minute)
+	for i := 1; i <= 10000; i++ {
 		tracker.Observe(time.Duration(i) * time.Millisecond)
 	}
-	if tracker.Count() != 3 {
-		t.Fatalf("expected tracker size 3, got %d", tracker.Count())
+
+	for _, tc := range []struct {
+		p    float64
+		want time.Duration
+	}{{50, 5 * time.Second}, {95, 9500 * time.Millisecond}, {99, 9900 * time.Millisecond}} {
+		got := tracker.Percentile(tc.p)
+		if diff := got - tc.want; diff < -tc.want/100 || diff > tc.want/100 {
+			t.Fatalf("p%v: expected %v within 1%%, got %v", tc.p, tc.want, got)
+		}
+	}
+	if summary := tracker.Summary(); summary.Count != 10000 || summary.MaxMs != 10000 {
+		t.Fatalf("unexpected summary: %+v", summary)
+	}
+}
+
+func TestLatencyTrackerWindow(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	tracker := NewLatencyTracker(10 * time.Second)
+	tracker.now = func() time.Time { return now }
+
+	tracker.Observe(time.Second)
+	now = now.Add(5 * time.Second)
+	tracker.Observe(10 * time.Millisecond)
+	if tracker.Count() != 2 || tracker.Percentile(100) != time.Second {
+		t.Fatalf("expected both samples in the window, got %d (max %v)", tracker.Count(), tracker.Percentile(100))
+	}
+
+	now = now.Add(6 * time.Second)
+	if tracker.Count() != 1 || tracker.Percentile(99) > 11*time.Millisecond {
+		t.Fatalf("expected the old sample to age out, got %d (p99 %v)", tracker.Count(), tracker.Percentile(99))
+	}
+
+	now = now.Add(time.Minute)
+	if tracker.Count() != 0 || tracker.Percentile(50) != 0 {
+		t.Fatalf("expected an empty window, got %d", tracker.Count())
+	}
+}
+
+func TestLatencyBucketsRoundTrip(t *testing.T) {
+	for _, d := range []time.Duration{0, 127 * time.Microsecond, 128 * time.Microsecond, 3 * time.Millisecond, 750 * time.Millisecond, 42 * time.Second, time.Hour} {
+		got := latencyValue(latencyBucket(d))
+		if diff := got - d; diff < -d/100 || diff > d/100 {
+			t.Fatalf("%v: bucket value %v is off by more than 1%%", d, got)
+		}
+	}
+	if bucket := latencyBucket(1000 * time.Hour); bucket != latencyBuckets-1 {
+		t.Fatalf("expected large samples to clamp to the last bucket, got %d", bucket)
+	}
+}
+
+func TestMethodLatencies(t *testing.T) {
+	latencies := NewMethodLatencies(0)
+	latencies.Observe("/rca.v1.RCAEngine/InvestigateIncident", 2*time.Second)
+	latencies.Observe("/rca.v1.RCAEngine/ListCorrelations", 20*time.Millisecond)
+	latencies.Observe("/rca.v1.RCAEngine/ListCorrelations", 40*time.Millisecond)
+
+	summary := latencies.Summary()
+	if len(summary) != 2 || summary["/rca.v1.RCAEngine/ListCorrelations"].Count != 2 || summary["/rca.v1.RCAEngine/InvestigateIncident"].MaxMs != 2000 {
+		t.Fatalf("unexpected summary: %+v", summary)
+	}
+	if latencies.Window() != DefaultLatencyWindow {
+		t.Fatalf("expected the default window, got %v", latencies.Window())
 	}
 }
@@ -1,29 +1,139 @@
 package utils
 
 import (
+	"context"
+	"fmt"
 	"log/slog"
+	"maps"
 	"os"
 	"strings"
+	"sync"
+	"sync/atomic"
 )
 
+// ModuleKey is the attribute that names a component's logger, as in logger.With(ModuleKey, "kafka"); Levels
+// can give each module its own verbosity.
+const ModuleKey = "module"
+
 // NewLogger returns a slog.Logger configured for the desired verbosity and format.
 func NewLogger(level string, json bool) *slog.Logger {
-	handlerLevel := slog.LevelInfo
-	switch strings.ToLower(level) {
-	case "debug":
-		handlerLevel = slog.LevelDebug
-	case "warn":
-		handlerLevel = slog.LevelWarn
-	case "error":
-		handlerLevel = slog.LevelError
+	logger, _ := NewLeveledLogger(level, json)
+	return logger
+}
+
+// NewLeveledLogger is NewLogger with verbosity that can be changed at runtime, globally or per module,
+// through the returned Levels; loggers derived with With share it. Unknown levels fall back to info.
+func NewLeveledLogger(level string, json bool) (*slog.Logger, *Levels) {
+	levels := &Levels{}
+	if parsed, err := ParseLevel(level); err == nil {
+		levels.Set(parsed)
 	}
 
+	// The wrapped handler never filters: slog consults only the outermost handler's Enabled.
 	var handler slog.Handler
 	if json {
-		handler = slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: handlerLevel})
+		handler = slog.NewJSONHandler(os.Stdout, nil)
 	} else {
-		handler = slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: handlerLevel})
+		handler = slog.NewTextHandler(os.Stdout, nil)
+	}
+
+	return slog.New(&moduleHandler{next: handler, levels: levels}), levels
+}
+
+// Levels holds the global log level and per-module overrides.
+type Levels struct {
+	global  slog.LevelVar
+	mu      sync.Mutex
+	modules atomic.Pointer[map[string]slog.Level]
+}
+
+// Set changes the global level.
+func (l *Levels) Set(level slog.Level) {
+	l.global.Set(level)
+}
+
+// Level returns the global level.
+func (l *Levels) Level() slog.Level {
+	return l.global.Level()
+}
+
+// SetModule overrides the level of loggers tagged with module.
+func (l *Levels) SetModule(module string, level slog.Level) {
+	l.updateModules(func(modules map[string]slog.Level) { modules[module] = level })
+}
+
+// ClearModule drops the override of module so it follows the global level again.
+func (l *Levels) ClearModule(module string) {
+	l.updateModules(func(modules map[string]slog.Level) { delete(modules, module) })
+}
+
+// Modules returns a copy of the per-module overrides.
+func (l *Levels) Modules() map[string]slog.Level {
+	if modules := l.modules.Load(); modules != nil {
+		return maps.Clone(*modules)
 	}
+	return map[string]slog.Level{}
+}
 
-	return slog.New(handler)
+// For returns the level in effect for module.
+func (l *Levels) For(module string) slog.Level {
+	if modules := l.modules.Load(); modules != nil && module != "" {
+		if level, ok := (*modules)[module]; ok {
+			return level
+		}
+	}
+	return l.global.Level()
+}
+
+func (l *Levels) updateModules(update func(map[string]slog.Level)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	next := l.Modules()
+	update(next)
+	l.modules.Store(&next)
+}
+
+// moduleHandler filters records by the level of the module its logger was derived for.
+type moduleHandler struct {
+	next   slog.Handler
+	levels *Levels
+	module string
+}
+
+func (h *moduleHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.levels.For(h.module)
+}
+
+func (h *moduleHandler) Handle(ctx context.Context, record slog.Record) error {
+	return h.next.Handle(ctx, record)
+}
+
+func (h *moduleHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	module := h.module
+	for _, attr := range attrs {
+		if attr.Key == ModuleKey {
+			module = attr.Value.String()
+		}
+	}
+	return &moduleHandler{next: h.next.WithAttrs(attrs), levels: h.levels, module: module}
+}
+
+func (h *moduleHandler) WithGroup(name string) slog.Handler {
+	return &moduleHandler{next: h.next.WithGroup(name), levels: h.levels, module: h.module}
+}
+
+// ParseLevel maps debug, info, warn, or error (case-insensitive) to a slog level; empty means info.
+func ParseLevel(level string) (slog.Level, error) {
+	switch strings.ToLower(level) {
+	case "", "info":
+		return slog.LevelInfo, nil
+	case "debug":
+		return slog.LevelDebug, nil
+	case "warn":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	default:
+		return slog.LevelInfo, fmt.Errorf("unknown log level %q", level)
+	}
 }
@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestModuleLevels(t *testing.T) {
+	var buf bytes.Buffer
+	levels := &Levels{}
+	logger := slog.New(&moduleHandler{next: slog.NewTextHandler(&buf, nil), levels: levels})
+	kafka := logger.With(slog.String(ModuleKey, "kafka"))
+	engine := logger.With(slog.String(ModuleKey, "engine")).WithGroup("req")
+
+	levels.SetModule("kafka", slog.LevelDebug)
+	kafka.Debug("kafka detail")
+	engine.Debug("engine detail")
+	logger.Info("global info")
+	if out := buf.String(); !strings.Contains(out, "kafka detail") || strings.Contains(out, "engine detail") || !strings.Contains(out, "global info") {
+		t.Fatalf("expected only the kafka module at debug, got:\n%s", out)
+	}
+
+	buf.Reset()
+	levels.ClearModule("kafka")
+	levels.Set(slog.LevelWarn)
+	kafka.Info("kafka info")
+	engine.Warn("engine warn")
+	if out := buf.String(); strings.Contains(out, "kafka info") || !strings.Contains(out, "engine warn") {
+		t.Fatalf("expected modules to follow the global level once cleared, got:\n%s", out)
+	}
+	if len(levels.Modules()) != 0 || levels.For("kafka") != slog.LevelWarn {
+		t.Fatalf("unexpected levels: %v %v", levels.Modules(), levels.For("kafka"))
+	}
+}
@@ -1,4 +1,47 @@
 package version
 
-// Commit holds the git describe output injected at build time.
-var Commit = "dev"
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+// Version, Commit, and BuildDate are injected at build time with -ldflags "-X"; see the Makefile.
+var (
+	// Version holds the git describe output, for example v1.4.0 or v1.4.0-3-gabc1234-dirty.
+	Version = "dev"
+	// Commit holds the full git revision.
+	Commit = ""
+	// BuildDate holds the RFC 3339 UTC time of the build.
+	BuildDate = ""
+)
+
+// Info describes the running engine build.
+type Info struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildDate string `json:"build_date"`
+	GoVersion string `json:"go_version"`
+}
+
+// Get returns the build information. A plain go build leaves Commit and BuildDate unset, so they fall back to
+// the VCS stamp the Go toolchain embeds, and then to "unknown".
+func Get() Info {
+	info := Info{Version: Version, Commit: Commit, BuildDate: BuildDate, GoVersion: runtime.Version()}
+	if build, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range build.Settings {
+			switch {
+			case setting.Key == "vcs.revision" && info.Commit == "":
+				info.Commit = setting.Value
+			case setting.Key == "vcs.time" && info.BuildDate == "":
+				info.BuildDate = setting.Value
+			}
+		}
+	}
+	if info.Commit == "" {
+		info.Commit = "unknown"
+	}
+	if info.BuildDate == "" {
+		info.BuildDate = "unknown"
+	}
+	return info
+}
@@ -0,0 +1,224 @@
+package watch
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/audit"
+	"github.com/miradorstack/mirador-rca/internal/engine"
+	"github.com/miradorstack/mirador-rca/internal/metrics"
+	"github.com/miradorstack/mirador-rca/internal/models"
+	"github.com/miradorstack/mirador-rca/internal/repo"
+)
+
+// Scanner runs a cheap anomaly scan; *engine.Pipeline satisfies it.
+type Scanner interface {
+	Scan(ctx context.Context, tenantID, service string, window models.TimeRange, threshold float64) (engine.ScanResult, error)
+}
+
+// Investigator runs a full RCA investigation; *engine.Pipeline satisfies it.
+type Investigator interface {
+	Investigate(ctx context.Context, req models.InvestigationRequest) (models.CorrelationResult, error)
+}
+
+// Target is one watched tenant/service pair, optionally in one environment. A full investigation of Window
+// starts when a scan finds at least MinDensity anomalies per minute, after which the target stays quiet for
+// Cooldown.
+type Target struct {
+	TenantID   string
+	Service    string
+	Interval   time.Duration
+	Window     time.Duration
+	MinDensity float64
+	// Threshold is the anomaly score threshold handed to detectors; zero uses their default.
+	Threshold float64
+	Cooldown  time.Duration
+	// Environment scopes the scans and investigations; empty leaves them unscoped.
+	Environment string
+}
+
+// Watcher scans every target on its own interval and investigates proactively.
+type Watcher struct {
+	logger       *slog.Logger
+	scanner      Scanner
+	investigator Investigator
+	timeout      time.Duration
+	now          func() time.Time
+
+	// mu guards targets, whose thresholds can change at runtime, and the last trigger time per target.
+	mu        sync.Mutex
+	targets   []Target
+	triggered map[string]time.Time
+}
+
+// NewWatcher validates targets and fills defaults: a 1 minute interval, a 10 minute window, and a cooldown
+// of 30 minutes. timeout bounds each investigation and defaults to two minutes.
+func NewWatcher(logger *slog.Logger, scanner Scanner, investigator Investigator, targets []Target, timeout time.Duration) (*Watcher, error) {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if timeout <= 0 {
+		timeout = 2 * time.Minute
+	}
+	normalized, err := NormalizeTargets(targets)
+	if err != nil {
+		return nil, err
+	}
+	return &Watcher{
+		logger:       logger,
+		scanner:      scanner,
+		investigator: investigator,
+		targets:      normalized,
+		timeout:      timeout,
+		now:          time.Now,
+		triggered:    map[string]time.Time{},
+	}, nil
+}
+
+// NormalizeTargets validates targets and fills the defaults described on NewWatcher.
+func NormalizeTargets(targets []Target) ([]Target, error) {
+	normalized := make([]Target, 0, len(targets))
+	for i, target := range targets {
+		if target.TenantID == "" || target.Service == "" {
+			return nil, fmt.Errorf("watch target %d: tenant and service are required", i)
+		}
+		if target.MinDensity <= 0 {
+			return nil, fmt.Errorf("watch target %s/%s: minDensity must be positive", target.TenantID, target.Service)
+		}
+		if target.Interval <= 0 {
+			target.Interval = time.Minute
+		}
+		if target.Window <= 0 {
+			target.Window = 10 * time.Minute
+		}
+		if target.Cooldown <= 0 {
+			target.Cooldown = 30 * time.Minute
+		}
+		normalized = append(normalized, target)
+	}
+	return normalized, nil
+}
+
+// SetThresholds updates the window, minimum density, score threshold, and cooldown of the watched targets
+// that also appear in targets, taking effect from their next scan. Targets are validated first and nothing
+// changes on error; scan intervals and the set of targets are fixed until restart.
+func (w *Watcher) SetThresholds(targets []Target) error {
+	normalized, err := NormalizeTargets(targets)
+	if err != nil {
+		return err
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, update := range normalized {
+		for i, current := range w.targets {
+			if current.key() == update.key() {
+				current.Window, current.MinDensity, current.Threshold, current.Cooldown = update.Window, update.MinDensity, update.Threshold, update.Cooldown
+				w.targets[i] = current
+			}
+		}
+	}
+	return nil
+}
+
+// Run scans every target until ctx is cancelled.
+func (w *Watcher) Run(ctx context.Context) {
+	w.mu.Lock()
+	targets := append([]Target(nil), w.targets...)
+	w.mu.Unlock()
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		wg.Add(1)
+		go func(i int, interval time.Duration) {
+			defer wg.Done()
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				target := w.target(i)
+				if _, err := w.Check(ctx, target); err != nil && ctx.Err() == nil {
+					w.logger.Warn("watch scan failed", slog.String("tenant_id", target.TenantID), slog.String("service", target.Service), slog.Any("error", err))
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+			}
+		}(i, target.Interval)
+	}
+	wg.Wait()
+}
+
+// key identifies a target across threshold updates and cooldowns.
+func (t Target) key() string {
+	return t.TenantID + "/" + t.Service + "/" + t.Environment
+}
+
+func (w *Watcher) target(i int) Target {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.targets[i]
+}
+
+// Check scans target once and, when its anomaly density crosses the threshold outside the cooldown, runs a
+// full investigation. It reports whether an investigation was started.
+func (w *Watcher) Check(ctx context.Context, target Target) (bool, error) {
+	now := w.now().UTC()
+	window := models.TimeRange{Start: now.Add(-target.Window), End: now}
+	ctx = repo.WithEnvironment(ctx, target.Environment)
+	scan, err := w.scanner.Scan(ctx, target.TenantID, target.Service, window, target.Threshold)
+	if err != nil {
+		metrics.ObserveWatchScan(target.TenantID, target.Service, 0, metrics.WatchError)
+		return false, err
+	}
+	if scan.Density < target.MinDensity {
+		metrics.ObserveWatchScan(target.TenantID, target.Service, scan.Density, metrics.WatchQuiet)
+		return false, nil
+	}
+
+	key := target.key()
+	w.mu.Lock()
+	if last, ok := w.triggered[key]; ok && now.Sub(last) < target.Cooldown {
+		w.mu.Unlock()
+		metrics.ObserveWatchScan(target.TenantID, target.Service, scan.Density, metrics.WatchCooldown)
+		return false, nil
+	}
+	w.triggered[key] = now
+	w.mu.Unlock()
+	metrics.ObserveWatchScan(target.TenantID, target.Service, scan.Density, metrics.WatchTriggered)
+
+	logger := w.logger.With(slog.String("tenant_id", target.TenantID), slog.String("service", target.Service), slog.String("environment", target.Environment), slog.Float64("density", scan.Density))
+	logger.Info("anomaly density crossed threshold; starting investigation", slog.Int("anomalies", scan.Anomalies))
+
+	investigateCtx, cancel := context.WithTimeout(audit.WithActor(ctx, "watch"), w.timeout)
+	defer cancel()
+	result, err := w.investigator.Investigate(investigateCtx, investigationRequest(target, window, scan, now))
+	if err != nil {
+		return true, fmt.Errorf("investigate %s: %w", target.Service, err)
+	}
+	logger.Info("watch investigation completed", slog.String("correlation_id", result.CorrelationID))
+	return true, nil
+}
+
+func investigationRequest(target Target, window models.TimeRange, scan engine.ScanResult, now time.Time) models.InvestigationRequest {
+	incidentID := fmt.Sprintf("watch:%s:%d", target.Service, now.Unix())
+	if target.Environment != "" {
+		incidentID = fmt.Sprintf("watch:%s:%s:%d", target.Service, target.Environment, now.Unix())
+	}
+	return models.InvestigationRequest{
+		IncidentID:       incidentID,
+		Environment:      target.Environment,
+		Symptoms:         []string{target.Service},
+		TimeRange:        window,
+		AffectedServices: []string{target.Service},
+		AnomalyThreshold: target.Threshold,
+		TenantID:         target.TenantID,
+		Labels:           map[string]string{"source": "watch"},
+		Incident: models.IncidentMetadata{
+			Title:       fmt.Sprintf("%s: %d anomalies in the last %s", target.Service, scan.Anomalies, target.Window),
+			Description: fmt.Sprintf("Proactive investigation: anomaly density %.2f/min reached the %.2f/min threshold.", scan.Density, target.MinDensity),
+		},
+	}
+}
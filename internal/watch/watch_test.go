@@ -0,0 +1,127 @@
+package watch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/miradorstack/mirador-rca/internal/engine"
+	"github.com/miradorstack/mirador-rca/internal/models"
+	"github.com/miradorstack/mirador-rca/internal/repo"
+)
+
+type scannerStub struct {
+	density      float64
+	windows      []models.TimeRange
+	environments []string
+}
+
+func (s *scannerStub) Scan(ctx context.Context, tenantID, service string, window models.TimeRange, threshold float64) (engine.ScanResult, error) {
+	s.windows = append(s.windows, window)
+	s.environments = append(s.environments, repo.Environment(ctx))
+	return engine.ScanResult{Anomalies: int(s.density * window.End.Sub(window.Start).Minutes()), Density: s.density}, nil
+}
+
+type investigatorStub struct {
+	requests []models.InvestigationRequest
+}
+
+func (s *investigatorStub) Investigate(ctx context.Context, req models.InvestigationRequest) (models.CorrelationResult, error) {
+	s.requests = append(s.requests, req)
+	return models.CorrelationResult{CorrelationID: "corr-1"}, nil
+}
+
+func TestWatcherTriggersOnDensityWithCooldown(t *testing.T) {
+	scanner := &scannerStub{density: 0.2}
+	investigator := &investigatorStub{}
+	target := Target{TenantID: "acme", Service: "checkout", Window: 10 * time.Minute, MinDensity: 0.5, Cooldown: 30 * time.Minute}
+	watcher, err := NewWatcher(nil, scanner, investigator, []Target{target}, time.Minute)
+	if err != nil {
+		t.Fatalf("new watcher: %v", err)
+	}
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	watcher.now = func() time.Time { return now }
+	target = watcher.targets[0]
+	ctx := context.Background()
+
+	if started, err := watcher.Check(ctx, target); err != nil || started {
+		t.Fatalf("expected a quiet scan, got %v %v", started, err)
+	}
+	scanner.density = 0.8
+	if started, err := watcher.Check(ctx, target); err != nil || !started {
+		t.Fatalf("expected an investigation, got %v %v", started, err)
+	}
+	now = now.Add(10 * time.Minute)
+	if started, _ := watcher.Check(ctx, target); started {
+		t.Fatalf("expected the cooldown to suppress a second investigation")
+	}
+	now = now.Add(25 * time.Minute)
+	if started, _ := watcher.Check(ctx, target); !started {
+		t.Fatalf("expected an investigation after the cooldown")
+	}
+
+	if len(investigator.requests) != 2 {
+		t.Fatalf("expected two investigations, got %d", len(investigator.requests))
+	}
+	req := investigator.requests[0]
+	if req.TenantID != "acme" || req.AffectedServices[0] != "checkout" || req.Labels["source"] != "watch" {
+		t.Fatalf("unexpected request: %+v", req)
+	}
+	if !req.TimeRange.Start.Equal(time.Date(2024, 3, 1, 9, 50, 0, 0, time.UTC)) || req.Incident.Title != "checkout: 8 anomalies in the last 10m0s" {
+		t.Fatalf("unexpected window or title: %+v", req)
+	}
+
+	if _, err := NewWatcher(nil, scanner, investigator, []Target{{TenantID: "acme", Service: "checkout"}}, 0); err == nil {
+		t.Fatalf("expected a target without minDensity to be rejected")
+	}
+}
+
+func TestWatcherSetThresholds(t *testing.T) {
+	scanner := &scannerStub{density: 0.8}
+	investigator := &investigatorStub{}
+	watcher, err := NewWatcher(nil, scanner, investigator, []Target{{TenantID: "acme", Service: "checkout", MinDensity: 0.5}}, time.Minute)
+	if err != nil {
+		t.Fatalf("new watcher: %v", err)
+	}
+
+	if err := watcher.SetThresholds([]Target{{TenantID: "acme", Service: "checkout"}}); err == nil {
+		t.Fatalf("expected a target without minDensity to be rejected")
+	}
+	if err := watcher.SetThresholds([]Target{
+		{TenantID: "acme", Service: "checkout", MinDensity: 2, Window: 5 * time.Minute},
+		{TenantID: "acme", Service: "search", MinDensity: 1},
+	}); err != nil {
+		t.Fatalf("set thresholds: %v", err)
+	}
+	target := watcher.target(0)
+	if target.MinDensity != 2 || target.Window != 5*time.Minute || target.Interval != time.Minute || len(watcher.targets) != 1 {
+		t.Fatalf("unexpected targets after update: %+v", watcher.targets)
+	}
+	if started, err := watcher.Check(context.Background(), target); err != nil || started {
+		t.Fatalf("expected the raised threshold to suppress an investigation, got %v %v", started, err)
+	}
+}
+
+func TestWatcherScopesTargetsToTheirEnvironment(t *testing.T) {
+	scanner := &scannerStub{density: 1}
+	investigator := &investigatorStub{}
+	targets := []Target{
+		{TenantID: "acme", Service: "checkout", Environment: "prod", MinDensity: 0.5},
+		{TenantID: "acme", Service: "checkout", Environment: "staging", MinDensity: 0.5},
+	}
+	watcher, err := NewWatcher(nil, scanner, investigator, targets, time.Minute)
+	if err != nil {
+		t.Fatalf("new watcher: %v", err)
+	}
+	for _, target := range watcher.targets {
+		if started, err := watcher.Check(context.Background(), target); err != nil || !started {
+			t.Fatalf("expected an investigation in %s, got %v %v", target.Environment, started, err)
+		}
+	}
+	if len(scanner.environments) != 2 || scanner.environments[0] != "prod" || scanner.environments[1] != "staging" {
+		t.Fatalf("expected each scan scoped to its environment, got %v", scanner.environments)
+	}
+	if len(investigator.requests) != 2 || investigator.requests[0].Environment != "prod" || investigator.requests[1].Environment != "staging" {
+		t.Fatalf("expected each investigation scoped and neither held back by the other's cooldown, got %+v", investigator.requests)
+	}
+}